@@ -65,6 +65,7 @@ import (
 	"github.com/zoobc/zoobc-core/api/service"
 	"github.com/zoobc/zoobc-core/common/chaintype"
 	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/fee"
 	"github.com/zoobc/zoobc-core/common/interceptor"
 	"github.com/zoobc/zoobc-core/common/monitoring"
 	"github.com/zoobc/zoobc-core/common/query"
@@ -101,6 +102,9 @@ func startGrpcServer(
 	maxAPIRequestPerSecond uint32,
 	nodePublicKey []byte,
 	feedbackStrategy feedbacksystem.FeedbackStrategyInterface,
+	pendingTransactionService coreService.PendingTransactionServiceInterface,
+	feeScaleService fee.FeeScaleServiceInterface,
+	detachedMultisigFeePayerSeed string,
 ) {
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(grpcMiddleware.ChainUnaryServer(
@@ -123,6 +127,8 @@ func startGrpcServer(
 		logger.Fatalf("failed to listen: %v\n", err)
 		return
 	}
+	// api services streaming the chain state listen to block events from the node observer
+	observerInstance := observer.NewObserver()
 	participationScoreService := coreService.NewParticipationScoreService(query.NewParticipationScoreQuery(), queryExecutor)
 
 	publishedReceiptUtil := coreUtil.NewPublishedReceiptUtil(query.NewPublishedReceiptQuery(), queryExecutor)
@@ -135,17 +141,20 @@ func startGrpcServer(
 		Service: service.NewBlockService(queryExecutor, blockServices, isDebugMode),
 	})
 	// Set GRPC handler for Transactions requests
+	transactionAPIService := service.NewTransactionService(
+		queryExecutor,
+		crypto.NewSignature(),
+		actionTypeSwitcher,
+		mempoolService,
+		observer.NewObserver(),
+		transactionUtil,
+		feedbackStrategy,
+		logger,
+		nodeRegistrationService,
+		nodeAddressInfoService,
+	)
 	rpcService.RegisterTransactionServiceServer(grpcServer, &handler.TransactionHandler{
-		Service: service.NewTransactionService(
-			queryExecutor,
-			crypto.NewSignature(),
-			actionTypeSwitcher,
-			mempoolService,
-			observer.NewObserver(),
-			transactionUtil,
-			feedbackStrategy,
-			logger,
-		),
+		Service: transactionAPIService,
 	})
 	// Set GRPC handler for Transactions requests
 	rpcService.RegisterHostServiceServer(grpcServer, &handler.HostHandler{
@@ -185,8 +194,11 @@ func startGrpcServer(
 		NodePublicKey: nodePublicKey,
 	})
 	// Set GRPC handler for account ledger request
+	accountLedgerService := service.NewAccountLedgerService(queryExecutor)
+	observerInstance.AddListener(observer.BlockPushed, accountLedgerService.BlockPushedListener())
+	observerInstance.AddListener(observer.BlockRollback, accountLedgerService.BlockRollbackListener())
 	rpcService.RegisterAccountLedgerServiceServer(grpcServer, &handler.AccountLedgerHandler{
-		Service: service.NewAccountLedgerService(queryExecutor),
+		Service: accountLedgerService,
 	})
 	// Set GRPC handler for escrow transaction request
 	rpcService.RegisterEscrowTransactionServiceServer(grpcServer, &handler.EscrowTransactionHandler{
@@ -201,6 +213,12 @@ func startGrpcServer(
 			query.NewPendingSignatureQuery(),
 			query.NewMultisignatureInfoQuery(),
 			query.NewMultiSignatureParticipantQuery(),
+			pendingTransactionService,
+			transactionAPIService,
+			actionTypeSwitcher,
+			transactionUtil,
+			crypto.NewSignature(),
+			detachedMultisigFeePayerSeed,
 		)})
 
 	// Set GRPC handler for health check
@@ -210,6 +228,7 @@ func startGrpcServer(
 	rpcService.RegisterAccountDatasetServiceServer(grpcServer, &handler.AccountDatasetHandler{
 		Service: service.NewAccountDatasetService(
 			query.NewAccountDatasetsQuery(),
+			query.NewAccountDatasetSchemaQuery(),
 			queryExecutor,
 		),
 	})
@@ -235,9 +254,39 @@ func startGrpcServer(
 		Service: service.NewLiquidTransactionService(
 			queryExecutor,
 			query.NewLiquidPaymentTransactionQuery(),
+			blockServices[(&chaintype.MainChain{}).GetTypeInt()],
+		),
+	})
+
+	// Set GRPC handler for hash time locked transfers
+	rpcService.RegisterHtlcServiceServer(grpcServer, &handler.HtlcHandler{
+		Service: service.NewHtlcService(
+			queryExecutor,
+			query.NewHtlcQuery(),
 		),
 	})
 
+	// Set GRPC handler for fee vote results and phases
+	rpcService.RegisterFeeVoteServiceServer(grpcServer, &handler.FeeVoteHandler{
+		Service: service.NewFeeVoteService(
+			queryExecutor,
+			feeScaleService,
+			blockServices[(&chaintype.MainChain{}).GetTypeInt()],
+			query.NewFeeScaleQuery(),
+			query.NewFeeVoteCommitmentVoteQuery(),
+			query.NewFeeVoteRevealVoteQuery(),
+		),
+	})
+
+	// Set GRPC handler for block subscriptions, also served as server-sent events on the http port
+	blockSubscriptionService := service.NewBlockSubscriptionService(blockServices)
+	observerInstance.AddListener(observer.BlockPushed, blockSubscriptionService.BlockPushedListener())
+	observerInstance.AddListener(observer.BlockRollback, blockSubscriptionService.BlockRollbackListener())
+	blockSubscriptionHandler := &handler.BlockSubscriptionHandler{
+		Service: blockSubscriptionService,
+	}
+	rpcService.RegisterBlockSubscriptionServiceServer(grpcServer, blockSubscriptionHandler)
+
 	go func() {
 		// serve rpc
 		if err := grpcServer.Serve(serv); err != nil {
@@ -267,6 +316,10 @@ func startGrpcServer(
 						data := monitoring.GetNodeStatus()
 						_ = tmp.ExecuteTemplate(w, "nodeStatus", data)
 					}
+					// server-sent events mapping of BlockSubscriptionService.SubscribeBlocks
+					if r.URL.Path == "/v1/blockSubscription/SubscribeBlocks" && r.Method == "GET" {
+						blockSubscriptionHandler.ServeHTTP(w, r)
+					}
 					if wrappedServer.IsGrpcWebRequest(r) || wrappedServer.IsAcceptableGrpcCorsRequest(r) {
 						wrappedServer.ServeHTTP(w, r)
 					}
@@ -313,6 +366,9 @@ func Start(
 	maxAPIRequestPerSecond uint32,
 	nodePublicKey []byte,
 	feedbackStrategy feedbacksystem.FeedbackStrategyInterface,
+	pendingTransactionService coreService.PendingTransactionServiceInterface,
+	feeScaleService fee.FeeScaleServiceInterface,
+	detachedMultisigFeePayerSeed string,
 ) {
 	startGrpcServer(
 		queryExecutor,
@@ -334,5 +390,8 @@ func Start(
 		maxAPIRequestPerSecond,
 		nodePublicKey,
 		feedbackStrategy,
+		pendingTransactionService,
+		feeScaleService,
+		detachedMultisigFeePayerSeed,
 	)
 }
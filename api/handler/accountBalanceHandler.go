@@ -51,8 +51,10 @@ package handler
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/zoobc/zoobc-core/api/service"
+	"github.com/zoobc/zoobc-core/common/constant"
 	"github.com/zoobc/zoobc-core/common/model"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -82,3 +84,16 @@ func (abh *AccountBalanceHandler) GetAccountBalances(ctx context.Context,
 
 	return abh.Service.GetAccountBalances(request)
 }
+
+func (abh *AccountBalanceHandler) DiscoverAccounts(ctx context.Context,
+	request *model.DiscoverAccountsRequest) (*model.DiscoverAccountsResponse, error) {
+
+	if len(request.GetAccountPublicKeys()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "At least 1 account public key is required")
+	}
+	if uint32(len(request.GetAccountPublicKeys())) > constant.MaxAPILimitPerPage {
+		return nil, status.Error(codes.OutOfRange, fmt.Sprintf("too many AccountPublicKeys, max. %d", constant.MaxAPILimitPerPage))
+	}
+
+	return abh.Service.DiscoverAccounts(request)
+}
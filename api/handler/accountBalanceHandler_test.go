@@ -56,6 +56,7 @@ import (
 	"testing"
 
 	"github.com/zoobc/zoobc-core/api/service"
+	"github.com/zoobc/zoobc-core/common/constant"
 	"github.com/zoobc/zoobc-core/common/model"
 )
 
@@ -211,3 +212,86 @@ func TestAccountBalanceHandler_GetAccountBalances(t *testing.T) {
 		})
 	}
 }
+
+type (
+	mockDiscoverAccountsSuccess struct {
+		service.AccountBalanceServiceInterface
+	}
+)
+
+func (*mockDiscoverAccountsSuccess) DiscoverAccounts(request *model.DiscoverAccountsRequest) (*model.DiscoverAccountsResponse, error) {
+	return &model.DiscoverAccountsResponse{
+		DiscoveredAccounts: []*model.DiscoveredAccount{},
+	}, nil
+}
+
+func TestAccountBalanceHandler_DiscoverAccounts(t *testing.T) {
+	type fields struct {
+		Service service.AccountBalanceServiceInterface
+	}
+	type args struct {
+		ctx     context.Context
+		request *model.DiscoverAccountsRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *model.DiscoverAccountsResponse
+		wantErr bool
+	}{
+		{
+			name: "DiscoverAccountsHandler:fail-{noPublicKeys}",
+			args: args{
+				request: &model.DiscoverAccountsRequest{
+					AccountPublicKeys: [][]byte{},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "DiscoverAccountsHandler:fail-{tooManyPublicKeys}",
+			args: args{
+				request: &model.DiscoverAccountsRequest{
+					AccountPublicKeys: make([][]byte, constant.MaxAPILimitPerPage+1),
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "DiscoverAccountsHandler:success",
+			args: args{
+				request: &model.DiscoverAccountsRequest{
+					AccountPublicKeys: [][]byte{
+						{185, 226, 12, 96, 140, 157, 68, 172, 119, 193, 144, 246, 76, 118, 0, 112, 113, 140, 183, 229, 116, 202, 211, 235,
+							190, 224, 217, 238, 63, 223, 225, 162},
+					},
+				},
+			},
+			fields: fields{
+				Service: &mockDiscoverAccountsSuccess{},
+			},
+			want: &model.DiscoverAccountsResponse{
+				DiscoveredAccounts: []*model.DiscoveredAccount{},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			abh := &AccountBalanceHandler{
+				Service: tt.fields.Service,
+			}
+			got, err := abh.DiscoverAccounts(tt.args.ctx, tt.args.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AccountBalanceHandler.DiscoverAccounts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AccountBalanceHandler.DiscoverAccounts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -95,3 +95,27 @@ func (adh *AccountDatasetHandler) GetAccountDataset(
 
 	return adh.Service.GetAccountDataset(request)
 }
+
+func (adh *AccountDatasetHandler) GetTypedAccountDatasets(
+	_ context.Context,
+	request *model.GetAccountDatasetsRequest,
+) (*model.GetTypedAccountDatasetsResponse, error) {
+
+	if request.GetPagination().GetLimit() > constant.MaxAPILimitPerPage {
+		return nil, status.Errorf(codes.OutOfRange, "Limit exceeded, max. %d", constant.MaxAPILimitPerPage)
+	}
+
+	return adh.Service.GetTypedAccountDatasets(request)
+}
+
+func (adh *AccountDatasetHandler) GetAccountDatasetSchema(
+	_ context.Context,
+	request *model.GetAccountDatasetSchemaRequest,
+) (*model.AccountDatasetSchema, error) {
+
+	if request.GetSetterAccountAddress() == nil || request.GetProperty() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Request must have SetterAccountAddress and Property")
+	}
+
+	return adh.Service.GetAccountDatasetSchema(request)
+}
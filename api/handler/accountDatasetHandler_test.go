@@ -237,3 +237,99 @@ func TestAccountDatasetHandler_GetAccountDataset(t *testing.T) {
 		})
 	}
 }
+
+type (
+	mockGetAccountDatasetSchemaSuccess struct {
+		service.AccountDatasetServiceInterface
+	}
+)
+
+func (*mockGetAccountDatasetSchemaSuccess) GetTypedAccountDatasets(
+	*model.GetAccountDatasetsRequest,
+) (*model.GetTypedAccountDatasetsResponse, error) {
+	return &model.GetTypedAccountDatasetsResponse{}, nil
+}
+func (*mockGetAccountDatasetSchemaSuccess) GetAccountDatasetSchema(
+	*model.GetAccountDatasetSchemaRequest,
+) (*model.AccountDatasetSchema, error) {
+	return &model.AccountDatasetSchema{}, nil
+}
+
+func TestAccountDatasetHandler_GetTypedAccountDatasets(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *model.GetAccountDatasetsRequest
+		want    *model.GetTypedAccountDatasetsResponse
+		wantErr bool
+	}{
+		{
+			name: "GetTypedAccountDatasets:LimitExceeded",
+			request: &model.GetAccountDatasetsRequest{
+				Pagination: &model.Pagination{
+					Limit: uint32(600),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "GetTypedAccountDatasets:Success",
+			request: &model.GetAccountDatasetsRequest{},
+			want:    &model.GetTypedAccountDatasetsResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adh := &AccountDatasetHandler{
+				Service: &mockGetAccountDatasetSchemaSuccess{},
+			}
+			got, err := adh.GetTypedAccountDatasets(context.Background(), tt.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AccountDatasetHandler.GetTypedAccountDatasets() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AccountDatasetHandler.GetTypedAccountDatasets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccountDatasetHandler_GetAccountDatasetSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *model.GetAccountDatasetSchemaRequest
+		want    *model.AccountDatasetSchema
+		wantErr bool
+	}{
+		{
+			name: "GetAccountDatasetSchema:MissingSetter",
+			request: &model.GetAccountDatasetSchemaRequest{
+				Property: "KycLevel",
+			},
+			wantErr: true,
+		},
+		{
+			name: "GetAccountDatasetSchema:Success",
+			request: &model.GetAccountDatasetSchemaRequest{
+				SetterAccountAddress: []byte{0, 0, 0, 0},
+				Property:             "KycLevel",
+			},
+			want: &model.AccountDatasetSchema{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adh := &AccountDatasetHandler{
+				Service: &mockGetAccountDatasetSchemaSuccess{},
+			}
+			got, err := adh.GetAccountDatasetSchema(context.Background(), tt.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AccountDatasetHandler.GetAccountDatasetSchema() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AccountDatasetHandler.GetAccountDatasetSchema() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -54,6 +54,7 @@ import (
 
 	"github.com/zoobc/zoobc-core/api/service"
 	"github.com/zoobc/zoobc-core/common/model"
+	rpcService "github.com/zoobc/zoobc-core/common/service"
 )
 
 type (
@@ -70,3 +71,11 @@ func (al *AccountLedgerHandler) GetAccountLedgers(
 ) (*model.GetAccountLedgersResponse, error) {
 	return al.Service.GetAccountLedgers(request)
 }
+
+// SubscribeAccountLedgers api handler of account ledger service that streams the ledgers of the subscribed accounts
+func (al *AccountLedgerHandler) SubscribeAccountLedgers(
+	request *model.SubscribeAccountLedgersRequest,
+	stream rpcService.AccountLedgerService_SubscribeAccountLedgersServer,
+) error {
+	return al.Service.StreamAccountLedgerEvents(stream.Context(), request, stream.Send)
+}
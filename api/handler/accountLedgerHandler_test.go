@@ -56,6 +56,7 @@ import (
 
 	"github.com/zoobc/zoobc-core/api/service"
 	"github.com/zoobc/zoobc-core/common/model"
+	rpcService "github.com/zoobc/zoobc-core/common/service"
 )
 
 type mockGetAccountLedgersSuccess struct {
@@ -109,3 +110,43 @@ func TestAccountLedgerHandler_GetAccountLedgers(t *testing.T) {
 		})
 	}
 }
+
+type (
+	mockStreamAccountLedgerEventsSuccess struct {
+		service.AccountLedgerServiceInterface
+	}
+	mockSubscribeAccountLedgersServer struct {
+		rpcService.AccountLedgerService_SubscribeAccountLedgersServer
+		events []*model.AccountLedgerEvent
+	}
+)
+
+func (*mockStreamAccountLedgerEventsSuccess) StreamAccountLedgerEvents(
+	ctx context.Context,
+	request *model.SubscribeAccountLedgersRequest,
+	send func(*model.AccountLedgerEvent) error,
+) error {
+	return send(&model.AccountLedgerEvent{BlockHeight: 1})
+}
+
+func (*mockSubscribeAccountLedgersServer) Context() context.Context {
+	return context.Background()
+}
+
+func (m *mockSubscribeAccountLedgersServer) Send(event *model.AccountLedgerEvent) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+func TestAccountLedgerHandler_SubscribeAccountLedgers(t *testing.T) {
+	stream := &mockSubscribeAccountLedgersServer{}
+	al := &AccountLedgerHandler{
+		Service: &mockStreamAccountLedgerEventsSuccess{},
+	}
+	if err := al.SubscribeAccountLedgers(&model.SubscribeAccountLedgersRequest{}, stream); err != nil {
+		t.Errorf("SubscribeAccountLedgers() error = %v", err)
+	}
+	if !reflect.DeepEqual(stream.events, []*model.AccountLedgerEvent{{BlockHeight: 1}}) {
+		t.Errorf("SubscribeAccountLedgers() sent = %v", stream.events)
+	}
+}
@@ -0,0 +1,144 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/zoobc/zoobc-core/api/service"
+	"github.com/zoobc/zoobc-core/common/model"
+	rpcService "github.com/zoobc/zoobc-core/common/service"
+)
+
+// BlockSubscriptionHandler to handle block subscriptions from client
+type BlockSubscriptionHandler struct {
+	Service service.BlockSubscriptionServiceInterface
+}
+
+// SubscribeBlocks streams the pushed blocks and rollbacks of the requested chain until the client disconnects
+func (bsh *BlockSubscriptionHandler) SubscribeBlocks(
+	req *model.SubscribeBlocksRequest,
+	stream rpcService.BlockSubscriptionService_SubscribeBlocksServer,
+) error {
+	return bsh.Service.StreamBlockEvents(stream.Context(), req, stream.Send)
+}
+
+// ServeHTTP streams the same events as SubscribeBlocks as server-sent events, for http clients without grpc-web.
+// Request fields are read from the query string, each event id is its block height so that a reconnecting
+// EventSource resumes after the last received block through the Last-Event-ID header
+func (bsh *BlockSubscriptionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		req       model.SubscribeBlocksRequest
+		marshaler = jsonpb.Marshaler{}
+		query     = r.URL.Query()
+	)
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	if chainType := query.Get("ChainType"); chainType != "" {
+		ct, err := strconv.ParseInt(chainType, 10, 32)
+		if err != nil {
+			http.Error(w, "invalid ChainType", http.StatusBadRequest)
+			return
+		}
+		req.ChainType = int32(ct)
+	}
+	if fromHeight := query.Get("FromHeight"); fromHeight != "" {
+		height, err := strconv.ParseUint(fromHeight, 10, 32)
+		if err != nil {
+			http.Error(w, "invalid FromHeight", http.StatusBadRequest)
+			return
+		}
+		req.FromHeight = uint32(height)
+	}
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		height, err := strconv.ParseUint(lastEventID, 10, 32)
+		if err != nil {
+			http.Error(w, "invalid Last-Event-ID", http.StatusBadRequest)
+			return
+		}
+		req.FromHeight = uint32(height) + 1
+	}
+	if includeTransactions := query.Get("IncludeTransactions"); includeTransactions != "" {
+		include, err := strconv.ParseBool(includeTransactions)
+		if err != nil {
+			http.Error(w, "invalid IncludeTransactions", http.StatusBadRequest)
+			return
+		}
+		req.IncludeTransactions = include
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	flusher.Flush()
+
+	err := bsh.Service.StreamBlockEvents(r.Context(), &req, func(event *model.BlockEvent) error {
+		data, err := marshaler.MarshalToString(event)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.GetBlock().GetHeight(), event.GetEventType(), data)
+		if err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	})
+	if err != nil {
+		_, _ = fmt.Fprintf(w, "event: error\ndata: %s\n\n", err.Error())
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,214 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/zoobc/zoobc-core/api/service"
+	"github.com/zoobc/zoobc-core/common/model"
+	rpcService "github.com/zoobc/zoobc-core/common/service"
+)
+
+type (
+	mockBlockSubscriptionServiceError struct {
+		service.BlockSubscriptionServiceInterface
+	}
+	mockBlockSubscriptionServiceSuccess struct {
+		service.BlockSubscriptionServiceInterface
+		request *model.SubscribeBlocksRequest
+	}
+	mockSubscribeBlocksServer struct {
+		rpcService.BlockSubscriptionService_SubscribeBlocksServer
+		events []*model.BlockEvent
+	}
+)
+
+func (*mockBlockSubscriptionServiceError) StreamBlockEvents(
+	ctx context.Context,
+	request *model.SubscribeBlocksRequest,
+	send func(*model.BlockEvent) error,
+) error {
+	return errors.New("Error StreamBlockEvents")
+}
+
+func (m *mockBlockSubscriptionServiceSuccess) StreamBlockEvents(
+	ctx context.Context,
+	request *model.SubscribeBlocksRequest,
+	send func(*model.BlockEvent) error,
+) error {
+	m.request = request
+	return send(&model.BlockEvent{
+		EventType: model.BlockEventType_BlockEventPushed,
+		Block:     &model.Block{Height: 10},
+	})
+}
+
+func (*mockSubscribeBlocksServer) Context() context.Context {
+	return context.Background()
+}
+
+func (m *mockSubscribeBlocksServer) Send(event *model.BlockEvent) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+func TestBlockSubscriptionHandler_SubscribeBlocks(t *testing.T) {
+	tests := []struct {
+		name       string
+		service    service.BlockSubscriptionServiceInterface
+		wantEvents int
+		wantErr    bool
+	}{
+		{
+			name:    "SubscribeBlocks:Error",
+			service: &mockBlockSubscriptionServiceError{},
+			wantErr: true,
+		},
+		{
+			name:       "SubscribeBlocks:Success",
+			service:    &mockBlockSubscriptionServiceSuccess{},
+			wantEvents: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bsh := &BlockSubscriptionHandler{
+				Service: tt.service,
+			}
+			stream := &mockSubscribeBlocksServer{}
+			if err := bsh.SubscribeBlocks(&model.SubscribeBlocksRequest{}, stream); (err != nil) != tt.wantErr {
+				t.Errorf("BlockSubscriptionHandler.SubscribeBlocks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(stream.events) != tt.wantEvents {
+				t.Errorf("BlockSubscriptionHandler.SubscribeBlocks() sent %d events, want %d", len(stream.events), tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestBlockSubscriptionHandler_ServeHTTP(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		lastEventID    string
+		service        service.BlockSubscriptionServiceInterface
+		wantStatus     int
+		wantBody       string
+		wantFromHeight uint32
+	}{
+		{
+			name:       "ServeHTTP:InvalidChainType",
+			url:        "/v1/blockSubscription/SubscribeBlocks?ChainType=main",
+			service:    &mockBlockSubscriptionServiceSuccess{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "ServeHTTP:InvalidFromHeight",
+			url:        "/v1/blockSubscription/SubscribeBlocks?FromHeight=-1",
+			service:    &mockBlockSubscriptionServiceSuccess{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "ServeHTTP:StreamError",
+			url:        "/v1/blockSubscription/SubscribeBlocks",
+			service:    &mockBlockSubscriptionServiceError{},
+			wantStatus: http.StatusOK,
+			wantBody:   "event: error\ndata: Error StreamBlockEvents\n\n",
+		},
+		{
+			name:           "ServeHTTP:Success",
+			url:            "/v1/blockSubscription/SubscribeBlocks?ChainType=0&FromHeight=5&IncludeTransactions=true",
+			service:        &mockBlockSubscriptionServiceSuccess{},
+			wantStatus:     http.StatusOK,
+			wantBody:       "id: 10\nevent: BlockEventPushed\n",
+			wantFromHeight: 5,
+		},
+		{
+			name:           "ServeHTTP:ResumeFromLastEventID",
+			url:            "/v1/blockSubscription/SubscribeBlocks?FromHeight=5",
+			lastEventID:    "10",
+			service:        &mockBlockSubscriptionServiceSuccess{},
+			wantStatus:     http.StatusOK,
+			wantBody:       "id: 10\nevent: BlockEventPushed\n",
+			wantFromHeight: 11,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bsh := &BlockSubscriptionHandler{
+				Service: tt.service,
+			}
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+			rec := httptest.NewRecorder()
+			bsh.ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("BlockSubscriptionHandler.ServeHTTP() status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("BlockSubscriptionHandler.ServeHTTP() body = %q, want %q", rec.Body.String(), tt.wantBody)
+			}
+			if mockService, ok := tt.service.(*mockBlockSubscriptionServiceSuccess); ok && tt.wantStatus == http.StatusOK {
+				if mockService.request.GetFromHeight() != tt.wantFromHeight {
+					t.Errorf("BlockSubscriptionHandler.ServeHTTP() FromHeight = %d, want %d",
+						mockService.request.GetFromHeight(), tt.wantFromHeight)
+				}
+			}
+		})
+	}
+}
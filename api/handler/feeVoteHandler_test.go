@@ -0,0 +1,155 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package handler
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zoobc/zoobc-core/api/service"
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+type (
+	mockFeeVoteServiceFailed struct {
+		service.FeeVoteServiceInterface
+	}
+	mockFeeVoteServiceSuccess struct {
+		service.FeeVoteServiceInterface
+	}
+)
+
+func (*mockFeeVoteServiceFailed) GetFeeVoteStatus(*model.GetFeeVoteStatusRequest) (*model.GetFeeVoteStatusResponse, error) {
+	return nil, errors.New("Error GetFeeVoteStatus")
+}
+
+func (*mockFeeVoteServiceFailed) GetFeeScales(*model.GetFeeScalesRequest) (*model.GetFeeScalesResponse, error) {
+	return nil, errors.New("Error GetFeeScales")
+}
+
+func (*mockFeeVoteServiceSuccess) GetFeeVoteStatus(*model.GetFeeVoteStatusRequest) (*model.GetFeeVoteStatusResponse, error) {
+	return &model.GetFeeVoteStatusResponse{Phase: model.FeeVotePhase_FeeVotePhaseReveal}, nil
+}
+
+func (*mockFeeVoteServiceSuccess) GetFeeScales(*model.GetFeeScalesRequest) (*model.GetFeeScalesResponse, error) {
+	return &model.GetFeeScalesResponse{Total: 1}, nil
+}
+
+func TestFeeVoteHandler_GetFeeVoteStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		service service.FeeVoteServiceInterface
+		want    *model.GetFeeVoteStatusResponse
+		wantErr bool
+	}{
+		{
+			name:    "GetFeeVoteStatus:Error",
+			service: &mockFeeVoteServiceFailed{},
+			wantErr: true,
+		},
+		{
+			name:    "GetFeeVoteStatus:Success",
+			service: &mockFeeVoteServiceSuccess{},
+			want:    &model.GetFeeVoteStatusResponse{Phase: model.FeeVotePhase_FeeVotePhaseReveal},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fvh := &FeeVoteHandler{
+				Service: tt.service,
+			}
+			got, err := fvh.GetFeeVoteStatus(context.Background(), &model.GetFeeVoteStatusRequest{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FeeVoteHandler.GetFeeVoteStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FeeVoteHandler.GetFeeVoteStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFeeVoteHandler_GetFeeScales(t *testing.T) {
+	tests := []struct {
+		name    string
+		service service.FeeVoteServiceInterface
+		want    *model.GetFeeScalesResponse
+		wantErr bool
+	}{
+		{
+			name:    "GetFeeScales:Error",
+			service: &mockFeeVoteServiceFailed{},
+			wantErr: true,
+		},
+		{
+			name:    "GetFeeScales:Success",
+			service: &mockFeeVoteServiceSuccess{},
+			want:    &model.GetFeeScalesResponse{Total: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fvh := &FeeVoteHandler{
+				Service: tt.service,
+			}
+			got, err := fvh.GetFeeScales(context.Background(), &model.GetFeeScalesRequest{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FeeVoteHandler.GetFeeScales() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FeeVoteHandler.GetFeeScales() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
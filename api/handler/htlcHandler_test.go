@@ -0,0 +1,155 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package handler
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zoobc/zoobc-core/api/service"
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+type (
+	mockHtlcServiceFailed struct {
+		service.HtlcServiceInterface
+	}
+	mockHtlcServiceSuccess struct {
+		service.HtlcServiceInterface
+	}
+)
+
+func (*mockHtlcServiceFailed) GetHtlcs(*model.GetHtlcsRequest) (*model.GetHtlcsResponse, error) {
+	return nil, errors.New("Error GetHtlcs")
+}
+
+func (*mockHtlcServiceFailed) GetHtlc(*model.GetHtlcRequest) (*model.Htlc, error) {
+	return nil, errors.New("Error GetHtlc")
+}
+
+func (*mockHtlcServiceSuccess) GetHtlcs(*model.GetHtlcsRequest) (*model.GetHtlcsResponse, error) {
+	return &model.GetHtlcsResponse{Total: 1}, nil
+}
+
+func (*mockHtlcServiceSuccess) GetHtlc(request *model.GetHtlcRequest) (*model.Htlc, error) {
+	return &model.Htlc{ID: request.GetID()}, nil
+}
+
+func TestHtlcHandler_GetHtlcs(t *testing.T) {
+	tests := []struct {
+		name    string
+		service service.HtlcServiceInterface
+		want    *model.GetHtlcsResponse
+		wantErr bool
+	}{
+		{
+			name:    "GetHtlcs:Error",
+			service: &mockHtlcServiceFailed{},
+			wantErr: true,
+		},
+		{
+			name:    "GetHtlcs:Success",
+			service: &mockHtlcServiceSuccess{},
+			want:    &model.GetHtlcsResponse{Total: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hh := &HtlcHandler{
+				Service: tt.service,
+			}
+			got, err := hh.GetHtlcs(context.Background(), &model.GetHtlcsRequest{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HtlcHandler.GetHtlcs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HtlcHandler.GetHtlcs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHtlcHandler_GetHtlc(t *testing.T) {
+	tests := []struct {
+		name    string
+		service service.HtlcServiceInterface
+		want    *model.Htlc
+		wantErr bool
+	}{
+		{
+			name:    "GetHtlc:Error",
+			service: &mockHtlcServiceFailed{},
+			wantErr: true,
+		},
+		{
+			name:    "GetHtlc:Success",
+			service: &mockHtlcServiceSuccess{},
+			want:    &model.Htlc{ID: 123},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hh := &HtlcHandler{
+				Service: tt.service,
+			}
+			got, err := hh.GetHtlc(context.Background(), &model.GetHtlcRequest{ID: 123})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HtlcHandler.GetHtlc() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HtlcHandler.GetHtlc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
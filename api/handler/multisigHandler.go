@@ -205,3 +205,17 @@ func (msh *MultisigHandler) GetParticipantsByMultisigAddresses(
 	result, err := msh.MultisigService.GetParticipantsByMultisigAddresses(req)
 	return result, err
 }
+
+func (msh *MultisigHandler) PostDetachedSignature(
+	_ context.Context,
+	req *model.PostDetachedSignatureRequest,
+) (*model.PostDetachedSignatureResponse, error) {
+	if len(req.GetTransactionHash()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "TransactionHashRequired")
+	}
+	if len(req.GetAccountAddress()) == 0 || len(req.GetSignature()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "AccountAddressAndSignatureRequired")
+	}
+	result, err := msh.MultisigService.PostDetachedSignature(req)
+	return result, err
+}
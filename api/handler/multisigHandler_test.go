@@ -401,3 +401,113 @@ func TestMultisigHandler_GetMultisigAddressByParticipantAddress(t *testing.T) {
 		})
 	}
 }
+
+type (
+	mockPostDetachedSignatureError struct {
+		service.MultisigServiceInterface
+	}
+	mockPostDetachedSignatureSuccess struct {
+		service.MultisigServiceInterface
+	}
+)
+
+func (*mockPostDetachedSignatureError) PostDetachedSignature(param *model.PostDetachedSignatureRequest,
+) (*model.PostDetachedSignatureResponse, error) {
+	return nil, errors.New("Error PostDetachedSignature")
+}
+
+func (*mockPostDetachedSignatureSuccess) PostDetachedSignature(param *model.PostDetachedSignatureRequest,
+) (*model.PostDetachedSignatureResponse, error) {
+	return &model.PostDetachedSignatureResponse{}, nil
+}
+
+func TestMultisigHandler_PostDetachedSignature(t *testing.T) {
+	type fields struct {
+		MultisigService service.MultisigServiceInterface
+	}
+	type args struct {
+		ctx context.Context
+		req *model.PostDetachedSignatureRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *model.PostDetachedSignatureResponse
+		wantErr bool
+	}{
+		{
+			name: "PostDetachedSignature:NoTransaction",
+			fields: fields{
+				MultisigService: &mockPostDetachedSignatureSuccess{},
+			},
+			args: args{
+				req: &model.PostDetachedSignatureRequest{
+					AccountAddress: []byte{1},
+					Signature:      []byte{2},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "PostDetachedSignature:NoSignature",
+			fields: fields{
+				MultisigService: &mockPostDetachedSignatureSuccess{},
+			},
+			args: args{
+				req: &model.PostDetachedSignatureRequest{
+					TransactionHash: []byte{1},
+					AccountAddress:  []byte{1},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "PostDetachedSignature:Error",
+			fields: fields{
+				MultisigService: &mockPostDetachedSignatureError{},
+			},
+			args: args{
+				req: &model.PostDetachedSignatureRequest{
+					TransactionHash: []byte{1},
+					AccountAddress:  []byte{1},
+					Signature:       []byte{2},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "PostDetachedSignature:Success",
+			fields: fields{
+				MultisigService: &mockPostDetachedSignatureSuccess{},
+			},
+			args: args{
+				req: &model.PostDetachedSignatureRequest{
+					TransactionHash: []byte{1},
+					AccountAddress:  []byte{1},
+					Signature:       []byte{2},
+				},
+			},
+			want:    &model.PostDetachedSignatureResponse{},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msh := &MultisigHandler{
+				MultisigService: tt.fields.MultisigService,
+			}
+			got, err := msh.PostDetachedSignature(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MultisigHandler.PostDetachedSignature() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MultisigHandler.PostDetachedSignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -146,3 +146,36 @@ func (th *TransactionHandler) GetTransactionMinimumFee(
 
 	return transactionFee, nil
 }
+
+// SimulateTransaction handles request to dry run a signed transaction before broadcasting it
+func (th *TransactionHandler) SimulateTransaction(
+	ctx context.Context,
+	req *model.SimulateTransactionRequest,
+) (*model.SimulateTransactionResponse, error) {
+	if len(req.GetTransactionBytes()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "TransactionBytes is required")
+	}
+	chainType := &chaintype.MainChain{}
+	return th.Service.SimulateTransaction(chainType, req)
+}
+
+// EstimateTransactionFee handles request to get the fees suggested to post a transaction given the mempool pressure
+func (th *TransactionHandler) EstimateTransactionFee(
+	ctx context.Context,
+	req *model.EstimateTransactionFeeRequest,
+) (*model.EstimateTransactionFeeResponse, error) {
+	if len(req.GetTransactionBytes()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "TransactionBytes is required")
+	}
+	chainType := &chaintype.MainChain{}
+	return th.Service.EstimateTransactionFee(chainType, req)
+}
+
+// PostTransactions handle a batch of transactions submitted by client
+func (th *TransactionHandler) PostTransactions(
+	ctx context.Context,
+	req *model.PostTransactionsRequest,
+) (*model.PostTransactionsResponse, error) {
+	chainType := &chaintype.MainChain{}
+	return th.Service.PostTransactions(chainType, req)
+}
@@ -325,3 +325,242 @@ func TestTransactionHandler_GetTransactionMinimumFee(t *testing.T) {
 		})
 	}
 }
+
+type (
+	mockSimulateTransactionError struct {
+		service.TransactionServiceInterface
+	}
+	mockSimulateTransactionSuccess struct {
+		service.TransactionServiceInterface
+	}
+)
+
+func (*mockSimulateTransactionError) SimulateTransaction(chaintype.ChainType, *model.SimulateTransactionRequest,
+) (*model.SimulateTransactionResponse, error) {
+	return nil, errors.New("Error SimulateTransaction")
+}
+func (*mockSimulateTransactionSuccess) SimulateTransaction(chaintype.ChainType, *model.SimulateTransactionRequest,
+) (*model.SimulateTransactionResponse, error) {
+	return &model.SimulateTransactionResponse{Accepted: true}, nil
+}
+
+func TestTransactionHandler_SimulateTransaction(t *testing.T) {
+	type fields struct {
+		Service service.TransactionServiceInterface
+	}
+	type args struct {
+		ctx context.Context
+		req *model.SimulateTransactionRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *model.SimulateTransactionResponse
+		wantErr bool
+	}{
+		{
+			name: "SimulateTransaction:EmptyTransactionBytes",
+			fields: fields{
+				Service: &mockSimulateTransactionSuccess{},
+			},
+			args: args{
+				req: &model.SimulateTransactionRequest{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "SimulateTransaction:Failed",
+			fields: fields{
+				Service: &mockSimulateTransactionError{},
+			},
+			args: args{
+				req: &model.SimulateTransactionRequest{TransactionBytes: []byte{1}},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "SimulateTransaction:Success",
+			fields: fields{
+				Service: &mockSimulateTransactionSuccess{},
+			},
+			args: args{
+				req: &model.SimulateTransactionRequest{TransactionBytes: []byte{1}},
+			},
+			want:    &model.SimulateTransactionResponse{Accepted: true},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th := &TransactionHandler{
+				Service: tt.fields.Service,
+			}
+			got, err := th.SimulateTransaction(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TransactionHandler.SimulateTransaction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TransactionHandler.SimulateTransaction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type (
+	mockEstimateTransactionFeeError struct {
+		service.TransactionServiceInterface
+	}
+	mockEstimateTransactionFeeSuccess struct {
+		service.TransactionServiceInterface
+	}
+)
+
+func (*mockEstimateTransactionFeeError) EstimateTransactionFee(chaintype.ChainType, *model.EstimateTransactionFeeRequest,
+) (*model.EstimateTransactionFeeResponse, error) {
+	return nil, errors.New("Error EstimateTransactionFee")
+}
+func (*mockEstimateTransactionFeeSuccess) EstimateTransactionFee(chaintype.ChainType, *model.EstimateTransactionFeeRequest,
+) (*model.EstimateTransactionFeeResponse, error) {
+	return &model.EstimateTransactionFeeResponse{MinimumFee: 1}, nil
+}
+
+func TestTransactionHandler_EstimateTransactionFee(t *testing.T) {
+	type fields struct {
+		Service service.TransactionServiceInterface
+	}
+	type args struct {
+		ctx context.Context
+		req *model.EstimateTransactionFeeRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *model.EstimateTransactionFeeResponse
+		wantErr bool
+	}{
+		{
+			name: "EstimateTransactionFee:EmptyTransactionBytes",
+			fields: fields{
+				Service: &mockEstimateTransactionFeeSuccess{},
+			},
+			args: args{
+				req: &model.EstimateTransactionFeeRequest{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "EstimateTransactionFee:Failed",
+			fields: fields{
+				Service: &mockEstimateTransactionFeeError{},
+			},
+			args: args{
+				req: &model.EstimateTransactionFeeRequest{TransactionBytes: []byte{1}},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "EstimateTransactionFee:Success",
+			fields: fields{
+				Service: &mockEstimateTransactionFeeSuccess{},
+			},
+			args: args{
+				req: &model.EstimateTransactionFeeRequest{TransactionBytes: []byte{1}},
+			},
+			want:    &model.EstimateTransactionFeeResponse{MinimumFee: 1},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th := &TransactionHandler{
+				Service: tt.fields.Service,
+			}
+			got, err := th.EstimateTransactionFee(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TransactionHandler.EstimateTransactionFee() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TransactionHandler.EstimateTransactionFee() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type (
+	mockPostTransactionsError struct {
+		service.TransactionServiceInterface
+	}
+	mockPostTransactionsSuccess struct {
+		service.TransactionServiceInterface
+	}
+)
+
+func (*mockPostTransactionsError) PostTransactions(chaintype.ChainType, *model.PostTransactionsRequest,
+) (*model.PostTransactionsResponse, error) {
+	return nil, errors.New("Error PostTransactions")
+}
+func (*mockPostTransactionsSuccess) PostTransactions(chaintype.ChainType, *model.PostTransactionsRequest,
+) (*model.PostTransactionsResponse, error) {
+	return &model.PostTransactionsResponse{
+		Results: []*model.PostTransactionResult{{TransactionID: 1}},
+	}, nil
+}
+
+func TestTransactionHandler_PostTransactions(t *testing.T) {
+	type fields struct {
+		Service service.TransactionServiceInterface
+	}
+	type args struct {
+		ctx context.Context
+		req *model.PostTransactionsRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *model.PostTransactionsResponse
+		wantErr bool
+	}{
+		{
+			name: "PostTransactions:Failed",
+			fields: fields{
+				Service: &mockPostTransactionsError{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "PostTransactions:Success",
+			fields: fields{
+				Service: &mockPostTransactionsSuccess{},
+			},
+			want: &model.PostTransactionsResponse{
+				Results: []*model.PostTransactionResult{{TransactionID: 1}},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th := &TransactionHandler{
+				Service: tt.fields.Service,
+			}
+			got, err := th.PostTransactions(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TransactionHandler.PostTransactions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TransactionHandler.PostTransactions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -51,7 +51,10 @@ package service
 
 import (
 	"database/sql"
+	"fmt"
 
+	"github.com/zoobc/zoobc-core/common/accounttype"
+	"github.com/zoobc/zoobc-core/common/constant"
 	"github.com/zoobc/zoobc-core/common/model"
 	"github.com/zoobc/zoobc-core/common/query"
 	"google.golang.org/grpc/codes"
@@ -62,6 +65,7 @@ type (
 	AccountBalanceServiceInterface interface {
 		GetAccountBalance(request *model.GetAccountBalanceRequest) (*model.GetAccountBalanceResponse, error)
 		GetAccountBalances(request *model.GetAccountBalancesRequest) (*model.GetAccountBalancesResponse, error)
+		DiscoverAccounts(request *model.DiscoverAccountsRequest) (*model.DiscoverAccountsResponse, error)
 	}
 
 	AccountBalanceService struct {
@@ -135,3 +139,131 @@ func (abs *AccountBalanceService) GetAccountBalances(
 		AccountBalances: accountBalances,
 	}, nil
 }
+
+// DiscoverAccounts find the used accounts among the public keys of the accounts of a hdwallet, in account index order.
+// An account is used when it has a balance or account ledgers, the discovery ends after GapLimit consecutive unused accounts
+func (abs *AccountBalanceService) DiscoverAccounts(
+	request *model.DiscoverAccountsRequest,
+) (*model.DiscoverAccountsResponse, error) {
+	var (
+		gapLimit         = request.GetGapLimit()
+		accountAddresses = make([][]byte, len(request.GetAccountPublicKeys()))
+		response         = &model.DiscoverAccountsResponse{}
+		unusedAccounts   uint32
+	)
+	if gapLimit == 0 {
+		gapLimit = constant.DiscoverAccountsDefaultGapLimit
+	}
+	for i, publicKey := range request.GetAccountPublicKeys() {
+		accountType := &accounttype.ZbcAccountType{}
+		if uint32(len(publicKey)) != accountType.GetAccountPublicKeyLength() {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("InvalidAccountPublicKeyLength at index %d", i))
+		}
+		accountType.SetAccountPublicKey(publicKey)
+		accountAddress, err := accountType.GetAccountAddress()
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		accountAddresses[i] = accountAddress
+	}
+	if len(accountAddresses) == 0 {
+		return response, nil
+	}
+
+	accountBalances, err := abs.getLatestAccountBalances(accountAddresses)
+	if err != nil {
+		return nil, err
+	}
+	ledgersCounts, err := abs.getAccountLedgersCounts(accountAddresses)
+	if err != nil {
+		return nil, err
+	}
+	for i, accountAddress := range accountAddresses {
+		var (
+			accountBalance = accountBalances[string(accountAddress)]
+			ledgersCount   = ledgersCounts[string(accountAddress)]
+		)
+		if accountBalance == nil && ledgersCount == 0 {
+			unusedAccounts++
+			if unusedAccounts >= gapLimit {
+				break
+			}
+			continue
+		}
+		unusedAccounts = 0
+		response.DiscoveredAccounts = append(response.DiscoveredAccounts, &model.DiscoveredAccount{
+			AccountIndex:   uint32(i),
+			AccountAddress: accountAddress,
+			AccountBalance: accountBalance,
+			LedgersCount:   ledgersCount,
+		})
+		response.NextAccountIndex = uint32(i) + 1
+	}
+	return response, nil
+}
+
+// getLatestAccountBalances return the latest balances of the accounts, mapped by account address
+func (abs *AccountBalanceService) getLatestAccountBalances(accountAddresses [][]byte) (map[string]*model.AccountBalance, error) {
+	var (
+		accountBalancesMap = make(map[string]*model.AccountBalance)
+		addresses          []interface{}
+		caseQ              = query.NewCaseQuery()
+	)
+	for _, accountAddress := range accountAddresses {
+		addresses = append(addresses, accountAddress)
+	}
+	caseQ.Select(abs.AccountBalanceQuery.TableName, abs.AccountBalanceQuery.Fields...)
+	caseQ.And(caseQ.In("account_address", addresses...))
+	caseQ.And(caseQ.Equal("latest", true))
+
+	selectQ, args := caseQ.Build()
+	rows, err := abs.QueryExecutor.ExecuteSelect(selectQ, false, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+
+	accountBalances, err := abs.AccountBalanceQuery.BuildModel([]*model.AccountBalance{}, rows)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, accountBalance := range accountBalances {
+		accountBalancesMap[string(accountBalance.GetAccountAddress())] = accountBalance
+	}
+	return accountBalancesMap, nil
+}
+
+// getAccountLedgersCounts return the number of account ledgers of the accounts, mapped by account address
+func (abs *AccountBalanceService) getAccountLedgersCounts(accountAddresses [][]byte) (map[string]uint64, error) {
+	var (
+		ledgersCounts = make(map[string]uint64)
+		addresses     []interface{}
+		ledgerQuery   = query.NewAccountLedgerQuery()
+		caseQ         = query.NewCaseQuery()
+	)
+	for _, accountAddress := range accountAddresses {
+		addresses = append(addresses, accountAddress)
+	}
+	caseQ.Select(ledgerQuery.TableName, "account_address", "COUNT(*)")
+	caseQ.Where(caseQ.In("account_address", addresses...))
+	caseQ.GroupBy("account_address")
+
+	selectQ, args := caseQ.Build()
+	rows, err := abs.QueryExecutor.ExecuteSelect(selectQ, false, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			accountAddress []byte
+			count          uint64
+		)
+		if err = rows.Scan(&accountAddress, &count); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		ledgersCounts[string(accountAddress)] = count
+	}
+	return ledgersCounts, nil
+}
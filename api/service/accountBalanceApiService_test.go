@@ -54,6 +54,7 @@ import (
 	"errors"
 	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -356,3 +357,167 @@ func TestAccountBalanceService_GetAccountBalances(t *testing.T) {
 		})
 	}
 }
+
+type (
+	mockDiscoverAccountsExecutorSuccess struct {
+		query.ExecutorInterface
+	}
+)
+
+var (
+	mockDiscoverAccountsPublicKeys = [][]byte{
+		accBalanceAccountAddress1[4:],
+		{1, 226, 12, 96, 140, 157, 68, 172, 119, 193, 144, 246, 76, 118, 0, 112, 113, 140, 183, 229, 116, 202, 211, 235, 190, 224,
+			217, 238, 63, 223, 225, 162},
+		{2, 226, 12, 96, 140, 157, 68, 172, 119, 193, 144, 246, 76, 118, 0, 112, 113, 140, 183, 229, 116, 202, 211, 235, 190, 224,
+			217, 238, 63, 223, 225, 162},
+		{3, 226, 12, 96, 140, 157, 68, 172, 119, 193, 144, 246, 76, 118, 0, 112, 113, 140, 183, 229, 116, 202, 211, 235, 190, 224,
+			217, 238, 63, 223, 225, 162},
+		{4, 226, 12, 96, 140, 157, 68, 172, 119, 193, 144, 246, 76, 118, 0, 112, 113, 140, 183, 229, 116, 202, 211, 235, 190, 224,
+			217, 238, 63, 223, 225, 162},
+	}
+	mockDiscoverAccountsBalance = &model.AccountBalance{
+		AccountAddress:   accBalanceAccountAddress1,
+		SpendableBalance: 100000000000,
+		Balance:          101666666666,
+		Latest:           true,
+	}
+	// the account 2 has no balance, only ledgers
+	mockDiscoverAccountsLedgerAccountAddress = append([]byte{0, 0, 0, 0}, mockDiscoverAccountsPublicKeys[2]...)
+)
+
+func (*mockDiscoverAccountsExecutorSuccess) ExecuteSelect(qStr string, _ bool, _ ...interface{}) (*sql.Rows, error) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	if strings.Contains(qStr, "account_ledger") {
+		mock.ExpectQuery("").WillReturnRows(mock.NewRows([]string{"account_address", "COUNT(*)"}).AddRow(
+			mockDiscoverAccountsLedgerAccountAddress,
+			3,
+		))
+		return db.Query("")
+	}
+	mock.ExpectQuery("").WillReturnRows(mock.NewRows(query.NewAccountBalanceQuery().Fields).AddRow(
+		mockDiscoverAccountsBalance.GetAccountAddress(),
+		mockDiscoverAccountsBalance.GetBlockHeight(),
+		mockDiscoverAccountsBalance.GetSpendableBalance(),
+		mockDiscoverAccountsBalance.GetBalance(),
+		mockDiscoverAccountsBalance.GetPopRevenue(),
+		mockDiscoverAccountsBalance.GetLatest(),
+	))
+	return db.Query("")
+}
+
+func TestAccountBalanceService_DiscoverAccounts(t *testing.T) {
+	type fields struct {
+		AccountBalanceQuery *query.AccountBalanceQuery
+		QueryExecutor       query.ExecutorInterface
+	}
+	type args struct {
+		request *model.DiscoverAccountsRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *model.DiscoverAccountsResponse
+		wantErr bool
+	}{
+		{
+			name: "DiscoverAccounts:InvalidPublicKeyLength",
+			fields: fields{
+				AccountBalanceQuery: query.NewAccountBalanceQuery(),
+				QueryExecutor:       &mockDiscoverAccountsExecutorSuccess{},
+			},
+			args: args{
+				request: &model.DiscoverAccountsRequest{
+					AccountPublicKeys: [][]byte{mockDiscoverAccountsPublicKeys[0], {1, 2, 3}},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "DiscoverAccounts:ExecutorError",
+			fields: fields{
+				AccountBalanceQuery: query.NewAccountBalanceQuery(),
+				QueryExecutor:       &mockGetAccountBalancesExecutorError{},
+			},
+			args: args{
+				request: &model.DiscoverAccountsRequest{
+					AccountPublicKeys: mockDiscoverAccountsPublicKeys,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "DiscoverAccounts:Success-{defaultGapLimit}",
+			fields: fields{
+				AccountBalanceQuery: query.NewAccountBalanceQuery(),
+				QueryExecutor:       &mockDiscoverAccountsExecutorSuccess{},
+			},
+			args: args{
+				request: &model.DiscoverAccountsRequest{
+					AccountPublicKeys: mockDiscoverAccountsPublicKeys,
+				},
+			},
+			want: &model.DiscoverAccountsResponse{
+				DiscoveredAccounts: []*model.DiscoveredAccount{
+					{
+						AccountIndex:   0,
+						AccountAddress: accBalanceAccountAddress1,
+						AccountBalance: mockDiscoverAccountsBalance,
+					},
+					{
+						AccountIndex:   2,
+						AccountAddress: mockDiscoverAccountsLedgerAccountAddress,
+						LedgersCount:   3,
+					},
+				},
+				NextAccountIndex: 3,
+			},
+			wantErr: false,
+		},
+		{
+			name: "DiscoverAccounts:Success-{gapLimitReached}",
+			fields: fields{
+				AccountBalanceQuery: query.NewAccountBalanceQuery(),
+				QueryExecutor:       &mockDiscoverAccountsExecutorSuccess{},
+			},
+			args: args{
+				request: &model.DiscoverAccountsRequest{
+					AccountPublicKeys: mockDiscoverAccountsPublicKeys,
+					GapLimit:          1,
+				},
+			},
+			want: &model.DiscoverAccountsResponse{
+				DiscoveredAccounts: []*model.DiscoveredAccount{
+					{
+						AccountIndex:   0,
+						AccountAddress: accBalanceAccountAddress1,
+						AccountBalance: mockDiscoverAccountsBalance,
+					},
+				},
+				NextAccountIndex: 1,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			abs := &AccountBalanceService{
+				AccountBalanceQuery: tt.fields.AccountBalanceQuery,
+				QueryExecutor:       tt.fields.QueryExecutor,
+			}
+			got, err := abs.DiscoverAccounts(tt.args.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AccountBalanceService.DiscoverAccounts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AccountBalanceService.DiscoverAccounts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -51,9 +51,11 @@ package service
 
 import (
 	"database/sql"
+	"encoding/hex"
 
 	"github.com/zoobc/zoobc-core/common/model"
 	"github.com/zoobc/zoobc-core/common/query"
+	"github.com/zoobc/zoobc-core/common/util"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -63,21 +65,26 @@ type (
 	AccountDatasetServiceInterface interface {
 		GetAccountDatasets(request *model.GetAccountDatasetsRequest) (*model.GetAccountDatasetsResponse, error)
 		GetAccountDataset(request *model.GetAccountDatasetRequest) (*model.AccountDataset, error)
+		GetTypedAccountDatasets(request *model.GetAccountDatasetsRequest) (*model.GetTypedAccountDatasetsResponse, error)
+		GetAccountDatasetSchema(request *model.GetAccountDatasetSchemaRequest) (*model.AccountDatasetSchema, error)
 	}
 	// AccountDatasetService contain fields that needed for AccountDatasetServiceInterface
 	AccountDatasetService struct {
-		AccountDatasetQuery *query.AccountDatasetQuery
-		QueryExecutor       query.ExecutorInterface
+		AccountDatasetQuery       *query.AccountDatasetQuery
+		AccountDatasetSchemaQuery *query.AccountDatasetSchemaQuery
+		QueryExecutor             query.ExecutorInterface
 	}
 )
 
 func NewAccountDatasetService(
 	accountDatasetQuery *query.AccountDatasetQuery,
+	accountDatasetSchemaQuery *query.AccountDatasetSchemaQuery,
 	queryExecutor query.ExecutorInterface,
 ) AccountDatasetServiceInterface {
 	return &AccountDatasetService{
-		AccountDatasetQuery: accountDatasetQuery,
-		QueryExecutor:       queryExecutor,
+		AccountDatasetQuery:       accountDatasetQuery,
+		AccountDatasetSchemaQuery: accountDatasetSchemaQuery,
+		QueryExecutor:             queryExecutor,
 	}
 }
 
@@ -110,7 +117,14 @@ func (ads *AccountDatasetService) GetAccountDatasets(
 	if request.GetHeight() > 0 {
 		caseQ.Where(caseQ.Equal("height", request.GetHeight()))
 	}
-	caseQ.And(caseQ.Equal("is_active", true))
+	switch request.GetStatus() {
+	case model.AccountDatasetStatus_AccountDatasetStatusExpired:
+		// removed datasets are inactive too, but carry no expiry
+		caseQ.And(caseQ.Equal("is_active", false))
+		caseQ.AndOr(caseQ.GreaterEqual("expiry_height", 1), caseQ.GreaterEqual("expiry_timestamp", 1))
+	default:
+		caseQ.And(caseQ.Equal("is_active", true))
+	}
 	caseQ.And(caseQ.Equal("latest", true))
 
 	countQ, countArgs := caseQ.Build()
@@ -181,3 +195,84 @@ func (ads *AccountDatasetService) GetAccountDataset(
 
 	return &accDataset, nil
 }
+
+/*
+GetTypedAccountDatasets return the same datasets as GetAccountDatasets with their value parsed by the schema of their property.
+A dataset keeps a string value when its setter hasn't registered a schema for the property or set it before the schema
+*/
+func (ads *AccountDatasetService) GetTypedAccountDatasets(
+	request *model.GetAccountDatasetsRequest,
+) (*model.GetTypedAccountDatasetsResponse, error) {
+	var (
+		typedDatasets []*model.TypedAccountDataset
+		schemas       = make(map[string]*model.AccountDatasetSchema)
+	)
+	datasets, err := ads.GetAccountDatasets(request)
+	if err != nil {
+		return nil, err
+	}
+	for _, dataset := range datasets.GetAccountDatasets() {
+		schemaKey := hex.EncodeToString(dataset.GetSetterAccountAddress()) + dataset.GetProperty()
+		schema, ok := schemas[schemaKey]
+		if !ok {
+			schema, err = ads.getLatestAccountDatasetSchema(dataset.GetSetterAccountAddress(), dataset.GetProperty())
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			schemas[schemaKey] = schema
+		}
+		typedDatasets = append(typedDatasets, ads.getTypedAccountDataset(schema, dataset))
+	}
+	return &model.GetTypedAccountDatasetsResponse{
+		Total:                datasets.GetTotal(),
+		TypedAccountDatasets: typedDatasets,
+	}, nil
+}
+
+// GetAccountDatasetSchema return the schema registered by the setter for the property
+func (ads *AccountDatasetService) GetAccountDatasetSchema(
+	request *model.GetAccountDatasetSchemaRequest,
+) (*model.AccountDatasetSchema, error) {
+	schema, err := ads.getLatestAccountDatasetSchema(request.GetSetterAccountAddress(), request.GetProperty())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if schema == nil {
+		return nil, status.Error(codes.NotFound, "Record not found")
+	}
+	return schema, nil
+}
+
+// getLatestAccountDatasetSchema return nil when no schema has been registered for the property
+func (ads *AccountDatasetService) getLatestAccountDatasetSchema(setter []byte, property string) (*model.AccountDatasetSchema, error) {
+	var schema model.AccountDatasetSchema
+	qry, args := ads.AccountDatasetSchemaQuery.GetLatestAccountDatasetSchema(setter, property)
+	row, err := ads.QueryExecutor.ExecuteSelectRow(qry, false, args...)
+	if err != nil {
+		return nil, err
+	}
+	err = ads.AccountDatasetSchemaQuery.Scan(&schema, row)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return nil, err
+		}
+		return nil, nil
+	}
+	return &schema, nil
+}
+
+func (*AccountDatasetService) getTypedAccountDataset(
+	schema *model.AccountDatasetSchema,
+	dataset *model.AccountDataset,
+) *model.TypedAccountDataset {
+	if schema != nil && dataset.GetHeight() >= schema.GetBlockHeight() {
+		typedDataset, err := util.ParseAccountDatasetValue(schema, dataset)
+		if err == nil {
+			return typedDataset
+		}
+	}
+	return &model.TypedAccountDataset{
+		AccountDataset: dataset,
+		ValueType:      model.AccountDatasetValueType_AccountDatasetValueString,
+	}
+}
@@ -51,7 +51,9 @@ package service
 
 import (
 	"database/sql"
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -63,6 +65,9 @@ type (
 	mockGetAccountDatasetsExecutor struct {
 		query.ExecutorInterface
 	}
+	mockGetExpiredAccountDatasetsExecutor struct {
+		mockGetAccountDatasetsExecutor
+	}
 )
 
 var (
@@ -90,6 +95,32 @@ func (*mockGetAccountDatasetsExecutor) ExecuteSelect(string, bool, ...interface{
 		true,
 		true,
 		5,
+		0,
+		0,
+	)
+	mock.ExpectQuery("").WillReturnRows(mockRows)
+
+	return db.Query("")
+}
+
+func (*mockGetExpiredAccountDatasetsExecutor) ExecuteSelect(qe string, _ bool, _ ...interface{}) (*sql.Rows, error) {
+	if !strings.Contains(qe, "AND is_active = ? AND (expiry_height >= ?  OR expiry_timestamp >= ? )") {
+		return nil, errors.New("mockError:WrongQuery")
+	}
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	mockRows := mock.NewRows(query.NewAccountDatasetsQuery().Fields)
+	mockRows.AddRow(
+		accDatasetSetterAccount1,
+		accDatasetRecipientAccount1,
+		"AccountDatasetEscrowApproval",
+		"Message",
+		false,
+		true,
+		10,
+		10,
+		0,
 	)
 	mock.ExpectQuery("").WillReturnRows(mockRows)
 
@@ -147,6 +178,40 @@ func TestAccountDatasetService_GetAccountDatasets(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "wantSuccess:Expired",
+			fields: fields{
+				AccountDatasetQuery: query.NewAccountDatasetsQuery(),
+				QueryExecutor:       &mockGetExpiredAccountDatasetsExecutor{},
+			},
+			args: args{
+				request: &model.GetAccountDatasetsRequest{
+					RecipientAccountAddress: accDatasetRecipientAccount1,
+					Status:                  model.AccountDatasetStatus_AccountDatasetStatusExpired,
+					Pagination: &model.Pagination{
+						OrderField: "height",
+						OrderBy:    model.OrderBy_ASC,
+						Page:       0,
+						Limit:      500,
+					},
+				},
+			},
+			want: &model.GetAccountDatasetsResponse{
+				Total: 1,
+				AccountDatasets: []*model.AccountDataset{
+					{
+						SetterAccountAddress:    accDatasetSetterAccount1,
+						RecipientAccountAddress: accDatasetRecipientAccount1,
+						Property:                "AccountDatasetEscrowApproval",
+						Value:                   "Message",
+						Height:                  10,
+						Latest:                  true,
+						IsActive:                false,
+						ExpiryHeight:            10,
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -188,6 +253,8 @@ func (*mockExecutorGetAccountDataset) ExecuteSelectRow(string, bool, ...interfac
 		true,
 		true,
 		5,
+		0,
+		0,
 	)
 	mock.ExpectQuery("").WillReturnRows(mockRow)
 	return db.QueryRow(""), nil
@@ -268,3 +335,174 @@ func TestAccountDatasetService_GetAccountDataset(t *testing.T) {
 		})
 	}
 }
+
+type (
+	mockExecutorGetTypedAccountDatasets struct {
+		query.ExecutorInterface
+		schemaHeight uint32
+	}
+)
+
+func (m *mockExecutorGetTypedAccountDatasets) ExecuteSelectRow(qStr string, _ bool, _ ...interface{}) (*sql.Row, error) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	if !strings.Contains(qStr, "account_dataset_schema") {
+		mock.ExpectQuery("").WillReturnRows(sqlmock.NewRows([]string{"total"}).AddRow(1))
+		return db.QueryRow(""), nil
+	}
+	mockRow := mock.NewRows(query.NewAccountDatasetSchemaQuery().Fields)
+	if m.schemaHeight > 0 {
+		mockRow.AddRow(
+			accDatasetSetterAccount1,
+			"KycLevel",
+			int32(model.AccountDatasetValueType_AccountDatasetValueInt),
+			2,
+			m.schemaHeight,
+			true,
+		)
+	}
+	mock.ExpectQuery("").WillReturnRows(mockRow)
+	return db.QueryRow(""), nil
+}
+
+func (*mockExecutorGetTypedAccountDatasets) ExecuteSelect(string, bool, ...interface{}) (*sql.Rows, error) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	mockRows := mock.NewRows(query.NewAccountDatasetsQuery().Fields)
+	mockRows.AddRow(
+		accDatasetSetterAccount1,
+		accDatasetRecipientAccount1,
+		"KycLevel",
+		"3",
+		true,
+		true,
+		5,
+		0,
+		0,
+	)
+	mock.ExpectQuery("").WillReturnRows(mockRows)
+	return db.Query("")
+}
+
+func TestAccountDatasetService_GetTypedAccountDatasets(t *testing.T) {
+	dataset := &model.AccountDataset{
+		SetterAccountAddress:    accDatasetSetterAccount1,
+		RecipientAccountAddress: accDatasetRecipientAccount1,
+		Property:                "KycLevel",
+		Value:                   "3",
+		Height:                  5,
+		Latest:                  true,
+		IsActive:                true,
+	}
+	tests := []struct {
+		name          string
+		QueryExecutor query.ExecutorInterface
+		want          *model.GetTypedAccountDatasetsResponse
+	}{
+		{
+			name:          "wantSuccess:NoSchema",
+			QueryExecutor: &mockExecutorGetTypedAccountDatasets{},
+			want: &model.GetTypedAccountDatasetsResponse{
+				Total: 1,
+				TypedAccountDatasets: []*model.TypedAccountDataset{
+					{
+						AccountDataset: dataset,
+						ValueType:      model.AccountDatasetValueType_AccountDatasetValueString,
+					},
+				},
+			},
+		},
+		{
+			name:          "wantSuccess:SetBeforeSchema",
+			QueryExecutor: &mockExecutorGetTypedAccountDatasets{schemaHeight: 6},
+			want: &model.GetTypedAccountDatasetsResponse{
+				Total: 1,
+				TypedAccountDatasets: []*model.TypedAccountDataset{
+					{
+						AccountDataset: dataset,
+						ValueType:      model.AccountDatasetValueType_AccountDatasetValueString,
+					},
+				},
+			},
+		},
+		{
+			name:          "wantSuccess:Typed",
+			QueryExecutor: &mockExecutorGetTypedAccountDatasets{schemaHeight: 4},
+			want: &model.GetTypedAccountDatasetsResponse{
+				Total: 1,
+				TypedAccountDatasets: []*model.TypedAccountDataset{
+					{
+						AccountDataset: dataset,
+						ValueType:      model.AccountDatasetValueType_AccountDatasetValueInt,
+						IntValue:       3,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ads := &AccountDatasetService{
+				AccountDatasetQuery:       query.NewAccountDatasetsQuery(),
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				QueryExecutor:             tt.QueryExecutor,
+			}
+			got, err := ads.GetTypedAccountDatasets(&model.GetAccountDatasetsRequest{Property: "KycLevel"})
+			if err != nil {
+				t.Errorf("GetTypedAccountDatasets() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetTypedAccountDatasets() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccountDatasetService_GetAccountDatasetSchema(t *testing.T) {
+	tests := []struct {
+		name          string
+		QueryExecutor query.ExecutorInterface
+		want          *model.AccountDatasetSchema
+		wantErr       bool
+	}{
+		{
+			name:          "wantError:NotFound",
+			QueryExecutor: &mockExecutorGetTypedAccountDatasets{},
+			wantErr:       true,
+		},
+		{
+			name:          "wantSuccess",
+			QueryExecutor: &mockExecutorGetTypedAccountDatasets{schemaHeight: 4},
+			want: &model.AccountDatasetSchema{
+				SetterAccountAddress: accDatasetSetterAccount1,
+				Property:             "KycLevel",
+				ValueType:            model.AccountDatasetValueType_AccountDatasetValueInt,
+				MaxLength:            2,
+				BlockHeight:          4,
+				Latest:               true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ads := &AccountDatasetService{
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				QueryExecutor:             tt.QueryExecutor,
+			}
+			got, err := ads.GetAccountDatasetSchema(&model.GetAccountDatasetSchemaRequest{
+				SetterAccountAddress: accDatasetSetterAccount1,
+				Property:             "KycLevel",
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetAccountDatasetSchema() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetAccountDatasetSchema() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
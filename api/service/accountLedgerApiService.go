@@ -51,10 +51,16 @@ package service
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
+	"fmt"
 
+	"github.com/zoobc/zoobc-core/common/chaintype"
+	"github.com/zoobc/zoobc-core/common/constant"
 	"github.com/zoobc/zoobc-core/common/model"
 	"github.com/zoobc/zoobc-core/common/query"
+	commonUtils "github.com/zoobc/zoobc-core/common/util"
+	"github.com/zoobc/zoobc-core/observer"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -63,9 +69,17 @@ type (
 	// AccountLedgerServiceInterface interface that has account ledger api service methods collection
 	AccountLedgerServiceInterface interface {
 		GetAccountLedgers(request *model.GetAccountLedgersRequest) (*model.GetAccountLedgersResponse, error)
+		StreamAccountLedgerEvents(
+			ctx context.Context,
+			request *model.SubscribeAccountLedgersRequest,
+			send func(*model.AccountLedgerEvent) error,
+		) error
+		BlockPushedListener() observer.Listener
+		BlockRollbackListener() observer.Listener
 	}
 	// AccountLedgerService struct fields of AccountLedgerService
 	AccountLedgerService struct {
+		blockSubscribers
 		Query query.ExecutorInterface
 	}
 )
@@ -73,6 +87,9 @@ type (
 // NewAccountLedgerService create instance of AccountLedgerService
 func NewAccountLedgerService(executorInterface query.ExecutorInterface) *AccountLedgerService {
 	return &AccountLedgerService{
+		blockSubscribers: blockSubscribers{
+			subscribers: make(map[*blockSubscriber]bool),
+		},
 		Query: executorInterface,
 	}
 }
@@ -148,3 +165,156 @@ func (al *AccountLedgerService) GetAccountLedgers(
 	}
 	return response, nil
 }
+
+// StreamAccountLedgerEvents method of account ledger service that streams the ledgers of the subscribed accounts,
+// filtered by request.EventTypes, one event per block starting at request.FromHeight (or after the last block when it is 0).
+// Sent ledgers removed by a rollback are retracted in one event holding the height of the common block.
+// It returns when ctx is done or when send fails
+func (al *AccountLedgerService) StreamAccountLedgerEvents(
+	ctx context.Context,
+	request *model.SubscribeAccountLedgersRequest,
+	send func(*model.AccountLedgerEvent) error,
+) error {
+	var (
+		mainchain   = &chaintype.MainChain{}
+		blockQuery  = query.NewBlockQuery(mainchain)
+		sentLedgers []*model.AccountLedger
+		lastHeight  uint32
+	)
+	if len(request.GetAccountAddresses()) == 0 {
+		return status.Error(codes.InvalidArgument, "AccountAddresses is required")
+	}
+	if uint32(len(request.GetAccountAddresses())) > constant.MaxAPILimitPerPage {
+		return status.Error(codes.OutOfRange, fmt.Sprintf("too many AccountAddresses, max. %d", constant.MaxAPILimitPerPage))
+	}
+	// subscribe before reading the ledgers, so no push or rollback happening meanwhile is missed
+	subscriber := al.subscribe(mainchain.GetTypeInt())
+	defer al.unsubscribe(subscriber)
+
+	lastBlock, err := commonUtils.GetLastBlock(al.Query, blockQuery)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	lastHeight = lastBlock.GetHeight()
+	if request.GetFromHeight() > 0 {
+		lastHeight = request.GetFromHeight() - 1
+	}
+
+	for {
+		rollbackBlock := subscriber.popRollbackBlock()
+		if rollbackBlock != nil && rollbackBlock.GetHeight() < lastHeight {
+			var retractedLedgers, keptLedgers []*model.AccountLedger
+			for _, ledger := range sentLedgers {
+				if ledger.GetBlockHeight() > rollbackBlock.GetHeight() {
+					retractedLedgers = append(retractedLedgers, ledger)
+				} else {
+					keptLedgers = append(keptLedgers, ledger)
+				}
+			}
+			sentLedgers = keptLedgers
+			if len(retractedLedgers) > 0 {
+				err = send(&model.AccountLedgerEvent{
+					EventType:      model.AccountLedgerEventType_AccountLedgerEventRetracted,
+					BlockHeight:    rollbackBlock.GetHeight(),
+					AccountLedgers: retractedLedgers,
+				})
+				if err != nil {
+					return err
+				}
+			}
+			lastHeight = rollbackBlock.GetHeight()
+		}
+
+		lastBlock, err = commonUtils.GetLastBlock(al.Query, blockQuery)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		for lastHeight < lastBlock.GetHeight() {
+			toHeight := lastHeight + constant.MaxAPILimitPerPage
+			if toHeight > lastBlock.GetHeight() {
+				toHeight = lastBlock.GetHeight()
+			}
+			ledgers, err := al.getAccountLedgersByHeightRange(request, lastHeight+1, toHeight)
+			if err != nil {
+				return err
+			}
+			// ledgers are sorted by height, send one event per block
+			for start := 0; start < len(ledgers); {
+				end := start + 1
+				for end < len(ledgers) && ledgers[end].GetBlockHeight() == ledgers[start].GetBlockHeight() {
+					end++
+				}
+				err = send(&model.AccountLedgerEvent{
+					EventType:      model.AccountLedgerEventType_AccountLedgerEventAdded,
+					BlockHeight:    ledgers[start].GetBlockHeight(),
+					AccountLedgers: ledgers[start:end],
+				})
+				if err != nil {
+					return err
+				}
+				start = end
+			}
+			sentLedgers = append(sentLedgers, ledgers...)
+			lastHeight = toHeight
+		}
+
+		// ledgers below the minimum rollback height can no longer be retracted
+		minRollbackHeight := commonUtils.GetMinRollbackHeight(lastHeight)
+		for len(sentLedgers) > 0 && sentLedgers[0].GetBlockHeight() <= minRollbackHeight {
+			sentLedgers = sentLedgers[1:]
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-subscriber.wakeUp:
+		}
+	}
+}
+
+// getAccountLedgersByHeightRange return the ledgers of the subscribed accounts and event types between two heights, sorted by height
+func (al *AccountLedgerService) getAccountLedgersByHeightRange(
+	request *model.SubscribeAccountLedgersRequest,
+	fromHeight, toHeight uint32,
+) ([]*model.AccountLedger, error) {
+	var (
+		ledgers     []*model.AccountLedger
+		addresses   []interface{}
+		eventTypes  []interface{}
+		ledgerQuery = query.NewAccountLedgerQuery()
+		caseQuery   = query.CaseQuery{
+			Query: bytes.NewBuffer([]byte{}),
+		}
+	)
+	for _, address := range request.GetAccountAddresses() {
+		addresses = append(addresses, address)
+	}
+	for _, eventType := range request.GetEventTypes() {
+		if eventType == model.EventType_EventAny {
+			eventTypes = nil
+			break
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	caseQuery.Select(ledgerQuery.TableName, ledgerQuery.Fields...)
+	caseQuery.Where(caseQuery.In("account_address", addresses...))
+	caseQuery.Where(caseQuery.Between("block_height", fromHeight, toHeight))
+	if len(eventTypes) > 0 {
+		caseQuery.Where(caseQuery.In("event_type", eventTypes...))
+	}
+	caseQuery.OrderBy("block_height", model.OrderBy_ASC)
+
+	selectQuery, args := caseQuery.Build()
+	rows, err := al.Query.ExecuteSelect(selectQuery, false, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+
+	ledgers, err = ledgerQuery.BuildModel(ledgers, rows)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return ledgers, nil
+}
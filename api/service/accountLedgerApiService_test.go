@@ -50,12 +50,15 @@
 package service
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"reflect"
 	"regexp"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/zoobc/zoobc-core/common/chaintype"
 	"github.com/zoobc/zoobc-core/common/model"
 	"github.com/zoobc/zoobc-core/common/query"
 )
@@ -154,3 +157,179 @@ func TestAccountLedgerService_GetAccountLedgers(t *testing.T) {
 		})
 	}
 }
+
+type (
+	mockQueryStreamAccountLedgers struct {
+		query.Executor
+		lastHeights []uint32
+		ledgers     []*model.AccountLedger
+	}
+)
+
+func (m *mockQueryStreamAccountLedgers) ExecuteSelectRow(qStr string, tx bool, args ...interface{}) (*sql.Row, error) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	lastHeight := m.lastHeights[0]
+	if len(m.lastHeights) > 1 {
+		m.lastHeights = m.lastHeights[1:]
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(qStr)).
+		WillReturnRows(sqlmock.NewRows(query.NewBlockQuery(&chaintype.MainChain{}).Fields).AddRow(
+			lastHeight,
+			mockGoodBlock.GetID(),
+			mockGoodBlock.GetBlockHash(),
+			mockGoodBlock.GetPreviousBlockHash(),
+			mockGoodBlock.GetTimestamp(),
+			mockGoodBlock.GetBlockSeed(),
+			mockGoodBlock.GetBlockSignature(),
+			mockGoodBlock.GetCumulativeDifficulty(),
+			mockGoodBlock.GetPayloadLength(),
+			mockGoodBlock.GetPayloadHash(),
+			mockGoodBlock.GetBlocksmithPublicKey(),
+			mockGoodBlock.GetTotalAmount(),
+			mockGoodBlock.GetTotalFee(),
+			mockGoodBlock.GetTotalCoinBase(),
+			mockGoodBlock.GetVersion(),
+			mockGoodBlock.GetMerkleRoot(),
+			mockGoodBlock.GetMerkleTree(),
+			mockGoodBlock.GetReferenceBlockHeight(),
+		))
+	return db.QueryRow(qStr), nil
+}
+
+func (m *mockQueryStreamAccountLedgers) ExecuteSelect(qStr string, tx bool, args ...interface{}) (*sql.Rows, error) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	// args hold the single subscribed address followed by the block height range
+	rowsMock := sqlmock.NewRows(mockAccountLedgerQuery.Fields)
+	for _, ledger := range m.ledgers {
+		if ledger.GetBlockHeight() >= args[1].(uint32) && ledger.GetBlockHeight() <= args[2].(uint32) {
+			rowsMock.AddRow(
+				ledger.GetAccountAddress(),
+				ledger.GetBalanceChange(),
+				ledger.GetBlockHeight(),
+				ledger.GetTransactionID(),
+				ledger.GetEventType(),
+				ledger.GetTimestamp(),
+			)
+		}
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(rowsMock)
+	return db.Query(qStr)
+}
+
+func TestAccountLedgerService_StreamAccountLedgerEvents(t *testing.T) {
+	var (
+		mockLedgers = []*model.AccountLedger{
+			{AccountAddress: mockAccountLedger.GetAccountAddress(), BalanceChange: 10, BlockHeight: 3, TransactionID: 1},
+			{AccountAddress: mockAccountLedger.GetAccountAddress(), BalanceChange: 20, BlockHeight: 3, TransactionID: 2},
+			{AccountAddress: mockAccountLedger.GetAccountAddress(), BalanceChange: 30, BlockHeight: 4, TransactionID: 3},
+		}
+		mockRequest = &model.SubscribeAccountLedgersRequest{
+			AccountAddresses: [][]byte{mockAccountLedger.GetAccountAddress()},
+		}
+	)
+	type wantEvent struct {
+		eventType   model.AccountLedgerEventType
+		blockHeight uint32
+		ledgers     int
+	}
+	tests := []struct {
+		name             string
+		executor         *mockQueryStreamAccountLedgers
+		request          *model.SubscribeAccountLedgersRequest
+		rollbackOnHeight uint32
+		sendErr          error
+		wantEvents       []wantEvent
+		wantErr          bool
+	}{
+		{
+			name:     "StreamAccountLedgerEvents:NoAccountAddresses",
+			executor: &mockQueryStreamAccountLedgers{lastHeights: []uint32{2}},
+			request:  &model.SubscribeAccountLedgersRequest{},
+			wantErr:  true,
+		},
+		{
+			name:     "StreamAccountLedgerEvents:NewLedgers",
+			executor: &mockQueryStreamAccountLedgers{lastHeights: []uint32{2, 4}, ledgers: mockLedgers},
+			request:  mockRequest,
+			wantEvents: []wantEvent{
+				{model.AccountLedgerEventType_AccountLedgerEventAdded, 3, 2},
+				{model.AccountLedgerEventType_AccountLedgerEventAdded, 4, 1},
+			},
+		},
+		{
+			name:     "StreamAccountLedgerEvents:FromHeight",
+			executor: &mockQueryStreamAccountLedgers{lastHeights: []uint32{4}, ledgers: mockLedgers},
+			request: &model.SubscribeAccountLedgersRequest{
+				AccountAddresses: mockRequest.GetAccountAddresses(),
+				FromHeight:       4,
+			},
+			wantEvents: []wantEvent{
+				{model.AccountLedgerEventType_AccountLedgerEventAdded, 4, 1},
+			},
+		},
+		{
+			name:             "StreamAccountLedgerEvents:Rollback",
+			executor:         &mockQueryStreamAccountLedgers{lastHeights: []uint32{2, 4}, ledgers: mockLedgers},
+			request:          mockRequest,
+			rollbackOnHeight: 4,
+			wantEvents: []wantEvent{
+				{model.AccountLedgerEventType_AccountLedgerEventAdded, 3, 2},
+				{model.AccountLedgerEventType_AccountLedgerEventAdded, 4, 1},
+				{model.AccountLedgerEventType_AccountLedgerEventRetracted, 3, 1},
+				{model.AccountLedgerEventType_AccountLedgerEventAdded, 4, 1},
+			},
+		},
+		{
+			name:     "StreamAccountLedgerEvents:SendFail",
+			executor: &mockQueryStreamAccountLedgers{lastHeights: []uint32{2, 4}, ledgers: mockLedgers},
+			request:  mockRequest,
+			sendErr:  errors.New("mockedError"),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				events      []*model.AccountLedgerEvent
+				ctx, cancel = context.WithCancel(context.Background())
+				al          = NewAccountLedgerService(tt.executor)
+			)
+			defer cancel()
+			send := func(event *model.AccountLedgerEvent) error {
+				if tt.sendErr != nil {
+					return tt.sendErr
+				}
+				events = append(events, event)
+				if event.GetBlockHeight() == tt.rollbackOnHeight && len(events) == 2 {
+					al.BlockRollbackListener().OnNotify(&model.Block{Height: 3}, &chaintype.MainChain{})
+					return nil
+				}
+				if len(events) == len(tt.wantEvents) {
+					cancel()
+				}
+				return nil
+			}
+			if len(tt.wantEvents) == 0 {
+				cancel()
+			}
+			err := al.StreamAccountLedgerEvents(ctx, tt.request, send)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StreamAccountLedgerEvents() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(events) != len(tt.wantEvents) {
+				t.Fatalf("StreamAccountLedgerEvents() sent %d events, want %d", len(events), len(tt.wantEvents))
+			}
+			for i, event := range events {
+				if event.GetEventType() != tt.wantEvents[i].eventType || event.GetBlockHeight() != tt.wantEvents[i].blockHeight ||
+					len(event.GetAccountLedgers()) != tt.wantEvents[i].ledgers {
+					t.Errorf("StreamAccountLedgerEvents() event %d = %v, want %v", i, event, tt.wantEvents[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package service
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/zoobc/zoobc-core/common/chaintype"
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/model"
+	coreService "github.com/zoobc/zoobc-core/core/service"
+	"github.com/zoobc/zoobc-core/observer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	// BlockSubscriptionServiceInterface represents interface for BlockSubscriptionService
+	BlockSubscriptionServiceInterface interface {
+		StreamBlockEvents(ctx context.Context, request *model.SubscribeBlocksRequest, send func(*model.BlockEvent) error) error
+		BlockPushedListener() observer.Listener
+		BlockRollbackListener() observer.Listener
+	}
+
+	// BlockSubscriptionService represents struct of BlockSubscriptionService
+	BlockSubscriptionService struct {
+		blockSubscribers
+		BlockCoreServices map[int32]coreService.BlockServiceInterface
+	}
+
+	// blockSubscribers keeps the subscriptions to be woken up when a block is pushed or rolled back,
+	// embedded by the streaming services driven by the chain state
+	blockSubscribers struct {
+		sync.RWMutex
+		subscribers map[*blockSubscriber]bool
+	}
+
+	// blockSubscriber holds the pending notifications of a single subscription
+	blockSubscriber struct {
+		sync.Mutex
+		chainType     int32
+		wakeUp        chan struct{}
+		rollbackBlock *model.Block
+	}
+)
+
+// NewBlockSubscriptionService create a new instance of BlockSubscriptionService
+func NewBlockSubscriptionService(blockCoreServices map[int32]coreService.BlockServiceInterface) *BlockSubscriptionService {
+	return &BlockSubscriptionService{
+		blockSubscribers: blockSubscribers{
+			subscribers: make(map[*blockSubscriber]bool),
+		},
+		BlockCoreServices: blockCoreServices,
+	}
+}
+
+// StreamBlockEvents send every block persisted from request.FromHeight (or after the current last block when it is 0)
+// and every rollback of already sent blocks, until ctx is done or send fails.
+// Blocks are always read back from the database, so a pushed block following a rollback is sent again
+func (bss *BlockSubscriptionService) StreamBlockEvents(
+	ctx context.Context,
+	request *model.SubscribeBlocksRequest,
+	send func(*model.BlockEvent) error,
+) error {
+	var (
+		lastHeight uint32
+		lastHash   []byte
+		err        error
+	)
+	blockCoreService, ok := bss.BlockCoreServices[request.GetChainType()]
+	if !ok {
+		return status.Error(codes.InvalidArgument, "invalid chain type")
+	}
+	// subscribe before reading the chain, so no push or rollback happening meanwhile is missed
+	subscriber := bss.subscribe(request.GetChainType())
+	defer bss.unsubscribe(subscriber)
+
+	if request.GetFromHeight() > 0 {
+		lastHeight = request.GetFromHeight() - 1
+	} else {
+		lastBlock, err := blockCoreService.GetLastBlock()
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		lastHeight, lastHash = lastBlock.GetHeight(), lastBlock.GetBlockHash()
+	}
+
+	for {
+		rollbackBlock := subscriber.popRollbackBlock()
+		// only rollbacks removing blocks already sent are relevant to the subscriber
+		if rollbackBlock != nil && rollbackBlock.GetHeight() < lastHeight {
+			err = send(&model.BlockEvent{
+				EventType: model.BlockEventType_BlockEventRollback,
+				ChainType: request.GetChainType(),
+				Block:     rollbackBlock,
+			})
+			if err != nil {
+				return err
+			}
+			lastHeight, lastHash = rollbackBlock.GetHeight(), rollbackBlock.GetBlockHash()
+		}
+		lastHeight, lastHash, err = bss.sendBlocksAfterHeight(blockCoreService, request, lastHeight, lastHash, send)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-subscriber.wakeUp:
+		}
+	}
+}
+
+// sendBlocksAfterHeight send the persisted blocks above lastHeight and return the height and hash of the last sent block.
+// It stops at the first block not extending the last sent one, the rollback notification that follows will realign the subscriber
+func (bss *BlockSubscriptionService) sendBlocksAfterHeight(
+	blockCoreService coreService.BlockServiceInterface,
+	request *model.SubscribeBlocksRequest,
+	lastHeight uint32,
+	lastHash []byte,
+	send func(*model.BlockEvent) error,
+) (uint32, []byte, error) {
+	for {
+		blocks, err := blockCoreService.GetBlocksFromHeight(lastHeight+1, constant.MaxAPILimitPerPage, request.GetIncludeTransactions())
+		if err != nil {
+			return lastHeight, lastHash, status.Error(codes.Internal, err.Error())
+		}
+		for _, block := range blocks {
+			if lastHash != nil && !bytes.Equal(block.GetPreviousBlockHash(), lastHash) {
+				return lastHeight, lastHash, nil
+			}
+			event := &model.BlockEvent{
+				EventType: model.BlockEventType_BlockEventPushed,
+				ChainType: request.GetChainType(),
+				Block:     block,
+			}
+			if request.GetIncludeTransactions() {
+				event.Transactions = block.GetTransactions()
+				block.Transactions = nil
+			}
+			err = send(event)
+			if err != nil {
+				return lastHeight, lastHash, err
+			}
+			lastHeight, lastHash = block.GetHeight(), block.GetBlockHash()
+		}
+		if uint32(len(blocks)) < constant.MaxAPILimitPerPage {
+			return lastHeight, lastHash, nil
+		}
+	}
+}
+
+// BlockPushedListener wake up the subscribers of the chain a block has been pushed to
+func (bs *blockSubscribers) BlockPushedListener() observer.Listener {
+	return observer.Listener{
+		OnNotify: func(block interface{}, args ...interface{}) {
+			chainType, ok := args[0].(chaintype.ChainType)
+			if !ok {
+				return
+			}
+			bs.notifySubscribers(chainType.GetTypeInt(), nil)
+		},
+	}
+}
+
+// BlockRollbackListener hand the common block of a rollback to the subscribers of the rolled back chain
+func (bs *blockSubscribers) BlockRollbackListener() observer.Listener {
+	return observer.Listener{
+		OnNotify: func(block interface{}, args ...interface{}) {
+			commonBlock, ok := block.(*model.Block)
+			if !ok {
+				return
+			}
+			chainType, ok := args[0].(chaintype.ChainType)
+			if !ok {
+				return
+			}
+			bs.notifySubscribers(chainType.GetTypeInt(), commonBlock)
+		},
+	}
+}
+
+func (bs *blockSubscribers) subscribe(chainType int32) *blockSubscriber {
+	bs.Lock()
+	defer bs.Unlock()
+	subscriber := &blockSubscriber{
+		chainType: chainType,
+		wakeUp:    make(chan struct{}, 1),
+	}
+	if bs.subscribers == nil {
+		bs.subscribers = make(map[*blockSubscriber]bool)
+	}
+	bs.subscribers[subscriber] = true
+	return subscriber
+}
+
+func (bs *blockSubscribers) unsubscribe(subscriber *blockSubscriber) {
+	bs.Lock()
+	defer bs.Unlock()
+	delete(bs.subscribers, subscriber)
+}
+
+func (bs *blockSubscribers) notifySubscribers(chainType int32, rollbackBlock *model.Block) {
+	bs.RLock()
+	defer bs.RUnlock()
+	for subscriber := range bs.subscribers {
+		if subscriber.chainType != chainType {
+			continue
+		}
+		if rollbackBlock != nil {
+			subscriber.setRollbackBlock(rollbackBlock)
+		}
+		select {
+		case subscriber.wakeUp <- struct{}{}:
+		default:
+			// subscriber already has a pending wake up
+		}
+	}
+}
+
+// setRollbackBlock keep the lowest common block among the rollbacks not yet sent
+func (sub *blockSubscriber) setRollbackBlock(commonBlock *model.Block) {
+	sub.Lock()
+	defer sub.Unlock()
+	if sub.rollbackBlock == nil || commonBlock.GetHeight() < sub.rollbackBlock.GetHeight() {
+		sub.rollbackBlock = commonBlock
+	}
+}
+
+func (sub *blockSubscriber) popRollbackBlock() *model.Block {
+	sub.Lock()
+	defer sub.Unlock()
+	commonBlock := sub.rollbackBlock
+	sub.rollbackBlock = nil
+	return commonBlock
+}
@@ -0,0 +1,299 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zoobc/zoobc-core/common/chaintype"
+	"github.com/zoobc/zoobc-core/common/model"
+	coreService "github.com/zoobc/zoobc-core/core/service"
+)
+
+type (
+	mockBlockSubscriptionCoreService struct {
+		coreService.BlockServiceInterface
+		blocks map[uint32][]*model.Block
+	}
+	mockBlockSubscriptionCoreServiceFail struct {
+		coreService.BlockServiceInterface
+	}
+)
+
+var (
+	mockBlockSubscriptionLastBlock = &model.Block{
+		ID:        1,
+		Height:    1,
+		BlockHash: []byte{1},
+	}
+	mockBlockSubscriptionBlocks = []*model.Block{
+		{
+			ID:                2,
+			Height:            2,
+			BlockHash:         []byte{2},
+			PreviousBlockHash: []byte{1},
+			Transactions:      []*model.Transaction{{ID: 1}},
+		},
+		{
+			ID:                3,
+			Height:            3,
+			BlockHash:         []byte{3},
+			PreviousBlockHash: []byte{2},
+		},
+	}
+)
+
+func (*mockBlockSubscriptionCoreService) GetLastBlock() (*model.Block, error) {
+	return mockBlockSubscriptionLastBlock, nil
+}
+
+func (m *mockBlockSubscriptionCoreService) GetBlocksFromHeight(startHeight, limit uint32, withAttachedData bool) ([]*model.Block, error) {
+	var blocks []*model.Block
+	for _, block := range m.blocks[startHeight] {
+		b := *block
+		if !withAttachedData {
+			b.Transactions = nil
+		}
+		blocks = append(blocks, &b)
+	}
+	return blocks, nil
+}
+
+func (*mockBlockSubscriptionCoreServiceFail) GetLastBlock() (*model.Block, error) {
+	return nil, errors.New("mockedError")
+}
+
+func TestNewBlockSubscriptionService(t *testing.T) {
+	blockCoreServices := map[int32]coreService.BlockServiceInterface{
+		0: &mockBlockSubscriptionCoreService{},
+	}
+	want := &BlockSubscriptionService{
+		blockSubscribers: blockSubscribers{
+			subscribers: make(map[*blockSubscriber]bool),
+		},
+		BlockCoreServices: blockCoreServices,
+	}
+	if got := NewBlockSubscriptionService(blockCoreServices); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewBlockSubscriptionService() = %v, want %v", got, want)
+	}
+}
+
+func TestBlockSubscriptionService_StreamBlockEvents(t *testing.T) {
+	type args struct {
+		request *model.SubscribeBlocksRequest
+	}
+	tests := []struct {
+		name             string
+		blockCoreService coreService.BlockServiceInterface
+		args             args
+		rollbackOnHeight uint32
+		sendErr          error
+		wantEventTypes   []model.BlockEventType
+		wantHeights      []uint32
+		wantTransactions []int
+		wantErr          bool
+	}{
+		{
+			name:             "StreamBlockEvents:InvalidChainType",
+			blockCoreService: &mockBlockSubscriptionCoreService{},
+			args: args{
+				request: &model.SubscribeBlocksRequest{ChainType: 5},
+			},
+			wantErr: true,
+		},
+		{
+			name:             "StreamBlockEvents:GetLastBlockFail",
+			blockCoreService: &mockBlockSubscriptionCoreServiceFail{},
+			args: args{
+				request: &model.SubscribeBlocksRequest{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "StreamBlockEvents:NewBlocks",
+			blockCoreService: &mockBlockSubscriptionCoreService{
+				blocks: map[uint32][]*model.Block{2: mockBlockSubscriptionBlocks},
+			},
+			args: args{
+				request: &model.SubscribeBlocksRequest{IncludeTransactions: true},
+			},
+			wantEventTypes:   []model.BlockEventType{model.BlockEventType_BlockEventPushed, model.BlockEventType_BlockEventPushed},
+			wantHeights:      []uint32{2, 3},
+			wantTransactions: []int{1, 0},
+		},
+		{
+			name: "StreamBlockEvents:FromHeight",
+			blockCoreService: &mockBlockSubscriptionCoreService{
+				blocks: map[uint32][]*model.Block{3: mockBlockSubscriptionBlocks[1:]},
+			},
+			args: args{
+				request: &model.SubscribeBlocksRequest{FromHeight: 3},
+			},
+			wantEventTypes:   []model.BlockEventType{model.BlockEventType_BlockEventPushed},
+			wantHeights:      []uint32{3},
+			wantTransactions: []int{0},
+		},
+		{
+			name: "StreamBlockEvents:ForkedBlockNotSent",
+			blockCoreService: &mockBlockSubscriptionCoreService{
+				blocks: map[uint32][]*model.Block{2: mockBlockSubscriptionBlocks[1:]},
+			},
+			args: args{
+				request: &model.SubscribeBlocksRequest{},
+			},
+		},
+		{
+			name: "StreamBlockEvents:Rollback",
+			blockCoreService: &mockBlockSubscriptionCoreService{
+				blocks: map[uint32][]*model.Block{2: mockBlockSubscriptionBlocks},
+			},
+			args: args{
+				request: &model.SubscribeBlocksRequest{},
+			},
+			rollbackOnHeight: 3,
+			wantEventTypes: []model.BlockEventType{
+				model.BlockEventType_BlockEventPushed,
+				model.BlockEventType_BlockEventPushed,
+				model.BlockEventType_BlockEventRollback,
+				model.BlockEventType_BlockEventPushed,
+				model.BlockEventType_BlockEventPushed,
+			},
+			wantHeights:      []uint32{2, 3, 1, 2, 3},
+			wantTransactions: []int{0, 0, 0, 0, 0},
+		},
+		{
+			name: "StreamBlockEvents:SendFail",
+			blockCoreService: &mockBlockSubscriptionCoreService{
+				blocks: map[uint32][]*model.Block{2: mockBlockSubscriptionBlocks},
+			},
+			args: args{
+				request: &model.SubscribeBlocksRequest{},
+			},
+			sendErr: errors.New("mockedError"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				events      []*model.BlockEvent
+				ctx, cancel = context.WithCancel(context.Background())
+				bss         = NewBlockSubscriptionService(map[int32]coreService.BlockServiceInterface{
+					0: tt.blockCoreService,
+				})
+			)
+			defer cancel()
+			send := func(event *model.BlockEvent) error {
+				if tt.sendErr != nil {
+					return tt.sendErr
+				}
+				events = append(events, event)
+				if event.GetEventType() == model.BlockEventType_BlockEventPushed &&
+					event.GetBlock().GetHeight() == tt.rollbackOnHeight && len(events) == 2 {
+					bss.BlockRollbackListener().OnNotify(mockBlockSubscriptionLastBlock, &chaintype.MainChain{})
+					return nil
+				}
+				if len(events) == len(tt.wantEventTypes) {
+					cancel()
+				}
+				return nil
+			}
+			if len(tt.wantEventTypes) == 0 {
+				cancel()
+			}
+			err := bss.StreamBlockEvents(ctx, tt.args.request, send)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StreamBlockEvents() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(events) != len(tt.wantEventTypes) {
+				t.Fatalf("StreamBlockEvents() sent %d events, want %d", len(events), len(tt.wantEventTypes))
+			}
+			for i, event := range events {
+				if event.GetEventType() != tt.wantEventTypes[i] || event.GetBlock().GetHeight() != tt.wantHeights[i] ||
+					len(event.GetTransactions()) != tt.wantTransactions[i] || len(event.GetBlock().GetTransactions()) != 0 {
+					t.Errorf("StreamBlockEvents() event %d = %v", i, event)
+				}
+			}
+			if len(bss.subscribers) != 0 {
+				t.Errorf("StreamBlockEvents() subscriber not removed")
+			}
+		})
+	}
+}
+
+func TestBlockSubscribers_notifySubscribers(t *testing.T) {
+	var (
+		bss       = &blockSubscribers{}
+		mainSub   = bss.subscribe(0)
+		spineSub  = bss.subscribe(1)
+		lowBlock  = &model.Block{Height: 5}
+		highBlock = &model.Block{Height: 10}
+	)
+	bss.BlockRollbackListener().OnNotify(lowBlock, &chaintype.MainChain{})
+	bss.BlockRollbackListener().OnNotify(highBlock, &chaintype.MainChain{})
+	bss.BlockPushedListener().OnNotify(highBlock, &chaintype.MainChain{})
+
+	if got := mainSub.popRollbackBlock(); got != lowBlock {
+		t.Errorf("notifySubscribers() rollback block = %v, want %v", got, lowBlock)
+	}
+	if got := mainSub.popRollbackBlock(); got != nil {
+		t.Errorf("popRollbackBlock() = %v, want nil", got)
+	}
+	if len(mainSub.wakeUp) != 1 {
+		t.Errorf("notifySubscribers() main subscriber not woken up")
+	}
+	if len(spineSub.wakeUp) != 0 || spineSub.popRollbackBlock() != nil {
+		t.Errorf("notifySubscribers() notified subscriber of another chain")
+	}
+}
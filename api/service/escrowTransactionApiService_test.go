@@ -101,6 +101,11 @@ func (*mockQueryExecutorGetEscrowTransactionsSuccess) ExecuteSelect(qStr string,
 		uint32(0),
 		true,
 		"",
+		nil,
+		uint32(0),
+		nil,
+		nil,
+		int64(0),
 	)
 
 	mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(mockedRows)
@@ -204,6 +209,11 @@ func (*mockExecutorGetEscrow) ExecuteSelectRow(string, bool, ...interface{}) (*s
 		uint32(0),
 		true,
 		"",
+		nil,
+		uint32(0),
+		nil,
+		nil,
+		int64(0),
 	)
 	mock.ExpectQuery("").WillReturnRows(mockedRow)
 	row := db.QueryRow("")
@@ -0,0 +1,231 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package service
+
+import (
+	"bytes"
+	"database/sql"
+	"time"
+
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/fee"
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+	coreService "github.com/zoobc/zoobc-core/core/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	// FeeVoteServiceInterface interface that contain methods of the fee vote results and phases
+	FeeVoteServiceInterface interface {
+		GetFeeVoteStatus(request *model.GetFeeVoteStatusRequest) (*model.GetFeeVoteStatusResponse, error)
+		GetFeeScales(request *model.GetFeeScalesRequest) (*model.GetFeeScalesResponse, error)
+	}
+	// FeeVoteService struct that contain fields that needed
+	FeeVoteService struct {
+		QueryExecutor              query.ExecutorInterface
+		FeeScaleService            fee.FeeScaleServiceInterface
+		BlockService               coreService.BlockServiceInterface
+		FeeScaleQuery              *query.FeeScaleQuery
+		FeeVoteCommitmentVoteQuery query.FeeVoteCommitmentVoteQueryInterface
+		FeeVoteRevealVoteQuery     query.FeeVoteRevealVoteQueryInterface
+	}
+)
+
+// NewFeeVoteService will create FeeVoteServiceInterface instance, feeScaleService has to be the instance
+// used by the block processing to share its cached fee scale
+func NewFeeVoteService(
+	queryExecutor query.ExecutorInterface,
+	feeScaleService fee.FeeScaleServiceInterface,
+	blockService coreService.BlockServiceInterface,
+	feeScaleQuery *query.FeeScaleQuery,
+	feeVoteCommitmentVoteQuery query.FeeVoteCommitmentVoteQueryInterface,
+	feeVoteRevealVoteQuery query.FeeVoteRevealVoteQueryInterface,
+) FeeVoteServiceInterface {
+	return &FeeVoteService{
+		QueryExecutor:              queryExecutor,
+		FeeScaleService:            feeScaleService,
+		BlockService:               blockService,
+		FeeScaleQuery:              feeScaleQuery,
+		FeeVoteCommitmentVoteQuery: feeVoteCommitmentVoteQuery,
+		FeeVoteRevealVoteQuery:     feeVoteRevealVoteQuery,
+	}
+}
+
+// GetFeeVoteStatus to get the phase of the fee vote, the votes counted since the last fee scale adjustment
+// and the fee scale they would select
+func (fvs *FeeVoteService) GetFeeVoteStatus(*model.GetFeeVoteStatusRequest) (*model.GetFeeVoteStatusResponse, error) {
+	var (
+		latestFeeScale   model.FeeScale
+		reveals          []*model.FeeVoteRevealVote
+		voteInfos        []*model.FeeVoteInfo
+		commitmentsCount uint32
+		rows             *sql.Rows
+		row              *sql.Row
+		err              error
+		now              = time.Now().Unix()
+	)
+	// same as the post transaction validation, the phase is relative to the node's current time
+	phase, canAdjust, err := fvs.FeeScaleService.GetCurrentPhase(now, true)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	err = fvs.FeeScaleService.GetLatestFeeScale(&latestFeeScale)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	lastBlock, err := fvs.BlockService.GetLastBlock()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	commitsQuery, args := fvs.FeeVoteCommitmentVoteQuery.GetVoteCommitsInPeriod(latestFeeScale.GetBlockHeight(), lastBlock.GetHeight())
+	row, err = fvs.QueryExecutor.ExecuteSelectRow(query.GetTotalRecordOfSelect(commitsQuery), false, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	err = row.Scan(&commitmentsCount)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	revealsQuery, args := fvs.FeeVoteRevealVoteQuery.GetFeeVoteRevealsInPeriod(latestFeeScale.GetBlockHeight(), lastBlock.GetHeight())
+	rows, err = fvs.QueryExecutor.ExecuteSelect(revealsQuery, false, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+	reveals, err = fvs.FeeVoteRevealVoteQuery.BuildModel(reveals, rows)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, reveal := range reveals {
+		voteInfos = append(voteInfos, reveal.GetVoteInfo())
+	}
+
+	return &model.GetFeeVoteStatusResponse{
+		Phase:             phase,
+		AdjustmentPending: canAdjust,
+		FromHeight:        latestFeeScale.GetBlockHeight(),
+		ToHeight:          lastBlock.GetHeight(),
+		PhaseEndTimestamp: getFeeVotePhaseEndTimestamp(now, phase),
+		CommitmentsCount:  commitmentsCount,
+		RevealsCount:      uint32(len(reveals)),
+		CurrentFeeScale:   &latestFeeScale,
+		ProjectedFeeScale: fvs.FeeScaleService.SelectVote(voteInfos, fee.SendZBCFeeConstant),
+	}, nil
+}
+
+// GetFeeScales to get the fee scales agreed by the past fee votes within the requested block height range
+func (fvs *FeeVoteService) GetFeeScales(request *model.GetFeeScalesRequest) (*model.GetFeeScalesResponse, error) {
+	var (
+		feeScales []*model.FeeScale
+		rows      *sql.Rows
+		count     uint64
+		row       *sql.Row
+		err       error
+	)
+
+	caseQuery := query.CaseQuery{
+		Query: bytes.NewBuffer([]byte{}),
+	}
+	caseQuery.Select(fvs.FeeScaleQuery.TableName, fvs.FeeScaleQuery.Fields...)
+	caseQuery.Where(caseQuery.GreaterEqual("block_height", request.GetFromHeight()))
+	if request.GetToHeight() > 0 {
+		caseQuery.And(caseQuery.LessEqual("block_height", request.GetToHeight()))
+	}
+
+	// count first
+	selectQuery, args := caseQuery.Build()
+	row, err = fvs.QueryExecutor.ExecuteSelectRow(query.GetTotalRecordOfSelect(selectQuery), false, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	err = row.Scan(&count)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// select records
+	page := request.GetPagination()
+	if page.GetOrderField() == "" {
+		caseQuery.OrderBy("block_height", page.GetOrderBy())
+	} else {
+		caseQuery.OrderBy(page.GetOrderField(), page.GetOrderBy())
+	}
+	caseQuery.Paginate(page.GetLimit(), page.GetPage())
+
+	selectQuery, args = caseQuery.Build()
+	rows, err = fvs.QueryExecutor.ExecuteSelect(selectQuery, false, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+
+	feeScales, err = fvs.FeeScaleQuery.BuildModel(feeScales, rows)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &model.GetFeeScalesResponse{
+		Total:     count,
+		FeeScales: feeScales,
+	}, nil
+}
+
+// getFeeVotePhaseEndTimestamp return the last second of the phase running at timestamp, the commit phase ending
+// with the CommitPhaseEndDay of the month and the reveal phase with the month
+func getFeeVotePhaseEndTimestamp(timestamp int64, phase model.FeeVotePhase) int64 {
+	year, month, _ := time.Unix(timestamp, 0).UTC().Date()
+	if phase == model.FeeVotePhase_FeeVotePhaseCommmit {
+		return time.Date(year, month, constant.CommitPhaseEndDay+1, 0, 0, 0, 0, time.UTC).Unix() - 1
+	}
+	return time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC).Unix() - 1
+}
@@ -0,0 +1,331 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/fee"
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+	coreService "github.com/zoobc/zoobc-core/core/service"
+)
+
+type (
+	mockFeeVoteFeeScaleServiceSuccess struct {
+		fee.FeeScaleServiceInterface
+	}
+	mockFeeVoteFeeScaleServiceFail struct {
+		fee.FeeScaleServiceInterface
+	}
+	mockFeeVoteBlockServiceSuccess struct {
+		coreService.BlockServiceInterface
+	}
+	mockFeeVoteBlockServiceFail struct {
+		coreService.BlockServiceInterface
+	}
+	mockQueryGetFeeVoteFail struct {
+		query.Executor
+	}
+	mockQueryGetFeeVoteSuccess struct {
+		query.Executor
+	}
+)
+
+var (
+	mockFeeScaleAPI = &model.FeeScale{
+		FeeScale:    constant.OneZBC,
+		BlockHeight: 5,
+		Latest:      true,
+	}
+	mockFeeVoteRevealAPI = &model.FeeVoteRevealVote{
+		VoteInfo: &model.FeeVoteInfo{
+			RecentBlockHash:   make([]byte, 32),
+			RecentBlockHeight: 6,
+			FeeVote:           fee.SendZBCFeeConstant * 2,
+		},
+		VoterSignature: []byte{1, 2, 3},
+		VoterAddress:   []byte{0, 1, 2, 3},
+		BlockHeight:    8,
+	}
+)
+
+func (*mockFeeVoteFeeScaleServiceSuccess) GetCurrentPhase(int64, bool) (model.FeeVotePhase, bool, error) {
+	return model.FeeVotePhase_FeeVotePhaseReveal, false, nil
+}
+
+func (*mockFeeVoteFeeScaleServiceSuccess) GetLatestFeeScale(feeScale *model.FeeScale) error {
+	*feeScale = *mockFeeScaleAPI
+	return nil
+}
+
+func (*mockFeeVoteFeeScaleServiceSuccess) SelectVote(votes []*model.FeeVoteInfo, originalSendZBCFee int64) int64 {
+	if len(votes) == 0 {
+		return mockFeeScaleAPI.GetFeeScale()
+	}
+	return votes[0].GetFeeVote() / originalSendZBCFee * constant.OneZBC
+}
+
+func (*mockFeeVoteFeeScaleServiceFail) GetCurrentPhase(int64, bool) (model.FeeVotePhase, bool, error) {
+	return model.FeeVotePhase_FeeVotePhaseCommmit, false, errors.New("want error")
+}
+
+func (*mockFeeVoteBlockServiceSuccess) GetLastBlock() (*model.Block, error) {
+	return &model.Block{Height: 10}, nil
+}
+
+func (*mockFeeVoteBlockServiceFail) GetLastBlock() (*model.Block, error) {
+	return nil, errors.New("want error")
+}
+
+func (*mockQueryGetFeeVoteFail) ExecuteSelect(string, bool, ...interface{}) (*sql.Rows, error) {
+	return nil, errors.New("want error")
+}
+
+func (*mockQueryGetFeeVoteFail) ExecuteSelectRow(string, bool, ...interface{}) (*sql.Row, error) {
+	return nil, errors.New("want error")
+}
+
+func (*mockQueryGetFeeVoteSuccess) ExecuteSelect(qStr string, tx bool, args ...interface{}) (*sql.Rows, error) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	if strings.Contains(qStr, query.NewFeeVoteRevealVoteQuery().TableName) {
+		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(
+			sqlmock.NewRows(query.NewFeeVoteRevealVoteQuery().Fields).AddRow(
+				mockFeeVoteRevealAPI.GetVoteInfo().GetRecentBlockHash(),
+				mockFeeVoteRevealAPI.GetVoteInfo().GetRecentBlockHeight(),
+				mockFeeVoteRevealAPI.GetVoteInfo().GetFeeVote(),
+				mockFeeVoteRevealAPI.GetVoterAddress(),
+				mockFeeVoteRevealAPI.GetVoterSignature(),
+				mockFeeVoteRevealAPI.GetBlockHeight(),
+			),
+		)
+	} else {
+		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(
+			sqlmock.NewRows(query.NewFeeScaleQuery().Fields).AddRow(
+				mockFeeScaleAPI.GetFeeScale(),
+				mockFeeScaleAPI.GetBlockHeight(),
+				mockFeeScaleAPI.GetLatest(),
+			),
+		)
+	}
+	return db.Query(qStr)
+}
+
+func (*mockQueryGetFeeVoteSuccess) ExecuteSelectRow(qStr string, tx bool, args ...interface{}) (*sql.Row, error) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(sqlmock.NewRows([]string{"total_record"}).AddRow(1))
+	return db.QueryRow(qStr), nil
+}
+
+func TestFeeVoteService_GetFeeVoteStatus(t *testing.T) {
+	tests := []struct {
+		name            string
+		queryExecutor   query.ExecutorInterface
+		feeScaleService fee.FeeScaleServiceInterface
+		blockService    coreService.BlockServiceInterface
+		want            *model.GetFeeVoteStatusResponse
+		wantErr         bool
+	}{
+		{
+			name:            "wantFail:GetCurrentPhase",
+			queryExecutor:   &mockQueryGetFeeVoteSuccess{},
+			feeScaleService: &mockFeeVoteFeeScaleServiceFail{},
+			blockService:    &mockFeeVoteBlockServiceSuccess{},
+			wantErr:         true,
+		},
+		{
+			name:            "wantFail:GetLastBlock",
+			queryExecutor:   &mockQueryGetFeeVoteSuccess{},
+			feeScaleService: &mockFeeVoteFeeScaleServiceSuccess{},
+			blockService:    &mockFeeVoteBlockServiceFail{},
+			wantErr:         true,
+		},
+		{
+			name:            "wantFail:Query",
+			queryExecutor:   &mockQueryGetFeeVoteFail{},
+			feeScaleService: &mockFeeVoteFeeScaleServiceSuccess{},
+			blockService:    &mockFeeVoteBlockServiceSuccess{},
+			wantErr:         true,
+		},
+		{
+			name:            "wantSuccess",
+			queryExecutor:   &mockQueryGetFeeVoteSuccess{},
+			feeScaleService: &mockFeeVoteFeeScaleServiceSuccess{},
+			blockService:    &mockFeeVoteBlockServiceSuccess{},
+			want: &model.GetFeeVoteStatusResponse{
+				Phase:             model.FeeVotePhase_FeeVotePhaseReveal,
+				FromHeight:        mockFeeScaleAPI.GetBlockHeight(),
+				ToHeight:          10,
+				CommitmentsCount:  1,
+				RevealsCount:      1,
+				CurrentFeeScale:   mockFeeScaleAPI,
+				ProjectedFeeScale: 2 * constant.OneZBC,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fvs := NewFeeVoteService(
+				tt.queryExecutor,
+				tt.feeScaleService,
+				tt.blockService,
+				query.NewFeeScaleQuery(),
+				query.NewFeeVoteCommitmentVoteQuery(),
+				query.NewFeeVoteRevealVoteQuery(),
+			)
+			got, err := fvs.GetFeeVoteStatus(&model.GetFeeVoteStatusRequest{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FeeVoteService.GetFeeVoteStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != nil {
+				// depends on the current time, covered by Test_getFeeVotePhaseEndTimestamp
+				got.PhaseEndTimestamp = 0
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FeeVoteService.GetFeeVoteStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFeeVoteService_GetFeeScales(t *testing.T) {
+	tests := []struct {
+		name          string
+		queryExecutor query.ExecutorInterface
+		request       *model.GetFeeScalesRequest
+		want          *model.GetFeeScalesResponse
+		wantErr       bool
+	}{
+		{
+			name:          "wantFail",
+			queryExecutor: &mockQueryGetFeeVoteFail{},
+			request:       &model.GetFeeScalesRequest{},
+			wantErr:       true,
+		},
+		{
+			name:          "wantSuccess",
+			queryExecutor: &mockQueryGetFeeVoteSuccess{},
+			request: &model.GetFeeScalesRequest{
+				FromHeight: 1,
+				ToHeight:   10,
+			},
+			want: &model.GetFeeScalesResponse{
+				Total:     1,
+				FeeScales: []*model.FeeScale{mockFeeScaleAPI},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fvs := NewFeeVoteService(
+				tt.queryExecutor,
+				&mockFeeVoteFeeScaleServiceSuccess{},
+				&mockFeeVoteBlockServiceSuccess{},
+				query.NewFeeScaleQuery(),
+				query.NewFeeVoteCommitmentVoteQuery(),
+				query.NewFeeVoteRevealVoteQuery(),
+			)
+			got, err := fvs.GetFeeScales(tt.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FeeVoteService.GetFeeScales() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FeeVoteService.GetFeeScales() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getFeeVotePhaseEndTimestamp(t *testing.T) {
+	tests := []struct {
+		name      string
+		timestamp int64
+		phase     model.FeeVotePhase
+		want      int64
+	}{
+		{
+			name:      "CommitPhase",
+			timestamp: time.Date(2020, time.February, 3, 10, 0, 0, 0, time.UTC).Unix(),
+			phase:     model.FeeVotePhase_FeeVotePhaseCommmit,
+			want:      time.Date(2020, time.February, 14, 23, 59, 59, 0, time.UTC).Unix(),
+		},
+		{
+			name:      "RevealPhase",
+			timestamp: time.Date(2020, time.February, 20, 10, 0, 0, 0, time.UTC).Unix(),
+			phase:     model.FeeVotePhase_FeeVotePhaseReveal,
+			want:      time.Date(2020, time.February, 29, 23, 59, 59, 0, time.UTC).Unix(),
+		},
+		{
+			name:      "RevealPhase:EndOfYear",
+			timestamp: time.Date(2020, time.December, 31, 23, 0, 0, 0, time.UTC).Unix(),
+			phase:     model.FeeVotePhase_FeeVotePhaseReveal,
+			want:      time.Date(2020, time.December, 31, 23, 59, 59, 0, time.UTC).Unix(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getFeeVotePhaseEndTimestamp(tt.timestamp, tt.phase); got != tt.want {
+				t.Errorf("getFeeVotePhaseEndTimestamp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package service
+
+import (
+	"bytes"
+	"database/sql"
+
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	// HtlcServiceInterface interface that contain methods of hash time locked transfers
+	HtlcServiceInterface interface {
+		GetHtlcs(request *model.GetHtlcsRequest) (*model.GetHtlcsResponse, error)
+		GetHtlc(request *model.GetHtlcRequest) (*model.Htlc, error)
+	}
+	// HtlcService struct that contain fields that needed
+	HtlcService struct {
+		QueryExecutor query.ExecutorInterface
+		HtlcQuery     *query.HtlcQuery
+	}
+)
+
+// NewHtlcService will create HtlcServiceInterface instance
+func NewHtlcService(
+	queryExecutor query.ExecutorInterface,
+	htlcQuery *query.HtlcQuery,
+) HtlcServiceInterface {
+	return &HtlcService{
+		QueryExecutor: queryExecutor,
+		HtlcQuery:     htlcQuery,
+	}
+}
+
+// GetHtlcs to get the latest state of the htlcs matching the request filters
+func (hs *HtlcService) GetHtlcs(request *model.GetHtlcsRequest) (*model.GetHtlcsResponse, error) {
+	var (
+		countQuery string
+		htlcs      []*model.Htlc
+		rows       *sql.Rows
+		count      uint64
+		row        *sql.Row
+		err        error
+	)
+
+	caseQuery := query.CaseQuery{
+		Query: bytes.NewBuffer([]byte{}),
+	}
+	caseQuery.Select(hs.HtlcQuery.TableName, hs.HtlcQuery.Fields...)
+	caseQuery.Where(caseQuery.Equal("latest", true))
+	if request.GetSenderAddress() != nil {
+		caseQuery.And(caseQuery.Equal("sender_address", request.GetSenderAddress()))
+	}
+	if request.GetRecipientAddress() != nil {
+		caseQuery.And(caseQuery.Equal("recipient_address", request.GetRecipientAddress()))
+	}
+	if request.GetHashLock() != nil {
+		caseQuery.And(caseQuery.Equal("hash_lock", request.GetHashLock()))
+	}
+	if len(request.GetStatuses()) > 0 {
+		var statuses []interface{}
+		for _, v := range request.GetStatuses() {
+			statuses = append(statuses, int32(v))
+		}
+		caseQuery.And(caseQuery.In("status", statuses...))
+	}
+
+	// count first
+	selectQuery, args := caseQuery.Build()
+	countQuery = query.GetTotalRecordOfSelect(selectQuery)
+	row, err = hs.QueryExecutor.ExecuteSelectRow(countQuery, false, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	err = row.Scan(&count)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// select records
+	page := request.GetPagination()
+	if page.GetOrderField() == "" {
+		caseQuery.OrderBy("id", page.GetOrderBy())
+	} else {
+		caseQuery.OrderBy(page.GetOrderField(), page.GetOrderBy())
+	}
+	caseQuery.Paginate(page.GetLimit(), page.GetPage())
+
+	selectQuery, args = caseQuery.Build()
+	rows, err = hs.QueryExecutor.ExecuteSelect(selectQuery, false, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+
+	htlcs, err = hs.HtlcQuery.BuildModels(rows)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &model.GetHtlcsResponse{
+		Total: count,
+		Htlcs: htlcs,
+	}, nil
+}
+
+// GetHtlc to get the latest state of a htlc by the id of its htlc lock transaction
+func (hs *HtlcService) GetHtlc(request *model.GetHtlcRequest) (*model.Htlc, error) {
+	var (
+		htlc model.Htlc
+		row  *sql.Row
+		err  error
+	)
+	qry, args := hs.HtlcQuery.GetHtlcByID(request.GetID())
+	row, err = hs.QueryExecutor.ExecuteSelectRow(qry, false, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	err = hs.HtlcQuery.Scan(&htlc, row)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return nil, status.Error(codes.NotFound, "htlc not found")
+	}
+	return &htlc, nil
+}
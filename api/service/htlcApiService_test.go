@@ -0,0 +1,230 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+)
+
+type (
+	mockQueryGetHtlcsFail struct {
+		query.Executor
+	}
+	mockQueryGetHtlcsSuccess struct {
+		query.Executor
+	}
+	mockQueryGetHtlcNotFound struct {
+		query.Executor
+	}
+)
+
+var (
+	mockHtlcAPI = &model.Htlc{
+		ID:               1,
+		SenderAddress:    []byte{0, 1, 2, 3},
+		RecipientAddress: []byte{3, 2, 1, 0},
+		Amount:           100,
+		HashLock:         make([]byte, 32),
+		TimeLockHeight:   10,
+		Status:           model.HtlcStatus_HtlcClaimed,
+		Preimage:         []byte{1, 2, 3},
+		BlockHeight:      5,
+		Latest:           true,
+	}
+)
+
+func (*mockQueryGetHtlcsFail) ExecuteSelect(string, bool, ...interface{}) (*sql.Rows, error) {
+	return nil, errors.New("want error")
+}
+
+func (*mockQueryGetHtlcsFail) ExecuteSelectRow(string, bool, ...interface{}) (*sql.Row, error) {
+	return nil, errors.New("want error")
+}
+
+func (*mockQueryGetHtlcsSuccess) ExecuteSelect(qStr string, tx bool, args ...interface{}) (*sql.Rows, error) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	mock.ExpectQuery("").WillReturnRows(
+		sqlmock.NewRows(query.NewHtlcQuery().Fields).AddRow(
+			mockHtlcAPI.GetID(),
+			mockHtlcAPI.GetSenderAddress(),
+			mockHtlcAPI.GetRecipientAddress(),
+			mockHtlcAPI.GetAmount(),
+			mockHtlcAPI.GetHashLock(),
+			mockHtlcAPI.GetTimeLockHeight(),
+			mockHtlcAPI.GetStatus(),
+			mockHtlcAPI.GetPreimage(),
+			mockHtlcAPI.GetBlockHeight(),
+			mockHtlcAPI.GetLatest(),
+		),
+	)
+	return db.Query("")
+}
+
+func (*mockQueryGetHtlcsSuccess) ExecuteSelectRow(qStr string, tx bool, args ...interface{}) (*sql.Row, error) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	if strings.Contains(qStr, "total_record") {
+		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(sqlmock.NewRows([]string{"total_record"}).AddRow(1))
+	} else {
+		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(
+			sqlmock.NewRows(query.NewHtlcQuery().Fields).AddRow(
+				mockHtlcAPI.GetID(),
+				mockHtlcAPI.GetSenderAddress(),
+				mockHtlcAPI.GetRecipientAddress(),
+				mockHtlcAPI.GetAmount(),
+				mockHtlcAPI.GetHashLock(),
+				mockHtlcAPI.GetTimeLockHeight(),
+				mockHtlcAPI.GetStatus(),
+				mockHtlcAPI.GetPreimage(),
+				mockHtlcAPI.GetBlockHeight(),
+				mockHtlcAPI.GetLatest(),
+			),
+		)
+	}
+	return db.QueryRow(qStr), nil
+}
+
+func (*mockQueryGetHtlcNotFound) ExecuteSelectRow(qStr string, tx bool, args ...interface{}) (*sql.Row, error) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(sqlmock.NewRows(query.NewHtlcQuery().Fields))
+	return db.QueryRow(qStr), nil
+}
+
+func TestHtlcService_GetHtlcs(t *testing.T) {
+	tests := []struct {
+		name          string
+		queryExecutor query.ExecutorInterface
+		request       *model.GetHtlcsRequest
+		want          *model.GetHtlcsResponse
+		wantErr       bool
+	}{
+		{
+			name:          "wantFail",
+			queryExecutor: &mockQueryGetHtlcsFail{},
+			request:       &model.GetHtlcsRequest{},
+			wantErr:       true,
+		},
+		{
+			name:          "wantSuccess",
+			queryExecutor: &mockQueryGetHtlcsSuccess{},
+			request: &model.GetHtlcsRequest{
+				RecipientAddress: mockHtlcAPI.GetRecipientAddress(),
+				HashLock:         mockHtlcAPI.GetHashLock(),
+				Statuses:         []model.HtlcStatus{model.HtlcStatus_HtlcClaimed},
+			},
+			want: &model.GetHtlcsResponse{
+				Total: 1,
+				Htlcs: []*model.Htlc{mockHtlcAPI},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hs := NewHtlcService(tt.queryExecutor, query.NewHtlcQuery())
+			got, err := hs.GetHtlcs(tt.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HtlcService.GetHtlcs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HtlcService.GetHtlcs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHtlcService_GetHtlc(t *testing.T) {
+	tests := []struct {
+		name          string
+		queryExecutor query.ExecutorInterface
+		want          *model.Htlc
+		wantErr       bool
+	}{
+		{
+			name:          "wantFail",
+			queryExecutor: &mockQueryGetHtlcsFail{},
+			wantErr:       true,
+		},
+		{
+			name:          "wantFail:NotFound",
+			queryExecutor: &mockQueryGetHtlcNotFound{},
+			wantErr:       true,
+		},
+		{
+			name:          "wantSuccess",
+			queryExecutor: &mockQueryGetHtlcsSuccess{},
+			want:          mockHtlcAPI,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hs := NewHtlcService(tt.queryExecutor, query.NewHtlcQuery())
+			got, err := hs.GetHtlc(&model.GetHtlcRequest{ID: mockHtlcAPI.GetID()})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HtlcService.GetHtlc() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HtlcService.GetHtlc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
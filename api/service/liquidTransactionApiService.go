@@ -5,6 +5,8 @@ import (
 
 	"github.com/zoobc/zoobc-core/common/model"
 	"github.com/zoobc/zoobc-core/common/query"
+	"github.com/zoobc/zoobc-core/common/util"
+	coreService "github.com/zoobc/zoobc-core/core/service"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -17,14 +19,17 @@ type (
 	LiquidTransactionService struct {
 		LiquidPaymentTransactionQuery *query.LiquidPaymentTransactionQuery
 		QueryExecutor                 query.ExecutorInterface
+		BlockService                  coreService.BlockServiceInterface
 	}
 )
 
 func NewLiquidTransactionService(executor query.ExecutorInterface,
-	liquidPaymentTransactionQuery *query.LiquidPaymentTransactionQuery) *LiquidTransactionService {
+	liquidPaymentTransactionQuery *query.LiquidPaymentTransactionQuery,
+	blockService coreService.BlockServiceInterface) *LiquidTransactionService {
 	return &LiquidTransactionService{
 		LiquidPaymentTransactionQuery: liquidPaymentTransactionQuery,
 		QueryExecutor:                 executor,
+		BlockService:                  blockService,
 	}
 }
 
@@ -95,6 +100,24 @@ func (lts *LiquidTransactionService) GetLiquidTransactions(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// the vested amount of a pending payment grows with the chain, the completed ones paid all their vested amount
+	lastBlock, err := lts.BlockService.GetLastBlock()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, liquidTransaction := range liquidTransactions {
+		if liquidTransaction.GetStatus() == model.LiquidPaymentStatus_LiquidPaymentCompleted {
+			liquidTransaction.VestedAmount = liquidTransaction.GetClaimedAmount()
+			continue
+		}
+		liquidTransaction.VestedAmount = util.GetLiquidPaymentVestedAmount(
+			liquidTransaction.GetAmount(),
+			liquidTransaction.GetCompleteMinutes(),
+			liquidTransaction.GetAppliedTime(),
+			lastBlock.GetTimestamp(),
+		)
+	}
+
 	return &model.GetLiquidTransactionsResponse{
 		Total:              count,
 		LiquidTransactions: liquidTransactions,
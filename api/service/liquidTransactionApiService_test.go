@@ -12,12 +12,14 @@ import (
 	"github.com/zoobc/zoobc-core/common/model"
 	"github.com/zoobc/zoobc-core/common/query"
 	"github.com/zoobc/zoobc-core/common/queue"
+	coreService "github.com/zoobc/zoobc-core/core/service"
 )
 
 func TestNewLiquidTransactionService(t *testing.T) {
 	type args struct {
 		executor                      query.ExecutorInterface
 		liquidPaymentTransactionQuery *query.LiquidPaymentTransactionQuery
+		blockService                  coreService.BlockServiceInterface
 	}
 
 	db, _, err := sqlmock.New()
@@ -43,7 +45,8 @@ func TestNewLiquidTransactionService(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewLiquidTransactionService(tt.args.executor, tt.args.liquidPaymentTransactionQuery); !reflect.DeepEqual(got, tt.want) {
+			got := NewLiquidTransactionService(tt.args.executor, tt.args.liquidPaymentTransactionQuery, tt.args.blockService)
+			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewLiquidTransactionService() = %v, want %v", got, tt.want)
 			}
 		})
@@ -57,8 +60,22 @@ type (
 	mockQueryGetLiquidTransactionsSuccess struct {
 		query.Executor
 	}
+	mockLiquidTransactionBlockServiceSuccess struct {
+		coreService.BlockServiceInterface
+	}
+	mockLiquidTransactionBlockServiceFail struct {
+		coreService.BlockServiceInterface
+	}
 )
 
+func (*mockLiquidTransactionBlockServiceSuccess) GetLastBlock() (*model.Block, error) {
+	return &model.Block{Height: 10, Timestamp: 3002}, nil
+}
+
+func (*mockLiquidTransactionBlockServiceFail) GetLastBlock() (*model.Block, error) {
+	return nil, errors.New("want error")
+}
+
 func (*mockQueryGetLiquidTransactionsFail) ExecuteSelect(query string, tx bool, args ...interface{}) (*sql.Rows, error) {
 	return nil, errors.New("want error")
 }
@@ -83,6 +100,19 @@ func (*mockQueryGetLiquidTransactionsSuccess) ExecuteSelect(qStr string, tx bool
 				1,
 				1,
 				true,
+				30,
+			).
+			AddRow(
+				2,
+				[]byte{0, 1, 2, 3},
+				[]byte{0, 1, 2, 3},
+				100,
+				2,
+				100,
+				0,
+				1,
+				true,
+				10,
 			),
 		)
 	return db.Query("")
@@ -93,7 +123,7 @@ func (*mockQueryGetLiquidTransactionsSuccess) ExecuteSelectRow(qStr string, tx b
 	defer db.Close()
 	switch strings.Contains(qStr, "total_record") {
 	case true:
-		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(sqlmock.NewRows([]string{"total_record"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(sqlmock.NewRows([]string{"total_record"}).AddRow(2))
 	default:
 		return nil, nil
 	}
@@ -104,6 +134,7 @@ func TestLiquidTransactionService_GetLiquidTransactions(t *testing.T) {
 	type fields struct {
 		LiquidPaymentTransactionQuery *query.LiquidPaymentTransactionQuery
 		QueryExecutor                 query.ExecutorInterface
+		BlockService                  coreService.BlockServiceInterface
 	}
 	type args struct {
 		request *model.GetLiquidTransactionsRequest
@@ -127,17 +158,31 @@ func TestLiquidTransactionService_GetLiquidTransactions(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "wantFail:GetLastBlock",
+			fields: fields{
+				LiquidPaymentTransactionQuery: &query.LiquidPaymentTransactionQuery{},
+				QueryExecutor:                 &mockQueryGetLiquidTransactionsSuccess{},
+				BlockService:                  &mockLiquidTransactionBlockServiceFail{},
+			},
+			args: args{
+				request: &model.GetLiquidTransactionsRequest{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "wantSuccess",
 			fields: fields{
 				LiquidPaymentTransactionQuery: &query.LiquidPaymentTransactionQuery{},
 				QueryExecutor:                 &mockQueryGetLiquidTransactionsSuccess{},
+				BlockService:                  &mockLiquidTransactionBlockServiceSuccess{},
 			},
 			args: args{
 				request: &model.GetLiquidTransactionsRequest{},
 			},
 			want: &model.GetLiquidTransactionsResponse{
-				Total: 1,
+				Total: 2,
 				LiquidTransactions: []*model.LiquidPayment{
 					{
 						ID:               1,
@@ -149,6 +194,21 @@ func TestLiquidTransactionService_GetLiquidTransactions(t *testing.T) {
 						Status:           1,
 						BlockHeight:      1,
 						Latest:           true,
+						ClaimedAmount:    30,
+						VestedAmount:     30,
+					},
+					{
+						ID:               2,
+						SenderAddress:    []byte{0, 1, 2, 3},
+						RecipientAddress: []byte{0, 1, 2, 3},
+						Amount:           100,
+						AppliedTime:      2,
+						CompleteMinutes:  100,
+						Status:           0,
+						BlockHeight:      1,
+						Latest:           true,
+						ClaimedAmount:    10,
+						VestedAmount:     50,
 					},
 				},
 			},
@@ -160,6 +220,7 @@ func TestLiquidTransactionService_GetLiquidTransactions(t *testing.T) {
 			lts := &LiquidTransactionService{
 				LiquidPaymentTransactionQuery: tt.fields.LiquidPaymentTransactionQuery,
 				QueryExecutor:                 tt.fields.QueryExecutor,
+				BlockService:                  tt.fields.BlockService,
 			}
 			got, err := lts.GetLiquidTransactions(tt.args.request)
 			if (err != nil) != tt.wantErr {
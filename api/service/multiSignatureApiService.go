@@ -52,12 +52,21 @@ package service
 import (
 	"database/sql"
 	"encoding/hex"
+	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/zoobc/zoobc-core/common/accounttype"
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/chaintype"
 	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/crypto"
 	"github.com/zoobc/zoobc-core/common/model"
 	"github.com/zoobc/zoobc-core/common/query"
+	"github.com/zoobc/zoobc-core/common/signaturetype"
+	"github.com/zoobc/zoobc-core/common/transaction"
+	commonUtils "github.com/zoobc/zoobc-core/common/util"
 	coreService "github.com/zoobc/zoobc-core/core/service"
+	"golang.org/x/crypto/sha3"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -85,6 +94,9 @@ type (
 		GetParticipantsByMultisigAddresses(
 			param *model.GetParticipantsByMultisigAddressesRequest,
 		) (*model.GetParticipantsByMultisigAddressesResponse, error)
+		PostDetachedSignature(
+			param *model.PostDetachedSignatureRequest,
+		) (*model.PostDetachedSignatureResponse, error)
 	}
 
 	MultisigService struct {
@@ -94,7 +106,15 @@ type (
 		PendingSignatureQuery          query.PendingSignatureQueryInterface
 		MultisignatureInfoQuery        query.MultisignatureInfoQueryInterface
 		MultiSignatureParticipantQuery query.MultiSignatureParticipantQueryInterface
-		Logger                         *logrus.Logger
+		PendingTransactionService      coreService.PendingTransactionServiceInterface
+		TransactionService             TransactionServiceInterface
+		ActionTypeSwitcher             transaction.TypeActionSwitcher
+		TransactionUtil                transaction.UtilInterface
+		Signature                      crypto.SignatureInterface
+		// DetachedMultisigFeePayerSeed seed of the account signing and paying the fee of the multisig transactions the node
+		// broadcasts once the detached signatures are enough, the node doesn't broadcast them when it is empty
+		DetachedMultisigFeePayerSeed string
+		Logger                       *logrus.Logger
 	}
 )
 
@@ -105,6 +125,12 @@ func NewMultisigService(
 	pendingSignatureQuery query.PendingSignatureQueryInterface,
 	multisignatureQuery query.MultisignatureInfoQueryInterface,
 	multiSignatureParticipantQuery query.MultiSignatureParticipantQueryInterface,
+	pendingTransactionService coreService.PendingTransactionServiceInterface,
+	transactionService TransactionServiceInterface,
+	actionTypeSwitcher transaction.TypeActionSwitcher,
+	transactionUtil transaction.UtilInterface,
+	signature crypto.SignatureInterface,
+	detachedMultisigFeePayerSeed string,
 ) *MultisigService {
 	return &MultisigService{
 		Executor:                       executor,
@@ -113,6 +139,12 @@ func NewMultisigService(
 		PendingSignatureQuery:          pendingSignatureQuery,
 		MultisignatureInfoQuery:        multisignatureQuery,
 		MultiSignatureParticipantQuery: multiSignatureParticipantQuery,
+		PendingTransactionService:      pendingTransactionService,
+		TransactionService:             transactionService,
+		ActionTypeSwitcher:             actionTypeSwitcher,
+		TransactionUtil:                transactionUtil,
+		Signature:                      signature,
+		DetachedMultisigFeePayerSeed:   detachedMultisigFeePayerSeed,
 	}
 }
 
@@ -249,7 +281,7 @@ func (ms *MultisigService) GetPendingTransactionDetailByTransactionHash(
 		return nil, status.Error(codes.Internal, "server error")
 	}
 	if err != sql.ErrNoRows {
-		multisigInfo.Addresses, err = ms.getMultisigAddressParticipants(pendingTx.SenderAddress)
+		multisigInfo.Addresses, multisigInfo.Weights, err = ms.getMultisigAddressParticipants(pendingTx.SenderAddress)
 		if err != nil {
 			if err != sql.ErrNoRows {
 				ms.Logger.Error(err)
@@ -269,7 +301,7 @@ func (ms *MultisigService) GetPendingTransactionDetailByTransactionHash(
 // optional) a start block height (for transaction in 'pending' status)
 func (ms *MultisigService) getMultisigAddressParticipants(
 	multisigAddress []byte,
-) (multisigParticipants [][]byte, err error) {
+) (multisigParticipants [][]byte, multisigWeights []uint32, err error) {
 	var (
 		caseQuery                = query.NewCaseQuery()
 		multisigParticipantQuery = query.NewMultiSignatureParticipantQuery()
@@ -282,17 +314,20 @@ func (ms *MultisigService) getMultisigAddressParticipants(
 	selectMultisigParticipantsQuery, args := caseQuery.Build()
 	multisigParticipantRows, err := ms.Executor.ExecuteSelect(selectMultisigParticipantsQuery, false, args...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer multisigParticipantRows.Close()
 	participants, err := ms.MultiSignatureParticipantQuery.BuildModel(multisigParticipantRows)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	for _, participant := range participants {
 		multisigParticipants = append(multisigParticipants, participant.GetAccountAddress())
+		if participant.GetWeight() != constant.MultiSigParticipantNotWeighted {
+			multisigWeights = append(multisigWeights, participant.GetWeight())
+		}
 	}
-	return multisigParticipants, nil
+	return multisigParticipants, multisigWeights, nil
 
 }
 
@@ -341,7 +376,9 @@ func (ms *MultisigService) GetMultisignatureInfo(
 		return nil, err
 	}
 	for idx, multisigInfo := range multiSignatureInfos {
-		multiSignatureInfos[idx].Addresses, err = ms.getMultisigAddressParticipants(multisigInfo.GetMultisigAddress())
+		multiSignatureInfos[idx].Addresses, multiSignatureInfos[idx].Weights, err = ms.getMultisigAddressParticipants(
+			multisigInfo.GetMultisigAddress(),
+		)
 		if err != nil {
 			if err != sql.ErrNoRows {
 				ms.Logger.Error(err)
@@ -488,7 +525,9 @@ func (ms *MultisigService) GetMultisigAddressesByBlockHeightRange(
 		return nil, err
 	}
 	for idx, multisigInfo := range multiSignatureInfos {
-		multiSignatureInfos[idx].Addresses, err = ms.getMultisigAddressParticipants(multisigInfo.GetMultisigAddress())
+		multiSignatureInfos[idx].Addresses, multiSignatureInfos[idx].Weights, err = ms.getMultisigAddressParticipants(
+			multisigInfo.GetMultisigAddress(),
+		)
 		if err != nil {
 			if err != sql.ErrNoRows {
 				ms.Logger.Error(err)
@@ -570,3 +609,131 @@ func (ms *MultisigService) GetParticipantsByMultisigAddresses(
 		MultiSignatureParticipants: multiSignatureParticipants,
 	}, err
 }
+
+// PostDetachedSignature collect the signature of a multisig participant off chain. Once the weight of the collected signatures
+// reach the minimum signatures of the multisig info, it returns the unsigned multisig transaction for the participant to sign
+// and post from its own account, unless the node is configured with a detached multisig fee payer: the node then broadcasts
+// the multisig transaction signed by the fee payer account, paying the minimum fee of the multisig transaction
+func (ms *MultisigService) PostDetachedSignature(
+	param *model.PostDetachedSignatureRequest,
+) (*model.PostDetachedSignatureResponse, error) {
+	var (
+		body             *model.MultiSignatureTransactionBody
+		multisigTxBytes  []byte
+		signaturesWeight uint64
+	)
+	lastBlock, err := ms.BlockService.GetLastBlock()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	body, err = ms.PendingTransactionService.AddDetachedSignature(
+		param.GetTransactionHash(),
+		param.GetAccountAddress(),
+		param.GetSignature(),
+		lastBlock.GetHeight(),
+	)
+	if err != nil {
+		if blockerErr, ok := err.(blocker.Blocker); ok && blockerErr.Type == blocker.ValidationErr {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	signaturesWeight = commonUtils.GetMultisigSignaturesWeight(body.GetMultiSignatureInfo(), body.GetSignatureInfo().GetSignatures())
+	response := &model.PostDetachedSignatureResponse{
+		TransactionHash:   body.GetSignatureInfo().GetTransactionHash(),
+		SignaturesWeight:  signaturesWeight,
+		MinimumSignatures: body.GetMultiSignatureInfo().GetMinimumSignatures(),
+	}
+	if signaturesWeight < uint64(body.GetMultiSignatureInfo().GetMinimumSignatures()) {
+		return response, nil
+	}
+
+	if ms.DetachedMultisigFeePayerSeed == "" {
+		multisigTxBytes, err = ms.generateDetachedMultisigTransactionBytes(body, param.GetAccountAddress(), "")
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		response.MultisigTransactionBytes = multisigTxBytes
+		return response, nil
+	}
+	feePayerAccountType, err := accounttype.NewAccountType(
+		int32(model.AccountType_ZbcAccountType),
+		signaturetype.NewEd25519Signature().GetPublicKeyFromSeed(ms.DetachedMultisigFeePayerSeed),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	feePayerAccountAddress, err := feePayerAccountType.GetAccountAddress()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	multisigTxBytes, err = ms.generateDetachedMultisigTransactionBytes(body, feePayerAccountAddress, ms.DetachedMultisigFeePayerSeed)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	_, err = ms.TransactionService.PostTransaction(&chaintype.MainChain{}, &model.PostTransactionRequest{
+		TransactionBytes: multisigTxBytes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = ms.PendingTransactionService.SetDetachedPendingTransactionExecuted(body, lastBlock.GetHeight())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	response.MultisigTransactionBytes = multisigTxBytes
+	return response, nil
+}
+
+// generateDetachedMultisigTransactionBytes build the multisig transaction posting the collected signatures from the sender
+// account, the multisig info and the transaction bytes being already known on chain. The transaction is signed with the
+// sender seed, or left unsigned for the sender to sign when the seed is empty
+func (ms *MultisigService) generateDetachedMultisigTransactionBytes(
+	body *model.MultiSignatureTransactionBody,
+	senderAccountAddress []byte,
+	senderSeed string,
+) ([]byte, error) {
+	multisigBody := &model.MultiSignatureTransactionBody{
+		SignatureInfo: body.GetSignatureInfo(),
+	}
+	multisigTxBodyBytes, err := (&transaction.MultiSignatureTransaction{Body: multisigBody}).GetBodyBytes()
+	if err != nil {
+		return nil, err
+	}
+	multisigTx := &model.Transaction{
+		Version:               1,
+		TransactionType:       uint32(model.TransactionType_MultiSignatureTransaction),
+		Timestamp:             time.Now().Unix(),
+		SenderAccountAddress:  senderAccountAddress,
+		TransactionBodyLength: uint32(len(multisigTxBodyBytes)),
+		TransactionBodyBytes:  multisigTxBodyBytes,
+		TransactionBody: &model.Transaction_MultiSignatureTransactionBody{
+			MultiSignatureTransactionBody: multisigBody,
+		},
+	}
+	txType, err := ms.ActionTypeSwitcher.GetTransactionType(multisigTx)
+	if err != nil {
+		return nil, err
+	}
+	multisigTx.Fee, err = txType.GetMinimumFee()
+	if err != nil {
+		return nil, err
+	}
+	unsignedTxBytes, err := ms.TransactionUtil.GetTransactionBytes(multisigTx, false)
+	if err != nil {
+		return nil, err
+	}
+	if senderSeed == "" {
+		return unsignedTxBytes, nil
+	}
+	txHash := sha3.Sum256(unsignedTxBytes)
+	multisigTx.Signature, err = ms.Signature.Sign(
+		txHash[:],
+		model.AccountType_ZbcAccountType,
+		senderSeed,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ms.TransactionUtil.GetTransactionBytes(multisigTx, true)
+}
@@ -50,7 +50,9 @@
 package service
 
 import (
+	"bytes"
 	"database/sql"
+	"encoding/hex"
 	"errors"
 	"reflect"
 	"regexp"
@@ -58,8 +60,15 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/sirupsen/logrus"
+	"github.com/zoobc/zoobc-core/common/accounttype"
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/chaintype"
+	"github.com/zoobc/zoobc-core/common/crypto"
 	"github.com/zoobc/zoobc-core/common/model"
 	"github.com/zoobc/zoobc-core/common/query"
+	"github.com/zoobc/zoobc-core/common/signaturetype"
+	"github.com/zoobc/zoobc-core/common/storage"
+	"github.com/zoobc/zoobc-core/common/transaction"
 	coreService "github.com/zoobc/zoobc-core/core/service"
 )
 
@@ -307,6 +316,7 @@ var (
 			{0, 0, 0, 0, 160, 121, 129, 83, 225, 164, 195, 123, 8, 181, 41, 251, 17, 3, 93, 37, 182, 109, 32, 174, 168, 68, 193, 212,
 				79, 54, 156, 213, 117, 27, 185, 167},
 		},
+		Weights: []uint32{3, 1, 1},
 		MultisigAddress: []byte{0, 0, 0, 0, 185, 226, 12, 96, 140, 157, 68, 172, 119, 193, 144, 246, 76, 118, 0, 112, 113, 140, 183, 229,
 			116, 202, 211, 235, 190, 224, 217, 238, 63, 223, 225, 162},
 		BlockHeight: 400,
@@ -320,6 +330,7 @@ var (
 		AccountAddress: []byte{0, 0, 0, 0, 185, 226, 12, 96, 140, 157, 68, 172, 119, 193, 144, 246, 76, 118, 0, 112, 113, 140, 183, 229,
 			116, 202, 211, 235, 190, 224, 217, 238, 63, 223, 225, 162},
 		AccountAddressIndex: 0,
+		Weight:              3,
 	}
 	mockMultisigParticipant2 = &model.MultiSignatureParticipant{
 		MultiSignatureAddress: []byte{0, 0, 0, 0, 185, 226, 12, 96, 140, 157, 68, 172, 119, 193, 144, 246, 76, 118, 0, 112, 113, 140, 183, 229,
@@ -329,6 +340,7 @@ var (
 		AccountAddress: []byte{0, 0, 0, 0, 8, 32, 68, 38, 181, 138, 127, 184, 190, 125, 84, 174, 13, 162, 122, 62, 183, 130, 70, 18, 103, 47, 177, 161,
 			153, 143, 61, 130, 145, 81, 222, 70},
 		AccountAddressIndex: 1,
+		Weight:              1,
 	}
 	mockMultisigParticipant3 = &model.MultiSignatureParticipant{
 		MultiSignatureAddress: []byte{0, 0, 0, 0, 185, 226, 12, 96, 140, 157, 68, 172, 119, 193, 144, 246, 76, 118, 0, 112, 113, 140, 183, 229,
@@ -338,6 +350,7 @@ var (
 		AccountAddress: []byte{0, 0, 0, 0, 160, 121, 129, 83, 225, 164, 195, 123, 8, 181, 41, 251, 17, 3, 93, 37, 182, 109, 32, 174, 168, 68, 193, 212,
 			79, 54, 156, 213, 117, 27, 185, 167},
 		AccountAddressIndex: 2,
+		Weight:              1,
 	}
 	mockMultisigParticipants = []*model.MultiSignatureParticipant{
 		mockMultisigParticipant1,
@@ -702,7 +715,7 @@ func TestNewMultisigService(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			if got := NewMultisigService(tt.args.executor, tt.args.blockService, tt.args.pendingTransactionQuery,
 				tt.args.pendingSignatureQuery, tt.args.multisignatureQuery,
-				tt.args.multiSignatureParticipantQuery); !reflect.DeepEqual(got, tt.want) {
+				tt.args.multiSignatureParticipantQuery, nil, nil, nil, nil, nil, ""); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewMultisigService() = %v, want %v", got, tt.want)
 			}
 		})
@@ -1108,3 +1121,234 @@ func TestMultisigService_GetMultisigAddressByParticipantAddress(t *testing.T) {
 		})
 	}
 }
+
+type (
+	mockPostDetachedSignaturePendingTxServiceFail struct {
+		coreService.PendingTransactionServiceInterface
+	}
+	mockPostDetachedSignaturePendingTxServiceNotEnough struct {
+		coreService.PendingTransactionServiceInterface
+	}
+	mockPostDetachedSignaturePendingTxServiceEnough struct {
+		coreService.PendingTransactionServiceInterface
+	}
+	mockPostDetachedSignatureTransactionServiceSuccess struct {
+		TransactionServiceInterface
+	}
+	mockPostDetachedSignatureTransactionServiceFail struct {
+		TransactionServiceInterface
+	}
+)
+
+var (
+	mockPostDetachedSignatureTxHash          = make([]byte, 32)
+	mockPostDetachedSignatureTxBytes         = []byte{1, 2, 3}
+	mockPostDetachedSignatureFeePayerSeed    = "mockedFeePayerSeed"
+	mockPostDetachedSignatureFeePayerAddress = func() []byte {
+		accountType, _ := accounttype.NewAccountType(
+			int32(model.AccountType_ZbcAccountType),
+			signaturetype.NewEd25519Signature().GetPublicKeyFromSeed(mockPostDetachedSignatureFeePayerSeed),
+		)
+		address, _ := accountType.GetAccountAddress()
+		return address
+	}()
+	mockPostDetachedSignatureInfo = &model.MultiSignatureInfo{
+		MinimumSignatures: 2,
+		MultisigAddress:   txAPIRecipientAccount1,
+		Addresses:         [][]byte{txAPISenderAccount1, txAPIRecipientAccount1},
+	}
+)
+
+func (*mockPostDetachedSignaturePendingTxServiceFail) AddDetachedSignature(
+	txHash, accountAddress, signature []byte,
+	blockHeight uint32,
+) (*model.MultiSignatureTransactionBody, error) {
+	return nil, blocker.NewBlocker(blocker.ValidationErr, "mockedError")
+}
+
+func (*mockPostDetachedSignaturePendingTxServiceNotEnough) AddDetachedSignature(
+	txHash, accountAddress, signature []byte,
+	blockHeight uint32,
+) (*model.MultiSignatureTransactionBody, error) {
+	return &model.MultiSignatureTransactionBody{
+		MultiSignatureInfo:       mockPostDetachedSignatureInfo,
+		UnsignedTransactionBytes: mockPostDetachedSignatureTxBytes,
+		SignatureInfo: &model.SignatureInfo{
+			TransactionHash: mockPostDetachedSignatureTxHash,
+			Signatures: map[string][]byte{
+				hex.EncodeToString(txAPISenderAccount1): {1},
+			},
+		},
+	}, nil
+}
+
+func (*mockPostDetachedSignaturePendingTxServiceEnough) AddDetachedSignature(
+	txHash, accountAddress, signature []byte,
+	blockHeight uint32,
+) (*model.MultiSignatureTransactionBody, error) {
+	return &model.MultiSignatureTransactionBody{
+		MultiSignatureInfo:       mockPostDetachedSignatureInfo,
+		UnsignedTransactionBytes: mockPostDetachedSignatureTxBytes,
+		SignatureInfo: &model.SignatureInfo{
+			TransactionHash: mockPostDetachedSignatureTxHash,
+			Signatures: map[string][]byte{
+				hex.EncodeToString(txAPISenderAccount1):    {1},
+				hex.EncodeToString(txAPIRecipientAccount1): {2},
+			},
+		},
+	}, nil
+}
+
+func (*mockPostDetachedSignaturePendingTxServiceEnough) SetDetachedPendingTransactionExecuted(
+	body *model.MultiSignatureTransactionBody, blockHeight uint32,
+) error {
+	return nil
+}
+
+func (*mockPostDetachedSignatureTransactionServiceSuccess) PostTransaction(
+	chaintype.ChainType, *model.PostTransactionRequest,
+) (*model.Transaction, error) {
+	return &model.Transaction{}, nil
+}
+
+func (*mockPostDetachedSignatureTransactionServiceFail) PostTransaction(
+	chaintype.ChainType, *model.PostTransactionRequest,
+) (*model.Transaction, error) {
+	return nil, errors.New("mockedError")
+}
+
+func TestMultisigService_PostDetachedSignature(t *testing.T) {
+	type fields struct {
+		BlockService                 coreService.BlockServiceInterface
+		PendingTransactionService    coreService.PendingTransactionServiceInterface
+		TransactionService           TransactionServiceInterface
+		DetachedMultisigFeePayerSeed string
+	}
+	tests := []struct {
+		name                   string
+		fields                 fields
+		want                   *model.PostDetachedSignatureResponse
+		wantMultisigTxBytes    bool
+		wantMultisigTxSignedBy []byte
+		wantErr                bool
+	}{
+		{
+			name: "PostDetachedSignature:GetLastBlockFail",
+			fields: fields{
+				BlockService: &mockLiquidTransactionBlockServiceFail{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "PostDetachedSignature:AddDetachedSignatureFail",
+			fields: fields{
+				BlockService:              &mockLiquidTransactionBlockServiceSuccess{},
+				PendingTransactionService: &mockPostDetachedSignaturePendingTxServiceFail{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "PostDetachedSignature:NotEnoughSignatures",
+			fields: fields{
+				BlockService:              &mockLiquidTransactionBlockServiceSuccess{},
+				PendingTransactionService: &mockPostDetachedSignaturePendingTxServiceNotEnough{},
+			},
+			want: &model.PostDetachedSignatureResponse{
+				TransactionHash:   mockPostDetachedSignatureTxHash,
+				SignaturesWeight:  1,
+				MinimumSignatures: 2,
+			},
+		},
+		{
+			name: "PostDetachedSignature:NoFeePayerReturnUnsignedTransaction",
+			fields: fields{
+				BlockService:              &mockLiquidTransactionBlockServiceSuccess{},
+				PendingTransactionService: &mockPostDetachedSignaturePendingTxServiceEnough{},
+			},
+			want: &model.PostDetachedSignatureResponse{
+				TransactionHash:   mockPostDetachedSignatureTxHash,
+				SignaturesWeight:  2,
+				MinimumSignatures: 2,
+			},
+			wantMultisigTxBytes: true,
+		},
+		{
+			name: "PostDetachedSignature:PostTransactionFail",
+			fields: fields{
+				BlockService:                 &mockLiquidTransactionBlockServiceSuccess{},
+				PendingTransactionService:    &mockPostDetachedSignaturePendingTxServiceEnough{},
+				TransactionService:           &mockPostDetachedSignatureTransactionServiceFail{},
+				DetachedMultisigFeePayerSeed: mockPostDetachedSignatureFeePayerSeed,
+			},
+			wantErr: true,
+		},
+		{
+			name: "PostDetachedSignature:Broadcast",
+			fields: fields{
+				BlockService:                 &mockLiquidTransactionBlockServiceSuccess{},
+				PendingTransactionService:    &mockPostDetachedSignaturePendingTxServiceEnough{},
+				TransactionService:           &mockPostDetachedSignatureTransactionServiceSuccess{},
+				DetachedMultisigFeePayerSeed: mockPostDetachedSignatureFeePayerSeed,
+			},
+			want: &model.PostDetachedSignatureResponse{
+				TransactionHash:   mockPostDetachedSignatureTxHash,
+				SignaturesWeight:  2,
+				MinimumSignatures: 2,
+			},
+			wantMultisigTxBytes:    true,
+			wantMultisigTxSignedBy: mockPostDetachedSignatureFeePayerAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms := &MultisigService{
+				BlockService:                 tt.fields.BlockService,
+				PendingTransactionService:    tt.fields.PendingTransactionService,
+				TransactionService:           tt.fields.TransactionService,
+				ActionTypeSwitcher:           &mockTypeSwitcherSuccess{},
+				TransactionUtil:              &transaction.Util{},
+				Signature:                    crypto.NewSignature(),
+				DetachedMultisigFeePayerSeed: tt.fields.DetachedMultisigFeePayerSeed,
+			}
+			got, err := ms.PostDetachedSignature(&model.PostDetachedSignatureRequest{
+				TransactionHash: mockPostDetachedSignatureTxHash,
+				AccountAddress:  txAPISenderAccount1,
+				Signature:       []byte{1},
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PostDetachedSignature() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if (len(got.GetMultisigTransactionBytes()) > 0) != tt.wantMultisigTxBytes {
+				t.Errorf("PostDetachedSignature() multisig tx bytes = %v, wantMultisigTxBytes %v",
+					got.GetMultisigTransactionBytes(), tt.wantMultisigTxBytes)
+				return
+			}
+			if tt.wantMultisigTxBytes {
+				multisigTx, err := (&transaction.Util{MempoolCacheStorage: storage.NewMempoolStorage()}).ParseTransactionBytes(
+					got.GetMultisigTransactionBytes(),
+					tt.wantMultisigTxSignedBy != nil,
+				)
+				if err != nil {
+					t.Errorf("PostDetachedSignature() multisig tx bytes not parsable: %v", err)
+					return
+				}
+				wantSender := tt.wantMultisigTxSignedBy
+				if wantSender == nil {
+					wantSender = txAPISenderAccount1
+				}
+				if !bytes.Equal(multisigTx.GetSenderAccountAddress(), wantSender) {
+					t.Errorf("PostDetachedSignature() multisig tx sender = %v, want %v", multisigTx.GetSenderAccountAddress(), wantSender)
+					return
+				}
+			}
+			got.MultisigTransactionBytes = nil
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PostDetachedSignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -52,8 +52,11 @@ package service
 import (
 	"database/sql"
 	"math"
+	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/zoobc/zoobc-core/common/blocker"
 	"github.com/zoobc/zoobc-core/common/chaintype"
 	"github.com/zoobc/zoobc-core/common/constant"
 	"github.com/zoobc/zoobc-core/common/crypto"
@@ -61,7 +64,9 @@ import (
 	"github.com/zoobc/zoobc-core/common/model"
 	"github.com/zoobc/zoobc-core/common/monitoring"
 	"github.com/zoobc/zoobc-core/common/query"
+	"github.com/zoobc/zoobc-core/common/storage"
 	"github.com/zoobc/zoobc-core/common/transaction"
+	commonUtils "github.com/zoobc/zoobc-core/common/util"
 	"github.com/zoobc/zoobc-core/core/service"
 	"github.com/zoobc/zoobc-core/observer"
 	"google.golang.org/grpc/codes"
@@ -74,21 +79,35 @@ type (
 		GetTransaction(chaintype.ChainType, *model.GetTransactionRequest) (*model.Transaction, error)
 		GetTransactions(chaintype.ChainType, *model.GetTransactionsRequest) (*model.GetTransactionsResponse, error)
 		PostTransaction(chaintype.ChainType, *model.PostTransactionRequest) (*model.Transaction, error)
+		PostTransactions(chaintype.ChainType, *model.PostTransactionsRequest) (*model.PostTransactionsResponse, error)
 		GetTransactionMinimumFee(request *model.GetTransactionMinimumFeeRequest) (
 			*model.GetTransactionMinimumFeeResponse, error,
 		)
+		SimulateTransaction(chaintype.ChainType, *model.SimulateTransactionRequest) (*model.SimulateTransactionResponse, error)
+		EstimateTransactionFee(chaintype.ChainType, *model.EstimateTransactionFeeRequest) (*model.EstimateTransactionFeeResponse, error)
 	}
 
 	// TransactionService represents struct of TransactionService
 	TransactionService struct {
-		Query              query.ExecutorInterface
-		Signature          crypto.SignatureInterface
-		ActionTypeSwitcher transaction.TypeActionSwitcher
-		MempoolService     service.MempoolServiceInterface
-		Observer           *observer.Observer
-		TransactionUtil    transaction.UtilInterface
-		FeedbackStrategy   feedbacksystem.FeedbackStrategyInterface
-		Logger             *log.Logger
+		Query                   query.ExecutorInterface
+		Signature               crypto.SignatureInterface
+		ActionTypeSwitcher      transaction.TypeActionSwitcher
+		MempoolService          service.MempoolServiceInterface
+		Observer                *observer.Observer
+		TransactionUtil         transaction.UtilInterface
+		FeedbackStrategy        feedbacksystem.FeedbackStrategyInterface
+		Logger                  *log.Logger
+		NodeRegistrationService service.NodeRegistrationServiceInterface
+		NodeAddressInfoService  service.NodeAddressInfoServiceInterface
+		simulationLimiter       simulationLimiter
+	}
+
+	// simulationLimiter allow a single transaction simulation at a time, no more often than
+	// constant.SimulateTransactionMinInterval, as it holds the db write lock while running
+	simulationLimiter struct {
+		sync.Mutex
+		isRunning bool
+		lastStart time.Time
 	}
 )
 
@@ -104,17 +123,21 @@ func NewTransactionService(
 	transactionUtil transaction.UtilInterface,
 	feedbackStrategy feedbacksystem.FeedbackStrategyInterface,
 	logger *log.Logger,
+	nodeRegistrationService service.NodeRegistrationServiceInterface,
+	nodeAddressInfoService service.NodeAddressInfoServiceInterface,
 ) *TransactionService {
 	if transactionServiceInstance == nil {
 		transactionServiceInstance = &TransactionService{
-			Query:              queryExecutor,
-			Signature:          signature,
-			ActionTypeSwitcher: txTypeSwitcher,
-			MempoolService:     mempoolService,
-			Observer:           observer,
-			TransactionUtil:    transactionUtil,
-			FeedbackStrategy:   feedbackStrategy,
-			Logger:             logger,
+			Query:                   queryExecutor,
+			Signature:               signature,
+			ActionTypeSwitcher:      txTypeSwitcher,
+			MempoolService:          mempoolService,
+			Observer:                observer,
+			TransactionUtil:         transactionUtil,
+			FeedbackStrategy:        feedbackStrategy,
+			Logger:                  logger,
+			NodeRegistrationService: nodeRegistrationService,
+			NodeAddressInfoService:  nodeAddressInfoService,
 		}
 	}
 	return transactionServiceInstance
@@ -260,6 +283,7 @@ func (ts *TransactionService) GetTransactions(
 			&tx.TransactionIndex,
 			&tx.MultisigChild,
 			&tx.Message,
+			&tx.ReplacedTransactionID,
 		)
 		if err != nil {
 			if err != sql.ErrNoRows {
@@ -287,12 +311,13 @@ func (ts *TransactionService) PostTransaction(
 	req *model.PostTransactionRequest,
 ) (*model.Transaction, error) {
 	var (
-		txBytes = req.GetTransactionBytes()
-		txType  transaction.TypeAction
-		tx      *model.Transaction
-		err     error
+		txBytes        = req.GetTransactionBytes()
+		txType         transaction.TypeAction
+		tx             *model.Transaction
+		err, rejectErr error
 		tpsProcessed,
 		tpsReceived int
+		replaced                    *storage.MempoolCacheObject
 		isDbTransactionHighPriority = false
 	)
 
@@ -360,32 +385,21 @@ func (ts *TransactionService) PostTransaction(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	// TODO: repetitive way
-	escrowable, ok := txType.Escrowable()
-	switch ok {
-	case true:
-		err = escrowable.EscrowApplyUnconfirmed()
-	default:
-		err = txType.ApplyUnconfirmed()
-	}
+	// apply unconfirmed and save to mempool, evicting last the transaction this one replaces if any
+	replaced, rejectErr, err = ts.MempoolService.ApplyMempoolTransaction(tx, txType, txBytes)
 	if err != nil {
-		errRollback := ts.Query.RollbackTx(isDbTransactionHighPriority)
-		if errRollback != nil {
-			return nil, status.Error(codes.Internal, errRollback.Error())
-		}
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, ts.rollbackReplacement(replaced, isDbTransactionHighPriority, err)
 	}
-	// Save to mempool
-	err = ts.MempoolService.AddMempoolTransaction(tx, txBytes)
-	if err != nil {
-		errRollback := ts.Query.RollbackTx(isDbTransactionHighPriority)
-		if errRollback != nil {
-			return nil, status.Error(codes.Internal, errRollback.Error())
-		}
-		return nil, status.Error(codes.Internal, err.Error())
+	if rejectErr != nil {
+		return nil, ts.rollbackReplacement(nil, isDbTransactionHighPriority, rejectErr)
 	}
 	err = ts.Query.CommitTx(isDbTransactionHighPriority)
 	if err != nil {
+		if replaced != nil {
+			if errInit := ts.MempoolService.InitMempoolTransaction(); errInit != nil {
+				ts.Logger.Error(errInit.Error())
+			}
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -403,6 +417,151 @@ func (ts *TransactionService) PostTransaction(
 	return tx, nil
 }
 
+// rollbackReplacement rollback the db transaction posting a transaction and return the grpc error of err. The transaction
+// replaced in the meantime, if any, is back in the mempool table then reloaded in cache too
+func (ts *TransactionService) rollbackReplacement(
+	replaced *storage.MempoolCacheObject,
+	isDbTransactionHighPriority bool,
+	err error,
+) error {
+	errRollback := ts.Query.RollbackTx(isDbTransactionHighPriority)
+	if errRollback != nil {
+		return status.Error(codes.Internal, errRollback.Error())
+	}
+	if replaced != nil {
+		if errInit := ts.MempoolService.InitMempoolTransaction(); errInit != nil {
+			return status.Error(codes.Internal, errInit.Error())
+		}
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// PostTransactions validate and add a batch of transactions to the mempool, holding the db lock once for the whole batch.
+// A rejected transaction doesn't fail the others, the response holds the result of each transaction in the request order
+func (ts *TransactionService) PostTransactions(
+	chaintype chaintype.ChainType,
+	req *model.PostTransactionsRequest,
+) (*model.PostTransactionsResponse, error) {
+	var (
+		txBytesList                 = req.GetTransactionBytes()
+		results                     = make([]*model.PostTransactionResult, len(txBytesList))
+		txs                         = make([]*model.Transaction, len(txBytesList))
+		txTypes                     = make([]transaction.TypeAction, len(txBytesList))
+		batchTxIDs                  = make(map[int64]bool)
+		validCount                  int
+		lastReplaced                *storage.MempoolCacheObject
+		isDbTransactionHighPriority = false
+	)
+	if len(txBytesList) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "TransactionBytes is required")
+	}
+	if uint32(len(txBytesList)) > constant.MaxAPILimitPerPage {
+		return nil, status.Errorf(codes.OutOfRange, "Limit exceeded, max. %d", constant.MaxAPILimitPerPage)
+	}
+	if limitReached, limitLevel := ts.FeedbackStrategy.IsCPULimitReached(constant.FeedbackCPUMinSamples); limitReached {
+		if limitLevel == constant.FeedbackLimitHigh || limitLevel == constant.FeedbackLimitCritical {
+			ts.Logger.Debug("Txs batch dropped due to high cpu usage")
+			monitoring.IncreaseTxFiltered()
+			return nil, status.Error(codes.Unavailable, "Service is currently not available")
+		}
+	}
+
+	// parsing and mempool validation don't need the db lock
+	for i, txBytes := range txBytesList {
+		ts.FeedbackStrategy.IncrementVarCount("txReceived")
+		monitoring.IncreaseTxReceived()
+
+		results[i] = &model.PostTransactionResult{}
+		tx, err := ts.TransactionUtil.ParseTransactionBytes(txBytes, true)
+		if err != nil {
+			rejectPostedTransaction(results[i], err)
+			continue
+		}
+		results[i].TransactionID = tx.GetID()
+		if batchTxIDs[tx.GetID()] {
+			rejectPostedTransaction(results[i], blocker.NewBlocker(blocker.DuplicateMempoolErr, "BatchDuplicated"))
+			continue
+		}
+		batchTxIDs[tx.GetID()] = true
+		txType, err := ts.ActionTypeSwitcher.GetTransactionType(tx)
+		if err != nil {
+			rejectPostedTransaction(results[i], err)
+			continue
+		}
+		if err = ts.MempoolService.ValidateMempoolTransaction(tx); err != nil {
+			rejectPostedTransaction(results[i], err)
+			continue
+		}
+		txs[i], txTypes[i] = tx, txType
+		validCount++
+	}
+	if validCount == 0 {
+		return &model.PostTransactionsResponse{
+			Results: results,
+		}, nil
+	}
+
+	err := ts.Query.BeginTx(isDbTransactionHighPriority, monitoring.PostTransactionServiceOwnerProcess)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for i, tx := range txs {
+		if tx == nil {
+			continue
+		}
+		var (
+			rejectErr error
+			replaced  *storage.MempoolCacheObject
+		)
+		// validated again against the db transaction state, so that the transactions of the batch already applied are
+		// accounted for. rejectErr only rejects this transaction, err aborts the whole batch
+		replaced, rejectErr, err = ts.MempoolService.ApplyMempoolTransaction(tx, txTypes[i], txBytesList[i])
+		if replaced != nil {
+			lastReplaced = replaced
+		}
+		if err != nil {
+			return nil, ts.rollbackReplacement(lastReplaced, isDbTransactionHighPriority, err)
+		}
+		if rejectErr != nil {
+			rejectPostedTransaction(results[i], rejectErr)
+			txs[i] = nil
+		}
+	}
+	err = ts.Query.CommitTx(isDbTransactionHighPriority)
+	if err != nil {
+		if lastReplaced != nil {
+			if errInit := ts.MempoolService.InitMempoolTransaction(); errInit != nil {
+				ts.Logger.Error(errInit.Error())
+			}
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for i, tx := range txs {
+		if tx == nil {
+			continue
+		}
+		tpsProcessed := ts.FeedbackStrategy.IncrementVarCount("tpsProcessedTmp").(int)
+		monitoring.SetTpsProcessed(tpsProcessed)
+		ts.FeedbackStrategy.IncrementVarCount("txProcessed")
+		monitoring.IncreaseTxProcessed()
+
+		ts.Observer.Notify(observer.TransactionAdded, txBytesList[i], chaintype)
+	}
+	return &model.PostTransactionsResponse{
+		Results: results,
+	}, nil
+}
+
+// rejectPostedTransaction set the result of a transaction that has not been added to the mempool
+func rejectPostedTransaction(result *model.PostTransactionResult, err error) {
+	result.Status = model.PostTransactionStatus_PostTransactionInvalid
+	if blockerErr, ok := err.(blocker.Blocker); ok && blockerErr.Type == blocker.DuplicateMempoolErr {
+		result.Status = model.PostTransactionStatus_PostTransactionDuplicate
+	}
+	result.Error = err.Error()
+}
+
 func (ts *TransactionService) GetTransactionMinimumFee(req *model.GetTransactionMinimumFeeRequest) (
 	*model.GetTransactionMinimumFeeResponse, error,
 ) {
@@ -427,3 +586,363 @@ func (ts *TransactionService) GetTransactionMinimumFee(req *model.GetTransaction
 		Fee: minFee,
 	}, nil
 }
+
+// EstimateTransactionFee suggest the fees of a transaction for the next block, within five blocks and economy confirmation
+// targets. Each target has to outbid the transactions waiting in mempool that would fill the blocks before, and to be on par
+// with the lowest fee per byte included by the recent blocks: the highest of them for the next block, their average within five
+// blocks and the lowest of them for economy
+func (ts *TransactionService) EstimateTransactionFee(
+	chainType chaintype.ChainType,
+	req *model.EstimateTransactionFeeRequest,
+) (*model.EstimateTransactionFeeResponse, error) {
+	var (
+		txBytes                          = req.GetTransactionBytes()
+		recentLowestFeePerByte           []int64
+		nextBlock, withinBlocks, economy int64
+	)
+	tx, err := ts.TransactionUtil.ParseTransactionBytes(txBytes, true)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	txType, err := ts.ActionTypeSwitcher.GetTransactionType(tx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	minFee, err := txType.GetMinimumFee()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	recentLowestFeePerByte, err = ts.getRecentBlocksLowestFeePerByte(chainType)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, target := range []struct {
+		numberOfBlocks int
+		estimate       *int64
+	}{
+		{numberOfBlocks: 1, estimate: &nextBlock},
+		{numberOfBlocks: constant.FeeEstimationWithinBlocks, estimate: &withinBlocks},
+		{numberOfBlocks: constant.FeeEstimationEconomyBlocks, estimate: &economy},
+	} {
+		*target.estimate, err = ts.MempoolService.GetFeePerByteToBeSelected(target.numberOfBlocks)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	if len(recentLowestFeePerByte) > 0 {
+		var highest, lowest, total = recentLowestFeePerByte[0], recentLowestFeePerByte[0], int64(0)
+		for _, feePerByte := range recentLowestFeePerByte {
+			if feePerByte > highest {
+				highest = feePerByte
+			}
+			if feePerByte < lowest {
+				lowest = feePerByte
+			}
+			total += feePerByte
+		}
+		nextBlock = commonUtils.MaxInt64(nextBlock, highest)
+		withinBlocks = commonUtils.MaxInt64(withinBlocks, total/int64(len(recentLowestFeePerByte)))
+		economy = commonUtils.MaxInt64(economy, lowest)
+	}
+	return &model.EstimateTransactionFeeResponse{
+		MinimumFee:       minFee,
+		NextBlock:        buildFeeEstimate(nextBlock, minFee, txBytes),
+		WithinFiveBlocks: buildFeeEstimate(withinBlocks, minFee, txBytes),
+		Economy:          buildFeeEstimate(economy, minFee, txBytes),
+	}, nil
+}
+
+// getRecentBlocksLowestFeePerByte return the lowest fee per byte included by each of the recent blocks having transactions
+func (ts *TransactionService) getRecentBlocksLowestFeePerByte(chainType chaintype.ChainType) ([]int64, error) {
+	var (
+		txs            []*model.Transaction
+		lowestByHeight = make(map[uint32]int64)
+		heights        []uint32
+		fromHeight     uint32 = 1
+		rows           *sql.Rows
+	)
+	lastBlock, err := commonUtils.GetLastBlock(ts.Query, query.NewBlockQuery(chainType))
+	if err != nil {
+		return nil, err
+	}
+	if lastBlock.GetHeight() > constant.FeeEstimationRecentBlocks {
+		fromHeight = lastBlock.GetHeight() - constant.FeeEstimationRecentBlocks + 1
+	}
+	txQuery := query.NewTransactionQuery(chainType)
+	qry, args := txQuery.GetTransactionsFromBlockHeight(fromHeight)
+	rows, err = ts.Query.ExecuteSelect(qry, false, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	txs, err = txQuery.BuildModel(txs, rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range txs {
+		txBytes, err := ts.TransactionUtil.GetTransactionBytes(tx, true)
+		if err != nil {
+			return nil, err
+		}
+		feePerByte := commonUtils.FeePerByteTransaction(tx.GetFee(), txBytes)
+		lowest, ok := lowestByHeight[tx.GetHeight()]
+		if !ok {
+			heights = append(heights, tx.GetHeight())
+		}
+		if !ok || feePerByte < lowest {
+			lowestByHeight[tx.GetHeight()] = feePerByte
+		}
+	}
+	lowestFeePerByte := make([]int64, len(heights))
+	for i, height := range heights {
+		lowestFeePerByte[i] = lowestByHeight[height]
+	}
+	return lowestFeePerByte, nil
+}
+
+// buildFeeEstimate convert a fee per byte to the fee of the transaction, rounded up and never lower than the minimum fee
+func buildFeeEstimate(feePerByte, minFee int64, txBytes []byte) *model.FeeEstimate {
+	fee := (feePerByte*int64(len(txBytes)) + constant.OneFeePerByteTransaction - 1) / constant.OneFeePerByteTransaction
+	if fee < minFee {
+		fee = minFee
+	}
+	return &model.FeeEstimate{
+		FeePerByte: commonUtils.FeePerByteTransaction(fee, txBytes),
+		Fee:        fee,
+	}
+}
+
+// SimulateTransaction dry run a signed transaction as if it was included in the next block: it goes through the mempool
+// validation, then Validate, ApplyUnconfirmed and ApplyConfirmed inside a db transaction that is always rolled back.
+// The mempool is only read. A transaction that would be rejected is not an error, the response tells why instead of
+// holding the changes
+func (ts *TransactionService) SimulateTransaction(
+	chainType chaintype.ChainType,
+	req *model.SimulateTransactionRequest,
+) (*model.SimulateTransactionResponse, error) {
+	var (
+		response                    = &model.SimulateTransactionResponse{}
+		isDbTransactionHighPriority = false
+	)
+	if !ts.simulationLimiter.acquire() {
+		return nil, status.Error(codes.ResourceExhausted, "transaction simulations are limited")
+	}
+	defer ts.simulationLimiter.release()
+	tx, err := ts.TransactionUtil.ParseTransactionBytes(req.GetTransactionBytes(), true)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	lastBlock, err := commonUtils.GetLastBlock(ts.Query, query.NewBlockQuery(chainType))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	tx.Height = lastBlock.GetHeight() + 1
+	txType, err := ts.ActionTypeSwitcher.GetTransactionType(tx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	response.Transaction = tx
+	response.MinimumFee, err = txType.GetMinimumFee()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err = ts.MempoolService.ValidateMempoolTransaction(tx); err != nil {
+		response.RejectionReason = err.Error()
+		return response, nil
+	}
+
+	err = ts.Query.BeginTx(isDbTransactionHighPriority, monitoring.SimulateTransactionServiceOwnerProcess)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// node registry caches are written by node registration transactions, keep their changes out of the committed values
+	if err = ts.beginSimulationCacheTransaction(); err != nil {
+		if errRollback := ts.Query.RollbackTx(isDbTransactionHighPriority); errRollback != nil {
+			ts.Logger.Error(errRollback.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	rejectErr := ts.freeSimulatedReplacement(tx)
+	if rejectErr == nil {
+		rejectErr = ts.applySimulatedTransaction(txType)
+	}
+	if rejectErr != nil {
+		response.RejectionReason = rejectErr.Error()
+	} else {
+		response.Accepted = true
+		err = ts.getSimulatedChanges(tx, response)
+	}
+	// nothing done by the simulation is ever committed
+	errRollback := ts.rollbackSimulationCacheTransaction()
+	if errRollbackTx := ts.Query.RollbackTx(isDbTransactionHighPriority); errRollbackTx != nil {
+		errRollback = errRollbackTx
+	}
+	if errRollback != nil {
+		return nil, status.Error(codes.Internal, errRollback.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return response, nil
+}
+
+func (sl *simulationLimiter) acquire() bool {
+	sl.Lock()
+	defer sl.Unlock()
+	if sl.isRunning || time.Since(sl.lastStart) < constant.SimulateTransactionMinInterval {
+		return false
+	}
+	sl.isRunning = true
+	sl.lastStart = time.Now()
+	return true
+}
+
+func (sl *simulationLimiter) release() {
+	sl.Lock()
+	sl.isRunning = false
+	sl.Unlock()
+}
+
+func (ts *TransactionService) beginSimulationCacheTransaction() error {
+	if err := ts.NodeRegistrationService.BeginCacheTransaction(); err != nil {
+		return err
+	}
+	if err := ts.NodeAddressInfoService.BeginCacheTransaction(); err != nil {
+		_ = ts.NodeRegistrationService.RollbackCacheTransaction()
+		return err
+	}
+	return nil
+}
+
+func (ts *TransactionService) rollbackSimulationCacheTransaction() error {
+	errNodeRegistry := ts.NodeRegistrationService.RollbackCacheTransaction()
+	if err := ts.NodeAddressInfoService.RollbackCacheTransaction(); err != nil {
+		return err
+	}
+	return errNodeRegistry
+}
+
+// freeSimulatedReplacement undo the unconfirmed effects of the mempool transaction the simulated one would replace, so
+// its spendable balance is freed. The mempool is left untouched, must be called inside db transaction scope
+func (ts *TransactionService) freeSimulatedReplacement(tx *model.Transaction) error {
+	replaced, err := ts.MempoolService.GetReplacedMempoolTransaction(tx)
+	if err != nil || replaced == nil {
+		return err
+	}
+	replacedTxType, err := ts.ActionTypeSwitcher.GetTransactionType(&replaced.Tx)
+	if err != nil {
+		return err
+	}
+	if escrowable, ok := replacedTxType.Escrowable(); ok {
+		return escrowable.EscrowUndoApplyUnconfirmed()
+	}
+	return replacedTxType.UndoApplyUnconfirmed()
+}
+
+// applySimulatedTransaction follow the path of a transaction posted to the mempool then included in a block: Validate,
+// ApplyUnconfirmed then ApplyConfirmed, the unconfirmed effects being undone on inclusion as ApplyConfirmed holds the
+// spendable balance too. Must be called inside db transaction scope
+func (ts *TransactionService) applySimulatedTransaction(txType transaction.TypeAction) error {
+	var (
+		blockTimestamp = time.Now().Unix()
+		steps          = []func() error{
+			func() error { return txType.Validate(true) },
+			txType.ApplyUnconfirmed,
+			txType.UndoApplyUnconfirmed,
+			func() error { return txType.ApplyConfirmed(blockTimestamp) },
+		}
+	)
+	escrowable, ok := txType.Escrowable()
+	if ok {
+		steps = []func() error{
+			func() error { return escrowable.EscrowValidate(true) },
+			escrowable.EscrowApplyUnconfirmed,
+			escrowable.EscrowUndoApplyUnconfirmed,
+			func() error { return escrowable.EscrowApplyConfirmed(blockTimestamp) },
+		}
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getSimulatedChanges read back what the simulated transaction has written, must be called inside db transaction scope
+func (ts *TransactionService) getSimulatedChanges(tx *model.Transaction, response *model.SimulateTransactionResponse) error {
+	var (
+		err                error
+		accountLedgerQuery = query.NewAccountLedgerQuery()
+		ledgerCaseQuery    = query.NewCaseQuery()
+	)
+	ledgerCaseQuery.Select(accountLedgerQuery.TableName, accountLedgerQuery.Fields...)
+	ledgerCaseQuery.Where(ledgerCaseQuery.Equal("transaction_id", tx.GetID()))
+	err = ts.selectSimulatedRecords(&ledgerCaseQuery, func(rows *sql.Rows) (err error) {
+		response.AccountLedgers, err = accountLedgerQuery.BuildModel([]*model.AccountLedger{}, rows)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// versioned records written by the simulated transaction are the only ones at the next block height
+	accountBalanceQuery := query.NewAccountBalanceQuery()
+	err = ts.selectSimulatedRecords(
+		latestAtHeightCaseQuery(accountBalanceQuery.TableName, accountBalanceQuery.Fields, "block_height", tx.GetHeight()),
+		func(rows *sql.Rows) (err error) {
+			response.AccountBalances, err = accountBalanceQuery.BuildModel([]*model.AccountBalance{}, rows)
+			return err
+		},
+	)
+	if err != nil {
+		return err
+	}
+	accountDatasetQuery := query.NewAccountDatasetsQuery()
+	err = ts.selectSimulatedRecords(
+		latestAtHeightCaseQuery(accountDatasetQuery.TableName, accountDatasetQuery.Fields, "height", tx.GetHeight()),
+		func(rows *sql.Rows) (err error) {
+			response.AccountDatasets, err = accountDatasetQuery.BuildModel([]*model.AccountDataset{}, rows)
+			return err
+		},
+	)
+	if err != nil {
+		return err
+	}
+	escrowQuery := query.NewEscrowTransactionQuery()
+	err = ts.selectSimulatedRecords(
+		latestAtHeightCaseQuery(escrowQuery.TableName, escrowQuery.Fields, "block_height", tx.GetHeight()),
+		func(rows *sql.Rows) (err error) {
+			response.Escrows, err = escrowQuery.BuildModels(rows)
+			return err
+		},
+	)
+	if err != nil {
+		return err
+	}
+	liquidPaymentQuery := query.NewLiquidPaymentTransactionQuery()
+	return ts.selectSimulatedRecords(
+		latestAtHeightCaseQuery(liquidPaymentQuery.TableName, liquidPaymentQuery.Fields, "block_height", tx.GetHeight()),
+		func(rows *sql.Rows) (err error) {
+			response.LiquidPayments, err = liquidPaymentQuery.BuildModels(rows)
+			return err
+		},
+	)
+}
+
+func (ts *TransactionService) selectSimulatedRecords(caseQuery *query.CaseQuery, buildModels func(*sql.Rows) error) error {
+	selectQuery, args := caseQuery.Build()
+	rows, err := ts.Query.ExecuteSelect(selectQuery, true, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	return buildModels(rows)
+}
+
+func latestAtHeightCaseQuery(tableName string, fields []string, heightColumn string, height uint32) *query.CaseQuery {
+	caseQuery := query.NewCaseQuery()
+	caseQuery.Select(tableName, fields...)
+	caseQuery.Where(caseQuery.Equal(heightColumn, height)).And(caseQuery.Equal("latest", true))
+	return &caseQuery
+}
@@ -55,8 +55,13 @@ import (
 	"database/sql"
 	"errors"
 	"reflect"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
 	"github.com/zoobc/zoobc-core/common/crypto"
 	"github.com/zoobc/zoobc-core/common/feedbacksystem"
 	"github.com/zoobc/zoobc-core/common/queue"
@@ -70,6 +75,8 @@ import (
 	"github.com/zoobc/zoobc-core/common/transaction"
 	"github.com/zoobc/zoobc-core/core/service"
 	"github.com/zoobc/zoobc-core/observer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // resetTransactionService resets the singleton back to nil, used in test case teardown
@@ -102,6 +109,9 @@ type (
 	mockMempoolServiceFailValidate struct {
 		service.MempoolService
 	}
+	mockMempoolServiceFailApply struct {
+		service.MempoolService
+	}
 	mockMempoolServiceSuccess struct {
 		service.MempoolService
 	}
@@ -174,6 +184,20 @@ func (*mockMempoolServiceFailAdd) ValidateMempoolTransaction(mpTx *model.Transac
 	return nil
 }
 
+func (*mockMempoolServiceFailAdd) ApplyMempoolTransaction(*model.Transaction, transaction.TypeAction, []byte,
+) (*storage.MempoolCacheObject, error, error) {
+	return nil, errors.New("mockError:addTxFail"), nil
+}
+
+func (*mockMempoolServiceFailApply) ValidateMempoolTransaction(mpTx *model.Transaction) error {
+	return nil
+}
+
+func (*mockMempoolServiceFailApply) ApplyMempoolTransaction(*model.Transaction, transaction.TypeAction, []byte,
+) (*storage.MempoolCacheObject, error, error) {
+	return nil, nil, errors.New("mockError:applyTxFail")
+}
+
 func (*mockMempoolServiceFailValidate) ValidateMempoolTransaction(mpTx *model.Transaction) error {
 	return errors.New("mockedError")
 }
@@ -186,6 +210,15 @@ func (*mockMempoolServiceSuccess) ValidateMempoolTransaction(mpTx *model.Transac
 	return nil
 }
 
+func (*mockMempoolServiceSuccess) ApplyMempoolTransaction(_ *model.Transaction, txType transaction.TypeAction, _ []byte,
+) (*storage.MempoolCacheObject, error, error) {
+	return nil, txType.ApplyUnconfirmed(), nil
+}
+
+func (*mockMempoolServiceSuccess) GetReplacedMempoolTransaction(*model.Transaction) (*storage.MempoolCacheObject, error) {
+	return nil, nil
+}
+
 func (*mockGetTransactionExecutorTxsFail) ExecuteSelect(query string, tx bool, args ...interface{}) (*sql.Rows, error) {
 	return nil, errors.New("mockError:getTxsFail")
 }
@@ -275,6 +308,8 @@ func TestNewTransactionService(t *testing.T) {
 				transactionUtil,
 				nil,
 				nil,
+				nil,
+				nil,
 			); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewTransactionService() = %v, want %v", got, tt.want)
 			}
@@ -317,6 +352,10 @@ func (*mockMempoolServicePostApprovalEscrowTXSuccess) ValidateMempoolTransaction
 func (*mockMempoolServicePostApprovalEscrowTXSuccess) AddMempoolTransaction(tx *model.Transaction, txBytes []byte) error {
 	return nil
 }
+func (*mockMempoolServicePostApprovalEscrowTXSuccess) ApplyMempoolTransaction(*model.Transaction, transaction.TypeAction, []byte,
+) (*storage.MempoolCacheObject, error, error) {
+	return nil, nil, nil
+}
 
 type (
 	mockCacheStorageAlwaysSuccess struct {
@@ -495,6 +534,27 @@ func TestTransactionService_PostTransaction(t *testing.T) {
 			wantErr: true,
 			want:    nil,
 		},
+		{
+			name: "PostTransaction:ApplyMempoolTransactionFail",
+			fields: fields{
+				Query:              &mockTransactionExecutorSuccess{},
+				ActionTypeSwitcher: &mockTypeSwitcherSuccess{},
+				MempoolService:     &mockMempoolServiceFailApply{},
+				Log:                mockLog,
+				TransactionUtil: &transaction.Util{
+					MempoolCacheStorage: &mockCacheStorageAlwaysSuccess{},
+				},
+				FeedbackStrategy: &feedbacksystem.DummyFeedbackStrategy{},
+			},
+			args: args{
+				chaintype: &chaintype.MainChain{},
+				req: &model.PostTransactionRequest{
+					TransactionBytes: sendZBCTxBytes,
+				},
+			},
+			wantErr: true,
+			want:    nil,
+		},
 		{
 			name: "PostTransaction:txType.AddMempoolTransactionFail",
 			fields: fields{
@@ -663,6 +723,7 @@ func (*mockQueryGetTransactionsSuccess) ExecuteSelect(qStr string, tx bool, args
 				1,
 				false,
 				[]byte{1, 2, 3},
+				0,
 			),
 		)
 	return db.Query("")
@@ -842,6 +903,7 @@ func (*mockQueryGetTransactionSuccess) ExecuteSelectRow(qstr string, tx bool, ar
 				[]byte{0, 0, 0, 0, 0, 0, 0}, 1, 1,
 				false,
 				"",
+				0,
 			),
 	)
 	return db.QueryRow(""), nil
@@ -945,3 +1007,658 @@ func TestTransactionService_GetTransaction(t *testing.T) {
 		})
 	}
 }
+
+type (
+	mockQuerySimulateTransaction struct {
+		mockTransactionExecutorSuccess
+		beginTxErr  error
+		rollbackErr error
+	}
+	mockNodeRegistrationServiceSimulateTransaction struct {
+		service.NodeRegistrationService
+		beginErr error
+	}
+	mockNodeAddressInfoServiceSimulateTransaction struct {
+		service.NodeAddressInfoService
+	}
+	mockMempoolServiceSimulateReplacement struct {
+		mockMempoolServiceSuccess
+	}
+	mockTxTypeSimulateTransaction struct {
+		transaction.TXEmpty
+		validateErr error
+		steps       []string
+	}
+)
+
+func (*mockMempoolServiceSimulateReplacement) GetReplacedMempoolTransaction(*model.Transaction,
+) (*storage.MempoolCacheObject, error) {
+	return &storage.MempoolCacheObject{}, nil
+}
+
+func (*mockMempoolServiceSimulateReplacement) RemoveMempoolTransactions([]*model.Transaction) error {
+	return errors.New("mockError:mempoolChanged")
+}
+
+func (*mockMempoolServiceSimulateReplacement) InitMempoolTransaction() error {
+	return errors.New("mockError:mempoolChanged")
+}
+
+func (m *mockTxTypeSimulateTransaction) Validate(bool) error {
+	m.steps = append(m.steps, "Validate")
+	return m.validateErr
+}
+
+func (m *mockTxTypeSimulateTransaction) ApplyUnconfirmed() error {
+	m.steps = append(m.steps, "ApplyUnconfirmed")
+	return nil
+}
+
+func (m *mockTxTypeSimulateTransaction) UndoApplyUnconfirmed() error {
+	m.steps = append(m.steps, "UndoApplyUnconfirmed")
+	return nil
+}
+
+func (m *mockTxTypeSimulateTransaction) ApplyConfirmed(int64) error {
+	m.steps = append(m.steps, "ApplyConfirmed")
+	return nil
+}
+
+func (m *mockNodeRegistrationServiceSimulateTransaction) BeginCacheTransaction() error {
+	return m.beginErr
+}
+
+func (*mockNodeRegistrationServiceSimulateTransaction) RollbackCacheTransaction() error {
+	return nil
+}
+
+func (*mockNodeAddressInfoServiceSimulateTransaction) BeginCacheTransaction() error {
+	return nil
+}
+
+func (*mockNodeAddressInfoServiceSimulateTransaction) RollbackCacheTransaction() error {
+	return nil
+}
+
+func (m *mockQuerySimulateTransaction) BeginTx(bool, int) error {
+	return m.beginTxErr
+}
+
+func (m *mockQuerySimulateTransaction) RollbackTx(bool) error {
+	return m.rollbackErr
+}
+
+func (*mockQuerySimulateTransaction) ExecuteSelectRow(qStr string, tx bool, args ...interface{}) (*sql.Row, error) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	mock.ExpectQuery(regexp.QuoteMeta(qStr)).
+		WillReturnRows(sqlmock.NewRows(query.NewBlockQuery(&chaintype.MainChain{}).Fields).AddRow(
+			mockGoodBlock.GetHeight(),
+			mockGoodBlock.GetID(),
+			mockGoodBlock.GetBlockHash(),
+			mockGoodBlock.GetPreviousBlockHash(),
+			mockGoodBlock.GetTimestamp(),
+			mockGoodBlock.GetBlockSeed(),
+			mockGoodBlock.GetBlockSignature(),
+			mockGoodBlock.GetCumulativeDifficulty(),
+			mockGoodBlock.GetPayloadLength(),
+			mockGoodBlock.GetPayloadHash(),
+			mockGoodBlock.GetBlocksmithPublicKey(),
+			mockGoodBlock.GetTotalAmount(),
+			mockGoodBlock.GetTotalFee(),
+			mockGoodBlock.GetTotalCoinBase(),
+			mockGoodBlock.GetVersion(),
+			mockGoodBlock.GetMerkleRoot(),
+			mockGoodBlock.GetMerkleTree(),
+			mockGoodBlock.GetReferenceBlockHeight(),
+		))
+	return db.QueryRow(qStr), nil
+}
+
+func (*mockQuerySimulateTransaction) ExecuteSelect(qStr string, tx bool, args ...interface{}) (*sql.Rows, error) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	accountLedgerQuery := query.NewAccountLedgerQuery()
+	rowsMock := sqlmock.NewRows(accountLedgerQuery.Fields)
+	if strings.Contains(qStr, accountLedgerQuery.TableName) {
+		rowsMock.AddRow(txAPISenderAccount1, -11, mockGoodBlock.GetHeight()+1, args[0], model.EventType_EventSendZBCTransaction, 0)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(rowsMock)
+	return db.Query(qStr)
+}
+
+func TestTransactionService_SimulateTransaction(t *testing.T) {
+	_, transactionBytes := transaction.GetFixtureForSpecificTransaction(
+		5298837107897007947,
+		1562806389280,
+		txAPISenderAccount1,
+		txAPIRecipientAccount1,
+		8,
+		model.TransactionType_SendZBCTransaction,
+		&model.SendZBCTransactionBody{
+			Amount: 10,
+		},
+		false,
+		true,
+	)
+	type fields struct {
+		Query                   query.ExecutorInterface
+		ActionTypeSwitcher      transaction.TypeActionSwitcher
+		MempoolService          service.MempoolServiceInterface
+		NodeRegistrationService service.NodeRegistrationServiceInterface
+	}
+	tests := []struct {
+		name                string
+		fields              fields
+		txBytes             []byte
+		wantAccepted        bool
+		wantRejectionReason string
+		wantAccountLedgers  int
+		wantErr             bool
+	}{
+		{
+			name:    "SimulateTransaction:txBytesInvalid",
+			fields:  fields{},
+			txBytes: []byte{1, 2, 3},
+			wantErr: true,
+		},
+		{
+			name: "SimulateTransaction:getLastBlockFail",
+			fields: fields{
+				Query: &mockGetTransactionExecutorTxNoRow{},
+			},
+			txBytes: transactionBytes,
+			wantErr: true,
+		},
+		{
+			name: "SimulateTransaction:mempoolValidationFail",
+			fields: fields{
+				Query:              &mockQuerySimulateTransaction{},
+				ActionTypeSwitcher: &mockTypeSwitcherSuccess{},
+				MempoolService:     &mockMempoolServiceFailValidate{},
+			},
+			txBytes:             transactionBytes,
+			wantRejectionReason: "mockedError",
+		},
+		{
+			name: "SimulateTransaction:beginTxFail",
+			fields: fields{
+				Query:                   &mockQuerySimulateTransaction{beginTxErr: errors.New("mockedError")},
+				ActionTypeSwitcher:      &mockTypeSwitcherSuccess{},
+				MempoolService:          &mockMempoolServiceSuccess{},
+				NodeRegistrationService: &mockNodeRegistrationServiceSimulateTransaction{},
+			},
+			txBytes: transactionBytes,
+			wantErr: true,
+		},
+		{
+			name: "SimulateTransaction:beginCacheTransactionFail",
+			fields: fields{
+				Query:              &mockQuerySimulateTransaction{},
+				ActionTypeSwitcher: &mockTypeSwitcherSuccess{},
+				MempoolService:     &mockMempoolServiceSuccess{},
+				NodeRegistrationService: &mockNodeRegistrationServiceSimulateTransaction{
+					beginErr: errors.New("mockedError"),
+				},
+			},
+			txBytes: transactionBytes,
+			wantErr: true,
+		},
+		{
+			name: "SimulateTransaction:applyUnconfirmedFail",
+			fields: fields{
+				Query:                   &mockQuerySimulateTransaction{},
+				ActionTypeSwitcher:      &mockTypeSwitcherApplyUnconfirmedFail{},
+				MempoolService:          &mockMempoolServiceSuccess{},
+				NodeRegistrationService: &mockNodeRegistrationServiceSimulateTransaction{},
+			},
+			txBytes:             transactionBytes,
+			wantRejectionReason: "mockError:ApplyUnconfirmedFail",
+		},
+		{
+			name: "SimulateTransaction:replacementLeavesMempoolUntouched",
+			fields: fields{
+				Query:                   &mockQuerySimulateTransaction{},
+				ActionTypeSwitcher:      &mockTypeSwitcherSuccess{},
+				MempoolService:          &mockMempoolServiceSimulateReplacement{},
+				NodeRegistrationService: &mockNodeRegistrationServiceSimulateTransaction{},
+			},
+			txBytes:            transactionBytes,
+			wantAccepted:       true,
+			wantAccountLedgers: 1,
+		},
+		{
+			name: "SimulateTransaction:rollbackFail",
+			fields: fields{
+				Query:                   &mockQuerySimulateTransaction{rollbackErr: errors.New("mockedError")},
+				ActionTypeSwitcher:      &mockTypeSwitcherSuccess{},
+				MempoolService:          &mockMempoolServiceSuccess{},
+				NodeRegistrationService: &mockNodeRegistrationServiceSimulateTransaction{},
+			},
+			txBytes: transactionBytes,
+			wantErr: true,
+		},
+		{
+			name: "SimulateTransaction:success",
+			fields: fields{
+				Query:                   &mockQuerySimulateTransaction{},
+				ActionTypeSwitcher:      &mockTypeSwitcherSuccess{},
+				MempoolService:          &mockMempoolServiceSuccess{},
+				NodeRegistrationService: &mockNodeRegistrationServiceSimulateTransaction{},
+			},
+			txBytes:            transactionBytes,
+			wantAccepted:       true,
+			wantAccountLedgers: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := &TransactionService{
+				Query:                   tt.fields.Query,
+				ActionTypeSwitcher:      tt.fields.ActionTypeSwitcher,
+				MempoolService:          tt.fields.MempoolService,
+				NodeRegistrationService: tt.fields.NodeRegistrationService,
+				NodeAddressInfoService:  &mockNodeAddressInfoServiceSimulateTransaction{},
+				TransactionUtil: &transaction.Util{
+					MempoolCacheStorage: &mockCacheStorageAlwaysSuccess{},
+				},
+			}
+			got, err := ts.SimulateTransaction(&chaintype.MainChain{}, &model.SimulateTransactionRequest{
+				TransactionBytes: tt.txBytes,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TransactionService.SimulateTransaction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.GetAccepted() != tt.wantAccepted || got.GetRejectionReason() != tt.wantRejectionReason {
+				t.Errorf("TransactionService.SimulateTransaction() accepted = %v reason = %q, want %v %q",
+					got.GetAccepted(), got.GetRejectionReason(), tt.wantAccepted, tt.wantRejectionReason)
+			}
+			if got.GetTransaction().GetHeight() != mockGoodBlock.GetHeight()+1 {
+				t.Errorf("TransactionService.SimulateTransaction() height = %d, want %d",
+					got.GetTransaction().GetHeight(), mockGoodBlock.GetHeight()+1)
+			}
+			if len(got.GetAccountLedgers()) != tt.wantAccountLedgers {
+				t.Errorf("TransactionService.SimulateTransaction() got %d account ledgers, want %d",
+					len(got.GetAccountLedgers()), tt.wantAccountLedgers)
+			}
+		})
+	}
+}
+
+func TestTransactionService_applySimulatedTransaction(t *testing.T) {
+	tests := []struct {
+		name      string
+		txType    *mockTxTypeSimulateTransaction
+		wantSteps []string
+		wantErr   bool
+	}{
+		{
+			name:      "applySimulatedTransaction:validateFail",
+			txType:    &mockTxTypeSimulateTransaction{validateErr: errors.New("mockedError")},
+			wantSteps: []string{"Validate"},
+			wantErr:   true,
+		},
+		{
+			name:      "applySimulatedTransaction:success",
+			txType:    &mockTxTypeSimulateTransaction{},
+			wantSteps: []string{"Validate", "ApplyUnconfirmed", "UndoApplyUnconfirmed", "ApplyConfirmed"},
+			wantErr:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&TransactionService{}).applySimulatedTransaction(tt.txType)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TransactionService.applySimulatedTransaction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(tt.txType.steps, tt.wantSteps) {
+				t.Errorf("TransactionService.applySimulatedTransaction() steps = %v, want %v", tt.txType.steps, tt.wantSteps)
+			}
+		})
+	}
+}
+
+func TestTransactionService_SimulateTransaction_Limited(t *testing.T) {
+	ts := &TransactionService{}
+	ts.simulationLimiter.isRunning = true
+	_, err := ts.SimulateTransaction(&chaintype.MainChain{}, &model.SimulateTransactionRequest{})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("TransactionService.SimulateTransaction() running simulation error = %v, want ResourceExhausted", err)
+	}
+	ts.simulationLimiter.isRunning = false
+	ts.simulationLimiter.lastStart = time.Now()
+	_, err = ts.SimulateTransaction(&chaintype.MainChain{}, &model.SimulateTransactionRequest{})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("TransactionService.SimulateTransaction() too frequent simulation error = %v, want ResourceExhausted", err)
+	}
+}
+
+type (
+	mockMempoolServiceFeePerByteToBeSelected struct {
+		service.MempoolService
+		feePerByte map[int]int64
+		err        error
+	}
+)
+
+func (m *mockMempoolServiceFeePerByteToBeSelected) GetFeePerByteToBeSelected(numberOfBlocks int) (int64, error) {
+	return m.feePerByte[numberOfBlocks], m.err
+}
+
+func TestTransactionService_EstimateTransactionFee(t *testing.T) {
+	_, transactionBytes := transaction.GetFixtureForSpecificTransaction(
+		5298837107897007947,
+		1562806389280,
+		txAPISenderAccount1,
+		txAPIRecipientAccount1,
+		8,
+		model.TransactionType_SendZBCTransaction,
+		&model.SendZBCTransactionBody{
+			Amount: 10,
+		},
+		false,
+		true,
+	)
+	txBytesLength := int64(len(transactionBytes))
+	type fields struct {
+		Query              query.ExecutorInterface
+		ActionTypeSwitcher transaction.TypeActionSwitcher
+		MempoolService     service.MempoolServiceInterface
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		txBytes []byte
+		want    *model.EstimateTransactionFeeResponse
+		wantErr bool
+	}{
+		{
+			name:    "EstimateTransactionFee:txBytesInvalid",
+			fields:  fields{},
+			txBytes: []byte{1, 2, 3},
+			wantErr: true,
+		},
+		{
+			name: "EstimateTransactionFee:getLastBlockFail",
+			fields: fields{
+				Query:              &mockGetTransactionExecutorTxNoRow{},
+				ActionTypeSwitcher: &mockTypeSwitcherSuccess{},
+			},
+			txBytes: transactionBytes,
+			wantErr: true,
+		},
+		{
+			name: "EstimateTransactionFee:mempoolFail",
+			fields: fields{
+				Query:              &mockQuerySimulateTransaction{},
+				ActionTypeSwitcher: &mockTypeSwitcherSuccess{},
+				MempoolService:     &mockMempoolServiceFeePerByteToBeSelected{err: errors.New("mockedError")},
+			},
+			txBytes: transactionBytes,
+			wantErr: true,
+		},
+		{
+			name: "EstimateTransactionFee:success",
+			fields: fields{
+				Query:              &mockQuerySimulateTransaction{},
+				ActionTypeSwitcher: &mockTypeSwitcherSuccess{},
+				MempoolService: &mockMempoolServiceFeePerByteToBeSelected{
+					feePerByte: map[int]int64{
+						1:                                   2 * constant.OneFeePerByteTransaction,
+						constant.FeeEstimationWithinBlocks:  constant.OneFeePerByteTransaction,
+						constant.FeeEstimationEconomyBlocks: 0,
+					},
+				},
+			},
+			txBytes: transactionBytes,
+			want: &model.EstimateTransactionFeeResponse{
+				MinimumFee: 0,
+				NextBlock: &model.FeeEstimate{
+					FeePerByte: 2 * constant.OneFeePerByteTransaction,
+					Fee:        2 * txBytesLength,
+				},
+				WithinFiveBlocks: &model.FeeEstimate{
+					FeePerByte: constant.OneFeePerByteTransaction,
+					Fee:        txBytesLength,
+				},
+				Economy: &model.FeeEstimate{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := &TransactionService{
+				Query:              tt.fields.Query,
+				ActionTypeSwitcher: tt.fields.ActionTypeSwitcher,
+				MempoolService:     tt.fields.MempoolService,
+				TransactionUtil: &transaction.Util{
+					MempoolCacheStorage: &mockCacheStorageAlwaysSuccess{},
+				},
+			}
+			got, err := ts.EstimateTransactionFee(&chaintype.MainChain{}, &model.EstimateTransactionFeeRequest{
+				TransactionBytes: tt.txBytes,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TransactionService.EstimateTransactionFee() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TransactionService.EstimateTransactionFee() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type (
+	mockMempoolServiceDuplicate struct {
+		service.MempoolService
+	}
+	mockMempoolServiceReplaceInBatch struct {
+		mockMempoolServiceSuccess
+		reloaded bool
+	}
+)
+
+func (*mockMempoolServiceDuplicate) ValidateMempoolTransaction(mpTx *model.Transaction) error {
+	return blocker.NewBlocker(blocker.DuplicateMempoolErr, "MempoolDuplicated")
+}
+
+func (*mockMempoolServiceReplaceInBatch) ApplyMempoolTransaction(_ *model.Transaction, txType transaction.TypeAction, _ []byte,
+) (*storage.MempoolCacheObject, error, error) {
+	return &storage.MempoolCacheObject{}, txType.ApplyUnconfirmed(), nil
+}
+
+func (m *mockMempoolServiceReplaceInBatch) InitMempoolTransaction() error {
+	m.reloaded = true
+	return nil
+}
+
+func TestTransactionService_PostTransactions(t *testing.T) {
+	var (
+		_, firstTxBytes = transaction.GetFixtureForSpecificTransaction(
+			5298837107897007947,
+			1562806389280,
+			txAPISenderAccount1,
+			txAPIRecipientAccount1,
+			8,
+			model.TransactionType_SendZBCTransaction,
+			&model.SendZBCTransactionBody{
+				Amount: 10,
+			},
+			false,
+			true,
+		)
+		_, secondTxBytes = transaction.GetFixtureForSpecificTransaction(
+			5298837107897007948,
+			1562806389281,
+			txAPISenderAccount1,
+			txAPIRecipientAccount1,
+			8,
+			model.TransactionType_SendZBCTransaction,
+			&model.SendZBCTransactionBody{
+				Amount: 20,
+			},
+			false,
+			true,
+		)
+	)
+	type fields struct {
+		Query              query.ExecutorInterface
+		ActionTypeSwitcher transaction.TypeActionSwitcher
+		MempoolService     service.MempoolServiceInterface
+	}
+	tests := []struct {
+		name            string
+		fields          fields
+		txBytesList     [][]byte
+		wantStatuses    []model.PostTransactionStatus
+		wantErr         bool
+		wantMempoolInit bool
+	}{
+		{
+			name:    "PostTransactions:emptyBatch",
+			fields:  fields{},
+			wantErr: true,
+		},
+		{
+			name: "PostTransactions:allInvalid",
+			fields: fields{
+				Query:              &mockTransactionExecutorFailBeginTx{},
+				ActionTypeSwitcher: &mockTypeSwitcherSuccess{},
+				MempoolService:     &mockMempoolServiceFailValidate{},
+			},
+			txBytesList: [][]byte{{1, 2, 3}, firstTxBytes},
+			wantStatuses: []model.PostTransactionStatus{
+				model.PostTransactionStatus_PostTransactionInvalid,
+				model.PostTransactionStatus_PostTransactionInvalid,
+			},
+		},
+		{
+			name: "PostTransactions:duplicateInMempool",
+			fields: fields{
+				Query:              &mockTransactionExecutorFailBeginTx{},
+				ActionTypeSwitcher: &mockTypeSwitcherSuccess{},
+				MempoolService:     &mockMempoolServiceDuplicate{},
+			},
+			txBytesList: [][]byte{firstTxBytes},
+			wantStatuses: []model.PostTransactionStatus{
+				model.PostTransactionStatus_PostTransactionDuplicate,
+			},
+		},
+		{
+			name: "PostTransactions:beginTxFail",
+			fields: fields{
+				Query:              &mockTransactionExecutorFailBeginTx{},
+				ActionTypeSwitcher: &mockTypeSwitcherSuccess{},
+				MempoolService:     &mockMempoolServiceSuccess{},
+			},
+			txBytesList: [][]byte{firstTxBytes},
+			wantErr:     true,
+		},
+		{
+			name: "PostTransactions:applyUnconfirmedFail",
+			fields: fields{
+				Query:              &mockTransactionExecutorSuccess{},
+				ActionTypeSwitcher: &mockTypeSwitcherApplyUnconfirmedFail{},
+				MempoolService:     &mockMempoolServiceSuccess{},
+			},
+			txBytesList: [][]byte{firstTxBytes},
+			wantStatuses: []model.PostTransactionStatus{
+				model.PostTransactionStatus_PostTransactionInvalid,
+			},
+		},
+		{
+			name: "PostTransactions:addMempoolFail",
+			fields: fields{
+				Query:              &mockTransactionExecutorSuccess{},
+				ActionTypeSwitcher: &mockTypeSwitcherSuccess{},
+				MempoolService:     &mockMempoolServiceFailAdd{},
+			},
+			txBytesList: [][]byte{firstTxBytes},
+			wantStatuses: []model.PostTransactionStatus{
+				model.PostTransactionStatus_PostTransactionInvalid,
+			},
+		},
+		{
+			name: "PostTransactions:commitFail",
+			fields: fields{
+				Query:              &mockTransactionExecutorCommitFail{},
+				ActionTypeSwitcher: &mockTypeSwitcherSuccess{},
+				MempoolService:     &mockMempoolServiceSuccess{},
+			},
+			txBytesList: [][]byte{firstTxBytes},
+			wantErr:     true,
+		},
+		{
+			name: "PostTransactions:commitFailAfterReplacement",
+			fields: fields{
+				Query:              &mockTransactionExecutorCommitFail{},
+				ActionTypeSwitcher: &mockTypeSwitcherSuccess{},
+				MempoolService:     &mockMempoolServiceReplaceInBatch{},
+			},
+			txBytesList:     [][]byte{firstTxBytes},
+			wantErr:         true,
+			wantMempoolInit: true,
+		},
+		{
+			name: "PostTransactions:success",
+			fields: fields{
+				Query:              &mockTransactionExecutorSuccess{},
+				ActionTypeSwitcher: &mockTypeSwitcherSuccess{},
+				MempoolService:     &mockMempoolServiceSuccess{},
+			},
+			txBytesList: [][]byte{firstTxBytes, {1, 2, 3}, firstTxBytes, secondTxBytes},
+			wantStatuses: []model.PostTransactionStatus{
+				model.PostTransactionStatus_PostTransactionAccepted,
+				model.PostTransactionStatus_PostTransactionInvalid,
+				model.PostTransactionStatus_PostTransactionDuplicate,
+				model.PostTransactionStatus_PostTransactionAccepted,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := &TransactionService{
+				Query:              tt.fields.Query,
+				ActionTypeSwitcher: tt.fields.ActionTypeSwitcher,
+				MempoolService:     tt.fields.MempoolService,
+				Observer:           observer.NewObserver(),
+				TransactionUtil: &transaction.Util{
+					MempoolCacheStorage: &mockCacheStorageAlwaysSuccess{},
+				},
+				FeedbackStrategy: &feedbacksystem.DummyFeedbackStrategy{},
+				Logger:           mockLog,
+			}
+			got, err := ts.PostTransactions(&chaintype.MainChain{}, &model.PostTransactionsRequest{
+				TransactionBytes: tt.txBytesList,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TransactionService.PostTransactions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if mempoolService, ok := tt.fields.MempoolService.(*mockMempoolServiceReplaceInBatch); ok &&
+				mempoolService.reloaded != tt.wantMempoolInit {
+				t.Errorf("TransactionService.PostTransactions() mempool reloaded = %v, want %v", mempoolService.reloaded, tt.wantMempoolInit)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got.GetResults()) != len(tt.wantStatuses) {
+				t.Fatalf("TransactionService.PostTransactions() got %d results, want %d", len(got.GetResults()), len(tt.wantStatuses))
+			}
+			for i, result := range got.GetResults() {
+				if result.GetStatus() != tt.wantStatuses[i] {
+					t.Errorf("TransactionService.PostTransactions() result %d = %v, want status %v", i, result, tt.wantStatuses[i])
+				}
+				if (result.GetError() == "") != (tt.wantStatuses[i] == model.PostTransactionStatus_PostTransactionAccepted) {
+					t.Errorf("TransactionService.PostTransactions() result %d error = %q", i, result.GetError())
+				}
+			}
+		})
+	}
+}
@@ -62,6 +62,7 @@ import (
 	"github.com/zoobc/zoobc-core/common/crypto"
 	"github.com/zoobc/zoobc-core/common/model"
 	"github.com/zoobc/zoobc-core/common/query"
+	"github.com/zoobc/zoobc-core/common/signaturetype"
 	"github.com/zoobc/zoobc-core/common/transaction"
 	"github.com/zoobc/zoobc-core/common/util"
 )
@@ -91,10 +92,18 @@ private key both in bytes and hex representation + the secret phrase
 		Use:   "ed25519",
 		Short: "Generate account using ed25519 algorithm. This is the default zoobc account",
 	}
+	ed25519HDAccountsCmd = &cobra.Command{
+		Use:   "ed25519-hd",
+		Short: "Derive many ed25519 accounts of a hdwallet seed, along the slip10 path m/44'/883'/account'",
+	}
 	bitcoinAccuntCmd = &cobra.Command{
 		Use:   "bitcoin",
 		Short: "Generate account based on Bitcoin signature that using Elliptic Curve Digital Signature Algorithm",
 	}
+	ethereumAccountCmd = &cobra.Command{
+		Use:   "ethereum",
+		Short: "Generate account from a bip39 mnemonic along the bip44 path of the ethereum wallets (m/44'/60'/account'/0/index)",
+	}
 	convAccuntToHexCmd = &cobra.Command{
 		Use:   "hexconv",
 		Short: "Convert a given (encoded/string) account address to hex format",
@@ -122,6 +131,9 @@ func init() {
 	// ed25519
 	ed25519AccountCmd.Flags().StringVar(&seed, "seed", "", "Seed that is used to generate the account")
 	ed25519AccountCmd.Flags().BoolVar(&ed25519UseSlip10, "use-slip10", false, "use slip10 to generate ed25519 private key")
+	ed25519HDAccountsCmd.Flags().StringVar(&seed, "seed", "", "hdwallet seed that is used to derive the accounts")
+	ed25519HDAccountsCmd.Flags().Uint32Var(&ed25519FromAccount, "from-account", 0, "account index of the first derived account")
+	ed25519HDAccountsCmd.Flags().Uint32Var(&ed25519NumberOfAccounts, "count", 1, "number of accounts to derive")
 	// bitcoin
 	bitcoinAccuntCmd.Flags().StringVar(&seed, "seed", "", "Seed that is used to generate the account")
 	bitcoinAccuntCmd.Flags().Int32Var(
@@ -130,9 +142,17 @@ func init() {
 		int32(model.PrivateKeyBytesLength_PrivateKey256Bits),
 		"The length of private key Bitcoin want to generate. supported format are 32, 48 & 64 length",
 	)
+	// ethereum
+	ethereumAccountCmd.Flags().StringVar(&seed, "seed", "", "bip39 mnemonic that is used to generate the account")
+	ethereumAccountCmd.Flags().StringVar(&ethereumPassword, "password", "", "optional password of the bip39 mnemonic")
+	ethereumAccountCmd.Flags().Uint32Var(&ethereumAccount, "account", 0, "account level of the derivation path")
+	ethereumAccountCmd.Flags().Uint32Var(&ethereumAddressIndex, "address-index", 0, "address index level of the derivation path")
 	convAccuntToHexCmd.Flags().StringVar(&encodedAccountAddress, "encodedAccountAddress", "",
 		"formatted/encoded account address. eg. ZBC_F5YUYDXD_WFDJSAV5_K3Y72RCM_GLQP32XI_QDVXOGGD_J7CGSSSK_5VKR7YML")
 	convAccuntToHexCmd.Flags().Int32Var(&accountTypeInt, "accountType", 0, "Account type num: 0=default, 1=btc, etc..")
+	convAccuntToHexCmd.Flags().StringVar(&ethereumSignature, "signature", "",
+		"hex signature of signedPayload by an ethereum address, to recover the public key of a 20 bytes encodedAccountAddress")
+	convAccuntToHexCmd.Flags().StringVar(&ethereumSignedPayload, "signedPayload", "", "hex payload signed by signature")
 	convHexAccountToEncodedCmd.Flags().StringVar(&hexAccountAddress, "hexAccountAddress", "",
 		"full accound address in hex format: eg. 00000000e1e6ea65267121801089048c3a1dd863aea1fab123977677c612658a749a8a01")
 	generateAccountAddressTableCmd.Flags().StringVar(&dbPath, "dbPath", "../resource",
@@ -141,7 +161,8 @@ func init() {
 		&bitcoinPublicKeyFormat,
 		"public-key-format",
 		int32(model.BitcoinPublicKeyFormat_PublicKeyFormatCompressed),
-		"Defines the format of public key Bitcoin want to generate. 0 for uncompressed format & 1 for compressed format",
+		"Defines the format of public key Bitcoin want to generate. 0 for uncompressed format, 1 for compressed format "+
+			"& 2 for bip-340 x-only format (schnorr signatures)",
 	)
 	// multisig
 	multiSigCmd.Flags().StringSliceVar(&multisigAddressesHex, "addresses", []string{},
@@ -149,6 +170,8 @@ func init() {
 			"remaining bytes = account public key")
 	multiSigCmd.Flags().Uint32Var(&multisigMinimSigs, "min-sigs", 0, "min-sigs that provide minimum signs")
 	multiSigCmd.Flags().Int64Var(&multiSigNonce, "nonce", 0, "nonce that provides")
+	multiSigCmd.Flags().UintSliceVar(&multisigWeights, "weights", []uint{}, "weight of each address, in the order of addresses. "+
+		"Every address weights 1 when not set")
 }
 
 // Commands will return the main generate account cmd
@@ -161,8 +184,12 @@ func Commands() *cobra.Command {
 	}
 	ed25519AccountCmd.Run = accountGeneratorInstance.GenerateEd25519Account()
 	accountCmd.AddCommand(ed25519AccountCmd)
+	ed25519HDAccountsCmd.Run = accountGeneratorInstance.GenerateEd25519HDAccounts()
+	accountCmd.AddCommand(ed25519HDAccountsCmd)
 	bitcoinAccuntCmd.Run = accountGeneratorInstance.GenerateBitcoinAccount()
 	accountCmd.AddCommand(bitcoinAccuntCmd)
+	ethereumAccountCmd.Run = accountGeneratorInstance.GenerateEthereumAccount()
+	accountCmd.AddCommand(ethereumAccountCmd)
 	convAccuntToHexCmd.Run = accountGeneratorInstance.ConvertEncodedAccountAddressToHex()
 	accountCmd.AddCommand(convAccuntToHexCmd)
 	convHexAccountToEncodedCmd.Run = accountGeneratorInstance.ConvertHexAccountToEncoded()
@@ -178,7 +205,15 @@ func Commands() *cobra.Command {
 // GenerateMultiSignatureAccount to generate address for multi signature transaction
 func (gc *GeneratorCommands) ConvertEncodedAccountAddressToHex() RunCommand {
 	return func(cmd *cobra.Command, args []string) {
-		fullAccountAddress, err := accounttype.ParseEncodedAccountToAccountAddress(accountTypeInt, encodedAccountAddress)
+		var (
+			fullAccountAddress []byte
+			err                error
+		)
+		if accountTypeInt == int32(model.AccountType_ETHAccountType) && ethereumSignature != "" {
+			fullAccountAddress, err = recoverEthereumAccountAddress(encodedAccountAddress, ethereumSignedPayload, ethereumSignature)
+		} else {
+			fullAccountAddress, err = accounttype.ParseEncodedAccountToAccountAddress(accountTypeInt, encodedAccountAddress)
+		}
 		if err != nil {
 			panic(err)
 		}
@@ -189,6 +224,25 @@ func (gc *GeneratorCommands) ConvertEncodedAccountAddressToHex() RunCommand {
 	}
 }
 
+// recoverEthereumAccountAddress resolve the full account address of a 20 bytes ethereum address from a signature of payload
+func recoverEthereumAccountAddress(encodedAddress, payloadHex, signatureHex string) ([]byte, error) {
+	payload, err := hex.DecodeString(payloadHex)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := hex.DecodeString(signatureHex)
+	if err != nil {
+		return nil, err
+	}
+	accountType := &accounttype.ETHAccountType{}
+	publicKey, err := accountType.RecoverPublicKeyFromAddress(encodedAddress, payload, signature)
+	if err != nil {
+		return nil, err
+	}
+	accountType.SetAccountPublicKey(publicKey)
+	return accountType.GetAccountAddress()
+}
+
 // ConvertHexAccountToEncoded Convert hex account address to human readable encoded account address
 func (gc *GeneratorCommands) ConvertHexAccountToEncoded() RunCommand {
 	return func(cmd *cobra.Command, args []string) {
@@ -356,6 +410,7 @@ DELETE FROM account_address;
 func (gc *GeneratorCommands) GenerateMultiSignatureAccount() RunCommand {
 	var (
 		multisigFullAccountAddresses [][]byte
+		multisigFullWeights          []uint32
 	)
 	for _, accAddrHex := range multisigAddressesHex {
 		decodedAddr, err := hex.DecodeString(accAddrHex)
@@ -364,11 +419,15 @@ func (gc *GeneratorCommands) GenerateMultiSignatureAccount() RunCommand {
 		}
 		multisigFullAccountAddresses = append(multisigFullAccountAddresses, decodedAddr)
 	}
+	for _, weight := range multisigWeights {
+		multisigFullWeights = append(multisigFullWeights, uint32(weight))
+	}
 	return func(cmd *cobra.Command, args []string) {
 		info := &model.MultiSignatureInfo{
 			MinimumSignatures: multisigMinimSigs,
 			Nonce:             multiSigNonce,
 			Addresses:         multisigFullAccountAddresses,
+			Weights:           multisigFullWeights,
 		}
 		address, err := gc.TransactionUtil.GenerateMultiSigAddress(info)
 		if err != nil {
@@ -409,6 +468,43 @@ func (gc *GeneratorCommands) GenerateEd25519Account() RunCommand {
 	}
 }
 
+// GenerateEd25519HDAccounts to derive the ed25519 accounts of a hdwallet seed, from account index from-account
+func (gc *GeneratorCommands) GenerateEd25519HDAccounts() RunCommand {
+	return func(ccmd *cobra.Command, args []string) {
+		if seed == "" {
+			seed = util.GetSecureRandomSeed()
+		}
+		for i := uint32(0); i < ed25519NumberOfAccounts; i++ {
+			var (
+				accountIndex                                                             = ed25519FromAccount + i
+				accountType                                                              = &accounttype.ZbcAccountType{}
+				privateKey, publicKey, publicKeyString, address, fullAccountAddress, err = gc.Signature.GenerateAccountFromSeed(
+					accountType,
+					seed,
+					true,
+					accountIndex,
+				)
+			)
+			if err != nil {
+				panic(err)
+			}
+			path, _ := signaturetype.GetSlip10AccountPath(accountIndex)
+			fmt.Printf("account index: %d\n", accountIndex)
+			fmt.Printf("derivation path: %s\n", path)
+			PrintAccount(
+				accountType,
+				seed,
+				publicKeyString,
+				address,
+				privateKey,
+				publicKey,
+				fullAccountAddress,
+			)
+			fmt.Println()
+		}
+	}
+}
+
 // GenerateBitcoinAccount to generate bitcoin account
 func (gc *GeneratorCommands) GenerateBitcoinAccount() RunCommand {
 	return func(ccmd *cobra.Command, args []string) {
@@ -439,6 +535,37 @@ func (gc *GeneratorCommands) GenerateBitcoinAccount() RunCommand {
 	}
 }
 
+// GenerateEthereumAccount to generate ethereum account
+func (gc *GeneratorCommands) GenerateEthereumAccount() RunCommand {
+	return func(ccmd *cobra.Command, args []string) {
+		if seed == "" {
+			seed = util.GetSecureRandomSeed()
+		}
+		var (
+			accountType                                                              = &accounttype.ETHAccountType{}
+			privateKey, publicKey, publicKeyString, address, fullAccountAddress, err = gc.Signature.GenerateAccountFromSeed(
+				accountType,
+				seed,
+				ethereumPassword,
+				ethereumAccount,
+				ethereumAddressIndex,
+			)
+		)
+		if err != nil {
+			panic(err)
+		}
+		PrintAccount(
+			accountType,
+			seed,
+			publicKeyString,
+			address,
+			privateKey,
+			publicKey,
+			fullAccountAddress,
+		)
+	}
+}
+
 // PrintAccount print out the generated account
 func PrintAccount(
 	accountType accounttype.AccountTypeInterface,
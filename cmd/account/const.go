@@ -57,11 +57,20 @@ var (
 	seed           string
 	// ed25519
 	ed25519UseSlip10 bool
+	ed25519FromAccount,
+	ed25519NumberOfAccounts uint32
 	// bitcoin
 	bitcoinPrivateKeyLength int32
 	bitcoinPublicKeyFormat  int32
+	// ethereum
+	ethereumPassword     string
+	ethereumAccount      uint32
+	ethereumAddressIndex uint32
+	ethereumSignature,
+	ethereumSignedPayload string
 	// multisig
 	multisigAddressesHex []string
 	multisigMinimSigs    uint32
 	multiSigNonce        int64
+	multisigWeights      []uint
 )
@@ -301,6 +301,8 @@ func initialize(
 			query.NewTransactionQuery(chainType),
 			nil,
 			nil,
+			nil,
+			nil,
 		), nil, nil, nil, nil, nil, nil, feeScaleService, query.GetPruneQuery(chainType), nil, nil, nil, nil)
 
 	migration = database.Migration{Query: queryExecutor}
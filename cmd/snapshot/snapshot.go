@@ -153,6 +153,10 @@ func newSnapshotProcess() func(ccmd *cobra.Command, args []string) {
 			query.NewFeeVoteRevealVoteQuery(),
 			query.NewLiquidPaymentTransactionQuery(),
 			query.NewNodeAdmissionTimestampQuery(),
+			query.NewLockedFundQuery(),
+			query.NewHtlcQuery(),
+			query.NewMultiSignatureRotationQuery(),
+			query.NewAccountDatasetSchemaQuery(),
 			query.NewBlockQuery(mainChain),
 			query.GetSnapshotQuery(mainChain),
 			query.GetBlocksmithSafeQuery(mainChain),
@@ -388,6 +392,10 @@ func storingPayloadProcess() func(ccmd *cobra.Command, args []string) {
 			query.NewFeeVoteRevealVoteQuery(),
 			query.NewLiquidPaymentTransactionQuery(),
 			query.NewNodeAdmissionTimestampQuery(),
+			query.NewLockedFundQuery(),
+			query.NewHtlcQuery(),
+			query.NewMultiSignatureRotationQuery(),
+			query.NewAccountDatasetSchemaQuery(),
 			query.NewBlockQuery(mainChain),
 			query.GetSnapshotQuery(mainChain),
 			query.GetBlocksmithSafeQuery(mainChain),
@@ -91,6 +91,16 @@ var (
 		Use:   "send-zbc",
 		Short: "send-zbc command used to generate \"send zbc\" transaction",
 	}
+	timeLockedSendZBCCmd = &cobra.Command{
+		Use:   "time-locked-send-zbc",
+		Short: "time-locked-send-zbc command used to generate \"time locked send zbc\" transaction",
+		Long: "time-locked-send-zbc command used to generate \"time locked send zbc\" transaction whose amount is received by the recipient " +
+			"only once the unlock height or the unlock timestamp is reached",
+	}
+	multiSendZBCCmd = &cobra.Command{
+		Use:   "multi-send-zbc",
+		Short: "multi-send-zbc command used to generate \"multi send zbc\" transaction paying several recipients at once",
+	}
 	registerNodeCmd = &cobra.Command{
 		Use:   "register-node",
 		Short: "register-node command is used to generate \"node registration\" transaction",
@@ -115,6 +125,10 @@ var (
 		Use:   "remove-account-dataset",
 		Short: "remove-account-dataset command used to generate \"remove account dataset\" transaction",
 	}
+	registerAccountDatasetSchemaCmd = &cobra.Command{
+		Use:   "register-account-dataset-schema",
+		Short: "register-account-dataset-schema command used to generate \"register account dataset schema\" transaction",
+	}
 	escrowApprovalCmd = &cobra.Command{
 		Use:   "escrow-approval",
 		Short: "transaction sub command used to generate 'escrow approval' transaction",
@@ -126,6 +140,18 @@ var (
 		Long: "transaction sub command used to generate 'multi signature' transaction that require multiple account to submit their signature " +
 			"before it is valid to be executed",
 	}
+	multiSigRotationCmd = &cobra.Command{
+		Use:   "multi-signature-rotation",
+		Short: "transaction sub command used to generate 'multi signature rotation' transaction",
+		Long: "transaction sub command used to generate 'multi signature rotation' transaction replacing the participants and threshold " +
+			"of the multisig account sending it. Its unsigned bytes are submitted as the unsigned-transaction of a multi signature transaction",
+	}
+	multiSigCancelCmd = &cobra.Command{
+		Use:   "multi-signature-cancel",
+		Short: "transaction sub command used to generate 'multi signature cancel' transaction",
+		Long: "transaction sub command used to generate 'multi signature cancel' transaction cancelling a transaction pending on " +
+			"the multisig account sending it. Its unsigned bytes are submitted as the unsigned-transaction of a multi signature transaction",
+	}
 	feeVoteCommitmentCmd = &cobra.Command{
 		Use:   "fee-vote-commit",
 		Short: "transaction sub command used to generate 'fee vote commitment vote' transaction",
@@ -146,6 +172,25 @@ var (
 		Short: "transaction sub command used to generate 'liquid payment stop' transaction",
 		Long:  "transaction sub command used to generate 'liquid payment stop' transaction used to stop a particular liquid payment",
 	}
+	liquidPaymentWithdrawCmd = &cobra.Command{
+		Use:   "liquid-payment-withdraw",
+		Short: "transaction sub command used to generate 'liquid payment withdraw' transaction",
+		Long:  "transaction sub command used to generate 'liquid payment withdraw' transaction used by the recipient to claim the amount vested so far",
+	}
+	htlcLockCmd = &cobra.Command{
+		Use:   "htlc-lock",
+		Short: "transaction sub command used to generate 'htlc lock' transaction",
+		Long: "transaction sub command used to generate 'htlc lock' transaction locking an amount the recipient can claim by revealing " +
+			"the preimage of the hash lock before the time lock height",
+	}
+	htlcClaimCmd = &cobra.Command{
+		Use:   "htlc-claim",
+		Short: "transaction sub command used to generate 'htlc claim' transaction revealing the preimage of a htlc hash lock",
+	}
+	htlcRefundCmd = &cobra.Command{
+		Use:   "htlc-refund",
+		Short: "transaction sub command used to generate 'htlc refund' transaction giving back the amount of an expired htlc",
+	}
 )
 
 func init() {
@@ -165,6 +210,8 @@ func init() {
 	txCmd.PersistentFlags().BoolVar(&post, "post", false, "post generated bytes to [127.0.0.1:7000](default)")
 	txCmd.PersistentFlags().StringVar(&postHost, "post-host", "127.0.0.1:7000", "destination of post action")
 	txCmd.PersistentFlags().StringVar(&senderAddressHex, "sender-address", "", "transaction's sender address")
+	txCmd.PersistentFlags().Int64Var(&replacedTransactionID, "replaced-transaction-id", 0,
+		"the pending transaction of the same sender this one replaces in mempool, needs --version 3")
 	txCmd.PersistentFlags().StringVarP(&dbPath, "db-path", "p", "resource", "db-path is database path location")
 	txCmd.PersistentFlags().StringVarP(&dBName, "db-name", "n", "zoobc.db", "db-name is database name {name}.db")
 	/*
@@ -176,6 +223,9 @@ func init() {
 	sendZBCCmd.Flags().Int64Var(&esTimeout, "timeout", 0, "Escrow fields: Timeout which is timestamp unix format")
 	sendZBCCmd.Flags().Int64Var(&esCommission, "commission", 0, "Escrow fields: Commission")
 	sendZBCCmd.Flags().StringVar(&esInstruction, "instruction", "", "Escrow fields: Instruction")
+	sendZBCCmd.Flags().StringSliceVar(&esApproverAddressesHex, "approver-addresses", []string{}, "Escrow fields: every approver "+
+		"of a multi approver escrow, the first one is the approver address --approver-addresses='address1,address2'")
+	sendZBCCmd.Flags().Uint32Var(&esMinimumApprovals, "minimum-approvals", 0, "Escrow fields: Minimum approvals of a multi approver escrow")
 
 	/*
 		RegisterNode Command
@@ -225,17 +275,30 @@ func init() {
 	*/
 	setupAccountDatasetCmd.Flags().StringVar(&property, "property", "", "Property of dataset wanted to be set")
 	setupAccountDatasetCmd.Flags().StringVar(&value, "value", "", "Value of dataset wanted to be set")
+	setupAccountDatasetCmd.Flags().Uint32Var(&expiryHeight, "expiry-height", 0, "Block height deactivating the dataset, 0 for no expiry")
+	setupAccountDatasetCmd.Flags().Int64Var(&expiryTimestamp, "expiry-timestamp", 0, "Block timestamp deactivating the dataset, 0 for no expiry")
 
 	/*
 		RemoveAccountDataset Command
 	*/
 	removeAccountDatasetCmd.Flags().StringVar(&property, "property", "", "Property of dataset wanted to be removed")
 	removeAccountDatasetCmd.Flags().StringVar(&value, "value", "", "Value of dataset wanted to be removed")
+
+	/*
+		RegisterAccountDatasetSchema Command
+	*/
+	registerAccountDatasetSchemaCmd.Flags().StringVar(&property, "property", "", "Property of dataset the schema is registered for")
+	registerAccountDatasetSchemaCmd.Flags().StringVar(&datasetValueType, "value-type", "String",
+		"Type of the dataset values: String, Int, Bool, Address, Hash or JSON")
+	registerAccountDatasetSchemaCmd.Flags().Uint32Var(&datasetMaxLength, "max-length", 0,
+		"Maximum length of the dataset values, required for JSON values")
 	/*
 		EscrowApproval Command
 	*/
 	escrowApprovalCmd.Flags().Int64Var(&transactionID, "transaction-id", 0, "escrow approval body field which is int64")
 	escrowApprovalCmd.Flags().BoolVar(&approval, "approval", false, "escrow approval body field which is bool")
+	escrowApprovalCmd.Flags().Int64Var(&releaseAmount, "release-amount", 0, "escrow approval body field which is int64, "+
+		"amount released by a partial approval, 0 releases the whole remaining amount")
 	/*
 		MultiSig Command
 	*/
@@ -243,7 +306,9 @@ func init() {
 		"--addressesHex='address1,address2'")
 	multiSigCmd.Flags().Int64Var(&nonce, "nonce", 0, "random number / access code for the multisig info")
 	multiSigCmd.Flags().Uint32Var(&minSignature, "min-signature", 0, "minimum number of signature required for the transaction "+
-		"to be valid")
+		"to be valid, or the minimum summed weight of the signatures when the participants are weighted")
+	multiSigCmd.Flags().UintSliceVar(&weights, "weights", []uint{}, "weight of each participant, in the order of addressesHex "+
+		"--weights='3,1'. Every participant weights 1 when not set")
 	multiSigCmd.Flags().StringVar(&unsignedTxHex, "unsigned-transaction", "", "hex string of the unsigned transaction bytes")
 	multiSigCmd.Flags().StringVar(&txHash, "transaction-hash", "", "hash of transaction being signed by address-signature list (hex)")
 	multiSigCmd.Flags().StringToStringVar(&addressSignatures, "address-signatures", make(map[string]string), "address:signature list "+
@@ -267,10 +332,61 @@ func init() {
 	liquidPaymentCmd.Flags().Int64Var(&sendAmount, "amount", 0, "Amount of zbc we want to send with liquid payment")
 	liquidPaymentCmd.Flags().Uint64Var(&completeMinutes, "complete-minutes", 0, "In how long the span we want to send the liquid payment (in minutes)")
 
+	/*
+		timeLockedSendZBCCmd
+	*/
+	timeLockedSendZBCCmd.Flags().Int64Var(&sendAmount, "amount", 0, "Amount of zbc we want to send once unlocked")
+	timeLockedSendZBCCmd.Flags().Uint32Var(&unlockHeight, "unlock-height", 0, "Block height releasing the amount to the recipient")
+	timeLockedSendZBCCmd.Flags().Int64Var(&unlockTimestamp, "unlock-timestamp", 0, "Block timestamp releasing the amount to the recipient")
+
+	/*
+		multiSendZBCCmd
+	*/
+	multiSendZBCCmd.Flags().StringToInt64Var(&recipientAmounts, "recipient-amounts", make(map[string]int64), "recipient:amount list "+
+		"--recipient-amounts='recipientAddressHex1=amount1,recipientAddressHex2=amount2'")
+
 	/*
 		liquidPaymentStopCmd
 	*/
 	liquidPaymentStopCmd.Flags().Int64Var(&transactionID, "transaction-id", 0, "liquid payment stop transaction body field which is int64")
+
+	/*
+		liquidPaymentWithdrawCmd
+	*/
+	liquidPaymentWithdrawCmd.Flags().Int64Var(&transactionID, "transaction-id", 0, "id of the liquid payment transaction to withdraw from")
+
+	/*
+		htlcLockCmd
+	*/
+	htlcLockCmd.Flags().Int64Var(&sendAmount, "amount", 0, "Amount of zbc locked for the recipient")
+	htlcLockCmd.Flags().StringVar(&hashLockHex, "hash-lock-hex", "", "hex string of the sha256 of the preimage revealed by the recipient")
+	htlcLockCmd.Flags().Uint32Var(&timeLockHeight, "time-lock-height", 0, "Block height from which the sender can be refunded")
+
+	/*
+		htlcClaimCmd
+	*/
+	htlcClaimCmd.Flags().Int64Var(&transactionID, "htlc-id", 0, "id of the htlc lock transaction")
+	htlcClaimCmd.Flags().StringVar(&preimageHex, "preimage-hex", "", "hex string of the preimage of the htlc hash lock")
+
+	/*
+		htlcRefundCmd
+	*/
+	htlcRefundCmd.Flags().Int64Var(&transactionID, "htlc-id", 0, "id of the htlc lock transaction")
+
+	/*
+		multiSigRotationCmd
+	*/
+	multiSigRotationCmd.Flags().StringSliceVar(&addressesHex, "addressesHex", []string{}, "list of the new participants "+
+		"--addressesHex='address1hex,address2hex'")
+	multiSigRotationCmd.Flags().Uint32Var(&minSignature, "min-signature", 0, "new minimum number of signature, or minimum summed "+
+		"weight of the signatures when the participants are weighted")
+	multiSigRotationCmd.Flags().UintSliceVar(&weights, "weights", []uint{}, "weight of each new participant, in the order of addressesHex "+
+		"--weights='3,1'. Every participant weights 1 when not set")
+
+	/*
+		multiSigCancelCmd
+	*/
+	multiSigCancelCmd.Flags().StringVar(&txHash, "transaction-hash", "", "hash of the pending transaction to cancel (hex)")
 }
 
 // Commands set TXGeneratorCommandsInstance that will used by whole commands
@@ -281,6 +397,10 @@ func Commands() *cobra.Command {
 
 	sendZBCCmd.Run = txGeneratorCommandsInstance.SendZBCProcess()
 	txCmd.AddCommand(sendZBCCmd)
+	timeLockedSendZBCCmd.Run = txGeneratorCommandsInstance.TimeLockedSendZBCProcess()
+	txCmd.AddCommand(timeLockedSendZBCCmd)
+	multiSendZBCCmd.Run = txGeneratorCommandsInstance.MultiSendZBCProcess()
+	txCmd.AddCommand(multiSendZBCCmd)
 	registerNodeCmd.Run = txGeneratorCommandsInstance.RegisterNodeProcess()
 	txCmd.AddCommand(registerNodeCmd)
 	updateNodeCmd.Run = txGeneratorCommandsInstance.UpdateNodeProcess()
@@ -293,6 +413,8 @@ func Commands() *cobra.Command {
 	txCmd.AddCommand(setupAccountDatasetCmd)
 	removeAccountDatasetCmd.Run = txGeneratorCommandsInstance.RemoveAccountDatasetProcess()
 	txCmd.AddCommand(removeAccountDatasetCmd)
+	registerAccountDatasetSchemaCmd.Run = txGeneratorCommandsInstance.RegisterAccountDatasetSchemaProcess()
+	txCmd.AddCommand(registerAccountDatasetSchemaCmd)
 	escrowApprovalCmd.Run = txGeneratorCommandsInstance.EscrowApprovalProcess()
 	txCmd.AddCommand(escrowApprovalCmd)
 	multiSigCmd.Run = txGeneratorCommandsInstance.MultiSignatureProcess()
@@ -305,6 +427,18 @@ func Commands() *cobra.Command {
 	txCmd.AddCommand(liquidPaymentCmd)
 	liquidPaymentStopCmd.Run = txGeneratorCommandsInstance.LiquidPaymentStopProcess()
 	txCmd.AddCommand(liquidPaymentStopCmd)
+	liquidPaymentWithdrawCmd.Run = txGeneratorCommandsInstance.LiquidPaymentWithdrawProcess()
+	txCmd.AddCommand(liquidPaymentWithdrawCmd)
+	htlcLockCmd.Run = txGeneratorCommandsInstance.HtlcLockProcess()
+	txCmd.AddCommand(htlcLockCmd)
+	htlcClaimCmd.Run = txGeneratorCommandsInstance.HtlcClaimProcess()
+	txCmd.AddCommand(htlcClaimCmd)
+	htlcRefundCmd.Run = txGeneratorCommandsInstance.HtlcRefundProcess()
+	txCmd.AddCommand(htlcRefundCmd)
+	multiSigRotationCmd.Run = txGeneratorCommandsInstance.MultiSignatureRotationProcess()
+	txCmd.AddCommand(multiSigRotationCmd)
+	multiSigCancelCmd.Run = txGeneratorCommandsInstance.MultiSignatureCancelProcess()
+	txCmd.AddCommand(multiSigCancelCmd)
 	return txCmd
 }
 
@@ -482,13 +616,13 @@ func (*TXGeneratorCommands) SetupAccountDatasetProcess() RunCommand {
 			message,
 		)
 
-		// Recipient required while property set as AccountDatasetEscrowApproval
+		// Recipient required while property set as AccountDatasetEscrowApproval or AccountDatasetSpendingRestriction
 		_, ok := model.AccountDatasetProperty_value[property]
 		if ok && recipientAccountAddressHex == "" {
-			println("--recipient is required while property as AccountDatasetEscrowApproval")
+			println("--recipient is required while property as " + property)
 			return
 		}
-		tx = GenerateTxSetupAccountDataset(tx, property, value)
+		tx = GenerateTxSetupAccountDataset(tx, property, value, expiryHeight, expiryTimestamp)
 		if escrow {
 			tx = GenerateEscrowedTransaction(tx)
 		}
@@ -518,6 +652,24 @@ func (*TXGeneratorCommands) RemoveAccountDatasetProcess() RunCommand {
 	}
 }
 
+// RegisterAccountDatasetSchemaProcess for generate TX RegisterAccountDatasetSchema type
+func (*TXGeneratorCommands) RegisterAccountDatasetSchemaProcess() RunCommand {
+	return func(ccmd *cobra.Command, args []string) {
+		tx := GenerateBasicTransaction(
+			senderAddressHex,
+			senderSeed,
+			version,
+			timestamp,
+			fee,
+			recipientAccountAddressHex,
+			message,
+		)
+		tx = GenerateTxRegisterAccountDatasetSchema(tx, property, datasetValueType, datasetMaxLength)
+		senderAccountType := getAccountAddressType(senderAddressHex)
+		PrintTx(GenerateSignedTxBytes(tx, senderSeed, senderAccountType, sign), outputType)
+	}
+}
+
 // EscrowApprovalProcess for generate TX EscrowApproval type
 func (*TXGeneratorCommands) EscrowApprovalProcess() RunCommand {
 	return func(ccmd *cobra.Command, args []string) {
@@ -549,7 +701,7 @@ func (*TXGeneratorCommands) MultiSignatureProcess() RunCommand {
 			message,
 		)
 
-		tx = GeneratedMultiSignatureTransaction(tx, minSignature, nonce, unsignedTxHex, txHash, addressSignatures, addressesHex)
+		tx = GeneratedMultiSignatureTransaction(tx, minSignature, nonce, unsignedTxHex, txHash, addressSignatures, addressesHex, weights)
 		if tx == nil {
 			fmt.Printf("fail to generate transaction, please check the provided parameter")
 		} else {
@@ -720,6 +872,42 @@ func (*TXGeneratorCommands) feeVoteRevealProcess() RunCommand {
 	}
 }
 
+// TimeLockedSendZBCProcess for generate TX TimeLockedSendZBC type
+func (*TXGeneratorCommands) TimeLockedSendZBCProcess() RunCommand {
+	return func(ccmd *cobra.Command, args []string) {
+		tx := GenerateBasicTransaction(
+			senderAddressHex,
+			senderSeed,
+			version,
+			timestamp,
+			fee,
+			recipientAccountAddressHex,
+			message,
+		)
+		tx = GenerateTxTimeLockedSendZBC(tx, sendAmount, unlockHeight, unlockTimestamp)
+		senderAccountType := getAccountAddressType(senderAddressHex)
+		PrintTx(GenerateSignedTxBytes(tx, senderSeed, senderAccountType, sign), outputType)
+	}
+}
+
+// MultiSendZBCProcess for generate TX MultiSendZBC type
+func (*TXGeneratorCommands) MultiSendZBCProcess() RunCommand {
+	return func(ccmd *cobra.Command, args []string) {
+		tx := GenerateBasicTransaction(
+			senderAddressHex,
+			senderSeed,
+			version,
+			timestamp,
+			fee,
+			"",
+			message,
+		)
+		tx = GenerateTxMultiSendZBC(tx, recipientAmounts)
+		senderAccountType := getAccountAddressType(senderAddressHex)
+		PrintTx(GenerateSignedTxBytes(tx, senderSeed, senderAccountType, sign), outputType)
+	}
+}
+
 // LiquidPaymentProcess for generate TX LiquidPayment type
 func (*TXGeneratorCommands) LiquidPaymentProcess() RunCommand {
 	return func(ccmd *cobra.Command, args []string) {
@@ -755,3 +943,119 @@ func (*TXGeneratorCommands) LiquidPaymentStopProcess() RunCommand {
 		PrintTx(GenerateSignedTxBytes(tx, senderSeed, senderAccountType, sign), outputType)
 	}
 }
+
+// LiquidPaymentWithdrawProcess for generate TX LiquidPaymentWithdraw type
+func (*TXGeneratorCommands) LiquidPaymentWithdrawProcess() RunCommand {
+	return func(ccmd *cobra.Command, args []string) {
+		tx := GenerateBasicTransaction(
+			senderAddressHex,
+			senderSeed,
+			version,
+			timestamp,
+			fee,
+			"",
+			message,
+		)
+		tx = GenerateTxLiquidPaymentWithdraw(tx, transactionID)
+		senderAccountType := getAccountAddressType(senderAddressHex)
+		PrintTx(GenerateSignedTxBytes(tx, senderSeed, senderAccountType, sign), outputType)
+	}
+}
+
+// HtlcLockProcess for generate TX HtlcLock type
+func (*TXGeneratorCommands) HtlcLockProcess() RunCommand {
+	return func(ccmd *cobra.Command, args []string) {
+		tx := GenerateBasicTransaction(
+			senderAddressHex,
+			senderSeed,
+			version,
+			timestamp,
+			fee,
+			recipientAccountAddressHex,
+			message,
+		)
+		tx = GenerateTxHtlcLock(tx, sendAmount, hashLockHex, timeLockHeight)
+		senderAccountType := getAccountAddressType(senderAddressHex)
+		PrintTx(GenerateSignedTxBytes(tx, senderSeed, senderAccountType, sign), outputType)
+	}
+}
+
+// HtlcClaimProcess for generate TX HtlcClaim type
+func (*TXGeneratorCommands) HtlcClaimProcess() RunCommand {
+	return func(ccmd *cobra.Command, args []string) {
+		tx := GenerateBasicTransaction(
+			senderAddressHex,
+			senderSeed,
+			version,
+			timestamp,
+			fee,
+			"",
+			message,
+		)
+		tx = GenerateTxHtlcClaim(tx, transactionID, preimageHex)
+		senderAccountType := getAccountAddressType(senderAddressHex)
+		PrintTx(GenerateSignedTxBytes(tx, senderSeed, senderAccountType, sign), outputType)
+	}
+}
+
+// HtlcRefundProcess for generate TX HtlcRefund type
+func (*TXGeneratorCommands) HtlcRefundProcess() RunCommand {
+	return func(ccmd *cobra.Command, args []string) {
+		tx := GenerateBasicTransaction(
+			senderAddressHex,
+			senderSeed,
+			version,
+			timestamp,
+			fee,
+			"",
+			message,
+		)
+		tx = GenerateTxHtlcRefund(tx, transactionID)
+		senderAccountType := getAccountAddressType(senderAddressHex)
+		PrintTx(GenerateSignedTxBytes(tx, senderSeed, senderAccountType, sign), outputType)
+	}
+}
+
+// MultiSignatureRotationProcess for generate TX MultiSignatureRotation type, the sender being the multisig address
+func (*TXGeneratorCommands) MultiSignatureRotationProcess() RunCommand {
+	return func(ccmd *cobra.Command, args []string) {
+		tx := GenerateBasicTransaction(
+			senderAddressHex,
+			senderSeed,
+			version,
+			timestamp,
+			fee,
+			"",
+			message,
+		)
+		tx = GenerateTxMultiSignatureRotation(tx, minSignature, addressesHex, weights)
+		if tx == nil {
+			fmt.Printf("fail to generate transaction, please check the provided parameter")
+		} else {
+			senderAccountType := getAccountAddressType(senderAddressHex)
+			PrintTx(GenerateSignedTxBytes(tx, senderSeed, senderAccountType, sign), outputType)
+		}
+	}
+}
+
+// MultiSignatureCancelProcess for generate TX MultiSignatureCancel type, the sender being the multisig address
+func (*TXGeneratorCommands) MultiSignatureCancelProcess() RunCommand {
+	return func(ccmd *cobra.Command, args []string) {
+		tx := GenerateBasicTransaction(
+			senderAddressHex,
+			senderSeed,
+			version,
+			timestamp,
+			fee,
+			"",
+			message,
+		)
+		tx = GenerateTxMultiSignatureCancel(tx, txHash)
+		if tx == nil {
+			fmt.Printf("fail to generate transaction, please check the provided parameter")
+		} else {
+			senderAccountType := getAccountAddressType(senderAddressHex)
+			PrintTx(GenerateSignedTxBytes(tx, senderSeed, senderAccountType, sign), outputType)
+		}
+	}
+}
@@ -56,18 +56,27 @@ import (
 var (
 	txTypeMap = map[string][]byte{
 		"sendZBC":                {1, 0, 0, 0},
+		"timeLockedSendZBC":      {1, 1, 0, 0},
+		"multiSendZBC":           {1, 2, 0, 0},
 		"registerNode":           {2, 0, 0, 0},
 		"updateNodeRegistration": {2, 1, 0, 0},
 		"removeNodeRegistration": {2, 2, 0, 0},
 		"claimNodeRegistration":  {2, 3, 0, 0},
 		"setupAccountDataset":    {3, 0, 0, 0},
 		"removeAccountDataset":   {3, 1, 0, 0},
+		"registerDatasetSchema":  {3, 2, 0, 0},
 		"approvalEscrow":         {4, 0, 0, 0},
 		"multiSignature":         {5, 0, 0, 0},
+		"multiSignatureRotation": {5, 1, 0, 0},
+		"multiSignatureCancel":   {5, 2, 0, 0},
 		"liquidPayment":          {6, 0, 0, 0},
 		"liquidPaymentStop":      {6, 1, 0, 0},
+		"liquidPaymentWithdraw":  {6, 2, 0, 0},
 		"feeVoteCommit":          {7, 0, 0, 0},
 		"feeVoteReveal":          {7, 1, 0, 0},
+		"htlcLock":               {8, 0, 0, 0},
+		"htlcClaim":              {8, 1, 0, 0},
+		"htlcRefund":             {8, 2, 0, 0},
 	}
 	signature = &crypto.Signature{}
 
@@ -83,6 +92,7 @@ var (
 	postHost                   string
 	senderAddressHex           string
 	sign                       bool
+	replacedTransactionID      int64
 
 	// Send zbc transaction
 	sendAmount int64
@@ -96,18 +106,26 @@ var (
 	databaseName               string
 
 	// dataset transaction
-	property string
-	value    string
+	property         string
+	value            string
+	datasetValueType string
+	datasetMaxLength uint32
+	expiryHeight     uint32
+	expiryTimestamp  int64
 	// escrowable
 	escrow               bool
 	esApproverAddressHex string
 	esCommission         int64
 	esTimeout            int64
 	esInstruction        string
+	// multi approver escrow
+	esApproverAddressesHex []string
+	esMinimumApprovals     uint32
 
 	// escrowApproval
 	approval      bool
 	transactionID int64
+	releaseAmount int64
 
 	// multiSignature
 	unsignedTxHex     string
@@ -116,6 +134,7 @@ var (
 	addressesHex      []string
 	nonce             int64
 	minSignature      uint32
+	weights           []uint
 
 	// fee vote
 	recentBlockHeight uint32
@@ -123,4 +142,13 @@ var (
 	dbPath, dBName    string
 	// liquidPayment
 	completeMinutes uint64
+	// timeLockedSendZBC
+	unlockHeight    uint32
+	unlockTimestamp int64
+	// multiSendZBC
+	recipientAmounts map[string]int64
+	// htlc
+	hashLockHex    string
+	timeLockHeight uint32
+	preimageHex    string
 )
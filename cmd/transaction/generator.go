@@ -54,6 +54,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -84,6 +85,58 @@ func GenerateTxSendZBC(tx *model.Transaction, sendAmount int64) *model.Transacti
 	return tx
 }
 
+// GenerateTxTimeLockedSendZBC return time locked send zbc transaction based on provided basic transaction, amount and unlock condition
+func GenerateTxTimeLockedSendZBC(tx *model.Transaction, sendAmount int64, unlockHeight uint32, unlockTimestamp int64) *model.Transaction {
+	txBody := &model.TimeLockedSendZBCTransactionBody{
+		Amount:          sendAmount,
+		UnlockHeight:    unlockHeight,
+		UnlockTimestamp: unlockTimestamp,
+	}
+	tx.TransactionType = util.ConvertBytesToUint32(txTypeMap["timeLockedSendZBC"])
+	tx.TransactionBody = &model.Transaction_TimeLockedSendZBCTransactionBody{
+		TimeLockedSendZBCTransactionBody: txBody,
+	}
+	txBodyBytes, _ := (&transaction.TimeLockedSendZBC{
+		Body: txBody,
+	}).GetBodyBytes()
+	tx.TransactionBodyBytes = txBodyBytes
+	tx.TransactionBodyLength = uint32(len(txBodyBytes))
+	return tx
+}
+
+// GenerateTxMultiSendZBC return multi send zbc transaction based on provided basic transaction & amount of every recipient
+func GenerateTxMultiSendZBC(tx *model.Transaction, recipientAmounts map[string]int64) *model.Transaction {
+	var (
+		recipientsHex = make([]string, 0, len(recipientAmounts))
+		txBody        = &model.MultiSendZBCTransactionBody{}
+	)
+	for recipientHex := range recipientAmounts {
+		recipientsHex = append(recipientsHex, recipientHex)
+	}
+	// map iteration order is random, sort recipients to always generate the same body bytes
+	sort.Strings(recipientsHex)
+	for _, recipientHex := range recipientsHex {
+		recipientAddress, err := hex.DecodeString(recipientHex)
+		if err != nil {
+			panic(err)
+		}
+		txBody.Recipients = append(txBody.Recipients, &model.MultiSendZBCRecipient{
+			RecipientAddress: recipientAddress,
+			Amount:           recipientAmounts[recipientHex],
+		})
+	}
+	tx.TransactionType = util.ConvertBytesToUint32(txTypeMap["multiSendZBC"])
+	tx.TransactionBody = &model.Transaction_MultiSendZBCTransactionBody{
+		MultiSendZBCTransactionBody: txBody,
+	}
+	txBodyBytes, _ := (&transaction.MultiSendZBC{
+		Body: txBody,
+	}).GetBodyBytes()
+	tx.TransactionBodyBytes = txBodyBytes
+	tx.TransactionBodyLength = uint32(len(txBodyBytes))
+	return tx
+}
+
 /*
 GenerateTxRegisterNode return register node transaction based on provided basic transaction &
 others specific field for generate register node transaction
@@ -219,10 +272,14 @@ others specific field for setup account dataset transaction
 func GenerateTxSetupAccountDataset(
 	tx *model.Transaction,
 	property, value string,
+	expiryHeight uint32,
+	expiryTimestamp int64,
 ) *model.Transaction {
 	txBody := &model.SetupAccountDatasetTransactionBody{
-		Property: property,
-		Value:    value,
+		Property:        property,
+		Value:           value,
+		ExpiryHeight:    expiryHeight,
+		ExpiryTimestamp: expiryTimestamp,
 	}
 	txBodyBytes, _ := (&transaction.SetupAccountDataset{
 		Body: txBody,
@@ -262,6 +319,34 @@ func GenerateTxRemoveAccountDataset(
 	return tx
 }
 
+// GenerateTxRegisterAccountDatasetSchema return register account dataset schema transaction, valueType is the name of the type without prefix
+func GenerateTxRegisterAccountDatasetSchema(
+	tx *model.Transaction,
+	property, valueType string,
+	maxLength uint32,
+) *model.Transaction {
+	valueTypeInt, ok := model.AccountDatasetValueType_value["AccountDatasetValue"+valueType]
+	if !ok {
+		panic(fmt.Sprintln("GenerateTxRegisterAccountDatasetSchema-Failed UnknownValueType", valueType))
+	}
+	txBody := &model.RegisterAccountDatasetSchemaTransactionBody{
+		Property:  property,
+		ValueType: model.AccountDatasetValueType(valueTypeInt),
+		MaxLength: maxLength,
+	}
+	txBodyBytes, _ := (&transaction.RegisterAccountDatasetSchema{
+		Body: txBody,
+	}).GetBodyBytes()
+
+	tx.TransactionType = util.ConvertBytesToUint32(txTypeMap["registerDatasetSchema"])
+	tx.TransactionBody = &model.Transaction_RegisterAccountDatasetSchemaTransactionBody{
+		RegisterAccountDatasetSchemaTransactionBody: txBody,
+	}
+	tx.TransactionBodyBytes = txBodyBytes
+	tx.TransactionBodyLength = uint32(len(txBodyBytes))
+	return tx
+}
+
 func getAccountTypeFromAccountHex(senderAccountAddressHex string) accounttype.AccountTypeInterface {
 	accountAddress, err := hex.DecodeString(senderAccountAddressHex)
 	if err != nil {
@@ -392,7 +477,8 @@ func GenerateBasicTransaction(
 			Commission:      0,
 			Timeout:         0,
 		},
-		Message: []byte(message),
+		Message:               []byte(message),
+		ReplacedTransactionID: replacedTransactionID,
 	}
 }
 
@@ -480,6 +566,7 @@ func GenerateEscrowApprovalTransaction(tx *model.Transaction) *model.Transaction
 	txBody := &model.ApprovalEscrowTransactionBody{
 		Approval:      chosen,
 		TransactionID: transactionID,
+		Amount:        releaseAmount,
 	}
 	txBodyBytes, _ := (&transaction.ApprovalEscrowTransaction{
 		Body: txBody,
@@ -487,7 +574,7 @@ func GenerateEscrowApprovalTransaction(tx *model.Transaction) *model.Transaction
 
 	tx.TransactionBody = txBody
 	tx.TransactionBodyBytes = txBodyBytes
-	tx.TransactionBodyLength = constant.EscrowApprovalBytesLength
+	tx.TransactionBodyLength = uint32(len(txBodyBytes))
 	tx.TransactionType = util.ConvertBytesToUint32(txTypeMap["approvalEscrow"])
 
 	return tx
@@ -505,10 +592,21 @@ func GenerateEscrowedTransaction(
 ) *model.Transaction {
 	decodedApproverAddress := getDecodeAddress(esApproverAddressHex)
 	tx.Escrow = &model.Escrow{
-		ApproverAddress: decodedApproverAddress,
-		Commission:      esCommission,
-		Timeout:         esTimeout,
-		Instruction:     esInstruction,
+		ApproverAddress:  decodedApproverAddress,
+		Commission:       esCommission,
+		Timeout:          esTimeout,
+		Instruction:      esInstruction,
+		MinimumApprovals: esMinimumApprovals,
+	}
+	if len(esApproverAddressesHex) > 0 {
+		for _, approverAddressHex := range esApproverAddressesHex {
+			tx.Escrow.ApproverAddresses = append(tx.Escrow.ApproverAddresses, getDecodeAddress(approverAddressHex))
+		}
+		tx.Escrow.ApproverAddress = tx.Escrow.ApproverAddresses[0]
+	}
+	if (len(tx.Escrow.GetApproverAddresses()) > 0 || tx.Escrow.GetMinimumApprovals() > 0) &&
+		tx.GetVersion() < constant.EscrowApproversTransactionVersion {
+		tx.Version = constant.EscrowApproversTransactionVersion
 	}
 	return tx
 }
@@ -521,6 +619,7 @@ GeneratedMultiSignatureTransaction inject escrow. Need:
 			- minSignature
 			- nonce
 			- addressesHex
+			- weights, optional
 Invalid escrow validation when those fields has not set
 */
 func GeneratedMultiSignatureTransaction(
@@ -529,6 +628,7 @@ func GeneratedMultiSignatureTransaction(
 	nonce int64,
 	unsignedTxHex, txHash string,
 	addressSignatures map[string]string, addressesHex []string,
+	participantWeights []uint,
 ) *model.Transaction {
 	var (
 		signatures    = make(map[string][]byte)
@@ -537,6 +637,7 @@ func GeneratedMultiSignatureTransaction(
 		multiSigInfo  *model.MultiSignatureInfo
 		err           error
 		fullAddresses [][]byte
+		fullWeights   []uint32
 	)
 	for _, addrHex := range addressesHex {
 		decodedAddr, err := hex.DecodeString(addrHex)
@@ -545,11 +646,15 @@ func GeneratedMultiSignatureTransaction(
 		}
 		fullAddresses = append(fullAddresses, decodedAddr)
 	}
+	for _, weight := range participantWeights {
+		fullWeights = append(fullWeights, uint32(weight))
+	}
 	if minSignature > 0 && len(addressesHex) > 0 {
 		multiSigInfo = &model.MultiSignatureInfo{
 			MinimumSignatures: minSignature,
 			Nonce:             nonce,
 			Addresses:         fullAddresses,
+			Weights:           fullWeights,
 		}
 	}
 	if unsignedTxHex != "" {
@@ -692,3 +797,141 @@ func GenerateTxLiquidPaymentStop(tx *model.Transaction, transactionID int64) *mo
 	tx.TransactionBodyLength = uint32(len(txBodyBytes))
 	return tx
 }
+
+// GenerateTxLiquidPaymentWithdraw return liquid payment withdraw transaction based on provided basic transaction and liquid payment id
+func GenerateTxLiquidPaymentWithdraw(tx *model.Transaction, transactionID int64) *model.Transaction {
+	txBody := &model.LiquidPaymentWithdrawTransactionBody{
+		TransactionID: transactionID,
+	}
+	tx.TransactionType = util.ConvertBytesToUint32(txTypeMap["liquidPaymentWithdraw"])
+	tx.TransactionBody = &model.Transaction_LiquidPaymentWithdrawTransactionBody{
+		LiquidPaymentWithdrawTransactionBody: txBody,
+	}
+	txBodyBytes, _ := (&transaction.LiquidPaymentWithdrawTransaction{
+		Body: txBody,
+	}).GetBodyBytes()
+	tx.TransactionBodyBytes = txBodyBytes
+	tx.TransactionBodyLength = uint32(len(txBodyBytes))
+	return tx
+}
+
+// GenerateTxHtlcLock return htlc lock transaction based on provided basic transaction, amount, hash lock and time lock height
+func GenerateTxHtlcLock(tx *model.Transaction, sendAmount int64, hashLockHex string, timeLockHeight uint32) *model.Transaction {
+	hashLock, err := hex.DecodeString(hashLockHex)
+	if err != nil {
+		panic(fmt.Sprintln("failed decode hashLockHex, ", err.Error()))
+	}
+	txBody := &model.HtlcLockTransactionBody{
+		Amount:         sendAmount,
+		HashLock:       hashLock,
+		TimeLockHeight: timeLockHeight,
+	}
+	tx.TransactionType = util.ConvertBytesToUint32(txTypeMap["htlcLock"])
+	tx.TransactionBody = &model.Transaction_HtlcLockTransactionBody{
+		HtlcLockTransactionBody: txBody,
+	}
+	txBodyBytes, _ := (&transaction.HtlcLockTransaction{
+		Body: txBody,
+	}).GetBodyBytes()
+	tx.TransactionBodyBytes = txBodyBytes
+	tx.TransactionBodyLength = uint32(len(txBodyBytes))
+	return tx
+}
+
+// GenerateTxHtlcClaim return htlc claim transaction based on provided basic transaction, htlc id and preimage
+func GenerateTxHtlcClaim(tx *model.Transaction, htlcID int64, preimageHex string) *model.Transaction {
+	preimage, err := hex.DecodeString(preimageHex)
+	if err != nil {
+		panic(fmt.Sprintln("failed decode preimageHex, ", err.Error()))
+	}
+	txBody := &model.HtlcClaimTransactionBody{
+		HtlcID:   htlcID,
+		Preimage: preimage,
+	}
+	tx.TransactionType = util.ConvertBytesToUint32(txTypeMap["htlcClaim"])
+	tx.TransactionBody = &model.Transaction_HtlcClaimTransactionBody{
+		HtlcClaimTransactionBody: txBody,
+	}
+	txBodyBytes, _ := (&transaction.HtlcClaimTransaction{
+		Body: txBody,
+	}).GetBodyBytes()
+	tx.TransactionBodyBytes = txBodyBytes
+	tx.TransactionBodyLength = uint32(len(txBodyBytes))
+	return tx
+}
+
+// GenerateTxHtlcRefund return htlc refund transaction based on provided basic transaction and htlc id
+func GenerateTxHtlcRefund(tx *model.Transaction, htlcID int64) *model.Transaction {
+	txBody := &model.HtlcRefundTransactionBody{
+		HtlcID: htlcID,
+	}
+	tx.TransactionType = util.ConvertBytesToUint32(txTypeMap["htlcRefund"])
+	tx.TransactionBody = &model.Transaction_HtlcRefundTransactionBody{
+		HtlcRefundTransactionBody: txBody,
+	}
+	txBodyBytes, _ := (&transaction.HtlcRefundTransaction{
+		Body: txBody,
+	}).GetBodyBytes()
+	tx.TransactionBodyBytes = txBodyBytes
+	tx.TransactionBodyLength = uint32(len(txBodyBytes))
+	return tx
+}
+
+// GenerateTxMultiSignatureRotation return multisig rotation transaction based on provided basic transaction and new participants
+func GenerateTxMultiSignatureRotation(
+	tx *model.Transaction,
+	minSignature uint32,
+	addressesHex []string,
+	participantWeights []uint,
+) *model.Transaction {
+	var (
+		addresses   [][]byte
+		fullWeights []uint32
+	)
+	for _, addrHex := range addressesHex {
+		decodedAddr, err := hex.DecodeString(addrHex)
+		if err != nil {
+			return nil
+		}
+		addresses = append(addresses, decodedAddr)
+	}
+	for _, weight := range participantWeights {
+		fullWeights = append(fullWeights, uint32(weight))
+	}
+	txBody := &model.MultiSignatureRotationTransactionBody{
+		MinimumSignatures: minSignature,
+		Addresses:         addresses,
+		Weights:           fullWeights,
+	}
+	tx.TransactionType = util.ConvertBytesToUint32(txTypeMap["multiSignatureRotation"])
+	tx.TransactionBody = &model.Transaction_MultiSignatureRotationTransactionBody{
+		MultiSignatureRotationTransactionBody: txBody,
+	}
+	txBodyBytes, _ := (&transaction.MultiSignatureRotationTransaction{
+		Body: txBody,
+	}).GetBodyBytes()
+	tx.TransactionBodyBytes = txBodyBytes
+	tx.TransactionBodyLength = uint32(len(txBodyBytes))
+	return tx
+}
+
+// GenerateTxMultiSignatureCancel return multisig cancel transaction based on provided basic transaction and pending transaction hash
+func GenerateTxMultiSignatureCancel(tx *model.Transaction, transactionHashHex string) *model.Transaction {
+	transactionHash, err := hex.DecodeString(transactionHashHex)
+	if err != nil {
+		return nil
+	}
+	txBody := &model.MultiSignatureCancelTransactionBody{
+		TransactionHash: transactionHash,
+	}
+	tx.TransactionType = util.ConvertBytesToUint32(txTypeMap["multiSignatureCancel"])
+	tx.TransactionBody = &model.Transaction_MultiSignatureCancelTransactionBody{
+		MultiSignatureCancelTransactionBody: txBody,
+	}
+	txBodyBytes, _ := (&transaction.MultiSignatureCancelTransaction{
+		Body: txBody,
+	}).GetBodyBytes()
+	tx.TransactionBodyBytes = txBodyBytes
+	tx.TransactionBodyLength = uint32(len(txBodyBytes))
+	return tx
+}
@@ -79,7 +79,7 @@ type (
 		IsEqual(acc AccountTypeInterface) bool
 		// GetSignatureType return the signature type number for this account type
 		GetSignatureType() model.SignatureType
-		// GetSignatureLength return the signature length for this account type
+		// GetSignatureLength return the signature length for this account type, 0 for variable length signatures ending the bytes
 		GetSignatureLength() uint32
 
 		// Sign accept a payload to be signed with an account seed then return the signature byte based on the
@@ -76,6 +76,8 @@ func NewAccountType(accTypeInt int32, accPubKey []byte) (AccountTypeInterface, e
 		acc = &EstoniaEidAccountType{}
 	case int32(model.AccountType_ETHAccountType):
 		acc = &ETHAccountType{}
+	case int32(model.AccountType_WebAuthnAccountType):
+		acc = &WebAuthnAccountType{}
 	default:
 		return nil, errors.New("InvalidAccountType")
 	}
@@ -113,6 +115,26 @@ func ParseBytesToAccountType(buffer *bytes.Buffer) (AccountTypeInterface, error)
 	return acc, nil
 }
 
+// ParseBytesToAccountAddresses parse the full account addresses (account type + account public key) concatenated in addressesBytes
+func ParseBytesToAccountAddresses(addressesBytes []byte) ([][]byte, error) {
+	var (
+		addresses [][]byte
+		buffer    = bytes.NewBuffer(addressesBytes)
+	)
+	for buffer.Len() > 0 {
+		acc, err := ParseBytesToAccountType(buffer)
+		if err != nil {
+			return nil, err
+		}
+		address, err := acc.GetAccountAddress()
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
 // ParseEncodedAccountToAccountAddress parse an encoded account type into a full account address ([]byte)
 // Note: we must know the account type first to do it
 func ParseEncodedAccountToAccountAddress(accTypeInt int32, encodedAccountAddress string) ([]byte, error) {
@@ -148,6 +170,18 @@ func ParseEncodedAccountToAccountAddress(accTypeInt int32, encodedAccountAddress
 		if err != nil {
 			return nil, err
 		}
+		// ethereum public keys only verify signatures within an ethereum account address
+		ethereumAccountType.SetAccountPublicKey(accPubKey)
+		return ethereumAccountType.GetAccountAddress()
+	case int32(model.AccountType_WebAuthnAccountType):
+		webAuthnAccountType := &WebAuthnAccountType{}
+		accPubKey, err = webAuthnAccountType.DecodePublicKeyFromAddress(encodedAccountAddress)
+		if err != nil {
+			return nil, err
+		}
+		// webauthn public keys only verify assertions within a webauthn account address
+		webAuthnAccountType.SetAccountPublicKey(accPubKey)
+		return webAuthnAccountType.GetAccountAddress()
 	default:
 		return nil, errors.New("InvalidAccountType")
 	}
@@ -161,13 +195,15 @@ func ParseEncodedAccountToAccountAddress(accTypeInt int32, encodedAccountAddress
 // GetAccountTypes returns all AccountTypeInterface (useful for loops)
 func GetAccountTypes() map[uint32]AccountTypeInterface {
 	var (
-		zbcAccount   = &ZbcAccountType{}
-		dummyAccount = &BTCAccountType{}
-		emptyAccount = &EmptyAccountType{}
+		zbcAccount      = &ZbcAccountType{}
+		dummyAccount    = &BTCAccountType{}
+		emptyAccount    = &EmptyAccountType{}
+		webAuthnAccount = &WebAuthnAccountType{}
 	)
 	return map[uint32]AccountTypeInterface{
-		uint32(zbcAccount.GetTypeInt()):   zbcAccount,
-		uint32(dummyAccount.GetTypeInt()): dummyAccount,
-		uint32(emptyAccount.GetTypeInt()): dummyAccount,
+		uint32(zbcAccount.GetTypeInt()):      zbcAccount,
+		uint32(dummyAccount.GetTypeInt()):    dummyAccount,
+		uint32(emptyAccount.GetTypeInt()):    dummyAccount,
+		uint32(webAuthnAccount.GetTypeInt()): webAuthnAccount,
 	}
 }
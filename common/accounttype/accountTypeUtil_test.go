@@ -51,6 +51,7 @@ package accounttype
 
 import (
 	"bytes"
+	"encoding/hex"
 	"reflect"
 	"testing"
 
@@ -59,9 +60,10 @@ import (
 
 func TestGetAccountTypes(t *testing.T) {
 	var (
-		zbcAccount   = &ZbcAccountType{}
-		dummyAccount = &BTCAccountType{}
-		emptyAccount = &EmptyAccountType{}
+		zbcAccount      = &ZbcAccountType{}
+		dummyAccount    = &BTCAccountType{}
+		emptyAccount    = &EmptyAccountType{}
+		webAuthnAccount = &WebAuthnAccountType{}
 	)
 	tests := []struct {
 		name string
@@ -70,9 +72,10 @@ func TestGetAccountTypes(t *testing.T) {
 		{
 			name: "TestGetAccountTypes:success",
 			want: map[uint32]AccountTypeInterface{
-				uint32(zbcAccount.GetTypeInt()):   zbcAccount,
-				uint32(dummyAccount.GetTypeInt()): dummyAccount,
-				uint32(emptyAccount.GetTypeInt()): dummyAccount,
+				uint32(zbcAccount.GetTypeInt()):      zbcAccount,
+				uint32(dummyAccount.GetTypeInt()):    dummyAccount,
+				uint32(emptyAccount.GetTypeInt()):    dummyAccount,
+				uint32(webAuthnAccount.GetTypeInt()): webAuthnAccount,
 			},
 		},
 	}
@@ -99,6 +102,8 @@ func TestNewAccountType(t *testing.T) {
 		124, 253, 52, 152, 112, 82, 88, 221, 180, 157, 254, 37, 187, 160, 10, 85, 94, 72, 203, 53, 167, 159,
 		61, 8, 76, 226, 109, 186, 192, 230, 187, 136, 116, 99, 119, 72, 23, 203, 128, 232, 155, 32, 192, 153,
 		11, 196, 127, 144, 117, 213})
+	webAuthnAccType := &WebAuthnAccountType{}
+	webAuthnAccType.SetAccountPublicKey([]byte{4, 1, 2, 3})
 	type args struct {
 		accTypeInt int32
 		accPubKey  []byte
@@ -151,6 +156,14 @@ func TestNewAccountType(t *testing.T) {
 			},
 			want: ethAccType,
 		},
+		{
+			name: "TestNewAccountType:success/WebAuthnAccountType",
+			args: args{
+				accPubKey:  []byte{4, 1, 2, 3},
+				accTypeInt: 5,
+			},
+			want: webAuthnAccType,
+		},
 		{
 			name: "TestNewAccountType:fail-{invalidAccountType}",
 			args: args{
@@ -272,6 +285,47 @@ func TestParseBytesToAccountType(t *testing.T) {
 	}
 }
 
+func TestParseBytesToAccountAddresses(t *testing.T) {
+	var (
+		fullAddress1 = []byte{0, 0, 0, 0, 149, 1, 110, 5, 224, 150, 132, 85, 59, 205, 45, 168, 107, 143, 209, 215, 181, 221, 109, 23, 39,
+			95, 248, 147, 114, 91, 115, 75, 51, 31, 148, 108}
+		fullAddress2 = []byte{0, 0, 0, 0, 4, 38, 68, 24, 230, 247, 88, 220, 119, 124, 51, 149, 127, 214, 82, 224, 72, 239, 56, 139, 255,
+			81, 229, 184, 77, 80, 80, 39, 254, 173, 28, 169}
+	)
+	tests := []struct {
+		name           string
+		addressesBytes []byte
+		want           [][]byte
+		wantErr        bool
+	}{
+		{
+			name: "TestParseBytesToAccountAddresses:empty",
+		},
+		{
+			name:           "TestParseBytesToAccountAddresses:success",
+			addressesBytes: append(append([]byte{}, fullAddress1...), fullAddress2...),
+			want:           [][]byte{fullAddress1, fullAddress2},
+		},
+		{
+			name:           "TestParseBytesToAccountAddresses:fail-{InvalidAccountPubKey}",
+			addressesBytes: append(append([]byte{}, fullAddress1...), fullAddress2[:20]...),
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBytesToAccountAddresses(tt.addressesBytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseBytesToAccountAddresses() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseBytesToAccountAddresses() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestParseEncodedAccountToAccountAddress(t *testing.T) {
 	var (
 		encAddress1  = "ZBC_SUAW4BPA_S2CFKO6N_FWUGXD6R_262523IX_E5P7RE3S_LNZUWMY7_SRWCMI2J"
@@ -304,6 +358,38 @@ func TestParseEncodedAccountToAccountAddress(t *testing.T) {
 			},
 			want: []byte{0, 0, 0, 0, 13, 137, 40, 212, 218, 119, 144, 80, 70, 113, 150, 129, 2, 84, 45, 144, 145, 17, 64, 134},
 		},
+		{
+			name: "TestParseEncodedAccountToAccountAddress:success-ethImplementation",
+			args: args{
+				encodedAccountAddress: "0x" + hex.EncodeToString(mockETHPublicKey),
+				accTypeInt:            int32(model.AccountType_ETHAccountType),
+			},
+			want: append([]byte{4, 0, 0, 0}, mockETHPublicKey...),
+		},
+		{
+			name: "TestParseEncodedAccountToAccountAddress:fail-{ethAddressWithoutSignature}",
+			args: args{
+				encodedAccountAddress: mockETHAddress,
+				accTypeInt:            int32(model.AccountType_ETHAccountType),
+			},
+			wantErr: true,
+		},
+		{
+			name: "TestParseEncodedAccountToAccountAddress:success-webAuthnImplementation",
+			args: args{
+				encodedAccountAddress: hex.EncodeToString(mockWebAuthnPublicKey),
+				accTypeInt:            int32(model.AccountType_WebAuthnAccountType),
+			},
+			want: append([]byte{5, 0, 0, 0}, mockWebAuthnPublicKey...),
+		},
+		{
+			name: "TestParseEncodedAccountToAccountAddress:fail-{webAuthnKeyNotOnCurve}",
+			args: args{
+				encodedAccountAddress: hex.EncodeToString(append([]byte{4}, make([]byte, 64)...)),
+				accTypeInt:            int32(model.AccountType_WebAuthnAccountType),
+			},
+			wantErr: true,
+		},
 		{
 			name: "TestParseEncodedAccountToAccountAddress:fail-{InvalidAccountType}",
 			args: args{
@@ -51,6 +51,7 @@ package accounttype
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"errors"
 	"github.com/btcsuite/btcd/btcec"
@@ -120,8 +121,9 @@ func (acc *BTCAccountType) GetSignatureType() model.SignatureType {
 	return model.SignatureType_BitcoinSignature
 }
 
+// GetSignatureLength bitcoin signatures embed the signer public key and have a variable length, 0 as they end the transaction bytes
 func (acc *BTCAccountType) GetSignatureLength() uint32 {
-	return constant.BTCECDSASignatureLength
+	return constant.BTCSignatureLength
 }
 
 func (acc *BTCAccountType) GetEncodedAddress() (string, error) {
@@ -162,6 +164,13 @@ func (acc *BTCAccountType) GenerateAccountFromSeed(seed string, optionalParams .
 	if err != nil {
 		return err
 	}
+	if publicKeyFormat == model.BitcoinPublicKeyFormat_PublicKeyFormatXOnly {
+		// x-only accounts are addressed by the compressed public key of the even y point their x-only public key stands for
+		acc.publicKey, err = signaturetype.NewBitcoinSchnorrSignature(bitcoinSignature.Curve).GetPublicKeyFromXOnlyPublicKey(acc.publicKey)
+		if err != nil {
+			return err
+		}
+	}
 	acc.encodedAddress, err = bitcoinSignature.GetAddressFromPublicKey(acc.publicKey)
 	if err != nil {
 		return err
@@ -196,6 +205,8 @@ func (acc *BTCAccountType) GetAccountPrivateKey() ([]byte, error) {
 	return acc.privateKey, nil
 }
 
+// Sign sign payload with the ecdsa private key of the seed, or with its bip-340 schnorr key when the optional
+// model.SignatureType_BitcoinSchnorrSignature is provided
 func (acc *BTCAccountType) Sign(payload []byte, seed string, optionalParams ...interface{}) ([]byte, error) {
 	var (
 		bitcoinSignature       = signaturetype.NewBitcoinSignature(signaturetype.DefaultBitcoinNetworkParams(), signaturetype.DefaultBitcoinCurve())
@@ -205,6 +216,9 @@ func (acc *BTCAccountType) Sign(payload []byte, seed string, optionalParams ...i
 	if err != nil {
 		return nil, err
 	}
+	if len(optionalParams) > 0 && optionalParams[0] == model.SignatureType_BitcoinSchnorrSignature {
+		return acc.signSchnorr(payload, accountPrivateKey)
+	}
 	accountPublicKey, err := bitcoinSignature.GetPublicKeyFromPrivateKey(
 		accountPrivateKey,
 		signaturetype.DefaultBitcoinPublicKeyFormat(),
@@ -225,16 +239,99 @@ func (acc *BTCAccountType) Sign(payload []byte, seed string, optionalParams ...i
 	return buffer.Bytes(), nil
 }
 
+// signSchnorr bip-340 schnorr signature of the sha256 of the payload: x-only public key length (2 bytes) + x-only public key +
+// signature
+func (acc *BTCAccountType) signSchnorr(payload []byte, accountPrivateKey *btcec.PrivateKey) ([]byte, error) {
+	var (
+		schnorrSignature    = signaturetype.NewBitcoinSchnorrSignature(signaturetype.DefaultBitcoinCurve())
+		buffer              = bytes.NewBuffer([]byte{})
+		message             = sha256.Sum256(payload)
+		xOnlyPublicKey, err = schnorrSignature.GetXOnlyPublicKey(accountPrivateKey)
+	)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := schnorrSignature.Sign(accountPrivateKey, message[:], nil)
+	if err != nil {
+		return nil, err
+	}
+	buffer.Write(convertUint16ToBytes(uint16(len(xOnlyPublicKey))))
+	buffer.Write(xOnlyPublicKey)
+	buffer.Write(signature)
+	return buffer.Bytes(), nil
+}
+
+// ParseSchnorrSignature parse the x-only public key and the bip-340 signature of a schnorr signature of the account, nil for
+// the ecdsa ones. The x-only public key is checked against the account public key, not the signature
+func (acc *BTCAccountType) ParseSchnorrSignature(signature []byte) (xOnlyPublicKey, schnorrSignature []byte, err error) {
+	return parseSchnorrSignature(signature, acc.GetAccountPublicKey())
+}
+
+func parseSchnorrSignature(signature, accountPublicKey []byte) (xOnlyPublicKey, schnorrSignature []byte, err error) {
+	var (
+		pubKeyFirstBytesIndex    = 2
+		signatureFirstBytesIndex = pubKeyFirstBytesIndex + constant.BTCXOnlyPublicKeyLength
+	)
+	if len(signature) < pubKeyFirstBytesIndex ||
+		convertBytesToUint16(signature[:pubKeyFirstBytesIndex]) != constant.BTCXOnlyPublicKeyLength {
+		return nil, nil, nil
+	}
+	if len(signature) != signatureFirstBytesIndex+constant.BTCSchnorrSignatureLength {
+		return nil, nil, blocker.NewBlocker(blocker.ValidationErr, "InvalidSchnorrSignatureLength")
+	}
+	accountXOnlyPublicKey, err := signaturetype.NewBitcoinSchnorrSignature(signaturetype.DefaultBitcoinCurve()).
+		GetXOnlyPublicKeyFromPublicKey(accountPublicKey)
+	if err != nil {
+		return nil, nil, blocker.NewBlocker(blocker.ValidationErr, err.Error())
+	}
+	xOnlyPublicKey = signature[pubKeyFirstBytesIndex:signatureFirstBytesIndex]
+	if !bytes.Equal(xOnlyPublicKey, accountXOnlyPublicKey) {
+		return nil, nil, blocker.NewBlocker(
+			blocker.ValidationErr,
+			"invalidAccountAddressOrSignaturePublicKey",
+		)
+	}
+	return xOnlyPublicKey, signature[signatureFirstBytesIndex:], nil
+}
+
 func (acc *BTCAccountType) VerifySignature(payload, signature, fullAccountAddress []byte) error {
+	accType, err := ParseBytesToAccountType(bytes.NewBuffer(fullAccountAddress))
+	if err != nil {
+		return err
+	}
+	xOnlyPublicKey, schnorrSignature, err := parseSchnorrSignature(signature, accType.GetAccountPublicKey())
+	if err != nil {
+		return err
+	}
+	if xOnlyPublicKey != nil {
+		message := sha256.Sum256(payload)
+		if !signaturetype.NewBitcoinSchnorrSignature(signaturetype.DefaultBitcoinCurve()).Verify(xOnlyPublicKey, message[:], schnorrSignature) {
+			return blocker.NewBlocker(
+				blocker.ValidationErr,
+				"InvalidSignature",
+			)
+		}
+		return nil
+	}
+	return acc.verifyECDSASignature(payload, signature, fullAccountAddress)
+}
+
+func (acc *BTCAccountType) verifyECDSASignature(payload, signature, fullAccountAddress []byte) error {
 	var (
 		bitcoinSignature = signaturetype.NewBitcoinSignature(signaturetype.DefaultBitcoinNetworkParams(), signaturetype.DefaultBitcoinCurve())
 		// first 2 bytes are the public key length
-		pubKeyFirstBytesIndex    = 2
-		pubKeyBytesLength        = convertBytesToUint16(signature[:pubKeyFirstBytesIndex])
-		signatureFirstBytesIndex = pubKeyFirstBytesIndex + int(pubKeyBytesLength)
-		signaturePubKeyBytes     = signature[pubKeyFirstBytesIndex:signatureFirstBytesIndex]
-		signaturePubKey, err     = bitcoinSignature.GetPublicKeyFromBytes(signaturePubKeyBytes)
+		pubKeyFirstBytesIndex = 2
 	)
+	// the signature is variable length, the public key length and the public key must fit in it
+	if len(signature) < pubKeyFirstBytesIndex {
+		return blocker.NewBlocker(blocker.ValidationErr, "InvalidSignatureLength")
+	}
+	signatureFirstBytesIndex := pubKeyFirstBytesIndex + int(convertBytesToUint16(signature[:pubKeyFirstBytesIndex]))
+	if len(signature) < signatureFirstBytesIndex {
+		return blocker.NewBlocker(blocker.ValidationErr, "InvalidSignatureLength")
+	}
+	signaturePubKeyBytes := signature[pubKeyFirstBytesIndex:signatureFirstBytesIndex]
+	signaturePubKey, err := bitcoinSignature.GetPublicKeyFromBytes(signaturePubKeyBytes)
 	if err != nil {
 		return blocker.NewBlocker(
 			blocker.ValidationErr,
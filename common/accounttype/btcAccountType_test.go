@@ -97,6 +97,17 @@ func TestBTCAccountType_GenerateAccountFromSeed(t *testing.T) {
 			},
 			want: "1FjUuYPZHz3D9kvEj21uiwE3JwYNemQqcv",
 		},
+		{
+			name: "GenerateAccountFromSeed:success-{xOnlyPublicKeyFormat}",
+			args: args{
+				seed: seed,
+				optionalParams: []interface{}{
+					model.PrivateKeyBytesLength_PrivateKey256Bits,
+					model.BitcoinPublicKeyFormat_PublicKeyFormatXOnly,
+				},
+			},
+			want: "1JM6TsPczuauXSBqq96CK7Uo38eLcXgzkn",
+		},
 		{
 			name: "GenerateAccountFromSeed:success-{invalidOptionalParams}",
 			args: args{
@@ -526,7 +537,7 @@ func TestBTCAccountType_GetSignatureLength(t *testing.T) {
 	}{
 		{
 			name: "GetSignatureLength:success",
-			want: constant.BTCECDSASignatureLength,
+			want: constant.BTCSignatureLength,
 		},
 	}
 	for _, tt := range tests {
@@ -681,6 +692,10 @@ func TestBTCAccountType_Sign(t *testing.T) {
 			12, 5, 166, 141, 205, 177, 156, 77, 122, 48, 69, 2, 33, 0, 184, 15, 123, 55, 191, 208, 195, 227, 186, 140, 100, 21, 170, 80,
 			65, 31, 156, 163, 120, 194, 142, 121, 103, 105, 146, 3, 242, 162, 86, 169, 141, 211, 2, 32, 16, 140, 220, 132, 123, 128, 89,
 			152, 29, 29, 91, 239, 70, 201, 42, 173, 210, 30, 218, 205, 224, 93, 135, 46, 221, 182, 148, 15, 252, 76, 119, 145}
+		accountAddress = []byte{1, 0, 0, 0, 3, 82, 247, 192, 243, 36, 207, 71, 90, 3, 103, 220, 47, 115, 64, 15, 13, 59, 186, 231, 45, 42,
+			149, 73, 12, 5, 166, 141, 205, 177, 156, 77, 122}
+		schnorrSignaturePrefix = []byte{32, 0, 82, 247, 192, 243, 36, 207, 71, 90, 3, 103, 220, 47, 115, 64, 15, 13, 59, 186, 231, 45, 42,
+			149, 73, 12, 5, 166, 141, 205, 177, 156, 77, 122}
 	)
 	type fields struct {
 		privateKey      []byte
@@ -709,6 +724,16 @@ func TestBTCAccountType_Sign(t *testing.T) {
 			},
 			want: signature,
 		},
+		{
+			// schnorr signatures are randomized, only the x-only public key is fixed
+			name: "Sign:success-{schnorr}",
+			args: args{
+				seed:           seed,
+				payload:        payload,
+				optionalParams: []interface{}{model.SignatureType_BitcoinSchnorrSignature},
+			},
+			want: schnorrSignaturePrefix,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -724,6 +749,12 @@ func TestBTCAccountType_Sign(t *testing.T) {
 				t.Errorf("Sign() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if len(got) == len(tt.want)+int(constant.BTCSchnorrSignatureLength) {
+				if err := acc.VerifySignature(tt.args.payload, got, accountAddress); err != nil {
+					t.Errorf("Sign() schnorr signature not verified: %v", err)
+				}
+				got = got[:len(tt.want)]
+			}
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Sign() got = %v, want %v", got, tt.want)
 			}
@@ -740,6 +771,10 @@ func TestBTCAccountType_VerifySignature(t *testing.T) {
 			12, 5, 166, 141, 205, 177, 156, 77, 122, 48, 69, 2, 33, 0, 184, 15, 123, 55, 191, 208, 195, 227, 186, 140, 100, 21, 170, 80,
 			65, 31, 156, 163, 120, 194, 142, 121, 103, 105, 146, 3, 242, 162, 86, 169, 141, 211, 2, 32, 16, 140, 220, 132, 123, 128, 89,
 			152, 29, 29, 91, 239, 70, 201, 42, 173, 210, 30, 218, 205, 224, 93, 135, 46, 221, 182, 148, 15, 252, 76, 119, 145}
+		schnorrSignature = []byte{32, 0, 82, 247, 192, 243, 36, 207, 71, 90, 3, 103, 220, 47, 115, 64, 15, 13, 59, 186, 231, 45, 42, 149,
+			73, 12, 5, 166, 141, 205, 177, 156, 77, 122, 163, 90, 33, 121, 148, 238, 34, 131, 206, 132, 138, 157, 169, 82, 143, 156, 32,
+			26, 197, 162, 229, 208, 215, 8, 151, 155, 129, 35, 235, 121, 251, 120, 245, 53, 220, 139, 98, 203, 198, 38, 211, 33, 166, 204,
+			76, 201, 192, 185, 195, 148, 179, 18, 75, 44, 152, 107, 149, 220, 186, 72, 171, 140, 160, 245}
 	)
 	type fields struct {
 		privateKey      []byte
@@ -767,6 +802,78 @@ func TestBTCAccountType_VerifySignature(t *testing.T) {
 				signature:          signature,
 			},
 		},
+		{
+			name: "VerifySignature:success-{schnorr}",
+			args: args{
+				fullAccountAddress: accountAddress,
+				payload:            payload,
+				signature:          schnorrSignature,
+			},
+		},
+		{
+			name: "VerifySignature:fail-{schnorrWrongPayload}",
+			args: args{
+				fullAccountAddress: accountAddress,
+				payload:            []byte{1, 2, 4},
+				signature:          schnorrSignature,
+			},
+			wantErr: true,
+		},
+		{
+			name: "VerifySignature:fail-{schnorrInvalidLength}",
+			args: args{
+				fullAccountAddress: accountAddress,
+				payload:            payload,
+				signature:          schnorrSignature[:len(schnorrSignature)-1],
+			},
+			wantErr: true,
+		},
+		{
+			name: "VerifySignature:fail-{emptySignature}",
+			args: args{
+				fullAccountAddress: accountAddress,
+				payload:            payload,
+				signature:          []byte{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "VerifySignature:fail-{oneByteSignature}",
+			args: args{
+				fullAccountAddress: accountAddress,
+				payload:            payload,
+				signature:          signature[:1],
+			},
+			wantErr: true,
+		},
+		{
+			name: "VerifySignature:fail-{publicKeyLongerThanSignature}",
+			args: args{
+				fullAccountAddress: accountAddress,
+				payload:            payload,
+				signature:          signature[:3],
+			},
+			wantErr: true,
+		},
+		{
+			name: "VerifySignature:fail-{noSignatureAfterPublicKey}",
+			args: args{
+				fullAccountAddress: accountAddress,
+				payload:            payload,
+				signature:          signature[:35],
+			},
+			wantErr: true,
+		},
+		{
+			name: "VerifySignature:fail-{schnorrPublicKeyNotMatchingAccount}",
+			args: args{
+				fullAccountAddress: accountAddress,
+				payload:            payload,
+				signature: append([]byte{32, 0, 249, 48, 138, 1, 146, 88, 195, 16, 73, 52, 79, 133, 248, 157, 82, 41, 184, 101, 200, 77,
+					210, 157, 201, 189, 64, 4, 131, 237, 157, 32, 174, 6}, schnorrSignature[34:]...),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
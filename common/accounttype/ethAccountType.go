@@ -54,11 +54,14 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/zoobc/zoobc-core/common/blocker"
 	"github.com/zoobc/zoobc-core/common/constant"
 	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/signaturetype"
 	"golang.org/x/crypto/sha3"
 )
 
@@ -137,25 +140,104 @@ func (acc *ETHAccountType) GetEncodedAddress() (string, error) {
 	return hexutil.Encode(hash.Sum(nil)[12:]), nil
 }
 
+// DecodePublicKeyFromAddress decode a hex encoded public key, with or without its 0x04 prefix.
+// The 20 bytes ethereum address is a hash of the public key, use RecoverPublicKeyFromAddress to resolve it
 func (acc *ETHAccountType) DecodePublicKeyFromAddress(address string) ([]byte, error) {
-	return nil, errors.New("NoImplementation")
+	publicKey, err := hexutil.Decode(address)
+	if err != nil && !strings.HasPrefix(address, "0x") {
+		publicKey, err = hexutil.Decode("0x" + address)
+	}
+	if err != nil {
+		return nil, blocker.NewBlocker(blocker.ParserErr, err.Error())
+	}
+	if len(publicKey) == int(acc.GetAccountPublicKeyLength())+1 && publicKey[0] == 4 {
+		publicKey = publicKey[1:]
+	}
+	switch len(publicKey) {
+	case int(acc.GetAccountPublicKeyLength()):
+		return publicKey, nil
+	case 20:
+		return nil, blocker.NewBlocker(blocker.ParserErr, "EthereumAddressNeedSignatureToRecoverPublicKey")
+	default:
+		return nil, blocker.NewBlocker(blocker.ParserErr, "InvalidEthereumPublicKeyLength")
+	}
 }
 
+// RecoverPublicKeyFromAddress recover the public key of a 20 bytes ethereum address from a signature of payload by this address
+func (acc *ETHAccountType) RecoverPublicKeyFromAddress(address string, payload, signature []byte) ([]byte, error) {
+	ethereumSignature := signaturetype.NewEthereumSignature()
+	publicKey, err := ethereumSignature.GetPublicKeyFromSignature(payload, signature)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(strings.TrimPrefix(ethereumSignature.GetAddressFromPublicKey(publicKey), "0x"), strings.TrimPrefix(address, "0x")) {
+		return nil, blocker.NewBlocker(blocker.ValidationErr, "SignatureNotFromAddress")
+	}
+	return publicKey, nil
+}
+
+// GenerateAccountFromSeed derive the account from a bip39 mnemonic along the m/44'/60'/account'/0/index path of the ethereum
+// wallets. optionalParams are the mnemonic password (string), the account and the address index (uint32), all defaulting to zero
 func (acc *ETHAccountType) GenerateAccountFromSeed(seed string, optionalParams ...interface{}) error {
-	return errors.New("NoImplementation")
+	var (
+		ethereumSignature = signaturetype.NewEthereumSignature()
+		password          string
+		account, index    uint32
+		ok                bool
+	)
+	if len(optionalParams) >= 1 {
+		password, ok = optionalParams[0].(string)
+		if !ok {
+			return blocker.NewBlocker(blocker.AppErr, "failedAssertPasswordType")
+		}
+	}
+	if len(optionalParams) >= 3 {
+		account, ok = optionalParams[1].(uint32)
+		if !ok {
+			return blocker.NewBlocker(blocker.AppErr, "failedAssertAccountType")
+		}
+		index, ok = optionalParams[2].(uint32)
+		if !ok {
+			return blocker.NewBlocker(blocker.AppErr, "failedAssertAddressIndexType")
+		}
+	}
+	privateKey, err := ethereumSignature.GetPrivateKeyFromSeed(seed, password, signaturetype.GetEthereumDerivationPath(account, index))
+	if err != nil {
+		return err
+	}
+	publicKey, err := ethereumSignature.GetPublicKeyFromPrivateKey(privateKey)
+	if err != nil {
+		return err
+	}
+	acc.privateKey = privateKey
+	acc.publicKey = publicKey
+	acc.fullAddress = nil
+	return nil
 }
 
+// GetAccountPublicKeyString return the hex encoded public key, the string DecodePublicKeyFromAddress parses back
 func (acc *ETHAccountType) GetAccountPublicKeyString() (string, error) {
-	return acc.GetEncodedAddress()
+	if len(acc.GetAccountPublicKey()) == 0 {
+		return "", errors.New("EmptyAccountPublicKey")
+	}
+	return hexutil.Encode(acc.GetAccountPublicKey()), nil
 }
 
-// GetAccountPrivateKey: we don't store neither generate private key of this account type as the private key resides in the eID
+// GetAccountPrivateKey return the private key of an account generated from its seed
 func (acc *ETHAccountType) GetAccountPrivateKey() ([]byte, error) {
+	if len(acc.privateKey) == 0 {
+		return nil, blocker.NewBlocker(blocker.AppErr, "AccountNotGenerated")
+	}
 	return acc.privateKey, nil
 }
 
-// Sign: Sign the payload with ethereum private key
+// Sign: Sign the payload with ethereum private key, generating the account from seed when it has not been yet
 func (acc *ETHAccountType) Sign(payload []byte, seed string, optionalParams ...interface{}) ([]byte, error) {
+	if len(acc.privateKey) == 0 {
+		if err := acc.GenerateAccountFromSeed(seed, optionalParams...); err != nil {
+			return nil, err
+		}
+	}
 	hash := crypto.Keccak256Hash(payload)
 	privateKey, err := crypto.ToECDSA(acc.privateKey)
 	if err != nil {
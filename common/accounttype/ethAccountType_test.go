@@ -51,9 +51,21 @@ package accounttype
 
 import (
 	"encoding/hex"
+	"reflect"
 	"testing"
 )
 
+var (
+	mockETHPayload, _ = hex.DecodeString("0100000001df6f1b60000000000400000011f2b30c9479ccaa639962e943ca7cfd3498705258ddb49d" +
+		"fe25bba00a555e48cb35a79f3d084ce26dbac0e6bb887463774817cb80e89b20c0990bc47f9075d500000000e12c84a0fd461cbbec5" +
+		"956a66b2ebad0499491cff77f75b583d041d757d87fff00e1f505000000000800000000e1f505000000000200000000000000")
+	mockETHSignature, _ = hex.DecodeString("c79984b222e95f095df054be5533fbc92f95f078b375d2985472bc96012176da2442dcbfe274ffe6a" +
+		"0f4bf31bfc6093554aae00f105a37add43257c569eb8fe91c")
+	mockETHPublicKey, _ = hex.DecodeString("11f2b30c9479ccaa639962e943ca7cfd3498705258ddb49dfe25bba00a555e48cb35a79f3d084ce26db" +
+		"ac0e6bb887463774817cb80e89b20c0990bc47f9075d5")
+	mockETHAddress = "0xc2524c08e0166f6a3b8d9925f8864c8ee18cb729"
+)
+
 func TestETHAccountType_VerifySignature(t *testing.T) {
 	payload, _ := hex.DecodeString("0100000001df6f1b60000000000400000011f2b30c9479ccaa639962e943ca7cfd3498705258ddb49d" +
 		"fe25bba00a555e48cb35a79f3d084ce26dbac0e6bb887463774817cb80e89b20c0990bc47f9075d500000000e12c84a0fd461cbbec5" +
@@ -163,3 +175,177 @@ func TestETHAccountType_GetEncodedAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestETHAccountType_GenerateAccountFromSeed(t *testing.T) {
+	var (
+		mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	)
+	type args struct {
+		seed           string
+		optionalParams []interface{}
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantAddress string
+		wantErr     bool
+	}{
+		{
+			name: "wantError:InvalidMnemonic",
+			args: args{
+				seed: "abandon abandon abandon",
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:InvalidPasswordType",
+			args: args{
+				seed:           mnemonic,
+				optionalParams: []interface{}{1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:InvalidAddressIndexType",
+			args: args{
+				seed:           mnemonic,
+				optionalParams: []interface{}{"", uint32(0), 1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantSuccess",
+			args: args{
+				seed: mnemonic,
+			},
+			wantAddress: "0x9858effd232b4033e47d90003d41ec34ecaeda94",
+		},
+		{
+			name: "wantSuccess:AddressIndex",
+			args: args{
+				seed:           mnemonic,
+				optionalParams: []interface{}{"", uint32(0), uint32(1)},
+			},
+			wantAddress: "0x6fac4d18c912343bf86fa7049364dd4e424ab9c0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acc := &ETHAccountType{}
+			err := acc.GenerateAccountFromSeed(tt.args.seed, tt.args.optionalParams...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ETHAccountType.GenerateAccountFromSeed() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			gotAddress, err := acc.GetEncodedAddress()
+			if err != nil {
+				t.Errorf("ETHAccountType.GetEncodedAddress() error = %v", err)
+				return
+			}
+			if gotAddress != tt.wantAddress {
+				t.Errorf("ETHAccountType.GenerateAccountFromSeed() address = %v, want %v", gotAddress, tt.wantAddress)
+			}
+		})
+	}
+}
+
+func TestETHAccountType_DecodePublicKeyFromAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "wantError:InvalidHex",
+			address: "0xzz",
+			wantErr: true,
+		},
+		{
+			name:    "wantError:EthereumAddress",
+			address: mockETHAddress,
+			wantErr: true,
+		},
+		{
+			name:    "wantSuccess",
+			address: "0x" + hex.EncodeToString(mockETHPublicKey),
+			want:    mockETHPublicKey,
+		},
+		{
+			name:    "wantSuccess:PrefixedPublicKey",
+			address: "04" + hex.EncodeToString(mockETHPublicKey),
+			want:    mockETHPublicKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acc := &ETHAccountType{}
+			got, err := acc.DecodePublicKeyFromAddress(tt.address)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ETHAccountType.DecodePublicKeyFromAddress() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ETHAccountType.DecodePublicKeyFromAddress() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestETHAccountType_RecoverPublicKeyFromAddress(t *testing.T) {
+	type args struct {
+		address   string
+		payload   []byte
+		signature []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "wantError:OtherAddress",
+			args: args{
+				address:   "0x9858effd232b4033e47d90003d41ec34ecaeda94",
+				payload:   mockETHPayload,
+				signature: mockETHSignature,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:InvalidSignature",
+			args: args{
+				address:   mockETHAddress,
+				payload:   mockETHPayload,
+				signature: mockETHSignature[:64],
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantSuccess",
+			args: args{
+				address:   "0xC2524C08E0166F6A3B8D9925F8864C8EE18CB729",
+				payload:   mockETHPayload,
+				signature: mockETHSignature,
+			},
+			want: mockETHPublicKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acc := &ETHAccountType{}
+			got, err := acc.RecoverPublicKeyFromAddress(tt.args.address, tt.args.payload, tt.args.signature)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ETHAccountType.RecoverPublicKeyFromAddress() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ETHAccountType.RecoverPublicKeyFromAddress() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
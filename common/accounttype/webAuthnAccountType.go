@@ -0,0 +1,171 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package accounttype
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/signaturetype"
+)
+
+// WebAuthnAccountType account type of the P-256 (secp256r1) keys of webauthn authenticators (passkeys, security keys)
+type WebAuthnAccountType struct {
+	publicKey, fullAddress []byte
+}
+
+func (acc *WebAuthnAccountType) SetAccountPublicKey(accountPublicKey []byte) {
+	if accountPublicKey == nil {
+		acc.publicKey = make([]byte, 0)
+	}
+	acc.publicKey = accountPublicKey
+}
+
+func (acc *WebAuthnAccountType) GetAccountAddress() ([]byte, error) {
+	if acc.fullAddress != nil {
+		return acc.fullAddress, nil
+	}
+	if acc.GetAccountPublicKey() == nil {
+		return nil, errors.New("AccountAddressPublicKeyEmpty")
+	}
+	buff := bytes.NewBuffer([]byte{})
+	tmpBuf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(tmpBuf, uint32(acc.GetTypeInt()))
+	buff.Write(tmpBuf)
+	buff.Write(acc.GetAccountPublicKey())
+	acc.fullAddress = buff.Bytes()
+	return acc.fullAddress, nil
+}
+
+func (acc *WebAuthnAccountType) GetTypeInt() int32 {
+	return int32(model.AccountType_WebAuthnAccountType)
+}
+
+func (acc *WebAuthnAccountType) GetAccountPublicKey() []byte {
+	return acc.publicKey
+}
+
+func (acc *WebAuthnAccountType) GetAccountPrefix() string {
+	return "P256"
+}
+
+func (acc *WebAuthnAccountType) GetName() string {
+	return "WebAuthnAccount"
+}
+
+func (acc *WebAuthnAccountType) GetAccountPublicKeyLength() uint32 {
+	return constant.WebAuthnPublicKeyLength
+}
+
+func (acc *WebAuthnAccountType) IsEqual(acc2 AccountTypeInterface) bool {
+	return bytes.Equal(acc.GetAccountPublicKey(), acc2.GetAccountPublicKey()) && acc.GetTypeInt() == acc2.GetTypeInt()
+}
+
+func (acc *WebAuthnAccountType) GetSignatureType() model.SignatureType {
+	return model.SignatureType_WebAuthnSignature
+}
+
+// GetSignatureLength webauthn assertions have a variable length, 0 tells the parsers the signature ends the transaction bytes
+func (acc *WebAuthnAccountType) GetSignatureLength() uint32 {
+	return constant.WebAuthnSignatureLength
+}
+
+func (acc *WebAuthnAccountType) GetEncodedAddress() (string, error) {
+	if acc.GetAccountPublicKey() == nil || bytes.Equal(acc.GetAccountPublicKey(), []byte{}) {
+		return "", errors.New("EmptyAccountPublicKey")
+	}
+	return hex.EncodeToString(acc.GetAccountPublicKey()), nil
+}
+
+// DecodePublicKeyFromAddress decode the hex encoded uncompressed P-256 public key of the address
+func (acc *WebAuthnAccountType) DecodePublicKeyFromAddress(address string) ([]byte, error) {
+	publicKey, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	if len(publicKey) != constant.WebAuthnPublicKeyLength {
+		return nil, blocker.NewBlocker(blocker.ParserErr, "InvalidWebAuthnPublicKeyLength")
+	}
+	if x, _ := elliptic.Unmarshal(elliptic.P256(), publicKey); x == nil {
+		return nil, blocker.NewBlocker(blocker.ParserErr, "InvalidWebAuthnPublicKey")
+	}
+	return publicKey, nil
+}
+
+func (acc *WebAuthnAccountType) GenerateAccountFromSeed(seed string, optionalParams ...interface{}) error {
+	return errors.New("NoImplementation")
+}
+
+func (acc *WebAuthnAccountType) GetAccountPublicKeyString() (string, error) {
+	return acc.GetEncodedAddress()
+}
+
+// GetAccountPrivateKey: we don't store neither generate private key of this account type as the private key resides in the authenticator
+func (acc *WebAuthnAccountType) GetAccountPrivateKey() ([]byte, error) {
+	return nil, nil
+}
+
+// Sign: the assertions of this account type are signed by the webauthn authenticators (navigator.credentials.get)
+// so we don't sign for this type of account.
+func (acc *WebAuthnAccountType) Sign(payload []byte, seed string, optionalParams ...interface{}) ([]byte, error) {
+	return []byte{}, nil
+}
+
+// VerifySignature verify signature is a webauthn assertion of the account public key having payload as challenge
+func (acc *WebAuthnAccountType) VerifySignature(payload, signature, accountAddress []byte) error {
+	return signaturetype.NewWebAuthnSignature().Verify(acc.GetAccountPublicKey(), payload, signature)
+}
@@ -0,0 +1,252 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package accounttype
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/zoobc/zoobc-core/common/signaturetype"
+	"golang.org/x/crypto/sha3"
+)
+
+var (
+	mockWebAuthnPrivateKey = func() *ecdsa.PrivateKey {
+		key := &ecdsa.PrivateKey{D: big.NewInt(0xc0ffee)}
+		key.PublicKey.Curve = elliptic.P256()
+		key.PublicKey.X, key.PublicKey.Y = key.PublicKey.Curve.ScalarBaseMult(key.D.Bytes())
+		return key
+	}()
+	mockWebAuthnPublicKey = elliptic.Marshal(elliptic.P256(), mockWebAuthnPrivateKey.X, mockWebAuthnPrivateKey.Y)
+)
+
+// mockWebAuthnAssertion build the assertion a wallet posts for a transaction hash as challenge
+func mockWebAuthnAssertion(t *testing.T, challenge []byte) []byte {
+	var (
+		authenticatorData = append(make([]byte, 32), 0x01, 0, 0, 0, 1)
+		clientDataJSON    = []byte(`{"type":"webauthn.get","challenge":"` + base64.RawURLEncoding.EncodeToString(challenge) +
+			`","origin":"https://wallet.zoobc.com"}`)
+		clientDataHash = sha256.Sum256(clientDataJSON)
+		signedDataHash = sha256.Sum256(append(append([]byte{}, authenticatorData...), clientDataHash[:]...))
+	)
+	r, s, err := ecdsa.Sign(rand.Reader, mockWebAuthnPrivateKey, signedDataHash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the wallet normalizes S to the lower half of the curve order
+	if s.Cmp(new(big.Int).Rsh(elliptic.P256().Params().N, 1)) > 0 {
+		s.Sub(elliptic.P256().Params().N, s)
+	}
+	signature, err := asn1.Marshal(struct{ R, S *big.Int }{R: r, S: s})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signaturetype.NewWebAuthnSignature().BuildAssertion(&signaturetype.WebAuthnAssertion{
+		AuthenticatorData: authenticatorData,
+		ClientDataJSON:    clientDataJSON,
+		Signature:         signature,
+	})
+}
+
+func TestWebAuthnAccountType_GetAccountAddress(t *testing.T) {
+	acc := &WebAuthnAccountType{}
+	acc.SetAccountPublicKey(mockWebAuthnPublicKey)
+	got, err := acc.GetAccountAddress()
+	if err != nil {
+		t.Errorf("WebAuthnAccountType.GetAccountAddress() error = %v", err)
+		return
+	}
+	if want := append([]byte{5, 0, 0, 0}, mockWebAuthnPublicKey...); !reflect.DeepEqual(got, want) {
+		t.Errorf("WebAuthnAccountType.GetAccountAddress() got = %v, want %v", got, want)
+	}
+}
+
+func TestWebAuthnAccountType_DecodePublicKeyFromAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "wantError:NotHex",
+			address: "P256_not_hex",
+			wantErr: true,
+		},
+		{
+			name:    "wantError:CompressedPublicKey",
+			address: hex.EncodeToString(append([]byte{2}, mockWebAuthnPublicKey[1:33]...)),
+			wantErr: true,
+		},
+		{
+			name:    "wantError:NotOnCurve",
+			address: hex.EncodeToString(append([]byte{4}, make([]byte, 64)...)),
+			wantErr: true,
+		},
+		{
+			name:    "wantSuccess",
+			address: hex.EncodeToString(mockWebAuthnPublicKey),
+			want:    mockWebAuthnPublicKey,
+		},
+		{
+			name:    "wantSuccess:HexPrefix",
+			address: "0x" + hex.EncodeToString(mockWebAuthnPublicKey),
+			want:    mockWebAuthnPublicKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acc := &WebAuthnAccountType{}
+			got, err := acc.DecodePublicKeyFromAddress(tt.address)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WebAuthnAccountType.DecodePublicKeyFromAddress() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WebAuthnAccountType.DecodePublicKeyFromAddress() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebAuthnAccountType_GetEncodedAddress(t *testing.T) {
+	acc := &WebAuthnAccountType{}
+	if _, err := acc.GetEncodedAddress(); err == nil {
+		t.Error("WebAuthnAccountType.GetEncodedAddress() want error for an empty public key")
+	}
+	acc.SetAccountPublicKey(mockWebAuthnPublicKey)
+	got, err := acc.GetEncodedAddress()
+	if err != nil {
+		t.Errorf("WebAuthnAccountType.GetEncodedAddress() error = %v", err)
+		return
+	}
+	if want := hex.EncodeToString(mockWebAuthnPublicKey); got != want {
+		t.Errorf("WebAuthnAccountType.GetEncodedAddress() got = %v, want %v", got, want)
+	}
+}
+
+func TestWebAuthnAccountType_VerifySignature(t *testing.T) {
+	var (
+		txHash         = sha3.Sum256([]byte("mock transaction bytes"))
+		otherTxHash    = sha3.Sum256([]byte("other transaction bytes"))
+		otherAccount   = elliptic.Marshal(elliptic.P256(), elliptic.P256().Params().Gx, elliptic.P256().Params().Gy)
+		webAuthnAcc    = &WebAuthnAccountType{}
+		accountAddr    []byte
+		assertion      = mockWebAuthnAssertion(t, txHash[:])
+		otherAssertion = mockWebAuthnAssertion(t, otherTxHash[:])
+	)
+	webAuthnAcc.SetAccountPublicKey(mockWebAuthnPublicKey)
+	accountAddr, _ = webAuthnAcc.GetAccountAddress()
+	type args struct {
+		publicKey []byte
+		payload   []byte
+		signature []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "wantError:OtherChallenge",
+			args: args{
+				publicKey: mockWebAuthnPublicKey,
+				payload:   txHash[:],
+				signature: otherAssertion,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:OtherAccount",
+			args: args{
+				publicKey: otherAccount,
+				payload:   txHash[:],
+				signature: assertion,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:EmptySignature",
+			args: args{
+				publicKey: mockWebAuthnPublicKey,
+				payload:   txHash[:],
+				signature: []byte{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantSuccess",
+			args: args{
+				publicKey: mockWebAuthnPublicKey,
+				payload:   txHash[:],
+				signature: assertion,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acc, err := NewAccountType(webAuthnAcc.GetTypeInt(), tt.args.publicKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := acc.VerifySignature(tt.args.payload, tt.args.signature, accountAddr); (err != nil) != tt.wantErr {
+				t.Errorf("WebAuthnAccountType.VerifySignature() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -128,10 +128,13 @@ func (acc *ZbcAccountType) GetEncodedAddress() (string, error) {
 	return address.EncodeZbcID(acc.GetAccountPrefix(), acc.GetAccountPublicKey())
 }
 
+// GenerateAccountFromSeed optionalParams are useSlip10 (bool) to derive the account as a hdwallet does, and the slip10
+// account index (uint32) of the hdwallet account, defaulting to the primary account 0
 func (acc *ZbcAccountType) GenerateAccountFromSeed(seed string, optionalParams ...interface{}) error {
 	var (
 		ed25519Signature = signaturetype.NewEd25519Signature()
 		useSlip10, ok    bool
+		accountIndex     uint32
 		err              error
 	)
 	if len(optionalParams) != 0 {
@@ -140,8 +143,14 @@ func (acc *ZbcAccountType) GenerateAccountFromSeed(seed string, optionalParams .
 			return blocker.NewBlocker(blocker.AppErr, "failedAssertType")
 		}
 	}
+	if len(optionalParams) > 1 {
+		accountIndex, ok = optionalParams[1].(uint32)
+		if !ok {
+			return blocker.NewBlocker(blocker.AppErr, "failedAssertAccountIndexType")
+		}
+	}
 	if useSlip10 {
-		acc.privateKey, err = ed25519Signature.GetPrivateKeyFromSeedUseSlip10(seed)
+		acc.privateKey, err = ed25519Signature.GetPrivateKeyFromSeedUseSlip10AccountIndex(seed, accountIndex)
 		if err != nil {
 			return err
 		}
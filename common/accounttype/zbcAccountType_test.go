@@ -62,6 +62,8 @@ func TestZbcAccountType_GenerateAccountFromSeed(t *testing.T) {
 		seed         = "concur vocalist rotten busload gap quote stinging undiluted surfer goofiness deviation starved"
 		pubKeySlip10 = []byte{149, 1, 110, 5, 224, 150, 132, 85, 59, 205, 45, 168, 107, 143, 209, 215, 181, 221, 109, 23, 39, 95, 248, 147, 114,
 			91, 115, 75, 51, 31, 148, 108}
+		pubKeySlip10Account1 = []byte{213, 28, 84, 20, 165, 162, 58, 188, 252, 186, 39, 203, 199, 219, 149, 121, 189, 7, 49, 221, 181, 233,
+			106, 205, 205, 64, 67, 179, 22, 198, 166, 255}
 		pubKey = []byte{4, 38, 68, 24, 230, 247, 88, 220, 119, 124, 51, 149, 127, 214, 82, 224, 72, 239, 56, 139, 255, 81, 229, 184, 77,
 			80, 80, 39, 254, 173, 28, 169}
 	)
@@ -91,6 +93,30 @@ func TestZbcAccountType_GenerateAccountFromSeed(t *testing.T) {
 			},
 			want: pubKeySlip10,
 		},
+		{
+			name: "GenerateAccountFromSeed:success-{ed25519-slip10-account0}",
+			args: args{
+				seed:           seed,
+				optionalParams: []interface{}{true, uint32(0)},
+			},
+			want: pubKeySlip10,
+		},
+		{
+			name: "GenerateAccountFromSeed:success-{ed25519-slip10-account1}",
+			args: args{
+				seed:           seed,
+				optionalParams: []interface{}{true, uint32(1)},
+			},
+			want: pubKeySlip10Account1,
+		},
+		{
+			name: "GenerateAccountFromSeed:fail-{ed25519-slip10-wrongAccountIndex}",
+			args: args{
+				seed:           seed,
+				optionalParams: []interface{}{true, 1},
+			},
+			wantErr: true,
+		},
 		{
 			name: "GenerateAccountFromSeed:success-{ed25519}",
 			args: args{
@@ -49,6 +49,10 @@
 // shall be included in all copies or substantial portions of the Software.
 package constant
 
+import "time"
+
 var (
 	MaxAPILimitPerPage uint32 = 500
+	// SimulateTransactionMinInterval minimum time between two transaction simulations, each one holding the db write lock
+	SimulateTransactionMinInterval = 500 * time.Millisecond
 )
@@ -72,8 +72,22 @@ var (
 	DatasetPropertyLength uint32 = 4
 	// DatasetValueLength is max length of string property value in dataset
 	DatasetValueLength uint32 = 4
+	// DatasetExpiryHeight is length of the optional expiry height of a dataset
+	DatasetExpiryHeight uint32 = 4
+	// DatasetExpiryTimestamp is length of the optional expiry timestamp of a dataset
+	DatasetExpiryTimestamp uint32 = 8
+	// DatasetSchemaValueTypeLength is length of the value type of a dataset schema
+	DatasetSchemaValueTypeLength uint32 = 4
+	// DatasetSchemaMaxLength is length of the maximum value length of a dataset schema
+	DatasetSchemaMaxLength uint32 = 4
+	// DatasetHashValueLength is length of the decoded value of a dataset whose schema type is hash
+	DatasetHashValueLength uint32 = 32
+	// DatasetMaxJSONValueLength is the upper limit of the maximum length of a dataset whose schema type is JSON
+	DatasetMaxJSONValueLength uint32 = 4096
 
 	TxMessageBytesLength uint32 = 4
+	// ReplacedTransactionID is length of the ID of the pending transaction a transaction replaces in mempool
+	ReplacedTransactionID uint32 = 8
 
 	EscrowApproverAddressLength uint32 = 4
 	EscrowCommissionLength      uint32 = 8
@@ -82,15 +96,22 @@ var (
 	EscrowID                    uint32 = 8
 	EscrowApprovalBytesLength          = EscrowApproval + EscrowID
 	EscrowInstructionLength     uint32 = 4
+	EscrowNumberOfApprovers     uint32 = 4
+	EscrowMinimumApprovals      uint32 = 4
+	EscrowReleaseAmount         uint32 = 8
 	MultisigFieldLength         uint32 = 4
 	// MultiSigFieldMissing indicate fields is missing, no need to read the bytes
 	MultiSigFieldMissing uint32
 	// MultiSigFieldPresent indicate fields is present, parse the byte accordingly
-	MultiSigFieldPresent           uint32 = 1
+	MultiSigFieldPresent uint32 = 1
+	// MultiSigFieldPresentWeighted indicate multisig info is present and followed by the weight of each participant
+	MultiSigFieldPresentWeighted   uint32 = 2
+	MultiSigParticipantWeight      uint32 = 4
 	MultiSigAddressLength          uint32 = 4
 	MultiSigSignatureLength        uint32 = 4
 	MultiSigSignatureAddressLength uint32 = 4
 	MultiSigNumberOfAddress        uint32 = 4
+	MultiSigNumberOfWeights        uint32 = 4
 	MultiSigNumberOfSignatures     uint32 = 4
 	MultiSigUnsignedTxBytesLength  uint32 = 4
 	MultiSigInfoSize               uint32 = 4
@@ -98,6 +119,8 @@ var (
 	MultiSigInfoNonce              uint32 = 8
 	MultiSigInfoMinSignature       uint32 = 4
 	MultiSigTransactionHash        uint32 = 32
+	// MultiSigParticipantNotWeighted stored weight of the participants of a multisig registered without weights
+	MultiSigParticipantNotWeighted uint32
 
 	// FeeVote part
 	FeeVote              uint32 = 8
@@ -108,4 +131,17 @@ var (
 
 	LiquidPaymentCompleteMinutesLength uint32 = 8
 	TransactionID                      uint32 = 8
+
+	// Time Locked Send ZBC Transaction
+	UnlockHeight    uint32 = 4
+	UnlockTimestamp uint32 = 8
+
+	// Multi Send ZBC Transaction
+	MultiSendZBCNumberOfRecipients uint32 = 4
+
+	// Hash Time Locked Transactions
+	HtlcHashLock       uint32 = 32
+	HtlcTimeLockHeight uint32 = 4
+	HtlcID             uint32 = 8
+	HtlcPreimageLength uint32 = 4
 )
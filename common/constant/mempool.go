@@ -64,4 +64,10 @@ const (
 	TxCachedTimeout = 300
 	// Gap of the CleanTimedoutTxCandidateThread
 	CleanTimedoutBlockTxCachedThreadGap = 10
+	// FeeEstimationRecentBlocks number of the last blocks which lowest included fee per byte is considered by the fee estimation
+	FeeEstimationRecentBlocks uint32 = 10
+	// FeeEstimationWithinBlocks confirmation target of the "within blocks" fee estimation
+	FeeEstimationWithinBlocks = 5
+	// FeeEstimationEconomyBlocks confirmation target of the economy fee estimation
+	FeeEstimationEconomyBlocks = 25
 )
@@ -55,3 +55,39 @@ const (
 	EstoniaEidSignatureLength = 96
 	EthereumSignatureLength   = 65
 )
+
+const (
+	// BTCSignatureLength bitcoin signatures embed the signer public key and a der ecdsa or bip-340 schnorr signature,
+	// they have a variable length and end the transaction bytes
+	BTCSignatureLength = 0
+	// BTCSchnorrSignatureLength length of the bip-340 schnorr signatures, R.x (32 bytes) || s (32 bytes)
+	BTCSchnorrSignatureLength = 64
+	// BTCXOnlyPublicKeyLength length of the bip-340 x-only public keys, the x coordinate of the even y point
+	BTCXOnlyPublicKeyLength = 32
+)
+
+const (
+	// WebAuthnSignatureLength webauthn assertions have a variable length, their signature ends the transaction bytes
+	WebAuthnSignatureLength = 0
+	// WebAuthnMaxSignatureLength max length of a webauthn assertion, authenticator data, client data and signature included
+	WebAuthnMaxSignatureLength = 1024
+	// WebAuthnPublicKeyLength length of the uncompressed (0x04 || X || Y) P-256 public keys of the webauthn accounts
+	WebAuthnPublicKeyLength = 65
+)
+
+const (
+	// BIP32HardenedKeyStart index of the first hardened child key in a bip32 derivation path
+	BIP32HardenedKeyStart uint32 = 0x80000000
+	// EthereumBIP44Purpose first level of the m/44'/60'/account'/0/index path used by the ethereum wallets
+	EthereumBIP44Purpose = BIP32HardenedKeyStart + 44
+	// EthereumBIP44CoinType second level of the ethereum wallets path, ethereum coin type registered in slip-0044
+	EthereumBIP44CoinType = BIP32HardenedKeyStart + 60
+)
+
+const (
+	// ZoobcSlip10AccountPathFormat m/44'/883'/account' slip-0010 path of the hierarchical deterministic ZBC accounts, ed25519
+	// only derives hardened keys. The account 0 is the one derived by the hdwallet
+	ZoobcSlip10AccountPathFormat = "m/44'/883'/%d'"
+	// DiscoverAccountsDefaultGapLimit number of consecutive unused accounts ending the discovery of the used ones
+	DiscoverAccountsDefaultGapLimit uint32 = 20
+)
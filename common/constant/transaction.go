@@ -64,4 +64,16 @@ var (
 	// TransactionTimeOffset use to put time offset for transaction timestamp when validate transaction
 	TransactionTimeOffset = 10 * time.Second
 	CompleteMinutesUnit   = 60 // 60 seconds
+	// MaxMultiSendZBCRecipients limit the outputs of a multi send zbc transaction to keep it well below the block payload
+	MaxMultiSendZBCRecipients = 500
+	// EscrowApproversTransactionVersion is the first transaction version whose escrow bytes carry the additional approvers
+	// and the minimum approvals of a multi approver escrow
+	EscrowApproversTransactionVersion uint32 = 2
+	// ReplacementTransactionVersion is the first transaction version whose bytes carry the ID of the pending transaction of
+	// the same sender it replaces in mempool
+	ReplacementTransactionVersion uint32 = 3
+	// MaxEscrowApprovers limit the approvers of a multi approver escrow
+	MaxEscrowApprovers = 20
+	// MaxHtlcPreimageLength limit the preimage revealed by a htlc claim, the secrets of atomic swaps are 32 bytes
+	MaxHtlcPreimageLength = 64
 )
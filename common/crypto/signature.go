@@ -51,8 +51,13 @@ package crypto
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"fmt"
 	"github.com/zoobc/zoobc-core/common/accounttype"
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
 	"github.com/zoobc/zoobc-core/common/signaturetype"
+	"golang.org/x/crypto/ed25519"
 	"golang.org/x/crypto/sha3"
 
 	"github.com/zoobc/zed25519/zed"
@@ -67,7 +72,9 @@ type (
 		Sign(payload []byte, accountType model.AccountType, seed string, optionalParams ...interface{}) ([]byte, error)
 		SignByNode(payload []byte, nodeSeed string) []byte
 		VerifySignature(payload, signature, accountAddress []byte) error
+		VerifySignatures(payloads, signatures, accountAddresses [][]byte) error
 		VerifyNodeSignature(payload, signature []byte, nodePublicKey []byte) bool
+		VerifyNodeSignatures(payloads, signatures, nodePublicKeys [][]byte) error
 		GenerateAccountFromSeed(accountType accounttype.AccountTypeInterface, seed string, optionalParams ...interface{}) (
 			privateKey, publicKey []byte,
 			publicKeyString, encodedAddress string,
@@ -127,6 +134,101 @@ func (*Signature) VerifySignature(payload, signature, accountAddress []byte) err
 	return accountType.VerifySignature(payload, signature, accountAddress)
 }
 
+// VerifySignatures verify the signatures of many payloads at once, as the transactions of a block. The ed25519 signatures of
+// the ZBC accounts and the bip-340 schnorr signatures of the BTC accounts are verified in batches, the other ones one by one.
+// When a batch fails its signatures are verified one by one to return the error of the invalid one
+func (*Signature) VerifySignatures(payloads, signatures, accountAddresses [][]byte) error {
+	var (
+		ed25519Signature                                      = signaturetype.NewEd25519Signature()
+		schnorrSignature                                      = signaturetype.NewBitcoinSchnorrSignature(signaturetype.DefaultBitcoinCurve())
+		accountTypes                                          = make([]accounttype.AccountTypeInterface, len(accountAddresses))
+		ed25519Indexes, schnorrIndexes                        []int
+		ed25519PublicKeys, ed25519Payloads, ed25519Signatures [][]byte
+		xOnlyPublicKeys, schnorrPayloads, schnorrSignatures   [][]byte
+	)
+	if len(payloads) != len(signatures) || len(payloads) != len(accountAddresses) {
+		return blocker.NewBlocker(blocker.ValidationErr, "SignaturesNotMatchingPayloads")
+	}
+	for i, accountAddress := range accountAddresses {
+		if len(accountAddress) < int(constant.AccountAddressTypeLength) {
+			return blocker.NewBlocker(blocker.ValidationErr, "InvalidAccountAddress")
+		}
+		accountType, err := accounttype.NewAccountType(int32(util.ConvertBytesToUint32(accountAddress[:4])), accountAddress[4:])
+		if err != nil {
+			return err
+		}
+		accountTypes[i] = accountType
+		switch acc := accountType.(type) {
+		case *accounttype.ZbcAccountType:
+			ed25519Indexes = append(ed25519Indexes, i)
+			ed25519PublicKeys = append(ed25519PublicKeys, acc.GetAccountPublicKey())
+			ed25519Payloads = append(ed25519Payloads, payloads[i])
+			ed25519Signatures = append(ed25519Signatures, signatures[i])
+			continue
+		case *accounttype.BTCAccountType:
+			xOnlyPublicKey, signature, err := acc.ParseSchnorrSignature(signatures[i])
+			if err != nil {
+				return err
+			}
+			if xOnlyPublicKey != nil {
+				// BTC accounts sign the sha256 of the payload with schnorr
+				message := sha256.Sum256(payloads[i])
+				schnorrIndexes = append(schnorrIndexes, i)
+				xOnlyPublicKeys = append(xOnlyPublicKeys, xOnlyPublicKey)
+				schnorrPayloads = append(schnorrPayloads, message[:])
+				schnorrSignatures = append(schnorrSignatures, signature)
+				continue
+			}
+		}
+		err = accountType.VerifySignature(payloads[i], signatures[i], accountAddress)
+		if err != nil {
+			return err
+		}
+	}
+	if len(ed25519Indexes) > 0 && !ed25519Signature.BatchVerify(ed25519PublicKeys, ed25519Payloads, ed25519Signatures) {
+		if err := verifySignaturesOneByOne(ed25519Indexes, accountTypes, payloads, signatures, accountAddresses); err != nil {
+			return err
+		}
+	}
+	if len(schnorrIndexes) > 0 && !schnorrSignature.BatchVerify(xOnlyPublicKeys, schnorrPayloads, schnorrSignatures) {
+		if err := verifySignaturesOneByOne(schnorrIndexes, accountTypes, payloads, signatures, accountAddresses); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifySignaturesOneByOne verify the signatures at indexes one by one, to locate the invalid one of a failed batch
+func verifySignaturesOneByOne(
+	indexes []int,
+	accountTypes []accounttype.AccountTypeInterface,
+	payloads, signatures, accountAddresses [][]byte,
+) error {
+	for _, i := range indexes {
+		if err := accountTypes[i].VerifySignature(payloads[i], signatures[i], accountAddresses[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyNodeSignatures verify many signatures signed with node private keys at once, as the recipient signatures of the
+// published receipts of a block. They are batch verified, then one by one when the batch fails to locate the invalid one
+func (s *Signature) VerifyNodeSignatures(payloads, signatures, nodePublicKeys [][]byte) error {
+	if len(payloads) != len(signatures) || len(payloads) != len(nodePublicKeys) {
+		return blocker.NewBlocker(blocker.ValidationErr, "SignaturesNotMatchingPayloads")
+	}
+	if signaturetype.NewEd25519Signature().BatchVerify(nodePublicKeys, payloads, signatures) {
+		return nil
+	}
+	for i := range payloads {
+		if len(nodePublicKeys[i]) != ed25519.PublicKeySize || !s.VerifyNodeSignature(payloads[i], signatures[i], nodePublicKeys[i]) {
+			return blocker.NewBlocker(blocker.ValidationErr, fmt.Sprintf("InvalidSignature - index %d", i))
+		}
+	}
+	return nil
+}
+
 // VerifyNodeSignature Verify a signature of a block or message signed with a node private key
 // Note: this function is a wrapper around the ed25519 algorithm
 func (*Signature) VerifyNodeSignature(payload, signature, nodePublicKey []byte) bool {
@@ -53,6 +53,7 @@ import (
 	"bytes"
 	"github.com/zoobc/zoobc-core/common/accounttype"
 	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/signaturetype"
 	"reflect"
 	"testing"
 
@@ -236,6 +237,82 @@ func TestSignature_VerifySignature(t *testing.T) {
 	}
 }
 
+func TestSignature_VerifySignatures(t *testing.T) {
+	var (
+		ed25519Payload        = []byte{12, 43, 65, 65, 12, 123, 43, 12, 1, 24, 5, 5, 12, 54}
+		ed25519AccountAddress = []byte{0, 0, 0, 0, 4, 38, 68, 24, 230, 247, 88, 220, 119, 124, 51, 149, 127, 214, 82, 224, 72, 239, 56,
+			139, 255, 81, 229, 184, 77, 80, 80, 39, 254, 173, 28, 169}
+		ed25519Signature = []byte{42, 62, 47, 200, 180, 101, 85, 204, 179, 147, 143, 68, 30, 111, 6, 94, 81, 248, 219, 43, 90, 6, 167,
+			45, 132, 96, 130, 0, 153, 244, 159, 137, 159, 113, 78, 9, 164, 154, 213, 255, 17, 206, 153, 156, 176, 206, 33,
+			103, 72, 182, 228, 148, 234, 15, 176, 243, 50, 221, 106, 152, 53, 54, 173, 15}
+		btcPayload        = []byte{1, 2, 3}
+		btcAccountAddress = []byte{1, 0, 0, 0, 3, 82, 247, 192, 243, 36, 207, 71, 90, 3, 103, 220, 47, 115, 64, 15, 13, 59, 186, 231, 45,
+			42, 149, 73, 12, 5, 166, 141, 205, 177, 156, 77, 122}
+		schnorrSignature = []byte{32, 0, 82, 247, 192, 243, 36, 207, 71, 90, 3, 103, 220, 47, 115, 64, 15, 13, 59, 186, 231, 45, 42, 149,
+			73, 12, 5, 166, 141, 205, 177, 156, 77, 122, 163, 90, 33, 121, 148, 238, 34, 131, 206, 132, 138, 157, 169, 82, 143, 156, 32,
+			26, 197, 162, 229, 208, 215, 8, 151, 155, 129, 35, 235, 121, 251, 120, 245, 53, 220, 139, 98, 203, 198, 38, 211, 33, 166, 204,
+			76, 201, 192, 185, 195, 148, 179, 18, 75, 44, 152, 107, 149, 220, 186, 72, 171, 140, 160, 245}
+	)
+	type args struct {
+		payloads         [][]byte
+		signatures       [][]byte
+		accountAddresses [][]byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "VerifySignatures:success-{ed25519AndSchnorr}",
+			args: args{
+				payloads:         [][]byte{ed25519Payload, btcPayload, btcPayload},
+				signatures:       [][]byte{ed25519Signature, schnorrSignature, schnorrSignature},
+				accountAddresses: [][]byte{ed25519AccountAddress, btcAccountAddress, btcAccountAddress},
+			},
+		},
+		{
+			name: "VerifySignatures:success-{empty}",
+			args: args{},
+		},
+		{
+			name: "VerifySignatures:fail-{invalidSchnorrSignature}",
+			args: args{
+				payloads:         [][]byte{ed25519Payload, btcPayload, {1, 2, 4}},
+				signatures:       [][]byte{ed25519Signature, schnorrSignature, schnorrSignature},
+				accountAddresses: [][]byte{ed25519AccountAddress, btcAccountAddress, btcAccountAddress},
+			},
+			wantErr: true,
+		},
+		{
+			name: "VerifySignatures:fail-{invalidEd25519Signature}",
+			args: args{
+				payloads:         [][]byte{btcPayload, btcPayload},
+				signatures:       [][]byte{ed25519Signature, schnorrSignature},
+				accountAddresses: [][]byte{ed25519AccountAddress, btcAccountAddress},
+			},
+			wantErr: true,
+		},
+		{
+			name: "VerifySignatures:fail-{signaturesNotMatchingPayloads}",
+			args: args{
+				payloads:         [][]byte{btcPayload},
+				signatures:       [][]byte{schnorrSignature, schnorrSignature},
+				accountAddresses: [][]byte{btcAccountAddress, btcAccountAddress},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Signature{}
+			if err := s.VerifySignatures(tt.args.payloads, tt.args.signatures, tt.args.accountAddresses); (err != nil) != tt.wantErr {
+				t.Errorf("Signature.VerifySignatures() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestSignature_VerifyNodeSignature(t *testing.T) {
 	type args struct {
 		payload       []byte
@@ -271,6 +348,88 @@ func TestSignature_VerifyNodeSignature(t *testing.T) {
 	}
 }
 
+func TestSignature_VerifyNodeSignatures(t *testing.T) {
+	var (
+		nodeSeed      = "concur vocalist rotten busload gap quote stinging undiluted surfer goofiness deviation starved"
+		nodePayload   = []byte{1, 2, 3}
+		nodeSignature = (&Signature{}).SignByNode(nodePayload, nodeSeed)
+		nodePublicKey = signaturetype.NewEd25519Signature().GetPublicKeyFromSeed(nodeSeed)
+		payload       = []byte{12, 43, 65, 65, 12, 123, 43, 12, 1, 24, 5, 5, 12, 54}
+		signature     = []byte{42, 62, 47, 200, 180, 101, 85, 204, 179, 147, 143, 68, 30, 111, 6, 94, 81, 248, 219, 43, 90, 6, 167,
+			45, 132, 96, 130, 0, 153, 244, 159, 137, 159, 113, 78, 9, 164, 154, 213, 255, 17, 206, 153, 156, 176, 206, 33,
+			103, 72, 182, 228, 148, 234, 15, 176, 243, 50, 221, 106, 152, 53, 54, 173, 15}
+		publicKey = []byte{4, 38, 68, 24, 230, 247, 88, 220, 119, 124, 51, 149, 127, 214, 82, 224, 72, 239, 56, 139, 255,
+			81, 229, 184, 77, 80, 80, 39, 254, 173, 28, 169}
+	)
+	type args struct {
+		payloads       [][]byte
+		signatures     [][]byte
+		nodePublicKeys [][]byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "VerifyNodeSignatures:success",
+			args: args{
+				payloads:       [][]byte{payload, nodePayload, nodePayload},
+				signatures:     [][]byte{signature, nodeSignature, nodeSignature},
+				nodePublicKeys: [][]byte{publicKey, nodePublicKey, nodePublicKey},
+			},
+		},
+		{
+			name: "VerifyNodeSignatures:success-{empty}",
+			args: args{},
+		},
+		{
+			name: "VerifyNodeSignatures:fail-{invalidSignature}",
+			args: args{
+				payloads:       [][]byte{payload, nodePayload, payload},
+				signatures:     [][]byte{signature, nodeSignature, nodeSignature},
+				nodePublicKeys: [][]byte{publicKey, nodePublicKey, nodePublicKey},
+			},
+			wantErr: true,
+			errMsg:  "ValidationErr: InvalidSignature - index 2",
+		},
+		{
+			name: "VerifyNodeSignatures:fail-{invalidPublicKeyLength}",
+			args: args{
+				payloads:       [][]byte{payload, nodePayload},
+				signatures:     [][]byte{signature, nodeSignature},
+				nodePublicKeys: [][]byte{publicKey, nodePublicKey[1:]},
+			},
+			wantErr: true,
+			errMsg:  "ValidationErr: InvalidSignature - index 1",
+		},
+		{
+			name: "VerifyNodeSignatures:fail-{lengthsNotMatching}",
+			args: args{
+				payloads:       [][]byte{payload},
+				signatures:     [][]byte{signature, nodeSignature},
+				nodePublicKeys: [][]byte{publicKey, nodePublicKey},
+			},
+			wantErr: true,
+			errMsg:  "ValidationErr: SignaturesNotMatchingPayloads",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Signature{}
+			err := s.VerifyNodeSignatures(tt.args.payloads, tt.args.signatures, tt.args.nodePublicKeys)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Signature.VerifyNodeSignatures() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && err.Error() != tt.errMsg {
+				t.Errorf("Signature.VerifyNodeSignatures() error = %v, want %v", err, tt.errMsg)
+			}
+		})
+	}
+}
+
 func TestSignature_GenerateAccountFromSeed(t *testing.T) {
 	type args struct {
 		accountType accounttype.AccountTypeInterface
@@ -551,6 +551,137 @@ func (m *Migration) Init() error {
 			ALTER TABLE "transaction"
 				ADD COLUMN "message" BLOB
 			`,
+			`
+			CREATE TABLE IF NOT EXISTS "locked_fund" (
+				"id" INTEGER,					-- id of the time locked send zbc transaction
+				"sender_address" BLOB,
+				"recipient_address" BLOB,
+				"amount" INTEGER,
+				"unlock_height" INTEGER,		-- 0 when unlocked by timestamp
+				"unlock_timestamp" INTEGER,		-- 0 when unlocked by height
+				"status" INTEGER,				-- locked or released
+				"block_height" INTEGER,
+				"latest" INTEGER,
+				PRIMARY KEY("id", "block_height")
+			)
+			`,
+			`
+			CREATE INDEX "locked_fund_status_idx" ON "locked_fund" ("status")
+			`,
+			`
+			CREATE INDEX "locked_fund_recipient_address_idx" ON "locked_fund" ("recipient_address")
+			`,
+			`
+			ALTER TABLE "escrow_transaction"
+				ADD COLUMN "approver_addresses" BLOB	-- every approver of a multi approver escrow, concatenated
+			`,
+			`
+			ALTER TABLE "escrow_transaction"
+				ADD COLUMN "minimum_approvals" INTEGER DEFAULT 0
+			`,
+			`
+			ALTER TABLE "escrow_transaction"
+				ADD COLUMN "approvals" BLOB	-- approvers who approved the escrow, concatenated
+			`,
+			`
+			ALTER TABLE "escrow_transaction"
+				ADD COLUMN "rejections" BLOB	-- approvers who rejected the escrow, concatenated
+			`,
+			`
+			ALTER TABLE "escrow_transaction"
+				ADD COLUMN "released_amount" INTEGER DEFAULT 0	-- part of the amount released by partial approvals
+			`,
+			`
+			CREATE TABLE IF NOT EXISTS "htlc" (
+				"id" INTEGER,					-- id of the htlc lock transaction
+				"sender_address" BLOB,
+				"recipient_address" BLOB,
+				"amount" INTEGER,
+				"hash_lock" BLOB,				-- sha256 of the preimage revealed by the claim
+				"time_lock_height" INTEGER,		-- height from which the sender can be refunded
+				"status" INTEGER,				-- locked, claimed or refunded
+				"preimage" BLOB,
+				"block_height" INTEGER,
+				"latest" INTEGER,
+				PRIMARY KEY("id", "block_height")
+			)
+			`,
+			`
+			CREATE INDEX "htlc_hash_lock_idx" ON "htlc" ("hash_lock")
+			`,
+			`
+			CREATE INDEX "htlc_recipient_address_idx" ON "htlc" ("recipient_address")
+			`,
+			`
+			ALTER TABLE "liquid_payment_transaction"
+				ADD COLUMN "claimed_amount" INTEGER DEFAULT 0	-- part of the amount paid to the recipient
+			`,
+			`
+			ALTER TABLE "multisignature_participant"
+				ADD COLUMN "weight" INTEGER DEFAULT 0	-- weight of the participant signature, 0 if the multisig is not weighted
+			`,
+			`
+			CREATE TABLE IF NOT EXISTS "multisignature_rotation" (
+				"id" INTEGER,					-- id of the rotation transaction
+				"multisig_address" BLOB,		-- address of the multisig account, unchanged by the rotation
+				"minimum_signatures" INTEGER,
+				"addresses" BLOB,				-- concatenated participant addresses after the rotation
+				"weights" BLOB,					-- participant weights after the rotation, empty when not weighted
+				"block_height" INTEGER,
+				"latest" INTEGER,
+				PRIMARY KEY("multisig_address", "block_height")
+			)
+			`,
+			`
+			CREATE TABLE IF NOT EXISTS "detached_pending_transaction" (
+				"sender_address" BLOB,			-- multisig address sending the transaction
+				"transaction_hash" BLOB,		-- transaction hash of the transaction collecting signatures off chain
+				"transaction_bytes" BLOB,		-- unsigned transaction bytes
+				"status" INTEGER,			-- pending until the multisig transaction is broadcast
+				"block_height" INTEGER,			-- node height when the transaction was submitted/updated
+				"latest" INTEGER,
+				PRIMARY KEY("transaction_hash", "block_height")
+			)
+			`,
+			`
+			CREATE TABLE IF NOT EXISTS "detached_pending_signature" (
+				"transaction_hash" BLOB,		-- transaction hash of the detached pending transaction being signed
+				"account_address" BLOB,			-- account address of the participant signing
+				"signature" BLOB,
+				"block_height" INTEGER,			-- node height when the signature was submitted
+				"latest" INTEGER,
+				PRIMARY KEY("account_address", "transaction_hash", "block_height")
+			)
+			`,
+			`
+			CREATE INDEX "detached_pending_signature_transaction_hash_idx" ON "detached_pending_signature" ("transaction_hash")
+			`,
+			`
+			CREATE TABLE IF NOT EXISTS "account_dataset_schema" (
+				"setter_account_address" BLOB,	-- account whose datasets of the property must match the schema
+				"property" TEXT,
+				"value_type" INTEGER,
+				"max_length" INTEGER,			-- maximum length of the value, 0 when not limited
+				"block_height" INTEGER,
+				"latest" INTEGER,
+				PRIMARY KEY("setter_account_address", "property", "block_height")
+			)
+			`,
+			`
+			ALTER TABLE "account_dataset"
+				ADD COLUMN "expiry_height" INTEGER DEFAULT 0	-- height from which the dataset is deactivated, 0 when not expiring by height
+			`,
+			`
+			ALTER TABLE "account_dataset"
+				ADD COLUMN "expiry_timestamp" INTEGER DEFAULT 0	-- timestamp from which the dataset is deactivated, 0 when not expiring by time
+			`,
+			`
+			ALTER TABLE "transaction"
+				ADD COLUMN "replaced_transaction_id" INTEGER DEFAULT 0	-- pending transaction of the same sender it replaced, 0 if none
+			`,
+			`
+			CREATE INDEX "transaction_replaced_transaction_id_idx" ON "transaction" ("replaced_transaction_id")
+			`,
 		}
 		return nil
 	}
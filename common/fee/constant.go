@@ -53,10 +53,11 @@ import "github.com/zoobc/zoobc-core/common/constant"
 
 const (
 	// SendZBCFeeConstant value of initial / constant send zbc fee
-	SendZBCFeeConstant        = constant.OneZBC / 100
-	InitialFeeScale           = constant.OneZBC / 100
-	FeeScaleLowerConstraints  = 0.5
-	FeeScaleUpperConstraints  = 2.0
-	FeePerCharacterMultiplier = float64(0.1) // so with initial fee, 1000 char would require 1ZBC
-	EscrowLifetimeDivider     = 24           // 24 hours
+	SendZBCFeeConstant          = constant.OneZBC / 100
+	InitialFeeScale             = constant.OneZBC / 100
+	FeeScaleLowerConstraints    = 0.5
+	FeeScaleUpperConstraints    = 2.0
+	FeePerCharacterMultiplier   = float64(0.1) // so with initial fee, 1000 char would require 1ZBC
+	EscrowLifetimeDivider       = 24           // 24 hours
+	FeePerExtraOutputMultiplier = float64(0.1) // so with initial fee, every output after the first require 0.001ZBC
 )
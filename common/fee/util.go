@@ -87,3 +87,16 @@ func CalculateTxMinimumFee(tx *model.Transaction, feeScale int64) (int64, error)
 	fee := int64(math.Ceil((txMessageFeeMultiplier + escrowInstructionFeeMultiplier + float64(minFeeMultiplier)) * escrowLifeDays * float64(feeScale)))
 	return fee, nil
 }
+
+// CalculateMultiOutputTxMinimumFee calculate the minimum fee for a transaction paying several outputs,
+// the minimum fee of the transaction covers the first output and every other output is charged FeePerExtraOutputMultiplier
+func CalculateMultiOutputTxMinimumFee(tx *model.Transaction, feeScale int64, numberOfOutputs int) (int64, error) {
+	fee, err := CalculateTxMinimumFee(tx, feeScale)
+	if err != nil {
+		return 0, err
+	}
+	if numberOfOutputs > 1 {
+		fee += int64(math.Ceil(float64(numberOfOutputs-1) * FeePerExtraOutputMultiplier * float64(feeScale)))
+	}
+	return fee, nil
+}
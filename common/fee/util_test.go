@@ -167,3 +167,62 @@ func TestCalculateTxMinimumFee(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateMultiOutputTxMinimumFee(t *testing.T) {
+	type args struct {
+		tx              *model.Transaction
+		feeScale        int64
+		numberOfOutputs int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int64
+		wantErr bool
+	}{
+		{
+			name: "wantError:escrowTimeoutHasPassed",
+			args: args{
+				tx: &model.Transaction{
+					Timestamp: 1000,
+					Escrow: &model.Escrow{
+						Timeout: 10,
+					},
+				},
+				feeScale:        InitialFeeScale,
+				numberOfOutputs: 2,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantSuccess:singleOutput",
+			args: args{
+				tx:              &model.Transaction{},
+				feeScale:        InitialFeeScale,
+				numberOfOutputs: 1,
+			},
+			want: InitialFeeScale,
+		},
+		{
+			name: "wantSuccess:multipleOutputs",
+			args: args{
+				tx:              &model.Transaction{},
+				feeScale:        InitialFeeScale,
+				numberOfOutputs: 201,
+			},
+			want: InitialFeeScale * 21,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CalculateMultiOutputTxMinimumFee(tt.args.tx, tt.args.feeScale, tt.args.numberOfOutputs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CalculateMultiOutputTxMinimumFee() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("CalculateMultiOutputTxMinimumFee() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -317,12 +317,179 @@ func (m *GetAccountBalancesResponse) GetAccountBalances() []*AccountBalance {
 	return nil
 }
 
+// DiscoverAccountsRequest a model request for discovering the used accounts of a hdwallet
+type DiscoverAccountsRequest struct {
+	// AccountPublicKeys public keys of the ZBC accounts derived along the slip10 path, in account index order.
+	// ed25519 slip10 only derives hardened keys, there is no extended public key to derive them from
+	AccountPublicKeys [][]byte `protobuf:"bytes,1,rep,name=AccountPublicKeys,proto3" json:"AccountPublicKeys,omitempty"`
+	// GapLimit number of consecutive unused accounts ending the discovery, 20 when zero
+	GapLimit             uint32   `protobuf:"varint,2,opt,name=GapLimit,proto3" json:"GapLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiscoverAccountsRequest) Reset()         { *m = DiscoverAccountsRequest{} }
+func (m *DiscoverAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*DiscoverAccountsRequest) ProtoMessage()    {}
+func (*DiscoverAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44b9b1c521a5bcaa, []int{5}
+}
+
+func (m *DiscoverAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiscoverAccountsRequest.Unmarshal(m, b)
+}
+func (m *DiscoverAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiscoverAccountsRequest.Marshal(b, m, deterministic)
+}
+func (m *DiscoverAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoverAccountsRequest.Merge(m, src)
+}
+func (m *DiscoverAccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_DiscoverAccountsRequest.Size(m)
+}
+func (m *DiscoverAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoverAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoverAccountsRequest proto.InternalMessageInfo
+
+func (m *DiscoverAccountsRequest) GetAccountPublicKeys() [][]byte {
+	if m != nil {
+		return m.AccountPublicKeys
+	}
+	return nil
+}
+
+func (m *DiscoverAccountsRequest) GetGapLimit() uint32 {
+	if m != nil {
+		return m.GapLimit
+	}
+	return 0
+}
+
+// DiscoveredAccount a used account, it has a balance or account ledgers
+type DiscoveredAccount struct {
+	// AccountIndex index of the account public key in the request
+	AccountIndex         uint32          `protobuf:"varint,1,opt,name=AccountIndex,proto3" json:"AccountIndex,omitempty"`
+	AccountAddress       []byte          `protobuf:"bytes,2,opt,name=AccountAddress,proto3" json:"AccountAddress,omitempty"`
+	AccountBalance       *AccountBalance `protobuf:"bytes,3,opt,name=AccountBalance,proto3" json:"AccountBalance,omitempty"`
+	LedgersCount         uint64          `protobuf:"varint,4,opt,name=LedgersCount,proto3" json:"LedgersCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DiscoveredAccount) Reset()         { *m = DiscoveredAccount{} }
+func (m *DiscoveredAccount) String() string { return proto.CompactTextString(m) }
+func (*DiscoveredAccount) ProtoMessage()    {}
+func (*DiscoveredAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44b9b1c521a5bcaa, []int{6}
+}
+
+func (m *DiscoveredAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiscoveredAccount.Unmarshal(m, b)
+}
+func (m *DiscoveredAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiscoveredAccount.Marshal(b, m, deterministic)
+}
+func (m *DiscoveredAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoveredAccount.Merge(m, src)
+}
+func (m *DiscoveredAccount) XXX_Size() int {
+	return xxx_messageInfo_DiscoveredAccount.Size(m)
+}
+func (m *DiscoveredAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoveredAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoveredAccount proto.InternalMessageInfo
+
+func (m *DiscoveredAccount) GetAccountIndex() uint32 {
+	if m != nil {
+		return m.AccountIndex
+	}
+	return 0
+}
+
+func (m *DiscoveredAccount) GetAccountAddress() []byte {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return nil
+}
+
+func (m *DiscoveredAccount) GetAccountBalance() *AccountBalance {
+	if m != nil {
+		return m.AccountBalance
+	}
+	return nil
+}
+
+func (m *DiscoveredAccount) GetLedgersCount() uint64 {
+	if m != nil {
+		return m.LedgersCount
+	}
+	return 0
+}
+
+type DiscoverAccountsResponse struct {
+	DiscoveredAccounts []*DiscoveredAccount `protobuf:"bytes,1,rep,name=DiscoveredAccounts,proto3" json:"DiscoveredAccounts,omitempty"`
+	// NextAccountIndex account index following the last used account, the one a wallet creates next
+	NextAccountIndex     uint32   `protobuf:"varint,2,opt,name=NextAccountIndex,proto3" json:"NextAccountIndex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiscoverAccountsResponse) Reset()         { *m = DiscoverAccountsResponse{} }
+func (m *DiscoverAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*DiscoverAccountsResponse) ProtoMessage()    {}
+func (*DiscoverAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44b9b1c521a5bcaa, []int{7}
+}
+
+func (m *DiscoverAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiscoverAccountsResponse.Unmarshal(m, b)
+}
+func (m *DiscoverAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiscoverAccountsResponse.Marshal(b, m, deterministic)
+}
+func (m *DiscoverAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoverAccountsResponse.Merge(m, src)
+}
+func (m *DiscoverAccountsResponse) XXX_Size() int {
+	return xxx_messageInfo_DiscoverAccountsResponse.Size(m)
+}
+func (m *DiscoverAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoverAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoverAccountsResponse proto.InternalMessageInfo
+
+func (m *DiscoverAccountsResponse) GetDiscoveredAccounts() []*DiscoveredAccount {
+	if m != nil {
+		return m.DiscoveredAccounts
+	}
+	return nil
+}
+
+func (m *DiscoverAccountsResponse) GetNextAccountIndex() uint32 {
+	if m != nil {
+		return m.NextAccountIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*AccountBalance)(nil), "model.AccountBalance")
 	proto.RegisterType((*GetAccountBalanceRequest)(nil), "model.GetAccountBalanceRequest")
 	proto.RegisterType((*GetAccountBalanceResponse)(nil), "model.GetAccountBalanceResponse")
 	proto.RegisterType((*GetAccountBalancesRequest)(nil), "model.GetAccountBalancesRequest")
 	proto.RegisterType((*GetAccountBalancesResponse)(nil), "model.GetAccountBalancesResponse")
+	proto.RegisterType((*DiscoverAccountsRequest)(nil), "model.DiscoverAccountsRequest")
+	proto.RegisterType((*DiscoveredAccount)(nil), "model.DiscoveredAccount")
+	proto.RegisterType((*DiscoverAccountsResponse)(nil), "model.DiscoverAccountsResponse")
 }
 
 func init() {
@@ -330,26 +497,34 @@ func init() {
 }
 
 var fileDescriptor_44b9b1c521a5bcaa = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x4b, 0xc3, 0x40,
-	0x10, 0xc5, 0xd9, 0xc6, 0x56, 0x99, 0xb6, 0x5a, 0x16, 0x94, 0xb5, 0x78, 0x08, 0x39, 0x48, 0x28,
-	0x98, 0x88, 0x9e, 0x45, 0x9a, 0x4b, 0x3d, 0x78, 0x90, 0xed, 0xad, 0xb7, 0x64, 0x33, 0xb4, 0xc5,
-	0x24, 0x13, 0xbb, 0x1b, 0x0f, 0xfd, 0x0e, 0x7e, 0x4b, 0x3f, 0x88, 0x98, 0xfe, 0xa1, 0x49, 0x23,
-	0x78, 0x59, 0xd8, 0xf7, 0xde, 0xfe, 0x78, 0x33, 0x2c, 0x0c, 0x53, 0x8a, 0x31, 0xf1, 0x43, 0xa5,
-	0xa8, 0xc8, 0x4c, 0x10, 0x26, 0x61, 0xa6, 0xd0, 0xcb, 0x57, 0x64, 0x88, 0xb7, 0x4b, 0xcf, 0xf9,
-	0x66, 0x70, 0x3e, 0xae, 0xf8, 0xfc, 0x76, 0xaf, 0x8c, 0xe3, 0x78, 0x85, 0x5a, 0x0b, 0x66, 0x33,
-	0xb7, 0x27, 0x6b, 0x2a, 0xb7, 0xa1, 0x1b, 0x24, 0xa4, 0xde, 0x5f, 0x70, 0x39, 0x5f, 0x18, 0xd1,
-	0xb2, 0x99, 0xdb, 0x97, 0x87, 0x12, 0xf7, 0x60, 0x30, 0xcd, 0x31, 0x8b, 0xc3, 0x28, 0xc1, 0x2d,
-	0x5d, 0x58, 0x36, 0x73, 0xad, 0xa0, 0x75, 0xcf, 0xe4, 0x91, 0xc7, 0x6f, 0xe0, 0x74, 0x17, 0x3b,
-	0xd9, 0xc7, 0x76, 0x12, 0x77, 0x00, 0xde, 0x28, 0x97, 0xf8, 0x89, 0x59, 0x81, 0xa2, 0xbd, 0x0f,
-	0x1c, 0xa8, 0xfc, 0x0a, 0x3a, 0xaf, 0xa1, 0x41, 0x6d, 0x44, 0xc7, 0x66, 0xee, 0x99, 0xdc, 0xde,
-	0x9c, 0x00, 0xc4, 0x04, 0x4d, 0x75, 0x50, 0x89, 0x1f, 0x05, 0x6a, 0xf3, 0xdf, 0x79, 0x9d, 0x19,
-	0x5c, 0x37, 0x30, 0x74, 0x4e, 0x99, 0x46, 0xfe, 0x54, 0x5f, 0x63, 0x09, 0xe9, 0x3e, 0x5c, 0x7a,
-	0xe5, 0x9e, 0xbd, 0xda, 0xb3, 0x5a, 0xd8, 0x99, 0x34, 0xb0, 0xf5, 0xae, 0xe0, 0x08, 0x06, 0xd5,
-	0x2a, 0xf8, 0x5b, 0xd1, 0x72, 0x7b, 0xf2, 0x48, 0x77, 0xbe, 0x18, 0x0c, 0x9b, 0x48, 0xdb, 0x9a,
-	0x1e, 0xf0, 0xaa, 0x35, 0x5d, 0xae, 0x37, 0x55, 0xfb, 0xb2, 0xc1, 0xe1, 0xcf, 0x70, 0x51, 0x43,
-	0x89, 0x96, 0x6d, 0xfd, 0x3d, 0x57, 0x3d, 0x1d, 0x8c, 0x66, 0xee, 0x7c, 0x69, 0x16, 0x45, 0xe4,
-	0x29, 0x4a, 0xfd, 0x35, 0x51, 0xa4, 0x36, 0xe7, 0x9d, 0xa2, 0x15, 0xfa, 0x8a, 0xd2, 0x94, 0x32,
-	0xbf, 0x64, 0x45, 0x9d, 0xf2, 0x67, 0x3e, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x46, 0x03, 0x0b,
-	0x09, 0xb7, 0x02, 0x00, 0x00,
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x95, 0x54, 0xcb, 0x4a, 0xc3, 0x40,
+	0x14, 0x25, 0x8d, 0xd6, 0x72, 0xdb, 0xaa, 0x1d, 0x50, 0x63, 0x71, 0x21, 0xb3, 0x10, 0x11, 0x4d,
+	0xa5, 0xae, 0x45, 0x8c, 0x42, 0x15, 0x8b, 0x94, 0xb8, 0x73, 0x97, 0x4c, 0x2e, 0x35, 0x98, 0x66,
+	0x62, 0x1e, 0xa2, 0xfe, 0x83, 0xe0, 0x57, 0xf9, 0x25, 0x7e, 0x88, 0xd3, 0x74, 0x12, 0xcc, 0x43,
+	0xd0, 0x4d, 0x48, 0xce, 0x39, 0x73, 0xe7, 0x9c, 0x7b, 0x2f, 0x81, 0xfe, 0x8c, 0x3b, 0xe8, 0x0d,
+	0x2c, 0xc6, 0x78, 0xe2, 0xc7, 0x86, 0xe5, 0x59, 0x3e, 0x43, 0x3d, 0x08, 0x79, 0xcc, 0xc9, 0x72,
+	0xca, 0xd1, 0x2f, 0x05, 0x56, 0xcf, 0x0b, 0x3c, 0xd9, 0xcb, 0x91, 0x73, 0xc7, 0x09, 0x31, 0x8a,
+	0x34, 0x65, 0x57, 0xd9, 0xef, 0x98, 0x25, 0x94, 0xec, 0x42, 0xdb, 0xf0, 0x38, 0x7b, 0xbc, 0x42,
+	0x77, 0xfa, 0x10, 0x6b, 0x0d, 0x21, 0xea, 0x9a, 0x3f, 0x21, 0xa2, 0xc3, 0xfa, 0x5d, 0x80, 0xbe,
+	0x63, 0xd9, 0x1e, 0xca, 0xea, 0x9a, 0x2a, 0x64, 0xaa, 0xd1, 0x38, 0x56, 0xcc, 0x0a, 0x47, 0x76,
+	0x60, 0x25, 0x93, 0x2d, 0xe5, 0xb2, 0x0c, 0x22, 0x14, 0x60, 0xc2, 0x03, 0x13, 0x9f, 0xd1, 0x4f,
+	0x50, 0x5b, 0xce, 0x05, 0x3f, 0x50, 0xb2, 0x09, 0xcd, 0xb1, 0x15, 0x63, 0x14, 0x6b, 0x4d, 0xc1,
+	0xb7, 0x4c, 0xf9, 0x45, 0x0d, 0xd0, 0x46, 0x18, 0x17, 0x83, 0x9a, 0xf8, 0x94, 0x08, 0xee, 0xaf,
+	0x79, 0xe9, 0x3d, 0x6c, 0xd7, 0xd4, 0x88, 0x02, 0xee, 0x47, 0x48, 0x4e, 0xcb, 0x6d, 0x4c, 0x8b,
+	0xb4, 0x87, 0x1b, 0x7a, 0xda, 0x67, 0xbd, 0x74, 0xac, 0x24, 0xa6, 0xa3, 0x9a, 0xda, 0x51, 0x66,
+	0xf0, 0x00, 0xd6, 0x8b, 0x56, 0x70, 0x6e, 0x51, 0x15, 0x16, 0x2b, 0x38, 0x7d, 0x57, 0xa0, 0x5f,
+	0x57, 0x49, 0xda, 0xd4, 0x81, 0x14, 0xa9, 0x3b, 0xf7, 0x6d, 0x61, 0xb5, 0x6b, 0xd6, 0x30, 0xe4,
+	0x0c, 0xd6, 0x4a, 0xa5, 0xc4, 0x9c, 0xd5, 0xdf, 0x73, 0x95, 0xd5, 0x94, 0xc1, 0xd6, 0xa5, 0x1b,
+	0x31, 0xfe, 0x8c, 0xa1, 0xa4, 0xf2, 0x58, 0x87, 0xd0, 0x93, 0xd0, 0x24, 0xb1, 0x3d, 0x97, 0xdd,
+	0xe0, 0x6b, 0x96, 0xab, 0x4a, 0x90, 0x3e, 0xb4, 0x46, 0x56, 0x30, 0x76, 0x67, 0x6e, 0xb6, 0x6a,
+	0xf9, 0x37, 0xfd, 0x54, 0xa0, 0x97, 0xdd, 0x82, 0x8e, 0x3c, 0x2b, 0xf6, 0xa5, 0x23, 0x5f, 0xaf,
+	0x7d, 0x07, 0x5f, 0x64, 0xca, 0x02, 0x56, 0x33, 0xfb, 0x46, 0xed, 0xae, 0x57, 0xc7, 0xab, 0xfe,
+	0x63, 0xbc, 0xe2, 0x9a, 0xce, 0x18, 0x9d, 0x29, 0x86, 0xd1, 0xc5, 0x1c, 0x4e, 0xb7, 0x7b, 0x29,
+	0x5d, 0xde, 0x02, 0x4e, 0x3f, 0x14, 0xd0, 0xaa, 0xed, 0x92, 0xb3, 0xbb, 0x02, 0x52, 0x09, 0xb9,
+	0x68, 0x58, 0x7b, 0xa8, 0x49, 0x1f, 0x15, 0x81, 0x59, 0x73, 0x66, 0xbe, 0x50, 0xb7, 0xf8, 0x12,
+	0x17, 0xba, 0xb3, 0xe8, 0x69, 0x05, 0x37, 0x0e, 0xee, 0xf7, 0xa7, 0x6e, 0xfc, 0x90, 0xd8, 0x3a,
+	0xe3, 0xb3, 0xc1, 0x1b, 0xe7, 0x36, 0x5b, 0x3c, 0x8f, 0x18, 0x0f, 0x71, 0x20, 0xc0, 0x19, 0xf7,
+	0x07, 0xe9, 0xed, 0x76, 0x33, 0xfd, 0xb5, 0x9c, 0x7c, 0x03, 0xc0, 0xfd, 0x16, 0xce, 0x78, 0x04,
+	0x00, 0x00,
 }
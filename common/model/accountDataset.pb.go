@@ -74,14 +74,22 @@ type AccountDatasetProperty int32
 
 const (
 	AccountDatasetProperty_AccountDatasetEscrowApproval AccountDatasetProperty = 0
+	// set by a trusted setter on an account, whose value is the property the recipients of the account transfers must carry from the same setter
+	AccountDatasetProperty_AccountDatasetSpendingRestriction AccountDatasetProperty = 1
+	// set by an account on the trusted setter it allows to restrict its transfers with AccountDatasetSpendingRestriction
+	AccountDatasetProperty_AccountDatasetSpendingRestrictionConsent AccountDatasetProperty = 2
 )
 
 var AccountDatasetProperty_name = map[int32]string{
 	0: "AccountDatasetEscrowApproval",
+	1: "AccountDatasetSpendingRestriction",
+	2: "AccountDatasetSpendingRestrictionConsent",
 }
 
 var AccountDatasetProperty_value = map[string]int32{
-	"AccountDatasetEscrowApproval": 0,
+	"AccountDatasetEscrowApproval":             0,
+	"AccountDatasetSpendingRestriction":        1,
+	"AccountDatasetSpendingRestrictionConsent": 2,
 }
 
 func (x AccountDatasetProperty) String() string {
@@ -92,18 +100,94 @@ func (AccountDatasetProperty) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_8f6e88b2db5bd817, []int{0}
 }
 
+// AccountDatasetValueType type of the value of a dataset property having a registered schema
+type AccountDatasetValueType int32
+
+const (
+	// free-form string, the type of the properties without schema
+	AccountDatasetValueType_AccountDatasetValueString AccountDatasetValueType = 0
+	// decimal int64
+	AccountDatasetValueType_AccountDatasetValueInt AccountDatasetValueType = 1
+	// "true" or "false"
+	AccountDatasetValueType_AccountDatasetValueBool AccountDatasetValueType = 2
+	// hex of a full account address (account type + account public key)
+	AccountDatasetValueType_AccountDatasetValueAddress AccountDatasetValueType = 3
+	// hex of a 32 bytes hash
+	AccountDatasetValueType_AccountDatasetValueHash AccountDatasetValueType = 4
+	// JSON document
+	AccountDatasetValueType_AccountDatasetValueJSON AccountDatasetValueType = 5
+)
+
+var AccountDatasetValueType_name = map[int32]string{
+	0: "AccountDatasetValueString",
+	1: "AccountDatasetValueInt",
+	2: "AccountDatasetValueBool",
+	3: "AccountDatasetValueAddress",
+	4: "AccountDatasetValueHash",
+	5: "AccountDatasetValueJSON",
+}
+
+var AccountDatasetValueType_value = map[string]int32{
+	"AccountDatasetValueString":  0,
+	"AccountDatasetValueInt":     1,
+	"AccountDatasetValueBool":    2,
+	"AccountDatasetValueAddress": 3,
+	"AccountDatasetValueHash":    4,
+	"AccountDatasetValueJSON":    5,
+}
+
+func (x AccountDatasetValueType) String() string {
+	return proto.EnumName(AccountDatasetValueType_name, int32(x))
+}
+
+func (AccountDatasetValueType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f6e88b2db5bd817, []int{1}
+}
+
+// AccountDatasetStatus filter of the datasets listed by GetAccountDatasets
+type AccountDatasetStatus int32
+
+const (
+	// datasets set and neither removed nor expired
+	AccountDatasetStatus_AccountDatasetStatusActive AccountDatasetStatus = 0
+	// datasets deactivated once their expiry height or timestamp was reached
+	AccountDatasetStatus_AccountDatasetStatusExpired AccountDatasetStatus = 1
+)
+
+var AccountDatasetStatus_name = map[int32]string{
+	0: "AccountDatasetStatusActive",
+	1: "AccountDatasetStatusExpired",
+}
+
+var AccountDatasetStatus_value = map[string]int32{
+	"AccountDatasetStatusActive":  0,
+	"AccountDatasetStatusExpired": 1,
+}
+
+func (x AccountDatasetStatus) String() string {
+	return proto.EnumName(AccountDatasetStatus_name, int32(x))
+}
+
+func (AccountDatasetStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f6e88b2db5bd817, []int{2}
+}
+
 // AccountDataset represent the account dataset structure stored in the database
 type AccountDataset struct {
-	SetterAccountAddress    []byte   `protobuf:"bytes,1,opt,name=SetterAccountAddress,proto3" json:"SetterAccountAddress,omitempty"`
-	RecipientAccountAddress []byte   `protobuf:"bytes,2,opt,name=RecipientAccountAddress,proto3" json:"RecipientAccountAddress,omitempty"`
-	Property                string   `protobuf:"bytes,3,opt,name=Property,proto3" json:"Property,omitempty"`
-	Value                   string   `protobuf:"bytes,4,opt,name=Value,proto3" json:"Value,omitempty"`
-	IsActive                bool     `protobuf:"varint,5,opt,name=IsActive,proto3" json:"IsActive,omitempty"`
-	Latest                  bool     `protobuf:"varint,6,opt,name=Latest,proto3" json:"Latest,omitempty"`
-	Height                  uint32   `protobuf:"varint,7,opt,name=Height,proto3" json:"Height,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	SetterAccountAddress    []byte `protobuf:"bytes,1,opt,name=SetterAccountAddress,proto3" json:"SetterAccountAddress,omitempty"`
+	RecipientAccountAddress []byte `protobuf:"bytes,2,opt,name=RecipientAccountAddress,proto3" json:"RecipientAccountAddress,omitempty"`
+	Property                string `protobuf:"bytes,3,opt,name=Property,proto3" json:"Property,omitempty"`
+	Value                   string `protobuf:"bytes,4,opt,name=Value,proto3" json:"Value,omitempty"`
+	IsActive                bool   `protobuf:"varint,5,opt,name=IsActive,proto3" json:"IsActive,omitempty"`
+	Latest                  bool   `protobuf:"varint,6,opt,name=Latest,proto3" json:"Latest,omitempty"`
+	Height                  uint32 `protobuf:"varint,7,opt,name=Height,proto3" json:"Height,omitempty"`
+	// ExpiryHeight block height from which the dataset is deactivated, 0 when it doesn't expire by height
+	ExpiryHeight uint32 `protobuf:"varint,8,opt,name=ExpiryHeight,proto3" json:"ExpiryHeight,omitempty"`
+	// ExpiryTimestamp block timestamp from which the dataset is deactivated, 0 when it doesn't expire by time
+	ExpiryTimestamp      int64    `protobuf:"varint,9,opt,name=ExpiryTimestamp,proto3" json:"ExpiryTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *AccountDataset) Reset()         { *m = AccountDataset{} }
@@ -180,17 +264,32 @@ func (m *AccountDataset) GetHeight() uint32 {
 	return 0
 }
 
+func (m *AccountDataset) GetExpiryHeight() uint32 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *AccountDataset) GetExpiryTimestamp() int64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
 // GetAccountDatasetsRequest represent request fields to get account dataset
 type GetAccountDatasetsRequest struct {
-	Property                string      `protobuf:"bytes,1,opt,name=Property,proto3" json:"Property,omitempty"`
-	Value                   string      `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
-	RecipientAccountAddress []byte      `protobuf:"bytes,3,opt,name=RecipientAccountAddress,proto3" json:"RecipientAccountAddress,omitempty"`
-	SetterAccountAddress    []byte      `protobuf:"bytes,4,opt,name=SetterAccountAddress,proto3" json:"SetterAccountAddress,omitempty"`
-	Height                  uint32      `protobuf:"varint,5,opt,name=Height,proto3" json:"Height,omitempty"`
-	Pagination              *Pagination `protobuf:"bytes,6,opt,name=Pagination,proto3" json:"Pagination,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}    `json:"-"`
-	XXX_unrecognized        []byte      `json:"-"`
-	XXX_sizecache           int32       `json:"-"`
+	Property                string               `protobuf:"bytes,1,opt,name=Property,proto3" json:"Property,omitempty"`
+	Value                   string               `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	RecipientAccountAddress []byte               `protobuf:"bytes,3,opt,name=RecipientAccountAddress,proto3" json:"RecipientAccountAddress,omitempty"`
+	SetterAccountAddress    []byte               `protobuf:"bytes,4,opt,name=SetterAccountAddress,proto3" json:"SetterAccountAddress,omitempty"`
+	Height                  uint32               `protobuf:"varint,5,opt,name=Height,proto3" json:"Height,omitempty"`
+	Pagination              *Pagination          `protobuf:"bytes,6,opt,name=Pagination,proto3" json:"Pagination,omitempty"`
+	Status                  AccountDatasetStatus `protobuf:"varint,7,opt,name=Status,proto3,enum=model.AccountDatasetStatus" json:"Status,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}             `json:"-"`
+	XXX_unrecognized        []byte               `json:"-"`
+	XXX_sizecache           int32                `json:"-"`
 }
 
 func (m *GetAccountDatasetsRequest) Reset()         { *m = GetAccountDatasetsRequest{} }
@@ -260,6 +359,13 @@ func (m *GetAccountDatasetsRequest) GetPagination() *Pagination {
 	return nil
 }
 
+func (m *GetAccountDatasetsRequest) GetStatus() AccountDatasetStatus {
+	if m != nil {
+		return m.Status
+	}
+	return AccountDatasetStatus_AccountDatasetStatusActive
+}
+
 type GetAccountDatasetsResponse struct {
 	Total                uint64            `protobuf:"varint,1,opt,name=Total,proto3" json:"Total,omitempty"`
 	AccountDatasets      []*AccountDataset `protobuf:"bytes,2,rep,name=AccountDatasets,proto3" json:"AccountDatasets,omitempty"`
@@ -354,12 +460,275 @@ func (m *GetAccountDatasetRequest) GetRecipientAccountAddress() []byte {
 	return nil
 }
 
+// AccountDatasetSchema the type the values of a property set by the setter account must have
+type AccountDatasetSchema struct {
+	SetterAccountAddress []byte                  `protobuf:"bytes,1,opt,name=SetterAccountAddress,proto3" json:"SetterAccountAddress,omitempty"`
+	Property             string                  `protobuf:"bytes,2,opt,name=Property,proto3" json:"Property,omitempty"`
+	ValueType            AccountDatasetValueType `protobuf:"varint,3,opt,name=ValueType,proto3,enum=model.AccountDatasetValueType" json:"ValueType,omitempty"`
+	// MaxLength maximum length of the value string, 0 when not limited
+	MaxLength            uint32   `protobuf:"varint,4,opt,name=MaxLength,proto3" json:"MaxLength,omitempty"`
+	BlockHeight          uint32   `protobuf:"varint,5,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	Latest               bool     `protobuf:"varint,6,opt,name=Latest,proto3" json:"Latest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountDatasetSchema) Reset()         { *m = AccountDatasetSchema{} }
+func (m *AccountDatasetSchema) String() string { return proto.CompactTextString(m) }
+func (*AccountDatasetSchema) ProtoMessage()    {}
+func (*AccountDatasetSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f6e88b2db5bd817, []int{4}
+}
+
+func (m *AccountDatasetSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountDatasetSchema.Unmarshal(m, b)
+}
+func (m *AccountDatasetSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountDatasetSchema.Marshal(b, m, deterministic)
+}
+func (m *AccountDatasetSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountDatasetSchema.Merge(m, src)
+}
+func (m *AccountDatasetSchema) XXX_Size() int {
+	return xxx_messageInfo_AccountDatasetSchema.Size(m)
+}
+func (m *AccountDatasetSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountDatasetSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountDatasetSchema proto.InternalMessageInfo
+
+func (m *AccountDatasetSchema) GetSetterAccountAddress() []byte {
+	if m != nil {
+		return m.SetterAccountAddress
+	}
+	return nil
+}
+
+func (m *AccountDatasetSchema) GetProperty() string {
+	if m != nil {
+		return m.Property
+	}
+	return ""
+}
+
+func (m *AccountDatasetSchema) GetValueType() AccountDatasetValueType {
+	if m != nil {
+		return m.ValueType
+	}
+	return AccountDatasetValueType_AccountDatasetValueString
+}
+
+func (m *AccountDatasetSchema) GetMaxLength() uint32 {
+	if m != nil {
+		return m.MaxLength
+	}
+	return 0
+}
+
+func (m *AccountDatasetSchema) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *AccountDatasetSchema) GetLatest() bool {
+	if m != nil {
+		return m.Latest
+	}
+	return false
+}
+
+// TypedAccountDataset account dataset with its value parsed according to the schema of its property
+type TypedAccountDataset struct {
+	AccountDataset *AccountDataset `protobuf:"bytes,1,opt,name=AccountDataset,proto3" json:"AccountDataset,omitempty"`
+	// ValueType AccountDatasetValueString when the dataset has no schema or was set before its schema
+	ValueType AccountDatasetValueType `protobuf:"varint,2,opt,name=ValueType,proto3,enum=model.AccountDatasetValueType" json:"ValueType,omitempty"`
+	IntValue  int64                   `protobuf:"varint,3,opt,name=IntValue,proto3" json:"IntValue,omitempty"`
+	BoolValue bool                    `protobuf:"varint,4,opt,name=BoolValue,proto3" json:"BoolValue,omitempty"`
+	// BytesValue decoded address or hash
+	BytesValue           []byte   `protobuf:"bytes,5,opt,name=BytesValue,proto3" json:"BytesValue,omitempty"`
+	JSONValue            string   `protobuf:"bytes,6,opt,name=JSONValue,proto3" json:"JSONValue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TypedAccountDataset) Reset()         { *m = TypedAccountDataset{} }
+func (m *TypedAccountDataset) String() string { return proto.CompactTextString(m) }
+func (*TypedAccountDataset) ProtoMessage()    {}
+func (*TypedAccountDataset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f6e88b2db5bd817, []int{5}
+}
+
+func (m *TypedAccountDataset) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TypedAccountDataset.Unmarshal(m, b)
+}
+func (m *TypedAccountDataset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TypedAccountDataset.Marshal(b, m, deterministic)
+}
+func (m *TypedAccountDataset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TypedAccountDataset.Merge(m, src)
+}
+func (m *TypedAccountDataset) XXX_Size() int {
+	return xxx_messageInfo_TypedAccountDataset.Size(m)
+}
+func (m *TypedAccountDataset) XXX_DiscardUnknown() {
+	xxx_messageInfo_TypedAccountDataset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TypedAccountDataset proto.InternalMessageInfo
+
+func (m *TypedAccountDataset) GetAccountDataset() *AccountDataset {
+	if m != nil {
+		return m.AccountDataset
+	}
+	return nil
+}
+
+func (m *TypedAccountDataset) GetValueType() AccountDatasetValueType {
+	if m != nil {
+		return m.ValueType
+	}
+	return AccountDatasetValueType_AccountDatasetValueString
+}
+
+func (m *TypedAccountDataset) GetIntValue() int64 {
+	if m != nil {
+		return m.IntValue
+	}
+	return 0
+}
+
+func (m *TypedAccountDataset) GetBoolValue() bool {
+	if m != nil {
+		return m.BoolValue
+	}
+	return false
+}
+
+func (m *TypedAccountDataset) GetBytesValue() []byte {
+	if m != nil {
+		return m.BytesValue
+	}
+	return nil
+}
+
+func (m *TypedAccountDataset) GetJSONValue() string {
+	if m != nil {
+		return m.JSONValue
+	}
+	return ""
+}
+
+type GetTypedAccountDatasetsResponse struct {
+	Total                uint64                 `protobuf:"varint,1,opt,name=Total,proto3" json:"Total,omitempty"`
+	TypedAccountDatasets []*TypedAccountDataset `protobuf:"bytes,2,rep,name=TypedAccountDatasets,proto3" json:"TypedAccountDatasets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetTypedAccountDatasetsResponse) Reset()         { *m = GetTypedAccountDatasetsResponse{} }
+func (m *GetTypedAccountDatasetsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTypedAccountDatasetsResponse) ProtoMessage()    {}
+func (*GetTypedAccountDatasetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f6e88b2db5bd817, []int{6}
+}
+
+func (m *GetTypedAccountDatasetsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTypedAccountDatasetsResponse.Unmarshal(m, b)
+}
+func (m *GetTypedAccountDatasetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTypedAccountDatasetsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTypedAccountDatasetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTypedAccountDatasetsResponse.Merge(m, src)
+}
+func (m *GetTypedAccountDatasetsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTypedAccountDatasetsResponse.Size(m)
+}
+func (m *GetTypedAccountDatasetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTypedAccountDatasetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTypedAccountDatasetsResponse proto.InternalMessageInfo
+
+func (m *GetTypedAccountDatasetsResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *GetTypedAccountDatasetsResponse) GetTypedAccountDatasets() []*TypedAccountDataset {
+	if m != nil {
+		return m.TypedAccountDatasets
+	}
+	return nil
+}
+
+type GetAccountDatasetSchemaRequest struct {
+	SetterAccountAddress []byte   `protobuf:"bytes,1,opt,name=SetterAccountAddress,proto3" json:"SetterAccountAddress,omitempty"`
+	Property             string   `protobuf:"bytes,2,opt,name=Property,proto3" json:"Property,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAccountDatasetSchemaRequest) Reset()         { *m = GetAccountDatasetSchemaRequest{} }
+func (m *GetAccountDatasetSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountDatasetSchemaRequest) ProtoMessage()    {}
+func (*GetAccountDatasetSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f6e88b2db5bd817, []int{7}
+}
+
+func (m *GetAccountDatasetSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountDatasetSchemaRequest.Unmarshal(m, b)
+}
+func (m *GetAccountDatasetSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountDatasetSchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountDatasetSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountDatasetSchemaRequest.Merge(m, src)
+}
+func (m *GetAccountDatasetSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountDatasetSchemaRequest.Size(m)
+}
+func (m *GetAccountDatasetSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountDatasetSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountDatasetSchemaRequest proto.InternalMessageInfo
+
+func (m *GetAccountDatasetSchemaRequest) GetSetterAccountAddress() []byte {
+	if m != nil {
+		return m.SetterAccountAddress
+	}
+	return nil
+}
+
+func (m *GetAccountDatasetSchemaRequest) GetProperty() string {
+	if m != nil {
+		return m.Property
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("model.AccountDatasetProperty", AccountDatasetProperty_name, AccountDatasetProperty_value)
+	proto.RegisterEnum("model.AccountDatasetValueType", AccountDatasetValueType_name, AccountDatasetValueType_value)
+	proto.RegisterEnum("model.AccountDatasetStatus", AccountDatasetStatus_name, AccountDatasetStatus_value)
 	proto.RegisterType((*AccountDataset)(nil), "model.AccountDataset")
 	proto.RegisterType((*GetAccountDatasetsRequest)(nil), "model.GetAccountDatasetsRequest")
 	proto.RegisterType((*GetAccountDatasetsResponse)(nil), "model.GetAccountDatasetsResponse")
 	proto.RegisterType((*GetAccountDatasetRequest)(nil), "model.GetAccountDatasetRequest")
+	proto.RegisterType((*AccountDatasetSchema)(nil), "model.AccountDatasetSchema")
+	proto.RegisterType((*TypedAccountDataset)(nil), "model.TypedAccountDataset")
+	proto.RegisterType((*GetTypedAccountDatasetsResponse)(nil), "model.GetTypedAccountDatasetsResponse")
+	proto.RegisterType((*GetAccountDatasetSchemaRequest)(nil), "model.GetAccountDatasetSchemaRequest")
 }
 
 func init() {
@@ -367,31 +736,53 @@ func init() {
 }
 
 var fileDescriptor_8f6e88b2db5bd817 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x8f, 0xd3, 0x30,
-	0x10, 0xc5, 0x71, 0xda, 0x94, 0x65, 0x96, 0xbf, 0xd6, 0x52, 0x4c, 0xc5, 0x21, 0xea, 0x29, 0x5a,
-	0x89, 0x16, 0xca, 0x05, 0x71, 0x41, 0x5d, 0x81, 0x00, 0x89, 0xc3, 0xca, 0x20, 0x0e, 0xdc, 0x5c,
-	0x77, 0xd4, 0x8d, 0xd4, 0x66, 0x8c, 0x3d, 0xdd, 0x15, 0x7c, 0x00, 0x3e, 0x35, 0x07, 0x54, 0xa7,
-	0xea, 0x26, 0xa5, 0xad, 0xd0, 0x5e, 0x22, 0x3d, 0xbf, 0x79, 0x99, 0x99, 0x5f, 0x1c, 0xe8, 0x2d,
-	0x68, 0x8a, 0xf3, 0xa1, 0xb1, 0x96, 0x96, 0x25, 0xbf, 0x33, 0x6c, 0x02, 0xf2, 0xc0, 0x79, 0x62,
-	0x92, 0x69, 0xf4, 0x7a, 0xdd, 0xaa, 0xc4, 0x99, 0x59, 0x51, 0x1a, 0x2e, 0xa8, 0xac, 0xec, 0xfe,
-	0x1f, 0x01, 0xf7, 0xc7, 0x8d, 0x9c, 0x1c, 0xc1, 0xc9, 0x17, 0x64, 0x46, 0xbf, 0x3e, 0x1f, 0x4f,
-	0xa7, 0x1e, 0x43, 0x50, 0x22, 0x13, 0xf9, 0x5d, 0xbd, 0xd3, 0x93, 0xaf, 0xe1, 0x89, 0x46, 0x5b,
-	0xb8, 0x02, 0x4b, 0xde, 0x8a, 0x25, 0x31, 0xb6, 0xcf, 0x96, 0x3d, 0x38, 0x3a, 0xf7, 0xe4, 0xd0,
-	0xf3, 0x4f, 0xd5, 0xca, 0x44, 0x7e, 0x47, 0x6f, 0xb4, 0x3c, 0x81, 0xf4, 0x9b, 0x99, 0x2f, 0x51,
-	0xb5, 0xa3, 0x51, 0x89, 0x55, 0xe2, 0x53, 0x18, 0x5b, 0x2e, 0x2e, 0x51, 0xa5, 0x99, 0xc8, 0x8f,
-	0xf4, 0x46, 0xcb, 0x2e, 0x74, 0x3e, 0x1b, 0xc6, 0xc0, 0xaa, 0x13, 0x9d, 0xb5, 0x5a, 0x9d, 0x7f,
-	0xc4, 0x62, 0x76, 0xc1, 0xea, 0x76, 0x26, 0xf2, 0x7b, 0x7a, 0xad, 0xfa, 0xbf, 0x13, 0x78, 0xfa,
-	0x01, 0xb9, 0x49, 0x20, 0x68, 0xfc, 0xb1, 0x5c, 0xa5, 0xea, 0xb3, 0x89, 0x7d, 0xb3, 0x25, 0xf5,
-	0xd9, 0x0e, 0x70, 0x68, 0x1d, 0xe6, 0xb0, 0x8f, 0x7a, 0xfb, 0x00, 0xf5, 0xeb, 0xad, 0xd2, 0xfa,
-	0x56, 0xf2, 0x25, 0xc0, 0xf9, 0xe6, 0x43, 0x47, 0x12, 0xc7, 0xa3, 0x47, 0x83, 0x78, 0x03, 0x06,
-	0xd7, 0x86, 0xae, 0x15, 0xf5, 0xaf, 0xa0, 0xb7, 0x8b, 0x43, 0x70, 0x54, 0x06, 0x94, 0x0a, 0xd2,
-	0xaf, 0xc4, 0x66, 0x1e, 0x29, 0xb4, 0xcf, 0x92, 0x17, 0x42, 0x57, 0x07, 0xf2, 0x2d, 0x3c, 0xd8,
-	0x0a, 0xa9, 0x24, 0x6b, 0xe5, 0xc7, 0xa3, 0xc7, 0xeb, 0x7e, 0x4d, 0x57, 0x6f, 0x57, 0xf7, 0x1d,
-	0xa8, 0x7f, 0x1a, 0xff, 0x0f, 0xff, 0x1b, 0xdf, 0xb8, 0xd3, 0x37, 0xd0, 0x6d, 0xb6, 0xdb, 0xbc,
-	0x33, 0x83, 0x67, 0x4d, 0xe7, 0x7d, 0xb0, 0x9e, 0xae, 0xc6, 0xce, 0x79, 0xba, 0x34, 0xf3, 0x87,
-	0xb7, 0xce, 0x4e, 0xbf, 0xe7, 0xb3, 0x82, 0x2f, 0x96, 0x93, 0x81, 0xa5, 0xc5, 0xf0, 0x17, 0xd1,
-	0xc4, 0x56, 0xcf, 0xe7, 0x96, 0x3c, 0x0e, 0x2d, 0x2d, 0x16, 0x54, 0x0e, 0xe3, 0xe6, 0x93, 0x4e,
-	0xfc, 0xc3, 0x5e, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xcb, 0x4e, 0x16, 0x9e, 0x03, 0x00,
-	0x00,
+	// 758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0xd3, 0x4c,
+	0x10, 0xed, 0x3a, 0x3f, 0x5f, 0x32, 0xfd, 0xf3, 0xb7, 0x84, 0xd4, 0x4d, 0x4b, 0x6a, 0x2c, 0x21,
+	0x59, 0x51, 0x49, 0x20, 0xbd, 0xe1, 0x02, 0x84, 0x12, 0xa8, 0xda, 0xa2, 0x52, 0xaa, 0x4d, 0x05,
+	0x12, 0x77, 0xae, 0xb3, 0x4a, 0x2c, 0x12, 0xaf, 0xb1, 0x37, 0x6d, 0xc3, 0x2b, 0x20, 0xc1, 0x03,
+	0xf1, 0x08, 0x48, 0x3c, 0x11, 0x17, 0xc8, 0x6b, 0x13, 0xc7, 0x8e, 0x5d, 0x4a, 0xc5, 0x4d, 0xa5,
+	0x3d, 0x67, 0xa6, 0xbb, 0x73, 0xe6, 0xcc, 0x38, 0x50, 0x1b, 0xb3, 0x3e, 0x1d, 0xb5, 0x0c, 0xd3,
+	0x64, 0x13, 0x9b, 0xbf, 0x34, 0xb8, 0xe1, 0x51, 0xde, 0x74, 0x5c, 0xc6, 0x19, 0x2e, 0x08, 0xae,
+	0x56, 0x0d, 0x42, 0x1c, 0x63, 0x60, 0xd9, 0x06, 0xb7, 0x98, 0x1d, 0xd0, 0xda, 0x0f, 0x09, 0xd6,
+	0x3a, 0xb1, 0x3c, 0xdc, 0x86, 0x4a, 0x8f, 0x72, 0x4e, 0xdd, 0x10, 0xef, 0xf4, 0xfb, 0x2e, 0xf5,
+	0x3c, 0x05, 0xa9, 0x48, 0x5f, 0x21, 0xa9, 0x1c, 0x7e, 0x02, 0x1b, 0x84, 0x9a, 0x96, 0x63, 0x51,
+	0x9b, 0x27, 0xd2, 0x24, 0x91, 0x96, 0x45, 0xe3, 0x1a, 0x94, 0x4e, 0x5d, 0xe6, 0x50, 0x97, 0x4f,
+	0x95, 0x9c, 0x8a, 0xf4, 0x32, 0x99, 0x9d, 0x71, 0x05, 0x0a, 0x6f, 0x8d, 0xd1, 0x84, 0x2a, 0x79,
+	0x41, 0x04, 0x07, 0x3f, 0xe3, 0xc8, 0xeb, 0x98, 0xdc, 0xba, 0xa0, 0x4a, 0x41, 0x45, 0x7a, 0x89,
+	0xcc, 0xce, 0xb8, 0x0a, 0xc5, 0x63, 0x83, 0x53, 0x8f, 0x2b, 0x45, 0xc1, 0x84, 0x27, 0x1f, 0x3f,
+	0xa4, 0xd6, 0x60, 0xc8, 0x95, 0xff, 0x54, 0xa4, 0xaf, 0x92, 0xf0, 0x84, 0x35, 0x58, 0xd9, 0xbf,
+	0x72, 0x2c, 0x77, 0x1a, 0xb2, 0x25, 0xc1, 0xc6, 0x30, 0xbc, 0x0b, 0xeb, 0xc1, 0xf9, 0xcc, 0x1a,
+	0x53, 0x8f, 0x1b, 0x63, 0x47, 0x29, 0xab, 0x48, 0xcf, 0x75, 0xa5, 0x47, 0x88, 0x24, 0x29, 0xed,
+	0x9b, 0x04, 0x9b, 0x07, 0x94, 0xc7, 0x35, 0xf5, 0x08, 0xfd, 0x38, 0xf1, 0xdf, 0x31, 0x5f, 0x2d,
+	0xca, 0xaa, 0x56, 0x9a, 0xaf, 0xf6, 0x1a, 0x65, 0x73, 0xd7, 0x2b, 0x9b, 0xd5, 0xc7, 0xfc, 0x35,
+	0x7d, 0x8c, 0x74, 0x2a, 0xc4, 0x74, 0x7a, 0x0c, 0x70, 0x3a, 0xb3, 0x8e, 0xd0, 0x76, 0xb9, 0xfd,
+	0x7f, 0x53, 0x78, 0xaa, 0x19, 0x11, 0x64, 0x2e, 0x08, 0xef, 0x41, 0xb1, 0xc7, 0x0d, 0x3e, 0xf1,
+	0x84, 0xe4, 0x6b, 0xed, 0xad, 0x30, 0x3c, 0xae, 0x4c, 0x10, 0x42, 0xc2, 0x50, 0xed, 0x12, 0x6a,
+	0x69, 0xe2, 0x79, 0x0e, 0xb3, 0x3d, 0x8a, 0x15, 0x28, 0x9c, 0x31, 0x6e, 0x8c, 0x84, 0x74, 0x79,
+	0xa1, 0x7f, 0x00, 0xe0, 0xe7, 0xb0, 0x9e, 0x48, 0x52, 0x24, 0x35, 0xa7, 0x2f, 0xb7, 0xef, 0xa6,
+	0xde, 0x4a, 0x92, 0xd1, 0x9a, 0x03, 0xca, 0xc2, 0xc5, 0x37, 0x69, 0xda, 0xad, 0x8d, 0xaf, 0xfd,
+	0x44, 0x50, 0x49, 0x68, 0x61, 0x0e, 0xe9, 0xd8, 0xb8, 0xd5, 0xfc, 0xcd, 0x3f, 0x51, 0x4a, 0x3c,
+	0xf1, 0x29, 0x94, 0x85, 0x95, 0xce, 0xa6, 0x0e, 0x15, 0x9e, 0x59, 0x6b, 0xd7, 0x53, 0x55, 0x99,
+	0x45, 0x91, 0x28, 0x01, 0x6f, 0x43, 0xf9, 0xb5, 0x71, 0x75, 0x4c, 0xed, 0x01, 0x1f, 0x0a, 0xeb,
+	0xac, 0x92, 0x08, 0xc0, 0x2a, 0x2c, 0x77, 0x47, 0xcc, 0xfc, 0x10, 0x33, 0xcd, 0x3c, 0x94, 0x35,
+	0x91, 0xda, 0x17, 0x09, 0xee, 0xf8, 0x17, 0xf4, 0x13, 0xdb, 0xe7, 0x59, 0x72, 0x1f, 0x89, 0xba,
+	0x33, 0x1b, 0x99, 0x5c, 0x5e, 0xb1, 0x62, 0xa5, 0xbf, 0x2d, 0xb6, 0x0e, 0xa5, 0x23, 0x3b, 0xa0,
+	0x94, 0xdc, 0x6c, 0xc6, 0x67, 0x98, 0x2f, 0x46, 0x97, 0xb1, 0x51, 0xb4, 0x94, 0x4a, 0x24, 0x02,
+	0x70, 0x1d, 0xa0, 0x3b, 0xe5, 0xd4, 0x0b, 0xe8, 0x82, 0x68, 0xd7, 0x1c, 0xe2, 0x67, 0xbf, 0xea,
+	0xbd, 0x39, 0x09, 0xe8, 0xa2, 0xe8, 0x52, 0x04, 0x68, 0x9f, 0x11, 0xec, 0x1c, 0x50, 0x9e, 0xa2,
+	0xc9, 0x4d, 0x06, 0xe0, 0x04, 0x2a, 0x69, 0x99, 0xe1, 0x14, 0xd4, 0x42, 0x09, 0x52, 0x42, 0x48,
+	0x6a, 0x9e, 0xe6, 0x40, 0x7d, 0x61, 0x1e, 0x02, 0x7f, 0xfe, 0x9e, 0x8a, 0x7f, 0x6c, 0xd3, 0xc6,
+	0x57, 0x04, 0xd5, 0xf8, 0x7d, 0x33, 0x07, 0xab, 0xb0, 0x1d, 0x67, 0xf6, 0x3d, 0xd3, 0x65, 0x97,
+	0x1d, 0xc7, 0x71, 0xd9, 0x85, 0x31, 0x92, 0x97, 0xf0, 0x03, 0xb8, 0x9f, 0x78, 0xab, 0x43, 0xed,
+	0xbe, 0x65, 0x0f, 0x08, 0xf5, 0xb8, 0x6b, 0x99, 0xfe, 0x46, 0x92, 0x11, 0xde, 0x05, 0xfd, 0x8f,
+	0x61, 0x2f, 0x7c, 0xa5, 0x6d, 0x2e, 0x4b, 0x8d, 0xef, 0x08, 0x36, 0x32, 0x4c, 0x83, 0xef, 0xc1,
+	0x66, 0x0a, 0xd5, 0xe3, 0xae, 0x65, 0x0f, 0xe4, 0x25, 0x5c, 0x83, 0x6a, 0x0a, 0x7d, 0x64, 0x73,
+	0x19, 0xe1, 0xad, 0xd4, 0xff, 0xea, 0xdb, 0x48, 0x96, 0x70, 0x1d, 0x6a, 0x29, 0x64, 0xa8, 0x9f,
+	0x9c, 0xcb, 0x48, 0x3e, 0x34, 0xbc, 0xa1, 0x9c, 0xcf, 0x20, 0x7d, 0x8b, 0xc9, 0x85, 0xc6, 0xbb,
+	0x85, 0x75, 0x23, 0x56, 0xee, 0xe2, 0x8d, 0x01, 0x1e, 0x7c, 0x50, 0xe5, 0x25, 0xbc, 0x03, 0x5b,
+	0x69, 0xbc, 0xf8, 0xee, 0xd1, 0xbe, 0x8c, 0xba, 0x8d, 0xf7, 0xfa, 0xc0, 0xe2, 0xc3, 0xc9, 0x79,
+	0xd3, 0x64, 0xe3, 0xd6, 0x27, 0xc6, 0xce, 0xcd, 0xe0, 0xef, 0x43, 0x93, 0xb9, 0xb4, 0x65, 0xb2,
+	0xf1, 0x98, 0xd9, 0x2d, 0x61, 0xc0, 0xf3, 0xa2, 0xf8, 0xd5, 0xb1, 0xf7, 0x6b, 0x00, 0x4f, 0x00,
+	0xca, 0x61, 0xb2, 0x08, 0x00, 0x00,
 }
@@ -69,6 +69,32 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// AccountLedgerEventType represent whether streamed account ledgers have been written or removed by a rollback
+type AccountLedgerEventType int32
+
+const (
+	AccountLedgerEventType_AccountLedgerEventAdded     AccountLedgerEventType = 0
+	AccountLedgerEventType_AccountLedgerEventRetracted AccountLedgerEventType = 1
+)
+
+var AccountLedgerEventType_name = map[int32]string{
+	0: "AccountLedgerEventAdded",
+	1: "AccountLedgerEventRetracted",
+}
+
+var AccountLedgerEventType_value = map[string]int32{
+	"AccountLedgerEventAdded":     0,
+	"AccountLedgerEventRetracted": 1,
+}
+
+func (x AccountLedgerEventType) String() string {
+	return proto.EnumName(AccountLedgerEventType_name, int32(x))
+}
+
+func (AccountLedgerEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8b8de9896218a2b4, []int{0}
+}
+
 type AccountLedger struct {
 	AccountAddress       []byte    `protobuf:"bytes,1,opt,name=AccountAddress,proto3" json:"AccountAddress,omitempty"`
 	BalanceChange        int64     `protobuf:"varint,2,opt,name=BalanceChange,proto3" json:"BalanceChange,omitempty"`
@@ -276,10 +302,127 @@ func (m *GetAccountLedgersResponse) GetAccountLedgers() []*AccountLedger {
 	return nil
 }
 
+// SubscribeAccountLedgersRequest represent request body for SubscribeAccountLedgers
+type SubscribeAccountLedgersRequest struct {
+	AccountAddresses [][]byte `protobuf:"bytes,1,rep,name=AccountAddresses,proto3" json:"AccountAddresses,omitempty"`
+	// EventTypes filter the streamed ledgers, empty means any event type
+	EventTypes []EventType `protobuf:"varint,2,rep,packed,name=EventTypes,proto3,enum=model.EventType" json:"EventTypes,omitempty"`
+	// FromHeight replay ledgers written from this block height before streaming new ones, 0 means only new ledgers
+	FromHeight           uint32   `protobuf:"varint,3,opt,name=FromHeight,proto3" json:"FromHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeAccountLedgersRequest) Reset()         { *m = SubscribeAccountLedgersRequest{} }
+func (m *SubscribeAccountLedgersRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeAccountLedgersRequest) ProtoMessage()    {}
+func (*SubscribeAccountLedgersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b8de9896218a2b4, []int{3}
+}
+
+func (m *SubscribeAccountLedgersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeAccountLedgersRequest.Unmarshal(m, b)
+}
+func (m *SubscribeAccountLedgersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeAccountLedgersRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeAccountLedgersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeAccountLedgersRequest.Merge(m, src)
+}
+func (m *SubscribeAccountLedgersRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeAccountLedgersRequest.Size(m)
+}
+func (m *SubscribeAccountLedgersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeAccountLedgersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeAccountLedgersRequest proto.InternalMessageInfo
+
+func (m *SubscribeAccountLedgersRequest) GetAccountAddresses() [][]byte {
+	if m != nil {
+		return m.AccountAddresses
+	}
+	return nil
+}
+
+func (m *SubscribeAccountLedgersRequest) GetEventTypes() []EventType {
+	if m != nil {
+		return m.EventTypes
+	}
+	return nil
+}
+
+func (m *SubscribeAccountLedgersRequest) GetFromHeight() uint32 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+// AccountLedgerEvent represent account ledgers written at a block height, or retracted by a rollback to it
+type AccountLedgerEvent struct {
+	EventType            AccountLedgerEventType `protobuf:"varint,1,opt,name=EventType,proto3,enum=model.AccountLedgerEventType" json:"EventType,omitempty"`
+	BlockHeight          uint32                 `protobuf:"varint,2,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	AccountLedgers       []*AccountLedger       `protobuf:"bytes,3,rep,name=AccountLedgers,proto3" json:"AccountLedgers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *AccountLedgerEvent) Reset()         { *m = AccountLedgerEvent{} }
+func (m *AccountLedgerEvent) String() string { return proto.CompactTextString(m) }
+func (*AccountLedgerEvent) ProtoMessage()    {}
+func (*AccountLedgerEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b8de9896218a2b4, []int{4}
+}
+
+func (m *AccountLedgerEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountLedgerEvent.Unmarshal(m, b)
+}
+func (m *AccountLedgerEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountLedgerEvent.Marshal(b, m, deterministic)
+}
+func (m *AccountLedgerEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountLedgerEvent.Merge(m, src)
+}
+func (m *AccountLedgerEvent) XXX_Size() int {
+	return xxx_messageInfo_AccountLedgerEvent.Size(m)
+}
+func (m *AccountLedgerEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountLedgerEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountLedgerEvent proto.InternalMessageInfo
+
+func (m *AccountLedgerEvent) GetEventType() AccountLedgerEventType {
+	if m != nil {
+		return m.EventType
+	}
+	return AccountLedgerEventType_AccountLedgerEventAdded
+}
+
+func (m *AccountLedgerEvent) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *AccountLedgerEvent) GetAccountLedgers() []*AccountLedger {
+	if m != nil {
+		return m.AccountLedgers
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("model.AccountLedgerEventType", AccountLedgerEventType_name, AccountLedgerEventType_value)
 	proto.RegisterType((*AccountLedger)(nil), "model.AccountLedger")
 	proto.RegisterType((*GetAccountLedgersRequest)(nil), "model.GetAccountLedgersRequest")
 	proto.RegisterType((*GetAccountLedgersResponse)(nil), "model.GetAccountLedgersResponse")
+	proto.RegisterType((*SubscribeAccountLedgersRequest)(nil), "model.SubscribeAccountLedgersRequest")
+	proto.RegisterType((*AccountLedgerEvent)(nil), "model.AccountLedgerEvent")
 }
 
 func init() {
@@ -287,30 +430,37 @@ func init() {
 }
 
 var fileDescriptor_8b8de9896218a2b4 = []byte{
-	// 390 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0xab, 0xd3, 0x40,
-	0x14, 0x85, 0x99, 0x24, 0x7d, 0xf0, 0xa6, 0x2f, 0xc5, 0x37, 0x88, 0xcc, 0x2b, 0x2e, 0x42, 0x90,
-	0x32, 0x08, 0x26, 0x5a, 0xb7, 0x6e, 0x1a, 0x2d, 0x2a, 0xb8, 0x90, 0x31, 0x2b, 0x77, 0xd3, 0xc9,
-	0x25, 0x0d, 0x26, 0x33, 0x31, 0x33, 0x15, 0xf4, 0xaf, 0xf8, 0x43, 0xdd, 0x4a, 0x27, 0x21, 0x6d,
-	0xaa, 0xe2, 0xdb, 0x64, 0xf1, 0x9d, 0x93, 0x7b, 0xef, 0x39, 0x09, 0xbe, 0x6b, 0x74, 0x01, 0x75,
-	0x2a, 0xa4, 0xd4, 0x07, 0x65, 0x3f, 0x40, 0x51, 0x42, 0x97, 0xb4, 0x9d, 0xb6, 0x9a, 0xcc, 0x9c,
-	0xb4, 0xbc, 0xed, 0x1d, 0xf0, 0x0d, 0x94, 0xed, 0x95, 0xe5, 0xa3, 0x1e, 0xb5, 0xa2, 0xac, 0x94,
-	0xb0, 0x95, 0x56, 0x3d, 0x8f, 0x7f, 0x21, 0x1c, 0x6e, 0xce, 0x27, 0x91, 0x15, 0x5e, 0x0c, 0x60,
-	0x53, 0x14, 0x1d, 0x18, 0x43, 0x51, 0x84, 0xd8, 0x0d, 0xbf, 0xa0, 0xe4, 0x09, 0x0e, 0x33, 0x51,
-	0x0b, 0x25, 0xe1, 0xf5, 0x5e, 0xa8, 0x12, 0xa8, 0x17, 0x21, 0xe6, 0xf3, 0x29, 0x24, 0x11, 0x9e,
-	0x67, 0xb5, 0x96, 0x5f, 0xde, 0x41, 0x55, 0xee, 0x2d, 0xf5, 0x23, 0xc4, 0x42, 0x7e, 0x8e, 0x08,
-	0xc3, 0x61, 0xde, 0x09, 0x65, 0x84, 0x3c, 0x9e, 0xf5, 0xfe, 0x0d, 0x0d, 0x8e, 0x73, 0x32, 0xef,
-	0x39, 0xe2, 0x53, 0x81, 0x3c, 0xc6, 0xd7, 0x79, 0xd5, 0x80, 0xb1, 0xa2, 0x69, 0xe9, 0x2c, 0x42,
-	0x2c, 0xe0, 0x27, 0x40, 0x12, 0x7c, 0xbd, 0x3d, 0x06, 0xce, 0xbf, 0xb7, 0x40, 0xaf, 0x22, 0xc4,
-	0x16, 0xeb, 0x07, 0x89, 0x4b, 0x9d, 0x8c, 0x9c, 0x9f, 0x2c, 0xf1, 0x4f, 0x0f, 0xd3, 0xb7, 0x60,
-	0x27, 0xe1, 0x0d, 0x87, 0xaf, 0x07, 0x30, 0xf6, 0xde, 0x25, 0x4c, 0x96, 0x7a, 0xff, 0x5d, 0xfa,
-	0x67, 0x58, 0xff, 0x5f, 0x61, 0x57, 0x78, 0x31, 0x66, 0xfb, 0x64, 0x45, 0x67, 0x5d, 0x2f, 0x01,
-	0xbf, 0xa0, 0x24, 0xc6, 0x37, 0x23, 0xd9, 0xaa, 0xc2, 0xf5, 0x12, 0xf2, 0x09, 0x23, 0x2f, 0x30,
-	0xfe, 0x38, 0x7e, 0x78, 0xd7, 0xcd, 0x7c, 0x7d, 0x3b, 0x9c, 0x79, 0x12, 0xf8, 0x99, 0x29, 0x36,
-	0xf8, 0xee, 0x2f, 0xe5, 0x98, 0x56, 0x2b, 0x03, 0x84, 0xe2, 0x59, 0xae, 0xad, 0xa8, 0x5d, 0x29,
-	0x81, 0xbb, 0xbe, 0x07, 0xe4, 0xd5, 0xd8, 0xdb, 0xf0, 0x0e, 0xf5, 0x22, 0x9f, 0xcd, 0xd7, 0x0f,
-	0x87, 0x6d, 0x13, 0x91, 0x5f, 0x78, 0xb3, 0xa7, 0x9f, 0x59, 0x59, 0xd9, 0xfd, 0x61, 0x97, 0x48,
-	0xdd, 0xa4, 0x3f, 0xb4, 0xde, 0xc9, 0xfe, 0xf9, 0x4c, 0xea, 0x0e, 0x52, 0xa9, 0x9b, 0x46, 0xab,
-	0xd4, 0x4d, 0xda, 0x5d, 0xb9, 0xff, 0xf7, 0xe5, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0x18,
-	0x4a, 0x38, 0x0e, 0x03, 0x00, 0x00,
+	// 498 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8d, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xc5, 0x76, 0x52, 0xa9, 0xd3, 0xba, 0x4a, 0x57, 0xa8, 0xb8, 0x2d, 0x94, 0xca, 0x42, 0x28,
+	0x8a, 0x84, 0xd3, 0x96, 0x23, 0x5c, 0x1a, 0x28, 0x1f, 0x12, 0x07, 0xb4, 0x8d, 0x38, 0x70, 0x5b,
+	0xdb, 0x23, 0xc7, 0x6a, 0xbc, 0xeb, 0xda, 0x1b, 0x24, 0xf8, 0x2b, 0x48, 0xfd, 0x0f, 0xfd, 0x75,
+	0x5c, 0xd9, 0xac, 0x23, 0xc7, 0x1b, 0x1b, 0xd1, 0x8b, 0x0f, 0xef, 0xbd, 0xdd, 0x79, 0xf3, 0x66,
+	0xd6, 0x70, 0x98, 0x89, 0x18, 0xe7, 0x63, 0x16, 0x45, 0x62, 0xc1, 0xe5, 0x17, 0x8c, 0x13, 0x2c,
+	0x82, 0xbc, 0x10, 0x52, 0x90, 0xbe, 0xa6, 0x8e, 0xf6, 0x2b, 0x05, 0xfe, 0x40, 0x2e, 0x2b, 0xe6,
+	0xe8, 0xa0, 0x82, 0x72, 0x96, 0xa4, 0x9c, 0xc9, 0x54, 0xf0, 0x0a, 0xf7, 0xff, 0x58, 0xe0, 0x5e,
+	0x36, 0x6f, 0x22, 0x2f, 0x61, 0x6f, 0x05, 0x5c, 0xc6, 0x71, 0x81, 0x65, 0xe9, 0x59, 0xa7, 0xd6,
+	0x70, 0x97, 0x6e, 0xa0, 0xe4, 0x05, 0xb8, 0x13, 0x36, 0x67, 0x3c, 0xc2, 0x77, 0x33, 0xc6, 0x13,
+	0xf4, 0x6c, 0x25, 0x73, 0xa8, 0x09, 0x92, 0x53, 0xd8, 0x99, 0xcc, 0x45, 0x74, 0xf3, 0x09, 0xd3,
+	0x64, 0x26, 0x3d, 0x47, 0x69, 0x5c, 0xda, 0x84, 0xc8, 0x10, 0xdc, 0x69, 0xc1, 0x78, 0xc9, 0xa2,
+	0xa5, 0xad, 0xcf, 0xef, 0xbd, 0xde, 0xf2, 0x9e, 0x89, 0x7d, 0x66, 0x51, 0x93, 0x20, 0x4f, 0x61,
+	0x7b, 0x9a, 0x66, 0x58, 0x4a, 0x96, 0xe5, 0x5e, 0x5f, 0xa9, 0x7a, 0x74, 0x0d, 0x90, 0x00, 0xb6,
+	0xaf, 0x96, 0x0d, 0x4f, 0x7f, 0xe6, 0xe8, 0x6d, 0x29, 0x76, 0xef, 0x62, 0x10, 0xe8, 0xae, 0x83,
+	0x1a, 0xa7, 0x6b, 0x89, 0xff, 0xdb, 0x06, 0xef, 0x23, 0x4a, 0xa3, 0xf9, 0x92, 0xe2, 0xed, 0x42,
+	0xdd, 0xf7, 0xe0, 0x10, 0x8c, 0xa2, 0xf6, 0x7f, 0x8b, 0xb6, 0x9b, 0x75, 0xfe, 0xd5, 0xac, 0x72,
+	0x50, 0xf7, 0x76, 0x2d, 0x59, 0x21, 0x75, 0x2e, 0x3d, 0xba, 0x81, 0x12, 0x1f, 0x76, 0x6b, 0xe4,
+	0x8a, 0xc7, 0x3a, 0x17, 0x97, 0x1a, 0x18, 0x39, 0x07, 0xf8, 0x5a, 0x0f, 0x5e, 0x67, 0xb3, 0x73,
+	0xb1, 0xbf, 0xb2, 0xb9, 0x26, 0x68, 0x43, 0xe4, 0x97, 0x70, 0xd8, 0x11, 0x4e, 0x99, 0x0b, 0x5e,
+	0x22, 0xf1, 0xa0, 0x3f, 0x15, 0x92, 0xcd, 0x75, 0x28, 0x3d, 0xed, 0xbe, 0x02, 0xc8, 0xdb, 0x3a,
+	0xb7, 0xd5, 0x19, 0x15, 0x8a, 0xa3, 0xaa, 0x3d, 0x5e, 0x55, 0x33, 0x48, 0xba, 0xa1, 0xf5, 0xef,
+	0x2c, 0x38, 0xb9, 0x5e, 0x84, 0x65, 0x54, 0xa4, 0x21, 0x76, 0x0f, 0x66, 0x04, 0x03, 0x73, 0x04,
+	0xb8, 0x1c, 0x8d, 0xa3, 0x46, 0xd3, 0xc2, 0xc9, 0x19, 0x40, 0x9d, 0x7c, 0x65, 0xa4, 0x6b, 0x3a,
+	0x0d, 0x0d, 0x39, 0x01, 0xf8, 0x50, 0x88, 0xcc, 0x58, 0xd6, 0x06, 0xe2, 0xdf, 0x5b, 0x40, 0x0c,
+	0x5f, 0xfa, 0x2c, 0x79, 0xd3, 0xdc, 0x02, 0x4b, 0x6f, 0xc1, 0xb3, 0xae, 0x86, 0x3b, 0x57, 0x62,
+	0xe3, 0x85, 0xd8, 0xed, 0x17, 0xd2, 0x0e, 0xd5, 0x79, 0x78, 0xa8, 0xa3, 0x6f, 0x70, 0xd0, 0x6d,
+	0x82, 0x1c, 0xc3, 0x93, 0x36, 0xa3, 0xe2, 0xc3, 0x78, 0xf0, 0x88, 0x3c, 0x87, 0xe3, 0x36, 0x49,
+	0x51, 0x16, 0x6a, 0x43, 0x95, 0xc0, 0x9a, 0x8c, 0xbe, 0x0f, 0x93, 0x54, 0xce, 0x16, 0x61, 0x10,
+	0x89, 0x6c, 0xfc, 0x4b, 0x88, 0x30, 0xaa, 0xbe, 0xaf, 0x22, 0x51, 0xe0, 0x58, 0x81, 0x99, 0xe0,
+	0x63, 0xed, 0x30, 0xdc, 0xd2, 0x3f, 0x9b, 0xd7, 0x7f, 0x01, 0x61, 0x97, 0x4c, 0x72, 0xbb, 0x04,
+	0x00, 0x00,
 }
@@ -77,6 +77,7 @@ const (
 	AccountType_EmptyAccountType      AccountType = 2
 	AccountType_EstoniaEidAccountType AccountType = 3
 	AccountType_ETHAccountType        AccountType = 4
+	AccountType_WebAuthnAccountType   AccountType = 5
 )
 
 var AccountType_name = map[int32]string{
@@ -85,6 +86,7 @@ var AccountType_name = map[int32]string{
 	2: "EmptyAccountType",
 	3: "EstoniaEidAccountType",
 	4: "ETHAccountType",
+	5: "WebAuthnAccountType",
 }
 
 var AccountType_value = map[string]int32{
@@ -93,6 +95,7 @@ var AccountType_value = map[string]int32{
 	"EmptyAccountType":      2,
 	"EstoniaEidAccountType": 3,
 	"ETHAccountType":        4,
+	"WebAuthnAccountType":   5,
 }
 
 func (x AccountType) String() string {
@@ -178,20 +181,21 @@ func init() {
 }
 
 var fileDescriptor_7d04e165ba99c2b5 = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0xd0, 0xcf, 0x4a, 0xc4, 0x30,
-	0x10, 0x06, 0x70, 0xb3, 0x7f, 0x04, 0xa3, 0x2c, 0x25, 0x28, 0xd6, 0x5b, 0xf1, 0x20, 0xa5, 0xe0,
-	0xe6, 0xe0, 0x13, 0x74, 0x25, 0x20, 0x78, 0x91, 0xd2, 0xd3, 0xde, 0x36, 0x93, 0xa0, 0x81, 0x4d,
-	0xa6, 0xb4, 0xe9, 0xa1, 0xfb, 0x50, 0x3e, 0xa3, 0x34, 0x5b, 0x30, 0xd6, 0x4b, 0x20, 0xbf, 0x6f,
-	0xf8, 0x06, 0x86, 0xde, 0x5b, 0x54, 0xfa, 0xc8, 0x0f, 0x00, 0xd8, 0x3b, 0x5f, 0x0f, 0x8d, 0xde,
-	0x36, 0x2d, 0x7a, 0x64, 0xeb, 0x10, 0x3c, 0x7e, 0x13, 0xba, 0x29, 0xcf, 0x61, 0xa9, 0x54, 0xab,
-	0xbb, 0x8e, 0x3d, 0xcd, 0x25, 0x25, 0x19, 0xc9, 0x6f, 0xaa, 0xf9, 0x5c, 0x46, 0xaf, 0xcb, 0xdf,
-	0xda, 0x74, 0x91, 0x91, 0x7c, 0x5d, 0xc5, 0xc4, 0x0a, 0x9a, 0x4c, 0xdf, 0x8f, 0x5e, 0x1e, 0x0d,
-	0xbc, 0xeb, 0x21, 0x5d, 0x86, 0xae, 0x7f, 0x3e, 0x6e, 0x15, 0x0e, 0x50, 0x69, 0x35, 0x45, 0xe9,
-	0x2a, 0x23, 0xf9, 0x55, 0x35, 0xd3, 0xe2, 0xf4, 0x67, 0x2b, 0x63, 0x74, 0xb3, 0x97, 0x10, 0x49,
-	0x72, 0x31, 0xda, 0xae, 0x7e, 0x8d, 0x8d, 0xb0, 0x5b, 0x9a, 0x08, 0xdb, 0xf8, 0x21, 0xd6, 0x05,
-	0x7b, 0xa0, 0x77, 0xa2, 0xf3, 0xe8, 0xcc, 0x41, 0x18, 0x15, 0x47, 0xcb, 0xb1, 0x44, 0xd4, 0x6f,
-	0xb1, 0xad, 0x76, 0xc5, 0x3e, 0xff, 0x34, 0xfe, 0xab, 0x97, 0x5b, 0x40, 0xcb, 0x4f, 0x88, 0x12,
-	0xce, 0xef, 0x33, 0x60, 0xab, 0x39, 0xa0, 0xb5, 0xe8, 0x78, 0x38, 0xac, 0xbc, 0x0c, 0x67, 0x7e,
-	0xf9, 0x09, 0x00, 0x00, 0xff, 0xff, 0x55, 0x02, 0x51, 0xae, 0x81, 0x01, 0x00, 0x00,
+	// 248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe3, 0x12, 0xcf, 0xcd, 0x4f, 0x49,
+	0xcd, 0xd1, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x09, 0xa9, 0x2c, 0x48, 0xd5, 0x2b, 0x28,
+	0xca, 0x2f, 0xc9, 0x17, 0x62, 0x05, 0x4b, 0x28, 0xad, 0x63, 0xe4, 0xe2, 0x73, 0x84, 0x48, 0x3a,
+	0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x0b, 0xa9, 0xa1, 0x8b, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0xf0,
+	0x04, 0xa1, 0xab, 0x53, 0xe0, 0xe2, 0x76, 0x44, 0x18, 0x2b, 0xc1, 0x04, 0x54, 0xc4, 0x1a, 0x84,
+	0x2c, 0x24, 0xa4, 0xc5, 0x25, 0x00, 0xe5, 0x06, 0x94, 0x26, 0xe5, 0x64, 0x26, 0x7b, 0xa7, 0x56,
+	0x4a, 0x30, 0x83, 0xcd, 0xc2, 0x10, 0x07, 0xd9, 0xea, 0x9a, 0x97, 0x0c, 0x74, 0x53, 0x0a, 0x54,
+	0x4a, 0x82, 0x05, 0xa8, 0x92, 0x33, 0x08, 0x4d, 0x54, 0x6b, 0x32, 0x23, 0x8a, 0xb5, 0x42, 0x42,
+	0x5c, 0x7c, 0x51, 0x49, 0xc9, 0x48, 0x22, 0x02, 0x0c, 0x20, 0x31, 0xa7, 0x10, 0x67, 0x64, 0x31,
+	0x46, 0x21, 0x11, 0x2e, 0x01, 0xd7, 0xdc, 0x82, 0x92, 0x4a, 0x64, 0x51, 0x26, 0x21, 0x49, 0x2e,
+	0x51, 0xd7, 0xe2, 0x92, 0xfc, 0xbc, 0xcc, 0x44, 0xd7, 0xcc, 0x14, 0x64, 0x29, 0x66, 0x90, 0x21,
+	0xae, 0x21, 0x1e, 0xc8, 0x62, 0x2c, 0x42, 0xe2, 0x5c, 0xc2, 0xe1, 0xa9, 0x49, 0x8e, 0xa5, 0x25,
+	0x19, 0x79, 0xc8, 0x12, 0xac, 0x4e, 0x5a, 0x51, 0x1a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
+	0xc9, 0xf9, 0xb9, 0xfa, 0x55, 0xf9, 0xf9, 0x49, 0xc9, 0x10, 0x52, 0x37, 0x39, 0xbf, 0x28, 0x55,
+	0x1f, 0x28, 0x98, 0x9b, 0x9f, 0xa7, 0x0f, 0x0e, 0xf2, 0x24, 0x36, 0x70, 0x04, 0x18, 0x03, 0x00,
+	0xca, 0xa5, 0xac, 0x06, 0x9b, 0x01, 0x00, 0x00,
 }
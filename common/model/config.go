@@ -65,6 +65,10 @@ type (
 		NodeKeyFileName, SnapshotPath string
 		AntiSpamFilter                                      bool
 		AntiSpamP2PRequestLimit, AntiSpamCPULimitPercentage int
+		// DetachedMultisigBroadcast let the node broadcast the multisig transactions completed by detached signatures,
+		// signed and paid by the DetachedMultisigFeePayerSeed account
+		DetachedMultisigBroadcast    bool
+		DetachedMultisigFeePayerSeed string
 
 		// validation fields
 		ConfigFileExist bool
@@ -136,14 +136,24 @@ type Escrow struct {
 	Amount           int64  `protobuf:"varint,5,opt,name=Amount,proto3" json:"Amount,omitempty"`
 	Commission       int64  `protobuf:"varint,6,opt,name=Commission,proto3" json:"Commission,omitempty"`
 	// Timeout is BlockHeight gap
-	Timeout              int64        `protobuf:"varint,7,opt,name=Timeout,proto3" json:"Timeout,omitempty"`
-	Status               EscrowStatus `protobuf:"varint,8,opt,name=Status,proto3,enum=model.EscrowStatus" json:"Status,omitempty"`
-	BlockHeight          uint32       `protobuf:"varint,9,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
-	Latest               bool         `protobuf:"varint,10,opt,name=Latest,proto3" json:"Latest,omitempty"`
-	Instruction          string       `protobuf:"bytes,11,opt,name=Instruction,proto3" json:"Instruction,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Timeout     int64        `protobuf:"varint,7,opt,name=Timeout,proto3" json:"Timeout,omitempty"`
+	Status      EscrowStatus `protobuf:"varint,8,opt,name=Status,proto3,enum=model.EscrowStatus" json:"Status,omitempty"`
+	BlockHeight uint32       `protobuf:"varint,9,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	Latest      bool         `protobuf:"varint,10,opt,name=Latest,proto3" json:"Latest,omitempty"`
+	Instruction string       `protobuf:"bytes,11,opt,name=Instruction,proto3" json:"Instruction,omitempty"`
+	// ApproverAddresses every approver of a multi approver escrow, the first one being ApproverAddress
+	ApproverAddresses [][]byte `protobuf:"bytes,12,rep,name=ApproverAddresses,proto3" json:"ApproverAddresses,omitempty"`
+	// MinimumApprovals number of approvers votes required to approve a multi approver escrow
+	MinimumApprovals uint32 `protobuf:"varint,13,opt,name=MinimumApprovals,proto3" json:"MinimumApprovals,omitempty"`
+	// Approvals approvers that voted to approve a multi approver escrow
+	Approvals [][]byte `protobuf:"bytes,14,rep,name=Approvals,proto3" json:"Approvals,omitempty"`
+	// Rejections approvers that voted to reject a multi approver escrow
+	Rejections [][]byte `protobuf:"bytes,15,rep,name=Rejections,proto3" json:"Rejections,omitempty"`
+	// ReleasedAmount part of the amount already released to the recipient by partial approvals
+	ReleasedAmount       int64    `protobuf:"varint,16,opt,name=ReleasedAmount,proto3" json:"ReleasedAmount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Escrow) Reset()         { *m = Escrow{} }
@@ -248,6 +258,41 @@ func (m *Escrow) GetInstruction() string {
 	return ""
 }
 
+func (m *Escrow) GetApproverAddresses() [][]byte {
+	if m != nil {
+		return m.ApproverAddresses
+	}
+	return nil
+}
+
+func (m *Escrow) GetMinimumApprovals() uint32 {
+	if m != nil {
+		return m.MinimumApprovals
+	}
+	return 0
+}
+
+func (m *Escrow) GetApprovals() [][]byte {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *Escrow) GetRejections() [][]byte {
+	if m != nil {
+		return m.Rejections
+	}
+	return nil
+}
+
+func (m *Escrow) GetReleasedAmount() int64 {
+	if m != nil {
+		return m.ReleasedAmount
+	}
+	return 0
+}
+
 // GetEscrowTransactionsRequest message for get escrow transactions
 type GetEscrowTransactionsRequest struct {
 	ApproverAddress      []byte         `protobuf:"bytes,1,opt,name=ApproverAddress,proto3" json:"ApproverAddress,omitempty"`
@@ -456,39 +501,42 @@ func init() {
 }
 
 var fileDescriptor_c4ffdfca00fa52ba = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x5f, 0x6f, 0xd3, 0x3c,
-	0x14, 0xc6, 0xe7, 0xb4, 0x4d, 0xbb, 0xd3, 0x3f, 0x6f, 0x5e, 0x23, 0x4d, 0xd6, 0x18, 0x52, 0x54,
-	0x21, 0x88, 0x8a, 0x68, 0x59, 0x11, 0x1f, 0xa0, 0xa5, 0x15, 0x54, 0xe2, 0x62, 0x72, 0x7b, 0xc5,
-	0x5d, 0x9a, 0x58, 0x9d, 0xa1, 0xb1, 0x43, 0xec, 0x00, 0xe2, 0xc3, 0x03, 0x8a, 0x9d, 0x66, 0xe9,
-	0xd6, 0xdd, 0x71, 0x53, 0xd5, 0xcf, 0xf3, 0x1c, 0xdb, 0xf9, 0x9d, 0x93, 0x00, 0x4e, 0x64, 0xcc,
-	0xf6, 0x13, 0xa6, 0xa2, 0x4c, 0xfe, 0x18, 0xa7, 0x99, 0xd4, 0x12, 0xb7, 0x8c, 0x76, 0x79, 0x61,
-	0xad, 0x34, 0xdc, 0x71, 0x11, 0x6a, 0x2e, 0x85, 0xb5, 0x87, 0x7f, 0x1c, 0x70, 0x97, 0x26, 0x8f,
-	0x31, 0x38, 0xab, 0x05, 0x41, 0x3e, 0x0a, 0x1a, 0x73, 0xe7, 0x0d, 0xa2, 0xce, 0x6a, 0x81, 0x9f,
-	0x43, 0x7f, 0xcd, 0x44, 0xcc, 0xb2, 0x59, 0x1c, 0x67, 0x4c, 0x29, 0xe2, 0xf8, 0x28, 0xe8, 0xd1,
-	0x63, 0x11, 0x8f, 0xc0, 0xa3, 0x2c, 0xe2, 0x29, 0x67, 0x42, 0x1f, 0x82, 0x0d, 0x13, 0x7c, 0xa0,
-	0xe3, 0x00, 0xfe, 0x9b, 0xa5, 0x69, 0x26, 0xbf, 0xdf, 0xed, 0xd9, 0x34, 0xd1, 0xfb, 0x32, 0xbe,
-	0x04, 0x77, 0x96, 0xc8, 0x5c, 0x68, 0xd2, 0xaa, 0xee, 0x54, 0x2a, 0x78, 0x08, 0xf0, 0x5e, 0x26,
-	0x09, 0x57, 0x8a, 0x4b, 0x41, 0xdc, 0xca, 0xaf, 0xa9, 0xf8, 0x0a, 0xda, 0x1b, 0x9e, 0x30, 0x99,
-	0x6b, 0xd2, 0xae, 0x02, 0x07, 0x09, 0xbf, 0x02, 0x77, 0xad, 0x43, 0x9d, 0x2b, 0xd2, 0xf1, 0x51,
-	0x30, 0x98, 0x3e, 0x19, 0x1b, 0x42, 0x63, 0x0b, 0xc3, 0x5a, 0xb4, 0x8c, 0x60, 0x1f, 0xba, 0xf3,
-	0xbd, 0x8c, 0xbe, 0x7e, 0x64, 0x7c, 0x77, 0xab, 0xc9, 0xb9, 0x8f, 0x82, 0x3e, 0xad, 0x4b, 0xf8,
-	0x02, 0xdc, 0x4f, 0xa1, 0x66, 0x4a, 0x13, 0xf0, 0x51, 0xd0, 0xa1, 0xe5, 0xaa, 0xa8, 0x5c, 0x09,
-	0xa5, 0xb3, 0x3c, 0x2a, 0xa0, 0x93, 0xae, 0x8f, 0x82, 0x73, 0x5a, 0x97, 0x86, 0xbf, 0x1d, 0xb8,
-	0xfa, 0xc0, 0xb4, 0x3d, 0x77, 0x93, 0x85, 0x42, 0x85, 0xc6, 0x50, 0x94, 0x7d, 0xcb, 0x8b, 0x2d,
-	0x4e, 0x10, 0x43, 0xa7, 0x89, 0xfd, 0xfb, 0x6e, 0xd9, 0x99, 0x68, 0x1e, 0xcd, 0xc4, 0x04, 0x3a,
-	0x16, 0x0b, 0x53, 0xa4, 0xe5, 0x37, 0x1e, 0x63, 0x57, 0x85, 0x8a, 0x03, 0x6b, 0xa8, 0xd6, 0x3a,
-	0xcc, 0xb4, 0x69, 0x59, 0x9f, 0x3e, 0xd0, 0xf1, 0x0b, 0x18, 0xd4, 0xb4, 0xa5, 0x88, 0x4d, 0xef,
-	0xfa, 0xf4, 0x9e, 0x5a, 0xe3, 0xdd, 0x39, 0xe2, 0x7d, 0x0d, 0x70, 0x53, 0xcd, 0xb8, 0x69, 0x54,
-	0x77, 0xfa, 0x7f, 0x79, 0xbd, 0x3b, 0x83, 0xd6, 0x42, 0xc3, 0x2d, 0x3c, 0x7b, 0x84, 0xbf, 0x4a,
-	0xa5, 0x50, 0x0c, 0x13, 0x68, 0x6d, 0xa4, 0x0e, 0xf7, 0x06, 0x7b, 0xd3, 0x70, 0xb0, 0x02, 0x7e,
-	0x09, 0x6d, 0x5b, 0x57, 0xa0, 0x6e, 0x04, 0xdd, 0x69, 0xff, 0x88, 0x04, 0x3d, 0xb8, 0xc3, 0x6b,
-	0x78, 0x7a, 0xea, 0x8c, 0x43, 0x8b, 0x4f, 0xbc, 0x7a, 0xa3, 0x05, 0xf4, 0xea, 0x3c, 0x71, 0x17,
-	0xda, 0x37, 0x4c, 0xc4, 0x5c, 0xec, 0xbc, 0x33, 0xdc, 0x83, 0x4e, 0xd9, 0xfc, 0xd8, 0x43, 0xc5,
-	0x8a, 0xb2, 0x2f, 0x2c, 0xd2, 0x2c, 0xf6, 0x9c, 0x22, 0xb8, 0xfc, 0x99, 0xf2, 0x8c, 0xc5, 0x5e,
-	0x63, 0xf4, 0x0e, 0x06, 0x76, 0x17, 0x1b, 0x0f, 0xf7, 0x85, 0x5d, 0x96, 0x7a, 0x67, 0x18, 0xc0,
-	0xb5, 0x95, 0x1e, 0x2a, 0xfe, 0xdb, 0x3a, 0xcf, 0x99, 0x8f, 0x3e, 0x07, 0x3b, 0xae, 0x6f, 0xf3,
-	0xed, 0x38, 0x92, 0xc9, 0xe4, 0x97, 0x94, 0xdb, 0xc8, 0xfe, 0xbe, 0x8e, 0x64, 0xc6, 0x26, 0x91,
-	0x4c, 0x12, 0x29, 0x26, 0xe6, 0x59, 0xb7, 0xae, 0xf9, 0x92, 0xbc, 0xfd, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0xfe, 0x1c, 0x94, 0xf7, 0x7e, 0x04, 0x00, 0x00,
+	// 591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x54, 0xdb, 0x6e, 0xd3, 0x40,
+	0x10, 0x25, 0x37, 0x27, 0x99, 0x5c, 0x9a, 0x2e, 0x52, 0xb5, 0x2a, 0x05, 0x45, 0x11, 0xa2, 0x51,
+	0x80, 0x84, 0x16, 0xf1, 0x01, 0x09, 0x8d, 0xa0, 0x12, 0x48, 0xd5, 0xb6, 0x4f, 0xbc, 0x39, 0xf6,
+	0x2a, 0x5d, 0x88, 0xbd, 0xc6, 0xbb, 0x06, 0xc4, 0xef, 0xf1, 0x5f, 0x88, 0xf1, 0xae, 0xe3, 0x38,
+	0x97, 0xbe, 0xf1, 0xe2, 0x78, 0xce, 0x99, 0xcb, 0xee, 0x99, 0x13, 0x03, 0x09, 0xa4, 0xcf, 0x57,
+	0x13, 0xae, 0xbc, 0x58, 0xfe, 0x1c, 0x47, 0xb1, 0xd4, 0x92, 0xd4, 0x0c, 0x76, 0x7a, 0x62, 0xa9,
+	0xc8, 0x5d, 0x8a, 0xd0, 0xd5, 0x42, 0x86, 0x96, 0x1e, 0xfc, 0xa9, 0x82, 0x33, 0x37, 0xf9, 0x84,
+	0x40, 0xf9, 0xfa, 0x8a, 0x96, 0xfa, 0xa5, 0x61, 0x65, 0x56, 0x7e, 0x53, 0x62, 0x18, 0x91, 0xe7,
+	0xd0, 0xb9, 0xe5, 0xa1, 0xcf, 0xe3, 0xa9, 0xef, 0xc7, 0x5c, 0x29, 0x5a, 0x46, 0xba, 0xcd, 0xb6,
+	0x41, 0x32, 0x82, 0x1e, 0xe3, 0x9e, 0x88, 0x04, 0x0f, 0xf5, 0x3a, 0xb1, 0x62, 0x12, 0xf7, 0x70,
+	0x32, 0x84, 0xa3, 0x69, 0x84, 0xb3, 0x7f, 0x6c, 0x7a, 0x56, 0x4d, 0xea, 0x2e, 0x4c, 0x4e, 0xc1,
+	0x99, 0x06, 0x32, 0x09, 0x35, 0xad, 0xe5, 0x67, 0xca, 0x10, 0x32, 0x00, 0x78, 0x2f, 0x83, 0x40,
+	0x28, 0x85, 0x57, 0xa1, 0x4e, 0xce, 0x17, 0x50, 0x72, 0x06, 0xf5, 0x3b, 0x11, 0x70, 0x99, 0x68,
+	0x5a, 0xcf, 0x13, 0xd6, 0x10, 0x79, 0x09, 0xce, 0xad, 0x76, 0x75, 0xa2, 0x68, 0x03, 0xc9, 0xee,
+	0xe5, 0xe3, 0xb1, 0x51, 0x68, 0x6c, 0xc5, 0xb0, 0x14, 0xcb, 0x52, 0x48, 0x1f, 0x5a, 0xb3, 0x95,
+	0xf4, 0xbe, 0x7d, 0xe4, 0x62, 0x79, 0xaf, 0x69, 0x13, 0x2b, 0x3a, 0xac, 0x08, 0x91, 0x13, 0x70,
+	0x3e, 0xb9, 0x9a, 0x2b, 0x4d, 0x01, 0xc9, 0x06, 0xcb, 0xa2, 0xb4, 0xf2, 0x3a, 0x54, 0x3a, 0x4e,
+	0xbc, 0x54, 0x74, 0xda, 0x42, 0xb2, 0xc9, 0x8a, 0x10, 0x79, 0x05, 0xc7, 0x3b, 0x37, 0xe7, 0x8a,
+	0xb6, 0xfb, 0x15, 0x94, 0x64, 0x9f, 0x48, 0xa5, 0xfe, 0x2c, 0x42, 0x11, 0x24, 0x81, 0xe5, 0xdc,
+	0x95, 0xa2, 0x1d, 0x73, 0x9c, 0x3d, 0x1c, 0x05, 0x68, 0x6e, 0x92, 0xba, 0xa6, 0xe3, 0x06, 0x20,
+	0xcf, 0x00, 0x18, 0xff, 0xca, 0xcd, 0x21, 0x14, 0x3d, 0x32, 0x74, 0x01, 0xc1, 0x49, 0x5d, 0xc6,
+	0x57, 0xdc, 0x55, 0xdc, 0xcf, 0xd6, 0xd0, 0xcb, 0x55, 0xdc, 0x61, 0x06, 0x7f, 0xcb, 0x70, 0xf6,
+	0x81, 0x6b, 0xab, 0xdd, 0x5d, 0xec, 0x86, 0xca, 0xb5, 0x5d, 0x18, 0xff, 0x9e, 0xa4, 0x32, 0x1c,
+	0xd8, 0x7a, 0xe9, 0xf0, 0xd6, 0xff, 0xbf, 0xe3, 0xac, 0xaf, 0xab, 0x5b, 0xbe, 0x9e, 0x40, 0xc3,
+	0xae, 0x16, 0xb5, 0xae, 0xe1, 0xd5, 0x1f, 0xd8, 0x7f, 0x9e, 0x94, 0x0e, 0x2c, 0xac, 0x1b, 0xe1,
+	0x58, 0x1b, 0xdb, 0xa1, 0xee, 0xbb, 0x38, 0x79, 0x01, 0xdd, 0x02, 0x36, 0x0f, 0x7d, 0xe3, 0xbf,
+	0x0e, 0xdb, 0x41, 0x0b, 0x9e, 0x69, 0x6c, 0x79, 0xe6, 0x02, 0xe0, 0x26, 0xff, 0x9f, 0x1a, 0xb3,
+	0xb5, 0x2e, 0x8f, 0xb3, 0xe3, 0x6d, 0x08, 0x56, 0x48, 0x1a, 0x2c, 0xe0, 0xe9, 0x03, 0xfa, 0xab,
+	0x08, 0x7f, 0x38, 0xa1, 0x50, 0xbb, 0x93, 0xda, 0x5d, 0x19, 0xd9, 0xab, 0x46, 0x07, 0x0b, 0x90,
+	0x73, 0xa8, 0xdb, 0xba, 0x54, 0xea, 0x0a, 0x8e, 0xea, 0x6c, 0x29, 0xc1, 0xd6, 0xec, 0xe0, 0x02,
+	0x9e, 0x1c, 0x9a, 0xb1, 0x5e, 0xf1, 0x81, 0xcf, 0xc7, 0xe8, 0x0a, 0xda, 0x45, 0x3d, 0x49, 0x0b,
+	0xea, 0x37, 0xb8, 0x47, 0x11, 0x2e, 0x7b, 0x8f, 0x48, 0x1b, 0x1a, 0xd9, 0xf2, 0xfd, 0x5e, 0x29,
+	0x8d, 0xac, 0xf9, 0x30, 0x2a, 0xa7, 0x89, 0xf3, 0x5f, 0x91, 0x88, 0x31, 0xa8, 0x8c, 0xde, 0x41,
+	0xd7, 0x76, 0x59, 0x9b, 0x37, 0xa5, 0xb3, 0x52, 0xec, 0x03, 0xe0, 0xd8, 0x4a, 0xec, 0x82, 0xef,
+	0xb6, 0xae, 0x57, 0x9e, 0x8d, 0xbe, 0x0c, 0x97, 0x42, 0xdf, 0x27, 0x8b, 0xb1, 0x27, 0x83, 0xc9,
+	0x6f, 0x29, 0x17, 0x9e, 0x7d, 0xbe, 0xf6, 0x64, 0xcc, 0x27, 0x08, 0x06, 0x32, 0x9c, 0x98, 0xbb,
+	0x2e, 0x1c, 0xf3, 0x35, 0x7c, 0xfb, 0x0f, 0x00, 0x53, 0x87, 0x99, 0x42, 0x05, 0x00, 0x00,
 }
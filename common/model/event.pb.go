@@ -72,23 +72,33 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type EventType int32
 
 const (
-	EventType_EventAny                               EventType = 0
-	EventType_EventSendZBCTransaction                EventType = 1
-	EventType_EventNodeRegistrationTransaction       EventType = 2
-	EventType_EventUpdateNodeRegistrationTransaction EventType = 3
-	EventType_EventRemoveNodeRegistrationTransaction EventType = 4
-	EventType_EventClaimNodeRegistrationTransaction  EventType = 5
-	EventType_EventSetupAccountDatasetTransaction    EventType = 6
-	EventType_EventRemoveAccountDatasetTransaction   EventType = 7
-	EventType_EventReward                            EventType = 8
-	EventType_EventApprovalEscrowTransaction         EventType = 9
-	EventType_EventMultiSignatureTransaction         EventType = 10
-	EventType_EventFeeVoteCommitTransaction          EventType = 11
-	EventType_EventFeeVoteRevealTransaction          EventType = 12
-	EventType_EventLiquidPaymentTransaction          EventType = 13
-	EventType_EventLiquidPaymentPaidTransaction      EventType = 14
-	EventType_EventLiquidPaymentStopTransaction      EventType = 15
-	EventType_EventEscrowedTransaction               EventType = 16
+	EventType_EventAny                                     EventType = 0
+	EventType_EventSendZBCTransaction                      EventType = 1
+	EventType_EventNodeRegistrationTransaction             EventType = 2
+	EventType_EventUpdateNodeRegistrationTransaction       EventType = 3
+	EventType_EventRemoveNodeRegistrationTransaction       EventType = 4
+	EventType_EventClaimNodeRegistrationTransaction        EventType = 5
+	EventType_EventSetupAccountDatasetTransaction          EventType = 6
+	EventType_EventRemoveAccountDatasetTransaction         EventType = 7
+	EventType_EventReward                                  EventType = 8
+	EventType_EventApprovalEscrowTransaction               EventType = 9
+	EventType_EventMultiSignatureTransaction               EventType = 10
+	EventType_EventFeeVoteCommitTransaction                EventType = 11
+	EventType_EventFeeVoteRevealTransaction                EventType = 12
+	EventType_EventLiquidPaymentTransaction                EventType = 13
+	EventType_EventLiquidPaymentPaidTransaction            EventType = 14
+	EventType_EventLiquidPaymentStopTransaction            EventType = 15
+	EventType_EventEscrowedTransaction                     EventType = 16
+	EventType_EventTimeLockedSendZBCTransaction            EventType = 17
+	EventType_EventTimeLockedFundReleased                  EventType = 18
+	EventType_EventMultiSendZBCTransaction                 EventType = 19
+	EventType_EventHtlcLockTransaction                     EventType = 20
+	EventType_EventHtlcClaimTransaction                    EventType = 21
+	EventType_EventHtlcRefundTransaction                   EventType = 22
+	EventType_EventLiquidPaymentWithdrawTransaction        EventType = 23
+	EventType_EventMultiSignatureRotationTransaction       EventType = 24
+	EventType_EventMultiSignatureCancelTransaction         EventType = 25
+	EventType_EventRegisterAccountDatasetSchemaTransaction EventType = 26
 )
 
 var EventType_name = map[int32]string{
@@ -109,26 +119,46 @@ var EventType_name = map[int32]string{
 	14: "EventLiquidPaymentPaidTransaction",
 	15: "EventLiquidPaymentStopTransaction",
 	16: "EventEscrowedTransaction",
+	17: "EventTimeLockedSendZBCTransaction",
+	18: "EventTimeLockedFundReleased",
+	19: "EventMultiSendZBCTransaction",
+	20: "EventHtlcLockTransaction",
+	21: "EventHtlcClaimTransaction",
+	22: "EventHtlcRefundTransaction",
+	23: "EventLiquidPaymentWithdrawTransaction",
+	24: "EventMultiSignatureRotationTransaction",
+	25: "EventMultiSignatureCancelTransaction",
+	26: "EventRegisterAccountDatasetSchemaTransaction",
 }
 
 var EventType_value = map[string]int32{
-	"EventAny":                               0,
-	"EventSendZBCTransaction":                1,
-	"EventNodeRegistrationTransaction":       2,
-	"EventUpdateNodeRegistrationTransaction": 3,
-	"EventRemoveNodeRegistrationTransaction": 4,
-	"EventClaimNodeRegistrationTransaction":  5,
-	"EventSetupAccountDatasetTransaction":    6,
-	"EventRemoveAccountDatasetTransaction":   7,
-	"EventReward":                            8,
-	"EventApprovalEscrowTransaction":         9,
-	"EventMultiSignatureTransaction":         10,
-	"EventFeeVoteCommitTransaction":          11,
-	"EventFeeVoteRevealTransaction":          12,
-	"EventLiquidPaymentTransaction":          13,
-	"EventLiquidPaymentPaidTransaction":      14,
-	"EventLiquidPaymentStopTransaction":      15,
-	"EventEscrowedTransaction":               16,
+	"EventAny":                                     0,
+	"EventSendZBCTransaction":                      1,
+	"EventNodeRegistrationTransaction":             2,
+	"EventUpdateNodeRegistrationTransaction":       3,
+	"EventRemoveNodeRegistrationTransaction":       4,
+	"EventClaimNodeRegistrationTransaction":        5,
+	"EventSetupAccountDatasetTransaction":          6,
+	"EventRemoveAccountDatasetTransaction":         7,
+	"EventReward":                                  8,
+	"EventApprovalEscrowTransaction":               9,
+	"EventMultiSignatureTransaction":               10,
+	"EventFeeVoteCommitTransaction":                11,
+	"EventFeeVoteRevealTransaction":                12,
+	"EventLiquidPaymentTransaction":                13,
+	"EventLiquidPaymentPaidTransaction":            14,
+	"EventLiquidPaymentStopTransaction":            15,
+	"EventEscrowedTransaction":                     16,
+	"EventTimeLockedSendZBCTransaction":            17,
+	"EventTimeLockedFundReleased":                  18,
+	"EventMultiSendZBCTransaction":                 19,
+	"EventHtlcLockTransaction":                     20,
+	"EventHtlcClaimTransaction":                    21,
+	"EventHtlcRefundTransaction":                   22,
+	"EventLiquidPaymentWithdrawTransaction":        23,
+	"EventMultiSignatureRotationTransaction":       24,
+	"EventMultiSignatureCancelTransaction":         25,
+	"EventRegisterAccountDatasetSchemaTransaction": 26,
 }
 
 func (x EventType) String() string {
@@ -148,27 +178,35 @@ func init() {
 }
 
 var fileDescriptor_24dabb9f57ff37c9 = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0xd2, 0xcd, 0x4e, 0x02, 0x31,
-	0x10, 0x00, 0x60, 0x7f, 0x00, 0xa1, 0xa0, 0xac, 0xbd, 0x68, 0xe2, 0x4f, 0x44, 0x41, 0x91, 0x44,
-	0xf6, 0xe0, 0x13, 0x00, 0xe2, 0x49, 0x0d, 0x01, 0xf4, 0xc0, 0xad, 0x74, 0x27, 0xd8, 0x64, 0xdb,
-	0x59, 0xbb, 0xdd, 0x25, 0xf8, 0xb4, 0x3e, 0x8a, 0xa1, 0x10, 0x52, 0xfc, 0xe1, 0xb2, 0xc9, 0xcc,
-	0x7c, 0xdb, 0xc9, 0xb4, 0x43, 0x0e, 0x25, 0x06, 0x10, 0xfa, 0x90, 0x82, 0x32, 0xcd, 0x48, 0xa3,
-	0x41, 0x9a, 0xb5, 0xa9, 0xc6, 0x57, 0x86, 0x14, 0xba, 0xf3, 0xf4, 0x70, 0x16, 0x01, 0x2d, 0x91,
-	0xbc, 0x0d, 0x5a, 0x6a, 0xe6, 0x6d, 0xd1, 0x13, 0x72, 0x64, 0xa3, 0x01, 0xa8, 0x60, 0xd4, 0xee,
-	0x0c, 0x35, 0x53, 0x31, 0xe3, 0x46, 0xa0, 0xf2, 0xb6, 0x69, 0x95, 0x5c, 0xd8, 0xe2, 0x0b, 0x06,
-	0xd0, 0x87, 0x89, 0x88, 0x8d, 0x66, 0xf3, 0x92, 0xab, 0x76, 0x68, 0x83, 0x5c, 0x5b, 0xf5, 0x1a,
-	0x05, 0xcc, 0xc0, 0x26, 0xbb, 0xbb, 0xb2, 0x7d, 0x90, 0x98, 0x6e, 0xb4, 0x19, 0x7a, 0x4b, 0x6a,
-	0xd6, 0x76, 0x42, 0x26, 0xe4, 0x26, 0x9a, 0xa5, 0x37, 0xe4, 0x6a, 0x39, 0x85, 0x49, 0xa2, 0x16,
-	0xe7, 0x98, 0x28, 0xf3, 0xc0, 0x0c, 0x8b, 0xc1, 0xb8, 0x30, 0x47, 0xeb, 0xa4, 0xea, 0xf4, 0xff,
-	0x5f, 0xee, 0xd1, 0x32, 0x29, 0x2e, 0xe5, 0x94, 0xe9, 0xc0, 0xcb, 0xd3, 0x4b, 0x72, 0xbe, 0xb8,
-	0xb7, 0x28, 0xd2, 0x98, 0xb2, 0xb0, 0x1b, 0x73, 0x8d, 0x53, 0xf7, 0xa7, 0xc2, 0xca, 0x3c, 0x27,
-	0xa1, 0x11, 0x03, 0x31, 0x51, 0xcc, 0x24, 0x1a, 0x5c, 0x43, 0x68, 0x85, 0x9c, 0x59, 0xf3, 0x08,
-	0xf0, 0x86, 0x06, 0x3a, 0x28, 0xa5, 0x58, 0xeb, 0x5d, 0xfc, 0x49, 0xfa, 0x90, 0x02, 0x0b, 0x5d,
-	0x52, 0x5a, 0x91, 0x27, 0xf1, 0x91, 0x88, 0xa0, 0xc7, 0x66, 0x72, 0xfe, 0xbe, 0x0e, 0xd9, 0xa7,
-	0x35, 0x52, 0xf9, 0x4d, 0x7a, 0x4c, 0x04, 0x2e, 0x3b, 0xf8, 0x9b, 0x0d, 0x0c, 0x46, 0x2e, 0x2b,
-	0xd3, 0x53, 0x72, 0x6c, 0xd9, 0x62, 0x6c, 0x58, 0x3b, 0xc4, 0x6b, 0x37, 0x46, 0xf5, 0x89, 0x30,
-	0xef, 0xc9, 0xb8, 0xc9, 0x51, 0xfa, 0x9f, 0x88, 0x63, 0xbe, 0xf8, 0xde, 0x71, 0xd4, 0xe0, 0x73,
-	0x94, 0x12, 0x95, 0x6f, 0xd7, 0x71, 0x9c, 0xb3, 0xcb, 0x79, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff,
-	0xef, 0x2e, 0x49, 0x30, 0xb1, 0x02, 0x00, 0x00,
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7d, 0x94, 0xdb, 0x6e, 0x13, 0x31,
+	0x10, 0x86, 0x39, 0xf5, 0x34, 0x2d, 0x74, 0x3b, 0x1c, 0x7a, 0x2e, 0x2d, 0xb4, 0x50, 0x22, 0x68,
+	0x90, 0x78, 0x82, 0x36, 0xb4, 0xe2, 0xa2, 0xa0, 0x6a, 0x93, 0x82, 0xd4, 0x3b, 0xc7, 0x1e, 0x52,
+	0x8b, 0xb5, 0xbd, 0x38, 0xde, 0x54, 0xe1, 0x51, 0x79, 0x1a, 0x76, 0x9d, 0xc8, 0xf2, 0x36, 0x25,
+	0x37, 0x96, 0x3c, 0xff, 0xe7, 0x19, 0x7b, 0xe6, 0x97, 0x61, 0x45, 0x19, 0x41, 0x59, 0x93, 0x06,
+	0xa4, 0xdd, 0x51, 0x6e, 0x8d, 0x33, 0x38, 0xe3, 0x43, 0x8d, 0xbf, 0x73, 0xb0, 0x70, 0x5a, 0x85,
+	0x3b, 0xc3, 0x9c, 0x70, 0x09, 0xe6, 0xfd, 0xe6, 0x58, 0x0f, 0x93, 0x7b, 0xb8, 0x09, 0xab, 0x7e,
+	0xd7, 0x26, 0x2d, 0xae, 0x4e, 0x5a, 0x1d, 0xcb, 0x74, 0x9f, 0x71, 0x27, 0x8d, 0x4e, 0xee, 0xe3,
+	0x3e, 0xec, 0x7a, 0xf1, 0x5b, 0x99, 0x26, 0xa5, 0x9e, 0xec, 0x3b, 0xcb, 0x2a, 0x29, 0xa6, 0x1e,
+	0x60, 0x03, 0xde, 0x78, 0xea, 0x32, 0x17, 0xcc, 0xd1, 0x34, 0xf6, 0x61, 0x60, 0x53, 0x52, 0x66,
+	0x30, 0x95, 0x7d, 0x84, 0xef, 0xe0, 0xc0, 0xb3, 0xad, 0x8c, 0x49, 0x35, 0x0d, 0x9d, 0xc1, 0xb7,
+	0xf0, 0x7a, 0xfc, 0x0a, 0x57, 0xe4, 0xc7, 0x9c, 0x9b, 0x42, 0xbb, 0xcf, 0xcc, 0xb1, 0x3e, 0xb9,
+	0x18, 0x9c, 0xc5, 0x43, 0xd8, 0x8f, 0xea, 0xff, 0x9f, 0x9c, 0xc3, 0x65, 0x58, 0x1c, 0x93, 0x37,
+	0xcc, 0x8a, 0x64, 0x1e, 0x5f, 0xc1, 0xce, 0xa8, 0x6f, 0x79, 0xd9, 0xdc, 0x01, 0xcb, 0x4e, 0xfb,
+	0xdc, 0x9a, 0x9b, 0xf8, 0xd0, 0x42, 0x60, 0xbe, 0x16, 0x99, 0x93, 0x6d, 0xd9, 0xd3, 0xcc, 0x15,
+	0x96, 0x62, 0x06, 0x70, 0x0f, 0xb6, 0x3d, 0x73, 0x46, 0xf4, 0xdd, 0x38, 0x6a, 0x19, 0xa5, 0x64,
+	0xad, 0xf6, 0xe2, 0x6d, 0x24, 0x2d, 0x67, 0xca, 0xb2, 0x18, 0x59, 0x0a, 0xc8, 0xb9, 0xfc, 0x5d,
+	0x48, 0x71, 0xc1, 0x86, 0xaa, 0x9a, 0x6f, 0x84, 0x3c, 0xc6, 0x03, 0xd8, 0x9b, 0x44, 0x2e, 0x98,
+	0x14, 0x31, 0xf6, 0xe4, 0x6e, 0xac, 0xed, 0x4c, 0x1e, 0x63, 0xcb, 0xb8, 0x05, 0x6b, 0x1e, 0x1b,
+	0x3d, 0x9b, 0x6a, 0x49, 0x92, 0x90, 0xa4, 0x23, 0x15, 0x9d, 0x1b, 0xfe, 0x8b, 0xc4, 0x1d, 0x86,
+	0x5a, 0xc1, 0x97, 0xb0, 0x79, 0x0b, 0x3b, 0x2b, 0xb4, 0x48, 0x29, 0xa3, 0x72, 0x00, 0x22, 0x41,
+	0xdc, 0x85, 0xad, 0xa8, 0x81, 0x93, 0x29, 0x9e, 0x86, 0x7b, 0x7c, 0x71, 0x19, 0xaf, 0x52, 0xc4,
+	0xea, 0x33, 0xdc, 0x86, 0xf5, 0xa0, 0x7a, 0xdf, 0xc4, 0xf2, 0x73, 0xdc, 0x81, 0x8d, 0x20, 0xa7,
+	0xf4, 0xb3, 0xac, 0x1d, 0xeb, 0x2f, 0x82, 0xe5, 0x6a, 0xbd, 0xf8, 0x21, 0xdd, 0xb5, 0xb0, 0xac,
+	0x36, 0xea, 0xd5, 0xe0, 0xe4, 0xfa, 0xa8, 0x53, 0xe3, 0x26, 0xec, 0xb9, 0x16, 0x5c, 0x57, 0x67,
+	0x5b, 0x4c, 0x73, 0xaa, 0x8d, 0x75, 0x1d, 0x3f, 0xc2, 0xfb, 0xb1, 0xeb, 0x2a, 0xab, 0x93, 0xad,
+	0x3b, 0xb4, 0xcd, 0xaf, 0x49, 0xb1, 0xf8, 0xc4, 0xc6, 0x49, 0xe3, 0xea, 0xb0, 0x57, 0x5e, 0xb0,
+	0xe8, 0x1e, 0x71, 0xa3, 0x9a, 0x7f, 0x8c, 0xe9, 0xf2, 0xd1, 0xfa, 0x81, 0x1b, 0x4b, 0xcd, 0x32,
+	0xa8, 0x8c, 0x6e, 0xfa, 0x8f, 0xa0, 0x3b, 0xeb, 0xbf, 0x85, 0x4f, 0xff, 0x00, 0xbc, 0x6e, 0xff,
+	0x63, 0x2b, 0x04, 0x00, 0x00,
 }
@@ -152,9 +152,257 @@ func (m *FeeScale) GetLatest() bool {
 	return false
 }
 
+// GetFeeVoteStatusRequest a model request for getting the state of the running fee vote
+type GetFeeVoteStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFeeVoteStatusRequest) Reset()         { *m = GetFeeVoteStatusRequest{} }
+func (m *GetFeeVoteStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFeeVoteStatusRequest) ProtoMessage()    {}
+func (*GetFeeVoteStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c5c28c2cd74d057, []int{1}
+}
+
+func (m *GetFeeVoteStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFeeVoteStatusRequest.Unmarshal(m, b)
+}
+func (m *GetFeeVoteStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFeeVoteStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFeeVoteStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeeVoteStatusRequest.Merge(m, src)
+}
+func (m *GetFeeVoteStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFeeVoteStatusRequest.Size(m)
+}
+func (m *GetFeeVoteStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeeVoteStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFeeVoteStatusRequest proto.InternalMessageInfo
+
+// GetFeeVoteStatusResponse state of the fee vote running since the last fee scale adjustment
+type GetFeeVoteStatusResponse struct {
+	Phase FeeVotePhase `protobuf:"varint,1,opt,name=Phase,proto3,enum=model.FeeVotePhase" json:"Phase,omitempty"`
+	// AdjustmentPending the month changed, the next block adjust the fee scale to ProjectedFeeScale
+	AdjustmentPending bool `protobuf:"varint,2,opt,name=AdjustmentPending,proto3" json:"AdjustmentPending,omitempty"`
+	// FromHeight block height of the last fee scale adjustment, start of the vote period
+	FromHeight uint32 `protobuf:"varint,3,opt,name=FromHeight,proto3" json:"FromHeight,omitempty"`
+	// ToHeight last block height, votes are counted up to this height included
+	ToHeight uint32 `protobuf:"varint,4,opt,name=ToHeight,proto3" json:"ToHeight,omitempty"`
+	// PhaseEndTimestamp last second of the current phase, in UTC
+	PhaseEndTimestamp int64     `protobuf:"varint,5,opt,name=PhaseEndTimestamp,proto3" json:"PhaseEndTimestamp,omitempty"`
+	CommitmentsCount  uint32    `protobuf:"varint,6,opt,name=CommitmentsCount,proto3" json:"CommitmentsCount,omitempty"`
+	RevealsCount      uint32    `protobuf:"varint,7,opt,name=RevealsCount,proto3" json:"RevealsCount,omitempty"`
+	CurrentFeeScale   *FeeScale `protobuf:"bytes,8,opt,name=CurrentFeeScale,proto3" json:"CurrentFeeScale,omitempty"`
+	// ProjectedFeeScale fee scale the revealed votes would select if the period ended now
+	ProjectedFeeScale    int64    `protobuf:"varint,9,opt,name=ProjectedFeeScale,proto3" json:"ProjectedFeeScale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFeeVoteStatusResponse) Reset()         { *m = GetFeeVoteStatusResponse{} }
+func (m *GetFeeVoteStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFeeVoteStatusResponse) ProtoMessage()    {}
+func (*GetFeeVoteStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c5c28c2cd74d057, []int{2}
+}
+
+func (m *GetFeeVoteStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFeeVoteStatusResponse.Unmarshal(m, b)
+}
+func (m *GetFeeVoteStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFeeVoteStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GetFeeVoteStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeeVoteStatusResponse.Merge(m, src)
+}
+func (m *GetFeeVoteStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFeeVoteStatusResponse.Size(m)
+}
+func (m *GetFeeVoteStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeeVoteStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFeeVoteStatusResponse proto.InternalMessageInfo
+
+func (m *GetFeeVoteStatusResponse) GetPhase() FeeVotePhase {
+	if m != nil {
+		return m.Phase
+	}
+	return FeeVotePhase_FeeVotePhaseCommmit
+}
+
+func (m *GetFeeVoteStatusResponse) GetAdjustmentPending() bool {
+	if m != nil {
+		return m.AdjustmentPending
+	}
+	return false
+}
+
+func (m *GetFeeVoteStatusResponse) GetFromHeight() uint32 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetFeeVoteStatusResponse) GetToHeight() uint32 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *GetFeeVoteStatusResponse) GetPhaseEndTimestamp() int64 {
+	if m != nil {
+		return m.PhaseEndTimestamp
+	}
+	return 0
+}
+
+func (m *GetFeeVoteStatusResponse) GetCommitmentsCount() uint32 {
+	if m != nil {
+		return m.CommitmentsCount
+	}
+	return 0
+}
+
+func (m *GetFeeVoteStatusResponse) GetRevealsCount() uint32 {
+	if m != nil {
+		return m.RevealsCount
+	}
+	return 0
+}
+
+func (m *GetFeeVoteStatusResponse) GetCurrentFeeScale() *FeeScale {
+	if m != nil {
+		return m.CurrentFeeScale
+	}
+	return nil
+}
+
+func (m *GetFeeVoteStatusResponse) GetProjectedFeeScale() int64 {
+	if m != nil {
+		return m.ProjectedFeeScale
+	}
+	return 0
+}
+
+// GetFeeScalesRequest a model request for getting the history of fee scale adjustments
+type GetFeeScalesRequest struct {
+	FromHeight           uint32      `protobuf:"varint,1,opt,name=FromHeight,proto3" json:"FromHeight,omitempty"`
+	ToHeight             uint32      `protobuf:"varint,2,opt,name=ToHeight,proto3" json:"ToHeight,omitempty"`
+	Pagination           *Pagination `protobuf:"bytes,3,opt,name=Pagination,proto3" json:"Pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetFeeScalesRequest) Reset()         { *m = GetFeeScalesRequest{} }
+func (m *GetFeeScalesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFeeScalesRequest) ProtoMessage()    {}
+func (*GetFeeScalesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c5c28c2cd74d057, []int{3}
+}
+
+func (m *GetFeeScalesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFeeScalesRequest.Unmarshal(m, b)
+}
+func (m *GetFeeScalesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFeeScalesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFeeScalesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeeScalesRequest.Merge(m, src)
+}
+func (m *GetFeeScalesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFeeScalesRequest.Size(m)
+}
+func (m *GetFeeScalesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeeScalesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFeeScalesRequest proto.InternalMessageInfo
+
+func (m *GetFeeScalesRequest) GetFromHeight() uint32 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetFeeScalesRequest) GetToHeight() uint32 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *GetFeeScalesRequest) GetPagination() *Pagination {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type GetFeeScalesResponse struct {
+	Total                uint64      `protobuf:"varint,1,opt,name=Total,proto3" json:"Total,omitempty"`
+	FeeScales            []*FeeScale `protobuf:"bytes,2,rep,name=FeeScales,proto3" json:"FeeScales,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetFeeScalesResponse) Reset()         { *m = GetFeeScalesResponse{} }
+func (m *GetFeeScalesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFeeScalesResponse) ProtoMessage()    {}
+func (*GetFeeScalesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c5c28c2cd74d057, []int{4}
+}
+
+func (m *GetFeeScalesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFeeScalesResponse.Unmarshal(m, b)
+}
+func (m *GetFeeScalesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFeeScalesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetFeeScalesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeeScalesResponse.Merge(m, src)
+}
+func (m *GetFeeScalesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFeeScalesResponse.Size(m)
+}
+func (m *GetFeeScalesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeeScalesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFeeScalesResponse proto.InternalMessageInfo
+
+func (m *GetFeeScalesResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *GetFeeScalesResponse) GetFeeScales() []*FeeScale {
+	if m != nil {
+		return m.FeeScales
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("model.FeeVotePhase", FeeVotePhase_name, FeeVotePhase_value)
 	proto.RegisterType((*FeeScale)(nil), "model.FeeScale")
+	proto.RegisterType((*GetFeeVoteStatusRequest)(nil), "model.GetFeeVoteStatusRequest")
+	proto.RegisterType((*GetFeeVoteStatusResponse)(nil), "model.GetFeeVoteStatusResponse")
+	proto.RegisterType((*GetFeeScalesRequest)(nil), "model.GetFeeScalesRequest")
+	proto.RegisterType((*GetFeeScalesResponse)(nil), "model.GetFeeScalesResponse")
 }
 
 func init() {
@@ -162,19 +410,35 @@ func init() {
 }
 
 var fileDescriptor_6c5c28c2cd74d057 = []byte{
-	// 210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x8f, 0x41, 0x4b, 0x87, 0x40,
-	0x10, 0x47, 0x5b, 0xff, 0xf4, 0x47, 0xb6, 0x02, 0xd9, 0xc2, 0x3c, 0xc5, 0xd2, 0x69, 0x11, 0x72,
-	0x83, 0x3e, 0x41, 0x06, 0xd2, 0xa1, 0x43, 0x6c, 0x50, 0xd0, 0x6d, 0x5d, 0x27, 0xb5, 0x76, 0x9b,
-	0xd0, 0xb1, 0x43, 0x9f, 0x3e, 0x30, 0x41, 0xe9, 0x32, 0xcc, 0xef, 0xbd, 0xd3, 0xe3, 0x67, 0x01,
-	0x1b, 0xf0, 0xfa, 0x0d, 0xe0, 0xc9, 0x59, 0x0f, 0xc5, 0xd7, 0x80, 0x84, 0xe2, 0x70, 0xa6, 0x97,
-	0x0d, 0x8f, 0xab, 0x45, 0x88, 0x8b, 0xf5, 0xcf, 0x98, 0x64, 0x6a, 0x57, 0x46, 0xd7, 0xcc, 0xac,
-	0x5e, 0xf2, 0xa3, 0xd2, 0xa3, 0xfb, 0xb8, 0x87, 0xbe, 0xed, 0x28, 0x8b, 0x24, 0x53, 0x27, 0x66,
-	0x8b, 0x44, 0xca, 0xf7, 0x0f, 0x96, 0x60, 0xa4, 0x6c, 0x27, 0x99, 0x8a, 0xcd, 0xb2, 0xf2, 0x17,
-	0x7e, 0x5c, 0x01, 0x3c, 0x23, 0xc1, 0x63, 0x67, 0x47, 0x10, 0xe7, 0xfc, 0x74, 0xbb, 0xef, 0x30,
-	0x84, 0xd0, 0x53, 0x72, 0x20, 0x52, 0x2e, 0xb6, 0xc2, 0xc0, 0x37, 0x58, 0x9f, 0xb0, 0xff, 0xfc,
-	0xb6, 0x79, 0x9f, 0x46, 0x4a, 0xa2, 0x32, 0x7f, 0x55, 0x6d, 0x4f, 0xdd, 0x54, 0x17, 0x0e, 0x83,
-	0xfe, 0x41, 0xac, 0xdd, 0xdf, 0xbd, 0x72, 0x38, 0x80, 0x76, 0x18, 0x02, 0x7e, 0xea, 0x39, 0xb5,
-	0xde, 0xcf, 0xe1, 0x37, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xab, 0x61, 0x3b, 0x4c, 0x10, 0x01,
-	0x00, 0x00,
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7d, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xc5, 0x71, 0x13, 0xdc, 0x49, 0xa1, 0xc9, 0xa6, 0x6a, 0x4d, 0x0f, 0xa8, 0xf2, 0xa9, 0x44,
+	0x6d, 0x52, 0xc2, 0xa9, 0xc7, 0x26, 0xa2, 0xed, 0x81, 0x43, 0xb4, 0x8d, 0x40, 0xe2, 0x82, 0x36,
+	0xf6, 0x92, 0xb8, 0xd8, 0x3b, 0xc1, 0x5e, 0x73, 0xe0, 0xcc, 0x77, 0xf0, 0xad, 0x6c, 0xc7, 0xc6,
+	0x76, 0x1c, 0xc4, 0xc5, 0xf2, 0xbc, 0xf7, 0x76, 0xfd, 0xe6, 0xcd, 0x18, 0x8e, 0x62, 0x0c, 0x64,
+	0x34, 0xfe, 0x2a, 0xe5, 0x83, 0x2f, 0x22, 0x39, 0xda, 0x24, 0xa8, 0x91, 0xb5, 0x09, 0x3d, 0x3d,
+	0xce, 0xc9, 0x8d, 0x58, 0x85, 0x4a, 0xe8, 0x10, 0x55, 0x4e, 0x7b, 0x01, 0x38, 0xb7, 0xc5, 0x01,
+	0xf6, 0xba, 0x7a, 0x77, 0xad, 0x33, 0xeb, 0xdc, 0x9e, 0xb6, 0xae, 0x2c, 0x5e, 0xf1, 0x67, 0xd0,
+	0x9d, 0x46, 0xe8, 0x7f, 0xbb, 0x97, 0xe1, 0x6a, 0xad, 0xdd, 0x96, 0x91, 0xbc, 0xe0, 0x75, 0x88,
+	0x1d, 0x43, 0xe7, 0x83, 0xd0, 0x32, 0xd5, 0xae, 0x6d, 0x48, 0x87, 0x17, 0x95, 0xf7, 0x0a, 0x4e,
+	0xee, 0xa4, 0x36, 0x17, 0x7d, 0x44, 0x2d, 0x1f, 0xb4, 0xd0, 0x59, 0xca, 0xe5, 0xf7, 0xec, 0x89,
+	0xfa, 0x6d, 0x83, 0xbb, 0xcb, 0xa5, 0x1b, 0x54, 0xa9, 0x64, 0x6f, 0xa0, 0x3d, 0x5f, 0x8b, 0x34,
+	0xb7, 0xf3, 0x72, 0x32, 0x18, 0x51, 0x17, 0xa3, 0x42, 0x4c, 0x14, 0xcf, 0x15, 0xec, 0x02, 0xfa,
+	0x37, 0xc1, 0x63, 0x96, 0xea, 0x58, 0x2a, 0x3d, 0x97, 0x2a, 0x08, 0xd5, 0x8a, 0x2c, 0x3a, 0x7c,
+	0x97, 0x30, 0xad, 0xc2, 0x6d, 0x82, 0x71, 0xd1, 0x89, 0x4d, 0x9d, 0xd4, 0x10, 0x76, 0x0a, 0xce,
+	0x02, 0x0b, 0x76, 0x8f, 0xd8, 0xb2, 0x66, 0x57, 0xd0, 0xa7, 0x4f, 0xbe, 0x57, 0xc1, 0x22, 0x8c,
+	0x4d, 0x0f, 0x22, 0xde, 0xb8, 0xed, 0x32, 0xaf, 0x5d, 0x92, 0x0d, 0xa1, 0x37, 0xc3, 0x38, 0x0e,
+	0xc9, 0x42, 0x3a, 0xc3, 0x4c, 0x69, 0xb7, 0x43, 0xb7, 0xee, 0xe0, 0xcc, 0x83, 0x03, 0x2e, 0x7f,
+	0x48, 0x11, 0x15, 0xba, 0xe7, 0xa4, 0xdb, 0xc2, 0xd8, 0x35, 0x1c, 0xce, 0xb2, 0x24, 0x31, 0x87,
+	0xca, 0x79, 0x39, 0x46, 0xd6, 0x9d, 0x1c, 0x56, 0x01, 0x11, 0xcc, 0x9b, 0x3a, 0x32, 0x9f, 0xe0,
+	0xa3, 0xf4, 0xb5, 0x0c, 0xca, 0xc3, 0xfb, 0x35, 0xf3, 0x4d, 0xd2, 0xfb, 0x65, 0xc1, 0x20, 0x1f,
+	0x10, 0xd5, 0x7f, 0x07, 0xd7, 0x88, 0xd0, 0xfa, 0x6f, 0x84, 0xad, 0x46, 0x84, 0x6f, 0x01, 0xe6,
+	0xe5, 0x26, 0x52, 0xfc, 0xdd, 0x49, 0xbf, 0xf0, 0x5e, 0x11, 0xbc, 0x26, 0xf2, 0xbe, 0xc0, 0xd1,
+	0xb6, 0x8b, 0x62, 0x45, 0x5c, 0x68, 0x2f, 0x50, 0x8b, 0x88, 0x1c, 0xec, 0x51, 0x13, 0x39, 0xc0,
+	0x2e, 0x61, 0xbf, 0x94, 0x1b, 0x07, 0xf6, 0xbf, 0xf2, 0xa9, 0x14, 0xc3, 0x4f, 0x70, 0x50, 0xdf,
+	0x2b, 0x76, 0x02, 0x83, 0x7a, 0xfd, 0x34, 0x28, 0x33, 0xa9, 0xde, 0x33, 0xb3, 0xe4, 0x6c, 0x6b,
+	0x01, 0x69, 0x32, 0x3d, 0xab, 0x89, 0xe7, 0x4b, 0xd7, 0x6b, 0x4d, 0x87, 0x9f, 0xcf, 0x57, 0xa1,
+	0x5e, 0x67, 0xcb, 0x91, 0x8f, 0xf1, 0xf8, 0x27, 0xe2, 0xd2, 0xcf, 0x9f, 0x97, 0x3e, 0x26, 0x72,
+	0x6c, 0xc0, 0x18, 0xd5, 0x98, 0x8c, 0x2d, 0x3b, 0xf4, 0x57, 0xbe, 0xfb, 0x03, 0xc6, 0xaf, 0x7a,
+	0x94, 0xcc, 0x03, 0x00, 0x00,
 }
@@ -95,18 +95,22 @@ func (LiquidPaymentStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type LiquidPayment struct {
-	ID                   int64               `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	SenderAddress        []byte              `protobuf:"bytes,2,opt,name=SenderAddress,proto3" json:"SenderAddress,omitempty"`
-	RecipientAddress     []byte              `protobuf:"bytes,3,opt,name=RecipientAddress,proto3" json:"RecipientAddress,omitempty"`
-	Amount               int64               `protobuf:"varint,4,opt,name=Amount,proto3" json:"Amount,omitempty"`
-	AppliedTime          int64               `protobuf:"varint,5,opt,name=AppliedTime,proto3" json:"AppliedTime,omitempty"`
-	CompleteMinutes      uint64              `protobuf:"varint,6,opt,name=CompleteMinutes,proto3" json:"CompleteMinutes,omitempty"`
-	Status               LiquidPaymentStatus `protobuf:"varint,7,opt,name=Status,proto3,enum=model.LiquidPaymentStatus" json:"Status,omitempty"`
-	BlockHeight          uint32              `protobuf:"varint,8,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
-	Latest               bool                `protobuf:"varint,9,opt,name=Latest,proto3" json:"Latest,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	ID               int64               `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SenderAddress    []byte              `protobuf:"bytes,2,opt,name=SenderAddress,proto3" json:"SenderAddress,omitempty"`
+	RecipientAddress []byte              `protobuf:"bytes,3,opt,name=RecipientAddress,proto3" json:"RecipientAddress,omitempty"`
+	Amount           int64               `protobuf:"varint,4,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	AppliedTime      int64               `protobuf:"varint,5,opt,name=AppliedTime,proto3" json:"AppliedTime,omitempty"`
+	CompleteMinutes  uint64              `protobuf:"varint,6,opt,name=CompleteMinutes,proto3" json:"CompleteMinutes,omitempty"`
+	Status           LiquidPaymentStatus `protobuf:"varint,7,opt,name=Status,proto3,enum=model.LiquidPaymentStatus" json:"Status,omitempty"`
+	BlockHeight      uint32              `protobuf:"varint,8,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	Latest           bool                `protobuf:"varint,9,opt,name=Latest,proto3" json:"Latest,omitempty"`
+	// ClaimedAmount part of the amount already paid to the recipient, by withdrawals or at completion
+	ClaimedAmount int64 `protobuf:"varint,10,opt,name=ClaimedAmount,proto3" json:"ClaimedAmount,omitempty"`
+	// VestedAmount part of the amount streamed to the recipient at the last block, filled by the api only
+	VestedAmount         int64    `protobuf:"varint,11,opt,name=VestedAmount,proto3" json:"VestedAmount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *LiquidPayment) Reset()         { *m = LiquidPayment{} }
@@ -197,6 +201,20 @@ func (m *LiquidPayment) GetLatest() bool {
 	return false
 }
 
+func (m *LiquidPayment) GetClaimedAmount() int64 {
+	if m != nil {
+		return m.ClaimedAmount
+	}
+	return 0
+}
+
+func (m *LiquidPayment) GetVestedAmount() int64 {
+	if m != nil {
+		return m.VestedAmount
+	}
+	return 0
+}
+
 // GetLiquidTransactions return GetLiquidTransactionsResponse
 type GetLiquidTransactionsRequest struct {
 	ID                   int64               `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
@@ -330,32 +348,33 @@ func init() {
 }
 
 var fileDescriptor_d0147bdf7fdaeca5 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xd1, 0x8e, 0xd2, 0x40,
-	0x14, 0x86, 0x9d, 0x02, 0x75, 0x3d, 0x88, 0xe2, 0x71, 0x43, 0x46, 0xa2, 0x49, 0x43, 0xf6, 0xa2,
-	0x21, 0x0a, 0x8a, 0x4f, 0x00, 0x92, 0xe8, 0xc6, 0x35, 0x21, 0xb3, 0x5c, 0x79, 0x57, 0xda, 0x13,
-	0x76, 0x62, 0x67, 0xa6, 0xdb, 0x99, 0x5e, 0xe8, 0x8d, 0x0f, 0xe9, 0x63, 0xf8, 0x12, 0xc6, 0x29,
-	0xcb, 0x52, 0x17, 0x13, 0xaf, 0xbc, 0x69, 0xd2, 0xff, 0xff, 0x4e, 0x3b, 0xff, 0xdf, 0x53, 0x78,
-	0xa6, 0x4c, 0x46, 0xf9, 0x34, 0x97, 0xd7, 0x95, 0xcc, 0x56, 0xc9, 0x57, 0x45, 0xda, 0x4d, 0x8a,
-	0xd2, 0x38, 0x83, 0x1d, 0x6f, 0x0d, 0x07, 0x35, 0x51, 0x24, 0x5b, 0xa9, 0x13, 0x27, 0x8d, 0xae,
-	0xed, 0xd1, 0x8f, 0x00, 0x7a, 0x17, 0x87, 0x63, 0x88, 0x10, 0x9c, 0x2f, 0x39, 0x8b, 0x58, 0xdc,
-	0x5a, 0x04, 0xaf, 0x99, 0x08, 0xce, 0x97, 0x78, 0x06, 0xbd, 0x4b, 0xd2, 0x19, 0x95, 0xf3, 0x2c,
-	0x2b, 0xc9, 0x5a, 0x1e, 0x44, 0x2c, 0x7e, 0x28, 0x9a, 0x22, 0x8e, 0xa1, 0x2f, 0x28, 0x95, 0x85,
-	0x24, 0xed, 0x6e, 0xc0, 0x96, 0x07, 0xef, 0xe8, 0x38, 0x84, 0x70, 0xae, 0x4c, 0xa5, 0x1d, 0x6f,
-	0xef, 0xdf, 0xb4, 0x53, 0xf0, 0x0c, 0xba, 0xf3, 0xa2, 0xc8, 0x25, 0x65, 0x6b, 0xa9, 0x88, 0x77,
-	0xf6, 0xc0, 0xa1, 0x8c, 0x2f, 0xe1, 0xf1, 0x3b, 0xa3, 0x8a, 0x9c, 0x1c, 0x7d, 0x92, 0xba, 0x72,
-	0x64, 0x79, 0x18, 0xb1, 0xb8, 0xed, 0xc9, 0x3f, 0x2d, 0x9c, 0x41, 0x78, 0xe9, 0x12, 0x57, 0x59,
-	0x7e, 0x3f, 0x62, 0xf1, 0xa3, 0xd9, 0x70, 0xe2, 0x0b, 0x99, 0x34, 0xb2, 0xd7, 0x84, 0xd8, 0x91,
-	0x18, 0x41, 0x77, 0x91, 0x9b, 0xf4, 0xcb, 0x07, 0x92, 0xdb, 0x2b, 0xc7, 0x4f, 0x22, 0x16, 0xf7,
-	0xc4, 0xa1, 0x84, 0x03, 0x08, 0x2f, 0x12, 0x47, 0xd6, 0xf1, 0x07, 0x11, 0x8b, 0x4f, 0xc4, 0xee,
-	0x6e, 0xf4, 0x93, 0xc1, 0xf3, 0xf7, 0xe4, 0xea, 0x87, 0xaf, 0xcb, 0x44, 0xdb, 0x24, 0xfd, 0x5d,
-	0xba, 0x15, 0x74, 0x5d, 0x91, 0xfd, 0x5f, 0x25, 0xdf, 0x86, 0x6e, 0xff, 0x73, 0xe8, 0x37, 0x00,
-	0xab, 0xfd, 0x92, 0xf8, 0xee, 0xbb, 0xb3, 0x27, 0xbb, 0xb9, 0x5b, 0x43, 0x1c, 0x40, 0xa3, 0xef,
-	0xf0, 0xe2, 0x2f, 0x61, 0x6d, 0x61, 0xb4, 0x25, 0xe4, 0xd0, 0x59, 0x1b, 0x97, 0xe4, 0x3e, 0x70,
-	0xfd, 0x81, 0x6a, 0x01, 0x97, 0x80, 0x77, 0xe7, 0x78, 0x10, 0xb5, 0xe2, 0xee, 0xec, 0xf4, 0xd8,
-	0x69, 0xc5, 0x11, 0x7e, 0xfc, 0x11, 0x9e, 0x1e, 0x89, 0x84, 0x1c, 0x4e, 0x1b, 0xf2, 0x8a, 0x74,
-	0x26, 0xf5, 0xb6, 0x7f, 0x0f, 0x87, 0x30, 0x68, 0x38, 0x37, 0xdb, 0x92, 0xf5, 0xd9, 0x62, 0xfc,
-	0x39, 0xde, 0x4a, 0x77, 0x55, 0x6d, 0x26, 0xa9, 0x51, 0xd3, 0x6f, 0xc6, 0x6c, 0xd2, 0xfa, 0xfa,
-	0x2a, 0x35, 0x25, 0x4d, 0x53, 0xa3, 0x94, 0xd1, 0x53, 0x7f, 0xb4, 0x4d, 0xe8, 0x7f, 0xa2, 0xb7,
-	0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xa4, 0x29, 0x3a, 0x80, 0x03, 0x00, 0x00,
+	// 448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbd, 0x53, 0x4d, 0x4f, 0xdb, 0x40,
+	0x10, 0xc5, 0xf9, 0x70, 0xe9, 0x84, 0x40, 0xba, 0x45, 0xd1, 0x36, 0x02, 0x09, 0x45, 0x08, 0x45,
+	0x11, 0x4d, 0xda, 0xf0, 0x0b, 0x92, 0x20, 0xd1, 0xaa, 0x41, 0x8a, 0x96, 0x88, 0x03, 0xb7, 0x8d,
+	0xbd, 0x0a, 0x2b, 0xec, 0x5d, 0x63, 0xaf, 0x0f, 0xed, 0xa5, 0xbf, 0x86, 0x5f, 0xc7, 0x9f, 0x60,
+	0xb0, 0x1d, 0x13, 0x93, 0x20, 0x71, 0xe2, 0xe2, 0xd5, 0xbe, 0xf7, 0x66, 0x67, 0xde, 0xcc, 0x18,
+	0xbe, 0xf9, 0xda, 0x15, 0x5e, 0xdf, 0x93, 0xf7, 0xb1, 0x74, 0xa7, 0xfc, 0xaf, 0x2f, 0x94, 0xe9,
+	0x05, 0xa1, 0x36, 0x9a, 0x54, 0x13, 0xaa, 0xd5, 0x4c, 0x15, 0x01, 0x5f, 0x48, 0xc5, 0x8d, 0xd4,
+	0x2a, 0xa5, 0xdb, 0x0f, 0x65, 0xa8, 0x4f, 0x56, 0xc3, 0x08, 0x81, 0xd2, 0xef, 0x73, 0x6a, 0x1d,
+	0x59, 0x9d, 0xf2, 0xa8, 0xf4, 0xc3, 0x62, 0x78, 0x23, 0xc7, 0x50, 0xbf, 0x12, 0xca, 0x15, 0xe1,
+	0xd0, 0x75, 0x43, 0x11, 0x45, 0xb4, 0x84, 0xf4, 0x0e, 0x2b, 0x82, 0xa4, 0x0b, 0x0d, 0x26, 0x1c,
+	0x19, 0x48, 0x7c, 0x66, 0x29, 0x2c, 0x27, 0xc2, 0x35, 0x9c, 0xb4, 0xc0, 0x1e, 0xfa, 0x3a, 0x56,
+	0x86, 0x56, 0xf2, 0x4c, 0x19, 0x82, 0xd9, 0x6a, 0xc3, 0x20, 0xf0, 0xa4, 0x70, 0x67, 0xd2, 0x17,
+	0xb4, 0x9a, 0x0b, 0x56, 0x61, 0x72, 0x0a, 0x7b, 0x63, 0xed, 0x07, 0x9e, 0x30, 0xe2, 0x52, 0xaa,
+	0xd8, 0x88, 0x88, 0xda, 0xa8, 0xac, 0x24, 0xca, 0xd7, 0x14, 0x19, 0x80, 0x7d, 0x65, 0xb8, 0x89,
+	0x23, 0xfa, 0x09, 0x45, 0xbb, 0x83, 0x56, 0x2f, 0x69, 0x48, 0xaf, 0xe0, 0x3d, 0x55, 0xb0, 0x4c,
+	0x49, 0x8e, 0xa0, 0x36, 0xf2, 0xb4, 0x73, 0xf7, 0x4b, 0xc8, 0xc5, 0xad, 0xa1, 0xdb, 0x18, 0x58,
+	0x67, 0xab, 0x10, 0x69, 0x82, 0x3d, 0xe1, 0xf8, 0xbc, 0xa1, 0x9f, 0x91, 0xdc, 0x66, 0xd9, 0x8d,
+	0x74, 0xa0, 0x3e, 0xf6, 0x38, 0x56, 0xe9, 0x66, 0x26, 0x21, 0xf7, 0x50, 0x24, 0xc8, 0x09, 0xec,
+	0x5c, 0x63, 0x44, 0x2e, 0xac, 0xe5, 0xc2, 0x02, 0xde, 0x7e, 0xb4, 0xe0, 0xe0, 0x42, 0x98, 0xb4,
+	0xdc, 0x59, 0xc8, 0x55, 0xc4, 0x9d, 0xe7, 0x31, 0x46, 0x4c, 0xdc, 0xc7, 0xcf, 0x29, 0x3f, 0x66,
+	0x6c, 0x2f, 0x6d, 0xac, 0xbc, 0xbb, 0x8d, 0x3f, 0x01, 0xa6, 0xf9, 0xda, 0x25, 0xd3, 0xac, 0x0d,
+	0xbe, 0x64, 0x71, 0x2f, 0x04, 0x5b, 0x11, 0xb5, 0xff, 0xc3, 0xe1, 0x1b, 0x66, 0xa3, 0x00, 0x0f,
+	0x41, 0x28, 0x54, 0x67, 0xda, 0x70, 0x2f, 0x31, 0x9c, 0x8e, 0x3c, 0x05, 0xc8, 0x39, 0x90, 0xf5,
+	0x38, 0x34, 0x5e, 0xc6, 0xac, 0xfb, 0x9b, 0xaa, 0x65, 0x1b, 0xf4, 0xdd, 0x3f, 0xf0, 0x75, 0x83,
+	0x25, 0x4c, 0xbb, 0x5f, 0x80, 0xa7, 0xd8, 0x48, 0xa9, 0x16, 0x8d, 0x2d, 0xdc, 0xe7, 0x66, 0x81,
+	0x59, 0xee, 0x9f, 0xdb, 0xb0, 0x46, 0xdd, 0x9b, 0xce, 0x42, 0x9a, 0xdb, 0x78, 0xde, 0x73, 0xb4,
+	0xdf, 0xff, 0xa7, 0xf5, 0xdc, 0x49, 0xbf, 0xdf, 0x1d, 0x1d, 0x8a, 0x3e, 0x82, 0xbe, 0x56, 0xfd,
+	0xa4, 0xb4, 0xb9, 0x9d, 0xfc, 0x96, 0x67, 0x4f, 0x53, 0x26, 0x3e, 0x67, 0xd2, 0x03, 0x00, 0x00,
 }
@@ -72,10 +72,11 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type PendingTransactionStatus int32
 
 const (
-	PendingTransactionStatus_PendingTransactionPending  PendingTransactionStatus = 0
-	PendingTransactionStatus_PendingTransactionExecuted PendingTransactionStatus = 1
-	PendingTransactionStatus_PendingTransactionNoOp     PendingTransactionStatus = 2
-	PendingTransactionStatus_PendingTransactionExpired  PendingTransactionStatus = 3
+	PendingTransactionStatus_PendingTransactionPending   PendingTransactionStatus = 0
+	PendingTransactionStatus_PendingTransactionExecuted  PendingTransactionStatus = 1
+	PendingTransactionStatus_PendingTransactionNoOp      PendingTransactionStatus = 2
+	PendingTransactionStatus_PendingTransactionExpired   PendingTransactionStatus = 3
+	PendingTransactionStatus_PendingTransactionCancelled PendingTransactionStatus = 4
 )
 
 var PendingTransactionStatus_name = map[int32]string{
@@ -83,13 +84,15 @@ var PendingTransactionStatus_name = map[int32]string{
 	1: "PendingTransactionExecuted",
 	2: "PendingTransactionNoOp",
 	3: "PendingTransactionExpired",
+	4: "PendingTransactionCancelled",
 }
 
 var PendingTransactionStatus_value = map[string]int32{
-	"PendingTransactionPending":  0,
-	"PendingTransactionExecuted": 1,
-	"PendingTransactionNoOp":     2,
-	"PendingTransactionExpired":  3,
+	"PendingTransactionPending":   0,
+	"PendingTransactionExecuted":  1,
+	"PendingTransactionNoOp":      2,
+	"PendingTransactionExpired":   3,
+	"PendingTransactionCancelled": 4,
 }
 
 func (x PendingTransactionStatus) String() string {
@@ -101,12 +104,14 @@ func (PendingTransactionStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type MultiSignatureInfo struct {
-	MinimumSignatures    uint32   `protobuf:"varint,1,opt,name=MinimumSignatures,proto3" json:"MinimumSignatures,omitempty"`
-	Nonce                int64    `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
-	MultisigAddress      []byte   `protobuf:"bytes,3,opt,name=MultisigAddress,proto3" json:"MultisigAddress,omitempty"`
-	BlockHeight          uint32   `protobuf:"varint,4,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
-	Latest               bool     `protobuf:"varint,5,opt,name=Latest,proto3" json:"Latest,omitempty"`
-	Addresses            [][]byte `protobuf:"bytes,6,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+	MinimumSignatures uint32   `protobuf:"varint,1,opt,name=MinimumSignatures,proto3" json:"MinimumSignatures,omitempty"`
+	Nonce             int64    `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	MultisigAddress   []byte   `protobuf:"bytes,3,opt,name=MultisigAddress,proto3" json:"MultisigAddress,omitempty"`
+	BlockHeight       uint32   `protobuf:"varint,4,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	Latest            bool     `protobuf:"varint,5,opt,name=Latest,proto3" json:"Latest,omitempty"`
+	Addresses         [][]byte `protobuf:"bytes,6,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+	// Weights weight of each participant, aligned with Addresses. Every participant weights 1 when empty
+	Weights              []uint32 `protobuf:"varint,7,rep,packed,name=Weights,proto3" json:"Weights,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -179,6 +184,13 @@ func (m *MultiSignatureInfo) GetAddresses() [][]byte {
 	return nil
 }
 
+func (m *MultiSignatureInfo) GetWeights() []uint32 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
 // represent the signature posted by account
 type SignatureInfo struct {
 	TransactionHash      []byte            `protobuf:"bytes,1,opt,name=TransactionHash,proto3" json:"TransactionHash,omitempty"`
@@ -229,14 +241,16 @@ func (m *SignatureInfo) GetSignatures() map[string][]byte {
 
 // represent the multi signature's participant account addresses
 type MultiSignatureParticipant struct {
-	MultiSignatureAddress []byte   `protobuf:"bytes,1,opt,name=MultiSignatureAddress,proto3" json:"MultiSignatureAddress,omitempty"`
-	AccountAddress        []byte   `protobuf:"bytes,2,opt,name=AccountAddress,proto3" json:"AccountAddress,omitempty"`
-	AccountAddressIndex   uint32   `protobuf:"varint,3,opt,name=AccountAddressIndex,proto3" json:"AccountAddressIndex,omitempty"`
-	Latest                bool     `protobuf:"varint,4,opt,name=Latest,proto3" json:"Latest,omitempty"`
-	BlockHeight           uint32   `protobuf:"varint,5,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
-	XXX_unrecognized      []byte   `json:"-"`
-	XXX_sizecache         int32    `json:"-"`
+	MultiSignatureAddress []byte `protobuf:"bytes,1,opt,name=MultiSignatureAddress,proto3" json:"MultiSignatureAddress,omitempty"`
+	AccountAddress        []byte `protobuf:"bytes,2,opt,name=AccountAddress,proto3" json:"AccountAddress,omitempty"`
+	AccountAddressIndex   uint32 `protobuf:"varint,3,opt,name=AccountAddressIndex,proto3" json:"AccountAddressIndex,omitempty"`
+	Latest                bool   `protobuf:"varint,4,opt,name=Latest,proto3" json:"Latest,omitempty"`
+	BlockHeight           uint32 `protobuf:"varint,5,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	// Weight added to the summed weight of the signatures when the participant signs
+	Weight               uint32   `protobuf:"varint,6,opt,name=Weight,proto3" json:"Weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MultiSignatureParticipant) Reset()         { *m = MultiSignatureParticipant{} }
@@ -299,6 +313,13 @@ func (m *MultiSignatureParticipant) GetBlockHeight() uint32 {
 	return 0
 }
 
+func (m *MultiSignatureParticipant) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 // represent the pending signature counter stored by node for multi-signature transaction
 type PendingSignature struct {
 	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=TransactionHash,proto3" json:"TransactionHash,omitempty"`
@@ -1229,6 +1250,220 @@ func (m *GetParticipantsByMultisigAddressesResponse) GetMultiSignatureParticipan
 	return nil
 }
 
+// MultiSignatureRotation record a replacement of the participants and threshold of a multisig account
+type MultiSignatureRotation struct {
+	// ID of the rotation transaction
+	ID                int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	MultisigAddress   []byte `protobuf:"bytes,2,opt,name=MultisigAddress,proto3" json:"MultisigAddress,omitempty"`
+	MinimumSignatures uint32 `protobuf:"varint,3,opt,name=MinimumSignatures,proto3" json:"MinimumSignatures,omitempty"`
+	// Addresses participants of the multisig account after the rotation
+	Addresses [][]byte `protobuf:"bytes,4,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+	// Weights weight of each participant after the rotation, empty when not weighted
+	Weights              []uint32 `protobuf:"varint,5,rep,packed,name=Weights,proto3" json:"Weights,omitempty"`
+	BlockHeight          uint32   `protobuf:"varint,6,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	Latest               bool     `protobuf:"varint,7,opt,name=Latest,proto3" json:"Latest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSignatureRotation) Reset()         { *m = MultiSignatureRotation{} }
+func (m *MultiSignatureRotation) String() string { return proto.CompactTextString(m) }
+func (*MultiSignatureRotation) ProtoMessage()    {}
+func (*MultiSignatureRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_136af44c597c17ae, []int{20}
+}
+
+func (m *MultiSignatureRotation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSignatureRotation.Unmarshal(m, b)
+}
+func (m *MultiSignatureRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSignatureRotation.Marshal(b, m, deterministic)
+}
+func (m *MultiSignatureRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSignatureRotation.Merge(m, src)
+}
+func (m *MultiSignatureRotation) XXX_Size() int {
+	return xxx_messageInfo_MultiSignatureRotation.Size(m)
+}
+func (m *MultiSignatureRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSignatureRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSignatureRotation proto.InternalMessageInfo
+
+func (m *MultiSignatureRotation) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *MultiSignatureRotation) GetMultisigAddress() []byte {
+	if m != nil {
+		return m.MultisigAddress
+	}
+	return nil
+}
+
+func (m *MultiSignatureRotation) GetMinimumSignatures() uint32 {
+	if m != nil {
+		return m.MinimumSignatures
+	}
+	return 0
+}
+
+func (m *MultiSignatureRotation) GetAddresses() [][]byte {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *MultiSignatureRotation) GetWeights() []uint32 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+func (m *MultiSignatureRotation) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *MultiSignatureRotation) GetLatest() bool {
+	if m != nil {
+		return m.Latest
+	}
+	return false
+}
+
+// PostDetachedSignatureRequest submit the signature of a multisig participant collected off chain by the node
+type PostDetachedSignatureRequest struct {
+	// TransactionHash of a transaction pending on chain
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=TransactionHash,proto3" json:"TransactionHash,omitempty"`
+	AccountAddress       []byte   `protobuf:"bytes,2,opt,name=AccountAddress,proto3" json:"AccountAddress,omitempty"`
+	Signature            []byte   `protobuf:"bytes,3,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PostDetachedSignatureRequest) Reset()         { *m = PostDetachedSignatureRequest{} }
+func (m *PostDetachedSignatureRequest) String() string { return proto.CompactTextString(m) }
+func (*PostDetachedSignatureRequest) ProtoMessage()    {}
+func (*PostDetachedSignatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_136af44c597c17ae, []int{21}
+}
+
+func (m *PostDetachedSignatureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostDetachedSignatureRequest.Unmarshal(m, b)
+}
+func (m *PostDetachedSignatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostDetachedSignatureRequest.Marshal(b, m, deterministic)
+}
+func (m *PostDetachedSignatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostDetachedSignatureRequest.Merge(m, src)
+}
+func (m *PostDetachedSignatureRequest) XXX_Size() int {
+	return xxx_messageInfo_PostDetachedSignatureRequest.Size(m)
+}
+func (m *PostDetachedSignatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostDetachedSignatureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostDetachedSignatureRequest proto.InternalMessageInfo
+
+func (m *PostDetachedSignatureRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *PostDetachedSignatureRequest) GetAccountAddress() []byte {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return nil
+}
+
+func (m *PostDetachedSignatureRequest) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type PostDetachedSignatureResponse struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=TransactionHash,proto3" json:"TransactionHash,omitempty"`
+	// SignaturesWeight summed weight of the signatures collected so far
+	SignaturesWeight  uint64 `protobuf:"varint,2,opt,name=SignaturesWeight,proto3" json:"SignaturesWeight,omitempty"`
+	MinimumSignatures uint32 `protobuf:"varint,3,opt,name=MinimumSignatures,proto3" json:"MinimumSignatures,omitempty"`
+	// MultisigTransactionBytes of the multisig transaction once the signatures are enough, signed and broadcast by the node
+	// when it has a detached multisig fee payer, otherwise unsigned with the posting participant as sender, to sign and post
+	MultisigTransactionBytes []byte   `protobuf:"bytes,4,opt,name=MultisigTransactionBytes,proto3" json:"MultisigTransactionBytes,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *PostDetachedSignatureResponse) Reset()         { *m = PostDetachedSignatureResponse{} }
+func (m *PostDetachedSignatureResponse) String() string { return proto.CompactTextString(m) }
+func (*PostDetachedSignatureResponse) ProtoMessage()    {}
+func (*PostDetachedSignatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_136af44c597c17ae, []int{22}
+}
+
+func (m *PostDetachedSignatureResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostDetachedSignatureResponse.Unmarshal(m, b)
+}
+func (m *PostDetachedSignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostDetachedSignatureResponse.Marshal(b, m, deterministic)
+}
+func (m *PostDetachedSignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostDetachedSignatureResponse.Merge(m, src)
+}
+func (m *PostDetachedSignatureResponse) XXX_Size() int {
+	return xxx_messageInfo_PostDetachedSignatureResponse.Size(m)
+}
+func (m *PostDetachedSignatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostDetachedSignatureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostDetachedSignatureResponse proto.InternalMessageInfo
+
+func (m *PostDetachedSignatureResponse) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *PostDetachedSignatureResponse) GetSignaturesWeight() uint64 {
+	if m != nil {
+		return m.SignaturesWeight
+	}
+	return 0
+}
+
+func (m *PostDetachedSignatureResponse) GetMinimumSignatures() uint32 {
+	if m != nil {
+		return m.MinimumSignatures
+	}
+	return 0
+}
+
+func (m *PostDetachedSignatureResponse) GetMultisigTransactionBytes() []byte {
+	if m != nil {
+		return m.MultisigTransactionBytes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("model.PendingTransactionStatus", PendingTransactionStatus_name, PendingTransactionStatus_value)
 	proto.RegisterType((*MultiSignatureInfo)(nil), "model.MultiSignatureInfo")
@@ -1253,6 +1488,9 @@ func init() {
 	proto.RegisterType((*MultiSignatureParticipants)(nil), "model.MultiSignatureParticipants")
 	proto.RegisterType((*GetParticipantsByMultisigAddressesResponse)(nil), "model.GetParticipantsByMultisigAddressesResponse")
 	proto.RegisterMapType((map[string]*MultiSignatureParticipants)(nil), "model.GetParticipantsByMultisigAddressesResponse.MultiSignatureParticipantsEntry")
+	proto.RegisterType((*MultiSignatureRotation)(nil), "model.MultiSignatureRotation")
+	proto.RegisterType((*PostDetachedSignatureRequest)(nil), "model.PostDetachedSignatureRequest")
+	proto.RegisterType((*PostDetachedSignatureResponse)(nil), "model.PostDetachedSignatureResponse")
 }
 
 func init() {
@@ -1260,69 +1498,78 @@ func init() {
 }
 
 var fileDescriptor_136af44c597c17ae = []byte{
-	// 1017 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6e, 0x1b, 0x45,
-	0x18, 0x67, 0xd6, 0x89, 0xd5, 0x7c, 0xb1, 0x1b, 0x67, 0x0a, 0x61, 0x63, 0xa5, 0xa9, 0x59, 0x95,
-	0x6a, 0x89, 0xa8, 0x53, 0xd2, 0x4a, 0x45, 0x48, 0x1c, 0xba, 0x34, 0x34, 0x11, 0xb4, 0x84, 0x49,
-	0x4e, 0x9c, 0xd8, 0xac, 0x07, 0x67, 0x55, 0x7b, 0xc7, 0xec, 0xcc, 0xa2, 0x18, 0x24, 0x2e, 0x5c,
-	0xb9, 0x00, 0x95, 0x78, 0x02, 0x1e, 0x00, 0xce, 0x88, 0x33, 0x4f, 0xc1, 0x8d, 0xf7, 0x40, 0xbb,
-	0x33, 0x6b, 0xcf, 0xee, 0x8e, 0x1d, 0xc7, 0x17, 0x2e, 0x96, 0xe7, 0xfb, 0xcd, 0x7c, 0x7f, 0x7e,
-	0xdf, 0x9f, 0xd9, 0x81, 0xf6, 0x90, 0xf5, 0xe8, 0x60, 0x7f, 0x98, 0x0c, 0x44, 0x78, 0x1a, 0xf6,
-	0x23, 0x5f, 0x24, 0x31, 0xed, 0x8e, 0x62, 0x26, 0x18, 0x5e, 0xcd, 0xb0, 0xf6, 0x96, 0xdc, 0x32,
-	0xf2, 0xfb, 0x61, 0xe4, 0x8b, 0x90, 0x45, 0x12, 0x76, 0xfe, 0x41, 0x80, 0x9f, 0x17, 0xce, 0x1d,
-	0x47, 0x5f, 0x31, 0xfc, 0x2e, 0x6c, 0x3e, 0x0f, 0xa3, 0x70, 0x98, 0x0c, 0x27, 0x72, 0x6e, 0xa3,
-	0x0e, 0x72, 0x9b, 0xa4, 0x0a, 0x60, 0x1b, 0x56, 0x5f, 0xb0, 0x28, 0xa0, 0xb6, 0xd5, 0x41, 0x6e,
-	0xcd, 0xb3, 0x1e, 0x20, 0x22, 0x05, 0xd8, 0x85, 0x8d, 0x4c, 0x3b, 0x0f, 0xfb, 0x4f, 0x7a, 0xbd,
-	0x98, 0x72, 0x6e, 0xd7, 0x3a, 0xc8, 0x6d, 0x90, 0xb2, 0x18, 0x77, 0x60, 0xdd, 0x1b, 0xb0, 0xe0,
-	0xe5, 0x11, 0x0d, 0xfb, 0x17, 0xc2, 0x5e, 0xc9, 0x6c, 0xe9, 0x22, 0xbc, 0x05, 0xf5, 0x4f, 0x7d,
-	0x41, 0xb9, 0xb0, 0x57, 0x3b, 0xc8, 0xbd, 0x41, 0xd4, 0x0a, 0xef, 0xc0, 0x9a, 0x52, 0x42, 0xb9,
-	0x5d, 0xef, 0xd4, 0xdc, 0x06, 0x99, 0x0a, 0x9c, 0xbf, 0x10, 0x34, 0x8b, 0xb1, 0xb9, 0xb0, 0x71,
-	0x16, 0xfb, 0x11, 0xf7, 0x83, 0x94, 0x87, 0x23, 0x9f, 0x5f, 0x64, 0x91, 0x35, 0x48, 0x59, 0x8c,
-	0x9f, 0x02, 0x68, 0xe1, 0x5b, 0x9d, 0x9a, 0xbb, 0x7e, 0x70, 0xb7, 0x9b, 0x31, 0xd9, 0x2d, 0xe8,
-	0x9c, 0xae, 0xf8, 0x61, 0x24, 0xe2, 0x31, 0xd1, 0xce, 0xb5, 0x3f, 0x84, 0x8d, 0x12, 0x8c, 0x5b,
-	0x50, 0x7b, 0x49, 0xc7, 0x99, 0xd9, 0x35, 0x92, 0xfe, 0xc5, 0xaf, 0xc3, 0xea, 0x37, 0xfe, 0x20,
-	0x91, 0x14, 0x36, 0x88, 0x5c, 0x7c, 0x60, 0xbd, 0x8f, 0x9c, 0x7f, 0x11, 0x6c, 0x17, 0x33, 0x74,
-	0xe2, 0xc7, 0x22, 0x0c, 0xc2, 0x91, 0x1f, 0x09, 0xfc, 0x08, 0xde, 0x28, 0x82, 0x39, 0xcd, 0x32,
-	0x24, 0x33, 0x88, 0xef, 0xc1, 0xcd, 0x27, 0x41, 0xc0, 0x92, 0x48, 0xe4, 0xdb, 0xa5, 0xd9, 0x92,
-	0x14, 0x3f, 0x80, 0x5b, 0x45, 0xc9, 0x71, 0xd4, 0xa3, 0x97, 0x59, 0x0a, 0x9b, 0xc4, 0x04, 0x69,
-	0x49, 0x5a, 0x29, 0x24, 0xa9, 0x94, 0xde, 0xd5, 0x4a, 0x7a, 0x9d, 0x3f, 0x11, 0xb4, 0x4e, 0x68,
-	0xd4, 0x0b, 0xa3, 0xfe, 0xc4, 0xdf, 0x6b, 0xe4, 0x6a, 0xd1, 0x90, 0x76, 0x60, 0x6d, 0xa2, 0x5e,
-	0xd5, 0xe2, 0x54, 0xb0, 0x7c, 0x15, 0x3a, 0x3f, 0x58, 0x80, 0x95, 0xfb, 0x9a, 0x6b, 0xf8, 0x2e,
-	0x34, 0x4f, 0x69, 0xd4, 0xa3, 0x71, 0x31, 0x2f, 0x45, 0xa1, 0x29, 0x4c, 0xcb, 0x1c, 0xe6, 0x1e,
-	0xb4, 0x34, 0x91, 0x37, 0x16, 0x34, 0xef, 0xa8, 0x8a, 0x1c, 0x3f, 0x86, 0xfa, 0xa9, 0xf0, 0x45,
-	0xc2, 0xb3, 0x38, 0x6e, 0x1e, 0xdc, 0x51, 0xa5, 0x5b, 0x75, 0x53, 0x6e, 0x23, 0x6a, 0xfb, 0xd5,
-	0xc9, 0xd2, 0x58, 0xa8, 0x17, 0x58, 0xf8, 0x1d, 0xc1, 0xed, 0x67, 0x54, 0x54, 0x2d, 0x70, 0x42,
-	0xbf, 0x4e, 0xd2, 0x42, 0x58, 0x8c, 0x90, 0xa9, 0xeb, 0xd6, 0xf5, 0x5c, 0x7f, 0x0f, 0xe0, 0x64,
-	0x32, 0xe3, 0x32, 0x66, 0xd6, 0x0f, 0x36, 0xf3, 0xc3, 0x13, 0x80, 0x68, 0x9b, 0x9c, 0x5f, 0x11,
-	0xec, 0xce, 0xf2, 0x99, 0x8f, 0x58, 0xc4, 0x69, 0xda, 0x9d, 0x1f, 0xa5, 0x45, 0xa4, 0x46, 0xa0,
-	0x5c, 0x60, 0x0c, 0x2b, 0x27, 0x7e, 0x5f, 0xb6, 0x6c, 0x93, 0x64, 0xff, 0xf1, 0x27, 0x70, 0xcb,
-	0xa0, 0xc8, 0xae, 0x65, 0xb3, 0x63, 0x7b, 0x66, 0x14, 0xc4, 0x74, 0xca, 0xa1, 0xf0, 0xd0, 0xe8,
-	0xd8, 0x53, 0x2a, 0xfc, 0x70, 0xe0, 0x8d, 0x4b, 0xc5, 0x91, 0x53, 0xdc, 0x05, 0x5c, 0x42, 0x8e,
-	0xe8, 0xa5, 0x1a, 0x36, 0x06, 0xc4, 0xf9, 0xc5, 0x82, 0x47, 0xd7, 0xb3, 0xa3, 0x68, 0x39, 0x36,
-	0x95, 0x7c, 0x66, 0x68, 0x6e, 0xac, 0xa6, 0x3e, 0x39, 0x84, 0xcd, 0x72, 0xf3, 0xe7, 0x13, 0xf7,
-	0xcd, 0xa2, 0xa6, 0x09, 0x4e, 0xaa, 0x27, 0x52, 0x8f, 0xaa, 0xb7, 0x99, 0x2a, 0x83, 0xdc, 0xa3,
-	0xea, 0x06, 0x62, 0x38, 0xe4, 0x7c, 0x07, 0x3b, 0xcf, 0xa8, 0xc8, 0xaf, 0x29, 0x6d, 0xb3, 0x62,
-	0xd9, 0x70, 0xb5, 0x21, 0xf3, 0xd5, 0x56, 0xac, 0x49, 0x6b, 0x91, 0x9a, 0x7c, 0x25, 0xfb, 0xc8,
-	0x64, 0xfd, 0xda, 0x25, 0x99, 0x73, 0xc2, 0x4b, 0x9c, 0xd4, 0x16, 0xe1, 0xa4, 0x70, 0xc8, 0xf9,
-	0x19, 0xc1, 0x7d, 0xcd, 0x2d, 0x15, 0xa0, 0x37, 0xd6, 0x6e, 0x24, 0x25, 0xd3, 0x6a, 0xb1, 0x0a,
-	0x2a, 0xa2, 0x0c, 0xc8, 0x32, 0x5c, 0x09, 0xe8, 0x2e, 0xea, 0xd3, 0x94, 0xbb, 0x33, 0x26, 0xfc,
-	0x41, 0xce, 0x5d, 0xb6, 0xc8, 0xbe, 0x79, 0x8a, 0x4a, 0x54, 0x09, 0x36, 0x48, 0x15, 0x70, 0x02,
-	0x78, 0xdb, 0x3c, 0x34, 0xbc, 0xb1, 0x9c, 0x91, 0x39, 0x03, 0xbb, 0x00, 0x1f, 0xc7, 0x6c, 0xa8,
-	0x66, 0xa9, 0xb4, 0xa8, 0x49, 0x70, 0x1b, 0x6e, 0x9c, 0x31, 0x85, 0xca, 0xb4, 0x4d, 0xd6, 0x4e,
-	0x02, 0xf7, 0xae, 0x32, 0xa2, 0x42, 0x9a, 0x31, 0x77, 0xd0, 0x52, 0x73, 0xe7, 0x0f, 0x63, 0x9a,
-	0x29, 0xf7, 0xc6, 0xda, 0x1d, 0x40, 0xfc, 0xa8, 0x4f, 0xb5, 0x66, 0x48, 0x43, 0xd2, 0x6f, 0x0d,
-	0x19, 0x69, 0x59, 0x9c, 0xce, 0xff, 0x33, 0xa6, 0xef, 0x93, 0x31, 0x17, 0x85, 0xcb, 0x8c, 0xf1,
-	0xdf, 0x90, 0xa9, 0x0e, 0xcc, 0x4e, 0xff, 0x9f, 0x3d, 0xf4, 0x23, 0x82, 0x77, 0xd2, 0xa4, 0x4e,
-	0xcb, 0x93, 0x7b, 0xe3, 0x8a, 0xd7, 0x39, 0xb1, 0xc6, 0xa2, 0x44, 0x33, 0x8a, 0x72, 0x99, 0xee,
-	0xf9, 0x1e, 0xda, 0x33, 0xbf, 0x2e, 0x39, 0xfe, 0x72, 0x1e, 0xaa, 0xaa, 0xab, 0x63, 0x8c, 0x5f,
-	0xdb, 0x48, 0xe6, 0xe8, 0x70, 0xfe, 0xb6, 0x60, 0x6f, 0x11, 0x3a, 0xe6, 0xb6, 0xee, 0x4f, 0x68,
-	0xae, 0x9f, 0xf2, 0x1e, 0xf9, 0x5c, 0xf9, 0xb9, 0xb8, 0xb5, 0xd9, 0x21, 0xa9, 0xcf, 0xfc, 0x39,
-	0x46, 0xdb, 0x23, 0xb8, 0x73, 0xc5, 0x71, 0xc3, 0x33, 0xe0, 0xb1, 0xfe, 0x0c, 0x58, 0x3f, 0x78,
-	0xeb, 0x2a, 0x6a, 0xb9, 0xf6, 0x52, 0xd8, 0x7b, 0x85, 0xc0, 0x9e, 0xf5, 0x81, 0x84, 0x6f, 0xc3,
-	0x76, 0x15, 0x53, 0x92, 0xd6, 0x6b, 0x78, 0x17, 0xda, 0x55, 0xf8, 0xf0, 0x92, 0x06, 0x89, 0xa0,
-	0xbd, 0x16, 0xc2, 0x6d, 0xd8, 0xaa, 0xe2, 0x2f, 0xd8, 0x67, 0xa3, 0x96, 0x65, 0x56, 0x7d, 0x78,
-	0x39, 0x0a, 0x63, 0xda, 0x6b, 0xd5, 0xbc, 0xbd, 0x2f, 0xdc, 0x7e, 0x28, 0x2e, 0x92, 0xf3, 0x6e,
-	0xc0, 0x86, 0xfb, 0xdf, 0x32, 0x76, 0x1e, 0xc8, 0xdf, 0xfb, 0x01, 0x8b, 0xe9, 0x7e, 0xc0, 0x86,
-	0x43, 0x16, 0xed, 0x67, 0x81, 0x9e, 0xd7, 0xb3, 0x57, 0xe9, 0xc3, 0xff, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xcf, 0xbc, 0x17, 0xec, 0xd2, 0x0e, 0x00, 0x00,
+	// 1158 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc5, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0x67, 0xd6, 0x7f, 0xd2, 0xbe, 0xc4, 0xad, 0x33, 0x85, 0xe0, 0x98, 0xb4, 0x09, 0xab, 0x52,
+	0x85, 0x88, 0x3a, 0x25, 0xad, 0x54, 0x54, 0x89, 0x43, 0xdd, 0x04, 0x12, 0x41, 0x4b, 0x98, 0x46,
+	0xaa, 0xc4, 0x89, 0xcd, 0x7a, 0x70, 0x56, 0xb5, 0x77, 0x8c, 0x77, 0x8d, 0x62, 0x90, 0xb8, 0x70,
+	0x45, 0x48, 0xfc, 0x91, 0xf8, 0x04, 0x7c, 0x00, 0x90, 0xb8, 0x21, 0xce, 0x7c, 0x0f, 0xbe, 0x03,
+	0xe2, 0x82, 0xc4, 0xec, 0xcc, 0xac, 0x77, 0x76, 0x77, 0xd6, 0xb1, 0x7d, 0x80, 0x8b, 0xed, 0x79,
+	0xbf, 0x37, 0xef, 0xff, 0xbc, 0x37, 0x63, 0x68, 0xf6, 0x59, 0x87, 0xf6, 0x76, 0xfb, 0xa3, 0x5e,
+	0xe8, 0x3d, 0xf5, 0xba, 0xbe, 0x13, 0x8e, 0x86, 0xb4, 0x35, 0x18, 0xb2, 0x90, 0xe1, 0x8a, 0xc0,
+	0x9a, 0x6b, 0x92, 0x65, 0xe0, 0x74, 0x3d, 0x0e, 0x7b, 0xcc, 0x97, 0xb0, 0xfd, 0x37, 0x02, 0xfc,
+	0x38, 0xb5, 0xef, 0xc8, 0xff, 0x84, 0xe1, 0x37, 0x60, 0xf5, 0xb1, 0xe7, 0x7b, 0xfd, 0x51, 0x7f,
+	0x42, 0x0f, 0x1a, 0x68, 0x0b, 0x6d, 0xd7, 0x48, 0x1e, 0xc0, 0x0d, 0xa8, 0x3c, 0x61, 0xbe, 0x4b,
+	0x1b, 0x16, 0xe7, 0x28, 0xb5, 0xad, 0x3b, 0x88, 0x48, 0x02, 0xde, 0x86, 0xab, 0x42, 0x7a, 0xe0,
+	0x75, 0x1f, 0x76, 0x3a, 0x9c, 0x37, 0x68, 0x94, 0x38, 0xcf, 0x0a, 0xc9, 0x92, 0xf1, 0x16, 0x2c,
+	0xb7, 0x7b, 0xcc, 0x7d, 0x7e, 0x48, 0xbd, 0xee, 0x59, 0xd8, 0x28, 0x0b, 0x5d, 0x3a, 0x09, 0xaf,
+	0x41, 0xf5, 0x7d, 0x27, 0xa4, 0x41, 0xd8, 0xa8, 0x70, 0xf0, 0x12, 0x51, 0x2b, 0xbc, 0x01, 0x97,
+	0x95, 0x10, 0x6e, 0x63, 0x75, 0xab, 0xc4, 0xa5, 0x27, 0x04, 0x6e, 0xdb, 0xd2, 0x33, 0xb1, 0x3f,
+	0x68, 0x2c, 0x71, 0xac, 0x46, 0xe2, 0xa5, 0xfd, 0x3b, 0x82, 0x5a, 0xda, 0x6b, 0x6e, 0xed, 0xc9,
+	0xd0, 0xf1, 0x03, 0xc7, 0x8d, 0x22, 0x74, 0xe8, 0x04, 0x67, 0xc2, 0x67, 0x6e, 0x6d, 0x86, 0x8c,
+	0xf7, 0x01, 0xb4, 0xc0, 0x58, 0x5c, 0xf0, 0xf2, 0xde, 0xcd, 0x96, 0x88, 0x71, 0x2b, 0x25, 0x33,
+	0x59, 0x05, 0x07, 0x7e, 0x38, 0x1c, 0x13, 0x6d, 0x5f, 0xf3, 0x6d, 0xb8, 0x9a, 0x81, 0x71, 0x1d,
+	0x4a, 0xcf, 0xe9, 0x58, 0xa8, 0xbd, 0x4c, 0xa2, 0x9f, 0xf8, 0x45, 0xa8, 0x7c, 0xe6, 0xf4, 0x46,
+	0x32, 0xb8, 0x2b, 0x44, 0x2e, 0x1e, 0x58, 0x6f, 0x21, 0xfb, 0x1f, 0x04, 0xeb, 0xe9, 0xdc, 0x1d,
+	0x3b, 0xc3, 0xd0, 0x73, 0xbd, 0x81, 0xe3, 0x87, 0xf8, 0x1e, 0xbc, 0x94, 0x06, 0xe3, 0x04, 0x48,
+	0x97, 0xcc, 0x20, 0xbe, 0x05, 0x57, 0x1e, 0xba, 0x2e, 0x1b, 0xf9, 0x61, 0xcc, 0x2e, 0xd5, 0x66,
+	0xa8, 0xf8, 0x0e, 0x5c, 0x4b, 0x53, 0x8e, 0xfc, 0x0e, 0x3d, 0x17, 0xc9, 0xad, 0x11, 0x13, 0xa4,
+	0xa5, 0xaf, 0x9c, 0x4a, 0x5f, 0x26, 0xf1, 0x15, 0x63, 0xe2, 0x65, 0xce, 0x78, 0x76, 0x23, 0x50,
+	0xad, 0xec, 0xdf, 0x10, 0xd4, 0x8f, 0xa9, 0xdf, 0xf1, 0xfc, 0xee, 0xc4, 0x8f, 0x39, 0x72, 0x38,
+	0xab, 0xab, 0xbc, 0xbe, 0x26, 0xe2, 0x55, 0xf5, 0x26, 0x84, 0xc5, 0xeb, 0xd6, 0xfe, 0xca, 0x02,
+	0xac, 0xcc, 0xd7, 0x4c, 0xc3, 0x37, 0x79, 0x55, 0x72, 0x2a, 0x1d, 0xa6, 0xf3, 0x95, 0x26, 0x9a,
+	0xdc, 0xb4, 0xcc, 0x6e, 0xee, 0x40, 0x5d, 0x23, 0xb5, 0xc7, 0x5c, 0xb7, 0xf2, 0x22, 0x47, 0xc7,
+	0xf7, 0xa1, 0xfa, 0x34, 0xe4, 0x7e, 0x05, 0xc2, 0x8f, 0x2b, 0x7b, 0x9b, 0xaa, 0xa4, 0xf3, 0x66,
+	0x4a, 0x36, 0xa2, 0xd8, 0x67, 0x4b, 0xa2, 0x8a, 0x42, 0x35, 0x15, 0x85, 0x9f, 0x11, 0x5c, 0x7f,
+	0x97, 0x86, 0x79, 0x0d, 0x01, 0xa1, 0x9f, 0x8e, 0xa2, 0x02, 0x99, 0x2d, 0x20, 0x89, 0xe9, 0xd6,
+	0x7c, 0xa6, 0xbf, 0x09, 0x70, 0x3c, 0xe9, 0x8a, 0x22, 0x32, 0xcb, 0x7b, 0xab, 0xf1, 0xe6, 0x09,
+	0x40, 0x34, 0x26, 0xfb, 0x47, 0x04, 0x37, 0x8a, 0x6c, 0x0e, 0x06, 0xfc, 0x8b, 0x46, 0xa7, 0xf6,
+	0x51, 0x54, 0x44, 0xaa, 0x69, 0xca, 0x05, 0xc6, 0x50, 0xe6, 0x62, 0xe4, 0x51, 0xae, 0x11, 0xf1,
+	0x1b, 0xbf, 0x07, 0xd7, 0x0c, 0x82, 0xb8, 0x21, 0x51, 0x4f, 0x59, 0x2f, 0xf4, 0x82, 0x98, 0x76,
+	0xd9, 0x14, 0xee, 0x1a, 0x0d, 0xdb, 0xa7, 0xa1, 0xe3, 0xf5, 0xda, 0xe3, 0x4c, 0x71, 0xc4, 0x21,
+	0x6e, 0x01, 0xce, 0x20, 0x87, 0xfc, 0x30, 0xcb, 0x26, 0x64, 0x40, 0xec, 0xef, 0x2d, 0xb8, 0x37,
+	0x9f, 0x1e, 0x15, 0x96, 0x23, 0x53, 0xc9, 0x0b, 0x45, 0x53, 0x7d, 0x35, 0x9d, 0x93, 0x03, 0x58,
+	0xcd, 0x1e, 0xfe, 0xb8, 0x13, 0xbf, 0x9c, 0x96, 0x34, 0xc1, 0x49, 0x7e, 0x47, 0x64, 0x51, 0x7e,
+	0xfe, 0xa9, 0x32, 0x88, 0x2d, 0xca, 0x33, 0x10, 0xc3, 0x26, 0xfb, 0x0b, 0xd8, 0xe0, 0x41, 0x89,
+	0x07, 0x9b, 0xc6, 0xac, 0xa2, 0x6c, 0x18, 0x86, 0xc8, 0x3c, 0x0c, 0xd3, 0x35, 0x69, 0xcd, 0x52,
+	0x93, 0x3f, 0xc8, 0x73, 0x64, 0xd2, 0x3e, 0x77, 0x49, 0xc6, 0x31, 0x09, 0x32, 0x31, 0x29, 0xcd,
+	0x12, 0x93, 0xd4, 0x26, 0xfb, 0x3b, 0x04, 0xb7, 0x35, 0xb3, 0x94, 0x83, 0xed, 0xb1, 0x36, 0xa9,
+	0x14, 0x4d, 0xab, 0xc5, 0x3c, 0xa8, 0x02, 0x65, 0x40, 0x16, 0x89, 0x55, 0x08, 0xad, 0x59, 0x6d,
+	0x4a, 0x62, 0x77, 0xc2, 0x42, 0xa7, 0x17, 0xc7, 0x4e, 0x2c, 0xc4, 0x2d, 0x29, 0x2d, 0x44, 0x95,
+	0xe0, 0x0a, 0xc9, 0x03, 0xb6, 0x0b, 0xaf, 0x99, 0x9b, 0x46, 0x7b, 0x2c, 0x7b, 0x64, 0x1c, 0x81,
+	0x1b, 0x00, 0xef, 0x0c, 0x59, 0x5f, 0xf5, 0x52, 0xa9, 0x51, 0xa3, 0xe0, 0x26, 0x5c, 0x3a, 0x61,
+	0x0a, 0x95, 0x69, 0x9b, 0xac, 0xed, 0x11, 0xdc, 0xba, 0x48, 0x89, 0x72, 0xa9, 0xa0, 0xef, 0xa0,
+	0x85, 0xfa, 0xce, 0x2f, 0xc6, 0x34, 0x53, 0xae, 0x55, 0x9b, 0x01, 0xc4, 0xf1, 0xbb, 0x54, 0x3b,
+	0x0c, 0x91, 0x4b, 0xfa, 0xd4, 0x90, 0x9e, 0x66, 0xc9, 0x51, 0xff, 0x3f, 0x61, 0x3a, 0x9f, 0xf4,
+	0x39, 0x4d, 0x5c, 0xa4, 0x8d, 0xff, 0x84, 0x4c, 0x75, 0x60, 0x36, 0xfa, 0xff, 0x3c, 0x43, 0x5f,
+	0x23, 0x78, 0x3d, 0x4a, 0x6a, 0x52, 0x9e, 0xdc, 0xc4, 0x9c, 0xd5, 0x71, 0x60, 0x8d, 0x45, 0x89,
+	0x0a, 0x8a, 0x72, 0x91, 0xd3, 0xf3, 0x25, 0x34, 0x0b, 0x6f, 0x9d, 0x01, 0xfe, 0x78, 0x1a, 0xaa,
+	0xaa, 0x6b, 0xcb, 0xe8, 0xbf, 0xc6, 0x48, 0xa6, 0xc8, 0xb0, 0xff, 0xb0, 0x60, 0x67, 0x96, 0x70,
+	0x4c, 0x3d, 0xba, 0xdf, 0xa2, 0xa9, 0x76, 0xca, 0x39, 0xf2, 0xa1, 0xb2, 0x73, 0x76, 0x6d, 0xc5,
+	0x2e, 0xa9, 0xeb, 0xff, 0x14, 0xa5, 0xcd, 0x01, 0x6c, 0x5e, 0xb0, 0xdd, 0xf0, 0x3c, 0xb8, 0xaf,
+	0x3f, 0x0f, 0x96, 0xf7, 0x5e, 0xbd, 0x28, 0xb4, 0x81, 0xfe, 0x82, 0xf8, 0x0b, 0xc1, 0x5a, 0x9a,
+	0x93, 0xf0, 0xe8, 0x88, 0xf1, 0x8a, 0xc1, 0x3a, 0xda, 0x17, 0x8a, 0xe4, 0x83, 0x8e, 0xaf, 0x4c,
+	0x03, 0xcc, 0x32, 0x0f, 0x30, 0xe3, 0xfb, 0xb1, 0x54, 0xf4, 0x7e, 0x4c, 0xbd, 0xe0, 0xca, 0x53,
+	0x5e, 0x70, 0x95, 0xd4, 0x0b, 0x2e, 0x7b, 0xeb, 0xac, 0x4e, 0xbb, 0x75, 0x2e, 0xa5, 0x6e, 0x9d,
+	0xdf, 0x20, 0xd8, 0x38, 0x66, 0x41, 0x18, 0xdd, 0x57, 0xdc, 0x33, 0xda, 0x49, 0xfc, 0x4f, 0xda,
+	0xd3, 0x7f, 0xf9, 0x8c, 0xb0, 0xff, 0xe4, 0xe3, 0xbb, 0xc0, 0x20, 0x55, 0xc7, 0xb3, 0x5b, 0xd4,
+	0x82, 0x7a, 0x12, 0xdc, 0x67, 0x49, 0xcf, 0x2c, 0x8b, 0x44, 0xe6, 0xb0, 0x39, 0x93, 0xf5, 0x00,
+	0x1a, 0x71, 0xb6, 0x73, 0xef, 0x8a, 0xb2, 0x30, 0xa8, 0x10, 0xdf, 0xf9, 0x15, 0x41, 0xa3, 0xe8,
+	0x42, 0x8e, 0xaf, 0xc3, 0x7a, 0x1e, 0x53, 0x94, 0xfa, 0x0b, 0x7c, 0x2a, 0x36, 0xf3, 0xf0, 0xc1,
+	0x39, 0x75, 0x47, 0x21, 0xed, 0xd4, 0x11, 0x9f, 0x8a, 0x6b, 0x79, 0xfc, 0x09, 0xfb, 0x60, 0x50,
+	0xb7, 0xcc, 0xa2, 0x0f, 0xce, 0x07, 0xde, 0x90, 0x6f, 0x2d, 0xe1, 0x4d, 0x78, 0x25, 0x0f, 0x3f,
+	0x72, 0x7c, 0x97, 0xf6, 0x7a, 0x9c, 0xa1, 0xdc, 0xde, 0xf9, 0x68, 0xbb, 0xeb, 0x85, 0x67, 0xa3,
+	0xd3, 0x96, 0xcb, 0xfa, 0xbb, 0x9f, 0x33, 0x76, 0xea, 0xca, 0xcf, 0xdb, 0x2e, 0x1b, 0xd2, 0x5d,
+	0x4e, 0xec, 0x33, 0x7f, 0x57, 0x9c, 0xbc, 0xd3, 0xaa, 0xf8, 0x63, 0xe5, 0xee, 0xbf, 0x88, 0xc9,
+	0x03, 0x70, 0x95, 0x11, 0x00, 0x00,
 }
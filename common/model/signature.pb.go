@@ -83,6 +83,10 @@ const (
 	SignatureType_EstoniaEidSignature SignatureType = 3
 	// in bytes: []byte{4,0,0,0}, eth uses ECDSA signing algorithm
 	SignatureType_EthereumSignature SignatureType = 4
+	// in bytes: []byte{5,0,0,0}, webauthn assertion signed by a P-256 (secp256r1) authenticator key
+	SignatureType_WebAuthnSignature SignatureType = 5
+	// in bytes: []byte{6,0,0,0}, bip-340 schnorr signature of a BTC account x-only public key
+	SignatureType_BitcoinSchnorrSignature SignatureType = 6
 )
 
 var SignatureType_name = map[int32]string{
@@ -91,14 +95,18 @@ var SignatureType_name = map[int32]string{
 	2: "MultisigSignature",
 	3: "EstoniaEidSignature",
 	4: "EthereumSignature",
+	5: "WebAuthnSignature",
+	6: "BitcoinSchnorrSignature",
 }
 
 var SignatureType_value = map[string]int32{
-	"DefaultSignature":    0,
-	"BitcoinSignature":    1,
-	"MultisigSignature":   2,
-	"EstoniaEidSignature": 3,
-	"EthereumSignature":   4,
+	"DefaultSignature":        0,
+	"BitcoinSignature":        1,
+	"MultisigSignature":       2,
+	"EstoniaEidSignature":     3,
+	"EthereumSignature":       4,
+	"WebAuthnSignature":       5,
+	"BitcoinSchnorrSignature": 6,
 }
 
 func (x SignatureType) String() string {
@@ -148,16 +156,20 @@ type BitcoinPublicKeyFormat int32
 const (
 	BitcoinPublicKeyFormat_PublicKeyFormatUncompressed BitcoinPublicKeyFormat = 0
 	BitcoinPublicKeyFormat_PublicKeyFormatCompressed   BitcoinPublicKeyFormat = 1
+	// bip-340 x-only public key, the x coordinate of the even y point
+	BitcoinPublicKeyFormat_PublicKeyFormatXOnly BitcoinPublicKeyFormat = 2
 )
 
 var BitcoinPublicKeyFormat_name = map[int32]string{
 	0: "PublicKeyFormatUncompressed",
 	1: "PublicKeyFormatCompressed",
+	2: "PublicKeyFormatXOnly",
 }
 
 var BitcoinPublicKeyFormat_value = map[string]int32{
 	"PublicKeyFormatUncompressed": 0,
 	"PublicKeyFormatCompressed":   1,
+	"PublicKeyFormatXOnly":        2,
 }
 
 func (x BitcoinPublicKeyFormat) String() string {
@@ -179,23 +191,24 @@ func init() {
 }
 
 var fileDescriptor_a69ee5fbbdd37ed5 = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0xd1, 0x4f, 0x4b, 0xf3, 0x40,
-	0x10, 0x06, 0xf0, 0xf6, 0x7d, 0xd5, 0xc3, 0x82, 0x10, 0xa3, 0x55, 0x44, 0x44, 0x8f, 0x12, 0xb0,
-	0xd1, 0xd6, 0x8a, 0x47, 0x89, 0x56, 0x90, 0x2a, 0x14, 0x54, 0x10, 0x6f, 0x9b, 0xed, 0x98, 0x0e,
-	0x64, 0x77, 0xca, 0xee, 0x6c, 0x21, 0x1e, 0xfd, 0xe4, 0x92, 0xf8, 0x67, 0x49, 0x2f, 0x7b, 0xf8,
-	0x3d, 0x0f, 0x2c, 0x33, 0x23, 0x7a, 0x9a, 0x66, 0x50, 0xa6, 0x0e, 0x0b, 0x23, 0xd9, 0x5b, 0xe8,
-	0x2f, 0x2c, 0x31, 0xc5, 0xeb, 0x0d, 0x27, 0x9f, 0x5d, 0xb1, 0xf9, 0xf4, 0x1b, 0x3d, 0x57, 0x0b,
-	0x88, 0x77, 0x44, 0x74, 0x0b, 0xef, 0xd2, 0x97, 0xfc, 0xe7, 0x51, 0xa7, 0xd6, 0x0c, 0x59, 0x11,
-	0x9a, 0xa0, 0xdd, 0xb8, 0x27, 0xb6, 0x1e, 0x7d, 0xc9, 0xe8, 0xb0, 0x08, 0xfc, 0x2f, 0xde, 0x13,
-	0xdb, 0x63, 0xc7, 0x64, 0x50, 0x8e, 0x71, 0x16, 0x82, 0xff, 0x75, 0x7f, 0xcc, 0x73, 0xb0, 0xe0,
-	0x75, 0xe0, 0xb5, 0xc4, 0x89, 0xde, 0xd4, 0xe2, 0x52, 0x32, 0x4c, 0xa0, 0xca, 0x2a, 0x06, 0xf7,
-	0x00, 0xa6, 0xe0, 0x79, 0xdd, 0x0f, 0xc1, 0xbd, 0x59, 0xca, 0x12, 0x67, 0x51, 0xa7, 0xcd, 0x83,
-	0xd1, 0x65, 0x86, 0xec, 0xa2, 0xe3, 0x36, 0x0f, 0xaf, 0x2e, 0x1a, 0x3e, 0x6b, 0xf3, 0xe8, 0x7c,
-	0xd0, 0xf0, 0x75, 0xf2, 0x2a, 0x76, 0x7f, 0x26, 0x9a, 0xfa, 0xbc, 0x44, 0x35, 0x81, 0xea, 0x8e,
-	0xac, 0x96, 0x1c, 0x1f, 0x89, 0x83, 0x15, 0x7a, 0x31, 0x8a, 0xf4, 0xc2, 0x82, 0x73, 0x50, 0xff,
-	0x7f, 0x28, 0xf6, 0x57, 0x0a, 0x37, 0x21, 0xee, 0x66, 0xc9, 0xdb, 0x49, 0x81, 0x3c, 0xf7, 0x79,
-	0x5f, 0x91, 0x4e, 0x3f, 0x88, 0x72, 0xf5, 0xfd, 0x9e, 0x2a, 0xb2, 0x90, 0x2a, 0xd2, 0x9a, 0x4c,
-	0xda, 0xec, 0x3f, 0xdf, 0x68, 0xae, 0x31, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x52, 0x4f, 0x49,
-	0xf9, 0xa6, 0x01, 0x00, 0x00,
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5d, 0xd1, 0x5b, 0x4b, 0xc3, 0x30,
+	0x18, 0x06, 0x60, 0x3b, 0xdd, 0x2e, 0x02, 0x42, 0x8d, 0xab, 0x53, 0x86, 0xe8, 0xa5, 0x14, 0x5c,
+	0x75, 0x07, 0xf1, 0x52, 0xab, 0x15, 0x44, 0xc5, 0x81, 0x8a, 0xe2, 0x5d, 0x0f, 0x9f, 0x6b, 0xa0,
+	0x4d, 0x4a, 0x0e, 0x83, 0xfa, 0xc3, 0xfc, 0x7d, 0xa6, 0xd5, 0x19, 0xdb, 0x9b, 0x5c, 0x3c, 0xef,
+	0xc7, 0x9b, 0x84, 0x0f, 0x39, 0x39, 0x4b, 0x20, 0xf3, 0x04, 0x59, 0xd0, 0x50, 0x2a, 0x0e, 0xa3,
+	0x82, 0x33, 0xc9, 0x70, 0xb7, 0x66, 0xf7, 0xcb, 0x42, 0x9b, 0x4f, 0xab, 0xe8, 0xb9, 0x2c, 0x00,
+	0xf7, 0x91, 0x7d, 0x0d, 0x1f, 0xa1, 0xca, 0xe4, 0x9f, 0xdb, 0x6b, 0x95, 0xfa, 0x44, 0xc6, 0x8c,
+	0x50, 0xa3, 0x16, 0x76, 0xd0, 0xd6, 0x83, 0x1e, 0x24, 0xba, 0xdc, 0x70, 0x07, 0x0f, 0xd0, 0x76,
+	0x20, 0x24, 0xa3, 0x24, 0x0c, 0x48, 0x62, 0x82, 0xf5, 0x6a, 0x3e, 0x90, 0x29, 0x70, 0x50, 0xb9,
+	0xe1, 0x8d, 0x8a, 0x5f, 0x21, 0xba, 0x54, 0x32, 0xfd, 0xd7, 0xde, 0xc5, 0x43, 0x34, 0x58, 0xdd,
+	0x19, 0xa7, 0x94, 0x71, 0x6e, 0xc2, 0x9e, 0x2b, 0x90, 0x33, 0xe7, 0x64, 0x19, 0x4a, 0xb8, 0x83,
+	0xd2, 0x2f, 0x25, 0x88, 0x7b, 0xa0, 0x0b, 0x99, 0x56, 0x65, 0x26, 0xb8, 0xa5, 0xcb, 0x30, 0x23,
+	0x89, 0xfe, 0x40, 0x83, 0xc7, 0xb3, 0x33, 0xdd, 0x2c, 0xec, 0xc3, 0x26, 0x4f, 0xce, 0xa7, 0x35,
+	0x9f, 0x34, 0x79, 0x76, 0x3a, 0xae, 0xf9, 0xc2, 0xe5, 0x68, 0xe7, 0xf7, 0x45, 0x73, 0x15, 0x65,
+	0x24, 0xd6, 0xe1, 0x0d, 0xe3, 0x79, 0x28, 0xf1, 0x01, 0x1a, 0xb6, 0xe8, 0x85, 0xc6, 0x2c, 0x2f,
+	0x38, 0x08, 0x01, 0xd5, 0xfd, 0xfb, 0x68, 0xaf, 0x35, 0x70, 0x65, 0x62, 0x0b, 0xef, 0xa2, 0x7e,
+	0x2b, 0x7e, 0x7b, 0xa4, 0x59, 0x69, 0x77, 0x7c, 0xf7, 0xfd, 0x68, 0x41, 0x64, 0xaa, 0xa2, 0x91,
+	0xee, 0xf3, 0x3e, 0x19, 0x8b, 0xe2, 0x9f, 0xf3, 0x38, 0x66, 0x1c, 0x3c, 0x8d, 0x39, 0xa3, 0x5e,
+	0xbd, 0xcd, 0xa8, 0x57, 0xef, 0x76, 0xf2, 0x0d, 0x89, 0xff, 0x4c, 0xb0, 0xf4, 0x01, 0x00, 0x00,
 }
@@ -168,6 +168,10 @@ type SnapshotPayload struct {
 	LiquidPayment              []*LiquidPayment             `protobuf:"bytes,15,rep,name=LiquidPayment,proto3" json:"LiquidPayment,omitempty"`
 	NodeAdmissionTimestamp     []*NodeAdmissionTimestamp    `protobuf:"bytes,16,rep,name=NodeAdmissionTimestamp,proto3" json:"NodeAdmissionTimestamp,omitempty"`
 	MultiSignatureParticipants []*MultiSignatureParticipant `protobuf:"bytes,17,rep,name=MultiSignatureParticipants,proto3" json:"MultiSignatureParticipants,omitempty"`
+	LockedFunds                []*LockedFund                `protobuf:"bytes,18,rep,name=LockedFunds,proto3" json:"LockedFunds,omitempty"`
+	Htlcs                      []*Htlc                      `protobuf:"bytes,19,rep,name=Htlcs,proto3" json:"Htlcs,omitempty"`
+	MultiSignatureRotations    []*MultiSignatureRotation    `protobuf:"bytes,20,rep,name=MultiSignatureRotations,proto3" json:"MultiSignatureRotations,omitempty"`
+	AccountDatasetSchemas      []*AccountDatasetSchema      `protobuf:"bytes,21,rep,name=AccountDatasetSchemas,proto3" json:"AccountDatasetSchemas,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}                     `json:"-"`
 	XXX_unrecognized           []byte                       `json:"-"`
 	XXX_sizecache              int32                        `json:"-"`
@@ -317,6 +321,34 @@ func (m *SnapshotPayload) GetMultiSignatureParticipants() []*MultiSignatureParti
 	return nil
 }
 
+func (m *SnapshotPayload) GetLockedFunds() []*LockedFund {
+	if m != nil {
+		return m.LockedFunds
+	}
+	return nil
+}
+
+func (m *SnapshotPayload) GetHtlcs() []*Htlc {
+	if m != nil {
+		return m.Htlcs
+	}
+	return nil
+}
+
+func (m *SnapshotPayload) GetMultiSignatureRotations() []*MultiSignatureRotation {
+	if m != nil {
+		return m.MultiSignatureRotations
+	}
+	return nil
+}
+
+func (m *SnapshotPayload) GetAccountDatasetSchemas() []*AccountDatasetSchema {
+	if m != nil {
+		return m.AccountDatasetSchemas
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SnapshotFileInfo)(nil), "model.SnapshotFileInfo")
 	proto.RegisterType((*SnapshotPayload)(nil), "model.SnapshotPayload")
@@ -327,51 +359,56 @@ func init() {
 }
 
 var fileDescriptor_5d9d8140a8c06fc6 = []byte{
-	// 731 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x5f, 0x4f, 0xdb, 0x3a,
-	0x14, 0x57, 0x29, 0xed, 0x05, 0x43, 0x29, 0x35, 0x7f, 0xae, 0xa9, 0xe0, 0x2a, 0x42, 0xf7, 0xa1,
-	0x62, 0x5a, 0x2b, 0xb1, 0xb7, 0x49, 0xdb, 0x04, 0x8c, 0x8a, 0x69, 0x63, 0xaa, 0x5c, 0xb6, 0x87,
-	0x3d, 0xcd, 0x75, 0x4c, 0x63, 0x91, 0xd8, 0x59, 0xec, 0x6c, 0x63, 0x5f, 0x6c, 0x2f, 0xfb, 0x70,
-	0x53, 0x1c, 0xa7, 0xd4, 0x49, 0xe0, 0xa5, 0x52, 0xce, 0xef, 0x4f, 0xfc, 0x3b, 0xf1, 0x39, 0x05,
-	0xbb, 0x91, 0xf4, 0x59, 0x38, 0x52, 0x82, 0xc4, 0x2a, 0x90, 0x7a, 0x18, 0x27, 0x52, 0x4b, 0xd8,
-	0x32, 0xd5, 0xfe, 0x7f, 0x16, 0x8c, 0xb9, 0x60, 0xe7, 0xa1, 0xa4, 0x77, 0xd7, 0x44, 0xf0, 0x5b,
-	0xa6, 0x2c, 0xad, 0xdf, 0xcf, 0x71, 0x42, 0xa9, 0x4c, 0x85, 0x3e, 0x27, 0x21, 0x11, 0x94, 0x59,
-	0xec, 0x30, 0xc7, 0x84, 0xf4, 0x19, 0x66, 0x73, 0xae, 0x74, 0x42, 0x34, 0x97, 0xa2, 0x56, 0xf9,
-	0x96, 0x68, 0xa2, 0x58, 0xe1, 0x6a, 0xdf, 0x1a, 0x93, 0x44, 0x73, 0xca, 0x63, 0x23, 0x9b, 0x52,
-	0x99, 0x94, 0x9c, 0xe3, 0x74, 0x16, 0x72, 0x15, 0x30, 0x1f, 0x33, 0xca, 0x78, 0x5c, 0xa8, 0x61,
-	0x8e, 0x32, 0x45, 0x13, 0xf9, 0xc3, 0x7d, 0x5b, 0x94, 0x86, 0x9a, 0x4f, 0xf9, 0x5c, 0x10, 0x9d,
-	0x2e, 0xdc, 0x7a, 0x39, 0x36, 0xcb, 0xe2, 0xd9, 0xd2, 0x91, 0x8d, 0x7d, 0xc7, 0xe3, 0x98, 0xf9,
-	0x26, 0xb8, 0x8a, 0xb8, 0x0e, 0x2c, 0x6c, 0x5b, 0x76, 0xcb, 0xd8, 0x94, 0x92, 0xb0, 0xf0, 0xd9,
-	0x59, 0x54, 0x3f, 0x4b, 0x5d, 0x14, 0x0f, 0xf2, 0x62, 0xc8, 0xbf, 0xa5, 0xdc, 0x9f, 0x90, 0xfb,
-	0x88, 0x09, 0x7b, 0xce, 0xe3, 0xdf, 0x2b, 0x60, 0x7b, 0x6a, 0xbb, 0x3e, 0xe6, 0x21, 0x7b, 0x27,
-	0x6e, 0x25, 0x3c, 0x71, 0x6b, 0x57, 0x44, 0x05, 0xa8, 0xe1, 0x35, 0x06, 0x9b, 0xb8, 0x52, 0x87,
-	0xfb, 0xa0, 0x7d, 0xc5, 0xf8, 0x3c, 0xd0, 0x68, 0xc5, 0x6b, 0x0c, 0x3a, 0xd8, 0x3e, 0xc1, 0xd7,
-	0xa0, 0x3f, 0x49, 0x24, 0x65, 0x4a, 0x5d, 0xfe, 0x8c, 0x79, 0xde, 0xf5, 0x1b, 0x1e, 0x31, 0xa5,
-	0x49, 0x14, 0xa3, 0xa6, 0xd7, 0x18, 0x34, 0xf1, 0x13, 0x0c, 0x78, 0x08, 0xd6, 0x2f, 0x02, 0xc2,
-	0xc5, 0xcd, 0x7d, 0xcc, 0xd0, 0xaa, 0xd7, 0x18, 0xb4, 0xf0, 0x43, 0x01, 0x7e, 0x02, 0xfb, 0xd3,
-	0xca, 0x75, 0x30, 0xd4, 0x96, 0xd7, 0x18, 0x6c, 0x9d, 0x1e, 0x0d, 0x4d, 0xe4, 0x61, 0x3d, 0x09,
-	0x3f, 0x22, 0xce, 0x82, 0x67, 0xc1, 0x2e, 0x82, 0x54, 0xdc, 0xa9, 0x2c, 0x1e, 0x53, 0xa8, 0xed,
-	0x35, 0xb3, 0xe0, 0xe5, 0xfa, 0xf1, 0x9f, 0x75, 0xd0, 0x2d, 0xba, 0x31, 0x21, 0xf7, 0xa1, 0x24,
-	0x3e, 0xfc, 0x1f, 0xb4, 0xf3, 0xef, 0x84, 0x1a, 0x5e, 0x73, 0xb0, 0x71, 0xba, 0x69, 0x8f, 0x61,
-	0x8a, 0xd8, 0x62, 0xf0, 0x0d, 0xe8, 0x9e, 0x39, 0x77, 0x55, 0xa1, 0x15, 0x43, 0xdf, 0xb3, 0x74,
-	0x17, 0xc5, 0x65, 0x36, 0xbc, 0x04, 0xbd, 0x8f, 0xa5, 0x0b, 0xad, 0x50, 0xd3, 0x58, 0xfc, 0x6b,
-	0x2d, 0xca, 0x38, 0xae, 0x2a, 0x96, 0xce, 0x61, 0x6f, 0xbe, 0x42, 0xab, 0x75, 0xe7, 0xb0, 0x28,
-	0x2e, 0xb3, 0xe1, 0x7b, 0xb0, 0x33, 0xa9, 0x8c, 0x87, 0x42, 0x2d, 0x63, 0x72, 0x60, 0x4d, 0xaa,
-	0x0c, 0x5c, 0xa7, 0xca, 0x42, 0x4d, 0x4a, 0xb3, 0x94, 0x37, 0xff, 0x21, 0x54, 0x19, 0xc7, 0x55,
-	0x05, 0x7c, 0x05, 0xe0, 0xa5, 0x19, 0xba, 0x9b, 0x84, 0x08, 0x45, 0x68, 0xde, 0x9c, 0x7f, 0x8c,
-	0x4f, 0xc7, 0xfa, 0xe4, 0x04, 0x5c, 0x43, 0x34, 0x91, 0x98, 0xf0, 0xb9, 0x98, 0x3b, 0xfa, 0x35,
-	0x37, 0x52, 0x85, 0x81, 0xeb, 0x54, 0x26, 0x52, 0x5e, 0x5e, 0x8c, 0xbb, 0x42, 0xeb, 0x6e, 0xa4,
-	0x12, 0x8e, 0xab, 0x8a, 0xec, 0x4c, 0xd7, 0xce, 0xce, 0xc8, 0x86, 0x54, 0x21, 0xe0, 0x9c, 0xa9,
-	0xca, 0xc0, 0x75, 0x2a, 0x78, 0x05, 0xe0, 0xb4, 0xbc, 0x51, 0x14, 0xda, 0x30, 0x5e, 0xa8, 0x98,
-	0x9a, 0x32, 0x01, 0xd7, 0x68, 0xe0, 0x33, 0xb0, 0x36, 0xb6, 0xcb, 0x07, 0x6d, 0x1a, 0x7d, 0xd7,
-	0xea, 0x8b, 0x32, 0x5e, 0x10, 0x20, 0x06, 0x7b, 0xe3, 0x7c, 0x27, 0x5d, 0xc8, 0x28, 0xe2, 0x3a,
-	0x5b, 0x41, 0xd9, 0x13, 0xea, 0x18, 0xe5, 0xe1, 0x83, 0xb2, 0xca, 0xc1, 0xf5, 0x52, 0x38, 0x06,
-	0x3d, 0x0b, 0x60, 0xf6, 0x9d, 0x91, 0xd0, 0xf8, 0x6d, 0x39, 0x49, 0x2a, 0x38, 0xae, 0x4a, 0xe0,
-	0x4b, 0xd0, 0xf9, 0xb0, 0xbc, 0x1a, 0x51, 0xd7, 0x78, 0xec, 0x5a, 0x0f, 0x07, 0xc3, 0x2e, 0x35,
-	0x5b, 0x44, 0xd9, 0x60, 0x9d, 0xf9, 0x11, 0x57, 0xca, 0x59, 0x71, 0xdb, 0xc6, 0xe4, 0x68, 0x69,
-	0x1e, 0xab, 0x24, 0xfc, 0x88, 0x18, 0x7e, 0x05, 0x7d, 0xf7, 0xe3, 0x2d, 0x26, 0x46, 0x68, 0x85,
-	0x7a, 0xc6, 0xda, 0xab, 0xfd, 0xf2, 0x4b, 0x44, 0xfc, 0x84, 0xc7, 0xf9, 0xc9, 0x97, 0xc1, 0x9c,
-	0xeb, 0x20, 0x9d, 0x0d, 0xa9, 0x8c, 0x46, 0xbf, 0xa4, 0x9c, 0xd1, 0xfc, 0xf7, 0x79, 0x36, 0x8e,
-	0x23, 0x2a, 0xa3, 0x48, 0x8a, 0x91, 0x79, 0xc3, 0xac, 0x6d, 0xfe, 0x2b, 0x5e, 0xfc, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0x85, 0x99, 0x5e, 0x0f, 0xa6, 0x07, 0x00, 0x00,
+	// 813 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7d, 0x55, 0xed, 0x4f, 0xd3, 0x40,
+	0x18, 0x0f, 0xe0, 0x26, 0xdc, 0x18, 0xb0, 0x83, 0x41, 0x99, 0x60, 0x90, 0xf8, 0x81, 0x60, 0xdc,
+	0x12, 0xf8, 0x66, 0xa2, 0x06, 0x90, 0x65, 0x46, 0x30, 0xf3, 0x86, 0x9a, 0xf8, 0xc9, 0x5b, 0x7b,
+	0xac, 0x17, 0xda, 0xbb, 0xba, 0xeb, 0x54, 0xfc, 0xc7, 0xfc, 0xea, 0x9f, 0xe6, 0xbd, 0x75, 0xec,
+	0xda, 0xc2, 0x97, 0x25, 0xfb, 0xbd, 0xf5, 0x9e, 0xe7, 0xfa, 0x3c, 0x05, 0x1b, 0x31, 0x0f, 0x48,
+	0xd4, 0x11, 0x0c, 0x27, 0x22, 0xe4, 0x69, 0x3b, 0x19, 0xf3, 0x94, 0xc3, 0x8a, 0x46, 0x5b, 0x4f,
+	0x2d, 0x99, 0x50, 0x46, 0x4e, 0x23, 0xee, 0xdf, 0x5c, 0x62, 0x46, 0xaf, 0x89, 0xb0, 0xb2, 0x56,
+	0xcb, 0xf0, 0xd8, 0xf7, 0xf9, 0x84, 0xa5, 0xa7, 0x38, 0xc2, 0xcc, 0x27, 0x96, 0xdb, 0x31, 0x1c,
+	0x93, 0xbf, 0x88, 0x8c, 0xa8, 0x48, 0xc7, 0x38, 0xa5, 0x9c, 0x95, 0x3a, 0xdf, 0xe1, 0x14, 0x0b,
+	0x92, 0xa5, 0xda, 0xa7, 0x26, 0x78, 0x9c, 0x52, 0x9f, 0x26, 0xda, 0x36, 0xf0, 0xf9, 0x38, 0x97,
+	0x9c, 0x4c, 0x86, 0x11, 0x15, 0x21, 0x09, 0x10, 0xf1, 0x09, 0x4d, 0x32, 0x37, 0x34, 0x2c, 0x11,
+	0xfe, 0x98, 0xff, 0x72, 0x9f, 0x16, 0x4f, 0xa2, 0x94, 0x0e, 0xe8, 0x88, 0xe1, 0x74, 0x32, 0x4d,
+	0x6b, 0x18, 0x6e, 0xa8, 0xca, 0xb3, 0xd0, 0xae, 0x2d, 0xfb, 0x86, 0x26, 0x09, 0x09, 0x74, 0xe1,
+	0x22, 0xa6, 0x69, 0x68, 0x69, 0xdb, 0xb2, 0x6b, 0x42, 0x06, 0x3e, 0x8e, 0xb2, 0x9c, 0xf5, 0x29,
+	0xfa, 0x85, 0xa7, 0x19, 0xb8, 0x6d, 0xc0, 0x88, 0xfe, 0x98, 0xd0, 0xa0, 0x8f, 0x6f, 0x63, 0xc2,
+	0xb2, 0x73, 0x6e, 0x5a, 0x4a, 0x86, 0x93, 0xa0, 0x3b, 0x61, 0x81, 0xc5, 0xd7, 0x0c, 0x1e, 0xa6,
+	0x91, 0x6f, 0x90, 0xfd, 0xbf, 0xf3, 0x60, 0x6d, 0x60, 0xef, 0xa7, 0x4b, 0x23, 0xf2, 0x9e, 0x5d,
+	0x73, 0x78, 0xe8, 0x62, 0x3d, 0x2c, 0x42, 0x6f, 0x6e, 0x6f, 0xee, 0x60, 0x19, 0x15, 0x70, 0xb8,
+	0x09, 0xaa, 0x3d, 0x42, 0x47, 0x61, 0xea, 0xcd, 0x4b, 0x45, 0x1d, 0xd9, 0x7f, 0xf0, 0x0d, 0x68,
+	0xf5, 0xc7, 0xdc, 0x27, 0x42, 0x9c, 0xff, 0x4e, 0xa8, 0xb9, 0x9f, 0x2b, 0x1a, 0xcb, 0xfb, 0xc5,
+	0x71, 0xe2, 0x2d, 0x48, 0xed, 0x02, 0x7a, 0x40, 0x01, 0x77, 0xc0, 0xd2, 0x59, 0x88, 0x29, 0xbb,
+	0xba, 0x4d, 0x88, 0xf7, 0x48, 0xca, 0x2b, 0xe8, 0x0e, 0x80, 0x9f, 0xc1, 0xe6, 0xa0, 0xf0, 0xe2,
+	0x68, 0x69, 0x45, 0x4a, 0x57, 0x8e, 0x76, 0xdb, 0xba, 0xd2, 0x76, 0xb9, 0x08, 0xdd, 0x63, 0x56,
+	0x85, 0xab, 0xc2, 0xce, 0xc2, 0x09, 0xbb, 0x11, 0xaa, 0x3c, 0x22, 0xbc, 0xea, 0xde, 0x82, 0x2a,
+	0x3c, 0x8f, 0xef, 0xff, 0xab, 0x81, 0xd5, 0xac, 0x1b, 0xb2, 0xfb, 0x11, 0xc7, 0x01, 0x7c, 0x0e,
+	0xaa, 0xe6, 0x46, 0x65, 0xbb, 0x16, 0x0e, 0x6a, 0x47, 0xcb, 0xf6, 0x18, 0x1a, 0x44, 0x96, 0x83,
+	0x6f, 0xc1, 0xea, 0x89, 0xf3, 0x56, 0x0b, 0xd9, 0x3b, 0x25, 0x6f, 0x5a, 0xb9, 0xcb, 0xa2, 0xbc,
+	0x1a, 0x9e, 0x83, 0xc6, 0xc7, 0xdc, 0xab, 0x2f, 0x64, 0x4b, 0x55, 0xc4, 0x96, 0x8d, 0xc8, 0xf3,
+	0xa8, 0xe8, 0x98, 0x39, 0x87, 0x9d, 0x11, 0x21, 0x1b, 0x5d, 0x72, 0x0e, 0xcb, 0xa2, 0xbc, 0x1a,
+	0x7e, 0x00, 0xeb, 0xfd, 0xc2, 0x20, 0x09, 0x79, 0x05, 0x2a, 0x64, 0xdb, 0x86, 0x14, 0x15, 0xa8,
+	0xcc, 0xa5, 0x8a, 0xea, 0xe7, 0xa6, 0xce, 0x34, 0xff, 0xae, 0xa8, 0x3c, 0x8f, 0x8a, 0x0e, 0xf8,
+	0x1a, 0xc0, 0x73, 0x3d, 0x9e, 0x57, 0x63, 0xcc, 0x04, 0xf6, 0x4d, 0x73, 0x1e, 0xeb, 0x9c, 0xba,
+	0xcd, 0x31, 0x02, 0x54, 0x22, 0xd4, 0x25, 0x11, 0x16, 0x50, 0x36, 0x72, 0xfc, 0x8b, 0x6e, 0x49,
+	0x05, 0x05, 0x2a, 0x73, 0xe9, 0x92, 0x0c, 0x3c, 0x5d, 0x0c, 0xc2, 0x5b, 0x72, 0x4b, 0xca, 0xf1,
+	0xa8, 0xe8, 0x50, 0x67, 0xba, 0x74, 0xb6, 0x8b, 0x1a, 0x52, 0xe1, 0x01, 0xe7, 0x4c, 0x45, 0x05,
+	0x2a, 0x73, 0xc1, 0x1e, 0x80, 0x83, 0xfc, 0xee, 0x11, 0x5e, 0x4d, 0x67, 0x79, 0xd9, 0xd4, 0xe4,
+	0x05, 0xa8, 0xc4, 0x03, 0x5f, 0x80, 0xc5, 0xae, 0x5d, 0x53, 0xde, 0xb2, 0xf6, 0xaf, 0x5a, 0x7f,
+	0x06, 0xa3, 0xa9, 0x00, 0x22, 0xd0, 0xec, 0x9a, 0xed, 0x75, 0xc6, 0x63, 0xe9, 0x57, 0xcb, 0x4a,
+	0xfd, 0xf3, 0xea, 0xda, 0xb9, 0x73, 0xe7, 0x2c, 0x6a, 0x50, 0xb9, 0x15, 0x76, 0x41, 0xc3, 0x12,
+	0x88, 0xfc, 0x24, 0x38, 0xd2, 0x79, 0x2b, 0x4e, 0x25, 0x05, 0x1e, 0x15, 0x2d, 0xf0, 0x15, 0xa8,
+	0x5f, 0xcc, 0x2e, 0x51, 0x6f, 0x55, 0x67, 0x6c, 0xd8, 0x0c, 0x87, 0x43, 0xae, 0x54, 0x2d, 0x22,
+	0x35, 0x58, 0x27, 0x41, 0x4c, 0x85, 0x70, 0x56, 0xdc, 0x9a, 0x0e, 0xd9, 0x9d, 0x99, 0xc7, 0xa2,
+	0x08, 0xdd, 0x63, 0x86, 0xdf, 0x41, 0xcb, 0xbd, 0xbc, 0xe9, 0xc4, 0x30, 0x39, 0x15, 0x0d, 0x1d,
+	0xbd, 0x57, 0x7a, 0xf3, 0x33, 0x42, 0xf4, 0x40, 0x06, 0x3c, 0x06, 0xb5, 0x8b, 0xe9, 0xe7, 0x41,
+	0x78, 0x50, 0x47, 0x36, 0xb2, 0x92, 0xa7, 0x0c, 0x9a, 0x55, 0xc1, 0x67, 0xa0, 0xd2, 0x93, 0xdf,
+	0x0e, 0xe1, 0xad, 0x6b, 0x79, 0xcd, 0xca, 0x15, 0x86, 0x0c, 0x03, 0xbf, 0x82, 0x2d, 0xf7, 0xa9,
+	0x88, 0xa7, 0x76, 0x43, 0x6d, 0x38, 0x1d, 0x29, 0x57, 0xa1, 0xfb, 0xdc, 0xf0, 0x13, 0x68, 0xba,
+	0xfb, 0x67, 0xe0, 0x87, 0x24, 0xc6, 0xc2, 0x6b, 0xea, 0xd8, 0x27, 0xa5, 0x3b, 0xcb, 0x68, 0x50,
+	0xb9, 0xf3, 0xf4, 0xf0, 0xdb, 0xc1, 0x48, 0xbe, 0xca, 0x93, 0x61, 0xdb, 0xe7, 0x71, 0xe7, 0x0f,
+	0xe7, 0x43, 0xdf, 0xfc, 0xbe, 0x54, 0x2b, 0xa9, 0x23, 0xc1, 0x98, 0xb3, 0x8e, 0xce, 0x1d, 0x56,
+	0xf5, 0xf7, 0xf2, 0xf8, 0x3f, 0x34, 0x25, 0x44, 0x23, 0xd4, 0x08, 0x00, 0x00,
 }
@@ -100,6 +100,24 @@ const (
 	TransactionType_FeeVoteCommitmentVoteTransaction TransactionType = 7
 	// in bytes: []byte{7,1,0,0}
 	TransactionType_FeeVoteRevealVoteTransaction TransactionType = 263
+	// in bytes: []byte{1,1,0,0}
+	TransactionType_TimeLockedSendZBCTransaction TransactionType = 257
+	// in bytes: []byte{1,2,0,0}
+	TransactionType_MultiSendZBCTransaction TransactionType = 513
+	// in bytes: []byte{8,0,0,0}
+	TransactionType_HtlcLockTransaction TransactionType = 8
+	// in bytes: []byte{8,1,0,0}
+	TransactionType_HtlcClaimTransaction TransactionType = 264
+	// in bytes: []byte{8,2,0,0}
+	TransactionType_HtlcRefundTransaction TransactionType = 520
+	// in bytes: []byte{6,2,0,0}
+	TransactionType_LiquidPaymentWithdrawTransaction TransactionType = 518
+	// in bytes: []byte{5,1,0,0}
+	TransactionType_MultiSignatureRotationTransaction TransactionType = 261
+	// in bytes: []byte{5,2,0,0}
+	TransactionType_MultiSignatureCancelTransaction TransactionType = 517
+	// in bytes: []byte{3,2,0,0}
+	TransactionType_RegisterAccountDatasetSchemaTransaction TransactionType = 515
 )
 
 var TransactionType_name = map[int32]string{
@@ -117,23 +135,41 @@ var TransactionType_name = map[int32]string{
 	262: "LiquidPaymentStopTransaction",
 	7:   "FeeVoteCommitmentVoteTransaction",
 	263: "FeeVoteRevealVoteTransaction",
+	257: "TimeLockedSendZBCTransaction",
+	513: "MultiSendZBCTransaction",
+	8:   "HtlcLockTransaction",
+	264: "HtlcClaimTransaction",
+	520: "HtlcRefundTransaction",
+	518: "LiquidPaymentWithdrawTransaction",
+	261: "MultiSignatureRotationTransaction",
+	517: "MultiSignatureCancelTransaction",
+	515: "RegisterAccountDatasetSchemaTransaction",
 }
 
 var TransactionType_value = map[string]int32{
-	"EmptyTransaction":                  0,
-	"SendZBCTransaction":                1,
-	"NodeRegistrationTransaction":       2,
-	"UpdateNodeRegistrationTransaction": 258,
-	"RemoveNodeRegistrationTransaction": 514,
-	"ClaimNodeRegistrationTransaction":  770,
-	"SetupAccountDatasetTransaction":    3,
-	"RemoveAccountDatasetTransaction":   259,
-	"ApprovalEscrowTransaction":         4,
-	"MultiSignatureTransaction":         5,
-	"LiquidPaymentTransaction":          6,
-	"LiquidPaymentStopTransaction":      262,
-	"FeeVoteCommitmentVoteTransaction":  7,
-	"FeeVoteRevealVoteTransaction":      263,
+	"EmptyTransaction":                        0,
+	"SendZBCTransaction":                      1,
+	"NodeRegistrationTransaction":             2,
+	"UpdateNodeRegistrationTransaction":       258,
+	"RemoveNodeRegistrationTransaction":       514,
+	"ClaimNodeRegistrationTransaction":        770,
+	"SetupAccountDatasetTransaction":          3,
+	"RemoveAccountDatasetTransaction":         259,
+	"ApprovalEscrowTransaction":               4,
+	"MultiSignatureTransaction":               5,
+	"LiquidPaymentTransaction":                6,
+	"LiquidPaymentStopTransaction":            262,
+	"FeeVoteCommitmentVoteTransaction":        7,
+	"FeeVoteRevealVoteTransaction":            263,
+	"TimeLockedSendZBCTransaction":            257,
+	"MultiSendZBCTransaction":                 513,
+	"HtlcLockTransaction":                     8,
+	"HtlcClaimTransaction":                    264,
+	"HtlcRefundTransaction":                   520,
+	"LiquidPaymentWithdrawTransaction":        518,
+	"MultiSignatureRotationTransaction":       261,
+	"MultiSignatureCancelTransaction":         517,
+	"RegisterAccountDatasetSchemaTransaction": 515,
 }
 
 func (x TransactionType) String() string {
@@ -144,6 +180,35 @@ func (TransactionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_8333001f09b34082, []int{0}
 }
 
+// PostTransactionStatus represent the outcome of a single transaction posted in a batch
+type PostTransactionStatus int32
+
+const (
+	PostTransactionStatus_PostTransactionAccepted  PostTransactionStatus = 0
+	PostTransactionStatus_PostTransactionDuplicate PostTransactionStatus = 1
+	PostTransactionStatus_PostTransactionInvalid   PostTransactionStatus = 2
+)
+
+var PostTransactionStatus_name = map[int32]string{
+	0: "PostTransactionAccepted",
+	1: "PostTransactionDuplicate",
+	2: "PostTransactionInvalid",
+}
+
+var PostTransactionStatus_value = map[string]int32{
+	"PostTransactionAccepted":  0,
+	"PostTransactionDuplicate": 1,
+	"PostTransactionInvalid":   2,
+}
+
+func (x PostTransactionStatus) String() string {
+	return proto.EnumName(PostTransactionStatus_name, int32(x))
+}
+
+func (PostTransactionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{1}
+}
+
 // Transaction represent the transaction data structure stored in the database
 type Transaction struct {
 	Version                 uint32 `protobuf:"varint,1,opt,name=Version,proto3" json:"Version,omitempty"`
@@ -177,14 +242,25 @@ type Transaction struct {
 	//	*Transaction_FeeVoteRevealTransactionBody
 	//	*Transaction_LiquidPaymentTransactionBody
 	//	*Transaction_LiquidPaymentStopTransactionBody
+	//	*Transaction_TimeLockedSendZBCTransactionBody
+	//	*Transaction_MultiSendZBCTransactionBody
+	//	*Transaction_HtlcLockTransactionBody
+	//	*Transaction_HtlcClaimTransactionBody
+	//	*Transaction_HtlcRefundTransactionBody
+	//	*Transaction_LiquidPaymentWithdrawTransactionBody
+	//	*Transaction_MultiSignatureRotationTransactionBody
+	//	*Transaction_MultiSignatureCancelTransactionBody
+	//	*Transaction_RegisterAccountDatasetSchemaTransactionBody
 	TransactionBody isTransaction_TransactionBody `protobuf_oneof:"TransactionBody"`
 	Signature       []byte                        `protobuf:"bytes,31,opt,name=Signature,proto3" json:"Signature,omitempty"`
 	// nullable
-	Escrow               *Escrow  `protobuf:"bytes,32,opt,name=Escrow,proto3" json:"Escrow,omitempty"`
-	Message              []byte   `protobuf:"bytes,33,opt,name=Message,proto3" json:"Message,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Escrow  *Escrow `protobuf:"bytes,32,opt,name=Escrow,proto3" json:"Escrow,omitempty"`
+	Message []byte  `protobuf:"bytes,33,opt,name=Message,proto3" json:"Message,omitempty"`
+	// the pending transaction of the same sender this one replaces in mempool, 0 if none
+	ReplacedTransactionID int64    `protobuf:"varint,43,opt,name=ReplacedTransactionID,proto3" json:"ReplacedTransactionID,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *Transaction) Reset()         { *m = Transaction{} }
@@ -370,6 +446,42 @@ type Transaction_LiquidPaymentStopTransactionBody struct {
 	LiquidPaymentStopTransactionBody *LiquidPaymentStopTransactionBody `protobuf:"bytes,30,opt,name=liquidPaymentStopTransactionBody,proto3,oneof"`
 }
 
+type Transaction_TimeLockedSendZBCTransactionBody struct {
+	TimeLockedSendZBCTransactionBody *TimeLockedSendZBCTransactionBody `protobuf:"bytes,34,opt,name=timeLockedSendZBCTransactionBody,proto3,oneof"`
+}
+
+type Transaction_MultiSendZBCTransactionBody struct {
+	MultiSendZBCTransactionBody *MultiSendZBCTransactionBody `protobuf:"bytes,35,opt,name=multiSendZBCTransactionBody,proto3,oneof"`
+}
+
+type Transaction_HtlcLockTransactionBody struct {
+	HtlcLockTransactionBody *HtlcLockTransactionBody `protobuf:"bytes,36,opt,name=htlcLockTransactionBody,proto3,oneof"`
+}
+
+type Transaction_HtlcClaimTransactionBody struct {
+	HtlcClaimTransactionBody *HtlcClaimTransactionBody `protobuf:"bytes,37,opt,name=htlcClaimTransactionBody,proto3,oneof"`
+}
+
+type Transaction_HtlcRefundTransactionBody struct {
+	HtlcRefundTransactionBody *HtlcRefundTransactionBody `protobuf:"bytes,38,opt,name=htlcRefundTransactionBody,proto3,oneof"`
+}
+
+type Transaction_LiquidPaymentWithdrawTransactionBody struct {
+	LiquidPaymentWithdrawTransactionBody *LiquidPaymentWithdrawTransactionBody `protobuf:"bytes,39,opt,name=liquidPaymentWithdrawTransactionBody,proto3,oneof"`
+}
+
+type Transaction_MultiSignatureRotationTransactionBody struct {
+	MultiSignatureRotationTransactionBody *MultiSignatureRotationTransactionBody `protobuf:"bytes,40,opt,name=multiSignatureRotationTransactionBody,proto3,oneof"`
+}
+
+type Transaction_MultiSignatureCancelTransactionBody struct {
+	MultiSignatureCancelTransactionBody *MultiSignatureCancelTransactionBody `protobuf:"bytes,41,opt,name=multiSignatureCancelTransactionBody,proto3,oneof"`
+}
+
+type Transaction_RegisterAccountDatasetSchemaTransactionBody struct {
+	RegisterAccountDatasetSchemaTransactionBody *RegisterAccountDatasetSchemaTransactionBody `protobuf:"bytes,42,opt,name=registerAccountDatasetSchemaTransactionBody,proto3,oneof"`
+}
+
 func (*Transaction_EmptyTransactionBody) isTransaction_TransactionBody() {}
 
 func (*Transaction_SendZBCTransactionBody) isTransaction_TransactionBody() {}
@@ -398,6 +510,24 @@ func (*Transaction_LiquidPaymentTransactionBody) isTransaction_TransactionBody()
 
 func (*Transaction_LiquidPaymentStopTransactionBody) isTransaction_TransactionBody() {}
 
+func (*Transaction_TimeLockedSendZBCTransactionBody) isTransaction_TransactionBody() {}
+
+func (*Transaction_MultiSendZBCTransactionBody) isTransaction_TransactionBody() {}
+
+func (*Transaction_HtlcLockTransactionBody) isTransaction_TransactionBody() {}
+
+func (*Transaction_HtlcClaimTransactionBody) isTransaction_TransactionBody() {}
+
+func (*Transaction_HtlcRefundTransactionBody) isTransaction_TransactionBody() {}
+
+func (*Transaction_LiquidPaymentWithdrawTransactionBody) isTransaction_TransactionBody() {}
+
+func (*Transaction_MultiSignatureRotationTransactionBody) isTransaction_TransactionBody() {}
+
+func (*Transaction_MultiSignatureCancelTransactionBody) isTransaction_TransactionBody() {}
+
+func (*Transaction_RegisterAccountDatasetSchemaTransactionBody) isTransaction_TransactionBody() {}
+
 func (m *Transaction) GetTransactionBody() isTransaction_TransactionBody {
 	if m != nil {
 		return m.TransactionBody
@@ -503,6 +633,69 @@ func (m *Transaction) GetLiquidPaymentStopTransactionBody() *LiquidPaymentStopTr
 	return nil
 }
 
+func (m *Transaction) GetTimeLockedSendZBCTransactionBody() *TimeLockedSendZBCTransactionBody {
+	if x, ok := m.GetTransactionBody().(*Transaction_TimeLockedSendZBCTransactionBody); ok {
+		return x.TimeLockedSendZBCTransactionBody
+	}
+	return nil
+}
+
+func (m *Transaction) GetMultiSendZBCTransactionBody() *MultiSendZBCTransactionBody {
+	if x, ok := m.GetTransactionBody().(*Transaction_MultiSendZBCTransactionBody); ok {
+		return x.MultiSendZBCTransactionBody
+	}
+	return nil
+}
+
+func (m *Transaction) GetHtlcLockTransactionBody() *HtlcLockTransactionBody {
+	if x, ok := m.GetTransactionBody().(*Transaction_HtlcLockTransactionBody); ok {
+		return x.HtlcLockTransactionBody
+	}
+	return nil
+}
+
+func (m *Transaction) GetHtlcClaimTransactionBody() *HtlcClaimTransactionBody {
+	if x, ok := m.GetTransactionBody().(*Transaction_HtlcClaimTransactionBody); ok {
+		return x.HtlcClaimTransactionBody
+	}
+	return nil
+}
+
+func (m *Transaction) GetHtlcRefundTransactionBody() *HtlcRefundTransactionBody {
+	if x, ok := m.GetTransactionBody().(*Transaction_HtlcRefundTransactionBody); ok {
+		return x.HtlcRefundTransactionBody
+	}
+	return nil
+}
+
+func (m *Transaction) GetLiquidPaymentWithdrawTransactionBody() *LiquidPaymentWithdrawTransactionBody {
+	if x, ok := m.GetTransactionBody().(*Transaction_LiquidPaymentWithdrawTransactionBody); ok {
+		return x.LiquidPaymentWithdrawTransactionBody
+	}
+	return nil
+}
+
+func (m *Transaction) GetMultiSignatureRotationTransactionBody() *MultiSignatureRotationTransactionBody {
+	if x, ok := m.GetTransactionBody().(*Transaction_MultiSignatureRotationTransactionBody); ok {
+		return x.MultiSignatureRotationTransactionBody
+	}
+	return nil
+}
+
+func (m *Transaction) GetMultiSignatureCancelTransactionBody() *MultiSignatureCancelTransactionBody {
+	if x, ok := m.GetTransactionBody().(*Transaction_MultiSignatureCancelTransactionBody); ok {
+		return x.MultiSignatureCancelTransactionBody
+	}
+	return nil
+}
+
+func (m *Transaction) GetRegisterAccountDatasetSchemaTransactionBody() *RegisterAccountDatasetSchemaTransactionBody {
+	if x, ok := m.GetTransactionBody().(*Transaction_RegisterAccountDatasetSchemaTransactionBody); ok {
+		return x.RegisterAccountDatasetSchemaTransactionBody
+	}
+	return nil
+}
+
 func (m *Transaction) GetSignature() []byte {
 	if m != nil {
 		return m.Signature
@@ -524,6 +717,13 @@ func (m *Transaction) GetMessage() []byte {
 	return nil
 }
 
+func (m *Transaction) GetReplacedTransactionID() int64 {
+	if m != nil {
+		return m.ReplacedTransactionID
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Transaction) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -541,6 +741,15 @@ func (*Transaction) XXX_OneofWrappers() []interface{} {
 		(*Transaction_FeeVoteRevealTransactionBody)(nil),
 		(*Transaction_LiquidPaymentTransactionBody)(nil),
 		(*Transaction_LiquidPaymentStopTransactionBody)(nil),
+		(*Transaction_TimeLockedSendZBCTransactionBody)(nil),
+		(*Transaction_MultiSendZBCTransactionBody)(nil),
+		(*Transaction_HtlcLockTransactionBody)(nil),
+		(*Transaction_HtlcClaimTransactionBody)(nil),
+		(*Transaction_HtlcRefundTransactionBody)(nil),
+		(*Transaction_LiquidPaymentWithdrawTransactionBody)(nil),
+		(*Transaction_MultiSignatureRotationTransactionBody)(nil),
+		(*Transaction_MultiSignatureCancelTransactionBody)(nil),
+		(*Transaction_RegisterAccountDatasetSchemaTransactionBody)(nil),
 	}
 }
 
@@ -827,7 +1036,11 @@ type SetupAccountDatasetTransactionBody struct {
 	// Property name
 	Property string `protobuf:"bytes,1,opt,name=Property,proto3" json:"Property,omitempty"`
 	// Value of property
-	Value                string   `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	// ExpiryHeight optional block height from which the dataset is deactivated
+	ExpiryHeight uint32 `protobuf:"varint,3,opt,name=ExpiryHeight,proto3" json:"ExpiryHeight,omitempty"`
+	// ExpiryTimestamp optional block timestamp from which the dataset is deactivated
+	ExpiryTimestamp      int64    `protobuf:"varint,4,opt,name=ExpiryTimestamp,proto3" json:"ExpiryTimestamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -872,6 +1085,20 @@ func (m *SetupAccountDatasetTransactionBody) GetValue() string {
 	return ""
 }
 
+func (m *SetupAccountDatasetTransactionBody) GetExpiryHeight() uint32 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *SetupAccountDatasetTransactionBody) GetExpiryTimestamp() int64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
 type RemoveAccountDatasetTransactionBody struct {
 	// Property name
 	Property string `protobuf:"bytes,1,opt,name=Property,proto3" json:"Property,omitempty"`
@@ -922,11 +1149,13 @@ func (m *RemoveAccountDatasetTransactionBody) GetValue() string {
 }
 
 type ApprovalEscrowTransactionBody struct {
-	Approval             EscrowApproval `protobuf:"varint,1,opt,name=Approval,proto3,enum=model.EscrowApproval" json:"Approval,omitempty"`
-	TransactionID        int64          `protobuf:"varint,2,opt,name=TransactionID,proto3" json:"TransactionID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Approval      EscrowApproval `protobuf:"varint,1,opt,name=Approval,proto3,enum=model.EscrowApproval" json:"Approval,omitempty"`
+	TransactionID int64          `protobuf:"varint,2,opt,name=TransactionID,proto3" json:"TransactionID,omitempty"`
+	// Amount released to the recipient by a partial approval, 0 releases the whole remaining amount
+	Amount               int64    `protobuf:"varint,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ApprovalEscrowTransactionBody) Reset()         { *m = ApprovalEscrowTransactionBody{} }
@@ -968,6 +1197,13 @@ func (m *ApprovalEscrowTransactionBody) GetTransactionID() int64 {
 	return 0
 }
 
+func (m *ApprovalEscrowTransactionBody) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
 type MultiSignatureTransactionBody struct {
 	// MultiSignatureInfo represent the information of the multisig-address
 	MultiSignatureInfo *MultiSignatureInfo `protobuf:"bytes,1,opt,name=MultiSignatureInfo,proto3" json:"MultiSignatureInfo,omitempty"`
@@ -1794,8 +2030,948 @@ func (m *GetTransactionMinimumFeeResponse) GetFee() int64 {
 	return 0
 }
 
+// SimulateTransactionRequest a model request for dry running a signed transaction without broadcasting it
+type SimulateTransactionRequest struct {
+	// Signed transaction bytes
+	TransactionBytes     []byte   `protobuf:"bytes,1,opt,name=TransactionBytes,proto3" json:"TransactionBytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimulateTransactionRequest) Reset()         { *m = SimulateTransactionRequest{} }
+func (m *SimulateTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateTransactionRequest) ProtoMessage()    {}
+func (*SimulateTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{27}
+}
+
+func (m *SimulateTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateTransactionRequest.Unmarshal(m, b)
+}
+func (m *SimulateTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *SimulateTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateTransactionRequest.Merge(m, src)
+}
+func (m *SimulateTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_SimulateTransactionRequest.Size(m)
+}
+func (m *SimulateTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateTransactionRequest proto.InternalMessageInfo
+
+func (m *SimulateTransactionRequest) GetTransactionBytes() []byte {
+	if m != nil {
+		return m.TransactionBytes
+	}
+	return nil
+}
+
+// SimulateTransactionResponse a model response for SimulateTransactionRequest, holds the changes the transaction
+// would make if it was included in the next block, none of them are persisted
+type SimulateTransactionResponse struct {
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=Transaction,proto3" json:"Transaction,omitempty"`
+	// Accepted false means the transaction would be rejected, RejectionReason tells why and no change is returned
+	Accepted        bool   `protobuf:"varint,2,opt,name=Accepted,proto3" json:"Accepted,omitempty"`
+	RejectionReason string `protobuf:"bytes,3,opt,name=RejectionReason,proto3" json:"RejectionReason,omitempty"`
+	MinimumFee      int64  `protobuf:"varint,4,opt,name=MinimumFee,proto3" json:"MinimumFee,omitempty"`
+	// AccountLedgers the balance deltas written by the transaction
+	AccountLedgers []*AccountLedger `protobuf:"bytes,5,rep,name=AccountLedgers,proto3" json:"AccountLedgers,omitempty"`
+	// AccountBalances the resulting balances of the accounts touched by the transaction
+	AccountBalances []*AccountBalance `protobuf:"bytes,6,rep,name=AccountBalances,proto3" json:"AccountBalances,omitempty"`
+	// AccountDatasets the datasets set or removed by the transaction
+	AccountDatasets []*AccountDataset `protobuf:"bytes,7,rep,name=AccountDatasets,proto3" json:"AccountDatasets,omitempty"`
+	// Escrows the escrow records created or updated by the transaction
+	Escrows []*Escrow `protobuf:"bytes,8,rep,name=Escrows,proto3" json:"Escrows,omitempty"`
+	// LiquidPayments the liquid payment records created or updated by the transaction
+	LiquidPayments       []*LiquidPayment `protobuf:"bytes,9,rep,name=LiquidPayments,proto3" json:"LiquidPayments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SimulateTransactionResponse) Reset()         { *m = SimulateTransactionResponse{} }
+func (m *SimulateTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateTransactionResponse) ProtoMessage()    {}
+func (*SimulateTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{28}
+}
+
+func (m *SimulateTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateTransactionResponse.Unmarshal(m, b)
+}
+func (m *SimulateTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *SimulateTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateTransactionResponse.Merge(m, src)
+}
+func (m *SimulateTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_SimulateTransactionResponse.Size(m)
+}
+func (m *SimulateTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateTransactionResponse proto.InternalMessageInfo
+
+func (m *SimulateTransactionResponse) GetTransaction() *Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *SimulateTransactionResponse) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *SimulateTransactionResponse) GetRejectionReason() string {
+	if m != nil {
+		return m.RejectionReason
+	}
+	return ""
+}
+
+func (m *SimulateTransactionResponse) GetMinimumFee() int64 {
+	if m != nil {
+		return m.MinimumFee
+	}
+	return 0
+}
+
+func (m *SimulateTransactionResponse) GetAccountLedgers() []*AccountLedger {
+	if m != nil {
+		return m.AccountLedgers
+	}
+	return nil
+}
+
+func (m *SimulateTransactionResponse) GetAccountBalances() []*AccountBalance {
+	if m != nil {
+		return m.AccountBalances
+	}
+	return nil
+}
+
+func (m *SimulateTransactionResponse) GetAccountDatasets() []*AccountDataset {
+	if m != nil {
+		return m.AccountDatasets
+	}
+	return nil
+}
+
+func (m *SimulateTransactionResponse) GetEscrows() []*Escrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+func (m *SimulateTransactionResponse) GetLiquidPayments() []*LiquidPayment {
+	if m != nil {
+		return m.LiquidPayments
+	}
+	return nil
+}
+
+// PostTransactionsRequest a model request for posting a batch of signed transactions
+type PostTransactionsRequest struct {
+	// Signed transactions bytes, processed in order
+	TransactionBytes     [][]byte `protobuf:"bytes,1,rep,name=TransactionBytes,proto3" json:"TransactionBytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PostTransactionsRequest) Reset()         { *m = PostTransactionsRequest{} }
+func (m *PostTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*PostTransactionsRequest) ProtoMessage()    {}
+func (*PostTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{29}
+}
+
+func (m *PostTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostTransactionsRequest.Unmarshal(m, b)
+}
+func (m *PostTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostTransactionsRequest.Marshal(b, m, deterministic)
+}
+func (m *PostTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostTransactionsRequest.Merge(m, src)
+}
+func (m *PostTransactionsRequest) XXX_Size() int {
+	return xxx_messageInfo_PostTransactionsRequest.Size(m)
+}
+func (m *PostTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostTransactionsRequest proto.InternalMessageInfo
+
+func (m *PostTransactionsRequest) GetTransactionBytes() [][]byte {
+	if m != nil {
+		return m.TransactionBytes
+	}
+	return nil
+}
+
+// PostTransactionResult the result of a single transaction of PostTransactionsRequest
+type PostTransactionResult struct {
+	Status PostTransactionStatus `protobuf:"varint,1,opt,name=Status,proto3,enum=model.PostTransactionStatus" json:"Status,omitempty"`
+	// TransactionID is 0 when the transaction bytes could not be parsed
+	TransactionID int64 `protobuf:"varint,2,opt,name=TransactionID,proto3" json:"TransactionID,omitempty"`
+	// Error tells why the transaction has not been accepted
+	Error                string   `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PostTransactionResult) Reset()         { *m = PostTransactionResult{} }
+func (m *PostTransactionResult) String() string { return proto.CompactTextString(m) }
+func (*PostTransactionResult) ProtoMessage()    {}
+func (*PostTransactionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{30}
+}
+
+func (m *PostTransactionResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostTransactionResult.Unmarshal(m, b)
+}
+func (m *PostTransactionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostTransactionResult.Marshal(b, m, deterministic)
+}
+func (m *PostTransactionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostTransactionResult.Merge(m, src)
+}
+func (m *PostTransactionResult) XXX_Size() int {
+	return xxx_messageInfo_PostTransactionResult.Size(m)
+}
+func (m *PostTransactionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostTransactionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostTransactionResult proto.InternalMessageInfo
+
+func (m *PostTransactionResult) GetStatus() PostTransactionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PostTransactionStatus_PostTransactionAccepted
+}
+
+func (m *PostTransactionResult) GetTransactionID() int64 {
+	if m != nil {
+		return m.TransactionID
+	}
+	return 0
+}
+
+func (m *PostTransactionResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// PostTransactionsResponse a model response for PostTransactionsRequest, one result per transaction in the request order
+type PostTransactionsResponse struct {
+	Results              []*PostTransactionResult `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *PostTransactionsResponse) Reset()         { *m = PostTransactionsResponse{} }
+func (m *PostTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*PostTransactionsResponse) ProtoMessage()    {}
+func (*PostTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{31}
+}
+
+func (m *PostTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostTransactionsResponse.Unmarshal(m, b)
+}
+func (m *PostTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostTransactionsResponse.Marshal(b, m, deterministic)
+}
+func (m *PostTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostTransactionsResponse.Merge(m, src)
+}
+func (m *PostTransactionsResponse) XXX_Size() int {
+	return xxx_messageInfo_PostTransactionsResponse.Size(m)
+}
+func (m *PostTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostTransactionsResponse proto.InternalMessageInfo
+
+func (m *PostTransactionsResponse) GetResults() []*PostTransactionResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// TimeLockedSendZBCTransactionBody send zbc that the recipient receives only once the unlock height or timestamp is reached
+type TimeLockedSendZBCTransactionBody struct {
+	Amount int64 `protobuf:"varint,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// UnlockHeight block height releasing the amount, exclusive with UnlockTimestamp
+	UnlockHeight uint32 `protobuf:"varint,2,opt,name=UnlockHeight,proto3" json:"UnlockHeight,omitempty"`
+	// UnlockTimestamp block timestamp releasing the amount, exclusive with UnlockHeight
+	UnlockTimestamp      int64    `protobuf:"varint,3,opt,name=UnlockTimestamp,proto3" json:"UnlockTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimeLockedSendZBCTransactionBody) Reset()         { *m = TimeLockedSendZBCTransactionBody{} }
+func (m *TimeLockedSendZBCTransactionBody) String() string { return proto.CompactTextString(m) }
+func (*TimeLockedSendZBCTransactionBody) ProtoMessage()    {}
+func (*TimeLockedSendZBCTransactionBody) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{32}
+}
+
+func (m *TimeLockedSendZBCTransactionBody) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeLockedSendZBCTransactionBody.Unmarshal(m, b)
+}
+func (m *TimeLockedSendZBCTransactionBody) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeLockedSendZBCTransactionBody.Marshal(b, m, deterministic)
+}
+func (m *TimeLockedSendZBCTransactionBody) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeLockedSendZBCTransactionBody.Merge(m, src)
+}
+func (m *TimeLockedSendZBCTransactionBody) XXX_Size() int {
+	return xxx_messageInfo_TimeLockedSendZBCTransactionBody.Size(m)
+}
+func (m *TimeLockedSendZBCTransactionBody) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeLockedSendZBCTransactionBody.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeLockedSendZBCTransactionBody proto.InternalMessageInfo
+
+func (m *TimeLockedSendZBCTransactionBody) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TimeLockedSendZBCTransactionBody) GetUnlockHeight() uint32 {
+	if m != nil {
+		return m.UnlockHeight
+	}
+	return 0
+}
+
+func (m *TimeLockedSendZBCTransactionBody) GetUnlockTimestamp() int64 {
+	if m != nil {
+		return m.UnlockTimestamp
+	}
+	return 0
+}
+
+// MultiSendZBCRecipient single output of a multi send zbc transaction
+type MultiSendZBCRecipient struct {
+	RecipientAddress     []byte   `protobuf:"bytes,1,opt,name=RecipientAddress,proto3" json:"RecipientAddress,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSendZBCRecipient) Reset()         { *m = MultiSendZBCRecipient{} }
+func (m *MultiSendZBCRecipient) String() string { return proto.CompactTextString(m) }
+func (*MultiSendZBCRecipient) ProtoMessage()    {}
+func (*MultiSendZBCRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{33}
+}
+
+func (m *MultiSendZBCRecipient) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSendZBCRecipient.Unmarshal(m, b)
+}
+func (m *MultiSendZBCRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSendZBCRecipient.Marshal(b, m, deterministic)
+}
+func (m *MultiSendZBCRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSendZBCRecipient.Merge(m, src)
+}
+func (m *MultiSendZBCRecipient) XXX_Size() int {
+	return xxx_messageInfo_MultiSendZBCRecipient.Size(m)
+}
+func (m *MultiSendZBCRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSendZBCRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSendZBCRecipient proto.InternalMessageInfo
+
+func (m *MultiSendZBCRecipient) GetRecipientAddress() []byte {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return nil
+}
+
+func (m *MultiSendZBCRecipient) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// MultiSendZBCTransactionBody send zbc to several recipients in a single transaction
+type MultiSendZBCTransactionBody struct {
+	Recipients           []*MultiSendZBCRecipient `protobuf:"bytes,1,rep,name=Recipients,proto3" json:"Recipients,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *MultiSendZBCTransactionBody) Reset()         { *m = MultiSendZBCTransactionBody{} }
+func (m *MultiSendZBCTransactionBody) String() string { return proto.CompactTextString(m) }
+func (*MultiSendZBCTransactionBody) ProtoMessage()    {}
+func (*MultiSendZBCTransactionBody) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{34}
+}
+
+func (m *MultiSendZBCTransactionBody) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSendZBCTransactionBody.Unmarshal(m, b)
+}
+func (m *MultiSendZBCTransactionBody) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSendZBCTransactionBody.Marshal(b, m, deterministic)
+}
+func (m *MultiSendZBCTransactionBody) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSendZBCTransactionBody.Merge(m, src)
+}
+func (m *MultiSendZBCTransactionBody) XXX_Size() int {
+	return xxx_messageInfo_MultiSendZBCTransactionBody.Size(m)
+}
+func (m *MultiSendZBCTransactionBody) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSendZBCTransactionBody.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSendZBCTransactionBody proto.InternalMessageInfo
+
+func (m *MultiSendZBCTransactionBody) GetRecipients() []*MultiSendZBCRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// HtlcLockTransactionBody lock an amount the recipient can claim by revealing the preimage of HashLock
+// before TimeLockHeight, the sender being refunded afterwards
+type HtlcLockTransactionBody struct {
+	Amount               int64    `protobuf:"varint,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	HashLock             []byte   `protobuf:"bytes,2,opt,name=HashLock,proto3" json:"HashLock,omitempty"`
+	TimeLockHeight       uint32   `protobuf:"varint,3,opt,name=TimeLockHeight,proto3" json:"TimeLockHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HtlcLockTransactionBody) Reset()         { *m = HtlcLockTransactionBody{} }
+func (m *HtlcLockTransactionBody) String() string { return proto.CompactTextString(m) }
+func (*HtlcLockTransactionBody) ProtoMessage()    {}
+func (*HtlcLockTransactionBody) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{35}
+}
+
+func (m *HtlcLockTransactionBody) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcLockTransactionBody.Unmarshal(m, b)
+}
+func (m *HtlcLockTransactionBody) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcLockTransactionBody.Marshal(b, m, deterministic)
+}
+func (m *HtlcLockTransactionBody) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcLockTransactionBody.Merge(m, src)
+}
+func (m *HtlcLockTransactionBody) XXX_Size() int {
+	return xxx_messageInfo_HtlcLockTransactionBody.Size(m)
+}
+func (m *HtlcLockTransactionBody) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcLockTransactionBody.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcLockTransactionBody proto.InternalMessageInfo
+
+func (m *HtlcLockTransactionBody) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *HtlcLockTransactionBody) GetHashLock() []byte {
+	if m != nil {
+		return m.HashLock
+	}
+	return nil
+}
+
+func (m *HtlcLockTransactionBody) GetTimeLockHeight() uint32 {
+	if m != nil {
+		return m.TimeLockHeight
+	}
+	return 0
+}
+
+// HtlcClaimTransactionBody credit the recipient of a htlc revealing the preimage of its hash lock
+type HtlcClaimTransactionBody struct {
+	HtlcID               int64    `protobuf:"varint,1,opt,name=HtlcID,proto3" json:"HtlcID,omitempty"`
+	Preimage             []byte   `protobuf:"bytes,2,opt,name=Preimage,proto3" json:"Preimage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HtlcClaimTransactionBody) Reset()         { *m = HtlcClaimTransactionBody{} }
+func (m *HtlcClaimTransactionBody) String() string { return proto.CompactTextString(m) }
+func (*HtlcClaimTransactionBody) ProtoMessage()    {}
+func (*HtlcClaimTransactionBody) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{36}
+}
+
+func (m *HtlcClaimTransactionBody) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcClaimTransactionBody.Unmarshal(m, b)
+}
+func (m *HtlcClaimTransactionBody) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcClaimTransactionBody.Marshal(b, m, deterministic)
+}
+func (m *HtlcClaimTransactionBody) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcClaimTransactionBody.Merge(m, src)
+}
+func (m *HtlcClaimTransactionBody) XXX_Size() int {
+	return xxx_messageInfo_HtlcClaimTransactionBody.Size(m)
+}
+func (m *HtlcClaimTransactionBody) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcClaimTransactionBody.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcClaimTransactionBody proto.InternalMessageInfo
+
+func (m *HtlcClaimTransactionBody) GetHtlcID() int64 {
+	if m != nil {
+		return m.HtlcID
+	}
+	return 0
+}
+
+func (m *HtlcClaimTransactionBody) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+// HtlcRefundTransactionBody give back the amount of an expired htlc to its sender
+type HtlcRefundTransactionBody struct {
+	HtlcID               int64    `protobuf:"varint,1,opt,name=HtlcID,proto3" json:"HtlcID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HtlcRefundTransactionBody) Reset()         { *m = HtlcRefundTransactionBody{} }
+func (m *HtlcRefundTransactionBody) String() string { return proto.CompactTextString(m) }
+func (*HtlcRefundTransactionBody) ProtoMessage()    {}
+func (*HtlcRefundTransactionBody) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{37}
+}
+
+func (m *HtlcRefundTransactionBody) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcRefundTransactionBody.Unmarshal(m, b)
+}
+func (m *HtlcRefundTransactionBody) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcRefundTransactionBody.Marshal(b, m, deterministic)
+}
+func (m *HtlcRefundTransactionBody) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcRefundTransactionBody.Merge(m, src)
+}
+func (m *HtlcRefundTransactionBody) XXX_Size() int {
+	return xxx_messageInfo_HtlcRefundTransactionBody.Size(m)
+}
+func (m *HtlcRefundTransactionBody) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcRefundTransactionBody.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcRefundTransactionBody proto.InternalMessageInfo
+
+func (m *HtlcRefundTransactionBody) GetHtlcID() int64 {
+	if m != nil {
+		return m.HtlcID
+	}
+	return 0
+}
+
+// LiquidPaymentWithdrawTransactionBody credit the recipient of a liquid payment with the amount vested so far
+type LiquidPaymentWithdrawTransactionBody struct {
+	TransactionID        int64    `protobuf:"varint,1,opt,name=TransactionID,proto3" json:"TransactionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LiquidPaymentWithdrawTransactionBody) Reset()         { *m = LiquidPaymentWithdrawTransactionBody{} }
+func (m *LiquidPaymentWithdrawTransactionBody) String() string { return proto.CompactTextString(m) }
+func (*LiquidPaymentWithdrawTransactionBody) ProtoMessage()    {}
+func (*LiquidPaymentWithdrawTransactionBody) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{38}
+}
+
+func (m *LiquidPaymentWithdrawTransactionBody) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LiquidPaymentWithdrawTransactionBody.Unmarshal(m, b)
+}
+func (m *LiquidPaymentWithdrawTransactionBody) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LiquidPaymentWithdrawTransactionBody.Marshal(b, m, deterministic)
+}
+func (m *LiquidPaymentWithdrawTransactionBody) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidPaymentWithdrawTransactionBody.Merge(m, src)
+}
+func (m *LiquidPaymentWithdrawTransactionBody) XXX_Size() int {
+	return xxx_messageInfo_LiquidPaymentWithdrawTransactionBody.Size(m)
+}
+func (m *LiquidPaymentWithdrawTransactionBody) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidPaymentWithdrawTransactionBody.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidPaymentWithdrawTransactionBody proto.InternalMessageInfo
+
+func (m *LiquidPaymentWithdrawTransactionBody) GetTransactionID() int64 {
+	if m != nil {
+		return m.TransactionID
+	}
+	return 0
+}
+
+// MultiSignatureRotationTransactionBody replace the participants and threshold of the multisig account sending it,
+// keeping the same multisig address
+type MultiSignatureRotationTransactionBody struct {
+	MinimumSignatures    uint32   `protobuf:"varint,1,opt,name=MinimumSignatures,proto3" json:"MinimumSignatures,omitempty"`
+	Addresses            [][]byte `protobuf:"bytes,2,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+	Weights              []uint32 `protobuf:"varint,3,rep,packed,name=Weights,proto3" json:"Weights,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSignatureRotationTransactionBody) Reset()         { *m = MultiSignatureRotationTransactionBody{} }
+func (m *MultiSignatureRotationTransactionBody) String() string { return proto.CompactTextString(m) }
+func (*MultiSignatureRotationTransactionBody) ProtoMessage()    {}
+func (*MultiSignatureRotationTransactionBody) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{39}
+}
+
+func (m *MultiSignatureRotationTransactionBody) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSignatureRotationTransactionBody.Unmarshal(m, b)
+}
+func (m *MultiSignatureRotationTransactionBody) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSignatureRotationTransactionBody.Marshal(b, m, deterministic)
+}
+func (m *MultiSignatureRotationTransactionBody) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSignatureRotationTransactionBody.Merge(m, src)
+}
+func (m *MultiSignatureRotationTransactionBody) XXX_Size() int {
+	return xxx_messageInfo_MultiSignatureRotationTransactionBody.Size(m)
+}
+func (m *MultiSignatureRotationTransactionBody) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSignatureRotationTransactionBody.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSignatureRotationTransactionBody proto.InternalMessageInfo
+
+func (m *MultiSignatureRotationTransactionBody) GetMinimumSignatures() uint32 {
+	if m != nil {
+		return m.MinimumSignatures
+	}
+	return 0
+}
+
+func (m *MultiSignatureRotationTransactionBody) GetAddresses() [][]byte {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *MultiSignatureRotationTransactionBody) GetWeights() []uint32 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+// MultiSignatureCancelTransactionBody cancel a transaction pending on the multisig account sending it
+type MultiSignatureCancelTransactionBody struct {
+	// TransactionHash of the pending transaction to cancel
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=TransactionHash,proto3" json:"TransactionHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSignatureCancelTransactionBody) Reset()         { *m = MultiSignatureCancelTransactionBody{} }
+func (m *MultiSignatureCancelTransactionBody) String() string { return proto.CompactTextString(m) }
+func (*MultiSignatureCancelTransactionBody) ProtoMessage()    {}
+func (*MultiSignatureCancelTransactionBody) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{40}
+}
+
+func (m *MultiSignatureCancelTransactionBody) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSignatureCancelTransactionBody.Unmarshal(m, b)
+}
+func (m *MultiSignatureCancelTransactionBody) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSignatureCancelTransactionBody.Marshal(b, m, deterministic)
+}
+func (m *MultiSignatureCancelTransactionBody) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSignatureCancelTransactionBody.Merge(m, src)
+}
+func (m *MultiSignatureCancelTransactionBody) XXX_Size() int {
+	return xxx_messageInfo_MultiSignatureCancelTransactionBody.Size(m)
+}
+func (m *MultiSignatureCancelTransactionBody) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSignatureCancelTransactionBody.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSignatureCancelTransactionBody proto.InternalMessageInfo
+
+func (m *MultiSignatureCancelTransactionBody) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+// RegisterAccountDatasetSchemaTransactionBody register the type of the values of a property set by the sender
+type RegisterAccountDatasetSchemaTransactionBody struct {
+	Property             string                  `protobuf:"bytes,1,opt,name=Property,proto3" json:"Property,omitempty"`
+	ValueType            AccountDatasetValueType `protobuf:"varint,2,opt,name=ValueType,proto3,enum=model.AccountDatasetValueType" json:"ValueType,omitempty"`
+	MaxLength            uint32                  `protobuf:"varint,3,opt,name=MaxLength,proto3" json:"MaxLength,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *RegisterAccountDatasetSchemaTransactionBody) Reset() {
+	*m = RegisterAccountDatasetSchemaTransactionBody{}
+}
+func (m *RegisterAccountDatasetSchemaTransactionBody) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RegisterAccountDatasetSchemaTransactionBody) ProtoMessage() {}
+func (*RegisterAccountDatasetSchemaTransactionBody) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{41}
+}
+
+func (m *RegisterAccountDatasetSchemaTransactionBody) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterAccountDatasetSchemaTransactionBody.Unmarshal(m, b)
+}
+func (m *RegisterAccountDatasetSchemaTransactionBody) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterAccountDatasetSchemaTransactionBody.Marshal(b, m, deterministic)
+}
+func (m *RegisterAccountDatasetSchemaTransactionBody) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterAccountDatasetSchemaTransactionBody.Merge(m, src)
+}
+func (m *RegisterAccountDatasetSchemaTransactionBody) XXX_Size() int {
+	return xxx_messageInfo_RegisterAccountDatasetSchemaTransactionBody.Size(m)
+}
+func (m *RegisterAccountDatasetSchemaTransactionBody) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterAccountDatasetSchemaTransactionBody.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterAccountDatasetSchemaTransactionBody proto.InternalMessageInfo
+
+func (m *RegisterAccountDatasetSchemaTransactionBody) GetProperty() string {
+	if m != nil {
+		return m.Property
+	}
+	return ""
+}
+
+func (m *RegisterAccountDatasetSchemaTransactionBody) GetValueType() AccountDatasetValueType {
+	if m != nil {
+		return m.ValueType
+	}
+	return AccountDatasetValueType_AccountDatasetValueString
+}
+
+func (m *RegisterAccountDatasetSchemaTransactionBody) GetMaxLength() uint32 {
+	if m != nil {
+		return m.MaxLength
+	}
+	return 0
+}
+
+// EstimateTransactionFeeRequest a model request for getting the fees suggested to post the transaction
+type EstimateTransactionFeeRequest struct {
+	// Transaction bytes, the fee it carries is ignored
+	TransactionBytes     []byte   `protobuf:"bytes,1,opt,name=TransactionBytes,proto3" json:"TransactionBytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EstimateTransactionFeeRequest) Reset()         { *m = EstimateTransactionFeeRequest{} }
+func (m *EstimateTransactionFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateTransactionFeeRequest) ProtoMessage()    {}
+func (*EstimateTransactionFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{42}
+}
+
+func (m *EstimateTransactionFeeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateTransactionFeeRequest.Unmarshal(m, b)
+}
+func (m *EstimateTransactionFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateTransactionFeeRequest.Marshal(b, m, deterministic)
+}
+func (m *EstimateTransactionFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateTransactionFeeRequest.Merge(m, src)
+}
+func (m *EstimateTransactionFeeRequest) XXX_Size() int {
+	return xxx_messageInfo_EstimateTransactionFeeRequest.Size(m)
+}
+func (m *EstimateTransactionFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateTransactionFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateTransactionFeeRequest proto.InternalMessageInfo
+
+func (m *EstimateTransactionFeeRequest) GetTransactionBytes() []byte {
+	if m != nil {
+		return m.TransactionBytes
+	}
+	return nil
+}
+
+// FeeEstimate fee suggested for a confirmation target
+type FeeEstimate struct {
+	// FeePerByte in the unit of the mempool ordering, fee * OneFeePerByteTransaction / transaction bytes length
+	FeePerByte int64 `protobuf:"varint,1,opt,name=FeePerByte,proto3" json:"FeePerByte,omitempty"`
+	// Fee of the transaction at FeePerByte, never lower than the minimum fee
+	Fee                  int64    `protobuf:"varint,2,opt,name=Fee,proto3" json:"Fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeeEstimate) Reset()         { *m = FeeEstimate{} }
+func (m *FeeEstimate) String() string { return proto.CompactTextString(m) }
+func (*FeeEstimate) ProtoMessage()    {}
+func (*FeeEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{43}
+}
+
+func (m *FeeEstimate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeEstimate.Unmarshal(m, b)
+}
+func (m *FeeEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeEstimate.Marshal(b, m, deterministic)
+}
+func (m *FeeEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeEstimate.Merge(m, src)
+}
+func (m *FeeEstimate) XXX_Size() int {
+	return xxx_messageInfo_FeeEstimate.Size(m)
+}
+func (m *FeeEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeEstimate proto.InternalMessageInfo
+
+func (m *FeeEstimate) GetFeePerByte() int64 {
+	if m != nil {
+		return m.FeePerByte
+	}
+	return 0
+}
+
+func (m *FeeEstimate) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+// EstimateTransactionFeeResponse a model response for EstimateTransactionFeeRequest, the suggested fees depend on
+// the transactions waiting in mempool and on the lowest fee per byte included by the recent blocks
+type EstimateTransactionFeeResponse struct {
+	MinimumFee int64 `protobuf:"varint,1,opt,name=MinimumFee,proto3" json:"MinimumFee,omitempty"`
+	// NextBlock fee to be selected in the next block
+	NextBlock *FeeEstimate `protobuf:"bytes,2,opt,name=NextBlock,proto3" json:"NextBlock,omitempty"`
+	// WithinFiveBlocks fee to be selected within the next five blocks
+	WithinFiveBlocks *FeeEstimate `protobuf:"bytes,3,opt,name=WithinFiveBlocks,proto3" json:"WithinFiveBlocks,omitempty"`
+	// Economy lowest fee expected to be selected once the mempool pressure drops
+	Economy              *FeeEstimate `protobuf:"bytes,4,opt,name=Economy,proto3" json:"Economy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *EstimateTransactionFeeResponse) Reset()         { *m = EstimateTransactionFeeResponse{} }
+func (m *EstimateTransactionFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateTransactionFeeResponse) ProtoMessage()    {}
+func (*EstimateTransactionFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8333001f09b34082, []int{44}
+}
+
+func (m *EstimateTransactionFeeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateTransactionFeeResponse.Unmarshal(m, b)
+}
+func (m *EstimateTransactionFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateTransactionFeeResponse.Marshal(b, m, deterministic)
+}
+func (m *EstimateTransactionFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateTransactionFeeResponse.Merge(m, src)
+}
+func (m *EstimateTransactionFeeResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateTransactionFeeResponse.Size(m)
+}
+func (m *EstimateTransactionFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateTransactionFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateTransactionFeeResponse proto.InternalMessageInfo
+
+func (m *EstimateTransactionFeeResponse) GetMinimumFee() int64 {
+	if m != nil {
+		return m.MinimumFee
+	}
+	return 0
+}
+
+func (m *EstimateTransactionFeeResponse) GetNextBlock() *FeeEstimate {
+	if m != nil {
+		return m.NextBlock
+	}
+	return nil
+}
+
+func (m *EstimateTransactionFeeResponse) GetWithinFiveBlocks() *FeeEstimate {
+	if m != nil {
+		return m.WithinFiveBlocks
+	}
+	return nil
+}
+
+func (m *EstimateTransactionFeeResponse) GetEconomy() *FeeEstimate {
+	if m != nil {
+		return m.Economy
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("model.TransactionType", TransactionType_name, TransactionType_value)
+	proto.RegisterEnum("model.PostTransactionStatus", PostTransactionStatus_name, PostTransactionStatus_value)
 	proto.RegisterType((*Transaction)(nil), "model.Transaction")
 	proto.RegisterType((*EmptyTransactionBody)(nil), "model.EmptyTransactionBody")
 	proto.RegisterType((*SendZBCTransactionBody)(nil), "model.SendZBCTransactionBody")
@@ -1823,6 +2999,24 @@ func init() {
 	proto.RegisterType((*SendBlockTransactionsResponse)(nil), "model.SendBlockTransactionsResponse")
 	proto.RegisterType((*GetTransactionMinimumFeeRequest)(nil), "model.GetTransactionMinimumFeeRequest")
 	proto.RegisterType((*GetTransactionMinimumFeeResponse)(nil), "model.GetTransactionMinimumFeeResponse")
+	proto.RegisterType((*SimulateTransactionRequest)(nil), "model.SimulateTransactionRequest")
+	proto.RegisterType((*SimulateTransactionResponse)(nil), "model.SimulateTransactionResponse")
+	proto.RegisterType((*PostTransactionsRequest)(nil), "model.PostTransactionsRequest")
+	proto.RegisterType((*PostTransactionResult)(nil), "model.PostTransactionResult")
+	proto.RegisterType((*PostTransactionsResponse)(nil), "model.PostTransactionsResponse")
+	proto.RegisterType((*TimeLockedSendZBCTransactionBody)(nil), "model.TimeLockedSendZBCTransactionBody")
+	proto.RegisterType((*MultiSendZBCRecipient)(nil), "model.MultiSendZBCRecipient")
+	proto.RegisterType((*MultiSendZBCTransactionBody)(nil), "model.MultiSendZBCTransactionBody")
+	proto.RegisterType((*HtlcLockTransactionBody)(nil), "model.HtlcLockTransactionBody")
+	proto.RegisterType((*HtlcClaimTransactionBody)(nil), "model.HtlcClaimTransactionBody")
+	proto.RegisterType((*HtlcRefundTransactionBody)(nil), "model.HtlcRefundTransactionBody")
+	proto.RegisterType((*LiquidPaymentWithdrawTransactionBody)(nil), "model.LiquidPaymentWithdrawTransactionBody")
+	proto.RegisterType((*MultiSignatureRotationTransactionBody)(nil), "model.MultiSignatureRotationTransactionBody")
+	proto.RegisterType((*MultiSignatureCancelTransactionBody)(nil), "model.MultiSignatureCancelTransactionBody")
+	proto.RegisterType((*RegisterAccountDatasetSchemaTransactionBody)(nil), "model.RegisterAccountDatasetSchemaTransactionBody")
+	proto.RegisterType((*EstimateTransactionFeeRequest)(nil), "model.EstimateTransactionFeeRequest")
+	proto.RegisterType((*FeeEstimate)(nil), "model.FeeEstimate")
+	proto.RegisterType((*EstimateTransactionFeeResponse)(nil), "model.EstimateTransactionFeeResponse")
 }
 
 func init() {
@@ -1830,109 +3024,168 @@ func init() {
 }
 
 var fileDescriptor_8333001f09b34082 = []byte{
-	// 1654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x5d, 0x53, 0xdb, 0x46,
-	0x17, 0xb6, 0x6c, 0xcc, 0xc7, 0xe1, 0x23, 0x66, 0x03, 0x46, 0x21, 0x26, 0x38, 0x0a, 0xf0, 0xfa,
-	0x25, 0x09, 0xbc, 0xe1, 0x65, 0x3a, 0x99, 0xdc, 0x61, 0x08, 0x35, 0x13, 0x68, 0xe8, 0x42, 0xc8,
-	0x4c, 0xee, 0x84, 0xbd, 0xd8, 0x9a, 0x58, 0x5a, 0x45, 0x92, 0x93, 0xd2, 0x64, 0x3a, 0xd3, 0xf4,
-	0xeb, 0xa6, 0x97, 0xfd, 0x0d, 0xfd, 0x1f, 0xbd, 0xeb, 0x8f, 0xe8, 0x55, 0xef, 0xfa, 0x2f, 0x3a,
-	0xda, 0x5d, 0xcb, 0x5a, 0x59, 0x96, 0xd4, 0xf4, 0xc6, 0x63, 0x9d, 0xf3, 0x9c, 0xf3, 0x9c, 0xfd,
-	0x3c, 0xe7, 0x2c, 0x2c, 0x99, 0xb4, 0x45, 0xba, 0xdb, 0x9e, 0xa3, 0x5b, 0xae, 0xde, 0xf4, 0x0c,
-	0x6a, 0x6d, 0xd9, 0x0e, 0xf5, 0x28, 0x2a, 0x32, 0xc5, 0x72, 0x85, 0xeb, 0x6d, 0x87, 0xd2, 0xab,
-	0xe7, 0x57, 0xcf, 0xdf, 0x59, 0xc4, 0x71, 0x3b, 0x86, 0xcd, 0x41, 0xcb, 0x65, 0xa1, 0xd5, 0xdb,
-	0x86, 0xa5, 0x0f, 0x8c, 0x97, 0x6f, 0x72, 0xb9, 0x43, 0x9a, 0xc4, 0xb0, 0x3d, 0x21, 0x14, 0xae,
-	0x2c, 0xda, 0x22, 0x98, 0xb4, 0x0d, 0xd7, 0x73, 0xc2, 0x26, 0x88, 0x6b, 0x89, 0xdb, 0x74, 0xe8,
-	0x3b, 0x21, 0x5b, 0xe6, 0x32, 0xb3, 0xd7, 0xf5, 0x8c, 0x33, 0xa3, 0x6d, 0xe9, 0x5e, 0xcf, 0x21,
-	0x32, 0xc5, 0x15, 0x21, 0x17, 0xd4, 0x13, 0x42, 0xed, 0x8f, 0x79, 0x98, 0x3e, 0x1f, 0x0c, 0x05,
-	0xa9, 0x30, 0x71, 0x41, 0x1c, 0xd7, 0xa0, 0x96, 0xaa, 0x54, 0x95, 0xda, 0x2c, 0xee, 0x7f, 0x22,
-	0x04, 0xf9, 0xa3, 0x03, 0x35, 0x5f, 0x55, 0x6a, 0x85, 0x7a, 0xfe, 0x7f, 0x0a, 0xce, 0x1f, 0x1d,
-	0xa0, 0x0a, 0x4c, 0xd4, 0xbb, 0xb4, 0xf9, 0xfa, 0xe8, 0x40, 0x2d, 0x04, 0x8a, 0xbe, 0x08, 0x95,
-	0x61, 0xbc, 0x41, 0x8c, 0x76, 0xc7, 0x53, 0xc7, 0x98, 0x2b, 0xf1, 0x85, 0x76, 0x60, 0xe1, 0x8c,
-	0x58, 0x2d, 0xe2, 0xec, 0x35, 0x9b, 0xb4, 0x67, 0x79, 0x7b, 0xad, 0x96, 0x43, 0x5c, 0x57, 0x2d,
-	0x56, 0x95, 0xda, 0x0c, 0x8e, 0xd5, 0xa1, 0xc7, 0xb0, 0x84, 0x49, 0xd3, 0xb0, 0x0d, 0x62, 0x79,
-	0x11, 0xb3, 0x71, 0x66, 0x36, 0x4a, 0x8d, 0x6a, 0x70, 0x23, 0x34, 0xc0, 0xf3, 0x6b, 0x9b, 0xa8,
-	0x13, 0x2c, 0x9c, 0xa8, 0x18, 0x2d, 0x40, 0xe1, 0x90, 0x10, 0x75, 0x32, 0x18, 0x89, 0xff, 0x89,
-	0xaa, 0x30, 0x75, 0x6e, 0x98, 0xc4, 0xf5, 0x74, 0xd3, 0x56, 0xa7, 0x02, 0xdd, 0x40, 0x18, 0x61,
-	0x68, 0xe8, 0x6e, 0x47, 0x05, 0x16, 0x53, 0x54, 0x8c, 0x76, 0x61, 0x31, 0x24, 0xaa, 0xd3, 0xd6,
-	0xf5, 0x31, 0xb1, 0xda, 0x5e, 0x47, 0x9d, 0x66, 0x11, 0xc5, 0x2b, 0xfd, 0xf9, 0x8a, 0x28, 0xea,
-	0xd7, 0x1e, 0x71, 0xd5, 0x19, 0x3e, 0x5f, 0x71, 0x3a, 0xb4, 0x09, 0xa5, 0x90, 0xfc, 0xc8, 0x6a,
-	0x91, 0xaf, 0xd4, 0x59, 0x46, 0x32, 0x24, 0x47, 0x6b, 0x30, 0x7b, 0xe2, 0x6f, 0x18, 0xd7, 0x68,
-	0xef, 0x77, 0x8c, 0x6e, 0x4b, 0x9d, 0xab, 0x2a, 0xb5, 0x49, 0x2c, 0x0b, 0xd1, 0x97, 0xb0, 0x40,
-	0x4c, 0xdb, 0xbb, 0x8e, 0xd0, 0xa9, 0xf3, 0x55, 0xa5, 0x36, 0xbd, 0x73, 0x7b, 0x8b, 0xed, 0xae,
-	0xad, 0xa7, 0x31, 0x90, 0x46, 0x0e, 0xc7, 0x9a, 0xa2, 0x97, 0x50, 0x76, 0x89, 0xd5, 0x7a, 0x55,
-	0xdf, 0x8f, 0x3a, 0x45, 0xcc, 0xe9, 0x8a, 0x70, 0x7a, 0x16, 0x0b, 0x6a, 0xe4, 0xf0, 0x08, 0x73,
-	0xe4, 0xc0, 0x6a, 0xf4, 0xd0, 0x44, 0x19, 0x6e, 0x32, 0x86, 0x0d, 0xc1, 0xf0, 0x45, 0x32, 0xba,
-	0x91, 0xc3, 0x69, 0x0e, 0xd1, 0xf7, 0x0a, 0xac, 0xf7, 0xec, 0x96, 0xee, 0x91, 0x14, 0x67, 0xea,
-	0x02, 0xa3, 0x7e, 0x20, 0xa8, 0x5f, 0x64, 0xb1, 0x69, 0xe4, 0x70, 0x36, 0xe7, 0x2c, 0x0c, 0x87,
-	0x98, 0xf4, 0x6d, 0x6a, 0x18, 0x8b, 0x52, 0x18, 0x38, 0x8b, 0x8d, 0x1f, 0x46, 0x26, 0xe7, 0xe8,
-	0x5b, 0x05, 0xd6, 0x9a, 0x5d, 0xdd, 0x30, 0xd3, 0xa2, 0x28, 0xb3, 0x28, 0xee, 0x8b, 0x28, 0xf6,
-	0x33, 0x98, 0x34, 0x72, 0x38, 0x93, 0x6b, 0xf4, 0x1e, 0x34, 0x97, 0x78, 0x3d, 0x5b, 0x5c, 0x08,
-	0x07, 0xba, 0xa7, 0xbb, 0xc4, 0x8b, 0x06, 0xb0, 0xc4, 0x02, 0xf8, 0x6f, 0xb0, 0xd5, 0xd2, 0x0c,
-	0x1a, 0x39, 0x9c, 0xc1, 0x2d, 0xfa, 0x06, 0xee, 0xf1, 0x99, 0x4a, 0x66, 0x57, 0x19, 0xfb, 0xa6,
-	0xb4, 0x08, 0x69, 0xf4, 0x59, 0x1c, 0xa3, 0x2e, 0xac, 0xe8, 0xb6, 0xed, 0xd0, 0xb7, 0x7a, 0xf7,
-	0x29, 0xcb, 0x10, 0x51, 0xe6, 0x5b, 0x8c, 0x79, 0x4d, 0x30, 0xef, 0x25, 0x61, 0x1b, 0x39, 0x9c,
-	0xec, 0xcc, 0x67, 0x93, 0x73, 0x4e, 0x94, 0x6d, 0x59, 0x62, 0x3b, 0x49, 0xc2, 0xfa, 0x6c, 0x89,
-	0xce, 0x90, 0x01, 0x15, 0x91, 0xc5, 0xf6, 0xa9, 0x69, 0x1a, 0x43, 0x93, 0x7a, 0x9b, 0x91, 0xdd,
-	0x13, 0x64, 0x87, 0x09, 0xd0, 0x46, 0x0e, 0x27, 0xba, 0x0a, 0x51, 0x61, 0xf2, 0x96, 0xe8, 0xdd,
-	0x28, 0x55, 0x25, 0x8e, 0x2a, 0x16, 0x1a, 0xa2, 0x8a, 0xd5, 0xfb, 0x54, 0x5d, 0xe3, 0x4d, 0xcf,
-	0x68, 0x9d, 0xea, 0xd7, 0x26, 0xb1, 0x86, 0x46, 0xb5, 0x22, 0x51, 0x1d, 0x27, 0x40, 0x7d, 0xaa,
-	0x24, 0x57, 0xa8, 0x07, 0x55, 0x49, 0x7f, 0xe6, 0x51, 0x3b, 0x4a, 0x77, 0x87, 0xd1, 0xfd, 0x27,
-	0x8e, 0x2e, 0x06, 0xde, 0xc8, 0xe1, 0x54, 0x97, 0xa8, 0x02, 0x53, 0xc1, 0x9a, 0xaa, 0xab, 0x2c,
-	0x7b, 0x0d, 0x04, 0x68, 0x1d, 0xc6, 0xf9, 0xe6, 0x52, 0xab, 0x8c, 0x7a, 0xb6, 0x9f, 0x52, 0x98,
-	0x10, 0x0b, 0xa5, 0x5f, 0xa1, 0x9c, 0x10, 0xd7, 0xd5, 0xdb, 0x44, 0xbd, 0xcb, 0x5c, 0xf4, 0x3f,
-	0xeb, 0xf3, 0x52, 0x1e, 0xf6, 0x19, 0xb5, 0x32, 0x2c, 0xc4, 0x65, 0x24, 0x6d, 0x17, 0xca, 0xf1,
-	0x49, 0x05, 0x2d, 0xc3, 0xf8, 0x9e, 0xe9, 0x9f, 0x2b, 0x56, 0xff, 0xf0, 0x5c, 0x2f, 0x24, 0xda,
-	0xef, 0x0a, 0xac, 0xa6, 0x5d, 0x3a, 0x6b, 0x30, 0xeb, 0x43, 0x4e, 0x7b, 0x97, 0x5d, 0xa3, 0xf9,
-	0x8c, 0x5c, 0x33, 0x37, 0x33, 0x58, 0x16, 0xa2, 0x0d, 0x98, 0x8b, 0x54, 0x31, 0x79, 0x06, 0x9b,
-	0x1b, 0x2a, 0x5e, 0x66, 0x8f, 0x69, 0xf3, 0x35, 0x69, 0xd5, 0xf5, 0xae, 0x6e, 0x35, 0x49, 0xa8,
-	0xcc, 0x92, 0x15, 0xe8, 0x21, 0x14, 0x4f, 0x29, 0x7d, 0x67, 0xb1, 0x5a, 0x6b, 0x7a, 0x67, 0x49,
-	0x4c, 0xde, 0x69, 0xa4, 0x0c, 0xc5, 0x1c, 0xa5, 0xfd, 0xaa, 0xc0, 0x7a, 0xa6, 0xcc, 0x93, 0x71,
-	0x40, 0x43, 0x81, 0xe6, 0x53, 0x03, 0x2d, 0x64, 0x0a, 0xf4, 0x04, 0xd6, 0x33, 0xa5, 0xa6, 0x6c,
-	0x71, 0x6a, 0xef, 0x61, 0x2d, 0x4b, 0x8e, 0xc9, 0x38, 0xea, 0x60, 0x2c, 0xf9, 0x4c, 0x63, 0xb9,
-	0x00, 0x2d, 0x3d, 0xbf, 0xa0, 0x65, 0x98, 0x3c, 0x75, 0xa8, 0x4d, 0x1c, 0x8f, 0xb3, 0x4e, 0xe1,
-	0xe0, 0x1b, 0x2d, 0x40, 0xf1, 0x42, 0xef, 0xf6, 0xf8, 0xf4, 0x4e, 0x61, 0xfe, 0xa1, 0xbd, 0x84,
-	0x7b, 0x19, 0x32, 0xc7, 0x27, 0x38, 0xfe, 0x00, 0x2b, 0x89, 0x89, 0x01, 0x3d, 0x82, 0xc9, 0x3e,
-	0x80, 0xb9, 0x9c, 0xdb, 0x59, 0x94, 0x4e, 0x6d, 0x5f, 0x89, 0x03, 0x98, 0xbf, 0x53, 0xc2, 0x15,
-	0x68, 0xb8, 0xa5, 0x90, 0x15, 0xda, 0x9f, 0x0a, 0xac, 0x24, 0x66, 0x0a, 0x74, 0x04, 0x48, 0x06,
-	0x1c, 0x59, 0x57, 0x94, 0x05, 0x32, 0xbd, 0x73, 0x2b, 0x36, 0xd7, 0xf8, 0x00, 0x1c, 0x63, 0x84,
-	0x9e, 0x80, 0xfa, 0xc2, 0x72, 0x8d, 0xb6, 0x45, 0x5a, 0x61, 0x16, 0x56, 0x68, 0xf3, 0xb3, 0x39,
-	0x52, 0x8f, 0x9e, 0xc0, 0xac, 0x1c, 0x01, 0xdf, 0xda, 0x0b, 0xfd, 0x9a, 0x42, 0x22, 0x97, 0xa1,
-	0xda, 0x13, 0xa8, 0x24, 0x25, 0x28, 0x7f, 0xd1, 0x7c, 0x25, 0xeb, 0x2a, 0xf8, 0x1e, 0x0c, 0xbe,
-	0xb5, 0x0f, 0x81, 0x6d, 0x7c, 0x46, 0xd9, 0x85, 0x69, 0xa1, 0x0f, 0xcd, 0x0b, 0x92, 0x73, 0x15,
-	0x8b, 0x29, 0x0c, 0xf3, 0xef, 0x26, 0xff, 0xbf, 0x33, 0xb8, 0xaa, 0xc5, 0xdd, 0x24, 0x4b, 0xb5,
-	0x0e, 0x54, 0x92, 0x92, 0x50, 0xd2, 0x4d, 0x8a, 0x1e, 0xc0, 0x8d, 0x7d, 0x6a, 0xda, 0x5d, 0xe2,
-	0x91, 0x13, 0xc3, 0xea, 0xf5, 0x27, 0x79, 0x8c, 0x81, 0xa2, 0x2a, 0xed, 0x18, 0xaa, 0x69, 0xf9,
-	0x67, 0x78, 0x5b, 0x29, 0xa3, 0xb6, 0xd5, 0x7d, 0x58, 0xfc, 0x5c, 0x3a, 0x1c, 0x98, 0xbc, 0xe9,
-	0x11, 0xd7, 0x13, 0x1d, 0xae, 0x12, 0xee, 0x70, 0xb5, 0xdf, 0xf2, 0x50, 0x96, 0xd1, 0x6e, 0x1f,
-	0x3e, 0x7c, 0x87, 0x2b, 0xb1, 0x77, 0xf8, 0xa0, 0x0d, 0xce, 0x4b, 0x6d, 0xf0, 0x26, 0xcc, 0x05,
-	0x3d, 0xe4, 0x99, 0xa7, 0x3b, 0x5e, 0xe8, 0x72, 0x8f, 0x68, 0xd0, 0x06, 0xcc, 0x04, 0x92, 0xa7,
-	0x56, 0x8b, 0x5d, 0xf2, 0x1c, 0x29, 0xc9, 0xe3, 0x9a, 0xdd, 0x62, 0x7c, 0xb3, 0xfb, 0x08, 0xe0,
-	0x34, 0x78, 0x84, 0x60, 0x3d, 0xf4, 0xf4, 0xce, 0x7c, 0xff, 0xfe, 0x0a, 0x14, 0x38, 0x04, 0xf2,
-	0xd3, 0xf7, 0xa1, 0x43, 0x4d, 0xd6, 0xde, 0x8b, 0x1e, 0x7a, 0x20, 0xf0, 0xf3, 0xf2, 0x39, 0xe5,
-	0xba, 0x49, 0xfe, 0x72, 0x20, 0x3e, 0xb5, 0xd7, 0xb0, 0x34, 0x34, 0x85, 0xae, 0x4d, 0x2d, 0x97,
-	0x20, 0x15, 0x8a, 0xe7, 0xd4, 0x13, 0x97, 0x07, 0x5f, 0x7d, 0x2e, 0x40, 0x9f, 0xc1, 0x4c, 0xd8,
-	0x42, 0xcd, 0x57, 0x0b, 0xa1, 0xcd, 0x1b, 0x5e, 0x3d, 0x09, 0xa7, 0x1d, 0x40, 0xf9, 0x94, 0xba,
-	0x71, 0xcb, 0x2b, 0xb7, 0xc4, 0xfc, 0x64, 0xf3, 0x15, 0x1b, 0x92, 0x6b, 0xcf, 0x61, 0x69, 0xc8,
-	0x8b, 0x08, 0x79, 0x57, 0x7a, 0x30, 0x89, 0x1c, 0xaa, 0xb0, 0x41, 0x18, 0xa6, 0xfd, 0xac, 0xf0,
-	0x8a, 0xe3, 0xdf, 0xc5, 0xe5, 0x2f, 0xc1, 0x7e, 0x47, 0x37, 0xf8, 0xca, 0xfa, 0xdb, 0xa9, 0x88,
-	0x07, 0x02, 0x7f, 0xf5, 0xf9, 0xe3, 0xc9, 0x20, 0x6d, 0x15, 0xf8, 0x43, 0x44, 0x44, 0xac, 0xed,
-	0xc3, 0xd2, 0x50, 0x34, 0x62, 0x7c, 0x35, 0x98, 0xc0, 0xfc, 0x15, 0x4a, 0x8c, 0x6d, 0x2e, 0x68,
-	0x4e, 0x98, 0x14, 0xf7, 0xd5, 0xda, 0x0f, 0x0a, 0xac, 0x8a, 0x41, 0xb0, 0x85, 0x1e, 0x71, 0x48,
-	0xa4, 0xd3, 0xe7, 0x0f, 0xad, 0x50, 0x2b, 0xe0, 0x88, 0x34, 0x65, 0x60, 0x89, 0xef, 0x4c, 0xda,
-	0x2f, 0x0a, 0x54, 0xfc, 0xd1, 0x8c, 0x0c, 0x42, 0x72, 0xae, 0x44, 0x9d, 0x3f, 0x80, 0xf9, 0xb0,
-	0x51, 0xff, 0xca, 0x2f, 0xd4, 0x66, 0xf0, 0xb0, 0xe2, 0x1f, 0xcc, 0xf1, 0x33, 0x58, 0x19, 0x11,
-	0x95, 0x98, 0xe9, 0x4d, 0x98, 0x14, 0x53, 0xc9, 0x67, 0x65, 0x78, 0xaa, 0x03, 0xbd, 0x76, 0x02,
-	0xab, 0xf2, 0x19, 0x3a, 0x31, 0x2c, 0xc3, 0xec, 0x99, 0x87, 0x84, 0x7c, 0xca, 0xfe, 0x7e, 0x0c,
-	0xd5, 0xd1, 0xee, 0x44, 0x78, 0xe2, 0x39, 0x4c, 0x91, 0x9e, 0xc3, 0x36, 0xff, 0x2a, 0x40, 0xcc,
-	0xc3, 0x59, 0x29, 0x5a, 0x65, 0x97, 0x72, 0xa8, 0x0c, 0x68, 0xb8, 0xc6, 0x2e, 0x29, 0x68, 0x15,
-	0x6e, 0x27, 0x54, 0x5f, 0xa5, 0x3c, 0xda, 0x80, 0xbb, 0xa9, 0xa5, 0x69, 0xe9, 0x23, 0xc3, 0xa5,
-	0x96, 0x86, 0xa5, 0x8f, 0x63, 0x68, 0x1d, 0xaa, 0x69, 0x35, 0x5f, 0xe9, 0xe3, 0x38, 0xd2, 0xe0,
-	0x4e, 0x72, 0x75, 0x56, 0x2a, 0xa0, 0x35, 0x7f, 0xc7, 0x27, 0x56, 0x5a, 0xa5, 0xef, 0xf2, 0x68,
-	0x05, 0x6e, 0x8d, 0x2c, 0x9b, 0x4a, 0x63, 0xbe, 0x7a, 0x64, 0x59, 0x53, 0x2a, 0xa2, 0x0a, 0xa8,
-	0xa3, 0xf2, 0x6a, 0x69, 0x1c, 0xdd, 0x8d, 0x64, 0xdd, 0x48, 0x2e, 0x2c, 0xfd, 0x98, 0x47, 0x6b,
-	0x50, 0x95, 0x4a, 0x0a, 0x1f, 0xe6, 0x7f, 0x85, 0x61, 0x13, 0xbe, 0x23, 0xa9, 0x78, 0x88, 0x22,
-	0x7e, 0xca, 0xd7, 0x37, 0x5f, 0xd5, 0xda, 0x86, 0xd7, 0xe9, 0x5d, 0x6e, 0x35, 0xa9, 0xb9, 0xfd,
-	0x35, 0xa5, 0x97, 0x4d, 0xfe, 0xfb, 0xb0, 0x49, 0x1d, 0xb2, 0xdd, 0xa4, 0xa6, 0x49, 0xad, 0x6d,
-	0xb6, 0x65, 0x2f, 0xc7, 0xd9, 0x7b, 0xf2, 0xff, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xd9, 0xab,
-	0x3a, 0xd7, 0x1f, 0x17, 0x00, 0x00,
+	// 2601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x5a, 0x4b, 0x6f, 0x1c, 0xc7,
+	0xf1, 0xdf, 0xd9, 0xe5, 0xb3, 0xf8, 0xd0, 0xa8, 0xcd, 0xc7, 0x70, 0xb9, 0x14, 0x57, 0x23, 0x52,
+	0xa2, 0x29, 0x59, 0xb2, 0xf9, 0x17, 0xfc, 0x37, 0x04, 0x21, 0x01, 0x9f, 0x5e, 0xc2, 0xa4, 0xc5,
+	0x34, 0x29, 0x09, 0x50, 0x10, 0x24, 0xa3, 0xd9, 0x26, 0x39, 0xd1, 0xce, 0xcc, 0x7a, 0x66, 0x56,
+	0x12, 0xe3, 0x20, 0x80, 0xe4, 0xc8, 0xf1, 0x21, 0x06, 0x72, 0x08, 0x82, 0x5c, 0x72, 0x4c, 0x90,
+	0x73, 0xbe, 0x41, 0x6e, 0xf9, 0x1c, 0x81, 0x3f, 0x48, 0xd0, 0x8f, 0x9d, 0x9d, 0x9e, 0x37, 0xad,
+	0x0b, 0xc1, 0xae, 0xfa, 0x75, 0x55, 0x75, 0x77, 0x75, 0x55, 0x75, 0xcd, 0xc2, 0xbc, 0xed, 0xb6,
+	0x49, 0xe7, 0x5e, 0xe0, 0x19, 0x8e, 0x6f, 0x98, 0x81, 0xe5, 0x3a, 0x77, 0xbb, 0x9e, 0x1b, 0xb8,
+	0x68, 0x98, 0x31, 0xea, 0x0d, 0xce, 0xef, 0x7a, 0xae, 0x7b, 0xfa, 0xe8, 0xf4, 0xd1, 0x2b, 0x87,
+	0x78, 0xfe, 0xb9, 0xd5, 0xe5, 0xa0, 0xfa, 0x9c, 0xe0, 0x1a, 0x67, 0x96, 0x63, 0x0c, 0x26, 0xd7,
+	0x3f, 0xe0, 0x74, 0x8f, 0x98, 0xc4, 0xea, 0x06, 0x82, 0x28, 0x44, 0x39, 0x6e, 0x9b, 0x60, 0x72,
+	0x66, 0xf9, 0x81, 0x17, 0x9d, 0x82, 0x38, 0x97, 0xf8, 0xa6, 0xe7, 0xbe, 0x12, 0xb4, 0x3a, 0xa7,
+	0xd9, 0xbd, 0x4e, 0x60, 0x1d, 0x5b, 0x67, 0x8e, 0x11, 0xf4, 0x3c, 0x22, 0xab, 0x38, 0x25, 0xe4,
+	0x89, 0x1b, 0x10, 0x79, 0x82, 0x61, 0x9a, 0x6e, 0xcf, 0x09, 0xb6, 0x8c, 0x8e, 0xe1, 0x98, 0xe9,
+	0xbc, 0x1d, 0x23, 0x30, 0x7c, 0xd2, 0x37, 0x6d, 0x41, 0xe2, 0x1d, 0x90, 0xf6, 0x19, 0xf1, 0x64,
+	0x56, 0xc7, 0xfa, 0xaa, 0x67, 0xb5, 0x8f, 0x8c, 0x0b, 0x9b, 0x38, 0x62, 0x96, 0xfe, 0xaf, 0x3a,
+	0x4c, 0x9c, 0x0c, 0x36, 0x0e, 0x69, 0x30, 0xfa, 0x84, 0x78, 0xbe, 0xe5, 0x3a, 0x9a, 0xd2, 0x54,
+	0xd6, 0xa6, 0x70, 0x7f, 0x88, 0x10, 0x54, 0xf7, 0x77, 0xb4, 0x6a, 0x53, 0x59, 0xab, 0x6d, 0x55,
+	0x3f, 0x56, 0x70, 0x75, 0x7f, 0x07, 0x35, 0x60, 0x74, 0xab, 0xe3, 0x9a, 0x2f, 0xf6, 0x77, 0xb4,
+	0x5a, 0xc8, 0xe8, 0x93, 0xd0, 0x1c, 0x8c, 0xb4, 0x88, 0x75, 0x76, 0x1e, 0x68, 0x43, 0x4c, 0x94,
+	0x18, 0xa1, 0x0d, 0x98, 0x39, 0x26, 0x4e, 0x9b, 0x78, 0x9b, 0xdc, 0xd6, 0xcd, 0x76, 0xdb, 0x23,
+	0xbe, 0xaf, 0x0d, 0x37, 0x95, 0xb5, 0x49, 0x9c, 0xca, 0x43, 0x9f, 0xc1, 0x3c, 0x26, 0xa6, 0xd5,
+	0xb5, 0x88, 0x13, 0xc4, 0xa6, 0x8d, 0xb0, 0x69, 0x59, 0x6c, 0xb4, 0x06, 0x57, 0x22, 0x0b, 0x3c,
+	0xb9, 0xe8, 0x12, 0x6d, 0x94, 0x99, 0x13, 0x27, 0xa3, 0x19, 0xa8, 0xed, 0x11, 0xa2, 0x8d, 0x85,
+	0x2b, 0xa1, 0x43, 0xd4, 0x84, 0xf1, 0x13, 0xcb, 0x26, 0x7e, 0x60, 0xd8, 0x5d, 0x6d, 0x3c, 0xe4,
+	0x0d, 0x88, 0x31, 0x0d, 0x2d, 0xc3, 0x3f, 0xd7, 0x80, 0xd9, 0x14, 0x27, 0xa3, 0xfb, 0x30, 0x1b,
+	0x21, 0x6d, 0xb9, 0xed, 0x8b, 0x03, 0xe2, 0x9c, 0x05, 0xe7, 0xda, 0x04, 0xb3, 0x28, 0x9d, 0x49,
+	0xf7, 0x2b, 0xc6, 0xd8, 0xba, 0x08, 0x88, 0xaf, 0x4d, 0xf2, 0xfd, 0x4a, 0xe3, 0xa1, 0x75, 0x50,
+	0x23, 0xf4, 0x7d, 0xa7, 0x4d, 0x5e, 0x6b, 0x53, 0x4c, 0x49, 0x82, 0x8e, 0x56, 0x60, 0xea, 0x90,
+	0xba, 0xa7, 0x6f, 0x9d, 0x6d, 0x9f, 0x5b, 0x9d, 0xb6, 0x36, 0xdd, 0x54, 0xd6, 0xc6, 0xb0, 0x4c,
+	0x44, 0x3f, 0x83, 0x19, 0x62, 0x77, 0x83, 0x8b, 0x98, 0x3a, 0xed, 0x6a, 0x53, 0x59, 0x9b, 0xd8,
+	0x58, 0xbc, 0xcb, 0x7c, 0xec, 0xee, 0x6e, 0x0a, 0xa4, 0x55, 0xc1, 0xa9, 0x53, 0xd1, 0x53, 0x98,
+	0xf3, 0x89, 0xd3, 0x7e, 0xb6, 0xb5, 0x1d, 0x17, 0x8a, 0x98, 0xd0, 0x25, 0x21, 0xf4, 0x38, 0x15,
+	0xd4, 0xaa, 0xe0, 0x8c, 0xe9, 0xc8, 0x83, 0xe5, 0xf8, 0x15, 0x8d, 0x6b, 0xf8, 0x80, 0x69, 0xb8,
+	0x29, 0x34, 0x7c, 0x99, 0x8f, 0x6e, 0x55, 0x70, 0x91, 0x40, 0xf4, 0x7b, 0x05, 0x56, 0x7b, 0xdd,
+	0xb6, 0x11, 0x90, 0x02, 0x61, 0xda, 0x0c, 0x53, 0x7d, 0x47, 0xa8, 0x7e, 0x5c, 0x66, 0x4e, 0xab,
+	0x82, 0xcb, 0x09, 0x67, 0x66, 0x78, 0xc4, 0x76, 0x5f, 0x16, 0x9a, 0x31, 0x2b, 0x99, 0x81, 0xcb,
+	0xcc, 0xa1, 0x66, 0x94, 0x12, 0x8e, 0xde, 0x28, 0xb0, 0x62, 0x76, 0x0c, 0xcb, 0x2e, 0xb2, 0x62,
+	0x8e, 0x59, 0x71, 0x5b, 0x58, 0xb1, 0x5d, 0x62, 0x4a, 0xab, 0x82, 0x4b, 0x89, 0x46, 0x5f, 0x83,
+	0xee, 0x93, 0xa0, 0xd7, 0xdd, 0x94, 0xc2, 0x65, 0xdc, 0x80, 0x79, 0x66, 0xc0, 0x87, 0xa1, 0xab,
+	0x15, 0x4d, 0x68, 0x55, 0x70, 0x09, 0xb1, 0xe8, 0x77, 0x70, 0x83, 0xef, 0x54, 0xbe, 0x76, 0x8d,
+	0x69, 0x5f, 0x97, 0x0e, 0xa1, 0x48, 0x7d, 0x19, 0xc1, 0xa8, 0x03, 0x4b, 0x46, 0xb7, 0xeb, 0xb9,
+	0x2f, 0x8d, 0xce, 0x2e, 0xcb, 0x47, 0x71, 0xcd, 0x0b, 0x4c, 0xf3, 0x8a, 0xd0, 0xbc, 0x99, 0x87,
+	0x6d, 0x55, 0x70, 0xbe, 0x30, 0xaa, 0x4d, 0xce, 0x70, 0x71, 0x6d, 0x75, 0x49, 0xdb, 0x61, 0x1e,
+	0x96, 0x6a, 0xcb, 0x15, 0x86, 0x2c, 0x68, 0x88, 0x9c, 0xb9, 0xed, 0xda, 0xb6, 0x95, 0xd8, 0xd4,
+	0x45, 0xa6, 0xec, 0x86, 0x50, 0xb6, 0x97, 0x03, 0x6d, 0x55, 0x70, 0xae, 0xa8, 0x88, 0x2a, 0x4c,
+	0x5e, 0x12, 0xa3, 0x13, 0x57, 0xd5, 0x48, 0x53, 0x95, 0x0a, 0x8d, 0xa8, 0x4a, 0xe5, 0x53, 0x55,
+	0x52, 0x86, 0x8e, 0xab, 0x5a, 0x92, 0x54, 0x1d, 0xe4, 0x40, 0xa9, 0xaa, 0x3c, 0x51, 0xa8, 0x07,
+	0x4d, 0x89, 0x7f, 0x1c, 0xb8, 0xdd, 0xb8, 0xba, 0x6b, 0x4c, 0xdd, 0xad, 0x34, 0x75, 0x29, 0xf0,
+	0x56, 0x05, 0x17, 0x8a, 0xa4, 0x6a, 0x03, 0xcb, 0x26, 0x07, 0xae, 0xf9, 0x82, 0xb4, 0xd3, 0x83,
+	0xba, 0xa6, 0x4b, 0x6a, 0x4f, 0x0a, 0xe0, 0x54, 0x6d, 0x91, 0x48, 0x74, 0x0a, 0x8b, 0xdc, 0x9f,
+	0xd2, 0x35, 0xde, 0x60, 0x1a, 0x75, 0xc9, 0x35, 0xb3, 0x94, 0xe5, 0x09, 0x42, 0xcf, 0x60, 0xfe,
+	0x3c, 0xe8, 0x98, 0xd4, 0x96, 0xb8, 0x8e, 0x15, 0xa6, 0xe3, 0x9a, 0xd0, 0xd1, 0x4a, 0x47, 0xb5,
+	0x2a, 0x38, 0x4b, 0x00, 0xfa, 0x05, 0x68, 0x94, 0xc5, 0xe2, 0x63, 0x5c, 0xf8, 0x2a, 0x13, 0xbe,
+	0x1c, 0x11, 0x9e, 0x06, 0x6b, 0x55, 0x70, 0xa6, 0x08, 0xf4, 0x2b, 0x58, 0xa0, 0x3c, 0x4c, 0x4e,
+	0x7b, 0x4e, 0x3b, 0x2e, 0xff, 0x26, 0x93, 0xdf, 0x8c, 0xc8, 0x4f, 0xc5, 0xb5, 0x2a, 0x38, 0x5b,
+	0x08, 0x4b, 0x08, 0x92, 0x83, 0x3c, 0xb5, 0x82, 0xf3, 0xb6, 0x67, 0x24, 0xe2, 0xd2, 0x2d, 0x29,
+	0x21, 0x1c, 0x94, 0x98, 0x42, 0x13, 0x42, 0x19, 0xd1, 0x2c, 0x37, 0xca, 0x91, 0x05, 0xbb, 0x41,
+	0x6a, 0x56, 0x5a, 0x93, 0x72, 0xe3, 0x61, 0x99, 0x39, 0x34, 0x37, 0x96, 0x12, 0x4e, 0x53, 0x83,
+	0x0c, 0xdc, 0x36, 0x1c, 0x93, 0x24, 0x42, 0xcb, 0x87, 0x52, 0x6a, 0x38, 0x2c, 0x9e, 0x41, 0x53,
+	0x43, 0x09, 0xc1, 0xe8, 0x2f, 0x0a, 0xdc, 0xf6, 0x58, 0xde, 0x24, 0x9e, 0x9c, 0x44, 0x8e, 0xcd,
+	0x73, 0x62, 0x1b, 0x71, 0x43, 0xd6, 0x99, 0x21, 0x1b, 0x61, 0x8e, 0x2a, 0x3d, 0xb3, 0x55, 0xc1,
+	0x97, 0x51, 0x84, 0x1a, 0x30, 0x1e, 0x9a, 0xae, 0x2d, 0xb3, 0xea, 0x76, 0x40, 0x40, 0xab, 0x30,
+	0xc2, 0x93, 0x8f, 0xd6, 0x64, 0x06, 0x4d, 0xf5, 0x4b, 0x4e, 0x46, 0xc4, 0x82, 0x49, 0x5f, 0x30,
+	0x87, 0xc4, 0xf7, 0x8d, 0x33, 0xa2, 0x5d, 0x67, 0x22, 0xfa, 0x43, 0x5a, 0x7d, 0x63, 0xd2, 0xed,
+	0x18, 0x26, 0x89, 0x7a, 0xe7, 0xfe, 0x8e, 0x76, 0x9b, 0x56, 0xf5, 0x38, 0x9d, 0xb9, 0x75, 0x15,
+	0xae, 0xc4, 0xec, 0xd4, 0xe7, 0x60, 0x26, 0xad, 0xce, 0xd5, 0xef, 0xc3, 0x5c, 0x46, 0x68, 0xa8,
+	0xc3, 0xc8, 0xa6, 0x4d, 0xd7, 0xaf, 0x29, 0xe1, 0x0b, 0x42, 0x50, 0xf4, 0xff, 0x28, 0xb0, 0x5c,
+	0x54, 0xca, 0xac, 0xc0, 0x14, 0x85, 0x1c, 0xf5, 0x9e, 0x77, 0x2c, 0xf3, 0x0b, 0x72, 0xc1, 0xc4,
+	0x4c, 0x62, 0x99, 0x88, 0x6e, 0xc2, 0x74, 0xec, 0x6d, 0x54, 0x65, 0xb0, 0xe9, 0xc4, 0x93, 0x68,
+	0x8a, 0x07, 0x4c, 0xf1, 0xba, 0x8c, 0x3c, 0xde, 0x64, 0x06, 0xfa, 0x08, 0x86, 0x8f, 0x5c, 0xf7,
+	0x95, 0xc3, 0x5e, 0x70, 0x13, 0x1b, 0xf3, 0x62, 0xcb, 0x8f, 0x62, 0x4f, 0x69, 0xcc, 0x51, 0xfa,
+	0x3f, 0x14, 0x58, 0x2d, 0x55, 0xcf, 0x96, 0x5c, 0x50, 0xc2, 0xd0, 0x6a, 0xa1, 0xa1, 0xb5, 0x52,
+	0x86, 0x1e, 0xc2, 0x6a, 0xa9, 0x82, 0xb7, 0x9c, 0x9d, 0xfa, 0xd7, 0xb0, 0x52, 0xa6, 0x72, 0x2d,
+	0xb9, 0xea, 0x70, 0x2d, 0xd5, 0x52, 0x6b, 0xf9, 0xa7, 0x02, 0x7a, 0x71, 0xd9, 0x8a, 0xea, 0x30,
+	0x76, 0xe4, 0xb9, 0x5d, 0xe2, 0x05, 0x5c, 0xed, 0x38, 0x0e, 0xc7, 0x68, 0x06, 0x86, 0x9f, 0x18,
+	0x9d, 0x1e, 0xdf, 0xdf, 0x71, 0xcc, 0x07, 0x48, 0x87, 0xc9, 0xdd, 0xd7, 0x5d, 0xcb, 0xbb, 0x10,
+	0xaf, 0xf8, 0x1a, 0x7b, 0x3f, 0x4a, 0x34, 0x74, 0x07, 0xae, 0xf0, 0xf1, 0xe0, 0x8d, 0x3c, 0x14,
+	0x9e, 0x51, 0x9c, 0xa5, 0x3f, 0x85, 0x1b, 0x25, 0x4a, 0xdc, 0xcb, 0x9b, 0xaa, 0xff, 0x55, 0x81,
+	0xa5, 0xdc, 0x12, 0x16, 0x7d, 0x02, 0x63, 0x7d, 0x00, 0x93, 0x39, 0xbd, 0x31, 0x2b, 0xc5, 0x8f,
+	0x3e, 0x13, 0x87, 0x30, 0xea, 0x7d, 0x72, 0x9c, 0x88, 0x78, 0x9f, 0xc4, 0x88, 0x5c, 0xef, 0x5a,
+	0xe2, 0x7a, 0xff, 0x57, 0x81, 0xa5, 0xdc, 0x7a, 0x17, 0xed, 0x03, 0x92, 0x01, 0xfb, 0xce, 0xa9,
+	0xcb, 0x8c, 0x9c, 0xd8, 0x58, 0x48, 0x0d, 0xff, 0x14, 0x80, 0x53, 0x26, 0xa1, 0x07, 0xa0, 0x3d,
+	0x76, 0x7c, 0xeb, 0xcc, 0x91, 0xa2, 0x18, 0x6f, 0x17, 0xf0, 0x58, 0x90, 0xc9, 0x47, 0x0f, 0x60,
+	0x4a, 0xb6, 0x80, 0x5f, 0xa5, 0x99, 0xfe, 0xcb, 0x48, 0x52, 0x2e, 0x43, 0xf5, 0x07, 0xd0, 0xc8,
+	0x2b, 0xb3, 0xe9, 0x89, 0x52, 0x26, 0xeb, 0x8d, 0x70, 0x9f, 0x0f, 0xc7, 0xfa, 0x6f, 0xc3, 0xb9,
+	0xe9, 0x75, 0xf1, 0x7d, 0x98, 0x10, 0xfc, 0xc8, 0xbe, 0x20, 0xb9, 0xe2, 0x66, 0x36, 0x45, 0x61,
+	0x34, 0x16, 0xd2, 0xff, 0xbd, 0x41, 0x42, 0x11, 0xb1, 0x50, 0xa6, 0xea, 0xe7, 0xd0, 0xc8, 0x2b,
+	0xa5, 0xf3, 0x22, 0x37, 0x75, 0xfe, 0x6d, 0xd7, 0xee, 0x76, 0x48, 0x40, 0x0e, 0x2d, 0xa7, 0xd7,
+	0xdf, 0xe4, 0x21, 0xee, 0xfc, 0x31, 0x96, 0x7e, 0x00, 0xcd, 0xa2, 0x2a, 0x3a, 0xe9, 0x72, 0x4a,
+	0x86, 0xcb, 0xe9, 0xb7, 0x61, 0xf6, 0x73, 0xe9, 0xe6, 0x60, 0xf2, 0x55, 0x8f, 0xf8, 0x81, 0xe8,
+	0xd3, 0x29, 0xd1, 0x3e, 0x9d, 0xfe, 0xef, 0x2a, 0xcc, 0xc9, 0x68, 0xbf, 0x0f, 0x4f, 0xe6, 0x0c,
+	0x25, 0x35, 0x67, 0x0c, 0x9a, 0x79, 0x55, 0xa9, 0x99, 0xb7, 0x0e, 0xd3, 0xe1, 0xfd, 0x3e, 0x0e,
+	0x0c, 0x2f, 0x7a, 0x05, 0x62, 0x1c, 0x74, 0x13, 0x26, 0x43, 0xca, 0xae, 0xd3, 0x8e, 0x44, 0x0a,
+	0x89, 0x9e, 0xd6, 0xb2, 0x1b, 0x4e, 0x6f, 0xd9, 0x7d, 0x02, 0x70, 0x14, 0x36, 0x6e, 0x59, 0x27,
+	0x70, 0x62, 0xe3, 0x6a, 0x3f, 0x5e, 0x86, 0x0c, 0x1c, 0x01, 0xd1, 0x22, 0x63, 0xcf, 0x73, 0x6d,
+	0xd6, 0xa4, 0x14, 0x9d, 0xc0, 0x01, 0x81, 0x56, 0x0f, 0x27, 0x2e, 0xe7, 0x8d, 0xf1, 0xfe, 0xa7,
+	0x18, 0xea, 0x2f, 0x60, 0x3e, 0xb1, 0x85, 0x7e, 0xd7, 0x75, 0x7c, 0x82, 0x34, 0x18, 0x3e, 0x71,
+	0x03, 0x11, 0x58, 0xf8, 0xe9, 0x73, 0x02, 0xfa, 0x14, 0x26, 0xa3, 0x33, 0xb4, 0x6a, 0xb3, 0x16,
+	0x71, 0xde, 0xe8, 0xe9, 0x49, 0x38, 0x7d, 0x07, 0xe6, 0x8e, 0x5c, 0x3f, 0xed, 0x78, 0xe5, 0xc6,
+	0x1e, 0xbf, 0xd9, 0xfc, 0xc4, 0x12, 0x74, 0xfd, 0x11, 0xcc, 0x27, 0xa4, 0x08, 0x93, 0xef, 0x4b,
+	0x6d, 0xdf, 0xd8, 0xa5, 0x8a, 0x4e, 0x88, 0xc2, 0xf4, 0x3f, 0x2a, 0xbc, 0xc2, 0x79, 0x3f, 0xbb,
+	0xe8, 0x11, 0x6c, 0x9f, 0x1b, 0x16, 0x3f, 0x59, 0xea, 0x4e, 0xc3, 0x78, 0x40, 0xa0, 0xa7, 0xcf,
+	0x5b, 0xc0, 0x83, 0x34, 0x59, 0xe3, 0xed, 0xd4, 0x18, 0x59, 0xdf, 0x86, 0xf9, 0x84, 0x35, 0x62,
+	0x7d, 0x6b, 0x30, 0x8a, 0x79, 0xe7, 0x5e, 0xac, 0x6d, 0x3a, 0x2c, 0x5f, 0x19, 0x15, 0xf7, 0xd9,
+	0xfa, 0x3b, 0x05, 0x96, 0xc5, 0x22, 0xd8, 0x41, 0x67, 0x5c, 0x12, 0xe9, 0xf6, 0xd1, 0xa5, 0xd5,
+	0xd6, 0x6a, 0x38, 0x46, 0x2d, 0x58, 0x58, 0x6e, 0xb7, 0x5c, 0xff, 0xb3, 0x02, 0x0d, 0xba, 0x9a,
+	0x4c, 0x23, 0x24, 0xe1, 0x4a, 0x5c, 0xf8, 0x1d, 0xb8, 0x1a, 0x9d, 0xd4, 0x0f, 0xf9, 0xb5, 0xb5,
+	0x49, 0x9c, 0x64, 0x5c, 0x62, 0x8f, 0xbf, 0x80, 0xa5, 0x0c, 0xab, 0xc4, 0x4e, 0xaf, 0xc3, 0x98,
+	0xd8, 0x4a, 0xbe, 0x2b, 0xc9, 0xad, 0x0e, 0xf9, 0xfa, 0x21, 0x2c, 0xcb, 0x77, 0xe8, 0xd0, 0x72,
+	0x2c, 0xbb, 0x67, 0xef, 0x11, 0xf2, 0x63, 0xfc, 0xfb, 0x33, 0x68, 0x66, 0x8b, 0x13, 0xe6, 0x89,
+	0xa6, 0xbe, 0x22, 0x35, 0xf5, 0xf5, 0x16, 0xd4, 0x8f, 0x2d, 0xbb, 0xd7, 0x31, 0x02, 0xf2, 0x9e,
+	0x77, 0xec, 0x87, 0x1a, 0x2c, 0xa6, 0x8a, 0x7a, 0x9f, 0x8b, 0x46, 0xf3, 0xe5, 0xa6, 0x69, 0x92,
+	0x6e, 0x40, 0xda, 0xcc, 0x8f, 0xc6, 0x70, 0x38, 0xa6, 0x67, 0x87, 0xc9, 0xaf, 0x89, 0x50, 0x63,
+	0xf8, 0x2e, 0x2f, 0x7a, 0xc7, 0x71, 0x9c, 0x8c, 0x74, 0x80, 0xc1, 0x8e, 0x44, 0xa2, 0x6d, 0x84,
+	0x8a, 0x1e, 0x86, 0xf1, 0x9f, 0x7f, 0x32, 0xa2, 0x9f, 0x61, 0x6a, 0x91, 0xb4, 0x2f, 0x31, 0x71,
+	0x0c, 0x8b, 0x7e, 0x0a, 0x57, 0x36, 0xa5, 0x0f, 0x55, 0xf4, 0x73, 0x0c, 0x9d, 0x3e, 0x2b, 0x4f,
+	0x17, 0x5c, 0x1c, 0x47, 0x47, 0x04, 0x88, 0x5a, 0xd0, 0xd7, 0x46, 0xd3, 0x04, 0x08, 0x2e, 0x8e,
+	0xa3, 0xd1, 0x2d, 0x18, 0xe5, 0x05, 0x9c, 0xaf, 0x8d, 0x35, 0x6b, 0xc9, 0x67, 0x61, 0x9f, 0x4b,
+	0x17, 0x2a, 0xa5, 0x5f, 0x5f, 0x1b, 0x97, 0x16, 0x2a, 0x31, 0x71, 0x0c, 0xab, 0xef, 0x26, 0x42,
+	0xa9, 0x9f, 0xef, 0x2d, 0xb5, 0x54, 0x6f, 0xf9, 0x5e, 0x81, 0xd9, 0x64, 0x48, 0xee, 0x75, 0x02,
+	0x74, 0x1f, 0x46, 0x8e, 0x03, 0x23, 0xe8, 0xf9, 0xa2, 0x3a, 0x6d, 0xf4, 0xb3, 0x98, 0x8c, 0xe6,
+	0x18, 0x2c, 0xb0, 0x97, 0x28, 0x51, 0x67, 0x60, 0x78, 0xd7, 0xf3, 0x5c, 0x4f, 0xf8, 0x0a, 0x1f,
+	0xe8, 0x18, 0xb4, 0xe4, 0xb2, 0x84, 0xe7, 0x7e, 0x0a, 0xa3, 0xdc, 0xb6, 0xfe, 0xbd, 0xce, 0x30,
+	0x89, 0x83, 0x70, 0x1f, 0xac, 0xff, 0x49, 0x81, 0x66, 0x51, 0xdf, 0x2e, 0xb7, 0xac, 0xd2, 0x61,
+	0xf2, 0xb1, 0x43, 0xe3, 0x8d, 0x54, 0x70, 0x48, 0x34, 0x5a, 0x7a, 0xf1, 0xf1, 0xe0, 0xdd, 0x31,
+	0x88, 0xa9, 0x71, 0x96, 0xfe, 0x4b, 0x98, 0x8d, 0xf6, 0xf5, 0xc2, 0x4f, 0x85, 0xf4, 0xec, 0xc2,
+	0x81, 0x5c, 0xff, 0x24, 0xe8, 0x11, 0x93, 0xab, 0x89, 0x22, 0xff, 0xe7, 0xb0, 0x98, 0xd3, 0x38,
+	0x44, 0x0f, 0x01, 0x42, 0x71, 0xf1, 0xdd, 0x4c, 0x35, 0x0c, 0x47, 0xf0, 0xfa, 0x05, 0xcc, 0x67,
+	0x74, 0x0c, 0x73, 0xb7, 0xb1, 0x0e, 0x63, 0xb4, 0xbe, 0xa6, 0xd3, 0x44, 0xed, 0x1b, 0x8e, 0x59,
+	0x42, 0x13, 0x47, 0x24, 0x3d, 0xee, 0x62, 0x54, 0xea, 0x1f, 0x59, 0xfd, 0x44, 0xaa, 0x9b, 0xf2,
+	0xa4, 0x62, 0x53, 0x50, 0xf8, 0x0b, 0x8e, 0x58, 0x36, 0xed, 0xc2, 0x08, 0xdd, 0xfd, 0xb1, 0xfe,
+	0xff, 0xb0, 0x90, 0xd9, 0x43, 0xcc, 0x13, 0xaa, 0x1f, 0xc1, 0x4a, 0x99, 0x76, 0xe0, 0x25, 0x8a,
+	0xe8, 0xef, 0x15, 0x58, 0x2d, 0xd5, 0xdc, 0xa3, 0xe9, 0x55, 0x04, 0xcd, 0x10, 0xea, 0x8b, 0x2f,
+	0xe4, 0x49, 0x06, 0x4d, 0xd5, 0xc2, 0x6b, 0xc2, 0x24, 0x3c, 0x20, 0xd0, 0x1a, 0xf3, 0x29, 0xdb,
+	0x5e, 0x5f, 0xab, 0x35, 0x6b, 0xb4, 0xc6, 0x14, 0x43, 0xfd, 0x11, 0xdc, 0x28, 0xd1, 0xe7, 0x4b,
+	0xfb, 0xe0, 0xac, 0xa4, 0x7e, 0x70, 0xd6, 0xff, 0xae, 0xc0, 0xed, 0x4b, 0x34, 0xec, 0x72, 0x5f,
+	0xde, 0x0f, 0x61, 0x9c, 0x3d, 0xb6, 0xc3, 0xe2, 0x66, 0x3a, 0x6c, 0x68, 0xcb, 0xa2, 0x43, 0x14,
+	0x1e, 0x4c, 0xa0, 0x5b, 0x72, 0x68, 0xbc, 0x16, 0x9f, 0xbb, 0xb9, 0xb3, 0x0d, 0x08, 0xb4, 0xca,
+	0xd8, 0xf5, 0x03, 0xcb, 0x96, 0x93, 0xe8, 0x8f, 0x2c, 0x0b, 0x3e, 0x67, 0x0f, 0xc6, 0xbe, 0x3c,
+	0x9a, 0x05, 0xf7, 0x08, 0x39, 0x22, 0x1e, 0xe5, 0x46, 0x7c, 0x21, 0x42, 0xed, 0x57, 0x09, 0x55,
+	0xb9, 0x4a, 0xf8, 0x41, 0x81, 0x6b, 0x59, 0x66, 0x89, 0x20, 0x29, 0xa7, 0x58, 0x25, 0x35, 0xc5,
+	0x7e, 0x0c, 0xe3, 0x5f, 0x92, 0xd7, 0xbc, 0xba, 0xd4, 0xaa, 0x52, 0x01, 0x10, 0xb1, 0x13, 0x0f,
+	0x40, 0xe8, 0x27, 0xa0, 0x52, 0xe7, 0xb6, 0x9c, 0x3d, 0xeb, 0x25, 0x61, 0x24, 0x5f, 0xab, 0x65,
+	0x4e, 0x4c, 0x60, 0xd1, 0x1d, 0x18, 0xdd, 0x35, 0x5d, 0xc7, 0xb5, 0x2f, 0xb4, 0xa1, 0xcc, 0x69,
+	0x7d, 0xc8, 0xfa, 0xdf, 0x46, 0x20, 0xe5, 0xb7, 0x10, 0x6a, 0xbc, 0xc5, 0xa9, 0x56, 0xd0, 0x1c,
+	0xa0, 0x64, 0x84, 0x53, 0x15, 0xb4, 0x0c, 0x8b, 0x39, 0xad, 0x2f, 0xb5, 0x8a, 0x6e, 0xc2, 0xf5,
+	0xc2, 0xbe, 0xa0, 0xfa, 0x96, 0xe1, 0x0a, 0xfb, 0x72, 0xea, 0xdb, 0x21, 0xb4, 0x0a, 0xcd, 0xa2,
+	0x86, 0x9b, 0xfa, 0x76, 0x04, 0xe9, 0x70, 0x2d, 0xbf, 0x33, 0xa6, 0xd6, 0xd0, 0x0a, 0x2c, 0x73,
+	0x95, 0xd9, 0xa0, 0x6f, 0xaa, 0x68, 0x09, 0x16, 0x32, 0xfb, 0x4b, 0xea, 0x10, 0x65, 0x67, 0xf6,
+	0x78, 0xd4, 0x61, 0xd4, 0x00, 0x2d, 0xab, 0xc9, 0xa0, 0x8e, 0xa0, 0xeb, 0xd0, 0xc8, 0x6b, 0x0c,
+	0xa8, 0xdf, 0x56, 0xd1, 0x0a, 0x34, 0xa5, 0xfe, 0x0a, 0x85, 0xd1, 0x51, 0x14, 0x36, 0x4a, 0x05,
+	0x49, 0x9d, 0x94, 0x38, 0xe2, 0x0f, 0x55, 0x0a, 0xc9, 0xcb, 0xcd, 0xea, 0x9b, 0x2a, 0x6a, 0xc0,
+	0x7c, 0x46, 0x2e, 0x53, 0xdf, 0x0c, 0xa1, 0x79, 0xf8, 0x20, 0x25, 0x19, 0xa9, 0x63, 0x68, 0x01,
+	0x66, 0xd2, 0x52, 0x85, 0xfa, 0x5d, 0x15, 0xd5, 0x61, 0x36, 0x35, 0xe2, 0xab, 0xdf, 0xb1, 0x93,
+	0x2c, 0x0a, 0xea, 0xea, 0xb7, 0x43, 0xd4, 0x31, 0x0a, 0x03, 0xb5, 0xfa, 0x8e, 0x6e, 0xd4, 0x72,
+	0x41, 0x04, 0x55, 0xdf, 0x0d, 0xa1, 0x3b, 0x70, 0xab, 0x64, 0x54, 0x54, 0xbf, 0x19, 0x5a, 0x77,
+	0x12, 0x35, 0x9b, 0xa8, 0xbe, 0x16, 0x13, 0x45, 0x61, 0xbf, 0x48, 0x57, 0x2b, 0xf4, 0xcc, 0x63,
+	0xcc, 0x9d, 0x5e, 0xb7, 0x63, 0x99, 0x46, 0x40, 0x54, 0x05, 0xd5, 0x13, 0x0f, 0xfc, 0x7d, 0xe7,
+	0xa5, 0xd1, 0xb1, 0xda, 0x6a, 0x75, 0x6b, 0xfd, 0xd9, 0xda, 0x99, 0x15, 0x9c, 0xf7, 0x9e, 0xdf,
+	0x35, 0x5d, 0xfb, 0xde, 0x6f, 0x5c, 0xf7, 0xb9, 0xc9, 0xff, 0x7e, 0x64, 0xba, 0x1e, 0xb9, 0x67,
+	0xba, 0xb6, 0xed, 0x3a, 0xf7, 0xd8, 0x85, 0x7e, 0x3e, 0xc2, 0x7e, 0xc6, 0xf5, 0x7f, 0xff, 0x1b,
+	0x00, 0xef, 0xe2, 0x54, 0x2e, 0x04, 0x27, 0x00, 0x00,
 }
@@ -52,17 +52,26 @@ package model
 // TransactionBodyInterface allowing isTransaction_TransactionBody access from other package
 type TransactionBodyInterface = isTransaction_TransactionBody
 
-func (*NodeRegistrationTransactionBody) isTransaction_TransactionBody()       {}
-func (*UpdateNodeRegistrationTransactionBody) isTransaction_TransactionBody() {}
-func (*RemoveNodeRegistrationTransactionBody) isTransaction_TransactionBody() {}
-func (*ClaimNodeRegistrationTransactionBody) isTransaction_TransactionBody()  {}
-func (*EmptyTransactionBody) isTransaction_TransactionBody()                  {}
-func (*SendZBCTransactionBody) isTransaction_TransactionBody()                {}
-func (*SetupAccountDatasetTransactionBody) isTransaction_TransactionBody()    {}
-func (*RemoveAccountDatasetTransactionBody) isTransaction_TransactionBody()   {}
-func (*ApprovalEscrowTransactionBody) isTransaction_TransactionBody()         {}
-func (*MultiSignatureTransactionBody) isTransaction_TransactionBody()         {}
-func (*FeeVoteCommitTransactionBody) isTransaction_TransactionBody()          {}
-func (*FeeVoteRevealTransactionBody) isTransaction_TransactionBody()          {}
-func (*LiquidPaymentTransactionBody) isTransaction_TransactionBody()          {}
-func (*LiquidPaymentStopTransactionBody) isTransaction_TransactionBody()      {}
+func (*NodeRegistrationTransactionBody) isTransaction_TransactionBody()             {}
+func (*UpdateNodeRegistrationTransactionBody) isTransaction_TransactionBody()       {}
+func (*RemoveNodeRegistrationTransactionBody) isTransaction_TransactionBody()       {}
+func (*ClaimNodeRegistrationTransactionBody) isTransaction_TransactionBody()        {}
+func (*EmptyTransactionBody) isTransaction_TransactionBody()                        {}
+func (*SendZBCTransactionBody) isTransaction_TransactionBody()                      {}
+func (*SetupAccountDatasetTransactionBody) isTransaction_TransactionBody()          {}
+func (*RemoveAccountDatasetTransactionBody) isTransaction_TransactionBody()         {}
+func (*ApprovalEscrowTransactionBody) isTransaction_TransactionBody()               {}
+func (*MultiSignatureTransactionBody) isTransaction_TransactionBody()               {}
+func (*FeeVoteCommitTransactionBody) isTransaction_TransactionBody()                {}
+func (*FeeVoteRevealTransactionBody) isTransaction_TransactionBody()                {}
+func (*LiquidPaymentTransactionBody) isTransaction_TransactionBody()                {}
+func (*LiquidPaymentStopTransactionBody) isTransaction_TransactionBody()            {}
+func (*LiquidPaymentWithdrawTransactionBody) isTransaction_TransactionBody()        {}
+func (*TimeLockedSendZBCTransactionBody) isTransaction_TransactionBody()            {}
+func (*MultiSendZBCTransactionBody) isTransaction_TransactionBody()                 {}
+func (*HtlcLockTransactionBody) isTransaction_TransactionBody()                     {}
+func (*HtlcClaimTransactionBody) isTransaction_TransactionBody()                    {}
+func (*HtlcRefundTransactionBody) isTransaction_TransactionBody()                   {}
+func (*MultiSignatureRotationTransactionBody) isTransaction_TransactionBody()       {}
+func (*MultiSignatureCancelTransactionBody) isTransaction_TransactionBody()         {}
+func (*RegisterAccountDatasetSchemaTransactionBody) isTransaction_TransactionBody() {}
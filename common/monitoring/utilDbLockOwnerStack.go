@@ -27,6 +27,8 @@ const (
 	InsertSnapshotPayloadToDBOwnerProcess        = 19
 	CreateSpineBlockManifestOwnerProcess         = 20
 	ExpiringEscrowTransactionsOwnerProcess       = 21
+	SimulateTransactionServiceOwnerProcess       = 22
+	AddDetachedSignatureOwnerProcess             = 23
 )
 
 // setting a big number to avoid losing count of important process
@@ -71,6 +71,8 @@ type (
 		InsertAccountDatasets(datasets []*model.AccountDataset) (str string, args []interface{})
 		InsertAccountDataset(dataset *model.AccountDataset) [][]interface{}
 		GetAccountDatasetEscrowApproval(accountAddress []byte) (qStr string, args []interface{})
+		GetExpiredAccountDatasets(blockHeight uint32, blockTimestamp int64) (qStr string, args []interface{})
+		GetUnmetAccountDatasetSpendingRestriction(senderAccountAddress, recipientAccountAddress []byte) (qStr string, args []interface{})
 		ExtractModel(dataset *model.AccountDataset) []interface{}
 		BuildModel(datasets []*model.AccountDataset, rows *sql.Rows) ([]*model.AccountDataset, error)
 		Scan(dataset *model.AccountDataset, row *sql.Row) error
@@ -88,6 +90,8 @@ func NewAccountDatasetsQuery() *AccountDatasetQuery {
 			"is_active",
 			"latest",
 			"height",
+			"expiry_height",
+			"expiry_timestamp",
 		},
 		TableName: "account_dataset",
 	}
@@ -195,7 +199,7 @@ func (adq *AccountDatasetQuery) InsertAccountDataset(dataset *model.AccountDatas
 				fmt.Sprintf(
 					"INSERT INTO %s (%s) VALUES(%s) "+
 						"ON CONFLICT(setter_account_address, recipient_account_address, property, height) "+
-						"DO UPDATE SET value = ?, is_active = ?, latest = ?",
+						"DO UPDATE SET value = ?, is_active = ?, latest = ?, expiry_height = ?, expiry_timestamp = ?",
 					adq.getTableName(),
 					strings.Join(adq.Fields, ", "),
 					fmt.Sprintf("?%s", strings.Repeat(", ?", len(adq.Fields)-1)),
@@ -206,6 +210,8 @@ func (adq *AccountDatasetQuery) InsertAccountDataset(dataset *model.AccountDatas
 				dataset.GetValue(),
 				dataset.GetIsActive(),
 				dataset.GetLatest(),
+				dataset.GetExpiryHeight(),
+				dataset.GetExpiryTimestamp(),
 			)...,
 		),
 	}
@@ -215,15 +221,71 @@ func (adq *AccountDatasetQuery) InsertAccountDataset(dataset *model.AccountDatas
 // SetterAccountAddress and RecipientAccountAddress must be the same person
 func (adq *AccountDatasetQuery) GetAccountDatasetEscrowApproval(accountAddress []byte) (qStr string, args []interface{}) {
 	return fmt.Sprintf(
-			"SELECT %s FROM %s WHERE setter_account_address = ? AND recipient_account_address = ? AND property = ? AND latest = ?",
-			strings.Join(adq.Fields, ", "),
-			adq.getTableName(),
-		), []interface{}{
-			accountAddress,
-			accountAddress,
-			model.AccountDatasetProperty_AccountDatasetEscrowApproval.String(),
-			1,
-		}
+		"SELECT %s FROM %s WHERE setter_account_address = ? AND recipient_account_address = ? AND property = ? AND latest = ?",
+		strings.Join(adq.Fields, ", "),
+		adq.getTableName(),
+	), []interface{}{
+		accountAddress,
+		accountAddress,
+		model.AccountDatasetProperty_AccountDatasetEscrowApproval.String(),
+		1,
+	}
+}
+
+// GetExpiredAccountDatasets represents query for get the active datasets which expiry height or expiry timestamp is reached by the block
+func (adq *AccountDatasetQuery) GetExpiredAccountDatasets(blockHeight uint32, blockTimestamp int64) (qStr string, args []interface{}) {
+	return fmt.Sprintf(
+		"SELECT %s FROM %s WHERE is_active = ? AND latest = ? AND "+
+			"((expiry_height > 0 AND expiry_height <= ?) OR (expiry_timestamp > 0 AND expiry_timestamp <= ?)) "+
+			"ORDER BY setter_account_address, recipient_account_address, property",
+		strings.Join(adq.Fields, ", "),
+		adq.getTableName(),
+	), []interface{}{
+		true,
+		true,
+		blockHeight,
+		blockTimestamp,
+	}
+}
+
+/*
+GetUnmetAccountDatasetSpendingRestriction represents query for get the first active AccountDatasetSpendingRestriction dataset
+of the sender which the recipient doesn't satisfy. A restriction only applies once the sender consented to it with an active
+AccountDatasetSpendingRestrictionConsent dataset set on the trusted setter. It's unmet when the recipient isn't the trusted
+setter of the restriction, and doesn't carry an active dataset of the restriction value as property set by the same trusted setter
+*/
+func (adq *AccountDatasetQuery) GetUnmetAccountDatasetSpendingRestriction(
+	senderAccountAddress, recipientAccountAddress []byte,
+) (qStr string, args []interface{}) {
+	var restrictionFields = make([]string, len(adq.Fields))
+	for i, field := range adq.Fields {
+		restrictionFields[i] = fmt.Sprintf("r.%s", field)
+	}
+	return fmt.Sprintf(
+		"SELECT %s FROM %s r WHERE r.recipient_account_address = ? AND r.property = ? AND r.is_active = ? AND r.latest = ? "+
+			"AND r.setter_account_address <> ? AND EXISTS (SELECT 1 FROM %s c "+
+			"WHERE c.setter_account_address = r.recipient_account_address AND c.recipient_account_address = r.setter_account_address "+
+			"AND c.property = ? AND c.is_active = ? AND c.latest = ?) AND NOT EXISTS (SELECT 1 FROM %s w "+
+			"WHERE w.setter_account_address = r.setter_account_address AND w.recipient_account_address = ? "+
+			"AND w.property = r.value AND w.is_active = ? AND w.latest = ?) "+
+			"ORDER BY r.setter_account_address LIMIT 1",
+		strings.Join(restrictionFields, ", "),
+		adq.getTableName(),
+		adq.getTableName(),
+		adq.getTableName(),
+	), []interface{}{
+		senderAccountAddress,
+		model.AccountDatasetProperty_AccountDatasetSpendingRestriction.String(),
+		true,
+		true,
+		recipientAccountAddress,
+		model.AccountDatasetProperty_AccountDatasetSpendingRestrictionConsent.String(),
+		true,
+		true,
+		recipientAccountAddress,
+		true,
+		true,
+	}
 }
 
 func (adq *AccountDatasetQuery) getTableName() string {
@@ -240,6 +302,8 @@ func (adq *AccountDatasetQuery) ExtractModel(dataset *model.AccountDataset) []in
 		dataset.GetIsActive(),
 		dataset.GetLatest(),
 		dataset.GetHeight(),
+		dataset.GetExpiryHeight(),
+		dataset.GetExpiryTimestamp(),
 	}
 }
 
@@ -261,6 +325,8 @@ func (adq *AccountDatasetQuery) BuildModel(
 			&dataset.IsActive,
 			&dataset.Latest,
 			&dataset.Height,
+			&dataset.ExpiryHeight,
+			&dataset.ExpiryTimestamp,
 		)
 		if err != nil {
 			return nil, err
@@ -280,6 +346,8 @@ func (*AccountDatasetQuery) Scan(dataset *model.AccountDataset, row *sql.Row) er
 		&dataset.IsActive,
 		&dataset.Latest,
 		&dataset.Height,
+		&dataset.ExpiryHeight,
+		&dataset.ExpiryTimestamp,
 	)
 }
 
@@ -51,7 +51,9 @@ package query
 
 import (
 	"database/sql"
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -65,11 +67,13 @@ var (
 			81, 229, 184, 77, 80, 80, 39, 254, 173, 28, 169},
 		RecipientAccountAddress: []byte{0, 0, 0, 0, 174, 8, 69, 186, 181, 103, 207, 111, 16, 204, 183, 18, 162, 64, 217, 82, 41, 208, 14,
 			252, 193, 14, 191, 200, 158, 211, 172, 37, 0, 58, 107, 64},
-		Property: "Admin",
-		Value:    "You're Welcome",
-		IsActive: true,
-		Latest:   true,
-		Height:   5,
+		Property:        "Admin",
+		Value:           "You're Welcome",
+		IsActive:        true,
+		Latest:          true,
+		Height:          5,
+		ExpiryHeight:    1000,
+		ExpiryTimestamp: 1600000000,
 	}
 )
 
@@ -111,8 +115,9 @@ func TestAccountDatasetsQuery_GetLastAccountDataset(t *testing.T) {
 				RecipientAccountAddress: mockDataset.GetRecipientAccountAddress(),
 				property:                mockDataset.GetProperty(),
 			},
-			wantQuery: "SELECT setter_account_address, recipient_account_address, property, value, is_active, latest, height " +
-				"FROM account_dataset WHERE setter_account_address = ? AND recipient_account_address = ? AND property = ? AND latest = ?",
+			wantQuery: "SELECT setter_account_address, recipient_account_address, property, value, is_active, latest, height, " +
+				"expiry_height, expiry_timestamp FROM account_dataset " +
+				"WHERE setter_account_address = ? AND recipient_account_address = ? AND property = ? AND latest = ?",
 			wantArgs: []interface{}{
 				mockDataset.GetSetterAccountAddress(),
 				mockDataset.GetRecipientAccountAddress(),
@@ -166,10 +171,10 @@ func TestAccountDatasetQuery_InsertAccountDataset(t *testing.T) {
 					true,
 				},
 				{
-					"INSERT INTO account_dataset (setter_account_address, recipient_account_address, property, value, is_active, latest, height) " +
-						"VALUES(?, ?, ?, ?, ?, ?, ?) " +
+					"INSERT INTO account_dataset (setter_account_address, recipient_account_address, property, value, is_active, latest, height, " +
+						"expiry_height, expiry_timestamp) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?) " +
 						"ON CONFLICT(setter_account_address, recipient_account_address, property, height) " +
-						"DO UPDATE SET value = ?, is_active = ?, latest = ?",
+						"DO UPDATE SET value = ?, is_active = ?, latest = ?, expiry_height = ?, expiry_timestamp = ?",
 					mockDataset.GetSetterAccountAddress(),
 					mockDataset.GetRecipientAccountAddress(),
 					mockDataset.GetProperty(),
@@ -177,9 +182,13 @@ func TestAccountDatasetQuery_InsertAccountDataset(t *testing.T) {
 					true,
 					true,
 					mockDataset.GetHeight(),
+					mockDataset.GetExpiryHeight(),
+					mockDataset.GetExpiryTimestamp(),
 					mockDataset.GetValue(),
 					mockDataset.GetIsActive(),
 					mockDataset.GetLatest(),
+					mockDataset.GetExpiryHeight(),
+					mockDataset.GetExpiryTimestamp(),
 				},
 			},
 		},
@@ -217,7 +226,8 @@ func TestAccountDatasetsQuery_GetAccountDatasetEscrowApproval(t *testing.T) {
 			fields: fields(*mockDatasetQuery),
 			args: args{accountAddress: []byte{0, 0, 0, 0, 4, 38, 68, 24, 230, 247, 88, 220, 119, 124, 51, 149, 127, 214, 82, 224, 72,
 				239, 56, 139, 255, 81, 229, 184, 77, 80, 80, 39, 254, 173, 28, 169}},
-			wantQStr: "SELECT setter_account_address, recipient_account_address, property, value, is_active, latest, height FROM account_dataset " +
+			wantQStr: "SELECT setter_account_address, recipient_account_address, property, value, is_active, latest, height, " +
+				"expiry_height, expiry_timestamp FROM account_dataset " +
 				"WHERE setter_account_address = ? AND recipient_account_address = ? AND property = ? AND latest = ?",
 			wantArgs: []interface{}{
 				[]byte{0, 0, 0, 0, 4, 38, 68, 24, 230, 247, 88, 220, 119, 124, 51, 149, 127, 214, 82, 224, 72,
@@ -269,6 +279,8 @@ func TestAccountDatasetsQuery_ExtractModel(t *testing.T) {
 				mockDataset.GetIsActive(),
 				mockDataset.GetLatest(),
 				mockDataset.GetHeight(),
+				mockDataset.GetExpiryHeight(),
+				mockDataset.GetExpiryTimestamp(),
 			},
 		},
 	}
@@ -300,6 +312,8 @@ func (*mockExecutorAccountDatasetBuildModel) ExecuteSelect(string, bool, ...inte
 		mockDataset.GetIsActive(),
 		mockDataset.GetLatest(),
 		mockDataset.GetHeight(),
+		mockDataset.GetExpiryHeight(),
+		mockDataset.GetExpiryTimestamp(),
 	)
 	mock.ExpectQuery("").WillReturnRows(mockRows)
 	return db.Query("")
@@ -368,6 +382,8 @@ func (*mockRowAccountDatasetQueryScan) ExecuteSelectRow(string, bool, ...interfa
 			mockDataset.GetIsActive(),
 			mockDataset.GetLatest(),
 			mockDataset.GetHeight(),
+			mockDataset.GetExpiryHeight(),
+			mockDataset.GetExpiryTimestamp(),
 		),
 	)
 	return db.QueryRow(""), nil
@@ -484,13 +500,13 @@ func TestAccountDatasetsQuery_SelectDataForSnapshot(t *testing.T) {
 				fromHeight: 0,
 				toHeight:   1,
 			},
-			want: `
-			SELECT setter_account_address, recipient_account_address, property, value, is_active, latest, height FROM account_dataset
+			want: fmt.Sprintf(`
+			SELECT %s FROM account_dataset
 			WHERE (setter_account_address, recipient_account_address, property, height) IN (
 				SELECT setter_account_address, recipient_account_address, property, MAX(height) FROM account_dataset
 				WHERE height >= 0 AND height <= 1 AND height != 0
 				GROUP BY setter_account_address, recipient_account_address, property
-			) ORDER BY height`,
+			) ORDER BY height`, strings.Join(mockDatasetQuery.Fields, ", ")),
 		},
 	}
 	for _, tt := range tests {
@@ -567,8 +583,9 @@ func TestAccountDatasetQuery_InsertAccountDatasets(t *testing.T) {
 					mockDataset,
 				},
 			},
-			wantStr: "INSERT INTO account_dataset (setter_account_address, recipient_account_address, property, value, is_active, latest, height) " +
-				"VALUES (?, ?, ?, ?, ?, ?, ?)",
+			wantStr: "INSERT INTO account_dataset (setter_account_address, recipient_account_address, property, value, is_active, " +
+				"latest, height, expiry_height, expiry_timestamp) " +
+				"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
 			wantArgs: NewAccountDatasetsQuery().ExtractModel(mockDataset),
 		},
 	}
@@ -588,3 +605,50 @@ func TestAccountDatasetQuery_InsertAccountDatasets(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountDatasetQuery_GetExpiredAccountDatasets(t *testing.T) {
+	gotQStr, gotArgs := mockDatasetQuery.GetExpiredAccountDatasets(1000, 1600000000)
+	wantQStr := "SELECT setter_account_address, recipient_account_address, property, value, is_active, latest, height, expiry_height, " +
+		"expiry_timestamp FROM account_dataset WHERE is_active = ? AND latest = ? AND " +
+		"((expiry_height > 0 AND expiry_height <= ?) OR (expiry_timestamp > 0 AND expiry_timestamp <= ?)) " +
+		"ORDER BY setter_account_address, recipient_account_address, property"
+	if gotQStr != wantQStr {
+		t.Errorf("GetExpiredAccountDatasets() gotQStr = \n%v, want \n%v", gotQStr, wantQStr)
+	}
+	if !reflect.DeepEqual(gotArgs, []interface{}{true, true, uint32(1000), int64(1600000000)}) {
+		t.Errorf("GetExpiredAccountDatasets() gotArgs = %v", gotArgs)
+	}
+}
+
+func TestAccountDatasetQuery_GetUnmetAccountDatasetSpendingRestriction(t *testing.T) {
+	gotQStr, gotArgs := mockDatasetQuery.GetUnmetAccountDatasetSpendingRestriction(
+		mockDataset.RecipientAccountAddress,
+		mockDataset.SetterAccountAddress,
+	)
+	wantQStr := "SELECT r.setter_account_address, r.recipient_account_address, r.property, r.value, r.is_active, r.latest, r.height, " +
+		"r.expiry_height, r.expiry_timestamp FROM account_dataset r WHERE r.recipient_account_address = ? AND r.property = ? " +
+		"AND r.is_active = ? AND r.latest = ? AND r.setter_account_address <> ? AND EXISTS (SELECT 1 FROM account_dataset c " +
+		"WHERE c.setter_account_address = r.recipient_account_address AND c.recipient_account_address = r.setter_account_address " +
+		"AND c.property = ? AND c.is_active = ? AND c.latest = ?) AND NOT EXISTS (SELECT 1 FROM account_dataset w " +
+		"WHERE w.setter_account_address = r.setter_account_address AND w.recipient_account_address = ? " +
+		"AND w.property = r.value AND w.is_active = ? AND w.latest = ?) ORDER BY r.setter_account_address LIMIT 1"
+	if gotQStr != wantQStr {
+		t.Errorf("GetUnmetAccountDatasetSpendingRestriction() gotQStr = \n%v, want \n%v", gotQStr, wantQStr)
+	}
+	wantArgs := []interface{}{
+		mockDataset.RecipientAccountAddress,
+		"AccountDatasetSpendingRestriction",
+		true,
+		true,
+		mockDataset.SetterAccountAddress,
+		"AccountDatasetSpendingRestrictionConsent",
+		true,
+		true,
+		mockDataset.SetterAccountAddress,
+		true,
+		true,
+	}
+	if !reflect.DeepEqual(gotArgs, wantArgs) {
+		t.Errorf("GetUnmetAccountDatasetSpendingRestriction() gotArgs = %v, want %v", gotArgs, wantArgs)
+	}
+}
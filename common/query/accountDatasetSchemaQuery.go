@@ -0,0 +1,296 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package query
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+type (
+	// AccountDatasetSchemaQuery fields must have
+	AccountDatasetSchemaQuery struct {
+		Fields    []string
+		TableName string
+	}
+
+	// AccountDatasetSchemaQueryInterface methods must have
+	AccountDatasetSchemaQueryInterface interface {
+		InsertAccountDatasetSchema(schema *model.AccountDatasetSchema) [][]interface{}
+		InsertAccountDatasetSchemas(schemas []*model.AccountDatasetSchema) (str string, args []interface{})
+		GetLatestAccountDatasetSchema(setterAccountAddress []byte, property string) (str string, args []interface{})
+		ExtractModel(schema *model.AccountDatasetSchema) []interface{}
+		BuildModels(rows *sql.Rows) ([]*model.AccountDatasetSchema, error)
+		Scan(schema *model.AccountDatasetSchema, row *sql.Row) error
+	}
+)
+
+// NewAccountDatasetSchemaQuery build an AccountDatasetSchemaQuery
+func NewAccountDatasetSchemaQuery() *AccountDatasetSchemaQuery {
+	return &AccountDatasetSchemaQuery{
+		Fields: []string{
+			"setter_account_address",
+			"property",
+			"value_type",
+			"max_length",
+			"block_height",
+			"latest",
+		},
+		TableName: "account_dataset_schema",
+	}
+}
+
+func (dsq *AccountDatasetSchemaQuery) getTableName() string {
+	return dsq.TableName
+}
+
+// InsertAccountDatasetSchema insert the schema as the latest one of the property of its setter
+func (dsq *AccountDatasetSchemaQuery) InsertAccountDatasetSchema(schema *model.AccountDatasetSchema) [][]interface{} {
+	schema.Latest = true
+	return [][]interface{}{
+		{
+			fmt.Sprintf(
+				"UPDATE %s SET latest = ? WHERE setter_account_address = ? AND property = ? AND block_height != ? AND latest = ?",
+				dsq.getTableName(),
+			),
+			false,
+			schema.GetSetterAccountAddress(),
+			schema.GetProperty(),
+			schema.GetBlockHeight(),
+			true,
+		},
+		append(
+			[]interface{}{
+				fmt.Sprintf(
+					"INSERT OR REPLACE INTO %s (%s) VALUES(%s)",
+					dsq.getTableName(),
+					strings.Join(dsq.Fields, ","),
+					fmt.Sprintf("? %s", strings.Repeat(", ?", len(dsq.Fields)-1))),
+			},
+			dsq.ExtractModel(schema)...,
+		),
+	}
+}
+
+// InsertAccountDatasetSchemas represents query builder to insert multiple records in single query
+func (dsq *AccountDatasetSchemaQuery) InsertAccountDatasetSchemas(
+	schemas []*model.AccountDatasetSchema,
+) (str string, args []interface{}) {
+	if len(schemas) > 0 {
+		str = fmt.Sprintf(
+			"INSERT INTO %s (%s) VALUES ",
+			dsq.getTableName(),
+			strings.Join(dsq.Fields, ", "),
+		)
+		for k, schema := range schemas {
+			str += fmt.Sprintf(
+				"(?%s)",
+				strings.Repeat(", ?", len(dsq.Fields)-1),
+			)
+			if k < len(schemas)-1 {
+				str += ","
+			}
+			args = append(args, dsq.ExtractModel(schema)...)
+		}
+	}
+	return str, args
+}
+
+// ImportSnapshot takes payload from downloaded snapshot and insert them into database
+func (dsq *AccountDatasetSchemaQuery) ImportSnapshot(payload interface{}) ([][]interface{}, error) {
+	var (
+		queries [][]interface{}
+	)
+	schemas, ok := payload.([]*model.AccountDatasetSchema)
+	if !ok {
+		return nil, blocker.NewBlocker(blocker.DBErr, "ImportSnapshotCannotCastTo"+dsq.TableName)
+	}
+	if len(schemas) > 0 {
+		recordsPerPeriod, rounds, remaining := CalculateBulkSize(len(dsq.Fields), len(schemas))
+		for i := 0; i < rounds; i++ {
+			qry, args := dsq.InsertAccountDatasetSchemas(schemas[i*recordsPerPeriod : (i*recordsPerPeriod)+recordsPerPeriod])
+			queries = append(queries, append([]interface{}{qry}, args...))
+		}
+		if remaining > 0 {
+			qry, args := dsq.InsertAccountDatasetSchemas(schemas[len(schemas)-remaining:])
+			queries = append(queries, append([]interface{}{qry}, args...))
+		}
+	}
+	return queries, nil
+}
+
+// RecalibrateVersionedTable recalibrate table to clean up multiple latest rows due to import function
+func (dsq *AccountDatasetSchemaQuery) RecalibrateVersionedTable() []string {
+	return []string{
+		fmt.Sprintf(
+			"update %s set latest = false where latest = true AND (setter_account_address, property, block_height) NOT IN "+
+				"(select t2.setter_account_address, t2.property, max(t2.block_height) from %s t2 "+
+				"group by t2.setter_account_address, t2.property)",
+			dsq.getTableName(), dsq.getTableName()),
+		fmt.Sprintf(
+			"update %s set latest = true where latest = false AND (setter_account_address, property, block_height) IN "+
+				"(select t2.setter_account_address, t2.property, max(t2.block_height) from %s t2 "+
+				"group by t2.setter_account_address, t2.property)",
+			dsq.getTableName(), dsq.getTableName()),
+	}
+}
+
+// GetLatestAccountDatasetSchema fetches the schema of the property set by the setter account
+func (dsq *AccountDatasetSchemaQuery) GetLatestAccountDatasetSchema(
+	setterAccountAddress []byte,
+	property string,
+) (str string, args []interface{}) {
+	return fmt.Sprintf(
+			"SELECT %s FROM %s WHERE setter_account_address = ? AND property = ? AND latest = ?",
+			strings.Join(dsq.Fields, ", "),
+			dsq.getTableName(),
+		),
+		[]interface{}{setterAccountAddress, property, true}
+}
+
+// ExtractModel will extract values of AccountDatasetSchema as []interface{}
+func (*AccountDatasetSchemaQuery) ExtractModel(schema *model.AccountDatasetSchema) []interface{} {
+	return []interface{}{
+		schema.GetSetterAccountAddress(),
+		schema.GetProperty(),
+		schema.GetValueType(),
+		schema.GetMaxLength(),
+		schema.GetBlockHeight(),
+		schema.GetLatest(),
+	}
+}
+
+// BuildModels extract sqlRaw into []*model.AccountDatasetSchema
+func (*AccountDatasetSchemaQuery) BuildModels(rows *sql.Rows) ([]*model.AccountDatasetSchema, error) {
+	var (
+		schemas []*model.AccountDatasetSchema
+		err     error
+	)
+
+	for rows.Next() {
+		var schema model.AccountDatasetSchema
+		err = rows.Scan(
+			&schema.SetterAccountAddress,
+			&schema.Property,
+			&schema.ValueType,
+			&schema.MaxLength,
+			&schema.BlockHeight,
+			&schema.Latest,
+		)
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, &schema)
+	}
+	return schemas, nil
+}
+
+// Scan extract sqlRaw *sql.Row into model.AccountDatasetSchema
+func (*AccountDatasetSchemaQuery) Scan(schema *model.AccountDatasetSchema, row *sql.Row) error {
+	return row.Scan(
+		&schema.SetterAccountAddress,
+		&schema.Property,
+		&schema.ValueType,
+		&schema.MaxLength,
+		&schema.BlockHeight,
+		&schema.Latest,
+	)
+}
+
+// Rollback delete records `WHERE height > "height"
+func (dsq *AccountDatasetSchemaQuery) Rollback(height uint32) (multiQueries [][]interface{}) {
+	return [][]interface{}{
+		{
+			fmt.Sprintf("DELETE FROM %s WHERE block_height > ?", dsq.getTableName()),
+			height,
+		},
+		{
+			fmt.Sprintf(`
+			UPDATE %s SET latest = ?
+			WHERE latest = ? AND (setter_account_address, property, block_height) IN (
+				SELECT t2.setter_account_address, t2.property, MAX(t2.block_height)
+				FROM %s as t2
+				GROUP BY t2.setter_account_address, t2.property
+			)`,
+				dsq.getTableName(),
+				dsq.getTableName(),
+			),
+			1,
+			0,
+		},
+	}
+}
+
+// SelectDataForSnapshot select the latest schema of every property registered between fromHeight and toHeight
+func (dsq *AccountDatasetSchemaQuery) SelectDataForSnapshot(fromHeight, toHeight uint32) string {
+	return fmt.Sprintf(`
+			SELECT %s FROM %s
+			WHERE (setter_account_address, property, block_height) IN (
+				SELECT t2.setter_account_address, t2.property, MAX(t2.block_height) FROM %s as t2
+				WHERE t2.block_height >= %d AND t2.block_height <= %d AND t2.block_height != 0
+				GROUP BY t2.setter_account_address, t2.property
+			) ORDER BY block_height`,
+		strings.Join(dsq.Fields, ","),
+		dsq.getTableName(),
+		dsq.getTableName(),
+		fromHeight,
+		toHeight,
+	)
+}
+
+// TrimDataBeforeSnapshot delete entries to assure there are no duplicates before applying a snapshot
+func (dsq *AccountDatasetSchemaQuery) TrimDataBeforeSnapshot(fromHeight, toHeight uint32) string {
+	return fmt.Sprintf(`DELETE FROM %s WHERE block_height >= %d AND block_height <= %d AND block_height != 0`,
+		dsq.getTableName(), fromHeight, toHeight)
+}
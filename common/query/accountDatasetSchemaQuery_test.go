@@ -0,0 +1,272 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package query
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+var (
+	mockAccountDatasetSchema = &model.AccountDatasetSchema{
+		SetterAccountAddress: liquidPayTxAddress1,
+		Property:             "KycLevel",
+		ValueType:            model.AccountDatasetValueType_AccountDatasetValueInt,
+		MaxLength:            2,
+		BlockHeight:          24,
+		Latest:               true,
+	}
+	mockAccountDatasetSchemaFields = "setter_account_address, property, value_type, max_length, block_height, latest"
+)
+
+func TestAccountDatasetSchemaQuery_InsertAccountDatasetSchema(t *testing.T) {
+	dsq := NewAccountDatasetSchemaQuery()
+	want := [][]interface{}{
+		{
+			"UPDATE account_dataset_schema SET latest = ? WHERE setter_account_address = ? AND property = ? AND block_height != ? " +
+				"AND latest = ?",
+			false,
+			mockAccountDatasetSchema.GetSetterAccountAddress(),
+			mockAccountDatasetSchema.GetProperty(),
+			mockAccountDatasetSchema.GetBlockHeight(),
+			true,
+		},
+		append(
+			[]interface{}{
+				"INSERT OR REPLACE INTO account_dataset_schema (setter_account_address,property,value_type,max_length," +
+					"block_height,latest) VALUES(? , ?, ?, ?, ?, ?)",
+			},
+			dsq.ExtractModel(mockAccountDatasetSchema)...,
+		),
+	}
+	if got := dsq.InsertAccountDatasetSchema(mockAccountDatasetSchema); !reflect.DeepEqual(got, want) {
+		t.Errorf("AccountDatasetSchemaQuery.InsertAccountDatasetSchema() = %v, want %v", got, want)
+	}
+}
+
+func TestAccountDatasetSchemaQuery_InsertAccountDatasetSchemas(t *testing.T) {
+	tests := []struct {
+		name     string
+		schemas  []*model.AccountDatasetSchema
+		wantStr  string
+		wantArgs []interface{}
+	}{
+		{
+			name:    "wantEmpty",
+			wantStr: "",
+		},
+		{
+			name:    "wantSuccess",
+			schemas: []*model.AccountDatasetSchema{mockAccountDatasetSchema, mockAccountDatasetSchema},
+			wantStr: "INSERT INTO account_dataset_schema (" + mockAccountDatasetSchemaFields + ") VALUES " +
+				"(?, ?, ?, ?, ?, ?),(?, ?, ?, ?, ?, ?)",
+			wantArgs: append(
+				NewAccountDatasetSchemaQuery().ExtractModel(mockAccountDatasetSchema),
+				NewAccountDatasetSchemaQuery().ExtractModel(mockAccountDatasetSchema)...,
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsq := NewAccountDatasetSchemaQuery()
+			gotStr, gotArgs := dsq.InsertAccountDatasetSchemas(tt.schemas)
+			if gotStr != tt.wantStr {
+				t.Errorf("AccountDatasetSchemaQuery.InsertAccountDatasetSchemas() gotStr = %v, want %v", gotStr, tt.wantStr)
+			}
+			if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("AccountDatasetSchemaQuery.InsertAccountDatasetSchemas() gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestAccountDatasetSchemaQuery_ImportSnapshot(t *testing.T) {
+	tests := []struct {
+		name        string
+		payload     interface{}
+		wantQueries int
+		wantErr     bool
+	}{
+		{
+			name:    "wantFail:WrongPayload",
+			payload: []*model.AccountDataset{},
+			wantErr: true,
+		},
+		{
+			name:        "wantSuccess",
+			payload:     []*model.AccountDatasetSchema{mockAccountDatasetSchema},
+			wantQueries: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsq := NewAccountDatasetSchemaQuery()
+			got, err := dsq.ImportSnapshot(tt.payload)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AccountDatasetSchemaQuery.ImportSnapshot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != tt.wantQueries {
+				t.Errorf("AccountDatasetSchemaQuery.ImportSnapshot() got %d queries, want %d", len(got), tt.wantQueries)
+			}
+		})
+	}
+}
+
+func TestAccountDatasetSchemaQuery_GetLatestAccountDatasetSchema(t *testing.T) {
+	dsq := NewAccountDatasetSchemaQuery()
+	gotStr, gotArgs := dsq.GetLatestAccountDatasetSchema(liquidPayTxAddress1, "KycLevel")
+	wantStr := "SELECT " + mockAccountDatasetSchemaFields + " FROM account_dataset_schema " +
+		"WHERE setter_account_address = ? AND property = ? AND latest = ?"
+	if gotStr != wantStr {
+		t.Errorf("AccountDatasetSchemaQuery.GetLatestAccountDatasetSchema() gotStr = %v, want %v", gotStr, wantStr)
+	}
+	if !reflect.DeepEqual(gotArgs, []interface{}{liquidPayTxAddress1, "KycLevel", true}) {
+		t.Errorf("AccountDatasetSchemaQuery.GetLatestAccountDatasetSchema() gotArgs = %v", gotArgs)
+	}
+}
+
+func TestAccountDatasetSchemaQuery_BuildModels(t *testing.T) {
+	dsq := NewAccountDatasetSchemaQuery()
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	mockRow := sqlmock.NewRows(dsq.Fields)
+	mockRow.AddRow(
+		mockAccountDatasetSchema.GetSetterAccountAddress(),
+		mockAccountDatasetSchema.GetProperty(),
+		int32(mockAccountDatasetSchema.GetValueType()),
+		mockAccountDatasetSchema.GetMaxLength(),
+		mockAccountDatasetSchema.GetBlockHeight(),
+		mockAccountDatasetSchema.GetLatest(),
+	)
+	mock.ExpectQuery("").WillReturnRows(mockRow)
+	rows, _ := db.Query("")
+	got, err := dsq.BuildModels(rows)
+	if err != nil {
+		t.Errorf("AccountDatasetSchemaQuery.BuildModels() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, []*model.AccountDatasetSchema{mockAccountDatasetSchema}) {
+		t.Errorf("AccountDatasetSchemaQuery.BuildModels() = %v, want %v", got, mockAccountDatasetSchema)
+	}
+}
+
+func TestAccountDatasetSchemaQuery_Scan(t *testing.T) {
+	var schema model.AccountDatasetSchema
+	dsq := NewAccountDatasetSchemaQuery()
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	mock.ExpectQuery("").WillReturnRows(sqlmock.NewRows(dsq.Fields).AddRow(
+		mockAccountDatasetSchema.GetSetterAccountAddress(),
+		mockAccountDatasetSchema.GetProperty(),
+		int32(mockAccountDatasetSchema.GetValueType()),
+		mockAccountDatasetSchema.GetMaxLength(),
+		mockAccountDatasetSchema.GetBlockHeight(),
+		mockAccountDatasetSchema.GetLatest(),
+	))
+	err := dsq.Scan(&schema, db.QueryRow(""))
+	if err != nil {
+		t.Errorf("AccountDatasetSchemaQuery.Scan() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(&schema, mockAccountDatasetSchema) {
+		t.Errorf("AccountDatasetSchemaQuery.Scan() = %v, want %v", &schema, mockAccountDatasetSchema)
+	}
+}
+
+func TestAccountDatasetSchemaQuery_Rollback(t *testing.T) {
+	dsq := NewAccountDatasetSchemaQuery()
+	want := [][]interface{}{
+		{
+			"DELETE FROM account_dataset_schema WHERE block_height > ?",
+			uint32(30),
+		},
+		{
+			`
+			UPDATE account_dataset_schema SET latest = ?
+			WHERE latest = ? AND (setter_account_address, property, block_height) IN (
+				SELECT t2.setter_account_address, t2.property, MAX(t2.block_height)
+				FROM account_dataset_schema as t2
+				GROUP BY t2.setter_account_address, t2.property
+			)`,
+			1,
+			0,
+		},
+	}
+	if got := dsq.Rollback(30); !reflect.DeepEqual(got, want) {
+		t.Errorf("AccountDatasetSchemaQuery.Rollback() = %v, want %v", got, want)
+	}
+}
+
+func TestAccountDatasetSchemaQuery_SelectDataForSnapshot(t *testing.T) {
+	dsq := NewAccountDatasetSchemaQuery()
+	want := fmt.Sprintf(`
+			SELECT setter_account_address,property,value_type,max_length,block_height,latest FROM account_dataset_schema
+			WHERE (setter_account_address, property, block_height) IN (
+				SELECT t2.setter_account_address, t2.property, MAX(t2.block_height) FROM account_dataset_schema as t2
+				WHERE t2.block_height >= %d AND t2.block_height <= %d AND t2.block_height != 0
+				GROUP BY t2.setter_account_address, t2.property
+			) ORDER BY block_height`, 0, 10)
+	if got := dsq.SelectDataForSnapshot(0, 10); got != want {
+		t.Errorf("AccountDatasetSchemaQuery.SelectDataForSnapshot() = %v, want %v", got, want)
+	}
+}
+
+func TestAccountDatasetSchemaQuery_TrimDataBeforeSnapshot(t *testing.T) {
+	dsq := NewAccountDatasetSchemaQuery()
+	want := "DELETE FROM account_dataset_schema WHERE block_height >= 0 AND block_height <= 10 AND block_height != 0"
+	if got := dsq.TrimDataBeforeSnapshot(0, 10); got != want {
+		t.Errorf("AccountDatasetSchemaQuery.TrimDataBeforeSnapshot() = %v, want %v", got, want)
+	}
+}
@@ -50,10 +50,12 @@
 package query
 
 import (
+	"bytes"
 	"database/sql"
 	"fmt"
 	"strings"
 
+	"github.com/zoobc/zoobc-core/common/accounttype"
 	"github.com/zoobc/zoobc-core/common/blocker"
 	"github.com/zoobc/zoobc-core/common/model"
 )
@@ -96,6 +98,11 @@ func NewEscrowTransactionQuery() *EscrowTransactionQuery {
 			"block_height",
 			"latest",
 			"instruction",
+			"approver_addresses",
+			"minimum_approvals",
+			"approvals",
+			"rejections",
+			"released_amount",
 		},
 		TableName: "escrow_transaction",
 	}
@@ -109,7 +116,7 @@ func (et *EscrowTransactionQuery) getTableName() string {
 InsertEscrowTransaction represents insert query for escrow_transaction table.
 There 2 queries result:
 		1. Update the previous record to latest = false
-		2. Insert new record which is the newest
+		2. Insert new record which is the newest, or update the votes of the record already inserted at the same height
 */
 func (et *EscrowTransactionQuery) InsertEscrowTransaction(escrow *model.Escrow) [][]interface{} {
 	return [][]interface{}{
@@ -124,7 +131,9 @@ func (et *EscrowTransactionQuery) InsertEscrowTransaction(escrow *model.Escrow)
 		append(
 			[]interface{}{
 				fmt.Sprintf(
-					"INSERT INTO %s (%s) VALUES(%s)",
+					"INSERT INTO %s (%s) VALUES(%s) ON CONFLICT(id, block_height) DO UPDATE SET status = excluded.status, "+
+						"latest = excluded.latest, approvals = excluded.approvals, rejections = excluded.rejections, "+
+						"released_amount = excluded.released_amount",
 					et.getTableName(),
 					strings.Join(et.Fields, ","),
 					fmt.Sprintf("? %s", strings.Repeat(", ?", len(et.Fields)-1))),
@@ -257,6 +266,11 @@ func (et *EscrowTransactionQuery) ExtractModel(escrow *model.Escrow) []interface
 		escrow.GetBlockHeight(),
 		escrow.GetLatest(),
 		escrow.GetInstruction(),
+		bytes.Join(escrow.GetApproverAddresses(), nil),
+		escrow.GetMinimumApprovals(),
+		bytes.Join(escrow.GetApprovals(), nil),
+		bytes.Join(escrow.GetRejections(), nil),
+		escrow.GetReleasedAmount(),
 	}
 }
 
@@ -268,7 +282,10 @@ func (et *EscrowTransactionQuery) BuildModels(rows *sql.Rows) ([]*model.Escrow,
 	)
 
 	for rows.Next() {
-		var escrow model.Escrow
+		var (
+			escrow                                   model.Escrow
+			approverAddresses, approvals, rejections []byte
+		)
 		err = rows.Scan(
 			&escrow.ID,
 			&escrow.SenderAddress,
@@ -281,10 +298,19 @@ func (et *EscrowTransactionQuery) BuildModels(rows *sql.Rows) ([]*model.Escrow,
 			&escrow.BlockHeight,
 			&escrow.Latest,
 			&escrow.Instruction,
+			&approverAddresses,
+			&escrow.MinimumApprovals,
+			&approvals,
+			&rejections,
+			&escrow.ReleasedAmount,
 		)
 		if err != nil {
 			return nil, err
 		}
+		err = et.parseEscrowApprovers(&escrow, approverAddresses, approvals, rejections)
+		if err != nil {
+			return nil, err
+		}
 		escrows = append(escrows, &escrow)
 	}
 	return escrows, nil
@@ -292,7 +318,10 @@ func (et *EscrowTransactionQuery) BuildModels(rows *sql.Rows) ([]*model.Escrow,
 
 // Scan extract sqlRaw *sql.Row into model.Escrow
 func (et *EscrowTransactionQuery) Scan(escrow *model.Escrow, row *sql.Row) error {
-	return row.Scan(
+	var (
+		approverAddresses, approvals, rejections []byte
+	)
+	err := row.Scan(
 		&escrow.ID,
 		&escrow.SenderAddress,
 		&escrow.RecipientAddress,
@@ -304,7 +333,31 @@ func (et *EscrowTransactionQuery) Scan(escrow *model.Escrow, row *sql.Row) error
 		&escrow.BlockHeight,
 		&escrow.Latest,
 		&escrow.Instruction,
+		&approverAddresses,
+		&escrow.MinimumApprovals,
+		&approvals,
+		&rejections,
+		&escrow.ReleasedAmount,
 	)
+	if err != nil {
+		return err
+	}
+	return et.parseEscrowApprovers(escrow, approverAddresses, approvals, rejections)
+}
+
+// parseEscrowApprovers split the concatenated approver addresses and votes stored in the escrow columns
+func (*EscrowTransactionQuery) parseEscrowApprovers(escrow *model.Escrow, approverAddresses, approvals, rejections []byte) error {
+	var err error
+	escrow.ApproverAddresses, err = accounttype.ParseBytesToAccountAddresses(approverAddresses)
+	if err != nil {
+		return err
+	}
+	escrow.Approvals, err = accounttype.ParseBytesToAccountAddresses(approvals)
+	if err != nil {
+		return err
+	}
+	escrow.Rejections, err = accounttype.ParseBytesToAccountAddresses(rejections)
+	return err
 }
 
 // Rollback delete records `WHERE height > "height"
@@ -98,6 +98,11 @@ var (
 		uint32(0),
 		true,
 		"",
+		[]byte{},
+		uint32(0),
+		[]byte{},
+		[]byte{},
+		int64(0),
 	}
 )
 
@@ -140,7 +145,10 @@ func TestEscrowTransactionQuery_InsertEscrowTransaction(t *testing.T) {
 				},
 				{
 					"INSERT INTO escrow_transaction (id,sender_address,recipient_address,approver_address,amount,commission,timeout,status," +
-						"block_height,latest,instruction) VALUES(? , ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+						"block_height,latest,instruction,approver_addresses,minimum_approvals,approvals,rejections,released_amount) " +
+						"VALUES(? , ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT(id, block_height) DO UPDATE SET " +
+						"status = excluded.status, latest = excluded.latest, approvals = excluded.approvals, rejections = excluded.rejections, " +
+						"released_amount = excluded.released_amount",
 					int64(0),
 					escrowSenderAddress,
 					escrowRecipientAddress,
@@ -152,6 +160,11 @@ func TestEscrowTransactionQuery_InsertEscrowTransaction(t *testing.T) {
 					uint32(0),
 					true,
 					"",
+					[]byte{},
+					uint32(0),
+					[]byte{},
+					[]byte{},
+					int64(0),
 				},
 			},
 		},
@@ -189,7 +202,8 @@ func TestEscrowTransactionQuery_GetLatestEscrowTransactionByID(t *testing.T) {
 			fields: fields(*mockEscrowQuery),
 			args:   args{id: 1},
 			wantQStr: "SELECT id, sender_address, recipient_address, approver_address, amount, commission, timeout, " +
-				"status, block_height, latest, instruction FROM escrow_transaction WHERE id = ? AND latest = ?",
+				"status, block_height, latest, instruction, approver_addresses, minimum_approvals, approvals, rejections, " +
+				"released_amount FROM escrow_transaction WHERE id = ? AND latest = ?",
 			wantArgs: []interface{}{int64(1), true},
 		},
 	}
@@ -260,6 +274,11 @@ func TestEscrowTransactionQuery_BuildModels(t *testing.T) {
 		uint32(0),
 		true,
 		"",
+		nil,
+		uint32(0),
+		nil,
+		nil,
+		int64(0),
 	)
 	mock.ExpectQuery("").WillReturnRows(mockRow)
 	mockedRow, _ := db.Query("")
@@ -320,6 +339,11 @@ func TestEscrowTransactionQuery_Scan(t *testing.T) {
 		uint32(0),
 		true,
 		"",
+		nil,
+		uint32(0),
+		nil,
+		nil,
+		int64(0),
 	)
 	mock.ExpectQuery("").WillReturnRows(mockRow)
 	mockedRow := db.QueryRow("")
@@ -433,7 +457,8 @@ func TestEscrowTransactionQuery_SelectDataForSnapshot(t *testing.T) {
 				TableName: qry.TableName,
 			},
 			want: "SELECT id,sender_address,recipient_address,approver_address,amount,commission,timeout,status,block_height,latest," +
-				"instruction FROM escrow_transaction WHERE (id, block_height) IN (SELECT t2.id, " +
+				"instruction,approver_addresses,minimum_approvals,approvals,rejections,released_amount FROM escrow_transaction " +
+				"WHERE (id, block_height) IN (SELECT t2.id, " +
 				"MAX(t2.block_height) FROM escrow_transaction as t2 WHERE t2." +
 				"block_height >= 0 AND t2.block_height <= 1 AND t2.block_height != 0 GROUP BY t2.id) ORDER BY block_height",
 		},
@@ -476,7 +501,8 @@ func TestEscrowTransactionQuery_GetEscrowTransactions(t *testing.T) {
 				},
 			},
 			want: "SELECT id, sender_address, recipient_address, approver_address, amount, commission, timeout, " +
-				"status, block_height, latest, instruction FROM escrow_transaction WHERE height = ? AND latest = ? ",
+				"status, block_height, latest, instruction, approver_addresses, minimum_approvals, approvals, rejections, " +
+				"released_amount FROM escrow_transaction WHERE height = ? AND latest = ? ",
 			want1: []interface{}{
 				100,
 				1,
@@ -556,7 +582,9 @@ func TestEscrowTransactionQuery_GetEscrowTransactionsByTransactionIdsAndStatus(t
 		escrowQuery := NewEscrowTransactionQuery()
 		query := escrowQuery.GetEscrowTransactionsByTransactionIdsAndStatus([]string{"1", "2"}, model.EscrowStatus_Pending)
 		expect := fmt.Sprintf("SELECT id, sender_address, recipient_address, approver_address, amount, commission, timeout, status, "+
-			"block_height, latest, instruction FROM escrow_transaction WHERE id IN (1, 2) AND status = %d", model.EscrowStatus_Pending)
+			"block_height, latest, instruction, approver_addresses, minimum_approvals, approvals, rejections, released_amount "+
+			"FROM escrow_transaction "+
+			"WHERE id IN (1, 2) AND status = %d", model.EscrowStatus_Pending)
 		if query != expect {
 			t.Errorf("expect: %v\ngot: %v\n", expect, query)
 		}
@@ -587,7 +615,8 @@ func TestEscrowTransactionQuery_InsertEscrowTransactions(t *testing.T) {
 				},
 			},
 			wantStr: "INSERT INTO escrow_transaction (id,sender_address,recipient_address,approver_address,amount,commission," +
-				"timeout,status,block_height,latest,instruction) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+				"timeout,status,block_height,latest,instruction,approver_addresses,minimum_approvals,approvals,rejections," +
+				"released_amount) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
 			wantArgs: NewEscrowTransactionQuery().ExtractModel(mockEscrow),
 		},
 	}
@@ -65,6 +65,7 @@ type (
 			accountAddress []byte,
 			height uint32,
 		) (qStr string, args []interface{})
+		GetVoteCommitsInPeriod(lowerBlockHeight, upperBlockHeight uint32) (qStr string, args []interface{})
 		InsertCommitVote(voteCommit *model.FeeVoteCommitmentVote) (qStr string, args []interface{})
 		InsertCommitVotes(voteCommits []*model.FeeVoteCommitmentVote) (qStr string, args []interface{})
 		ExtractModel(voteCommit *model.FeeVoteCommitmentVote) []interface{}
@@ -169,6 +170,16 @@ func (fsvc *FeeVoteCommitmentVoteQuery) GetVoteCommitByAccountAddressAndHeight(
 		strings.Join(fsvc.Fields, ","), fsvc.getTableName()), []interface{}{accountAddress, height}
 }
 
+// GetVoteCommitsInPeriod to get vote commits within block-height range, blockHeight limit are inclusive
+func (fsvc *FeeVoteCommitmentVoteQuery) GetVoteCommitsInPeriod(
+	lowerBlockHeight, upperBlockHeight uint32,
+) (
+	qStr string, args []interface{},
+) {
+	return fmt.Sprintf(`SELECT %s FROM %s WHERE block_height BETWEEN ? AND ?`,
+		strings.Join(fsvc.Fields, ","), fsvc.getTableName()), []interface{}{lowerBlockHeight, upperBlockHeight}
+}
+
 // ExtractModel to  extract FeeVoteCommitmentVote model to []interface
 func (*FeeVoteCommitmentVoteQuery) ExtractModel(voteCommit *model.FeeVoteCommitmentVote) []interface{} {
 	return []interface{}{
@@ -181,6 +181,53 @@ func TestFeeVoteCommitmentVoteQuery_GetVoteCommitByAccountAddressAndHeight(t *te
 	}
 }
 
+func TestFeeVoteCommitmentVoteQuery_GetVoteCommitsInPeriod(t *testing.T) {
+	type fields struct {
+		Fields    []string
+		TableName string
+	}
+	type args struct {
+		lowerBlockHeight uint32
+		upperBlockHeight uint32
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		wantQStr string
+		wantArgs []interface{}
+	}{
+		{
+			name:   "wantSuccess",
+			fields: fields(*mockFeeVoteCommitmentVoteQuery),
+			args: args{
+				lowerBlockHeight: 0,
+				upperBlockHeight: 720,
+			},
+			wantQStr: "SELECT vote_hash,voter_address,block_height FROM fee_vote_commitment_vote WHERE block_height BETWEEN ? AND ?",
+			wantArgs: []interface{}{
+				uint32(0),
+				uint32(720),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsvc := &FeeVoteCommitmentVoteQuery{
+				Fields:    tt.fields.Fields,
+				TableName: tt.fields.TableName,
+			}
+			gotQStr, gotArgs := fsvc.GetVoteCommitsInPeriod(tt.args.lowerBlockHeight, tt.args.upperBlockHeight)
+			if gotQStr != tt.wantQStr {
+				t.Errorf("FeeVoteCommitmentVoteQuery.GetVoteCommitsInPeriod() gotQStr = %v, want %v", gotQStr, tt.wantQStr)
+			}
+			if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("FeeVoteCommitmentVoteQuery.GetVoteCommitsInPeriod() gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
 type (
 	mockRowFeeVoteCommitmentVoteQueryScan struct {
 		Executor
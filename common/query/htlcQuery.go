@@ -0,0 +1,300 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package query
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+type (
+	// HtlcQuery fields must have
+	HtlcQuery struct {
+		Fields    []string
+		TableName string
+	}
+
+	// HtlcQueryInterface methods must have
+	HtlcQueryInterface interface {
+		InsertHtlc(htlc *model.Htlc) [][]interface{}
+		InsertHtlcs(htlcs []*model.Htlc) (str string, args []interface{})
+		GetHtlcByID(id int64) (str string, args []interface{})
+		ExtractModel(htlc *model.Htlc) []interface{}
+		BuildModels(rows *sql.Rows) ([]*model.Htlc, error)
+		Scan(htlc *model.Htlc, row *sql.Row) error
+	}
+)
+
+// NewHtlcQuery build a HtlcQuery
+func NewHtlcQuery() *HtlcQuery {
+	return &HtlcQuery{
+		Fields: []string{
+			"id",
+			"sender_address",
+			"recipient_address",
+			"amount",
+			"hash_lock",
+			"time_lock_height",
+			"status",
+			"preimage",
+			"block_height",
+			"latest",
+		},
+		TableName: "htlc",
+	}
+}
+
+func (hq *HtlcQuery) getTableName() string {
+	return hq.TableName
+}
+
+// InsertHtlc insert a new version of the htlc, setting the previous one as not latest.
+// A htlc claimed or refunded in the block it has been locked replaces the version of that block
+func (hq *HtlcQuery) InsertHtlc(htlc *model.Htlc) [][]interface{} {
+	htlc.Latest = true
+	return [][]interface{}{
+		{
+			fmt.Sprintf(
+				"UPDATE %s set latest = ? WHERE id = ?",
+				hq.getTableName(),
+			),
+			false,
+			htlc.GetID(),
+		},
+		append(
+			[]interface{}{
+				fmt.Sprintf(
+					"INSERT INTO %s (%s) VALUES(%s) ON CONFLICT(id, block_height) "+
+						"DO UPDATE SET status = excluded.status, preimage = excluded.preimage, latest = excluded.latest",
+					hq.getTableName(),
+					strings.Join(hq.Fields, ","),
+					fmt.Sprintf("? %s", strings.Repeat(", ?", len(hq.Fields)-1))),
+			},
+			hq.ExtractModel(htlc)...,
+		),
+	}
+}
+
+// InsertHtlcs represents query builder to insert multiple records in single query
+func (hq *HtlcQuery) InsertHtlcs(htlcs []*model.Htlc) (str string, args []interface{}) {
+	if len(htlcs) > 0 {
+		str = fmt.Sprintf(
+			"INSERT INTO %s (%s) VALUES ",
+			hq.getTableName(),
+			strings.Join(hq.Fields, ", "),
+		)
+		for k, htlc := range htlcs {
+			str += fmt.Sprintf(
+				"(?%s)",
+				strings.Repeat(", ?", len(hq.Fields)-1),
+			)
+			if k < len(htlcs)-1 {
+				str += ","
+			}
+			args = append(args, hq.ExtractModel(htlc)...)
+		}
+	}
+	return str, args
+}
+
+// ImportSnapshot takes payload from downloaded snapshot and insert them into database
+func (hq *HtlcQuery) ImportSnapshot(payload interface{}) ([][]interface{}, error) {
+	var (
+		queries [][]interface{}
+	)
+	htlcs, ok := payload.([]*model.Htlc)
+	if !ok {
+		return nil, blocker.NewBlocker(blocker.DBErr, "ImportSnapshotCannotCastTo"+hq.TableName)
+	}
+	if len(htlcs) > 0 {
+		recordsPerPeriod, rounds, remaining := CalculateBulkSize(len(hq.Fields), len(htlcs))
+		for i := 0; i < rounds; i++ {
+			qry, args := hq.InsertHtlcs(htlcs[i*recordsPerPeriod : (i*recordsPerPeriod)+recordsPerPeriod])
+			queries = append(queries, append([]interface{}{qry}, args...))
+		}
+		if remaining > 0 {
+			qry, args := hq.InsertHtlcs(htlcs[len(htlcs)-remaining:])
+			queries = append(queries, append([]interface{}{qry}, args...))
+		}
+	}
+	return queries, nil
+}
+
+// RecalibrateVersionedTable recalibrate table to clean up multiple latest rows due to import function
+func (hq *HtlcQuery) RecalibrateVersionedTable() []string {
+	return []string{
+		fmt.Sprintf(
+			"update %s set latest = false where latest = true AND (id, block_height) NOT IN "+
+				"(select t2.id, max(t2.block_height) from %s t2 group by t2.id)",
+			hq.getTableName(), hq.getTableName()),
+		fmt.Sprintf(
+			"update %s set latest = true where latest = false AND (id, block_height) IN "+
+				"(select t2.id, max(t2.block_height) from %s t2 group by t2.id)",
+			hq.getTableName(), hq.getTableName()),
+	}
+}
+
+// GetHtlcByID fetches the latest record of the htlc locked by the htlc lock transaction id
+func (hq *HtlcQuery) GetHtlcByID(id int64) (str string, args []interface{}) {
+	return fmt.Sprintf(
+			"SELECT %s FROM %s WHERE id = ? AND latest = ?",
+			strings.Join(hq.Fields, ", "),
+			hq.getTableName(),
+		),
+		[]interface{}{id, true}
+}
+
+// ExtractModel will extract values of Htlc as []interface{}
+func (hq *HtlcQuery) ExtractModel(htlc *model.Htlc) []interface{} {
+	return []interface{}{
+		htlc.GetID(),
+		htlc.GetSenderAddress(),
+		htlc.GetRecipientAddress(),
+		htlc.GetAmount(),
+		htlc.GetHashLock(),
+		htlc.GetTimeLockHeight(),
+		htlc.GetStatus(),
+		htlc.GetPreimage(),
+		htlc.GetBlockHeight(),
+		htlc.GetLatest(),
+	}
+}
+
+// BuildModels extract sqlRaw into []*model.Htlc
+func (hq *HtlcQuery) BuildModels(rows *sql.Rows) ([]*model.Htlc, error) {
+	var (
+		htlcs []*model.Htlc
+		err   error
+	)
+
+	for rows.Next() {
+		var htlc model.Htlc
+		err = rows.Scan(
+			&htlc.ID,
+			&htlc.SenderAddress,
+			&htlc.RecipientAddress,
+			&htlc.Amount,
+			&htlc.HashLock,
+			&htlc.TimeLockHeight,
+			&htlc.Status,
+			&htlc.Preimage,
+			&htlc.BlockHeight,
+			&htlc.Latest,
+		)
+		if err != nil {
+			return nil, err
+		}
+		htlcs = append(htlcs, &htlc)
+	}
+	return htlcs, nil
+}
+
+// Scan extract sqlRaw *sql.Row into model.Htlc
+func (hq *HtlcQuery) Scan(htlc *model.Htlc, row *sql.Row) error {
+	return row.Scan(
+		&htlc.ID,
+		&htlc.SenderAddress,
+		&htlc.RecipientAddress,
+		&htlc.Amount,
+		&htlc.HashLock,
+		&htlc.TimeLockHeight,
+		&htlc.Status,
+		&htlc.Preimage,
+		&htlc.BlockHeight,
+		&htlc.Latest,
+	)
+}
+
+// Rollback delete records `WHERE height > "height"
+func (hq *HtlcQuery) Rollback(height uint32) (multiQueries [][]interface{}) {
+	return [][]interface{}{
+		{
+			fmt.Sprintf("DELETE FROM %s WHERE block_height > ?", hq.getTableName()),
+			height,
+		},
+		{
+			fmt.Sprintf(`
+			UPDATE %s SET latest = ?
+			WHERE latest = ? AND (id, block_height) IN (
+				SELECT t2.id, MAX(t2.block_height)
+				FROM %s as t2
+				GROUP BY t2.id
+			)`,
+				hq.getTableName(),
+				hq.getTableName(),
+			),
+			1,
+			0,
+		},
+	}
+}
+
+// SelectDataForSnapshot select the latest version of each htlc written between fromHeight and toHeight
+func (hq *HtlcQuery) SelectDataForSnapshot(fromHeight, toHeight uint32) string {
+	return fmt.Sprintf(
+		"SELECT %s FROM %s WHERE (id, block_height) IN (SELECT t2.id, MAX(t2.block_height) FROM %s as t2 "+
+			"WHERE t2.block_height >= %d AND t2.block_height <= %d AND t2.block_height != 0 GROUP BY t2.id) ORDER BY block_height",
+		strings.Join(hq.Fields, ","),
+		hq.getTableName(),
+		hq.getTableName(),
+		fromHeight,
+		toHeight,
+	)
+}
+
+// TrimDataBeforeSnapshot delete entries to assure there are no duplicates before applying a snapshot
+func (hq *HtlcQuery) TrimDataBeforeSnapshot(fromHeight, toHeight uint32) string {
+	return fmt.Sprintf(`DELETE FROM %s WHERE block_height >= %d AND block_height <= %d AND block_height != 0`,
+		hq.getTableName(), fromHeight, toHeight)
+}
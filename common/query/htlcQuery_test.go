@@ -0,0 +1,305 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package query
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+var (
+	mockHtlc = &model.Htlc{
+		ID:               1,
+		SenderAddress:    liquidPayTxAddress1,
+		RecipientAddress: liquidPayTxAddress2,
+		Amount:           123456,
+		HashLock:         make([]byte, 32),
+		TimeLockHeight:   100,
+		Status:           model.HtlcStatus_HtlcClaimed,
+		Preimage:         []byte{1, 2, 3},
+		BlockHeight:      24,
+		Latest:           true,
+	}
+	mockHtlcFields = "id, sender_address, recipient_address, amount, hash_lock, time_lock_height, status, preimage, block_height, latest"
+)
+
+func TestHtlcQuery_InsertHtlc(t *testing.T) {
+	type args struct {
+		htlc *model.Htlc
+	}
+	tests := []struct {
+		name string
+		args args
+		want [][]interface{}
+	}{
+		{
+			name: "wantSuccess",
+			args: args{
+				htlc: mockHtlc,
+			},
+			want: [][]interface{}{
+				{
+					"UPDATE htlc set latest = ? WHERE id = ?",
+					false,
+					int64(1),
+				},
+				{
+					"INSERT INTO htlc (id,sender_address,recipient_address,amount,hash_lock,time_lock_height," +
+						"status,preimage,block_height,latest) VALUES(? , ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT(id, block_height) " +
+						"DO UPDATE SET status = excluded.status, preimage = excluded.preimage, latest = excluded.latest",
+					mockHtlc.GetID(),
+					mockHtlc.GetSenderAddress(),
+					mockHtlc.GetRecipientAddress(),
+					mockHtlc.GetAmount(),
+					mockHtlc.GetHashLock(),
+					mockHtlc.GetTimeLockHeight(),
+					mockHtlc.GetStatus(),
+					mockHtlc.GetPreimage(),
+					mockHtlc.GetBlockHeight(),
+					true,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hq := NewHtlcQuery()
+			if got := hq.InsertHtlc(tt.args.htlc); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HtlcQuery.InsertHtlc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHtlcQuery_InsertHtlcs(t *testing.T) {
+	type args struct {
+		htlcs []*model.Htlc
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantStr  string
+		wantArgs []interface{}
+	}{
+		{
+			name:    "wantEmpty",
+			args:    args{},
+			wantStr: "",
+		},
+		{
+			name: "wantSuccess",
+			args: args{
+				htlcs: []*model.Htlc{mockHtlc, mockHtlc},
+			},
+			wantStr: "INSERT INTO htlc (" + mockHtlcFields + ") VALUES " +
+				"(?, ?, ?, ?, ?, ?, ?, ?, ?, ?),(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+			wantArgs: append(NewHtlcQuery().ExtractModel(mockHtlc), NewHtlcQuery().ExtractModel(mockHtlc)...),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hq := NewHtlcQuery()
+			gotStr, gotArgs := hq.InsertHtlcs(tt.args.htlcs)
+			if gotStr != tt.wantStr {
+				t.Errorf("HtlcQuery.InsertHtlcs() gotStr = %v, want %v", gotStr, tt.wantStr)
+			}
+			if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("HtlcQuery.InsertHtlcs() gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestHtlcQuery_ImportSnapshot(t *testing.T) {
+	tests := []struct {
+		name        string
+		payload     interface{}
+		wantQueries int
+		wantErr     bool
+	}{
+		{
+			name:    "wantFail:WrongPayload",
+			payload: []*model.LockedFund{},
+			wantErr: true,
+		},
+		{
+			name:        "wantSuccess",
+			payload:     []*model.Htlc{mockHtlc},
+			wantQueries: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hq := NewHtlcQuery()
+			got, err := hq.ImportSnapshot(tt.payload)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HtlcQuery.ImportSnapshot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != tt.wantQueries {
+				t.Errorf("HtlcQuery.ImportSnapshot() got %d queries, want %d", len(got), tt.wantQueries)
+			}
+		})
+	}
+}
+
+func TestHtlcQuery_GetHtlcByID(t *testing.T) {
+	hq := NewHtlcQuery()
+	gotStr, gotArgs := hq.GetHtlcByID(123)
+	wantStr := "SELECT " + mockHtlcFields + " FROM htlc WHERE id = ? AND latest = ?"
+	if gotStr != wantStr {
+		t.Errorf("HtlcQuery.GetHtlcByID() gotStr = %v, want %v", gotStr, wantStr)
+	}
+	if !reflect.DeepEqual(gotArgs, []interface{}{int64(123), true}) {
+		t.Errorf("HtlcQuery.GetHtlcByID() gotArgs = %v", gotArgs)
+	}
+}
+
+func TestHtlcQuery_BuildModels(t *testing.T) {
+	hq := NewHtlcQuery()
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	mockRow := sqlmock.NewRows(hq.Fields)
+	mockRow.AddRow(
+		mockHtlc.GetID(),
+		mockHtlc.GetSenderAddress(),
+		mockHtlc.GetRecipientAddress(),
+		mockHtlc.GetAmount(),
+		mockHtlc.GetHashLock(),
+		mockHtlc.GetTimeLockHeight(),
+		mockHtlc.GetStatus(),
+		mockHtlc.GetPreimage(),
+		mockHtlc.GetBlockHeight(),
+		mockHtlc.GetLatest(),
+	)
+	mock.ExpectQuery("").WillReturnRows(mockRow)
+	rows, _ := db.Query("")
+	got, err := hq.BuildModels(rows)
+	if err != nil {
+		t.Errorf("HtlcQuery.BuildModels() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, []*model.Htlc{mockHtlc}) {
+		t.Errorf("HtlcQuery.BuildModels() = %v, want %v", got, mockHtlc)
+	}
+}
+
+func TestHtlcQuery_Scan(t *testing.T) {
+	var htlc model.Htlc
+	hq := NewHtlcQuery()
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	mockRow := sqlmock.NewRows(hq.Fields)
+	mockRow.AddRow(
+		mockHtlc.GetID(),
+		mockHtlc.GetSenderAddress(),
+		mockHtlc.GetRecipientAddress(),
+		mockHtlc.GetAmount(),
+		mockHtlc.GetHashLock(),
+		mockHtlc.GetTimeLockHeight(),
+		mockHtlc.GetStatus(),
+		mockHtlc.GetPreimage(),
+		mockHtlc.GetBlockHeight(),
+		mockHtlc.GetLatest(),
+	)
+	mock.ExpectQuery("").WillReturnRows(mockRow)
+	if err := hq.Scan(&htlc, db.QueryRow("")); err != nil {
+		t.Errorf("HtlcQuery.Scan() error = %v", err)
+	}
+	if !reflect.DeepEqual(&htlc, mockHtlc) {
+		t.Errorf("HtlcQuery.Scan() = %v, want %v", &htlc, mockHtlc)
+	}
+}
+
+func TestHtlcQuery_Rollback(t *testing.T) {
+	hq := NewHtlcQuery()
+	want := [][]interface{}{
+		{
+			"DELETE FROM htlc WHERE block_height > ?",
+			uint32(30),
+		},
+		{
+			`
+			UPDATE htlc SET latest = ?
+			WHERE latest = ? AND (id, block_height) IN (
+				SELECT t2.id, MAX(t2.block_height)
+				FROM htlc as t2
+				GROUP BY t2.id
+			)`,
+			1,
+			0,
+		},
+	}
+	if got := hq.Rollback(30); !reflect.DeepEqual(got, want) {
+		t.Errorf("HtlcQuery.Rollback() = %v, want %v", got, want)
+	}
+}
+
+func TestHtlcQuery_SelectDataForSnapshot(t *testing.T) {
+	hq := NewHtlcQuery()
+	want := fmt.Sprintf("SELECT id,sender_address,recipient_address,amount,hash_lock,time_lock_height,status,preimage,block_height,latest "+
+		"FROM htlc WHERE (id, block_height) IN (SELECT t2.id, MAX(t2.block_height) FROM htlc as t2 "+
+		"WHERE t2.block_height >= %d AND t2.block_height <= %d AND t2.block_height != 0 GROUP BY t2.id) ORDER BY block_height", 0, 10)
+	if got := hq.SelectDataForSnapshot(0, 10); got != want {
+		t.Errorf("HtlcQuery.SelectDataForSnapshot() = %v, want %v", got, want)
+	}
+}
+
+func TestHtlcQuery_TrimDataBeforeSnapshot(t *testing.T) {
+	hq := NewHtlcQuery()
+	want := "DELETE FROM htlc WHERE block_height >= 0 AND block_height <= 10 AND block_height != 0"
+	if got := hq.TrimDataBeforeSnapshot(0, 10); got != want {
+		t.Errorf("HtlcQuery.TrimDataBeforeSnapshot() = %v, want %v", got, want)
+	}
+}
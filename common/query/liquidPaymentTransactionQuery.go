@@ -73,6 +73,7 @@ type (
 		GetPendingLiquidPaymentTransactionByID(id int64, status model.LiquidPaymentStatus) (str string, args []interface{})
 		GetPassedTimePendingLiquidPaymentTransactions(timestamp int64) (qStr string, args []interface{})
 		CompleteLiquidPaymentTransaction(id int64, causedFields map[string]interface{}) [][]interface{}
+		WithdrawLiquidPaymentTransaction(id, claimedAmount int64, causedFields map[string]interface{}) [][]interface{}
 		ExtractModel(*model.LiquidPayment) []interface{}
 		BuildModels(*sql.Rows) ([]*model.LiquidPayment, error)
 		Scan(liquidPayment *model.LiquidPayment, row *sql.Row) error
@@ -92,6 +93,7 @@ func NewLiquidPaymentTransactionQuery() *LiquidPaymentTransactionQuery {
 			"status",
 			"block_height",
 			"latest",
+			"claimed_amount",
 		},
 		TableName: "liquid_payment_transaction",
 	}
@@ -189,16 +191,49 @@ func (lpt *LiquidPaymentTransactionQuery) CompleteLiquidPaymentTransaction(id in
 	return [][]interface{}{
 		{
 			fmt.Sprintf(
-				"INSERT INTO %s (id, sender_address, recipient_address, amount, applied_time, complete_minutes, status, block_height, latest)"+
-					" SELECT id, sender_address, recipient_address, amount, applied_time, complete_minutes, ?, %d, true FROM %s WHERE id = %d AND latest = 1"+
-					" ON CONFLICT(id, block_height) DO UPDATE SET status = ?",
+				"INSERT INTO %s (id, sender_address, recipient_address, amount, applied_time, complete_minutes, status, block_height, latest, "+
+					"claimed_amount) SELECT id, sender_address, recipient_address, amount, applied_time, complete_minutes, ?, %d, true, "+
+					"? FROM %s WHERE id = %d AND latest = 1 ON CONFLICT(id, block_height) DO UPDATE SET status = ?, claimed_amount = ?",
 				lpt.getTableName(),
 				causedFields["block_height"],
 				lpt.getTableName(),
 				id,
 			),
 			model.LiquidPaymentStatus_LiquidPaymentCompleted,
+			causedFields["claimed_amount"],
 			model.LiquidPaymentStatus_LiquidPaymentCompleted,
+			causedFields["claimed_amount"],
+		},
+		{
+			fmt.Sprintf(
+				"UPDATE %s set latest = ? WHERE id = ? AND block_height != %d and latest = true",
+				lpt.getTableName(),
+				causedFields["block_height"],
+			),
+			false,
+			id,
+		},
+	}
+}
+
+// WithdrawLiquidPaymentTransaction insert a new version of the liquid payment with the amount claimed so far by the recipient
+func (lpt *LiquidPaymentTransactionQuery) WithdrawLiquidPaymentTransaction(
+	id, claimedAmount int64,
+	causedFields map[string]interface{},
+) [][]interface{} {
+	return [][]interface{}{
+		{
+			fmt.Sprintf(
+				"INSERT INTO %s (id, sender_address, recipient_address, amount, applied_time, complete_minutes, status, block_height, latest, "+
+					"claimed_amount) SELECT id, sender_address, recipient_address, amount, applied_time, complete_minutes, status, %d, true, "+
+					"? FROM %s WHERE id = %d AND latest = 1 ON CONFLICT(id, block_height) DO UPDATE SET claimed_amount = ?",
+				lpt.getTableName(),
+				causedFields["block_height"],
+				lpt.getTableName(),
+				id,
+			),
+			claimedAmount,
+			claimedAmount,
 		},
 		{
 			fmt.Sprintf(
@@ -245,6 +280,7 @@ func (lpt *LiquidPaymentTransactionQuery) ExtractModel(liquidPayment *model.Liqu
 		liquidPayment.GetStatus(),
 		liquidPayment.GetBlockHeight(),
 		liquidPayment.GetLatest(),
+		liquidPayment.GetClaimedAmount(),
 	}
 }
 
@@ -267,6 +303,7 @@ func (lpt *LiquidPaymentTransactionQuery) BuildModels(rows *sql.Rows) ([]*model.
 			&liquidPayment.Status,
 			&liquidPayment.BlockHeight,
 			&liquidPayment.Latest,
+			&liquidPayment.ClaimedAmount,
 		)
 		if err != nil {
 			return nil, err
@@ -288,6 +325,7 @@ func (lpt *LiquidPaymentTransactionQuery) Scan(liquidPayment *model.LiquidPaymen
 		&liquidPayment.Status,
 		&liquidPayment.BlockHeight,
 		&liquidPayment.Latest,
+		&liquidPayment.ClaimedAmount,
 	)
 }
 
@@ -100,7 +100,7 @@ func TestLiquidPaymentTransactionQuery_InsertLiquidPaymentTransaction(t *testing
 				append(
 					[]interface{}{
 						"INSERT INTO liquid_payment_transaction (id,sender_address,recipient_address,amount," +
-							"applied_time,complete_minutes,status,block_height,latest) VALUES(? , ?, ?, ?, ?, ?, ?, ?, ?)",
+							"applied_time,complete_minutes,status,block_height,latest,claimed_amount) VALUES(? , ?, ?, ?, ?, ?, ?, ?, ?, ?)",
 					},
 					[]interface{}{
 						liquidPayment.GetID(),
@@ -112,6 +112,7 @@ func TestLiquidPaymentTransactionQuery_InsertLiquidPaymentTransaction(t *testing
 						liquidPayment.GetStatus(),
 						liquidPayment.GetBlockHeight(),
 						true,
+						liquidPayment.GetClaimedAmount(),
 					}...,
 				),
 			},
@@ -142,16 +143,20 @@ func TestLiquidPaymentTransactionQuery_CompleteLiquidPaymentTransaction(t *testi
 			args: args{
 				id: 1234,
 				causedFields: map[string]interface{}{
-					"block_height": 123,
+					"block_height":   123,
+					"claimed_amount": int64(500),
 				},
 			},
 			want: [][]interface{}{
 				{
 					"INSERT INTO liquid_payment_transaction (id, sender_address, recipient_address, amount, applied_time, complete_minutes, status," +
-						" block_height, latest) SELECT id, sender_address, recipient_address, amount, applied_time, complete_minutes, ?, 123, true FROM" +
-						" liquid_payment_transaction WHERE id = 1234 AND latest = 1 ON CONFLICT(id, block_height) DO UPDATE SET status = ?",
+						" block_height, latest, claimed_amount) SELECT id, sender_address, recipient_address, amount, applied_time, complete_minutes," +
+						" ?, 123, true, ? FROM liquid_payment_transaction WHERE id = 1234 AND latest = 1" +
+						" ON CONFLICT(id, block_height) DO UPDATE SET status = ?, claimed_amount = ?",
 					model.LiquidPaymentStatus_LiquidPaymentCompleted,
+					int64(500),
 					model.LiquidPaymentStatus_LiquidPaymentCompleted,
+					int64(500),
 				},
 				{
 					"UPDATE liquid_payment_transaction set latest = ? WHERE id = ? AND block_height != 123 and latest = true",
@@ -171,6 +176,54 @@ func TestLiquidPaymentTransactionQuery_CompleteLiquidPaymentTransaction(t *testi
 	}
 }
 
+func TestLiquidPaymentTransactionQuery_WithdrawLiquidPaymentTransaction(t *testing.T) {
+	type args struct {
+		id            int64
+		claimedAmount int64
+		causedFields  map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want [][]interface{}
+	}{
+		{
+			name: "wantSuccess",
+			args: args{
+				id:            1234,
+				claimedAmount: 500,
+				causedFields: map[string]interface{}{
+					"block_height": 123,
+				},
+			},
+			want: [][]interface{}{
+				{
+					"INSERT INTO liquid_payment_transaction (id, sender_address, recipient_address, amount, applied_time, complete_minutes, status," +
+						" block_height, latest, claimed_amount) SELECT id, sender_address, recipient_address, amount, applied_time, complete_minutes," +
+						" status, 123, true, ? FROM liquid_payment_transaction WHERE id = 1234 AND latest = 1" +
+						" ON CONFLICT(id, block_height) DO UPDATE SET claimed_amount = ?",
+					int64(500),
+					int64(500),
+				},
+				{
+					"UPDATE liquid_payment_transaction set latest = ? WHERE id = ? AND block_height != 123 and latest = true",
+					false,
+					1234,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lpt := NewLiquidPaymentTransactionQuery()
+			got := lpt.WithdrawLiquidPaymentTransaction(tt.args.id, tt.args.claimedAmount, tt.args.causedFields)
+			if fmt.Sprintf("%v", got) != fmt.Sprintf("%v", tt.want) {
+				t.Errorf("LiquidPaymentTransactionQuery.WithdrawLiquidPaymentTransaction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestLiquidPaymentTransactionQuery_GetPendingLiquidPaymentTransactionByID(t *testing.T) {
 	type args struct {
 		id     int64
@@ -189,7 +242,7 @@ func TestLiquidPaymentTransactionQuery_GetPendingLiquidPaymentTransactionByID(t
 				status: model.LiquidPaymentStatus_LiquidPaymentPending,
 			},
 			wantStr: "SELECT id, sender_address, recipient_address, amount, applied_time, complete_minutes, status," +
-				" block_height, latest FROM liquid_payment_transaction WHERE id = ? AND status = ? AND latest = ?",
+				" block_height, latest, claimed_amount FROM liquid_payment_transaction WHERE id = ? AND status = ? AND latest = ?",
 			wantArgs: []interface{}{123, model.LiquidPaymentStatus_LiquidPaymentPending, true},
 		},
 	}
@@ -223,7 +276,8 @@ func TestLiquidPaymentTransactionQuery_GetPassedTimePendingLiquidPaymentTransact
 				timestamp: 123141,
 			},
 			wantQStr: "SELECT id, sender_address, recipient_address, amount, applied_time, complete_minutes, status," +
-				" block_height, latest FROM liquid_payment_transaction WHERE applied_time+(complete_minutes*60) <= ? AND status = ? AND latest = ?",
+				" block_height, latest, claimed_amount FROM liquid_payment_transaction WHERE applied_time+(complete_minutes*60) <= ? AND status = ?" +
+				" AND latest = ?",
 			wantArgs: []interface{}{123141, model.LiquidPaymentStatus_LiquidPaymentPending, true},
 		},
 	}
@@ -262,6 +316,7 @@ func TestLiquidPaymentTransactionQuery_ExtractModel(t *testing.T) {
 					Status:           1234567,
 					BlockHeight:      12345678,
 					Latest:           true,
+					ClaimedAmount:    100,
 				},
 			},
 			want: []interface{}{123,
@@ -272,7 +327,8 @@ func TestLiquidPaymentTransactionQuery_ExtractModel(t *testing.T) {
 				123456,
 				1234567,
 				12345678,
-				true},
+				true,
+				100},
 		},
 	}
 	for _, tt := range tests {
@@ -297,6 +353,7 @@ func TestLiquidPaymentTransactionQuery_BuildModels(t *testing.T) {
 		Status:           1234567,
 		BlockHeight:      12345678,
 		Latest:           true,
+		ClaimedAmount:    100,
 	}
 	db, mock, _ := sqlmock.New()
 	mockRow := sqlmock.NewRows(mockLiquidPaymentTransaction.Fields)
@@ -310,6 +367,7 @@ func TestLiquidPaymentTransactionQuery_BuildModels(t *testing.T) {
 		mockLiquidPayment.GetStatus(),
 		mockLiquidPayment.GetBlockHeight(),
 		mockLiquidPayment.GetLatest(),
+		mockLiquidPayment.GetClaimedAmount(),
 	)
 	mock.ExpectQuery("").WillReturnRows(mockRow)
 	mockedRow, _ := db.Query("")
@@ -358,6 +416,7 @@ func TestLiquidPaymentTransactionQuery_Scan(t *testing.T) {
 		Status:           1234567,
 		BlockHeight:      12345678,
 		Latest:           true,
+		ClaimedAmount:    100,
 	}
 	db, mock, _ := sqlmock.New()
 	mockRow := sqlmock.NewRows(mockLiquidPaymentTransaction.Fields)
@@ -371,6 +430,7 @@ func TestLiquidPaymentTransactionQuery_Scan(t *testing.T) {
 		mockLiquidPayment.GetStatus(),
 		mockLiquidPayment.GetBlockHeight(),
 		mockLiquidPayment.GetLatest(),
+		mockLiquidPayment.GetClaimedAmount(),
 	)
 	mock.ExpectQuery("").WillReturnRows(mockRow)
 	mockedRow := db.QueryRow("")
@@ -459,7 +519,7 @@ func TestLiquidPaymentTransactionQuery_SelectDataForSnapshot(t *testing.T) {
 				toHeight:   1440,
 			},
 			want: "SELECT id,sender_address,recipient_address,amount,applied_time,complete_minutes,status," +
-				"block_height,latest FROM liquid_payment_transaction WHERE (id, block_height) IN (SELECT t2.id, MAX(" +
+				"block_height,latest,claimed_amount FROM liquid_payment_transaction WHERE (id, block_height) IN (SELECT t2.id, MAX(" +
 				"t2.block_height) FROM liquid_payment_transaction as t2 WHERE t2.block_height >= 720" +
 				" AND t2.block_height <= 1440 AND t2.block_height != 0 GROUP BY t2.id) ORDER BY block_height",
 		},
@@ -0,0 +1,335 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package query
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+type (
+	// LockedFundQuery fields must have
+	LockedFundQuery struct {
+		Fields    []string
+		TableName string
+	}
+
+	// LockedFundQueryInterface methods must have
+	LockedFundQueryInterface interface {
+		InsertLockedFund(lockedFund *model.LockedFund) [][]interface{}
+		InsertLockedFunds(lockedFunds []*model.LockedFund) (str string, args []interface{})
+		GetLockedFundByID(id int64) (str string, args []interface{})
+		GetReleasableLockedFunds(blockHeight uint32, blockTimestamp int64) (str string, args []interface{})
+		ReleaseLockedFund(id int64, blockHeight uint32) [][]interface{}
+		ExtractModel(lockedFund *model.LockedFund) []interface{}
+		BuildModels(rows *sql.Rows) ([]*model.LockedFund, error)
+		Scan(lockedFund *model.LockedFund, row *sql.Row) error
+	}
+)
+
+// NewLockedFundQuery build a LockedFundQuery
+func NewLockedFundQuery() *LockedFundQuery {
+	return &LockedFundQuery{
+		Fields: []string{
+			"id",
+			"sender_address",
+			"recipient_address",
+			"amount",
+			"unlock_height",
+			"unlock_timestamp",
+			"status",
+			"block_height",
+			"latest",
+		},
+		TableName: "locked_fund",
+	}
+}
+
+func (lfq *LockedFundQuery) getTableName() string {
+	return lfq.TableName
+}
+
+// InsertLockedFund insert a new version of the locked fund, setting the previous one as not latest
+func (lfq *LockedFundQuery) InsertLockedFund(lockedFund *model.LockedFund) [][]interface{} {
+	lockedFund.Latest = true
+	return [][]interface{}{
+		{
+			fmt.Sprintf(
+				"UPDATE %s set latest = ? WHERE id = ?",
+				lfq.getTableName(),
+			),
+			false,
+			lockedFund.GetID(),
+		},
+		append(
+			[]interface{}{
+				fmt.Sprintf(
+					"INSERT INTO %s (%s) VALUES(%s)",
+					lfq.getTableName(),
+					strings.Join(lfq.Fields, ","),
+					fmt.Sprintf("? %s", strings.Repeat(", ?", len(lfq.Fields)-1))),
+			},
+			lfq.ExtractModel(lockedFund)...,
+		),
+	}
+}
+
+// InsertLockedFunds represents query builder to insert multiple records in single query
+func (lfq *LockedFundQuery) InsertLockedFunds(lockedFunds []*model.LockedFund) (str string, args []interface{}) {
+	if len(lockedFunds) > 0 {
+		str = fmt.Sprintf(
+			"INSERT INTO %s (%s) VALUES ",
+			lfq.getTableName(),
+			strings.Join(lfq.Fields, ", "),
+		)
+		for k, lockedFund := range lockedFunds {
+			str += fmt.Sprintf(
+				"(?%s)",
+				strings.Repeat(", ?", len(lfq.Fields)-1),
+			)
+			if k < len(lockedFunds)-1 {
+				str += ","
+			}
+			args = append(args, lfq.ExtractModel(lockedFund)...)
+		}
+	}
+	return str, args
+}
+
+// ImportSnapshot takes payload from downloaded snapshot and insert them into database
+func (lfq *LockedFundQuery) ImportSnapshot(payload interface{}) ([][]interface{}, error) {
+	var (
+		queries [][]interface{}
+	)
+	lockedFunds, ok := payload.([]*model.LockedFund)
+	if !ok {
+		return nil, blocker.NewBlocker(blocker.DBErr, "ImportSnapshotCannotCastTo"+lfq.TableName)
+	}
+	if len(lockedFunds) > 0 {
+		recordsPerPeriod, rounds, remaining := CalculateBulkSize(len(lfq.Fields), len(lockedFunds))
+		for i := 0; i < rounds; i++ {
+			qry, args := lfq.InsertLockedFunds(lockedFunds[i*recordsPerPeriod : (i*recordsPerPeriod)+recordsPerPeriod])
+			queries = append(queries, append([]interface{}{qry}, args...))
+		}
+		if remaining > 0 {
+			qry, args := lfq.InsertLockedFunds(lockedFunds[len(lockedFunds)-remaining:])
+			queries = append(queries, append([]interface{}{qry}, args...))
+		}
+	}
+	return queries, nil
+}
+
+// RecalibrateVersionedTable recalibrate table to clean up multiple latest rows due to import function
+func (lfq *LockedFundQuery) RecalibrateVersionedTable() []string {
+	return []string{
+		fmt.Sprintf(
+			"update %s set latest = false where latest = true AND (id, block_height) NOT IN "+
+				"(select t2.id, max(t2.block_height) from %s t2 group by t2.id)",
+			lfq.getTableName(), lfq.getTableName()),
+		fmt.Sprintf(
+			"update %s set latest = true where latest = false AND (id, block_height) IN "+
+				"(select t2.id, max(t2.block_height) from %s t2 group by t2.id)",
+			lfq.getTableName(), lfq.getTableName()),
+	}
+}
+
+// GetLockedFundByID fetches the latest locked fund record of a time locked send zbc transaction
+func (lfq *LockedFundQuery) GetLockedFundByID(id int64) (str string, args []interface{}) {
+	return fmt.Sprintf(
+			"SELECT %s FROM %s WHERE id = ? AND latest = ?",
+			strings.Join(lfq.Fields, ", "),
+			lfq.getTableName(),
+		),
+		[]interface{}{id, true}
+}
+
+// GetReleasableLockedFunds fetches the still locked funds which unlock height or unlock timestamp is reached by the block
+func (lfq *LockedFundQuery) GetReleasableLockedFunds(blockHeight uint32, blockTimestamp int64) (str string, args []interface{}) {
+	return fmt.Sprintf(
+			"SELECT %s FROM %s WHERE status = ? AND latest = ? AND "+
+				"((unlock_height > 0 AND unlock_height <= ?) OR (unlock_timestamp > 0 AND unlock_timestamp <= ?)) ORDER BY id",
+			strings.Join(lfq.Fields, ", "),
+			lfq.getTableName(),
+		),
+		[]interface{}{model.LockedFundStatus_LockedFundLocked, true, blockHeight, blockTimestamp}
+}
+
+// ReleaseLockedFund insert a released version of the latest locked fund record at blockHeight
+func (lfq *LockedFundQuery) ReleaseLockedFund(id int64, blockHeight uint32) [][]interface{} {
+	return [][]interface{}{
+		{
+			fmt.Sprintf(
+				"INSERT INTO %s (%s) SELECT id, sender_address, recipient_address, amount, unlock_height, unlock_timestamp, ?, ?, true "+
+					"FROM %s WHERE id = ? AND latest = 1 ON CONFLICT(id, block_height) DO UPDATE SET status = ?",
+				lfq.getTableName(),
+				strings.Join(lfq.Fields, ", "),
+				lfq.getTableName(),
+			),
+			model.LockedFundStatus_LockedFundReleased,
+			blockHeight,
+			id,
+			model.LockedFundStatus_LockedFundReleased,
+		},
+		{
+			fmt.Sprintf(
+				"UPDATE %s set latest = ? WHERE id = ? AND block_height != ? AND latest = true",
+				lfq.getTableName(),
+			),
+			false,
+			id,
+			blockHeight,
+		},
+	}
+}
+
+// ExtractModel will extract values of LockedFund as []interface{}
+func (lfq *LockedFundQuery) ExtractModel(lockedFund *model.LockedFund) []interface{} {
+	return []interface{}{
+		lockedFund.GetID(),
+		lockedFund.GetSenderAddress(),
+		lockedFund.GetRecipientAddress(),
+		lockedFund.GetAmount(),
+		lockedFund.GetUnlockHeight(),
+		lockedFund.GetUnlockTimestamp(),
+		lockedFund.GetStatus(),
+		lockedFund.GetBlockHeight(),
+		lockedFund.GetLatest(),
+	}
+}
+
+// BuildModels extract sqlRaw into []*model.LockedFund
+func (lfq *LockedFundQuery) BuildModels(rows *sql.Rows) ([]*model.LockedFund, error) {
+	var (
+		lockedFunds []*model.LockedFund
+		err         error
+	)
+
+	for rows.Next() {
+		var lockedFund model.LockedFund
+		err = rows.Scan(
+			&lockedFund.ID,
+			&lockedFund.SenderAddress,
+			&lockedFund.RecipientAddress,
+			&lockedFund.Amount,
+			&lockedFund.UnlockHeight,
+			&lockedFund.UnlockTimestamp,
+			&lockedFund.Status,
+			&lockedFund.BlockHeight,
+			&lockedFund.Latest,
+		)
+		if err != nil {
+			return nil, err
+		}
+		lockedFunds = append(lockedFunds, &lockedFund)
+	}
+	return lockedFunds, nil
+}
+
+// Scan extract sqlRaw *sql.Row into model.LockedFund
+func (lfq *LockedFundQuery) Scan(lockedFund *model.LockedFund, row *sql.Row) error {
+	return row.Scan(
+		&lockedFund.ID,
+		&lockedFund.SenderAddress,
+		&lockedFund.RecipientAddress,
+		&lockedFund.Amount,
+		&lockedFund.UnlockHeight,
+		&lockedFund.UnlockTimestamp,
+		&lockedFund.Status,
+		&lockedFund.BlockHeight,
+		&lockedFund.Latest,
+	)
+}
+
+// Rollback delete records `WHERE height > "height"
+func (lfq *LockedFundQuery) Rollback(height uint32) (multiQueries [][]interface{}) {
+	return [][]interface{}{
+		{
+			fmt.Sprintf("DELETE FROM %s WHERE block_height > ?", lfq.getTableName()),
+			height,
+		},
+		{
+			fmt.Sprintf(`
+			UPDATE %s SET latest = ?
+			WHERE latest = ? AND (id, block_height) IN (
+				SELECT t2.id, MAX(t2.block_height)
+				FROM %s as t2
+				GROUP BY t2.id
+			)`,
+				lfq.getTableName(),
+				lfq.getTableName(),
+			),
+			1,
+			0,
+		},
+	}
+}
+
+// SelectDataForSnapshot select the latest version of each locked fund written between fromHeight and toHeight
+func (lfq *LockedFundQuery) SelectDataForSnapshot(fromHeight, toHeight uint32) string {
+	return fmt.Sprintf(
+		"SELECT %s FROM %s WHERE (id, block_height) IN (SELECT t2.id, MAX(t2.block_height) FROM %s as t2 "+
+			"WHERE t2.block_height >= %d AND t2.block_height <= %d AND t2.block_height != 0 GROUP BY t2.id) ORDER BY block_height",
+		strings.Join(lfq.Fields, ","),
+		lfq.getTableName(),
+		lfq.getTableName(),
+		fromHeight,
+		toHeight,
+	)
+}
+
+// TrimDataBeforeSnapshot delete entries to assure there are no duplicates before applying a snapshot
+func (lfq *LockedFundQuery) TrimDataBeforeSnapshot(fromHeight, toHeight uint32) string {
+	return fmt.Sprintf(`DELETE FROM %s WHERE block_height >= %d AND block_height <= %d AND block_height != 0`,
+		lfq.getTableName(), fromHeight, toHeight)
+}
@@ -0,0 +1,359 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package query
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+var (
+	mockLockedFund = &model.LockedFund{
+		ID:               1,
+		SenderAddress:    liquidPayTxAddress1,
+		RecipientAddress: liquidPayTxAddress2,
+		Amount:           123456,
+		UnlockHeight:     100,
+		Status:           model.LockedFundStatus_LockedFundLocked,
+		BlockHeight:      24,
+		Latest:           true,
+	}
+	mockLockedFundFields = "id, sender_address, recipient_address, amount, unlock_height, unlock_timestamp, status, block_height, latest"
+)
+
+func TestLockedFundQuery_InsertLockedFund(t *testing.T) {
+	type args struct {
+		lockedFund *model.LockedFund
+	}
+	tests := []struct {
+		name string
+		args args
+		want [][]interface{}
+	}{
+		{
+			name: "wantSuccess",
+			args: args{
+				lockedFund: mockLockedFund,
+			},
+			want: [][]interface{}{
+				{
+					"UPDATE locked_fund set latest = ? WHERE id = ?",
+					false,
+					int64(1),
+				},
+				{
+					"INSERT INTO locked_fund (id,sender_address,recipient_address,amount,unlock_height,unlock_timestamp," +
+						"status,block_height,latest) VALUES(? , ?, ?, ?, ?, ?, ?, ?, ?)",
+					mockLockedFund.GetID(),
+					mockLockedFund.GetSenderAddress(),
+					mockLockedFund.GetRecipientAddress(),
+					mockLockedFund.GetAmount(),
+					mockLockedFund.GetUnlockHeight(),
+					mockLockedFund.GetUnlockTimestamp(),
+					mockLockedFund.GetStatus(),
+					mockLockedFund.GetBlockHeight(),
+					true,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lfq := NewLockedFundQuery()
+			if got := lfq.InsertLockedFund(tt.args.lockedFund); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LockedFundQuery.InsertLockedFund() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLockedFundQuery_InsertLockedFunds(t *testing.T) {
+	type args struct {
+		lockedFunds []*model.LockedFund
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantStr  string
+		wantArgs []interface{}
+	}{
+		{
+			name:    "wantEmpty",
+			args:    args{},
+			wantStr: "",
+		},
+		{
+			name: "wantSuccess",
+			args: args{
+				lockedFunds: []*model.LockedFund{mockLockedFund, mockLockedFund},
+			},
+			wantStr: "INSERT INTO locked_fund (" + mockLockedFundFields + ") VALUES " +
+				"(?, ?, ?, ?, ?, ?, ?, ?, ?),(?, ?, ?, ?, ?, ?, ?, ?, ?)",
+			wantArgs: append(NewLockedFundQuery().ExtractModel(mockLockedFund), NewLockedFundQuery().ExtractModel(mockLockedFund)...),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lfq := NewLockedFundQuery()
+			gotStr, gotArgs := lfq.InsertLockedFunds(tt.args.lockedFunds)
+			if gotStr != tt.wantStr {
+				t.Errorf("LockedFundQuery.InsertLockedFunds() gotStr = %v, want %v", gotStr, tt.wantStr)
+			}
+			if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("LockedFundQuery.InsertLockedFunds() gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestLockedFundQuery_ImportSnapshot(t *testing.T) {
+	tests := []struct {
+		name        string
+		payload     interface{}
+		wantQueries int
+		wantErr     bool
+	}{
+		{
+			name:    "wantFail:WrongPayload",
+			payload: []*model.LiquidPayment{},
+			wantErr: true,
+		},
+		{
+			name:        "wantSuccess",
+			payload:     []*model.LockedFund{mockLockedFund},
+			wantQueries: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lfq := NewLockedFundQuery()
+			got, err := lfq.ImportSnapshot(tt.payload)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LockedFundQuery.ImportSnapshot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != tt.wantQueries {
+				t.Errorf("LockedFundQuery.ImportSnapshot() got %d queries, want %d", len(got), tt.wantQueries)
+			}
+		})
+	}
+}
+
+func TestLockedFundQuery_GetLockedFundByID(t *testing.T) {
+	lfq := NewLockedFundQuery()
+	gotStr, gotArgs := lfq.GetLockedFundByID(123)
+	wantStr := "SELECT " + mockLockedFundFields + " FROM locked_fund WHERE id = ? AND latest = ?"
+	if gotStr != wantStr {
+		t.Errorf("LockedFundQuery.GetLockedFundByID() gotStr = %v, want %v", gotStr, wantStr)
+	}
+	if !reflect.DeepEqual(gotArgs, []interface{}{int64(123), true}) {
+		t.Errorf("LockedFundQuery.GetLockedFundByID() gotArgs = %v", gotArgs)
+	}
+}
+
+func TestLockedFundQuery_GetReleasableLockedFunds(t *testing.T) {
+	type args struct {
+		blockHeight    uint32
+		blockTimestamp int64
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantStr  string
+		wantArgs []interface{}
+	}{
+		{
+			name: "wantSuccess",
+			args: args{
+				blockHeight:    100,
+				blockTimestamp: 1000000,
+			},
+			wantStr: "SELECT " + mockLockedFundFields + " FROM locked_fund WHERE status = ? AND latest = ? AND " +
+				"((unlock_height > 0 AND unlock_height <= ?) OR (unlock_timestamp > 0 AND unlock_timestamp <= ?)) ORDER BY id",
+			wantArgs: []interface{}{model.LockedFundStatus_LockedFundLocked, true, uint32(100), int64(1000000)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lfq := NewLockedFundQuery()
+			gotStr, gotArgs := lfq.GetReleasableLockedFunds(tt.args.blockHeight, tt.args.blockTimestamp)
+			if gotStr != tt.wantStr {
+				t.Errorf("LockedFundQuery.GetReleasableLockedFunds() gotStr = %v, want %v", gotStr, tt.wantStr)
+			}
+			if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("LockedFundQuery.GetReleasableLockedFunds() gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestLockedFundQuery_ReleaseLockedFund(t *testing.T) {
+	lfq := NewLockedFundQuery()
+	want := [][]interface{}{
+		{
+			"INSERT INTO locked_fund (" + mockLockedFundFields + ") SELECT id, sender_address, recipient_address, amount, " +
+				"unlock_height, unlock_timestamp, ?, ?, true FROM locked_fund WHERE id = ? AND latest = 1 " +
+				"ON CONFLICT(id, block_height) DO UPDATE SET status = ?",
+			model.LockedFundStatus_LockedFundReleased,
+			uint32(123),
+			int64(1234),
+			model.LockedFundStatus_LockedFundReleased,
+		},
+		{
+			"UPDATE locked_fund set latest = ? WHERE id = ? AND block_height != ? AND latest = true",
+			false,
+			int64(1234),
+			uint32(123),
+		},
+	}
+	if got := lfq.ReleaseLockedFund(1234, 123); !reflect.DeepEqual(got, want) {
+		t.Errorf("LockedFundQuery.ReleaseLockedFund() = %v, want %v", got, want)
+	}
+}
+
+func TestLockedFundQuery_BuildModels(t *testing.T) {
+	lfq := NewLockedFundQuery()
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	mockRow := sqlmock.NewRows(lfq.Fields)
+	mockRow.AddRow(
+		mockLockedFund.GetID(),
+		mockLockedFund.GetSenderAddress(),
+		mockLockedFund.GetRecipientAddress(),
+		mockLockedFund.GetAmount(),
+		mockLockedFund.GetUnlockHeight(),
+		mockLockedFund.GetUnlockTimestamp(),
+		mockLockedFund.GetStatus(),
+		mockLockedFund.GetBlockHeight(),
+		mockLockedFund.GetLatest(),
+	)
+	mock.ExpectQuery("").WillReturnRows(mockRow)
+	rows, _ := db.Query("")
+	got, err := lfq.BuildModels(rows)
+	if err != nil {
+		t.Errorf("LockedFundQuery.BuildModels() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, []*model.LockedFund{mockLockedFund}) {
+		t.Errorf("LockedFundQuery.BuildModels() = %v, want %v", got, mockLockedFund)
+	}
+}
+
+func TestLockedFundQuery_Scan(t *testing.T) {
+	var lockedFund model.LockedFund
+	lfq := NewLockedFundQuery()
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	mockRow := sqlmock.NewRows(lfq.Fields)
+	mockRow.AddRow(
+		mockLockedFund.GetID(),
+		mockLockedFund.GetSenderAddress(),
+		mockLockedFund.GetRecipientAddress(),
+		mockLockedFund.GetAmount(),
+		mockLockedFund.GetUnlockHeight(),
+		mockLockedFund.GetUnlockTimestamp(),
+		mockLockedFund.GetStatus(),
+		mockLockedFund.GetBlockHeight(),
+		mockLockedFund.GetLatest(),
+	)
+	mock.ExpectQuery("").WillReturnRows(mockRow)
+	if err := lfq.Scan(&lockedFund, db.QueryRow("")); err != nil {
+		t.Errorf("LockedFundQuery.Scan() error = %v", err)
+	}
+	if !reflect.DeepEqual(&lockedFund, mockLockedFund) {
+		t.Errorf("LockedFundQuery.Scan() = %v, want %v", &lockedFund, mockLockedFund)
+	}
+}
+
+func TestLockedFundQuery_Rollback(t *testing.T) {
+	lfq := NewLockedFundQuery()
+	want := [][]interface{}{
+		{
+			"DELETE FROM locked_fund WHERE block_height > ?",
+			uint32(30),
+		},
+		{
+			`
+			UPDATE locked_fund SET latest = ?
+			WHERE latest = ? AND (id, block_height) IN (
+				SELECT t2.id, MAX(t2.block_height)
+				FROM locked_fund as t2
+				GROUP BY t2.id
+			)`,
+			1,
+			0,
+		},
+	}
+	if got := lfq.Rollback(30); !reflect.DeepEqual(got, want) {
+		t.Errorf("LockedFundQuery.Rollback() = %v, want %v", got, want)
+	}
+}
+
+func TestLockedFundQuery_SelectDataForSnapshot(t *testing.T) {
+	lfq := NewLockedFundQuery()
+	want := fmt.Sprintf("SELECT id,sender_address,recipient_address,amount,unlock_height,unlock_timestamp,status,block_height,latest "+
+		"FROM locked_fund WHERE (id, block_height) IN (SELECT t2.id, MAX(t2.block_height) FROM locked_fund as t2 "+
+		"WHERE t2.block_height >= %d AND t2.block_height <= %d AND t2.block_height != 0 GROUP BY t2.id) ORDER BY block_height", 0, 10)
+	if got := lfq.SelectDataForSnapshot(0, 10); got != want {
+		t.Errorf("LockedFundQuery.SelectDataForSnapshot() = %v, want %v", got, want)
+	}
+}
+
+func TestLockedFundQuery_TrimDataBeforeSnapshot(t *testing.T) {
+	lfq := NewLockedFundQuery()
+	want := "DELETE FROM locked_fund WHERE block_height >= 0 AND block_height <= 10 AND block_height != 0"
+	if got := lfq.TrimDataBeforeSnapshot(0, 10); got != want {
+		t.Errorf("LockedFundQuery.TrimDataBeforeSnapshot() = %v, want %v", got, want)
+	}
+}
@@ -84,6 +84,7 @@ func NewMultiSignatureParticipantQuery() *MultiSignatureParticipantQuery {
 			// TODO: multisig participants should not have latest field. once they are added to a multisig address they can never been updated
 			"latest",
 			"block_height",
+			"weight",
 		},
 		TableName: "multisignature_participant",
 	}
@@ -100,6 +101,7 @@ func (msq *MultiSignatureParticipantQuery) ExtractModel(participant *model.Multi
 		participant.GetAccountAddressIndex(),
 		participant.GetLatest(),
 		participant.GetBlockHeight(),
+		participant.GetWeight(),
 	}
 }
 func (msq *MultiSignatureParticipantQuery) BuildModel(rows *sql.Rows) (participants []*model.MultiSignatureParticipant, err error) {
@@ -112,6 +114,7 @@ func (msq *MultiSignatureParticipantQuery) BuildModel(rows *sql.Rows) (participa
 			&participant.AccountAddressIndex,
 			&participant.Latest,
 			&participant.BlockHeight,
+			&participant.Weight,
 		)
 		if err != nil {
 			return participants, err
@@ -127,6 +130,7 @@ func (msq *MultiSignatureParticipantQuery) Scan(participant *model.MultiSignatur
 		&participant.AccountAddressIndex,
 		&participant.Latest,
 		&participant.BlockHeight,
+		&participant.Weight,
 	)
 }
 func (msq *MultiSignatureParticipantQuery) InsertMultisignatureParticipants(
@@ -153,6 +157,12 @@ func (msq *MultiSignatureParticipantQuery) InsertMultisignatureParticipants(
 		}
 		queries = append(
 			queries,
+			// a rotation in the block the participants have been inserted replaces them all
+			[]interface{}{
+				fmt.Sprintf("DELETE FROM %s WHERE multisig_address = ? AND block_height = ?", msq.getTableName()),
+				participants[0].GetMultiSignatureAddress(),
+				participants[0].GetBlockHeight(),
+			},
 			append([]interface{}{qStr}, args...),
 			[]interface{}{
 				fmt.Sprintf(
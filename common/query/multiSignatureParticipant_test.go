@@ -90,6 +90,7 @@ func TestMultiSignatureParticipantQuery_ExtractModel(t *testing.T) {
 					MultiSignatureAddress: multisigAccountAddress1,
 					AccountAddress:        multisigAccountAddress2,
 					BlockHeight:           100,
+					Weight:                3,
 				},
 			},
 			want: []interface{}{
@@ -98,6 +99,7 @@ func TestMultiSignatureParticipantQuery_ExtractModel(t *testing.T) {
 				uint32(0),
 				false,
 				uint32(100),
+				uint32(3),
 			},
 		},
 	}
@@ -123,6 +125,7 @@ func TestMultiSignatureParticipantQuery_BuildModel(t *testing.T) {
 			0,
 			true,
 			100,
+			3,
 		))
 	rows, _ := dbMock.Query("")
 	type fields struct {
@@ -152,6 +155,7 @@ func TestMultiSignatureParticipantQuery_BuildModel(t *testing.T) {
 					AccountAddressIndex:   0,
 					Latest:                true,
 					BlockHeight:           100,
+					Weight:                3,
 				},
 			},
 		},
@@ -183,6 +187,7 @@ func TestMultiSignatureParticipantQuery_Scan(t *testing.T) {
 			0,
 			true,
 			100,
+			3,
 		))
 	row := dbMock.QueryRow("")
 
@@ -251,6 +256,7 @@ func TestMultiSignatureParticipantQuery_InsertMultisignatureParticipants(t *test
 						AccountAddressIndex:   0,
 						BlockHeight:           100,
 						Latest:                true,
+						Weight:                3,
 					},
 					{
 						MultiSignatureAddress: multisigAccountAddress2,
@@ -258,23 +264,30 @@ func TestMultiSignatureParticipantQuery_InsertMultisignatureParticipants(t *test
 						AccountAddressIndex:   1,
 						BlockHeight:           100,
 						Latest:                true,
+						Weight:                1,
 					},
 				},
 			},
 			wantQueries: [][]interface{}{
+				{
+					"DELETE FROM multisignature_participant WHERE multisig_address = ? AND block_height = ?",
+					multisigAccountAddress1, uint32(100),
+				},
 				{
 					"INSERT OR REPLACE INTO multisignature_participant (multisig_address, account_address, account_address_index, latest, " +
-						"block_height) VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)",
+						"block_height, weight) VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)",
 					multisigAccountAddress1,
 					multisigAccountAddress2,
 					uint32(0),
 					true,
 					uint32(100),
+					uint32(3),
 					multisigAccountAddress2,
 					multisigAccountAddress1,
 					uint32(1),
 					true,
 					uint32(100),
+					uint32(1),
 				},
 				{
 					"UPDATE multisignature_participant SET latest = ? WHERE multisig_address = ? AND block_height != ? AND latest = ?",
@@ -321,7 +334,7 @@ func TestMultiSignatureParticipantQuery_GetMultiSignatureParticipantsByMultisigA
 				multisigAddress: multisigAccountAddress1,
 			},
 			fields: fields(*NewMultiSignatureParticipantQuery()),
-			wantStr: "SELECT multisig_address,account_address,account_address_index,latest,block_height " +
+			wantStr: "SELECT multisig_address,account_address,account_address_index,latest,block_height,weight " +
 				"FROM multisignature_participant WHERE multisig_address = ? AND block_height >= ? AND block_height <= ? " +
 				"ORDER BY account_address_index",
 			wantArgs: []interface{}{
@@ -0,0 +1,343 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package query
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/zoobc/zoobc-core/common/accounttype"
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+type (
+	// MultiSignatureRotationQuery fields must have
+	MultiSignatureRotationQuery struct {
+		Fields    []string
+		TableName string
+	}
+
+	// MultiSignatureRotationQueryInterface methods must have
+	MultiSignatureRotationQueryInterface interface {
+		InsertMultiSignatureRotation(rotation *model.MultiSignatureRotation) [][]interface{}
+		InsertMultiSignatureRotations(rotations []*model.MultiSignatureRotation) (str string, args []interface{})
+		GetLatestMultiSignatureRotationByMultisigAddress(multisigAddress []byte) (str string, args []interface{})
+		GetMultiSignatureRotationsByMultisigAddress(multisigAddress []byte) (str string, args []interface{})
+		ExtractModel(rotation *model.MultiSignatureRotation) []interface{}
+		BuildModels(rows *sql.Rows) ([]*model.MultiSignatureRotation, error)
+		Scan(rotation *model.MultiSignatureRotation, row *sql.Row) error
+	}
+)
+
+// NewMultiSignatureRotationQuery build a MultiSignatureRotationQuery
+func NewMultiSignatureRotationQuery() *MultiSignatureRotationQuery {
+	return &MultiSignatureRotationQuery{
+		Fields: []string{
+			"id",
+			"multisig_address",
+			"minimum_signatures",
+			"addresses",
+			"weights",
+			"block_height",
+			"latest",
+		},
+		TableName: "multisignature_rotation",
+	}
+}
+
+func (mrq *MultiSignatureRotationQuery) getTableName() string {
+	return mrq.TableName
+}
+
+// InsertMultiSignatureRotation insert the rotation as the latest one of its multisig address.
+// A second rotation of the same multisig address in a block replaces the first one
+func (mrq *MultiSignatureRotationQuery) InsertMultiSignatureRotation(rotation *model.MultiSignatureRotation) [][]interface{} {
+	rotation.Latest = true
+	return [][]interface{}{
+		{
+			fmt.Sprintf(
+				"UPDATE %s SET latest = ? WHERE multisig_address = ? AND block_height != ? AND latest = ?",
+				mrq.getTableName(),
+			),
+			false,
+			rotation.GetMultisigAddress(),
+			rotation.GetBlockHeight(),
+			true,
+		},
+		append(
+			[]interface{}{
+				fmt.Sprintf(
+					"INSERT OR REPLACE INTO %s (%s) VALUES(%s)",
+					mrq.getTableName(),
+					strings.Join(mrq.Fields, ","),
+					fmt.Sprintf("? %s", strings.Repeat(", ?", len(mrq.Fields)-1))),
+			},
+			mrq.ExtractModel(rotation)...,
+		),
+	}
+}
+
+// InsertMultiSignatureRotations represents query builder to insert multiple records in single query
+func (mrq *MultiSignatureRotationQuery) InsertMultiSignatureRotations(
+	rotations []*model.MultiSignatureRotation,
+) (str string, args []interface{}) {
+	if len(rotations) > 0 {
+		str = fmt.Sprintf(
+			"INSERT INTO %s (%s) VALUES ",
+			mrq.getTableName(),
+			strings.Join(mrq.Fields, ", "),
+		)
+		for k, rotation := range rotations {
+			str += fmt.Sprintf(
+				"(?%s)",
+				strings.Repeat(", ?", len(mrq.Fields)-1),
+			)
+			if k < len(rotations)-1 {
+				str += ","
+			}
+			args = append(args, mrq.ExtractModel(rotation)...)
+		}
+	}
+	return str, args
+}
+
+// ImportSnapshot takes payload from downloaded snapshot and insert them into database
+func (mrq *MultiSignatureRotationQuery) ImportSnapshot(payload interface{}) ([][]interface{}, error) {
+	var (
+		queries [][]interface{}
+	)
+	rotations, ok := payload.([]*model.MultiSignatureRotation)
+	if !ok {
+		return nil, blocker.NewBlocker(blocker.DBErr, "ImportSnapshotCannotCastTo"+mrq.TableName)
+	}
+	if len(rotations) > 0 {
+		recordsPerPeriod, rounds, remaining := CalculateBulkSize(len(mrq.Fields), len(rotations))
+		for i := 0; i < rounds; i++ {
+			qry, args := mrq.InsertMultiSignatureRotations(rotations[i*recordsPerPeriod : (i*recordsPerPeriod)+recordsPerPeriod])
+			queries = append(queries, append([]interface{}{qry}, args...))
+		}
+		if remaining > 0 {
+			qry, args := mrq.InsertMultiSignatureRotations(rotations[len(rotations)-remaining:])
+			queries = append(queries, append([]interface{}{qry}, args...))
+		}
+	}
+	return queries, nil
+}
+
+// RecalibrateVersionedTable recalibrate table to clean up multiple latest rows due to import function
+func (mrq *MultiSignatureRotationQuery) RecalibrateVersionedTable() []string {
+	return []string{
+		fmt.Sprintf(
+			"update %s set latest = false where latest = true AND (multisig_address, block_height) NOT IN "+
+				"(select t2.multisig_address, max(t2.block_height) from %s t2 group by t2.multisig_address)",
+			mrq.getTableName(), mrq.getTableName()),
+		fmt.Sprintf(
+			"update %s set latest = true where latest = false AND (multisig_address, block_height) IN "+
+				"(select t2.multisig_address, max(t2.block_height) from %s t2 group by t2.multisig_address)",
+			mrq.getTableName(), mrq.getTableName()),
+	}
+}
+
+// GetLatestMultiSignatureRotationByMultisigAddress fetches the last rotation of the multisig address
+func (mrq *MultiSignatureRotationQuery) GetLatestMultiSignatureRotationByMultisigAddress(
+	multisigAddress []byte,
+) (str string, args []interface{}) {
+	return fmt.Sprintf(
+			"SELECT %s FROM %s WHERE multisig_address = ? AND latest = ?",
+			strings.Join(mrq.Fields, ", "),
+			mrq.getTableName(),
+		),
+		[]interface{}{multisigAddress, true}
+}
+
+// GetMultiSignatureRotationsByMultisigAddress fetches the rotation history of the multisig address, oldest first
+func (mrq *MultiSignatureRotationQuery) GetMultiSignatureRotationsByMultisigAddress(
+	multisigAddress []byte,
+) (str string, args []interface{}) {
+	return fmt.Sprintf(
+			"SELECT %s FROM %s WHERE multisig_address = ? ORDER BY block_height",
+			strings.Join(mrq.Fields, ", "),
+			mrq.getTableName(),
+		),
+		[]interface{}{multisigAddress}
+}
+
+// ExtractModel will extract values of MultiSignatureRotation as []interface{}
+func (mrq *MultiSignatureRotationQuery) ExtractModel(rotation *model.MultiSignatureRotation) []interface{} {
+	var weights = make([]byte, 4*len(rotation.GetWeights()))
+	for i, weight := range rotation.GetWeights() {
+		binary.LittleEndian.PutUint32(weights[4*i:], weight)
+	}
+	return []interface{}{
+		rotation.GetID(),
+		rotation.GetMultisigAddress(),
+		rotation.GetMinimumSignatures(),
+		bytes.Join(rotation.GetAddresses(), nil),
+		weights,
+		rotation.GetBlockHeight(),
+		rotation.GetLatest(),
+	}
+}
+
+// BuildModels extract sqlRaw into []*model.MultiSignatureRotation
+func (mrq *MultiSignatureRotationQuery) BuildModels(rows *sql.Rows) ([]*model.MultiSignatureRotation, error) {
+	var (
+		rotations []*model.MultiSignatureRotation
+		err       error
+	)
+
+	for rows.Next() {
+		var (
+			rotation           model.MultiSignatureRotation
+			addresses, weights []byte
+		)
+		err = rows.Scan(
+			&rotation.ID,
+			&rotation.MultisigAddress,
+			&rotation.MinimumSignatures,
+			&addresses,
+			&weights,
+			&rotation.BlockHeight,
+			&rotation.Latest,
+		)
+		if err != nil {
+			return nil, err
+		}
+		err = mrq.parseRotationParticipants(&rotation, addresses, weights)
+		if err != nil {
+			return nil, err
+		}
+		rotations = append(rotations, &rotation)
+	}
+	return rotations, nil
+}
+
+// Scan extract sqlRaw *sql.Row into model.MultiSignatureRotation
+func (mrq *MultiSignatureRotationQuery) Scan(rotation *model.MultiSignatureRotation, row *sql.Row) error {
+	var (
+		addresses, weights []byte
+	)
+	err := row.Scan(
+		&rotation.ID,
+		&rotation.MultisigAddress,
+		&rotation.MinimumSignatures,
+		&addresses,
+		&weights,
+		&rotation.BlockHeight,
+		&rotation.Latest,
+	)
+	if err != nil {
+		return err
+	}
+	return mrq.parseRotationParticipants(rotation, addresses, weights)
+}
+
+// parseRotationParticipants split the concatenated addresses and weights stored in the rotation columns
+func (*MultiSignatureRotationQuery) parseRotationParticipants(rotation *model.MultiSignatureRotation, addresses, weights []byte) error {
+	var err error
+	rotation.Addresses, err = accounttype.ParseBytesToAccountAddresses(addresses)
+	if err != nil {
+		return err
+	}
+	if len(weights)%4 != 0 {
+		return blocker.NewBlocker(blocker.DBErr, "InvalidMultiSignatureRotationWeights")
+	}
+	rotation.Weights = nil
+	for i := 0; i < len(weights); i += 4 {
+		rotation.Weights = append(rotation.Weights, binary.LittleEndian.Uint32(weights[i:i+4]))
+	}
+	return nil
+}
+
+// Rollback delete records `WHERE height > "height"
+func (mrq *MultiSignatureRotationQuery) Rollback(height uint32) (multiQueries [][]interface{}) {
+	return [][]interface{}{
+		{
+			fmt.Sprintf("DELETE FROM %s WHERE block_height > ?", mrq.getTableName()),
+			height,
+		},
+		{
+			fmt.Sprintf(`
+			UPDATE %s SET latest = ?
+			WHERE latest = ? AND (multisig_address, block_height) IN (
+				SELECT t2.multisig_address, MAX(t2.block_height)
+				FROM %s as t2
+				GROUP BY t2.multisig_address
+			)`,
+				mrq.getTableName(),
+				mrq.getTableName(),
+			),
+			1,
+			0,
+		},
+	}
+}
+
+// SelectDataForSnapshot select every rotation written between fromHeight and toHeight, keeping the history of the
+// multisig accounts
+func (mrq *MultiSignatureRotationQuery) SelectDataForSnapshot(fromHeight, toHeight uint32) string {
+	return fmt.Sprintf(
+		"SELECT %s FROM %s WHERE block_height >= %d AND block_height <= %d AND block_height != 0 ORDER BY block_height",
+		strings.Join(mrq.Fields, ","),
+		mrq.getTableName(),
+		fromHeight,
+		toHeight,
+	)
+}
+
+// TrimDataBeforeSnapshot delete entries to assure there are no duplicates before applying a snapshot
+func (mrq *MultiSignatureRotationQuery) TrimDataBeforeSnapshot(fromHeight, toHeight uint32) string {
+	return fmt.Sprintf(`DELETE FROM %s WHERE block_height >= %d AND block_height <= %d AND block_height != 0`,
+		mrq.getTableName(), fromHeight, toHeight)
+}
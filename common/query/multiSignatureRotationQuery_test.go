@@ -0,0 +1,319 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package query
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+var (
+	mockMultiSignatureRotation = &model.MultiSignatureRotation{
+		ID:                1,
+		MultisigAddress:   liquidPayTxAddress1,
+		MinimumSignatures: 3,
+		Addresses:         [][]byte{liquidPayTxAddress1, liquidPayTxAddress2},
+		Weights:           []uint32{2, 1},
+		BlockHeight:       24,
+		Latest:            true,
+	}
+	mockMultiSignatureRotationFields = "id, multisig_address, minimum_signatures, addresses, weights, block_height, latest"
+)
+
+func TestMultiSignatureRotationQuery_InsertMultiSignatureRotation(t *testing.T) {
+	mrq := NewMultiSignatureRotationQuery()
+	want := [][]interface{}{
+		{
+			"UPDATE multisignature_rotation SET latest = ? WHERE multisig_address = ? AND block_height != ? AND latest = ?",
+			false,
+			mockMultiSignatureRotation.GetMultisigAddress(),
+			mockMultiSignatureRotation.GetBlockHeight(),
+			true,
+		},
+		append(
+			[]interface{}{
+				"INSERT OR REPLACE INTO multisignature_rotation (id,multisig_address,minimum_signatures,addresses,weights," +
+					"block_height,latest) VALUES(? , ?, ?, ?, ?, ?, ?)",
+			},
+			mrq.ExtractModel(mockMultiSignatureRotation)...,
+		),
+	}
+	if got := mrq.InsertMultiSignatureRotation(mockMultiSignatureRotation); !reflect.DeepEqual(got, want) {
+		t.Errorf("MultiSignatureRotationQuery.InsertMultiSignatureRotation() = %v, want %v", got, want)
+	}
+}
+
+func TestMultiSignatureRotationQuery_InsertMultiSignatureRotations(t *testing.T) {
+	type args struct {
+		rotations []*model.MultiSignatureRotation
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantStr  string
+		wantArgs []interface{}
+	}{
+		{
+			name:    "wantEmpty",
+			args:    args{},
+			wantStr: "",
+		},
+		{
+			name: "wantSuccess",
+			args: args{
+				rotations: []*model.MultiSignatureRotation{mockMultiSignatureRotation, mockMultiSignatureRotation},
+			},
+			wantStr: "INSERT INTO multisignature_rotation (" + mockMultiSignatureRotationFields + ") VALUES " +
+				"(?, ?, ?, ?, ?, ?, ?),(?, ?, ?, ?, ?, ?, ?)",
+			wantArgs: append(
+				NewMultiSignatureRotationQuery().ExtractModel(mockMultiSignatureRotation),
+				NewMultiSignatureRotationQuery().ExtractModel(mockMultiSignatureRotation)...,
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mrq := NewMultiSignatureRotationQuery()
+			gotStr, gotArgs := mrq.InsertMultiSignatureRotations(tt.args.rotations)
+			if gotStr != tt.wantStr {
+				t.Errorf("MultiSignatureRotationQuery.InsertMultiSignatureRotations() gotStr = %v, want %v", gotStr, tt.wantStr)
+			}
+			if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("MultiSignatureRotationQuery.InsertMultiSignatureRotations() gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestMultiSignatureRotationQuery_ImportSnapshot(t *testing.T) {
+	tests := []struct {
+		name        string
+		payload     interface{}
+		wantQueries int
+		wantErr     bool
+	}{
+		{
+			name:    "wantFail:WrongPayload",
+			payload: []*model.Htlc{},
+			wantErr: true,
+		},
+		{
+			name:        "wantSuccess",
+			payload:     []*model.MultiSignatureRotation{mockMultiSignatureRotation},
+			wantQueries: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mrq := NewMultiSignatureRotationQuery()
+			got, err := mrq.ImportSnapshot(tt.payload)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MultiSignatureRotationQuery.ImportSnapshot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != tt.wantQueries {
+				t.Errorf("MultiSignatureRotationQuery.ImportSnapshot() got %d queries, want %d", len(got), tt.wantQueries)
+			}
+		})
+	}
+}
+
+func TestMultiSignatureRotationQuery_GetLatestMultiSignatureRotationByMultisigAddress(t *testing.T) {
+	mrq := NewMultiSignatureRotationQuery()
+	gotStr, gotArgs := mrq.GetLatestMultiSignatureRotationByMultisigAddress(liquidPayTxAddress1)
+	wantStr := "SELECT " + mockMultiSignatureRotationFields + " FROM multisignature_rotation WHERE multisig_address = ? AND latest = ?"
+	if gotStr != wantStr {
+		t.Errorf("MultiSignatureRotationQuery.GetLatestMultiSignatureRotationByMultisigAddress() gotStr = %v, want %v", gotStr, wantStr)
+	}
+	if !reflect.DeepEqual(gotArgs, []interface{}{liquidPayTxAddress1, true}) {
+		t.Errorf("MultiSignatureRotationQuery.GetLatestMultiSignatureRotationByMultisigAddress() gotArgs = %v", gotArgs)
+	}
+}
+
+func TestMultiSignatureRotationQuery_GetMultiSignatureRotationsByMultisigAddress(t *testing.T) {
+	mrq := NewMultiSignatureRotationQuery()
+	gotStr, gotArgs := mrq.GetMultiSignatureRotationsByMultisigAddress(liquidPayTxAddress1)
+	wantStr := "SELECT " + mockMultiSignatureRotationFields + " FROM multisignature_rotation WHERE multisig_address = ? ORDER BY block_height"
+	if gotStr != wantStr {
+		t.Errorf("MultiSignatureRotationQuery.GetMultiSignatureRotationsByMultisigAddress() gotStr = %v, want %v", gotStr, wantStr)
+	}
+	if !reflect.DeepEqual(gotArgs, []interface{}{liquidPayTxAddress1}) {
+		t.Errorf("MultiSignatureRotationQuery.GetMultiSignatureRotationsByMultisigAddress() gotArgs = %v", gotArgs)
+	}
+}
+
+func TestMultiSignatureRotationQuery_ExtractModel(t *testing.T) {
+	mrq := NewMultiSignatureRotationQuery()
+	got := mrq.ExtractModel(mockMultiSignatureRotation)
+	wantWeights := []byte{2, 0, 0, 0, 1, 0, 0, 0}
+	if !reflect.DeepEqual(got[4], wantWeights) {
+		t.Errorf("MultiSignatureRotationQuery.ExtractModel() weights = %v, want %v", got[4], wantWeights)
+	}
+	if !reflect.DeepEqual(got[3], append(append([]byte{}, liquidPayTxAddress1...), liquidPayTxAddress2...)) {
+		t.Errorf("MultiSignatureRotationQuery.ExtractModel() addresses = %v", got[3])
+	}
+}
+
+func TestMultiSignatureRotationQuery_BuildModels(t *testing.T) {
+	mrq := NewMultiSignatureRotationQuery()
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	mockRow := sqlmock.NewRows(mrq.Fields)
+	mockRow.AddRow(
+		mockMultiSignatureRotation.GetID(),
+		mockMultiSignatureRotation.GetMultisigAddress(),
+		mockMultiSignatureRotation.GetMinimumSignatures(),
+		bytes.Join(mockMultiSignatureRotation.GetAddresses(), nil),
+		[]byte{2, 0, 0, 0, 1, 0, 0, 0},
+		mockMultiSignatureRotation.GetBlockHeight(),
+		mockMultiSignatureRotation.GetLatest(),
+	)
+	mock.ExpectQuery("").WillReturnRows(mockRow)
+	rows, _ := db.Query("")
+	got, err := mrq.BuildModels(rows)
+	if err != nil {
+		t.Errorf("MultiSignatureRotationQuery.BuildModels() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, []*model.MultiSignatureRotation{mockMultiSignatureRotation}) {
+		t.Errorf("MultiSignatureRotationQuery.BuildModels() = %v, want %v", got, mockMultiSignatureRotation)
+	}
+}
+
+func TestMultiSignatureRotationQuery_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights []byte
+		want    *model.MultiSignatureRotation
+		wantErr bool
+	}{
+		{
+			name:    "wantFail:TruncatedWeights",
+			weights: []byte{2, 0, 0},
+			wantErr: true,
+		},
+		{
+			name:    "wantSuccess",
+			weights: []byte{2, 0, 0, 0, 1, 0, 0, 0},
+			want:    mockMultiSignatureRotation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rotation model.MultiSignatureRotation
+			mrq := NewMultiSignatureRotationQuery()
+			db, mock, _ := sqlmock.New()
+			defer db.Close()
+			mock.ExpectQuery("").WillReturnRows(sqlmock.NewRows(mrq.Fields).AddRow(
+				mockMultiSignatureRotation.GetID(),
+				mockMultiSignatureRotation.GetMultisigAddress(),
+				mockMultiSignatureRotation.GetMinimumSignatures(),
+				bytes.Join(mockMultiSignatureRotation.GetAddresses(), nil),
+				tt.weights,
+				mockMultiSignatureRotation.GetBlockHeight(),
+				mockMultiSignatureRotation.GetLatest(),
+			))
+			err := mrq.Scan(&rotation, db.QueryRow(""))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MultiSignatureRotationQuery.Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(&rotation, tt.want) {
+				t.Errorf("MultiSignatureRotationQuery.Scan() = %v, want %v", &rotation, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiSignatureRotationQuery_Rollback(t *testing.T) {
+	mrq := NewMultiSignatureRotationQuery()
+	want := [][]interface{}{
+		{
+			"DELETE FROM multisignature_rotation WHERE block_height > ?",
+			uint32(30),
+		},
+		{
+			`
+			UPDATE multisignature_rotation SET latest = ?
+			WHERE latest = ? AND (multisig_address, block_height) IN (
+				SELECT t2.multisig_address, MAX(t2.block_height)
+				FROM multisignature_rotation as t2
+				GROUP BY t2.multisig_address
+			)`,
+			1,
+			0,
+		},
+	}
+	if got := mrq.Rollback(30); !reflect.DeepEqual(got, want) {
+		t.Errorf("MultiSignatureRotationQuery.Rollback() = %v, want %v", got, want)
+	}
+}
+
+func TestMultiSignatureRotationQuery_SelectDataForSnapshot(t *testing.T) {
+	mrq := NewMultiSignatureRotationQuery()
+	want := fmt.Sprintf("SELECT id,multisig_address,minimum_signatures,addresses,weights,block_height,latest "+
+		"FROM multisignature_rotation WHERE block_height >= %d AND block_height <= %d AND block_height != 0 ORDER BY block_height", 0, 10)
+	if got := mrq.SelectDataForSnapshot(0, 10); got != want {
+		t.Errorf("MultiSignatureRotationQuery.SelectDataForSnapshot() = %v, want %v", got, want)
+	}
+}
+
+func TestMultiSignatureRotationQuery_TrimDataBeforeSnapshot(t *testing.T) {
+	mrq := NewMultiSignatureRotationQuery()
+	want := "DELETE FROM multisignature_rotation WHERE block_height >= 0 AND block_height <= 10 AND block_height != 0"
+	if got := mrq.TrimDataBeforeSnapshot(0, 10); got != want {
+		t.Errorf("MultiSignatureRotationQuery.TrimDataBeforeSnapshot() = %v, want %v", got, want)
+	}
+}
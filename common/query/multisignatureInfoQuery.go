@@ -55,6 +55,7 @@ import (
 	"strings"
 
 	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
 	"github.com/zoobc/zoobc-core/common/model"
 )
 
@@ -113,7 +114,7 @@ func (msi *MultisignatureInfoQuery) GetMultisignatureInfoByAddressWithParticipan
 		t1Fields = append(t1Fields, fmt.Sprintf("t1.%s", msiField))
 	}
 	queryMultisigInfo := fmt.Sprintf(
-		"SELECT %s, t2.account_address FROM %s t1 LEFT JOIN %s t2 ON t1.multisig_address = t2.multisig_address "+
+		"SELECT %s, t2.account_address, t2.weight FROM %s t1 LEFT JOIN %s t2 ON t1.multisig_address = t2.multisig_address "+
 			"WHERE t1.multisig_address = ? AND t1.block_height >= ? AND t1.latest = true AND t2.latest = true "+
 			"ORDER BY t2.account_address_index DESC",
 		strings.Join(t1Fields, ", "),
@@ -179,6 +180,11 @@ func (msi *MultisignatureInfoQuery) InsertMultiSignatureInfos(multiSignatureInfo
 			musigInfoArgs = append(musigInfoArgs, msi.ExtractModel(musig)...)
 
 			for a, address := range musig.GetAddresses() {
+				// participants of a non weighted multisig are stored without weight
+				var weight = constant.MultiSigParticipantNotWeighted
+				if len(musig.GetWeights()) > 0 {
+					weight = musig.GetWeights()[a]
+				}
 				participantQ += fmt.Sprintf("(?%s)", strings.Repeat(", ?", len(participantQueryInterface.Fields)-1))
 
 				if !(a == len(musig.GetAddresses())-1 && m == len(multiSignatureInfos)-1) {
@@ -190,6 +196,7 @@ func (msi *MultisignatureInfoQuery) InsertMultiSignatureInfos(multiSignatureInfo
 					AccountAddressIndex:   uint32(a),
 					BlockHeight:           musig.GetBlockHeight(),
 					Latest:                musig.GetLatest(),
+					Weight:                weight,
 				})...)
 			}
 		}
@@ -286,7 +293,7 @@ func (msi *MultisignatureInfoQuery) BuildModel(
 	return mss, nil
 }
 
-// BuildModelWithParticipant will build model from *sql.Rows that expect has addresses column
+// BuildModelWithParticipant will build model from *sql.Rows that expect has addresses and weight columns
 // which is result from sub query of multisignature_participant
 func (msi *MultisignatureInfoQuery) BuildModelWithParticipant(
 	mss []*model.MultiSignatureInfo, rows *sql.Rows,
@@ -295,6 +302,7 @@ func (msi *MultisignatureInfoQuery) BuildModelWithParticipant(
 		var (
 			multisigInfo       model.MultiSignatureInfo
 			participantAddress []byte
+			participantWeight  uint32
 		)
 		err := rows.Scan(
 			&multisigInfo.MultisigAddress,
@@ -303,11 +311,16 @@ func (msi *MultisignatureInfoQuery) BuildModelWithParticipant(
 			&multisigInfo.BlockHeight,
 			&multisigInfo.Latest,
 			&participantAddress,
+			&participantWeight,
 		)
-		multisigInfo.Addresses = [][]byte{participantAddress}
 		if err != nil {
 			return nil, err
 		}
+		multisigInfo.Addresses = [][]byte{participantAddress}
+		// only multisig registered with weights have weights, keeping the address and body bytes of the other ones
+		if participantWeight != constant.MultiSigParticipantNotWeighted {
+			multisigInfo.Weights = []uint32{participantWeight}
+		}
 		mss = append(mss, &multisigInfo)
 	}
 	return mss, nil
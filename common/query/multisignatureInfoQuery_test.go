@@ -79,7 +79,7 @@ func getBuildModelErrorMockRows() *sql.Rows {
 func getBuildModelSuccessMockRows(withParticipant bool) *sql.Rows {
 	db, mock, _ := sqlmock.New()
 	if withParticipant {
-		mockRow := sqlmock.NewRows(append(mockMultisigInfoQueryInstance.Fields, "multisig_address"))
+		mockRow := sqlmock.NewRows(append(mockMultisigInfoQueryInstance.Fields, "multisig_address", "weight"))
 		mockRow.AddRow(
 			multisigAccountAddress1,
 			uint32(1),
@@ -87,6 +87,7 @@ func getBuildModelSuccessMockRows(withParticipant bool) *sql.Rows {
 			uint32(12),
 			true,
 			multisigAccountAddress2,
+			uint32(2),
 		)
 		mock.ExpectQuery("").WillReturnRows(mockRow)
 		rows, _ := db.Query("")
@@ -105,6 +106,23 @@ func getBuildModelSuccessMockRows(withParticipant bool) *sql.Rows {
 	return rows
 }
 
+func getBuildModelNotWeightedMockRows() *sql.Rows {
+	db, mock, _ := sqlmock.New()
+	mockRow := sqlmock.NewRows(append(mockMultisigInfoQueryInstance.Fields, "multisig_address", "weight"))
+	mockRow.AddRow(
+		multisigAccountAddress1,
+		uint32(1),
+		int64(10),
+		uint32(12),
+		true,
+		multisigAccountAddress2,
+		constant.MultiSigParticipantNotWeighted,
+	)
+	mock.ExpectQuery("").WillReturnRows(mockRow)
+	rows, _ := db.Query("")
+	return rows
+}
+
 func TestMultisignatureInfoQuery_BuildModelWithParticipant(t *testing.T) {
 	type fields struct {
 		Fields    []string
@@ -144,6 +162,31 @@ func TestMultisignatureInfoQuery_BuildModelWithParticipant(t *testing.T) {
 				mss:  []*model.MultiSignatureInfo{},
 				rows: getBuildModelSuccessMockRows(true),
 			},
+			want: []*model.MultiSignatureInfo{
+				{
+					MultisigAddress:   multisigAccountAddress1,
+					MinimumSignatures: 1,
+					Nonce:             10,
+					BlockHeight:       12,
+					Latest:            true,
+					Addresses: [][]byte{
+						multisigAccountAddress2,
+					},
+					Weights: []uint32{2},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "BuildModel:NotWeighted",
+			fields: fields{
+				Fields:    mockMultisigInfoQueryInstance.Fields,
+				TableName: mockMultisigInfoQueryInstance.TableName,
+			},
+			args: args{
+				mss:  []*model.MultiSignatureInfo{},
+				rows: getBuildModelNotWeightedMockRows(),
+			},
 			want: []*model.MultiSignatureInfo{
 				{
 					MultisigAddress:   multisigAccountAddress1,
@@ -340,7 +383,7 @@ func TestMultisignatureInfoQuery_GetMultisignatureInfoByAddressWithParticipants(
 				currentHeight:   0,
 				limit:           constant.MinRollbackBlocks,
 			},
-			wantStr: "SELECT t1.multisig_address, t1.minimum_signatures, t1.nonce, t1.block_height, t1.latest, t2.account_address " +
+			wantStr: "SELECT t1.multisig_address, t1.minimum_signatures, t1.nonce, t1.block_height, t1.latest, t2.account_address, t2.weight " +
 				"FROM multisignature_info t1 LEFT JOIN multisignature_participant t2 ON t1.multisig_address = t2.multisig_address " +
 				"WHERE t1.multisig_address = ? AND t1.block_height >= ? AND t1.latest = true AND t2.latest = true " +
 				"ORDER BY t2.account_address_index DESC",
@@ -383,6 +426,16 @@ var (
 		BlockHeight:     0,
 		Latest:          true,
 	}
+	mockInsertMultisignatureInfoWeighted = &model.MultiSignatureInfo{
+		MinimumSignatures: 4,
+		Addresses: [][]byte{
+			multisigAccountAddress2,
+			multisigAccountAddress3,
+		},
+		Weights:         []uint32{3, 1},
+		MultisigAddress: multisigAccountAddress1,
+		Latest:          true,
+	}
 	// InsertMultisignatureInfo mocks
 )
 
@@ -747,11 +800,33 @@ func TestMultisignatureInfoQuery_InsertMultiSignatureInfos(t *testing.T) {
 					musigQ.ExtractModel(mockInsertMultisignatureInfoMultisig)...,
 				),
 				{
-					"INSERT INTO multisignature_participant (multisig_address, account_address, account_address_index, latest, block_height) " +
-						"VALUES(?, ?, ?, ?, ?),(?, ?, ?, ?, ?),(?, ?, ?, ?, ?)",
-					multisigAccountAddress1, multisigAccountAddress2, uint32(0), true, uint32(0), multisigAccountAddress1,
-					multisigAccountAddress3, uint32(1), true, uint32(0), multisigAccountAddress1, multisigAccountAddress3, uint32(2), true,
-					uint32(0),
+					"INSERT INTO multisignature_participant (multisig_address, account_address, account_address_index, latest, block_height, " +
+						"weight) VALUES(?, ?, ?, ?, ?, ?),(?, ?, ?, ?, ?, ?),(?, ?, ?, ?, ?, ?)",
+					multisigAccountAddress1, multisigAccountAddress2, uint32(0), true, uint32(0), uint32(0), multisigAccountAddress1,
+					multisigAccountAddress3, uint32(1), true, uint32(0), uint32(0), multisigAccountAddress1, multisigAccountAddress3, uint32(2),
+					true, uint32(0), uint32(0),
+				},
+			},
+		},
+		{
+			name:   "WantSuccess:Weighted",
+			fields: fields(*NewMultisignatureInfoQuery()),
+			args: args{
+				multiSignatureInfos: []*model.MultiSignatureInfo{
+					mockInsertMultisignatureInfoWeighted,
+				},
+			},
+			want: [][]interface{}{
+				append([]interface{}{
+					"INSERT INTO multisignature_info (multisig_address, minimum_signatures, nonce, block_height, latest) VALUES (?, ?, ?, ?, ?)",
+				},
+					musigQ.ExtractModel(mockInsertMultisignatureInfoWeighted)...,
+				),
+				{
+					"INSERT INTO multisignature_participant (multisig_address, account_address, account_address_index, latest, block_height, " +
+						"weight) VALUES(?, ?, ?, ?, ?, ?),(?, ?, ?, ?, ?, ?)",
+					multisigAccountAddress1, multisigAccountAddress2, uint32(0), true, uint32(0), uint32(3), multisigAccountAddress1,
+					multisigAccountAddress3, uint32(1), true, uint32(0), uint32(1),
 				},
 			},
 		},
@@ -91,6 +91,13 @@ func NewPendingSignatureQuery() *PendingSignatureQuery {
 	}
 }
 
+// NewDetachedPendingSignatureQuery returns PendingSignatureQuery instance over the signatures the node collects off chain
+func NewDetachedPendingSignatureQuery() *PendingSignatureQuery {
+	pendingSignatureQuery := NewPendingSignatureQuery()
+	pendingSignatureQuery.TableName = "detached_pending_signature"
+	return pendingSignatureQuery
+}
+
 func (psq *PendingSignatureQuery) getTableName() string {
 	return psq.TableName
 }
@@ -93,6 +93,34 @@ func TestNewPendingSignatureQuery(t *testing.T) {
 	}
 }
 
+func TestNewDetachedPendingSignatureQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		want *PendingSignatureQuery
+	}{
+		{
+			name: "NewDetachedPendingSignatureQuery-Success",
+			want: &PendingSignatureQuery{
+				Fields: []string{
+					"transaction_hash",
+					"account_address",
+					"signature",
+					"block_height",
+					"latest",
+				},
+				TableName: "detached_pending_signature",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewDetachedPendingSignatureQuery(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewDetachedPendingSignatureQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 // mock build model rows getter
 func getPendingSignatureQueryBuildModelRowsFail() *sql.Rows {
 	db, mock, _ := sqlmock.New()
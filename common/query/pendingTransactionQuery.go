@@ -103,6 +103,14 @@ func NewPendingTransactionQuery() *PendingTransactionQuery {
 	}
 }
 
+// NewDetachedPendingTransactionQuery returns PendingTransactionQuery instance over the transactions the node collects
+// signatures for off chain, kept apart from the pending transactions of the chain state
+func NewDetachedPendingTransactionQuery() *PendingTransactionQuery {
+	pendingTransactionQuery := NewPendingTransactionQuery()
+	pendingTransactionQuery.TableName = "detached_pending_transaction"
+	return pendingTransactionQuery
+}
+
 func (ptq *PendingTransactionQuery) getTableName() string {
 	return ptq.TableName
 }
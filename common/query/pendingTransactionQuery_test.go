@@ -92,6 +92,35 @@ func TestNewPendingTransactionQuery(t *testing.T) {
 	}
 }
 
+func TestNewDetachedPendingTransactionQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		want *PendingTransactionQuery
+	}{
+		{
+			name: "NewDetachedPendingTransactionQuery-Success",
+			want: &PendingTransactionQuery{
+				Fields: []string{
+					"sender_address",
+					"transaction_hash",
+					"transaction_bytes",
+					"status",
+					"block_height",
+					"latest",
+				},
+				TableName: "detached_pending_transaction",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewDetachedPendingTransactionQuery(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewDetachedPendingTransactionQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 // mock PendingTransactionQueryBuildModel
 func getPendingTransactionQueryBuildModelFailRow() *sql.Rows {
 	db, mock, _ := sqlmock.New()
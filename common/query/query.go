@@ -102,6 +102,10 @@ func GetDerivedQuery(ct chaintype.ChainType) (derivedQuery []DerivedQuery) {
 			NewMultiSignatureParticipantQuery(),
 			NewBatchReceiptQuery(),
 			NewMerkleTreeQuery(),
+			NewLockedFundQuery(),
+			NewHtlcQuery(),
+			NewMultiSignatureRotationQuery(),
+			NewAccountDatasetSchemaQuery(),
 		}
 		derivedQuery = append(derivedQuery, mainchainDerivedQuery...)
 	case *chaintype.SpineChain:
@@ -135,6 +139,10 @@ func GetSnapshotQuery(ct chaintype.ChainType) (snapshotQuery map[string]Snapshot
 			"feeVoteReveal":            NewFeeVoteRevealVoteQuery(),
 			"liquidPaymentTransaction": NewLiquidPaymentTransactionQuery(),
 			"nodeAdmissionTimestamp":   NewNodeAdmissionTimestampQuery(),
+			"lockedFund":               NewLockedFundQuery(),
+			"htlc":                     NewHtlcQuery(),
+			"multisignatureRotation":   NewMultiSignatureRotationQuery(),
+			"accountDatasetSchema":     NewAccountDatasetSchemaQuery(),
 		}
 	default:
 		snapshotQuery = map[string]SnapshotQuery{}
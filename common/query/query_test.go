@@ -92,6 +92,10 @@ func TestGetDerivedQuery(t *testing.T) {
 				NewMultiSignatureParticipantQuery(),
 				NewBatchReceiptQuery(),
 				NewMerkleTreeQuery(),
+				NewLockedFundQuery(),
+				NewHtlcQuery(),
+				NewMultiSignatureRotationQuery(),
+				NewAccountDatasetSchemaQuery(),
 			},
 		},
 		{
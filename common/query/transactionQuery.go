@@ -64,6 +64,8 @@ type (
 		GetTransaction(id int64) string
 		GetTransactionsByIds(txIds []int64) (str string, args []interface{})
 		GetTransactionsByBlockID(blockID int64) (str string, args []interface{})
+		GetTransactionsFromBlockHeight(fromHeight uint32) (str string, args []interface{})
+		GetReplacementTransaction(replacedTransactionID int64, senderAccountAddress []byte) (str string, args []interface{})
 		ExtractModel(tx *model.Transaction) []interface{}
 		BuildModel(txs []*model.Transaction, rows *sql.Rows) ([]*model.Transaction, error)
 		Scan(tx *model.Transaction, row *sql.Row) error
@@ -96,6 +98,7 @@ func NewTransactionQuery(chaintype chaintype.ChainType) *TransactionQuery {
 			"transaction_index",
 			"multisig_child",
 			"message",
+			"replaced_transaction_id",
 		},
 		TableName: "\"transaction\"",
 		ChainType: chaintype,
@@ -131,6 +134,13 @@ func (tq *TransactionQuery) GetTransactionsByBlockID(blockID int64) (str string,
 	return query, []interface{}{blockID}
 }
 
+// GetTransactionsFromBlockHeight get the transactions included by the blocks from the given height
+func (tq *TransactionQuery) GetTransactionsFromBlockHeight(fromHeight uint32) (str string, args []interface{}) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE block_height >= ? AND multisig_child = false "+
+		"ORDER BY block_height, transaction_index ASC", strings.Join(tq.Fields, ", "), tq.getTableName())
+	return query, []interface{}{fromHeight}
+}
+
 func (tq *TransactionQuery) GetTransactionsByIds(txIds []int64) (str string, args []interface{}) {
 
 	for _, id := range txIds {
@@ -145,6 +155,17 @@ func (tq *TransactionQuery) GetTransactionsByIds(txIds []int64) (str string, arg
 		args
 }
 
+// GetReplacementTransaction get the confirmed transaction of the same sender replacing the given pending transaction, a
+// transaction and its replacement conflict so only one of them can be confirmed
+func (tq *TransactionQuery) GetReplacementTransaction(
+	replacedTransactionID int64,
+	senderAccountAddress []byte,
+) (str string, args []interface{}) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE replaced_transaction_id = ? AND sender_account_address = ? LIMIT 1",
+		strings.Join(tq.Fields, ", "), tq.getTableName())
+	return query, []interface{}{replacedTransactionID, senderAccountAddress}
+}
+
 // ExtractModel extract the model struct fields to the order of TransactionQuery.Fields
 func (*TransactionQuery) ExtractModel(tx *model.Transaction) []interface{} {
 	return []interface{}{
@@ -164,6 +185,7 @@ func (*TransactionQuery) ExtractModel(tx *model.Transaction) []interface{} {
 		&tx.TransactionIndex,
 		&tx.MultisigChild,
 		&tx.Message,
+		&tx.ReplacedTransactionID,
 	}
 }
 
@@ -190,6 +212,7 @@ func (*TransactionQuery) BuildModel(txs []*model.Transaction, rows *sql.Rows) ([
 			&tx.TransactionIndex,
 			&tx.MultisigChild,
 			&tx.Message,
+			&tx.ReplacedTransactionID,
 		)
 		if err != nil {
 			return nil, err
@@ -217,6 +240,7 @@ func (*TransactionQuery) Scan(tx *model.Transaction, row *sql.Row) error {
 		&tx.TransactionIndex,
 		&tx.MultisigChild,
 		&tx.Message,
+		&tx.ReplacedTransactionID,
 	)
 	return err
 }
@@ -102,6 +102,7 @@ var (
 		1,
 		1,
 		"",
+		0,
 	}
 )
 var _ = mockTransactionRow
@@ -126,7 +127,7 @@ func TestGetTransaction(t *testing.T) {
 			want: "SELECT id, block_id, block_height, sender_account_address, " +
 				"recipient_account_address, transaction_type, fee, timestamp, " +
 				"transaction_hash, transaction_body_length, transaction_body_bytes, signature, version, " +
-				"transaction_index, multisig_child, message from \"transaction\"" +
+				"transaction_index, multisig_child, message, replaced_transaction_id from \"transaction\"" +
 				" WHERE id = 1",
 		},
 	}
@@ -275,6 +276,20 @@ func TestTransactionQuery_GetTransactionsByBlockID(t *testing.T) {
 	}
 }
 
+func TestTransactionQuery_GetTransactionsFromBlockHeight(t *testing.T) {
+	gotStr, gotArgs := mockTransactionQuery.GetTransactionsFromBlockHeight(10)
+	wantStr := fmt.Sprintf("SELECT %s FROM \"transaction\" WHERE block_height >= ? AND multisig_child = false"+
+		" ORDER BY block_height, transaction_index ASC",
+		strings.Join(mockTransactionQuery.Fields, ", "),
+	)
+	if gotStr != wantStr {
+		t.Errorf("GetTransactionsFromBlockHeight() gotStr = %v, want %v", gotStr, wantStr)
+	}
+	if !reflect.DeepEqual(gotArgs, []interface{}{uint32(10)}) {
+		t.Errorf("GetTransactionsFromBlockHeight() gotArgs = %v, want %v", gotArgs, []interface{}{uint32(10)})
+	}
+}
+
 func TestTransactionQuery_GetTransactionsByIds(t *testing.T) {
 
 	type fields struct {
@@ -298,7 +313,7 @@ func TestTransactionQuery_GetTransactionsByIds(t *testing.T) {
 			args:   args{txIds: []int64{1, 2, 3, 4}},
 			wantStr: "SELECT id, block_id, block_height, sender_account_address, recipient_account_address, transaction_type, fee, timestamp, " +
 				"transaction_hash, transaction_body_length, transaction_body_bytes, signature, version, transaction_index, " +
-				"multisig_child, message FROM \"transaction\" WHERE multisig_child = false AND id IN(?, ?, ?, ?)",
+				"multisig_child, message, replaced_transaction_id FROM \"transaction\" WHERE multisig_child = false AND id IN(?, ?, ?, ?)",
 			wantArgs: []interface{}{
 				int64(1),
 				int64(2),
@@ -325,6 +340,23 @@ func TestTransactionQuery_GetTransactionsByIds(t *testing.T) {
 	}
 }
 
+func TestTransactionQuery_GetReplacementTransaction(t *testing.T) {
+	t.Run("GetReplacementTransaction:success", func(t *testing.T) {
+		gotStr, gotArgs := mockTransactionQuery.GetReplacementTransaction(1, mockTransaction.SenderAccountAddress)
+		wantStr := "SELECT id, block_id, block_height, sender_account_address, recipient_account_address, transaction_type, fee, " +
+			"timestamp, transaction_hash, transaction_body_length, transaction_body_bytes, signature, version, transaction_index, " +
+			"multisig_child, message, replaced_transaction_id FROM \"transaction\" WHERE replaced_transaction_id = ? " +
+			"AND sender_account_address = ? LIMIT 1"
+		if gotStr != wantStr {
+			t.Errorf("GetReplacementTransaction() gotStr = %v, want %v", gotStr, wantStr)
+		}
+		wantArgs := []interface{}{int64(1), mockTransaction.SenderAccountAddress}
+		if !reflect.DeepEqual(gotArgs, wantArgs) {
+			t.Errorf("GetReplacementTransaction() gotArgs = %v, want %v", gotArgs, wantArgs)
+		}
+	})
+}
+
 type (
 	mockQueryExecutorBuildModel struct {
 		Executor
@@ -351,6 +383,7 @@ func (*mockQueryExecutorBuildModel) ExecuteSelect(query string, tx bool, args ..
 			1,
 			false,
 			[]byte{1, 2, 3},
+			0,
 		),
 	)
 	return db.Query("")
@@ -424,6 +457,7 @@ func (*mockRowTransactionQueryScan) ExecuteSelectRow(qStr string, args ...interf
 			1,
 			false,
 			"",
+			0,
 		),
 	)
 	return db.QueryRow("")
@@ -80,22 +80,24 @@ func init() {
 }
 
 var fileDescriptor_8b38d5f230566dd1 = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x4e, 0x2d, 0x2a,
+	// 259 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe3, 0x92, 0x29, 0x4e, 0x2d, 0x2a,
 	0xcb, 0x4c, 0x4e, 0xd5, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x71, 0x4a, 0xcc, 0x49, 0xcc,
 	0x4b, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x87, 0xca, 0x4a, 0x49, 0xe5, 0xe6,
-	0xa7, 0xa4, 0xe6, 0x60, 0x55, 0x24, 0x25, 0x93, 0x9e, 0x9f, 0x9f, 0x9e, 0x93, 0xaa, 0x9f, 0x58,
-	0x90, 0xa9, 0x9f, 0x98, 0x97, 0x97, 0x5f, 0x92, 0x58, 0x92, 0x99, 0x9f, 0x57, 0x0c, 0x91, 0x35,
-	0x9a, 0xc5, 0xc4, 0x25, 0xea, 0x88, 0xa2, 0x2d, 0x18, 0x62, 0xa6, 0x50, 0x23, 0x23, 0x97, 0x90,
-	0x7b, 0x6a, 0x09, 0xaa, 0x64, 0xb1, 0x90, 0x82, 0x1e, 0xd8, 0x2e, 0x3d, 0x4c, 0xa9, 0xa0, 0xd4,
-	0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x29, 0x45, 0x3c, 0x2a, 0x8a, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x95,
-	0xd4, 0x9a, 0x2e, 0x3f, 0x99, 0xcc, 0xa4, 0x20, 0x24, 0xa7, 0x5f, 0x66, 0x08, 0x73, 0xb5, 0x3e,
-	0x16, 0xcb, 0x6a, 0xb9, 0x04, 0x31, 0x44, 0x85, 0xe4, 0x71, 0x99, 0x0f, 0x73, 0x80, 0x02, 0x6e,
-	0x05, 0x50, 0xfb, 0x55, 0xc1, 0xf6, 0xcb, 0x0b, 0xc9, 0xe2, 0xb5, 0xdf, 0x49, 0x27, 0x4a, 0x2b,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0x2a, 0x3f, 0x3f, 0x29, 0x19,
-	0x42, 0xea, 0x26, 0xe7, 0x17, 0xa5, 0xea, 0x27, 0xe7, 0xe7, 0xe6, 0xe6, 0xe7, 0xe9, 0x43, 0x23,
-	0x21, 0x89, 0x0d, 0x1c, 0xa2, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x32, 0x9a, 0x5b,
-	0xb4, 0x01, 0x00, 0x00,
+	0xa7, 0xa4, 0xe6, 0x60, 0x55, 0x24, 0x25, 0x93, 0x9e, 0x9f, 0x9f, 0x9e, 0x03, 0x34, 0xa1, 0x20,
+	0x53, 0x3f, 0x31, 0x2f, 0x2f, 0xbf, 0x24, 0xb1, 0x24, 0x33, 0x3f, 0xaf, 0x18, 0x22, 0x6b, 0x34,
+	0x95, 0x99, 0x4b, 0xd4, 0x11, 0x45, 0x5b, 0x30, 0xc4, 0x4c, 0xa1, 0x46, 0x46, 0x2e, 0x21, 0xf7,
+	0xd4, 0x12, 0x54, 0xc9, 0x62, 0x21, 0x05, 0x3d, 0xb0, 0x5d, 0x7a, 0x98, 0x52, 0x41, 0xa9, 0x85,
+	0xa5, 0xa9, 0xc5, 0x25, 0x52, 0x8a, 0x78, 0x54, 0x14, 0x17, 0x00, 0xad, 0x4e, 0x55, 0x52, 0x6b,
+	0xba, 0xfc, 0x64, 0x32, 0x93, 0x82, 0x90, 0x9c, 0x7e, 0x99, 0x21, 0xcc, 0xd5, 0xfa, 0x58, 0x2c,
+	0xab, 0xe5, 0x12, 0xc4, 0x10, 0x15, 0x92, 0xc7, 0x65, 0x3e, 0xcc, 0x01, 0x0a, 0xb8, 0x15, 0x40,
+	0xed, 0x57, 0x05, 0xdb, 0x2f, 0x2f, 0x24, 0x8b, 0xd7, 0x7e, 0xa1, 0x4a, 0x2e, 0x01, 0x97, 0xcc,
+	0xe2, 0xe4, 0xfc, 0xb2, 0xd4, 0x22, 0xa8, 0x4c, 0xb1, 0x90, 0x1c, 0xd4, 0x70, 0x74, 0x09, 0x98,
+	0xe5, 0xf2, 0x38, 0xe5, 0xa1, 0x76, 0xab, 0x80, 0xed, 0x96, 0x13, 0x92, 0x41, 0xb6, 0x1b, 0x5d,
+	0xb5, 0x93, 0x4e, 0x94, 0x56, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e,
+	0x55, 0x7e, 0x7e, 0x52, 0x32, 0x84, 0xd4, 0x4d, 0xce, 0x2f, 0x4a, 0xd5, 0x07, 0x0a, 0xe6, 0xe6,
+	0xe7, 0xe9, 0x43, 0xe3, 0x3f, 0x89, 0x0d, 0x1c, 0x99, 0xc6, 0x00, 0x16, 0x12, 0xf3, 0x20, 0x2f,
+	0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -112,6 +114,7 @@ const _ = grpc.SupportPackageIsVersion6
 type AccountBalanceServiceClient interface {
 	GetAccountBalances(ctx context.Context, in *model.GetAccountBalancesRequest, opts ...grpc.CallOption) (*model.GetAccountBalancesResponse, error)
 	GetAccountBalance(ctx context.Context, in *model.GetAccountBalanceRequest, opts ...grpc.CallOption) (*model.GetAccountBalanceResponse, error)
+	DiscoverAccounts(ctx context.Context, in *model.DiscoverAccountsRequest, opts ...grpc.CallOption) (*model.DiscoverAccountsResponse, error)
 }
 
 type accountBalanceServiceClient struct {
@@ -140,10 +143,20 @@ func (c *accountBalanceServiceClient) GetAccountBalance(ctx context.Context, in
 	return out, nil
 }
 
+func (c *accountBalanceServiceClient) DiscoverAccounts(ctx context.Context, in *model.DiscoverAccountsRequest, opts ...grpc.CallOption) (*model.DiscoverAccountsResponse, error) {
+	out := new(model.DiscoverAccountsResponse)
+	err := c.cc.Invoke(ctx, "/service.AccountBalanceService/DiscoverAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountBalanceServiceServer is the server API for AccountBalanceService service.
 type AccountBalanceServiceServer interface {
 	GetAccountBalances(context.Context, *model.GetAccountBalancesRequest) (*model.GetAccountBalancesResponse, error)
 	GetAccountBalance(context.Context, *model.GetAccountBalanceRequest) (*model.GetAccountBalanceResponse, error)
+	DiscoverAccounts(context.Context, *model.DiscoverAccountsRequest) (*model.DiscoverAccountsResponse, error)
 }
 
 // UnimplementedAccountBalanceServiceServer can be embedded to have forward compatible implementations.
@@ -156,6 +169,9 @@ func (*UnimplementedAccountBalanceServiceServer) GetAccountBalances(ctx context.
 func (*UnimplementedAccountBalanceServiceServer) GetAccountBalance(ctx context.Context, req *model.GetAccountBalanceRequest) (*model.GetAccountBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccountBalance not implemented")
 }
+func (*UnimplementedAccountBalanceServiceServer) DiscoverAccounts(ctx context.Context, req *model.DiscoverAccountsRequest) (*model.DiscoverAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscoverAccounts not implemented")
+}
 
 func RegisterAccountBalanceServiceServer(s *grpc.Server, srv AccountBalanceServiceServer) {
 	s.RegisterService(&_AccountBalanceService_serviceDesc, srv)
@@ -197,6 +213,24 @@ func _AccountBalanceService_GetAccountBalance_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountBalanceService_DiscoverAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.DiscoverAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountBalanceServiceServer).DiscoverAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.AccountBalanceService/DiscoverAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountBalanceServiceServer).DiscoverAccounts(ctx, req.(*model.DiscoverAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AccountBalanceService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "service.AccountBalanceService",
 	HandlerType: (*AccountBalanceServiceServer)(nil),
@@ -209,6 +243,10 @@ var _AccountBalanceService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccountBalance",
 			Handler:    _AccountBalanceService_GetAccountBalance_Handler,
 		},
+		{
+			MethodName: "DiscoverAccounts",
+			Handler:    _AccountBalanceService_DiscoverAccounts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service/accountBalance.proto",
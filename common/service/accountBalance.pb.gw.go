@@ -118,6 +118,26 @@ func request_AccountBalanceService_GetAccountBalance_0(ctx context.Context, mars
 
 }
 
+var (
+	filter_AccountBalanceService_DiscoverAccounts_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+)
+
+func request_AccountBalanceService_DiscoverAccounts_0(ctx context.Context, marshaler runtime.Marshaler, client AccountBalanceServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq model.DiscoverAccountsRequest
+	var metadata runtime.ServerMetadata
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_AccountBalanceService_DiscoverAccounts_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.DiscoverAccounts(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
 // RegisterAccountBalanceServiceHandlerFromEndpoint is same as RegisterAccountBalanceServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
 func RegisterAccountBalanceServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
@@ -196,6 +216,26 @@ func RegisterAccountBalanceServiceHandlerClient(ctx context.Context, mux *runtim
 
 	})
 
+	mux.Handle("GET", pattern_AccountBalanceService_DiscoverAccounts_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_AccountBalanceService_DiscoverAccounts_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_AccountBalanceService_DiscoverAccounts_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -203,10 +243,14 @@ var (
 	pattern_AccountBalanceService_GetAccountBalances_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "account", "GetAccountBalances"}, "", runtime.AssumeColonVerbOpt(true)))
 
 	pattern_AccountBalanceService_GetAccountBalance_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "account", "GetAccountBalance"}, "", runtime.AssumeColonVerbOpt(true)))
+
+	pattern_AccountBalanceService_DiscoverAccounts_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "account", "DiscoverAccounts"}, "", runtime.AssumeColonVerbOpt(true)))
 )
 
 var (
 	forward_AccountBalanceService_GetAccountBalances_0 = runtime.ForwardResponseMessage
 
 	forward_AccountBalanceService_GetAccountBalance_0 = runtime.ForwardResponseMessage
+
+	forward_AccountBalanceService_DiscoverAccounts_0 = runtime.ForwardResponseMessage
 )
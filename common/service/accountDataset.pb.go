@@ -80,22 +80,25 @@ func init() {
 }
 
 var fileDescriptor_2e05ba97ba46e9e1 = []byte{
-	// 229 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x4e, 0x2d, 0x2a,
+	// 285 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe3, 0x92, 0x29, 0x4e, 0x2d, 0x2a,
 	0xcb, 0x4c, 0x4e, 0xd5, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x71, 0x49, 0x2c, 0x49, 0x2c,
 	0x4e, 0x2d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x87, 0xca, 0x4a, 0x49, 0xe5, 0xe6,
-	0xa7, 0xa4, 0xe6, 0x60, 0x55, 0x24, 0x25, 0x93, 0x9e, 0x9f, 0x9f, 0x9e, 0x93, 0xaa, 0x9f, 0x58,
-	0x90, 0xa9, 0x9f, 0x98, 0x97, 0x97, 0x5f, 0x92, 0x58, 0x92, 0x99, 0x9f, 0x57, 0x0c, 0x91, 0x35,
-	0x9a, 0xcb, 0xc4, 0x25, 0xea, 0x88, 0xa2, 0x2d, 0x18, 0x62, 0xa6, 0x50, 0x07, 0x23, 0x97, 0x90,
-	0x7b, 0x6a, 0x09, 0xaa, 0x64, 0xb1, 0x90, 0x82, 0x1e, 0xd8, 0x2e, 0x3d, 0x4c, 0xa9, 0xa0, 0xd4,
-	0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x29, 0x45, 0x3c, 0x2a, 0x8a, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x95,
-	0x74, 0x9b, 0x2e, 0x3f, 0x99, 0xcc, 0xa4, 0x2e, 0xa4, 0xaa, 0x5f, 0x66, 0x88, 0xe6, 0x6a, 0x7d,
-	0x2c, 0x76, 0x56, 0x72, 0x09, 0x62, 0x88, 0x0a, 0xc9, 0xe3, 0xb2, 0x06, 0xe6, 0x0e, 0x51, 0xa8,
-	0x02, 0x54, 0x59, 0x25, 0x1d, 0xb0, 0xdd, 0x6a, 0x42, 0x2a, 0xc4, 0xd8, 0xed, 0xa4, 0x13, 0xa5,
-	0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x5f, 0x95, 0x9f, 0x9f, 0x94,
-	0x0c, 0x21, 0x75, 0x93, 0xf3, 0x8b, 0x52, 0xf5, 0x93, 0xf3, 0x73, 0x73, 0xf3, 0xf3, 0xf4, 0xa1,
-	0xf1, 0x90, 0xc4, 0x06, 0x0e, 0x54, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x22, 0x08,
-	0x37, 0xb7, 0x01, 0x00, 0x00,
+	0xa7, 0xa4, 0xe6, 0x60, 0x55, 0x24, 0x25, 0x93, 0x9e, 0x9f, 0x9f, 0x9e, 0x03, 0x34, 0xa1, 0x20,
+	0x53, 0x3f, 0x31, 0x2f, 0x2f, 0xbf, 0x24, 0xb1, 0x24, 0x33, 0x3f, 0xaf, 0x18, 0x22, 0x6b, 0x74,
+	0x9a, 0x85, 0x4b, 0xd4, 0x11, 0x45, 0x5b, 0x30, 0xc4, 0x4c, 0xa1, 0x0e, 0x46, 0x2e, 0x21, 0xf7,
+	0xd4, 0x12, 0x54, 0xc9, 0x62, 0x21, 0x05, 0x3d, 0xb0, 0x5d, 0x7a, 0x98, 0x52, 0x41, 0xa9, 0x85,
+	0xa5, 0xa9, 0xc5, 0x25, 0x52, 0x8a, 0x78, 0x54, 0x14, 0x17, 0x00, 0xad, 0x4e, 0x55, 0xd2, 0x6d,
+	0xba, 0xfc, 0x64, 0x32, 0x93, 0xba, 0x90, 0xaa, 0x7e, 0x99, 0x21, 0x9a, 0xab, 0xf5, 0xb1, 0xd8,
+	0x59, 0xc9, 0x25, 0x88, 0x21, 0x2a, 0x24, 0x8f, 0xcb, 0x1a, 0x98, 0x3b, 0x44, 0xa1, 0x0a, 0x50,
+	0x65, 0x95, 0x74, 0xc0, 0x76, 0xab, 0x09, 0xa9, 0x10, 0x63, 0xb7, 0xd0, 0x74, 0x46, 0x2e, 0x71,
+	0xa0, 0x68, 0x48, 0x65, 0x41, 0x6a, 0x0a, 0xe9, 0x41, 0xa1, 0x86, 0x50, 0x81, 0xcd, 0x04, 0x78,
+	0x78, 0x18, 0x81, 0xdd, 0xa4, 0x23, 0xa4, 0x85, 0xdd, 0x4d, 0x58, 0x6d, 0x9f, 0x08, 0x71, 0x19,
+	0x5a, 0xe4, 0x25, 0x67, 0xa4, 0xe6, 0x26, 0x0a, 0xa9, 0xe2, 0x72, 0x19, 0x44, 0x1e, 0xe6, 0x3c,
+	0x69, 0xac, 0x21, 0x04, 0x51, 0x43, 0xc8, 0x4d, 0xd8, 0xf4, 0x38, 0xe9, 0x44, 0x69, 0xa5, 0x67,
+	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x57, 0xe5, 0xe7, 0x27, 0x25, 0x43, 0x48,
+	0xdd, 0xe4, 0xfc, 0xa2, 0x54, 0x7d, 0xa0, 0x60, 0x6e, 0x7e, 0x9e, 0x3e, 0x34, 0xd5, 0x26, 0xb1,
+	0x81, 0x93, 0xa0, 0x31, 0x00, 0x98, 0x02, 0xb4, 0xcf, 0xe5, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -112,6 +115,8 @@ const _ = grpc.SupportPackageIsVersion6
 type AccountDatasetServiceClient interface {
 	GetAccountDatasets(ctx context.Context, in *model.GetAccountDatasetsRequest, opts ...grpc.CallOption) (*model.GetAccountDatasetsResponse, error)
 	GetAccountDataset(ctx context.Context, in *model.GetAccountDatasetRequest, opts ...grpc.CallOption) (*model.AccountDataset, error)
+	GetTypedAccountDatasets(ctx context.Context, in *model.GetAccountDatasetsRequest, opts ...grpc.CallOption) (*model.GetTypedAccountDatasetsResponse, error)
+	GetAccountDatasetSchema(ctx context.Context, in *model.GetAccountDatasetSchemaRequest, opts ...grpc.CallOption) (*model.AccountDatasetSchema, error)
 }
 
 type accountDatasetServiceClient struct {
@@ -140,10 +145,30 @@ func (c *accountDatasetServiceClient) GetAccountDataset(ctx context.Context, in
 	return out, nil
 }
 
+func (c *accountDatasetServiceClient) GetTypedAccountDatasets(ctx context.Context, in *model.GetAccountDatasetsRequest, opts ...grpc.CallOption) (*model.GetTypedAccountDatasetsResponse, error) {
+	out := new(model.GetTypedAccountDatasetsResponse)
+	err := c.cc.Invoke(ctx, "/service.AccountDatasetService/GetTypedAccountDatasets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountDatasetServiceClient) GetAccountDatasetSchema(ctx context.Context, in *model.GetAccountDatasetSchemaRequest, opts ...grpc.CallOption) (*model.AccountDatasetSchema, error) {
+	out := new(model.AccountDatasetSchema)
+	err := c.cc.Invoke(ctx, "/service.AccountDatasetService/GetAccountDatasetSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountDatasetServiceServer is the server API for AccountDatasetService service.
 type AccountDatasetServiceServer interface {
 	GetAccountDatasets(context.Context, *model.GetAccountDatasetsRequest) (*model.GetAccountDatasetsResponse, error)
 	GetAccountDataset(context.Context, *model.GetAccountDatasetRequest) (*model.AccountDataset, error)
+	GetTypedAccountDatasets(context.Context, *model.GetAccountDatasetsRequest) (*model.GetTypedAccountDatasetsResponse, error)
+	GetAccountDatasetSchema(context.Context, *model.GetAccountDatasetSchemaRequest) (*model.AccountDatasetSchema, error)
 }
 
 // UnimplementedAccountDatasetServiceServer can be embedded to have forward compatible implementations.
@@ -156,6 +181,12 @@ func (*UnimplementedAccountDatasetServiceServer) GetAccountDatasets(ctx context.
 func (*UnimplementedAccountDatasetServiceServer) GetAccountDataset(ctx context.Context, req *model.GetAccountDatasetRequest) (*model.AccountDataset, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccountDataset not implemented")
 }
+func (*UnimplementedAccountDatasetServiceServer) GetTypedAccountDatasets(ctx context.Context, req *model.GetAccountDatasetsRequest) (*model.GetTypedAccountDatasetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTypedAccountDatasets not implemented")
+}
+func (*UnimplementedAccountDatasetServiceServer) GetAccountDatasetSchema(ctx context.Context, req *model.GetAccountDatasetSchemaRequest) (*model.AccountDatasetSchema, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountDatasetSchema not implemented")
+}
 
 func RegisterAccountDatasetServiceServer(s *grpc.Server, srv AccountDatasetServiceServer) {
 	s.RegisterService(&_AccountDatasetService_serviceDesc, srv)
@@ -197,6 +228,42 @@ func _AccountDatasetService_GetAccountDataset_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountDatasetService_GetTypedAccountDatasets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.GetAccountDatasetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountDatasetServiceServer).GetTypedAccountDatasets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.AccountDatasetService/GetTypedAccountDatasets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountDatasetServiceServer).GetTypedAccountDatasets(ctx, req.(*model.GetAccountDatasetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountDatasetService_GetAccountDatasetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.GetAccountDatasetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountDatasetServiceServer).GetAccountDatasetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.AccountDatasetService/GetAccountDatasetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountDatasetServiceServer).GetAccountDatasetSchema(ctx, req.(*model.GetAccountDatasetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AccountDatasetService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "service.AccountDatasetService",
 	HandlerType: (*AccountDatasetServiceServer)(nil),
@@ -209,6 +276,14 @@ var _AccountDatasetService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccountDataset",
 			Handler:    _AccountDatasetService_GetAccountDataset_Handler,
 		},
+		{
+			MethodName: "GetTypedAccountDatasets",
+			Handler:    _AccountDatasetService_GetTypedAccountDatasets_Handler,
+		},
+		{
+			MethodName: "GetAccountDatasetSchema",
+			Handler:    _AccountDatasetService_GetAccountDatasetSchema_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service/accountDataset.proto",
@@ -80,20 +80,23 @@ func init() {
 }
 
 var fileDescriptor_3348bbcb1f9dd137 = []byte{
-	// 205 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0x4e, 0x2d, 0x2a,
+	// 247 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe3, 0x92, 0x2e, 0x4e, 0x2d, 0x2a,
 	0xcb, 0x4c, 0x4e, 0xd5, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0xf1, 0x49, 0x4d, 0x49, 0x4f,
 	0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x87, 0x4a, 0x4a, 0x49, 0xe6, 0xe6, 0xa7,
-	0xa4, 0xe6, 0x60, 0x53, 0x23, 0x25, 0x93, 0x9e, 0x9f, 0x9f, 0x9e, 0x93, 0xaa, 0x9f, 0x58, 0x90,
-	0xa9, 0x9f, 0x98, 0x97, 0x97, 0x5f, 0x92, 0x58, 0x92, 0x99, 0x9f, 0x57, 0x0c, 0x91, 0x35, 0x9a,
-	0xcb, 0xc8, 0x25, 0xe2, 0x88, 0xac, 0x2b, 0x18, 0x62, 0xa2, 0x50, 0x0b, 0x23, 0x97, 0xa0, 0x7b,
-	0x6a, 0x09, 0x8a, 0x5c, 0xb1, 0x90, 0xbc, 0x1e, 0xd8, 0x22, 0x3d, 0x0c, 0x99, 0xa0, 0xd4, 0xc2,
-	0xd2, 0xd4, 0xe2, 0x12, 0x29, 0x05, 0xdc, 0x0a, 0x8a, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x95, 0x74,
-	0x9a, 0x2e, 0x3f, 0x99, 0xcc, 0xa4, 0x26, 0xa4, 0xa2, 0x5f, 0x66, 0x08, 0x73, 0xb0, 0x6e, 0x0e,
-	0x58, 0x91, 0x3e, 0x86, 0x2e, 0x27, 0x9d, 0x28, 0xad, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd,
-	0xe4, 0xfc, 0x5c, 0xfd, 0xaa, 0xfc, 0xfc, 0xa4, 0x64, 0x08, 0xa9, 0x9b, 0x9c, 0x5f, 0x94, 0xaa,
-	0x9f, 0x9c, 0x9f, 0x9b, 0x9b, 0x9f, 0xa7, 0x0f, 0x0d, 0x86, 0x24, 0x36, 0xb0, 0xa7, 0x8c, 0x01,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xc2, 0x57, 0xf6, 0x35, 0x01, 0x00, 0x00,
+	0xa4, 0xe6, 0x60, 0x53, 0x23, 0x25, 0x93, 0x9e, 0x9f, 0x9f, 0x9e, 0x03, 0xd4, 0x5f, 0x90, 0xa9,
+	0x9f, 0x98, 0x97, 0x97, 0x5f, 0x92, 0x58, 0x92, 0x99, 0x9f, 0x57, 0x0c, 0x91, 0x35, 0xda, 0xc0,
+	0xc4, 0x25, 0xe2, 0x88, 0xac, 0x2b, 0x18, 0x62, 0xa2, 0x50, 0x0b, 0x23, 0x97, 0xa0, 0x7b, 0x6a,
+	0x09, 0x8a, 0x5c, 0xb1, 0x90, 0xbc, 0x1e, 0xd8, 0x22, 0x3d, 0x0c, 0x99, 0xa0, 0xd4, 0xc2, 0xd2,
+	0xd4, 0xe2, 0x12, 0x29, 0x05, 0xdc, 0x0a, 0x8a, 0x0b, 0x80, 0xd6, 0xa6, 0x2a, 0xe9, 0x34, 0x5d,
+	0x7e, 0x32, 0x99, 0x49, 0x4d, 0x48, 0x45, 0xbf, 0xcc, 0x10, 0xe6, 0x60, 0xdd, 0x1c, 0xb0, 0x22,
+	0x7d, 0x4c, 0x0b, 0x27, 0x30, 0x72, 0x89, 0x07, 0x97, 0x26, 0x15, 0x27, 0x17, 0x65, 0x26, 0xa5,
+	0xa2, 0xc9, 0xa9, 0x42, 0xed, 0xc2, 0x21, 0x0f, 0x73, 0x92, 0x24, 0x54, 0x19, 0x8a, 0xac, 0x6b,
+	0x59, 0x6a, 0x5e, 0x89, 0x92, 0x21, 0xd8, 0x2d, 0xda, 0x42, 0x9a, 0x48, 0x6e, 0x81, 0xc8, 0xeb,
+	0xe3, 0x30, 0xd4, 0x80, 0xd1, 0x49, 0x27, 0x4a, 0x2b, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f,
+	0x39, 0x3f, 0x57, 0xbf, 0x2a, 0x3f, 0x3f, 0x29, 0x19, 0x42, 0xea, 0x26, 0xe7, 0x17, 0xa5, 0xea,
+	0x03, 0x05, 0x73, 0xf3, 0xf3, 0xf4, 0xa1, 0x31, 0x93, 0xc4, 0x06, 0x0e, 0x67, 0x63, 0x00, 0xd7,
+	0xd1, 0x4c, 0xf6, 0xc8, 0x01, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -109,6 +112,7 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type AccountLedgerServiceClient interface {
 	GetAccountLedgers(ctx context.Context, in *model.GetAccountLedgersRequest, opts ...grpc.CallOption) (*model.GetAccountLedgersResponse, error)
+	SubscribeAccountLedgers(ctx context.Context, in *model.SubscribeAccountLedgersRequest, opts ...grpc.CallOption) (AccountLedgerService_SubscribeAccountLedgersClient, error)
 }
 
 type accountLedgerServiceClient struct {
@@ -128,9 +132,42 @@ func (c *accountLedgerServiceClient) GetAccountLedgers(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *accountLedgerServiceClient) SubscribeAccountLedgers(ctx context.Context, in *model.SubscribeAccountLedgersRequest, opts ...grpc.CallOption) (AccountLedgerService_SubscribeAccountLedgersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AccountLedgerService_serviceDesc.Streams[0], "/service.AccountLedgerService/SubscribeAccountLedgers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &accountLedgerServiceSubscribeAccountLedgersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AccountLedgerService_SubscribeAccountLedgersClient interface {
+	Recv() (*model.AccountLedgerEvent, error)
+	grpc.ClientStream
+}
+
+type accountLedgerServiceSubscribeAccountLedgersClient struct {
+	grpc.ClientStream
+}
+
+func (x *accountLedgerServiceSubscribeAccountLedgersClient) Recv() (*model.AccountLedgerEvent, error) {
+	m := new(model.AccountLedgerEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AccountLedgerServiceServer is the server API for AccountLedgerService service.
 type AccountLedgerServiceServer interface {
 	GetAccountLedgers(context.Context, *model.GetAccountLedgersRequest) (*model.GetAccountLedgersResponse, error)
+	SubscribeAccountLedgers(*model.SubscribeAccountLedgersRequest, AccountLedgerService_SubscribeAccountLedgersServer) error
 }
 
 // UnimplementedAccountLedgerServiceServer can be embedded to have forward compatible implementations.
@@ -140,6 +177,9 @@ type UnimplementedAccountLedgerServiceServer struct {
 func (*UnimplementedAccountLedgerServiceServer) GetAccountLedgers(ctx context.Context, req *model.GetAccountLedgersRequest) (*model.GetAccountLedgersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccountLedgers not implemented")
 }
+func (*UnimplementedAccountLedgerServiceServer) SubscribeAccountLedgers(req *model.SubscribeAccountLedgersRequest, srv AccountLedgerService_SubscribeAccountLedgersServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeAccountLedgers not implemented")
+}
 
 func RegisterAccountLedgerServiceServer(s *grpc.Server, srv AccountLedgerServiceServer) {
 	s.RegisterService(&_AccountLedgerService_serviceDesc, srv)
@@ -163,6 +203,27 @@ func _AccountLedgerService_GetAccountLedgers_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountLedgerService_SubscribeAccountLedgers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(model.SubscribeAccountLedgersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AccountLedgerServiceServer).SubscribeAccountLedgers(m, &accountLedgerServiceSubscribeAccountLedgersServer{stream})
+}
+
+type AccountLedgerService_SubscribeAccountLedgersServer interface {
+	Send(*model.AccountLedgerEvent) error
+	grpc.ServerStream
+}
+
+type accountLedgerServiceSubscribeAccountLedgersServer struct {
+	grpc.ServerStream
+}
+
+func (x *accountLedgerServiceSubscribeAccountLedgersServer) Send(m *model.AccountLedgerEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _AccountLedgerService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "service.AccountLedgerService",
 	HandlerType: (*AccountLedgerServiceServer)(nil),
@@ -172,6 +233,12 @@ var _AccountLedgerService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _AccountLedgerService_GetAccountLedgers_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeAccountLedgers",
+			Handler:       _AccountLedgerService_SubscribeAccountLedgers_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "service/accountLedger.proto",
 }
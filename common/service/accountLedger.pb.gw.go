@@ -98,6 +98,34 @@ func request_AccountLedgerService_GetAccountLedgers_0(ctx context.Context, marsh
 
 }
 
+var (
+	filter_AccountLedgerService_SubscribeAccountLedgers_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+)
+
+func request_AccountLedgerService_SubscribeAccountLedgers_0(ctx context.Context, marshaler runtime.Marshaler, client AccountLedgerServiceClient, req *http.Request, pathParams map[string]string) (AccountLedgerService_SubscribeAccountLedgersClient, runtime.ServerMetadata, error) {
+	var protoReq model.SubscribeAccountLedgersRequest
+	var metadata runtime.ServerMetadata
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_AccountLedgerService_SubscribeAccountLedgers_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.SubscribeAccountLedgers(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
 // RegisterAccountLedgerServiceHandlerFromEndpoint is same as RegisterAccountLedgerServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
 func RegisterAccountLedgerServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
@@ -156,13 +184,37 @@ func RegisterAccountLedgerServiceHandlerClient(ctx context.Context, mux *runtime
 
 	})
 
+	mux.Handle("GET", pattern_AccountLedgerService_SubscribeAccountLedgers_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_AccountLedgerService_SubscribeAccountLedgers_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_AccountLedgerService_SubscribeAccountLedgers_0(ctx, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
 var (
 	pattern_AccountLedgerService_GetAccountLedgers_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "account-ledger", "GetAccountLedgers"}, "", runtime.AssumeColonVerbOpt(true)))
+
+	pattern_AccountLedgerService_SubscribeAccountLedgers_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "accountLedger", "SubscribeAccountLedgers"}, "", runtime.AssumeColonVerbOpt(true)))
 )
 
 var (
 	forward_AccountLedgerService_GetAccountLedgers_0 = runtime.ForwardResponseMessage
+
+	forward_AccountLedgerService_SubscribeAccountLedgers_0 = runtime.ForwardResponseStream
 )
@@ -80,36 +80,38 @@ func init() {
 }
 
 var fileDescriptor_c7c370ee2b80617f = []byte{
-	// 459 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xb1, 0x6e, 0x13, 0x31,
-	0x18, 0xc7, 0x75, 0x0c, 0x20, 0x79, 0x41, 0xb2, 0x04, 0x43, 0x54, 0x31, 0xa4, 0x29, 0x43, 0x9b,
-	0xc4, 0x84, 0x50, 0x21, 0xc8, 0xd4, 0x13, 0x52, 0xcb, 0x80, 0x54, 0x51, 0x26, 0x36, 0xc7, 0xf9,
-	0x70, 0x2c, 0xee, 0xfc, 0x85, 0xb3, 0xaf, 0xd2, 0x31, 0xf2, 0x00, 0x0c, 0xf0, 0x26, 0x3c, 0x02,
-	0x13, 0x33, 0xbc, 0x02, 0x13, 0x4f, 0x81, 0xea, 0xf3, 0xd1, 0x88, 0xf3, 0x39, 0x49, 0x97, 0x0c,
-	0xf7, 0xfd, 0xff, 0xfe, 0x7e, 0xff, 0xfc, 0x2d, 0x93, 0x3d, 0x03, 0xc5, 0xa5, 0x12, 0xc0, 0xf2,
-	0x32, 0xb3, 0xea, 0x42, 0x49, 0xcd, 0x6d, 0x59, 0xc0, 0x78, 0x55, 0xa0, 0x45, 0x7a, 0xc7, 0x4f,
-	0x7b, 0xbd, 0x1c, 0x17, 0x90, 0x05, 0x45, 0xbd, 0x3d, 0x89, 0x28, 0x33, 0x60, 0x7c, 0xa5, 0x18,
-	0xd7, 0x1a, 0x2d, 0xb7, 0x0a, 0xb5, 0xa9, 0xa7, 0x8f, 0x7f, 0x10, 0x72, 0xf7, 0xd5, 0x95, 0xcd,
-	0x28, 0x79, 0x51, 0x9f, 0x46, 0xbf, 0x24, 0xe4, 0xfe, 0x29, 0xd8, 0x73, 0xd0, 0x0b, 0xa5, 0xe5,
-	0x9b, 0x82, 0x6b, 0xc3, 0x85, 0x33, 0xd1, 0xc1, 0xd8, 0x6d, 0x1a, 0x87, 0xc7, 0xaf, 0xe1, 0x43,
-	0x09, 0xc6, 0xf6, 0x0e, 0x36, 0xa8, 0xcc, 0x0a, 0xb5, 0x81, 0xfe, 0xd1, 0xa7, 0x5f, 0xbf, 0xbf,
-	0xde, 0x3a, 0xa0, 0xfb, 0xec, 0x72, 0x52, 0xb3, 0x1b, 0x25, 0x59, 0xc7, 0xe6, 0x3f, 0x09, 0x19,
-	0x06, 0x47, 0x2f, 0xc0, 0x72, 0x95, 0xa5, 0xd5, 0xda, 0xa7, 0x33, 0x6e, 0x96, 0xf4, 0x79, 0x0c,
-	0xa2, 0xc3, 0xd4, 0x04, 0x98, 0xdd, 0xc8, 0xeb, 0x63, 0x9d, 0xb8, 0x58, 0x33, 0xfa, 0x6c, 0x73,
-	0xac, 0x2e, 0xf6, 0xcf, 0x09, 0xb9, 0x77, 0x0a, 0xb6, 0x29, 0xa6, 0xee, 0xf3, 0xa5, 0x7e, 0x87,
-	0x74, 0xff, 0x9a, 0xac, 0x3d, 0x6d, 0xf0, 0x07, 0x71, 0x91, 0xe7, 0x3c, 0x74, 0x9c, 0x03, 0xda,
-	0xff, 0x9f, 0x33, 0xb0, 0xf6, 0x67, 0x42, 0x1e, 0xae, 0x4d, 0x4e, 0x16, 0x8b, 0x02, 0x8c, 0x49,
-	0xab, 0x73, 0x5e, 0x58, 0x25, 0xd4, 0x8a, 0x6b, 0xeb, 0xbf, 0xd1, 0x27, 0xed, 0xe5, 0x11, 0x79,
-	0x83, 0x7c, 0xbc, 0xa3, 0xcb, 0x67, 0x98, 0xb9, 0x0c, 0xc7, 0x74, 0xda, 0x95, 0x21, 0x46, 0xfa,
-	0x2d, 0x21, 0x0f, 0xc2, 0xb7, 0x2d, 0xad, 0xce, 0x40, 0xc9, 0xa5, 0xa5, 0xc3, 0xe8, 0x4d, 0x6e,
-	0x64, 0x4d, 0x88, 0xd1, 0x96, 0x6a, 0x0f, 0x3f, 0x75, 0xf0, 0x23, 0x7a, 0xb4, 0xc5, 0xfd, 0xff,
-	0x47, 0x14, 0x6e, 0x02, 0x4c, 0x5a, 0xa5, 0x19, 0x8a, 0xf7, 0x7e, 0x05, 0xd7, 0x12, 0x22, 0x4d,
-	0x84, 0xe4, 0x9b, 0x9b, 0x08, 0xbb, 0x76, 0x6c, 0x22, 0x48, 0xfa, 0x3d, 0x21, 0xfd, 0xab, 0xdc,
-	0xd7, 0x1d, 0x99, 0xb4, 0x6a, 0x19, 0xe9, 0xa3, 0xb5, 0xff, 0x37, 0x2e, 0x6d, 0xc2, 0x4c, 0x76,
-	0x70, 0xf8, 0x20, 0x4f, 0x5d, 0x90, 0x09, 0x65, 0xad, 0x56, 0xe2, 0x07, 0xa4, 0xc3, 0xb7, 0x87,
-	0x52, 0xd9, 0x65, 0x39, 0x1f, 0x0b, 0xcc, 0xd9, 0x47, 0xc4, 0xb9, 0xa8, 0x7f, 0x47, 0x02, 0x0b,
-	0x60, 0x02, 0xf3, 0x1c, 0x35, 0xf3, 0x4f, 0xf6, 0xfc, 0xb6, 0x7b, 0x7f, 0xa7, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x28, 0xb2, 0xcf, 0x55, 0xe2, 0x05, 0x00, 0x00,
+	// 482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9d, 0x95, 0xcd, 0x4e, 0xc2, 0x40,
+	0x14, 0x85, 0x53, 0x17, 0x9a, 0xd4, 0x85, 0xc9, 0x24, 0xba, 0x20, 0xc6, 0x05, 0x82, 0x0b, 0xf9,
+	0x19, 0x11, 0x8d, 0x51, 0x56, 0x34, 0x26, 0xea, 0xc2, 0x84, 0xa8, 0x2b, 0x77, 0x43, 0x7b, 0x2d,
+	0x13, 0xcb, 0x0c, 0x76, 0x06, 0x12, 0x5c, 0xfa, 0x00, 0x2e, 0xf4, 0x4d, 0x7c, 0x04, 0x1f, 0x41,
+	0x57, 0xee, 0x5d, 0xf9, 0x14, 0x96, 0x76, 0x0a, 0x08, 0xd3, 0x02, 0x6e, 0x20, 0x99, 0x7b, 0x0e,
+	0xf7, 0x3b, 0xbd, 0x73, 0xa9, 0xb9, 0x29, 0xc0, 0xef, 0x51, 0x1b, 0x70, 0xbb, 0xeb, 0x49, 0x7a,
+	0x4d, 0x5d, 0x46, 0x64, 0xd7, 0x87, 0x72, 0xc7, 0xe7, 0x92, 0xa3, 0x15, 0x55, 0xcd, 0x64, 0xda,
+	0xdc, 0x01, 0x4f, 0x2b, 0xca, 0x6c, 0xba, 0x9c, 0xbb, 0x1e, 0x60, 0xd2, 0xa1, 0x98, 0x30, 0xc6,
+	0x25, 0x91, 0x94, 0x33, 0x11, 0x55, 0xf7, 0xbf, 0x56, 0xcd, 0xb5, 0xcb, 0x81, 0x4d, 0x50, 0xf7,
+	0x3a, 0xfa, 0x35, 0xf4, 0x62, 0x98, 0x1b, 0x67, 0x20, 0x1b, 0xc0, 0x1c, 0xca, 0xdc, 0x1b, 0x9f,
+	0x30, 0x41, 0xec, 0xd0, 0x84, 0x72, 0xe5, 0xb0, 0x53, 0x59, 0x5f, 0xbe, 0x82, 0x87, 0x2e, 0x08,
+	0x99, 0xc9, 0xcf, 0x50, 0x89, 0x4e, 0xf0, 0x05, 0xd9, 0xc2, 0xd3, 0xe7, 0xf7, 0xeb, 0x52, 0x1e,
+	0x6d, 0xe3, 0x5e, 0x25, 0x62, 0x0f, 0x20, 0x70, 0x42, 0xe7, 0x1f, 0xc3, 0x2c, 0x6a, 0x4b, 0xa7,
+	0x20, 0x09, 0xf5, 0xac, 0xfe, 0xd8, 0xd1, 0x39, 0x11, 0x2d, 0x74, 0x92, 0x06, 0x91, 0x60, 0x8a,
+	0x03, 0xd4, 0xfe, 0xe5, 0x55, 0xb1, 0xea, 0x61, 0xac, 0x1a, 0x3a, 0x9e, 0x1d, 0x2b, 0x89, 0xfd,
+	0xd9, 0x30, 0xd7, 0x03, 0x43, 0x3c, 0x98, 0x68, 0x9e, 0x17, 0xec, 0x8e, 0xa3, 0xed, 0x11, 0xd9,
+	0x74, 0x35, 0xc6, 0xcf, 0xa5, 0x8b, 0x14, 0xe7, 0x6e, 0xc8, 0x99, 0x43, 0xd9, 0x49, 0x4e, 0x4d,
+	0xdb, 0x0f, 0xc3, 0xdc, 0x19, 0xab, 0xd4, 0x1d, 0xc7, 0x07, 0x21, 0xac, 0x7e, 0x83, 0xf8, 0x92,
+	0xda, 0xb4, 0x43, 0x98, 0x54, 0x67, 0xe8, 0x60, 0xba, 0x79, 0x8a, 0x3c, 0x46, 0x3e, 0x5c, 0xd0,
+	0xa5, 0x32, 0xd4, 0xc2, 0x0c, 0x87, 0xa8, 0x9a, 0x94, 0x21, 0x8d, 0xf4, 0xcd, 0x30, 0xb7, 0xf4,
+	0xb7, 0xcd, 0xea, 0x9f, 0x03, 0x75, 0x5b, 0x12, 0x15, 0x53, 0x6f, 0x72, 0x2c, 0x8b, 0x43, 0x94,
+	0xe6, 0x54, 0x2b, 0xf8, 0x6a, 0x08, 0x5f, 0x42, 0x85, 0x39, 0xee, 0xff, 0x90, 0x48, 0x3f, 0x09,
+	0x08, 0x04, 0x96, 0xc7, 0xed, 0x7b, 0xd5, 0x82, 0x30, 0x17, 0x52, 0x26, 0xa1, 0x93, 0xcf, 0x9e,
+	0x84, 0xde, 0xb5, 0xe0, 0x24, 0xb4, 0xa4, 0xef, 0x86, 0x99, 0x1d, 0xe4, 0x1e, 0xcd, 0x28, 0x50,
+	0x4d, 0x19, 0xd1, 0xde, 0xd8, 0xf3, 0x4d, 0x97, 0xc6, 0x61, 0x2a, 0x0b, 0x38, 0x54, 0x90, 0xa3,
+	0x30, 0x48, 0x05, 0xe1, 0xa9, 0xa9, 0xcc, 0xa0, 0x1b, 0x2c, 0x6d, 0x83, 0x0b, 0x39, 0x58, 0x6a,
+	0xbb, 0x05, 0xce, 0xf0, 0x8f, 0x78, 0xb8, 0xb4, 0xda, 0xea, 0xe4, 0xd2, 0x26, 0x88, 0xfe, 0x2e,
+	0x6d, 0xf6, 0xef, 0xd2, 0x6a, 0x3d, 0x56, 0xf1, 0x76, 0xd7, 0xa5, 0xb2, 0xd5, 0x6d, 0x96, 0x6d,
+	0xde, 0xc6, 0x8f, 0x9c, 0x37, 0xed, 0xe8, 0xb3, 0x64, 0x73, 0x1f, 0x70, 0x70, 0xd8, 0xe6, 0x0c,
+	0xab, 0x77, 0x48, 0x73, 0x39, 0x7c, 0x21, 0x54, 0x7f, 0x01, 0x23, 0x3b, 0x62, 0x7e, 0x73, 0x06,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -132,6 +134,7 @@ type MultisigServiceClient interface {
 	GetPendingTransactionsByHeight(ctx context.Context, in *model.GetPendingTransactionsByHeightRequest, opts ...grpc.CallOption) (*model.GetPendingTransactionsByHeightResponse, error)
 	GetMultisigAddressesByBlockHeightRange(ctx context.Context, in *model.GetMultisigAddressesByBlockHeightRangeRequest, opts ...grpc.CallOption) (*model.GetMultisigAddressesByBlockHeightRangeResponse, error)
 	GetParticipantsByMultisigAddresses(ctx context.Context, in *model.GetParticipantsByMultisigAddressesRequest, opts ...grpc.CallOption) (*model.GetParticipantsByMultisigAddressesResponse, error)
+	PostDetachedSignature(ctx context.Context, in *model.PostDetachedSignatureRequest, opts ...grpc.CallOption) (*model.PostDetachedSignatureResponse, error)
 }
 
 type multisigServiceClient struct {
@@ -205,6 +208,15 @@ func (c *multisigServiceClient) GetParticipantsByMultisigAddresses(ctx context.C
 	return out, nil
 }
 
+func (c *multisigServiceClient) PostDetachedSignature(ctx context.Context, in *model.PostDetachedSignatureRequest, opts ...grpc.CallOption) (*model.PostDetachedSignatureResponse, error) {
+	out := new(model.PostDetachedSignatureResponse)
+	err := c.cc.Invoke(ctx, "/service.MultisigService/PostDetachedSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MultisigServiceServer is the server API for MultisigService service.
 type MultisigServiceServer interface {
 	GetPendingTransactions(context.Context, *model.GetPendingTransactionsRequest) (*model.GetPendingTransactionsResponse, error)
@@ -215,6 +227,7 @@ type MultisigServiceServer interface {
 	GetPendingTransactionsByHeight(context.Context, *model.GetPendingTransactionsByHeightRequest) (*model.GetPendingTransactionsByHeightResponse, error)
 	GetMultisigAddressesByBlockHeightRange(context.Context, *model.GetMultisigAddressesByBlockHeightRangeRequest) (*model.GetMultisigAddressesByBlockHeightRangeResponse, error)
 	GetParticipantsByMultisigAddresses(context.Context, *model.GetParticipantsByMultisigAddressesRequest) (*model.GetParticipantsByMultisigAddressesResponse, error)
+	PostDetachedSignature(context.Context, *model.PostDetachedSignatureRequest) (*model.PostDetachedSignatureResponse, error)
 }
 
 // UnimplementedMultisigServiceServer can be embedded to have forward compatible implementations.
@@ -242,6 +255,9 @@ func (*UnimplementedMultisigServiceServer) GetMultisigAddressesByBlockHeightRang
 func (*UnimplementedMultisigServiceServer) GetParticipantsByMultisigAddresses(ctx context.Context, req *model.GetParticipantsByMultisigAddressesRequest) (*model.GetParticipantsByMultisigAddressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetParticipantsByMultisigAddresses not implemented")
 }
+func (*UnimplementedMultisigServiceServer) PostDetachedSignature(ctx context.Context, req *model.PostDetachedSignatureRequest) (*model.PostDetachedSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostDetachedSignature not implemented")
+}
 
 func RegisterMultisigServiceServer(s *grpc.Server, srv MultisigServiceServer) {
 	s.RegisterService(&_MultisigService_serviceDesc, srv)
@@ -373,6 +389,24 @@ func _MultisigService_GetParticipantsByMultisigAddresses_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MultisigService_PostDetachedSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.PostDetachedSignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultisigServiceServer).PostDetachedSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.MultisigService/PostDetachedSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultisigServiceServer).PostDetachedSignature(ctx, req.(*model.PostDetachedSignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MultisigService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "service.MultisigService",
 	HandlerType: (*MultisigServiceServer)(nil),
@@ -405,6 +439,10 @@ var _MultisigService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetParticipantsByMultisigAddresses",
 			Handler:    _MultisigService_GetParticipantsByMultisigAddresses_Handler,
 		},
+		{
+			MethodName: "PostDetachedSignature",
+			Handler:    _MultisigService_PostDetachedSignature_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service/multiSignature.proto",
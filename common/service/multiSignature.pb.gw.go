@@ -218,6 +218,26 @@ func request_MultisigService_GetParticipantsByMultisigAddresses_0(ctx context.Co
 
 }
 
+var (
+	filter_MultisigService_PostDetachedSignature_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+)
+
+func request_MultisigService_PostDetachedSignature_0(ctx context.Context, marshaler runtime.Marshaler, client MultisigServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq model.PostDetachedSignatureRequest
+	var metadata runtime.ServerMetadata
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MultisigService_PostDetachedSignature_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.PostDetachedSignature(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
 // RegisterMultisigServiceHandlerFromEndpoint is same as RegisterMultisigServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
 func RegisterMultisigServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
@@ -396,6 +416,26 @@ func RegisterMultisigServiceHandlerClient(ctx context.Context, mux *runtime.Serv
 
 	})
 
+	mux.Handle("POST", pattern_MultisigService_PostDetachedSignature_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_MultisigService_PostDetachedSignature_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MultisigService_PostDetachedSignature_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -413,6 +453,8 @@ var (
 	pattern_MultisigService_GetMultisigAddressesByBlockHeightRange_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "multisig", "GetMultisigAddressesByBlockHeightRange"}, "", runtime.AssumeColonVerbOpt(true)))
 
 	pattern_MultisigService_GetParticipantsByMultisigAddresses_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "multisig", "GetParticipantsByMultisigAddresses"}, "", runtime.AssumeColonVerbOpt(true)))
+
+	pattern_MultisigService_PostDetachedSignature_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "multisig", "PostDetachedSignature"}, "", runtime.AssumeColonVerbOpt(true)))
 )
 
 var (
@@ -429,4 +471,6 @@ var (
 	forward_MultisigService_GetMultisigAddressesByBlockHeightRange_0 = runtime.ForwardResponseMessage
 
 	forward_MultisigService_GetParticipantsByMultisigAddresses_0 = runtime.ForwardResponseMessage
+
+	forward_MultisigService_PostDetachedSignature_0 = runtime.ForwardResponseMessage
 )
@@ -80,25 +80,31 @@ func init() {
 }
 
 var fileDescriptor_e672968ede58c6fc = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x4e, 0x2d, 0x2a,
-	0xcb, 0x4c, 0x4e, 0xd5, 0x2f, 0x29, 0x4a, 0xcc, 0x2b, 0x4e, 0x4c, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x87, 0x4a, 0x49, 0x89, 0xe7, 0xe6, 0xa7, 0xa4, 0xe6,
-	0x60, 0xaa, 0x90, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f,
-	0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x04, 0x49, 0x16, 0x43, 0x64, 0x8d, 0xa6, 0xb1, 0x70, 0x09, 0x85,
-	0x20, 0xf4, 0x04, 0x43, 0x4c, 0x13, 0xaa, 0xe4, 0xe2, 0x77, 0x4f, 0x2d, 0x41, 0x92, 0x28, 0x16,
-	0x92, 0xd5, 0x03, 0xdb, 0xa0, 0x87, 0x26, 0x1e, 0x94, 0x5a, 0x58, 0x9a, 0x5a, 0x5c, 0x22, 0x25,
-	0x87, 0x4b, 0xba, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0x49, 0xbd, 0xe9, 0xf2, 0x93, 0xc9, 0x4c,
-	0x8a, 0x42, 0xf2, 0xfa, 0x65, 0x86, 0xc8, 0xae, 0xd4, 0x47, 0xb7, 0x27, 0x8b, 0x8b, 0x0f, 0x55,
-	0x48, 0x48, 0x06, 0xab, 0xd1, 0x30, 0x8b, 0x85, 0xa0, 0xb2, 0x48, 0x52, 0x4a, 0x6a, 0x60, 0xcb,
-	0x14, 0x84, 0xe4, 0xf0, 0x5b, 0x06, 0xf2, 0x66, 0x40, 0x7e, 0x31, 0x8a, 0x10, 0xcc, 0x9b, 0x68,
-	0xe2, 0xe8, 0xde, 0xc4, 0x90, 0x46, 0xf5, 0xa6, 0x12, 0x86, 0x37, 0xd1, 0xed, 0x99, 0xcb, 0xc8,
-	0x25, 0x81, 0xea, 0x1a, 0xdf, 0xcc, 0xbc, 0xcc, 0xdc, 0xd2, 0x5c, 0xb7, 0xd4, 0x54, 0x21, 0x35,
-	0xac, 0x3e, 0x46, 0x28, 0x80, 0xb9, 0x46, 0x9d, 0xa0, 0x3a, 0xa8, 0xb3, 0x0c, 0xc0, 0xce, 0xd2,
-	0x52, 0xd2, 0xc0, 0x1f, 0x20, 0x08, 0x9d, 0x4e, 0x3a, 0x51, 0x5a, 0xe9, 0x99, 0x25, 0x19, 0xa5,
-	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x55, 0xf9, 0xf9, 0x49, 0xc9, 0x10, 0x52, 0x37, 0x39, 0xbf,
-	0x28, 0x55, 0x3f, 0x39, 0x3f, 0x37, 0x37, 0x3f, 0x4f, 0x1f, 0x9a, 0xfa, 0x92, 0xd8, 0xc0, 0xa9,
-	0xc9, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x19, 0x8f, 0x5a, 0xaa, 0x02, 0x00, 0x00,
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x85, 0x94, 0xbb, 0x4e, 0xc3, 0x30,
+	0x14, 0x86, 0x55, 0x06, 0x2a, 0x79, 0x00, 0x64, 0x24, 0x2e, 0x51, 0xe9, 0xc5, 0xd0, 0x34, 0x2a,
+	0x10, 0x73, 0x79, 0x03, 0x24, 0x60, 0x42, 0x42, 0x94, 0x89, 0x2d, 0x09, 0x56, 0x30, 0x4a, 0xe2,
+	0x10, 0xbb, 0x95, 0x40, 0x62, 0x61, 0x63, 0x46, 0x8c, 0x3c, 0x08, 0xcf, 0xc1, 0x2b, 0xf0, 0x20,
+	0xa4, 0xae, 0x43, 0x13, 0x27, 0x6d, 0x97, 0x44, 0x39, 0xff, 0x39, 0xfe, 0xbf, 0xdf, 0x4e, 0x02,
+	0xb6, 0x39, 0x49, 0x46, 0xd4, 0x23, 0x58, 0x24, 0x4e, 0xc4, 0x1d, 0x4f, 0x50, 0x16, 0xd9, 0x71,
+	0xc2, 0x04, 0x83, 0x75, 0x25, 0x19, 0x9b, 0x21, 0xbb, 0x27, 0x41, 0xb9, 0xc3, 0x68, 0xf8, 0x8c,
+	0xf9, 0x01, 0xc1, 0x4e, 0x4c, 0xb1, 0x13, 0x45, 0x4c, 0x38, 0x63, 0x91, 0x4f, 0xd4, 0x93, 0xef,
+	0x3a, 0x80, 0xb7, 0xd3, 0x99, 0xc1, 0x64, 0x35, 0xf8, 0x0c, 0x56, 0x2f, 0x89, 0xc8, 0x09, 0x1c,
+	0xee, 0xd8, 0xd2, 0xc1, 0xd6, 0xea, 0x37, 0xe4, 0x69, 0x48, 0xb8, 0x30, 0x9a, 0xb3, 0x64, 0x1e,
+	0xa7, 0x37, 0x82, 0x7a, 0x6f, 0x3f, 0xbf, 0x1f, 0x4b, 0x1d, 0xd8, 0xc2, 0xa3, 0xe3, 0x3c, 0x25,
+	0xd6, 0x7d, 0x1e, 0xc1, 0x4a, 0xb1, 0x04, 0x1b, 0x95, 0x4b, 0x67, 0xc6, 0x50, 0xa9, 0x39, 0x09,
+	0x99, 0xd2, 0xac, 0x0d, 0x9b, 0xf3, 0xcd, 0xc6, 0x31, 0xaf, 0x19, 0x2f, 0x94, 0xb2, 0x98, 0x5a,
+	0x5d, 0x8f, 0x59, 0x92, 0x8b, 0x31, 0x51, 0x29, 0xa6, 0xee, 0xf3, 0x55, 0x03, 0x5b, 0x45, 0x9a,
+	0x2b, 0x1a, 0xd1, 0x70, 0x18, 0x5e, 0x10, 0x02, 0xcd, 0xca, 0xc4, 0xd3, 0x86, 0x8c, 0xa6, 0xb7,
+	0xb0, 0x4f, 0x61, 0x1d, 0x49, 0xac, 0x3e, 0xb2, 0xe6, 0x6f, 0x48, 0x0e, 0xe1, 0xbd, 0x06, 0xd6,
+	0x07, 0xe9, 0x43, 0xe0, 0x08, 0x92, 0xe7, 0xee, 0x28, 0xcb, 0x0a, 0x2d, 0xa3, 0x42, 0xf3, 0x5a,
+	0x14, 0xd0, 0xbe, 0x04, 0xea, 0xa2, 0x5d, 0x1d, 0xa8, 0xca, 0xf3, 0x15, 0xac, 0x69, 0xdb, 0xc7,
+	0xe1, 0x8c, 0x83, 0xf8, 0x7f, 0x1f, 0x5b, 0x33, 0x75, 0x45, 0x60, 0x49, 0x02, 0x84, 0xda, 0x0b,
+	0x4e, 0x8a, 0xc3, 0xcf, 0x1a, 0xd8, 0x38, 0xe7, 0x82, 0x86, 0x45, 0xac, 0xf1, 0x2e, 0xed, 0x29,
+	0x97, 0x6a, 0x39, 0x63, 0xe9, 0x2e, 0xe8, 0x52, 0x44, 0xb6, 0x24, 0xb2, 0xa0, 0xa9, 0x13, 0x55,
+	0xcf, 0x9d, 0x1d, 0xdc, 0xf5, 0x7d, 0x2a, 0x1e, 0x86, 0xae, 0xed, 0xb1, 0x10, 0xbf, 0x30, 0xe6,
+	0x7a, 0x93, 0xeb, 0xa1, 0xc7, 0x12, 0x82, 0xd3, 0x62, 0x98, 0x0e, 0xab, 0x1f, 0x84, 0xbb, 0x2c,
+	0x3f, 0xf8, 0xd3, 0x3f, 0x0e, 0x45, 0xf5, 0x20, 0x4d, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -117,6 +123,9 @@ type TransactionServiceClient interface {
 	GetTransaction(ctx context.Context, in *model.GetTransactionRequest, opts ...grpc.CallOption) (*model.Transaction, error)
 	PostTransaction(ctx context.Context, in *model.PostTransactionRequest, opts ...grpc.CallOption) (*model.PostTransactionResponse, error)
 	GetTransactionMinimumFee(ctx context.Context, in *model.GetTransactionMinimumFeeRequest, opts ...grpc.CallOption) (*model.GetTransactionMinimumFeeResponse, error)
+	SimulateTransaction(ctx context.Context, in *model.SimulateTransactionRequest, opts ...grpc.CallOption) (*model.SimulateTransactionResponse, error)
+	PostTransactions(ctx context.Context, in *model.PostTransactionsRequest, opts ...grpc.CallOption) (*model.PostTransactionsResponse, error)
+	EstimateTransactionFee(ctx context.Context, in *model.EstimateTransactionFeeRequest, opts ...grpc.CallOption) (*model.EstimateTransactionFeeResponse, error)
 }
 
 type transactionServiceClient struct {
@@ -163,12 +172,42 @@ func (c *transactionServiceClient) GetTransactionMinimumFee(ctx context.Context,
 	return out, nil
 }
 
+func (c *transactionServiceClient) SimulateTransaction(ctx context.Context, in *model.SimulateTransactionRequest, opts ...grpc.CallOption) (*model.SimulateTransactionResponse, error) {
+	out := new(model.SimulateTransactionResponse)
+	err := c.cc.Invoke(ctx, "/service.TransactionService/SimulateTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) PostTransactions(ctx context.Context, in *model.PostTransactionsRequest, opts ...grpc.CallOption) (*model.PostTransactionsResponse, error) {
+	out := new(model.PostTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/service.TransactionService/PostTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) EstimateTransactionFee(ctx context.Context, in *model.EstimateTransactionFeeRequest, opts ...grpc.CallOption) (*model.EstimateTransactionFeeResponse, error) {
+	out := new(model.EstimateTransactionFeeResponse)
+	err := c.cc.Invoke(ctx, "/service.TransactionService/EstimateTransactionFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServiceServer is the server API for TransactionService service.
 type TransactionServiceServer interface {
 	GetTransactions(context.Context, *model.GetTransactionsRequest) (*model.GetTransactionsResponse, error)
 	GetTransaction(context.Context, *model.GetTransactionRequest) (*model.Transaction, error)
 	PostTransaction(context.Context, *model.PostTransactionRequest) (*model.PostTransactionResponse, error)
 	GetTransactionMinimumFee(context.Context, *model.GetTransactionMinimumFeeRequest) (*model.GetTransactionMinimumFeeResponse, error)
+	SimulateTransaction(context.Context, *model.SimulateTransactionRequest) (*model.SimulateTransactionResponse, error)
+	PostTransactions(context.Context, *model.PostTransactionsRequest) (*model.PostTransactionsResponse, error)
+	EstimateTransactionFee(context.Context, *model.EstimateTransactionFeeRequest) (*model.EstimateTransactionFeeResponse, error)
 }
 
 // UnimplementedTransactionServiceServer can be embedded to have forward compatible implementations.
@@ -187,6 +226,15 @@ func (*UnimplementedTransactionServiceServer) PostTransaction(ctx context.Contex
 func (*UnimplementedTransactionServiceServer) GetTransactionMinimumFee(ctx context.Context, req *model.GetTransactionMinimumFeeRequest) (*model.GetTransactionMinimumFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionMinimumFee not implemented")
 }
+func (*UnimplementedTransactionServiceServer) SimulateTransaction(ctx context.Context, req *model.SimulateTransactionRequest) (*model.SimulateTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTransaction not implemented")
+}
+func (*UnimplementedTransactionServiceServer) PostTransactions(ctx context.Context, req *model.PostTransactionsRequest) (*model.PostTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostTransactions not implemented")
+}
+func (*UnimplementedTransactionServiceServer) EstimateTransactionFee(ctx context.Context, req *model.EstimateTransactionFeeRequest) (*model.EstimateTransactionFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTransactionFee not implemented")
+}
 
 func RegisterTransactionServiceServer(s *grpc.Server, srv TransactionServiceServer) {
 	s.RegisterService(&_TransactionService_serviceDesc, srv)
@@ -264,6 +312,60 @@ func _TransactionService_GetTransactionMinimumFee_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_SimulateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.SimulateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SimulateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TransactionService/SimulateTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SimulateTransaction(ctx, req.(*model.SimulateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_PostTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.PostTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).PostTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TransactionService/PostTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).PostTransactions(ctx, req.(*model.PostTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_EstimateTransactionFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.EstimateTransactionFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).EstimateTransactionFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.TransactionService/EstimateTransactionFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).EstimateTransactionFee(ctx, req.(*model.EstimateTransactionFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TransactionService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "service.TransactionService",
 	HandlerType: (*TransactionServiceServer)(nil),
@@ -284,6 +386,18 @@ var _TransactionService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactionMinimumFee",
 			Handler:    _TransactionService_GetTransactionMinimumFee_Handler,
 		},
+		{
+			MethodName: "SimulateTransaction",
+			Handler:    _TransactionService_SimulateTransaction_Handler,
+		},
+		{
+			MethodName: "PostTransactions",
+			Handler:    _TransactionService_PostTransactions_Handler,
+		},
+		{
+			MethodName: "EstimateTransactionFee",
+			Handler:    _TransactionService_EstimateTransactionFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service/transaction.proto",
@@ -0,0 +1,275 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package signaturetype
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+
+	"github.com/btcsuite/btcd/btcec"
+	btcecv2 "github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
+)
+
+type (
+	// BitcoinSchnorrSignature represent of bip-340 schnorr signature over the bitcoin curve secp256k1, signing 32 bytes
+	// messages. Signing and verifying are done by btcec, only the batch verification is built here on its curve operations
+	// more: https://github.com/bitcoin/bips/blob/master/bip-0340.mediawiki
+	BitcoinSchnorrSignature struct {
+		Curve *btcec.KoblitzCurve
+	}
+)
+
+const (
+	bip340ChallengeTag = "BIP0340/challenge"
+	bip340MessageSize  = 32
+)
+
+// NewBitcoinSchnorrSignature is new instance of bitcoin schnorr signature
+func NewBitcoinSchnorrSignature(curve *btcec.KoblitzCurve) *BitcoinSchnorrSignature {
+	return &BitcoinSchnorrSignature{
+		Curve: curve,
+	}
+}
+
+// TaggedHash bip-340 tagged hash: sha256(sha256(tag) || sha256(tag) || msgs)
+func (*BitcoinSchnorrSignature) TaggedHash(tag string, msgs ...[]byte) []byte {
+	var (
+		tagHash = sha256.Sum256([]byte(tag))
+		hasher  = sha256.New()
+	)
+	hasher.Write(tagHash[:])
+	hasher.Write(tagHash[:])
+	for _, msg := range msgs {
+		hasher.Write(msg)
+	}
+	return hasher.Sum(nil)
+}
+
+// GetXOnlyPublicKey get the 32 bytes x-only public key of a private key
+func (b *BitcoinSchnorrSignature) GetXOnlyPublicKey(privateKey *btcec.PrivateKey) ([]byte, error) {
+	schnorrPrivateKey, err := b.toSchnorrPrivateKey(privateKey)
+	if err != nil {
+		return nil, blocker.NewBlocker(blocker.AppErr, err.Error())
+	}
+	return schnorr.SerializePubKey(schnorrPrivateKey.PubKey()), nil
+}
+
+// GetXOnlyPublicKeyFromPublicKey get the x-only public key of a compressed or uncompressed public key, the x coordinate
+func (b *BitcoinSchnorrSignature) GetXOnlyPublicKeyFromPublicKey(publicKey []byte) ([]byte, error) {
+	switch len(publicKey) {
+	case constant.BTCXOnlyPublicKeyLength:
+		return publicKey, nil
+	case btcec.PubKeyBytesLenCompressed, btcec.PubKeyBytesLenUncompressed:
+		return publicKey[1 : 1+constant.BTCXOnlyPublicKeyLength], nil
+	default:
+		return nil, blocker.NewBlocker(blocker.ParserErr, "invalidPublicKeyLength")
+	}
+}
+
+// GetPublicKeyFromXOnlyPublicKey get the compressed public key of the even y point an x-only public key stands for
+func (b *BitcoinSchnorrSignature) GetPublicKeyFromXOnlyPublicKey(xOnlyPublicKey []byte) ([]byte, error) {
+	if len(xOnlyPublicKey) != constant.BTCXOnlyPublicKeyLength {
+		return nil, blocker.NewBlocker(blocker.ParserErr, "invalidXOnlyPublicKeyLength")
+	}
+	publicKey, err := schnorr.ParsePubKey(xOnlyPublicKey)
+	if err != nil {
+		return nil, blocker.NewBlocker(blocker.ParserErr, "invalidXOnlyPublicKey")
+	}
+	return publicKey.SerializeCompressed(), nil
+}
+
+// Sign generates the bip-340 schnorr signature (R.x || s) of the 32 bytes message, auxRand is the 32 bytes auxiliary
+// randomness mixed into the nonce, nil for a fresh random one
+func (b *BitcoinSchnorrSignature) Sign(privateKey *btcec.PrivateKey, message, auxRand []byte) ([]byte, error) {
+	var aux [32]byte
+	if len(message) != bip340MessageSize {
+		return nil, blocker.NewBlocker(blocker.AuthErr, "invalidSchnorrMessageLength")
+	}
+	schnorrPrivateKey, err := b.toSchnorrPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	switch auxRand {
+	case nil:
+		if _, err = rand.Read(aux[:]); err != nil {
+			return nil, blocker.NewBlocker(blocker.AuthErr, err.Error())
+		}
+	default:
+		if len(auxRand) != len(aux) {
+			return nil, blocker.NewBlocker(blocker.AuthErr, "invalidSchnorrAuxRandLength")
+		}
+		copy(aux[:], auxRand)
+	}
+	// btcec verifies the signature before returning it
+	signature, err := schnorr.Sign(schnorrPrivateKey, message, schnorr.CustomNonce(aux))
+	if err != nil {
+		return nil, blocker.NewBlocker(blocker.AuthErr, err.Error())
+	}
+	return signature.Serialize(), nil
+}
+
+// Verify to verify the bip-340 schnorr signature of the 32 bytes message using the provided x-only public key
+func (b *BitcoinSchnorrSignature) Verify(xOnlyPublicKey, message, signature []byte) bool {
+	publicKey, err := schnorr.ParsePubKey(xOnlyPublicKey)
+	if err != nil {
+		return false
+	}
+	schnorrSignature, err := schnorr.ParseSignature(signature)
+	if err != nil {
+		return false
+	}
+	return schnorrSignature.Verify(message, publicKey)
+}
+
+// BatchVerify verify many bip-340 schnorr signatures at once, faster than verifying them one by one as the point
+// multiplications share their doublings. It checks (s1 + a2⋅s2 + ... + au⋅su)⋅G = R1 + a2⋅R2 + ... + au⋅Ru + e1⋅P1 +
+// (a2⋅e2)⋅P2 + ... + (au⋅eu)⋅Pu with random a2...au, false when any of the signatures is invalid. Only public values are
+// involved, so the curve operations don't need to be constant time
+func (b *BitcoinSchnorrSignature) BatchVerify(xOnlyPublicKeys, messages, signatures [][]byte) bool {
+	var (
+		sumS           btcecv2.ModNScalar
+		left, right    btcecv2.JacobianPoint
+		scalars        []*btcecv2.ModNScalar
+		points         []*btcecv2.JacobianPoint
+		randomA        [32]byte
+		signatureCount = len(signatures)
+	)
+	if len(xOnlyPublicKeys) != signatureCount || len(messages) != signatureCount {
+		return false
+	}
+	for i := range signatures {
+		var (
+			a, s, e   btcecv2.ModNScalar
+			rx, ry    btcecv2.FieldVal
+			r, p      btcecv2.JacobianPoint
+			ae        btcecv2.ModNScalar
+			publicKey *btcecv2.PublicKey
+			err       error
+		)
+		if len(messages[i]) != bip340MessageSize || len(signatures[i]) != constant.BTCSchnorrSignatureLength {
+			return false
+		}
+		publicKey, err = schnorr.ParsePubKey(xOnlyPublicKeys[i])
+		if err != nil {
+			return false
+		}
+		publicKey.AsJacobian(&p)
+		// R is the even y point of x coordinate r, r < p and s < n
+		if rx.SetByteSlice(signatures[i][:32]) || !btcecv2.DecompressY(&rx, false, &ry) {
+			return false
+		}
+		ry.Normalize()
+		r = btcecv2.MakeJacobianPoint(&rx, &ry, new(btcecv2.FieldVal).SetInt(1))
+		if s.SetByteSlice(signatures[i][32:]) {
+			return false
+		}
+		// a1 = 1, the others are random and not zero
+		a.SetInt(1)
+		for i > 0 && (a.IsZero() || a.Equals(new(btcecv2.ModNScalar).SetInt(1))) {
+			if _, err = rand.Read(randomA[:]); err != nil {
+				return false
+			}
+			a.SetBytes(&randomA)
+		}
+		e.SetByteSlice(b.TaggedHash(bip340ChallengeTag, signatures[i][:32], xOnlyPublicKeys[i], messages[i]))
+		sumS.Add(new(btcecv2.ModNScalar).Mul2(&a, &s))
+		ae.Mul2(&a, &e)
+		scalars = append(scalars, &a, &ae)
+		points = append(points, &r, &p)
+	}
+	btcecv2.ScalarBaseMultNonConst(&sumS, &left)
+	b.multiScalarMult(scalars, points, &right)
+	if left.Z.IsZero() || right.Z.IsZero() {
+		return left.Z.IsZero() && right.Z.IsZero()
+	}
+	left.ToAffine()
+	right.ToAffine()
+	return left.X.Equals(&right.X) && left.Y.Equals(&right.Y)
+}
+
+// toSchnorrPrivateKey the btcec v2 private key schnorr signs with, the private key must be in [1, n-1]
+func (*BitcoinSchnorrSignature) toSchnorrPrivateKey(privateKey *btcec.PrivateKey) (*btcecv2.PrivateKey, error) {
+	var d btcecv2.ModNScalar
+	if privateKey == nil || privateKey.D == nil || privateKey.D.Sign() <= 0 || privateKey.D.BitLen() > 256 {
+		return nil, blocker.NewBlocker(blocker.AuthErr, "invalidPrivateKey")
+	}
+	if isOverflow := d.SetByteSlice(privateKey.D.Bytes()); isOverflow || d.IsZero() {
+		return nil, blocker.NewBlocker(blocker.AuthErr, "invalidPrivateKey")
+	}
+	return btcecv2.PrivKeyFromScalar(&d), nil
+}
+
+// multiScalarMult result = scalars[0]⋅points[0] + ... + scalars[u]⋅points[u], the points sharing the doublings of a single
+// double and add over the bits of the scalars
+func (*BitcoinSchnorrSignature) multiScalarMult(
+	scalars []*btcecv2.ModNScalar,
+	points []*btcecv2.JacobianPoint,
+	result *btcecv2.JacobianPoint,
+) {
+	var scalarsBytes = make([][32]byte, len(scalars))
+	for i, scalar := range scalars {
+		scalarsBytes[i] = scalar.Bytes()
+	}
+	// start from the point at infinity
+	result.X.SetInt(0)
+	result.Y.SetInt(0)
+	result.Z.SetInt(0)
+	for bit := 0; bit < 256; bit++ {
+		btcecv2.DoubleNonConst(result, result)
+		for i := range points {
+			if scalarsBytes[i][bit/8]>>(7-uint(bit%8))&1 == 1 {
+				btcecv2.AddNonConst(result, points[i], result)
+			}
+		}
+	}
+}
@@ -0,0 +1,431 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package signaturetype
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"golang.org/x/crypto/sha3"
+)
+
+// bip-340 test vectors: https://github.com/bitcoin/bips/blob/master/bip-0340/test-vectors.csv
+var (
+	mockSchnorrPrivKey, _   = hex.DecodeString("B7E151628AED2A6ABF7158809CF4F3C762E7160F38B4DA56A784D9045190CFEF")
+	mockSchnorrXOnlyKey, _  = hex.DecodeString("DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659")
+	mockSchnorrAuxRand, _   = hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000001")
+	mockSchnorrPayload, _   = hex.DecodeString("243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89")
+	mockSchnorrSignature, _ = hex.DecodeString("6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE3341" +
+		"8906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A")
+)
+
+func mockSchnorrPrivateKey(keyBytes []byte) *btcec.PrivateKey {
+	privateKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), keyBytes)
+	return privateKey
+}
+
+func TestBitcoinSchnorrSignature_TaggedHash(t *testing.T) {
+	// tagged hash of an empty message with the empty tag, sha256(sha256("") || sha256(""))
+	want := "2dba5dbc339e7316aea2683faf839c1b7b1ee2313db792112588118df066aa35"
+	if got := hex.EncodeToString(NewBitcoinSchnorrSignature(btcec.S256()).TaggedHash("")); got != want {
+		t.Errorf("TaggedHash() = %v, want %v", got, want)
+	}
+}
+
+func TestBitcoinSchnorrSignature_GetXOnlyPublicKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		privateKey []byte
+		want       []byte
+		wantErr    bool
+	}{
+		{
+			name:       "wantError:ZeroPrivateKey",
+			privateKey: make([]byte, 32),
+			wantErr:    true,
+		},
+		{
+			name:       "wantSuccess:EvenY",
+			privateKey: mockSchnorrPrivKey,
+			want:       mockSchnorrXOnlyKey,
+		},
+		{
+			name:       "wantSuccess:OddY",
+			privateKey: mockBitcoinPrivKey32Bytes[:],
+			want:       mockBitcoinPublicKetBytes[1:],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBitcoinSchnorrSignature(btcec.S256())
+			got, err := b.GetXOnlyPublicKey(mockSchnorrPrivateKey(tt.privateKey))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetXOnlyPublicKey() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetXOnlyPublicKey() got = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBitcoinSchnorrSignature_GetXOnlyPublicKeyFromPublicKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		publicKey []byte
+		want      []byte
+		wantErr   bool
+	}{
+		{
+			name:      "wantError:InvalidLength",
+			publicKey: []byte{1, 2, 3},
+			wantErr:   true,
+		},
+		{
+			name:      "wantSuccess:XOnly",
+			publicKey: mockSchnorrXOnlyKey,
+			want:      mockSchnorrXOnlyKey,
+		},
+		{
+			name:      "wantSuccess:Compressed",
+			publicKey: mockBitcoinPublicKetBytes,
+			want:      mockBitcoinPublicKetBytes[1:],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewBitcoinSchnorrSignature(btcec.S256()).GetXOnlyPublicKeyFromPublicKey(tt.publicKey)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetXOnlyPublicKeyFromPublicKey() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetXOnlyPublicKeyFromPublicKey() got = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBitcoinSchnorrSignature_GetPublicKeyFromXOnlyPublicKey(t *testing.T) {
+	notOnCurve, _ := hex.DecodeString("EEFDEA4CDB677750A420FEE807EACF21EB9898AE79B9768766E4FAA04A2D4A34")
+	tests := []struct {
+		name    string
+		xOnly   []byte
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "wantError:InvalidLength",
+			xOnly:   mockBitcoinPublicKetBytes,
+			wantErr: true,
+		},
+		{
+			name:    "wantError:NotOnCurve",
+			xOnly:   notOnCurve,
+			wantErr: true,
+		},
+		{
+			name:  "wantSuccess",
+			xOnly: mockSchnorrXOnlyKey,
+			want:  append([]byte{2}, mockSchnorrXOnlyKey...),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewBitcoinSchnorrSignature(btcec.S256()).GetPublicKeyFromXOnlyPublicKey(tt.xOnly)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetPublicKeyFromXOnlyPublicKey() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetPublicKeyFromXOnlyPublicKey() got = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBitcoinSchnorrSignature_Sign(t *testing.T) {
+	var (
+		vector0PrivKey, _ = hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000003")
+		vector0Sig, _     = hex.DecodeString("E907831F80848D1069A5371B402410364BDF1C5F8307B0084C55F1CE2DCA8215" +
+			"25F66A4A85EA8B71E482A74F382D2CE5EBEEE8FDB2172F477DF4900D310536C0")
+	)
+	type args struct {
+		privateKey []byte
+		payload    []byte
+		auxRand    []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "wantError:ZeroPrivateKey",
+			args: args{
+				privateKey: make([]byte, 32),
+				payload:    mockSchnorrPayload,
+				auxRand:    mockSchnorrAuxRand,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:InvalidPayloadLength",
+			args: args{
+				privateKey: mockSchnorrPrivKey,
+				payload:    []byte{1, 2, 3},
+				auxRand:    mockSchnorrAuxRand,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantSuccess:Vector0",
+			args: args{
+				privateKey: vector0PrivKey,
+				payload:    make([]byte, 32),
+				auxRand:    make([]byte, 32),
+			},
+			want: vector0Sig,
+		},
+		{
+			name: "wantSuccess:Vector1",
+			args: args{
+				privateKey: mockSchnorrPrivKey,
+				payload:    mockSchnorrPayload,
+				auxRand:    mockSchnorrAuxRand,
+			},
+			want: mockSchnorrSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBitcoinSchnorrSignature(btcec.S256())
+			got, err := b.Sign(mockSchnorrPrivateKey(tt.args.privateKey), tt.args.payload, tt.args.auxRand)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Sign() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Sign() got = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBitcoinSchnorrSignature_Verify(t *testing.T) {
+	var (
+		tamperedSignature = append([]byte{}, mockSchnorrSignature...)
+		highS             = append(append([]byte{}, mockSchnorrSignature[:32]...), btcec.S256().Params().N.Bytes()...)
+		oddYKey           = mockSchnorrPrivateKey(mockBitcoinPrivKey32Bytes[:])
+		oddYPayload       = sha3.Sum256([]byte("payload"))
+		oddYSignature, _  = NewBitcoinSchnorrSignature(btcec.S256()).Sign(oddYKey, oddYPayload[:], nil)
+	)
+	tamperedSignature[63] ^= 0x01
+	type args struct {
+		xOnlyPublicKey []byte
+		payload        []byte
+		signature      []byte
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "wantFalse:InvalidSignatureLength",
+			args: args{
+				xOnlyPublicKey: mockSchnorrXOnlyKey,
+				payload:        mockSchnorrPayload,
+				signature:      mockSchnorrSignature[:63],
+			},
+		},
+		{
+			name: "wantFalse:InvalidPublicKey",
+			args: args{
+				xOnlyPublicKey: mockBitcoinPublicKetBytes,
+				payload:        mockSchnorrPayload,
+				signature:      mockSchnorrSignature,
+			},
+		},
+		{
+			name: "wantFalse:OtherPayload",
+			args: args{
+				xOnlyPublicKey: mockSchnorrXOnlyKey,
+				payload:        oddYPayload[:],
+				signature:      mockSchnorrSignature,
+			},
+		},
+		{
+			name: "wantFalse:TamperedSignature",
+			args: args{
+				xOnlyPublicKey: mockSchnorrXOnlyKey,
+				payload:        mockSchnorrPayload,
+				signature:      tamperedSignature,
+			},
+		},
+		{
+			name: "wantFalse:SEqualToCurveOrder",
+			args: args{
+				xOnlyPublicKey: mockSchnorrXOnlyKey,
+				payload:        mockSchnorrPayload,
+				signature:      highS,
+			},
+		},
+		{
+			name: "wantTrue:Vector1",
+			args: args{
+				xOnlyPublicKey: mockSchnorrXOnlyKey,
+				payload:        mockSchnorrPayload,
+				signature:      mockSchnorrSignature,
+			},
+			want: true,
+		},
+		{
+			name: "wantTrue:OddYPrivateKey",
+			args: args{
+				xOnlyPublicKey: mockBitcoinPublicKetBytes[1:],
+				payload:        oddYPayload[:],
+				signature:      oddYSignature,
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBitcoinSchnorrSignature(btcec.S256())
+			if got := b.Verify(tt.args.xOnlyPublicKey, tt.args.payload, tt.args.signature); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBitcoinSchnorrSignature_BatchVerify(t *testing.T) {
+	var (
+		b                                     = NewBitcoinSchnorrSignature(btcec.S256())
+		xOnlyPublicKeys, payloads, signatures [][]byte
+	)
+	for i := byte(1); i <= 5; i++ {
+		var (
+			privateKey = sha3.Sum256([]byte{0, i})
+			payload    = sha3.Sum256([]byte{i})
+		)
+		xOnlyPublicKey, _ := b.GetXOnlyPublicKey(mockSchnorrPrivateKey(privateKey[:]))
+		signature, _ := b.Sign(mockSchnorrPrivateKey(privateKey[:]), payload[:], nil)
+		xOnlyPublicKeys = append(xOnlyPublicKeys, xOnlyPublicKey)
+		payloads = append(payloads, payload[:])
+		signatures = append(signatures, signature)
+	}
+	swappedPayloads := append([][]byte{payloads[1], payloads[0]}, payloads[2:]...)
+	type args struct {
+		xOnlyPublicKeys [][]byte
+		payloads        [][]byte
+		signatures      [][]byte
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "wantFalse:LengthMismatch",
+			args: args{
+				xOnlyPublicKeys: xOnlyPublicKeys,
+				payloads:        payloads[1:],
+				signatures:      signatures,
+			},
+		},
+		{
+			name: "wantFalse:OneInvalidSignature",
+			args: args{
+				xOnlyPublicKeys: append(append([][]byte{}, xOnlyPublicKeys...), mockSchnorrXOnlyKey),
+				payloads:        append(append([][]byte{}, payloads...), mockSchnorrPayload),
+				signatures:      append(append([][]byte{}, signatures...), mockSchnorrSignature[:63]),
+			},
+		},
+		{
+			name: "wantFalse:SwappedPayloads",
+			args: args{
+				xOnlyPublicKeys: xOnlyPublicKeys,
+				payloads:        swappedPayloads,
+				signatures:      signatures,
+			},
+		},
+		{
+			name: "wantTrue:Single",
+			args: args{
+				xOnlyPublicKeys: [][]byte{mockSchnorrXOnlyKey},
+				payloads:        [][]byte{mockSchnorrPayload},
+				signatures:      [][]byte{mockSchnorrSignature},
+			},
+			want: true,
+		},
+		{
+			name: "wantTrue:Many",
+			args: args{
+				xOnlyPublicKeys: append(append([][]byte{}, xOnlyPublicKeys...), mockSchnorrXOnlyKey),
+				payloads:        append(append([][]byte{}, payloads...), mockSchnorrPayload),
+				signatures:      append(append([][]byte{}, signatures...), mockSchnorrSignature),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := b.BatchVerify(tt.args.xOnlyPublicKeys, tt.args.payloads, tt.args.signatures); got != tt.want {
+				t.Errorf("BatchVerify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -171,7 +171,7 @@ func (b *BitcoinSignature) GetPublicKeyFromSeed(
 
 // GetPublicKeyFromPrivateKey get raw public key from private key
 // public key format : https://bitcoin.org/en/wallets-guide#public-key-formats
-func (*BitcoinSignature) GetPublicKeyFromPrivateKey(
+func (b *BitcoinSignature) GetPublicKeyFromPrivateKey(
 	privateKey *btcec.PrivateKey,
 	format model.BitcoinPublicKeyFormat,
 ) ([]byte, error) {
@@ -180,6 +180,8 @@ func (*BitcoinSignature) GetPublicKeyFromPrivateKey(
 		return privateKey.PubKey().SerializeUncompressed(), nil
 	case model.BitcoinPublicKeyFormat_PublicKeyFormatCompressed:
 		return privateKey.PubKey().SerializeCompressed(), nil
+	case model.BitcoinPublicKeyFormat_PublicKeyFormatXOnly:
+		return NewBitcoinSchnorrSignature(b.Curve).GetXOnlyPublicKey(privateKey)
 	default:
 		return nil, blocker.NewBlocker(blocker.AppErr, "invalidPublicKeyFormat")
 	}
@@ -304,6 +304,18 @@ func TestBitcoinSignature_GetPublicKeyFromPrivateKey(t *testing.T) {
 			want:    mockPubKey.SerializeUncompressed(),
 			wantErr: false,
 		},
+		{
+			name: "wantSuccess:XOnlyFormat",
+			fields: fields{
+				Curve: DefaultBitcoinCurve(),
+			},
+			args: args{
+				privateKey: mockPrivateKey,
+				format:     model.BitcoinPublicKeyFormat_PublicKeyFormatXOnly,
+			},
+			want:    mockPubKey.SerializeCompressed()[1:],
+			wantErr: false,
+		},
 		{
 			name:   "wantFail:InvalidFormat",
 			fields: fields{},
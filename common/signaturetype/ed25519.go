@@ -52,9 +52,12 @@ package signaturetype
 import (
 	"bytes"
 	"encoding/base64"
+	"fmt"
+	"github.com/hdevalence/ed25519consensus"
 	"github.com/zoobc/lib/address"
 	slip10 "github.com/zoobc/zoo-slip10"
 	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
 	"golang.org/x/crypto/ed25519"
 	"golang.org/x/crypto/sha3"
 )
@@ -66,6 +69,7 @@ type SignatureTypeInterface interface {
 	Verify(accountPublicKey, payload, signature []byte) bool
 	GetPrivateKeyFromSeed(seed string) []byte
 	GetPrivateKeyFromSeedUseSlip10(seed string) ([]byte, error)
+	GetPrivateKeyFromSeedUseSlip10AccountIndex(seed string, accountIndex uint32) ([]byte, error)
 	GetPublicKeyFromPrivateKeyUseSlip10(privateKey []byte) ([]byte, error)
 	GetPublicKeyFromSeed(seed string) []byte
 	GetAddressFromSeed(prefix, seed string) string
@@ -88,9 +92,26 @@ func (*Ed25519Signature) Sign(accountPrivateKey, payload []byte) []byte {
 	return ed25519.Sign(accountPrivateKey, payload)
 }
 
-// Verify to verify the signature of payload using provided account public key
+// Verify to verify the signature of payload using provided account public key, with the zip-215 rules BatchVerify applies too
+// more: https://zips.z.cash/zip-0215
 func (*Ed25519Signature) Verify(accountPublicKey, payload, signature []byte) bool {
-	return ed25519.Verify(accountPublicKey, payload, signature)
+	return ed25519consensus.Verify(accountPublicKey, payload, signature)
+}
+
+// BatchVerify verify many ed25519 signatures at once, faster than verifying them one by one. It accepts the same
+// signatures Verify accepts, false when any of them is invalid without telling which one
+func (*Ed25519Signature) BatchVerify(publicKeys, payloads, signatures [][]byte) bool {
+	if len(publicKeys) != len(signatures) || len(payloads) != len(signatures) {
+		return false
+	}
+	if len(signatures) == 0 {
+		return true
+	}
+	batchVerifier := ed25519consensus.NewPreallocatedBatchVerifier(len(signatures))
+	for i := range signatures {
+		batchVerifier.Add(publicKeys[i], payloads[i], signatures[i])
+	}
+	return batchVerifier.Verify()
 }
 
 // GetPrivateKeyFromSeed to get private key form seed
@@ -117,6 +138,28 @@ func (*Ed25519Signature) GetPrivateKeyFromSeedUseSlip10(seed string) ([]byte, er
 	return slip10Key.Key, nil
 }
 
+// GetPrivateKeyFromSeedUseSlip10AccountIndex generate the private key of the account at accountIndex of a hdwallet seed,
+// the account 0 is the one of GetPrivateKeyFromSeedUseSlip10
+func (*Ed25519Signature) GetPrivateKeyFromSeedUseSlip10AccountIndex(seed string, accountIndex uint32) ([]byte, error) {
+	path, err := GetSlip10AccountPath(accountIndex)
+	if err != nil {
+		return nil, err
+	}
+	slip10Key, err := slip10.DeriveForPath(path, slip10.NewSeed(seed, slip10.DefaultPassword))
+	if err != nil {
+		return nil, err
+	}
+	return slip10Key.Key, nil
+}
+
+// GetSlip10AccountPath return the m/44'/883'/accountIndex' slip10 path of a hdwallet account
+func GetSlip10AccountPath(accountIndex uint32) (string, error) {
+	if accountIndex >= constant.BIP32HardenedKeyStart {
+		return "", blocker.NewBlocker(blocker.ValidationErr, "InvalidSlip10AccountIndex")
+	}
+	return fmt.Sprintf(constant.ZoobcSlip10AccountPathFormat, accountIndex), nil
+}
+
 // GetPublicKeyFromPrivateKeyUseSlip10 get pubic key from slip10 private key
 func (*Ed25519Signature) GetPublicKeyFromPrivateKeyUseSlip10(privateKey []byte) ([]byte, error) {
 	var (
@@ -50,6 +50,7 @@
 package signaturetype
 
 import (
+	"encoding/hex"
 	"reflect"
 	"testing"
 
@@ -334,3 +335,187 @@ func TestEd25519Signature_GetPrivateKeyFromSeedUseSlip10(t *testing.T) {
 		})
 	}
 }
+
+func TestEd25519Signature_GetPrivateKeyFromSeedUseSlip10AccountIndex(t *testing.T) {
+	type args struct {
+		seed         string
+		accountIndex uint32
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "wantSuccess:PrimaryAccount",
+			args: args{
+				seed:         ed25519MockSeed,
+				accountIndex: 0,
+			},
+			want: []byte{28, 54, 122, 202, 22, 213, 226, 171, 212, 4, 201, 23, 18, 83, 234, 116,
+				168, 202, 38, 81, 62, 84, 121, 59, 175, 165, 81, 161, 131, 173, 227, 20},
+			wantErr: false,
+		},
+		{
+			name: "wantSuccess:SecondAccount",
+			args: args{
+				seed:         ed25519MockSeed,
+				accountIndex: 1,
+			},
+			want: []byte{166, 109, 185, 69, 217, 97, 53, 87, 2, 146, 235, 225, 242, 213, 116, 93,
+				50, 212, 255, 74, 19, 153, 206, 61, 244, 132, 151, 158, 156, 249, 228, 237},
+			wantErr: false,
+		},
+		{
+			name: "wantErr:HardenedAccountIndex",
+			args: args{
+				seed:         ed25519MockSeed,
+				accountIndex: 1 << 31,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &Ed25519Signature{}
+			got, err := e.GetPrivateKeyFromSeedUseSlip10AccountIndex(tt.args.seed, tt.args.accountIndex)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Ed25519Signature.GetPrivateKeyFromSeedUseSlip10AccountIndex() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Ed25519Signature.GetPrivateKeyFromSeedUseSlip10AccountIndex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEd25519Signature_BatchVerify(t *testing.T) {
+	var (
+		es                               = NewEd25519Signature()
+		publicKeys, payloads, signatures [][]byte
+	)
+	for _, seed := range []string{ed25519MockSeed, "concur vocalist rotten busload gap quote stinging undiluted surfer",
+		"goofiness deviation starved compile fernlike laptop scouring bobsled"} {
+		payload := []byte(seed)
+		publicKeys = append(publicKeys, es.GetPublicKeyFromSeed(seed))
+		payloads = append(payloads, payload)
+		signatures = append(signatures, es.Sign(es.GetPrivateKeyFromSeed(seed), payload))
+	}
+	type args struct {
+		publicKeys, payloads, signatures [][]byte
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "wantTrue:Many",
+			args: args{publicKeys: publicKeys, payloads: payloads, signatures: signatures},
+			want: true,
+		},
+		{
+			name: "wantTrue:Empty",
+			args: args{},
+			want: true,
+		},
+		{
+			name: "wantFalse:LengthMismatch",
+			args: args{publicKeys: publicKeys, payloads: payloads[1:], signatures: signatures},
+			want: false,
+		},
+		{
+			name: "wantFalse:SwappedPayloads",
+			args: args{
+				publicKeys: publicKeys,
+				payloads:   [][]byte{payloads[1], payloads[0], payloads[2]},
+				signatures: signatures,
+			},
+			want: false,
+		},
+		{
+			name: "wantFalse:InvalidPublicKeyLength",
+			args: args{
+				publicKeys: [][]byte{publicKeys[0][1:]},
+				payloads:   payloads[:1],
+				signatures: signatures[:1],
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := es.BatchVerify(tt.args.publicKeys, tt.args.payloads, tt.args.signatures); got != tt.want {
+				t.Errorf("Ed25519Signature.BatchVerify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestEd25519Signature_VerifyMatchesBatchVerify the single and the batch verification must accept the same signatures,
+// including the ones with small order components that a cofactorless single verification would reject
+func TestEd25519Signature_VerifyMatchesBatchVerify(t *testing.T) {
+	var (
+		es        = NewEd25519Signature()
+		payload   = []byte("Zcash")
+		privKey   = es.GetPrivateKeyFromSeed(ed25519MockSeed)
+		signature = es.Sign(privKey, payload)
+		// zip-215 vector: identity public key, small order R and s = 0
+		smallOrderPublicKey, _ = hex.DecodeString("0100000000000000000000000000000000000000000000000000000000000000")
+		smallOrderSignature, _ = hex.DecodeString("c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a" +
+			"0000000000000000000000000000000000000000000000000000000000000000")
+		nonCanonicalS = append([]byte{}, signature...)
+	)
+	nonCanonicalS[63] |= 0xF0
+	tests := []struct {
+		name      string
+		publicKey []byte
+		payload   []byte
+		signature []byte
+		want      bool
+	}{
+		{
+			name:      "wantTrue:Honest",
+			publicKey: ed25519MockPublicKey,
+			payload:   payload,
+			signature: signature,
+			want:      true,
+		},
+		{
+			name:      "wantTrue:SmallOrderComponents",
+			publicKey: smallOrderPublicKey,
+			payload:   payload,
+			signature: smallOrderSignature,
+			want:      true,
+		},
+		{
+			name:      "wantFalse:OtherPayload",
+			publicKey: ed25519MockPublicKey,
+			payload:   []byte("Zcas"),
+			signature: signature,
+			want:      false,
+		},
+		{
+			name:      "wantFalse:NonCanonicalS",
+			publicKey: ed25519MockPublicKey,
+			payload:   payload,
+			signature: nonCanonicalS,
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := es.Verify(tt.publicKey, tt.payload, tt.signature); got != tt.want {
+				t.Errorf("Ed25519Signature.Verify() = %v, want %v", got, tt.want)
+			}
+			got := es.BatchVerify([][]byte{ed25519MockPublicKey, tt.publicKey}, [][]byte{payload, tt.payload},
+				[][]byte{signature, tt.signature})
+			if got != tt.want {
+				t.Errorf("Ed25519Signature.BatchVerify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package signaturetype
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
+)
+
+type (
+	// EthereumSignature represent of ethereum signature, the secp256k1 keys being derived from a bip39 mnemonic
+	// along the bip44 path of the ethereum wallets
+	EthereumSignature struct{}
+)
+
+// NewEthereumSignature is new instance of ethereum signature
+func NewEthereumSignature() *EthereumSignature {
+	return &EthereumSignature{}
+}
+
+// GetEthereumDerivationPath return the m/44'/60'/account'/0/index bip44 path of the ethereum wallets
+func GetEthereumDerivationPath(account, index uint32) []uint32 {
+	return []uint32{
+		constant.EthereumBIP44Purpose,
+		constant.EthereumBIP44CoinType,
+		constant.BIP32HardenedKeyStart + account,
+		0,
+		index,
+	}
+}
+
+// GetPrivateKeyFromSeed derive the private key of path from a bip39 mnemonic and its optional password
+func (es *EthereumSignature) GetPrivateKeyFromSeed(mnemonic, password string, path []uint32) ([]byte, error) {
+	seedBytes, err := bip39.NewSeedWithErrorChecking(mnemonic, password)
+	if err != nil {
+		return nil, blocker.NewBlocker(blocker.AppErr, err.Error())
+	}
+	return es.derivePrivateKey(seedBytes, path)
+}
+
+// derivePrivateKey bip32 private parent key to private child key derivation from the master key of seedBytes,
+// done here as hdkeychain of the btcutil version in use drops the leading zeros of the private keys it derives
+func (*EthereumSignature) derivePrivateKey(seedBytes []byte, path []uint32) ([]byte, error) {
+	var (
+		curve = btcec.S256()
+		mac   = hmac.New(sha512.New, []byte("Bitcoin seed"))
+	)
+	_, _ = mac.Write(seedBytes)
+	sum := mac.Sum(nil)
+	key, chainCode := sum[:32], sum[32:]
+	keyNum := new(big.Int).SetBytes(key)
+	if keyNum.Sign() == 0 || keyNum.Cmp(curve.N) >= 0 {
+		return nil, blocker.NewBlocker(blocker.AppErr, "InvalidMasterKey")
+	}
+	for _, index := range path {
+		var data []byte
+		if index >= constant.BIP32HardenedKeyStart {
+			data = append([]byte{0}, key...)
+		} else {
+			_, publicKey := btcec.PrivKeyFromBytes(curve, key)
+			data = publicKey.SerializeCompressed()
+		}
+		indexBytes := make([]byte, 4)
+		binary.BigEndian.PutUint32(indexBytes, index)
+		data = append(data, indexBytes...)
+
+		mac = hmac.New(sha512.New, chainCode)
+		_, _ = mac.Write(data)
+		sum = mac.Sum(nil)
+		childNum := new(big.Int).SetBytes(sum[:32])
+		if childNum.Cmp(curve.N) >= 0 {
+			return nil, blocker.NewBlocker(blocker.AppErr, "InvalidChildKey")
+		}
+		childNum.Add(childNum, keyNum)
+		childNum.Mod(childNum, curve.N)
+		if childNum.Sign() == 0 {
+			return nil, blocker.NewBlocker(blocker.AppErr, "InvalidChildKey")
+		}
+		keyNum = childNum
+		key = math.PaddedBigBytes(keyNum, 32)
+		chainCode = sum[32:]
+	}
+	return key, nil
+}
+
+// GetPublicKeyFromPrivateKey return the 64 bytes uncompressed public key of privateKey, without its 0x04 prefix
+func (*EthereumSignature) GetPublicKeyFromPrivateKey(privateKey []byte) ([]byte, error) {
+	ecdsaPrivateKey, err := crypto.ToECDSA(privateKey)
+	if err != nil {
+		return nil, blocker.NewBlocker(blocker.AppErr, err.Error())
+	}
+	return crypto.FromECDSAPub(&ecdsaPrivateKey.PublicKey)[1:], nil
+}
+
+// GetAddressFromPublicKey return the hex encoded 20 bytes address of a 64 bytes public key
+func (*EthereumSignature) GetAddressFromPublicKey(publicKey []byte) string {
+	return hexutil.Encode(crypto.Keccak256(publicKey)[12:])
+}
+
+// Sign to generates the 65 bytes [R || S || V] signature of the keccak256 hash of payload
+func (*EthereumSignature) Sign(privateKey, payload []byte) ([]byte, error) {
+	ecdsaPrivateKey, err := crypto.ToECDSA(privateKey)
+	if err != nil {
+		return nil, blocker.NewBlocker(blocker.AppErr, err.Error())
+	}
+	signature, err := crypto.Sign(crypto.Keccak256(payload), ecdsaPrivateKey)
+	if err != nil {
+		return nil, blocker.NewBlocker(blocker.AuthErr, err.Error())
+	}
+	return signature, nil
+}
+
+// GetPublicKeyFromSignature recover the 64 bytes public key that signed payload,
+// the recovery id of the signature can be either 0/1 or 27/28 as set by the ethereum wallets
+func (*EthereumSignature) GetPublicKeyFromSignature(payload, signature []byte) ([]byte, error) {
+	if len(signature) != constant.EthereumSignatureLength {
+		return nil, blocker.NewBlocker(blocker.ValidationErr, "InvalidSignatureLength")
+	}
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	publicKey, err := crypto.Ecrecover(crypto.Keccak256(payload), sig)
+	if err != nil {
+		return nil, blocker.NewBlocker(blocker.ValidationErr, err.Error())
+	}
+	return publicKey[1:], nil
+}
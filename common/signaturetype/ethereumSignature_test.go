@@ -0,0 +1,234 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package signaturetype
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+
+	"github.com/zoobc/zoobc-core/common/constant"
+)
+
+var (
+	mockEthereumMnemonic   = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	mockEthereumPrivKey, _ = hex.DecodeString("1ab42cc412b618bdea3a599e3c9bae199ebf030895b039e9db1e30dafb12b727")
+	mockEthereumPubKey, _  = hex.DecodeString("37b0bb7a8288d38ed49a524b5dc98cff3eb5ca824c9f9dc0dfdb3d9cd600f299" +
+		"a6179912b7451c09896c4098eca7ce6b2e58330672795e847c4d6af44e024230")
+	mockEthereumAddress = "0x9858effd232b4033e47d90003d41ec34ecaeda94"
+)
+
+func TestGetEthereumDerivationPath(t *testing.T) {
+	want := []uint32{
+		constant.BIP32HardenedKeyStart + 44,
+		constant.BIP32HardenedKeyStart + 60,
+		constant.BIP32HardenedKeyStart + 1,
+		0,
+		2,
+	}
+	if got := GetEthereumDerivationPath(1, 2); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetEthereumDerivationPath() = %v, want %v", got, want)
+	}
+}
+
+func TestEthereumSignature_GetPrivateKeyFromSeed(t *testing.T) {
+	type args struct {
+		mnemonic string
+		password string
+		path     []uint32
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "wantError:InvalidMnemonic",
+			args: args{
+				mnemonic: "abandon abandon abandon",
+				path:     GetEthereumDerivationPath(0, 0),
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantSuccess",
+			args: args{
+				mnemonic: mockEthereumMnemonic,
+				path:     GetEthereumDerivationPath(0, 0),
+			},
+			want: hex.EncodeToString(mockEthereumPrivKey),
+		},
+		{
+			name: "wantSuccess:AddressIndex",
+			args: args{
+				mnemonic: mockEthereumMnemonic,
+				path:     GetEthereumDerivationPath(0, 1),
+			},
+			want: "9a983cb3d832fbde5ab49d692b7a8bf5b5d232479c99333d0fc8e1d21f1b55b6",
+		},
+		{
+			name: "wantSuccess:Password",
+			args: args{
+				mnemonic: mockEthereumMnemonic,
+				password: "zoobc",
+				path:     GetEthereumDerivationPath(0, 0),
+			},
+			want: "25ba9c98e8bd95af51a750090052114d630a89c265d91211011736d5ff1b3464",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewEthereumSignature().GetPrivateKeyFromSeed(tt.args.mnemonic, tt.args.password, tt.args.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EthereumSignature.GetPrivateKeyFromSeed() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if hex.EncodeToString(got) != tt.want {
+				t.Errorf("EthereumSignature.GetPrivateKeyFromSeed() = %x, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEthereumSignature_derivePrivateKey(t *testing.T) {
+	// bip32 test vector 1, chain m/0'/1
+	seedBytes, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	want := "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"
+	got, err := NewEthereumSignature().derivePrivateKey(seedBytes, []uint32{constant.BIP32HardenedKeyStart, 1})
+	if err != nil {
+		t.Errorf("EthereumSignature.derivePrivateKey() error = %v", err)
+		return
+	}
+	if hex.EncodeToString(got) != want {
+		t.Errorf("EthereumSignature.derivePrivateKey() = %x, want %v", got, want)
+	}
+}
+
+func TestEthereumSignature_GetPublicKeyFromPrivateKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		privateKey []byte
+		want       []byte
+		wantErr    bool
+	}{
+		{
+			name:       "wantError:InvalidPrivateKey",
+			privateKey: []byte{1, 2, 3},
+			wantErr:    true,
+		},
+		{
+			name:       "wantSuccess",
+			privateKey: mockEthereumPrivKey,
+			want:       mockEthereumPubKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewEthereumSignature().GetPublicKeyFromPrivateKey(tt.privateKey)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EthereumSignature.GetPublicKeyFromPrivateKey() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EthereumSignature.GetPublicKeyFromPrivateKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEthereumSignature_GetAddressFromPublicKey(t *testing.T) {
+	if got := NewEthereumSignature().GetAddressFromPublicKey(mockEthereumPubKey); got != mockEthereumAddress {
+		t.Errorf("EthereumSignature.GetAddressFromPublicKey() = %v, want %v", got, mockEthereumAddress)
+	}
+}
+
+func TestEthereumSignature_GetPublicKeyFromSignature(t *testing.T) {
+	var (
+		payload      = []byte("zoobc")
+		signature, _ = NewEthereumSignature().Sign(mockEthereumPrivKey, payload)
+		// the same signature with the recovery id set by the ethereum wallets
+		walletSignature = append(append([]byte{}, signature[:64]...), signature[64]+27)
+	)
+	tests := []struct {
+		name      string
+		signature []byte
+		want      []byte
+		wantErr   bool
+	}{
+		{
+			name:      "wantError:InvalidSignatureLength",
+			signature: signature[:64],
+			wantErr:   true,
+		},
+		{
+			name:      "wantSuccess",
+			signature: signature,
+			want:      mockEthereumPubKey,
+		},
+		{
+			name:      "wantSuccess:WalletRecoveryID",
+			signature: walletSignature,
+			want:      mockEthereumPubKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewEthereumSignature().GetPublicKeyFromSignature(payload, tt.signature)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EthereumSignature.GetPublicKeyFromSignature() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EthereumSignature.GetPublicKeyFromSignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package signaturetype
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
+)
+
+type (
+	// WebAuthnSignature represent of webauthn signature, the assertion of a P-256 authenticator key over the signed payload
+	// used as the challenge of the client data
+	WebAuthnSignature struct{}
+	// WebAuthnAssertion the parts of a webauthn assertion the authenticator signature covers
+	WebAuthnAssertion struct {
+		AuthenticatorData []byte
+		ClientDataJSON    []byte
+		Signature         []byte
+	}
+	// webAuthnClientData fields of the client data json checked by the verification
+	webAuthnClientData struct {
+		Type      string `json:"type"`
+		Challenge string `json:"challenge"`
+	}
+	// ecdsaSignature ASN.1 DER structure of the ECDSA signatures returned by the authenticators
+	ecdsaSignature struct {
+		R, S *big.Int
+	}
+)
+
+const (
+	// webAuthnAssertionType client data type of the assertions (navigator.credentials.get)
+	webAuthnAssertionType = "webauthn.get"
+	// webAuthnAuthenticatorDataMinLength rpIdHash (32 bytes) + flags (1 byte) + signCount (4 bytes)
+	webAuthnAuthenticatorDataMinLength = 37
+	// webAuthnFlagUserPresent flag set by the authenticator when the user is present
+	webAuthnFlagUserPresent = 0x01
+	// webAuthnPartLength length of the 2 bytes little endian length prefixing the assertion parts
+	webAuthnPartLength = 2
+)
+
+// webAuthnHalfOrder half the order of P-256, the greatest S of a signature, as N-S is valid too the higher S are rejected to
+// keep the signed transactions, identified by the hash of their bytes, from being malleable
+var webAuthnHalfOrder = new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+
+// NewWebAuthnSignature is new instance of webauthn signature
+func NewWebAuthnSignature() *WebAuthnSignature {
+	return &WebAuthnSignature{}
+}
+
+// ParseAssertion parse the signature bytes of a webauthn assertion:
+// authenticatorData length (2 bytes) + authenticatorData + clientDataJSON length (2 bytes) + clientDataJSON + DER signature
+func (*WebAuthnSignature) ParseAssertion(signature []byte) (*WebAuthnAssertion, error) {
+	var (
+		assertion WebAuthnAssertion
+		buffer    = bytes.NewBuffer(signature)
+		readPart  = func() ([]byte, error) {
+			lengthBytes := buffer.Next(webAuthnPartLength)
+			if len(lengthBytes) < webAuthnPartLength {
+				return nil, blocker.NewBlocker(blocker.ParserErr, "InvalidWebAuthnAssertion")
+			}
+			length := int(binary.LittleEndian.Uint16(lengthBytes))
+			part := buffer.Next(length)
+			if len(part) < length {
+				return nil, blocker.NewBlocker(blocker.ParserErr, "InvalidWebAuthnAssertion")
+			}
+			return part, nil
+		}
+		err error
+	)
+	if len(signature) > constant.WebAuthnMaxSignatureLength {
+		return nil, blocker.NewBlocker(blocker.ParserErr, "WebAuthnAssertionTooLong")
+	}
+	assertion.AuthenticatorData, err = readPart()
+	if err != nil {
+		return nil, err
+	}
+	assertion.ClientDataJSON, err = readPart()
+	if err != nil {
+		return nil, err
+	}
+	assertion.Signature = buffer.Bytes()
+	return &assertion, nil
+}
+
+// BuildAssertion concatenate the parts of a webauthn assertion to the signature bytes ParseAssertion reads
+func (*WebAuthnSignature) BuildAssertion(assertion *WebAuthnAssertion) []byte {
+	var (
+		buffer      = bytes.NewBuffer([]byte{})
+		lengthBytes = make([]byte, webAuthnPartLength)
+	)
+	binary.LittleEndian.PutUint16(lengthBytes, uint16(len(assertion.AuthenticatorData)))
+	buffer.Write(lengthBytes)
+	buffer.Write(assertion.AuthenticatorData)
+	binary.LittleEndian.PutUint16(lengthBytes, uint16(len(assertion.ClientDataJSON)))
+	buffer.Write(lengthBytes)
+	buffer.Write(assertion.ClientDataJSON)
+	buffer.Write(assertion.Signature)
+	return buffer.Bytes()
+}
+
+// Verify check that signature is a webauthn assertion of the uncompressed P-256 publicKey with payload as challenge:
+// the client data is an assertion of the base64url encoded payload, the user was present and the authenticator signed
+// authenticatorData || sha256(clientDataJSON). S must be at most N/2, the wallet replaces the S of the authenticator
+// signature by N-S when it is greater
+func (wa *WebAuthnSignature) Verify(publicKey, payload, signature []byte) error {
+	var (
+		clientData webAuthnClientData
+		ecdsaSig   ecdsaSignature
+		key        ecdsa.PublicKey
+	)
+	assertion, err := wa.ParseAssertion(signature)
+	if err != nil {
+		return err
+	}
+	if len(assertion.AuthenticatorData) < webAuthnAuthenticatorDataMinLength {
+		return blocker.NewBlocker(blocker.ValidationErr, "InvalidWebAuthnAuthenticatorData")
+	}
+	if assertion.AuthenticatorData[32]&webAuthnFlagUserPresent == 0 {
+		return blocker.NewBlocker(blocker.ValidationErr, "WebAuthnUserNotPresent")
+	}
+	err = json.Unmarshal(assertion.ClientDataJSON, &clientData)
+	if err != nil {
+		return blocker.NewBlocker(blocker.ValidationErr, "InvalidWebAuthnClientData")
+	}
+	if clientData.Type != webAuthnAssertionType {
+		return blocker.NewBlocker(blocker.ValidationErr, "InvalidWebAuthnClientDataType")
+	}
+	if clientData.Challenge != base64.RawURLEncoding.EncodeToString(payload) {
+		return blocker.NewBlocker(blocker.ValidationErr, "WebAuthnChallengeMismatch")
+	}
+	rest, err := asn1.Unmarshal(assertion.Signature, &ecdsaSig)
+	if err != nil || len(rest) > 0 || ecdsaSig.R == nil || ecdsaSig.S == nil {
+		return blocker.NewBlocker(blocker.ValidationErr, "InvalidWebAuthnSignatureEncoding")
+	}
+	if ecdsaSig.S.Cmp(webAuthnHalfOrder) > 0 {
+		return blocker.NewBlocker(blocker.ValidationErr, "WebAuthnSignatureHighS")
+	}
+	key.Curve = elliptic.P256()
+	key.X, key.Y = elliptic.Unmarshal(key.Curve, publicKey)
+	if key.X == nil {
+		return blocker.NewBlocker(blocker.ValidationErr, "InvalidWebAuthnPublicKey")
+	}
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	signedDataHash := sha256.Sum256(append(append([]byte{}, assertion.AuthenticatorData...), clientDataHash[:]...))
+	if !ecdsa.Verify(&key, signedDataHash[:], ecdsaSig.R, ecdsaSig.S) {
+		return blocker.NewBlocker(blocker.ValidationErr, "InvalidSignature")
+	}
+	return nil
+}
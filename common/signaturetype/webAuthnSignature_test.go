@@ -0,0 +1,312 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package signaturetype
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+var (
+	mockWebAuthnPayload    = sha256.Sum256([]byte("mock transaction bytes"))
+	mockWebAuthnPrivateKey = func() *ecdsa.PrivateKey {
+		key := &ecdsa.PrivateKey{D: big.NewInt(0xc0ffee)}
+		key.PublicKey.Curve = elliptic.P256()
+		key.PublicKey.X, key.PublicKey.Y = key.PublicKey.Curve.ScalarBaseMult(key.D.Bytes())
+		return key
+	}()
+	mockWebAuthnPublicKey = elliptic.Marshal(elliptic.P256(), mockWebAuthnPrivateKey.X, mockWebAuthnPrivateKey.Y)
+	// rpIdHash + flags (user present and verified) + signCount
+	mockWebAuthnAuthenticatorData = append(make([]byte, 32), 0x05, 0, 0, 0, 1)
+)
+
+func mockWebAuthnClientData(assertionType string, challenge []byte) []byte {
+	return []byte(`{"type":"` + assertionType + `","challenge":"` + base64.RawURLEncoding.EncodeToString(challenge) +
+		`","origin":"https://wallet.zoobc.com","crossOrigin":false}`)
+}
+
+// mockWebAuthnAssertion sign authenticatorData || sha256(clientDataJSON) as an authenticator does, with S normalized to the
+// lower half of the curve order as the wallet does
+func mockWebAuthnAssertion(t *testing.T, authenticatorData, clientDataJSON []byte) []byte {
+	return mockWebAuthnAssertionS(t, authenticatorData, clientDataJSON, false, nil)
+}
+
+// mockWebAuthnAssertionS mockWebAuthnAssertion with S in the higher half of the curve order when highS, trailing appended
+// to the DER signature
+func mockWebAuthnAssertionS(t *testing.T, authenticatorData, clientDataJSON []byte, highS bool, trailing []byte) []byte {
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signedDataHash := sha256.Sum256(append(append([]byte{}, authenticatorData...), clientDataHash[:]...))
+	r, s, err := ecdsa.Sign(rand.Reader, mockWebAuthnPrivateKey, signedDataHash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (s.Cmp(webAuthnHalfOrder) > 0) != highS {
+		s.Sub(elliptic.P256().Params().N, s)
+	}
+	signature, err := asn1.Marshal(ecdsaSignature{R: r, S: s})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewWebAuthnSignature().BuildAssertion(&WebAuthnAssertion{
+		AuthenticatorData: authenticatorData,
+		ClientDataJSON:    clientDataJSON,
+		Signature:         append(signature, trailing...),
+	})
+}
+
+func TestWebAuthnSignature_ParseAssertion(t *testing.T) {
+	assertion := &WebAuthnAssertion{
+		AuthenticatorData: mockWebAuthnAuthenticatorData,
+		ClientDataJSON:    mockWebAuthnClientData("webauthn.get", mockWebAuthnPayload[:]),
+		Signature:         []byte{48, 6, 2, 1, 1, 2, 1, 2},
+	}
+	tests := []struct {
+		name      string
+		signature []byte
+		want      *WebAuthnAssertion
+		wantErr   bool
+	}{
+		{
+			name:      "wantError:Empty",
+			signature: []byte{},
+			wantErr:   true,
+		},
+		{
+			name:      "wantError:AuthenticatorDataTruncated",
+			signature: []byte{37, 0, 1, 2, 3},
+			wantErr:   true,
+		},
+		{
+			name:      "wantError:ClientDataTruncated",
+			signature: append([]byte{1, 0, 1}, 10, 0, 1),
+			wantErr:   true,
+		},
+		{
+			name:      "wantError:TooLong",
+			signature: append(NewWebAuthnSignature().BuildAssertion(assertion), make([]byte, 1024)...),
+			wantErr:   true,
+		},
+		{
+			name:      "wantSuccess",
+			signature: NewWebAuthnSignature().BuildAssertion(assertion),
+			want:      assertion,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewWebAuthnSignature().ParseAssertion(tt.signature)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseAssertion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseAssertion() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebAuthnSignature_Verify(t *testing.T) {
+	var (
+		validClientData   = mockWebAuthnClientData("webauthn.get", mockWebAuthnPayload[:])
+		validSignature    = mockWebAuthnAssertion(t, mockWebAuthnAuthenticatorData, validClientData)
+		tamperedSignature = append([]byte{}, validSignature...)
+		otherPublicKey    = elliptic.Marshal(elliptic.P256(), elliptic.P256().Params().Gx, elliptic.P256().Params().Gy)
+	)
+	tamperedSignature[2+32] ^= 0x04
+	type args struct {
+		publicKey []byte
+		payload   []byte
+		signature []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "wantError:MalformedAssertion",
+			args: args{
+				publicKey: mockWebAuthnPublicKey,
+				payload:   mockWebAuthnPayload[:],
+				signature: []byte{1, 2, 3},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:AuthenticatorDataTooShort",
+			args: args{
+				publicKey: mockWebAuthnPublicKey,
+				payload:   mockWebAuthnPayload[:],
+				signature: mockWebAuthnAssertion(t, mockWebAuthnAuthenticatorData[:33], validClientData),
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:UserNotPresent",
+			args: args{
+				publicKey: mockWebAuthnPublicKey,
+				payload:   mockWebAuthnPayload[:],
+				signature: mockWebAuthnAssertion(t, append(make([]byte, 32), 0x04, 0, 0, 0, 1), validClientData),
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:InvalidClientData",
+			args: args{
+				publicKey: mockWebAuthnPublicKey,
+				payload:   mockWebAuthnPayload[:],
+				signature: mockWebAuthnAssertion(t, mockWebAuthnAuthenticatorData, []byte("{")),
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:WrongClientDataType",
+			args: args{
+				publicKey: mockWebAuthnPublicKey,
+				payload:   mockWebAuthnPayload[:],
+				signature: mockWebAuthnAssertion(t, mockWebAuthnAuthenticatorData,
+					mockWebAuthnClientData("webauthn.create", mockWebAuthnPayload[:])),
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:WrongChallenge",
+			args: args{
+				publicKey: mockWebAuthnPublicKey,
+				payload:   mockWebAuthnPayload[:],
+				signature: mockWebAuthnAssertion(t, mockWebAuthnAuthenticatorData,
+					mockWebAuthnClientData("webauthn.get", []byte("another transaction hash"))),
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:InvalidSignatureEncoding",
+			args: args{
+				publicKey: mockWebAuthnPublicKey,
+				payload:   mockWebAuthnPayload[:],
+				signature: NewWebAuthnSignature().BuildAssertion(&WebAuthnAssertion{
+					AuthenticatorData: mockWebAuthnAuthenticatorData,
+					ClientDataJSON:    validClientData,
+					Signature:         []byte{1, 2, 3},
+				}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:TrailingSignatureBytes",
+			args: args{
+				publicKey: mockWebAuthnPublicKey,
+				payload:   mockWebAuthnPayload[:],
+				signature: mockWebAuthnAssertionS(t, mockWebAuthnAuthenticatorData, validClientData, false, []byte{0}),
+			},
+			wantErr: true,
+		},
+		{
+			// N-S verifies too with ecdsa, accepting it would give a second valid transaction with another id
+			name: "wantError:HighS",
+			args: args{
+				publicKey: mockWebAuthnPublicKey,
+				payload:   mockWebAuthnPayload[:],
+				signature: mockWebAuthnAssertionS(t, mockWebAuthnAuthenticatorData, validClientData, true, nil),
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:InvalidPublicKey",
+			args: args{
+				publicKey: mockWebAuthnPublicKey[:33],
+				payload:   mockWebAuthnPayload[:],
+				signature: validSignature,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:OtherPublicKey",
+			args: args{
+				publicKey: otherPublicKey,
+				payload:   mockWebAuthnPayload[:],
+				signature: validSignature,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:TamperedAuthenticatorData",
+			args: args{
+				publicKey: mockWebAuthnPublicKey,
+				payload:   mockWebAuthnPayload[:],
+				signature: tamperedSignature,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantSuccess",
+			args: args{
+				publicKey: mockWebAuthnPublicKey,
+				payload:   mockWebAuthnPayload[:],
+				signature: validSignature,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := NewWebAuthnSignature().Verify(tt.args.publicKey, tt.args.payload, tt.args.signature); (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -102,11 +102,28 @@ func (tx *ApprovalEscrowTransaction) SkipMempoolTransaction(
 	if err != nil {
 		return true, err
 	}
+	for _, selectedTx := range selectedTransactions {
+		// an approver votes once per escrow per block, so the same vote can't be counted twice before being persisted
+		sameTxType := model.TransactionType_ApprovalEscrowTransaction == model.TransactionType(selectedTx.GetTransactionType())
+		if !sameTxType || !bytes.Equal(tx.TransactionObject.SenderAccountAddress, selectedTx.SenderAccountAddress) {
+			continue
+		}
+		selectedBody, err := tx.ParseBodyBytes(selectedTx.GetTransactionBodyBytes())
+		if err != nil {
+			continue
+		}
+		if selectedBody.(*model.ApprovalEscrowTransactionBody).GetTransactionID() == tx.Body.GetTransactionID() {
+			return true, nil
+		}
+	}
 	return false, nil
 }
 
-// GetSize of approval transaction body bytes
-func (*ApprovalEscrowTransaction) GetSize() (uint32, error) {
+// GetSize of approval transaction body bytes, a partial approval also carries the released amount
+func (tx *ApprovalEscrowTransaction) GetSize() (uint32, error) {
+	if tx.Body.GetAmount() > 0 {
+		return constant.EscrowApprovalBytesLength + constant.EscrowReleaseAmount, nil
+	}
 	return constant.EscrowApprovalBytesLength, nil
 }
 
@@ -129,6 +146,9 @@ func (tx *ApprovalEscrowTransaction) GetBodyBytes() ([]byte, error) {
 	buffer := bytes.NewBuffer([]byte{})
 	buffer.Write(util.ConvertUint32ToBytes(uint32(tx.Body.GetApproval())))
 	buffer.Write(util.ConvertUint64ToBytes(uint64(tx.Body.GetTransactionID())))
+	if tx.Body.GetAmount() > 0 {
+		buffer.Write(util.ConvertUint64ToBytes(uint64(tx.Body.GetAmount())))
+	}
 	return buffer.Bytes(), nil
 }
 
@@ -161,9 +181,20 @@ func (tx *ApprovalEscrowTransaction) ParseBodyBytes(
 	}
 	escrowID := util.ConvertBytesToUint64(chunked)
 
+	// the released amount is only present in the body of a partial approval
+	var releaseAmount uint64
+	if buffer.Len() > 0 {
+		chunked, err = util.ReadTransactionBytes(buffer, int(constant.EscrowReleaseAmount))
+		if err != nil {
+			return nil, err
+		}
+		releaseAmount = util.ConvertBytesToUint64(chunked)
+	}
+
 	return &model.ApprovalEscrowTransactionBody{
 		Approval:      model.EscrowApproval(approvalInt),
 		TransactionID: int64(escrowID),
+		Amount:        int64(releaseAmount),
 	}, nil
 }
 
@@ -225,10 +256,24 @@ func (tx *ApprovalEscrowTransaction) checkEscrowValidity(dbTx bool, blockHeight
 		return blocker.NewBlocker(blocker.ValidationErr, "EscrowTargetNotValidByStatus")
 	}
 
-	// Check sender, should be approver address
-	if !bytes.Equal(latestEscrow.GetApproverAddress(), tx.TransactionObject.SenderAccountAddress) {
+	// Check sender, should be one of the approvers who hasn't voted yet
+	if !tx.isEscrowApprover(&latestEscrow) {
 		return blocker.NewBlocker(blocker.ValidationErr, "InvalidSenderAddress")
 	}
+	if util.HasEscrowApproverVoted(&latestEscrow, tx.TransactionObject.SenderAccountAddress) {
+		return blocker.NewBlocker(blocker.ValidationErr, "EscrowApproverAlreadyVoted")
+	}
+	// the approvers of a multi approver escrow vote to approve or reject, expiring is left to the timeout
+	if util.IsMultiApproverEscrow(&latestEscrow) &&
+		tx.Body.GetApproval() != model.EscrowApproval_Approve && tx.Body.GetApproval() != model.EscrowApproval_Reject {
+		return blocker.NewBlocker(blocker.ValidationErr, "InvalidEscrowApproval")
+	}
+	if tx.Body.GetAmount() != 0 {
+		err = tx.checkEscrowPartialRelease(dbTx, &latestEscrow)
+		if err != nil {
+			return err
+		}
+	}
 
 	// check transaction id is valid
 	if latestEscrow.GetID() != tx.Body.GetTransactionID() {
@@ -237,6 +282,51 @@ func (tx *ApprovalEscrowTransaction) checkEscrowValidity(dbTx bool, blockHeight
 	return nil
 }
 
+/*
+checkEscrowPartialRelease check a partial approval releases part of the remaining amount of a single approver send zbc escrow,
+the approvers of a multi approver escrow only vote for the whole amount
+*/
+func (tx *ApprovalEscrowTransaction) checkEscrowPartialRelease(dbTx bool, escrow *model.Escrow) error {
+	var (
+		escrowedTransaction model.Transaction
+		row                 *sql.Row
+		err                 error
+	)
+	if tx.Body.GetApproval() != model.EscrowApproval_Approve {
+		return blocker.NewBlocker(blocker.ValidationErr, "ReleaseAmountOnlyForApproval")
+	}
+	if util.IsMultiApproverEscrow(escrow) {
+		return blocker.NewBlocker(blocker.ValidationErr, "PartialReleaseNotAllowedForMultiApproverEscrow")
+	}
+	if tx.Body.GetAmount() < 0 || tx.Body.GetAmount() > escrow.GetAmount()-escrow.GetReleasedAmount() {
+		return blocker.NewBlocker(blocker.ValidationErr, "InvalidReleaseAmount")
+	}
+	row, err = tx.QueryExecutor.ExecuteSelectRow(tx.TransactionQuery.GetTransaction(escrow.GetID()), dbTx)
+	if err != nil {
+		return err
+	}
+	err = tx.TransactionQuery.Scan(&escrowedTransaction, row)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		return blocker.NewBlocker(blocker.ValidationErr, "TransactionNotFound")
+	}
+	if model.TransactionType(escrowedTransaction.GetTransactionType()) != model.TransactionType_SendZBCTransaction {
+		return blocker.NewBlocker(blocker.ValidationErr, "PartialReleaseOnlyForSendZBC")
+	}
+	return nil
+}
+
+func (tx *ApprovalEscrowTransaction) isEscrowApprover(escrow *model.Escrow) bool {
+	for _, approver := range util.GetEscrowApprovers(escrow) {
+		if bytes.Equal(approver, tx.TransactionObject.SenderAccountAddress) {
+			return true
+		}
+	}
+	return false
+}
+
 /*
 ApplyUnconfirmed exec before Confirmed
 */
@@ -263,12 +353,13 @@ func (tx *ApprovalEscrowTransaction) ApplyConfirmed(blockTimestamp int64) error
 		transaction  model.Transaction
 		txType       TypeAction
 		row          *sql.Row
+		approvalBody = tx.Body
 		err          error
 	)
 
-	// Get escrow by reference transaction ID
+	// Get escrow by reference transaction ID, reading the votes already applied in the current block
 	escrowQ, escrowArgs := tx.EscrowQuery.GetLatestEscrowTransactionByID(tx.Body.GetTransactionID())
-	row, err = tx.QueryExecutor.ExecuteSelectRow(escrowQ, false, escrowArgs...)
+	row, err = tx.QueryExecutor.ExecuteSelectRow(escrowQ, true, escrowArgs...)
 	if err != nil {
 		return err
 	}
@@ -280,6 +371,42 @@ func (tx *ApprovalEscrowTransaction) ApplyConfirmed(blockTimestamp int64) error
 		}
 		return blocker.NewBlocker(blocker.AppErr, "EscrowNotFound")
 	}
+	if latestEscrow.GetStatus() != model.EscrowStatus_Pending {
+		return blocker.NewBlocker(blocker.AppErr, "EscrowTargetNotValidByStatus")
+	}
+	latestEscrow.BlockHeight = tx.TransactionObject.Height
+	latestEscrow.Latest = true
+
+	if util.IsMultiApproverEscrow(&latestEscrow) {
+		var settled bool
+		if util.HasEscrowApproverVoted(&latestEscrow, tx.TransactionObject.SenderAccountAddress) {
+			return blocker.NewBlocker(blocker.AppErr, "EscrowApproverAlreadyVoted")
+		}
+		if tx.Body.GetApproval() == model.EscrowApproval_Approve {
+			latestEscrow.Approvals = append(latestEscrow.Approvals, tx.TransactionObject.SenderAccountAddress)
+		} else {
+			latestEscrow.Rejections = append(latestEscrow.Rejections, tx.TransactionObject.SenderAccountAddress)
+		}
+		approvalBody = &model.ApprovalEscrowTransactionBody{
+			TransactionID: tx.Body.GetTransactionID(),
+		}
+		approvalBody.Approval, settled = util.GetEscrowSettlement(&latestEscrow)
+		if !settled {
+			// keep the vote, the escrow stays pending until enough approvers voted or it expires
+			err = tx.QueryExecutor.ExecuteTransactions(tx.EscrowQuery.InsertEscrowTransaction(&latestEscrow))
+			if err != nil {
+				return err
+			}
+			return tx.AccountBalanceHelper.AddAccountBalance(
+				tx.TransactionObject.SenderAccountAddress,
+				-tx.TransactionObject.Fee,
+				model.EventType_EventApprovalEscrowTransaction,
+				tx.TransactionObject.Height,
+				tx.TransactionObject.ID,
+				uint64(blockTimestamp),
+			)
+		}
+	}
 
 	// get what transaction type it is, and switch to specific approval
 	transactionQ := tx.TransactionQuery.GetTransaction(latestEscrow.GetID())
@@ -308,7 +435,7 @@ func (tx *ApprovalEscrowTransaction) ApplyConfirmed(blockTimestamp int64) error
 	if !ok {
 		return blocker.NewBlocker(blocker.AppErr, "ExpectEscrowableTransaction")
 	}
-	err = escrowable.EscrowApproval(blockTimestamp, tx.Body)
+	err = escrowable.EscrowApproval(blockTimestamp, approvalBody)
 	if err != nil {
 		return blocker.NewBlocker(blocker.AppErr, "EscrowApprovalFailed")
 	}
@@ -328,6 +455,42 @@ func (tx *ApprovalEscrowTransaction) ApplyConfirmed(blockTimestamp int64) error
 	return nil
 }
 
+/*
+addEscrowCommission pay the commission of an approved or rejected escrow, split equally among the approvers who voted.
+The remainder of the split goes to the first voter
+*/
+func addEscrowCommission(
+	accountBalanceHelper AccountBalanceHelperInterface,
+	escrow *model.Escrow,
+	blockHeight uint32,
+	transactionID int64,
+	blockTimestamp int64,
+) error {
+	var (
+		recipients = util.GetEscrowCommissionRecipients(escrow)
+		share      = escrow.GetCommission() / int64(len(recipients))
+		remainder  = escrow.GetCommission() % int64(len(recipients))
+	)
+	for i, recipient := range recipients {
+		commission := share
+		if i == 0 {
+			commission += remainder
+		}
+		err := accountBalanceHelper.AddAccountBalance(
+			recipient,
+			commission,
+			model.EventType_EventApprovalEscrowTransaction,
+			blockHeight,
+			transactionID,
+			uint64(blockTimestamp),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 /*
 Escrowable will check the transaction is escrow or not.
 Rebuild escrow if not nil, and can use for whole sibling methods (escrow)
@@ -100,6 +100,18 @@ func TestApprovalEscrowTransaction_GetBodyBytes(t *testing.T) {
 			},
 			want: []byte{1, 0, 0, 0, 115, 169, 219, 42, 28, 0, 0, 0},
 		},
+		{
+			name: "wantSuccess:PartialRelease",
+			fields: fields{
+				TransactionObject: &model.Transaction{},
+				Body: &model.ApprovalEscrowTransactionBody{
+					Approval:      0,
+					TransactionID: 120978123123,
+					Amount:        5,
+				},
+			},
+			want: []byte{0, 0, 0, 0, 115, 169, 219, 42, 28, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -157,6 +169,22 @@ func TestApprovalEscrowTransaction_ParseBodyBytes(t *testing.T) {
 				TransactionID: 120978123123,
 			},
 		},
+		{
+			name:   "wantSuccess:PartialRelease",
+			fields: fields{TransactionObject: &model.Transaction{}},
+			args:   args{bodyBytes: []byte{0, 0, 0, 0, 115, 169, 219, 42, 28, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0}},
+			want: &model.ApprovalEscrowTransactionBody{
+				Approval:      0,
+				TransactionID: 120978123123,
+				Amount:        5,
+			},
+		},
+		{
+			name:    "wantError:InvalidReleaseAmountBytes",
+			fields:  fields{TransactionObject: &model.Transaction{}},
+			args:    args{bodyBytes: []byte{0, 0, 0, 0, 115, 169, 219, 42, 28, 0, 0, 0, 5}},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -210,6 +238,11 @@ func (*mockQueryExecutorValidate) ExecuteSelectRow(qStr string, tx bool, args ..
 		1,
 		true,
 		"",
+		nil,
+		uint32(0),
+		nil,
+		nil,
+		int64(0),
 	)
 	mock.ExpectQuery("").WillReturnRows(mockRow)
 	mockedRow := db.QueryRow("")
@@ -377,6 +410,102 @@ func TestApprovalEscrowTransaction_Validate(t *testing.T) {
 	}
 }
 
+func TestApprovalEscrowTransaction_SkipMempoolTransaction(t *testing.T) {
+	approvalBodyBytes := func(escrowID int64) []byte {
+		bodyBytes, _ := (&ApprovalEscrowTransaction{
+			Body: &model.ApprovalEscrowTransactionBody{
+				Approval:      model.EscrowApproval_Approve,
+				TransactionID: escrowID,
+			},
+		}).GetBodyBytes()
+		return bodyBytes
+	}
+	type args struct {
+		selectedTransactions []*model.Transaction
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "wantSuccess:NoSelectedApproval",
+			args: args{
+				selectedTransactions: []*model.Transaction{},
+			},
+			want: false,
+		},
+		{
+			name: "wantSuccess:ApprovalOfAnotherEscrowSelected",
+			args: args{
+				selectedTransactions: []*model.Transaction{
+					{
+						TransactionType:      uint32(model.TransactionType_ApprovalEscrowTransaction),
+						SenderAccountAddress: approvalEscrowAccountAddress3,
+						TransactionBodyBytes: approvalBodyBytes(120978123124),
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "wantSuccess:ApprovalOfSameEscrowByAnotherSenderSelected",
+			args: args{
+				selectedTransactions: []*model.Transaction{
+					{
+						TransactionType:      uint32(model.TransactionType_ApprovalEscrowTransaction),
+						SenderAccountAddress: approvalEscrowAccountAddress2,
+						TransactionBodyBytes: approvalBodyBytes(120978123123),
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "wantSuccess:ApprovalOfSameEscrowSelected",
+			args: args{
+				selectedTransactions: []*model.Transaction{
+					{
+						TransactionType:      uint32(model.TransactionType_ApprovalEscrowTransaction),
+						SenderAccountAddress: approvalEscrowAccountAddress3,
+						TransactionBodyBytes: approvalBodyBytes(120978123124),
+					},
+					{
+						TransactionType:      uint32(model.TransactionType_ApprovalEscrowTransaction),
+						SenderAccountAddress: approvalEscrowAccountAddress3,
+						TransactionBodyBytes: approvalBodyBytes(120978123123),
+					},
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &ApprovalEscrowTransaction{
+				TransactionObject: &model.Transaction{
+					SenderAccountAddress: approvalEscrowAccountAddress3,
+				},
+				Body: &model.ApprovalEscrowTransactionBody{
+					Approval:      model.EscrowApproval_Approve,
+					TransactionID: 120978123123,
+				},
+				QueryExecutor: &mockQueryExecutorValidate{},
+				EscrowQuery:   query.NewEscrowTransactionQuery(),
+			}
+			got, err := tx.SkipMempoolTransaction(tt.args.selectedTransactions, 0, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SkipMempoolTransaction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("SkipMempoolTransaction() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 type (
 	mockQueryExecutorUnconfirmed struct {
 		query.Executor
@@ -558,12 +687,30 @@ func (*mockEscrowQueryApplyConfirmedOK) Scan(escrow *model.Escrow, _ *sql.Row) e
 	escrow.Amount = 10
 	escrow.Commission = 1
 	escrow.Timeout = 120
-	escrow.Status = 1
+	escrow.Status = model.EscrowStatus_Pending
 	escrow.BlockHeight = 0
 	escrow.Latest = true
 	return nil
 }
 
+type (
+	mockEscrowQueryApplyConfirmedMultiApprover struct {
+		mockEscrowQueryApplyConfirmedOK
+		approvals [][]byte
+	}
+)
+
+func (m *mockEscrowQueryApplyConfirmedMultiApprover) Scan(escrow *model.Escrow, row *sql.Row) error {
+	_ = m.mockEscrowQueryApplyConfirmedOK.Scan(escrow, row)
+	escrow.ApproverAddresses = [][]byte{approvalEscrowAccountAddress3, approvalEscrowAccountAddress1, approvalEscrowAccountAddress2}
+	escrow.MinimumApprovals = 2
+	escrow.Approvals = m.approvals
+	return nil
+}
+func (*mockEscrowQueryApplyConfirmedMultiApprover) InsertEscrowTransaction(*model.Escrow) [][]interface{} {
+	return [][]interface{}{}
+}
+
 type (
 	mockAccountBalanceHelperApprovalEscrowTransactionApplyConfirmedSuccess struct {
 		AccountBalanceHelper
@@ -617,6 +764,69 @@ func TestApprovalEscrowTransaction_ApplyConfirmed(t *testing.T) {
 				AccountBalanceHelper: &mockAccountBalanceHelperApprovalEscrowTransactionApplyConfirmedSuccess{},
 			},
 		},
+		{
+			name: "wantSuccess:MultiApproverVoteNotSettled",
+			fields: fields{
+				TransactionObject: &model.Transaction{
+					ID:                   1234567890,
+					Fee:                  1,
+					SenderAccountAddress: approvalEscrowAccountAddress3,
+					Height:               1,
+				},
+				Body: &model.ApprovalEscrowTransactionBody{
+					Approval:      model.EscrowApproval_Approve,
+					TransactionID: 1234567890,
+				},
+				EscrowQuery:          &mockEscrowQueryApplyConfirmedMultiApprover{},
+				QueryExecutor:        &mockEscrowQueryExecutorApplyConfirmedOK{},
+				AccountBalanceHelper: &mockAccountBalanceHelperApprovalEscrowTransactionApplyConfirmedSuccess{},
+			},
+		},
+		{
+			name: "wantSuccess:MultiApproverVoteSettled",
+			fields: fields{
+				TransactionObject: &model.Transaction{
+					ID:                   1234567890,
+					Fee:                  1,
+					SenderAccountAddress: approvalEscrowAccountAddress3,
+					Height:               1,
+				},
+				Body: &model.ApprovalEscrowTransactionBody{
+					Approval:      model.EscrowApproval_Approve,
+					TransactionID: 1234567890,
+				},
+				EscrowQuery: &mockEscrowQueryApplyConfirmedMultiApprover{
+					approvals: [][]byte{approvalEscrowAccountAddress1},
+				},
+				QueryExecutor:    &mockEscrowQueryExecutorApplyConfirmedOK{},
+				TransactionQuery: &mockTransactionQueryApplyConfirmedOK{},
+				TypeActionSwitcher: &TypeSwitcher{
+					Executor: &mockEscrowQueryExecutorApplyConfirmedOK{},
+				},
+				AccountBalanceHelper: &mockAccountBalanceHelperApprovalEscrowTransactionApplyConfirmedSuccess{},
+			},
+		},
+		{
+			name: "wantError:MultiApproverAlreadyVoted",
+			fields: fields{
+				TransactionObject: &model.Transaction{
+					ID:                   1234567890,
+					Fee:                  1,
+					SenderAccountAddress: approvalEscrowAccountAddress3,
+					Height:               1,
+				},
+				Body: &model.ApprovalEscrowTransactionBody{
+					Approval:      model.EscrowApproval_Approve,
+					TransactionID: 1234567890,
+				},
+				EscrowQuery: &mockEscrowQueryApplyConfirmedMultiApprover{
+					approvals: [][]byte{approvalEscrowAccountAddress3},
+				},
+				QueryExecutor:        &mockEscrowQueryExecutorApplyConfirmedOK{},
+				AccountBalanceHelper: &mockAccountBalanceHelperApprovalEscrowTransactionApplyConfirmedSuccess{},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
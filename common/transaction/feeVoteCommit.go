@@ -403,26 +403,24 @@ func (tx *FeeVoteCommitTransaction) EscrowApproval(blockTimestamp int64, txBody
 		if err != nil {
 			return err
 		}
-		err = tx.AccountBalanceHelper.AddAccountBalance(
-			tx.TransactionObject.Escrow.GetApproverAddress(),
-			tx.TransactionObject.Escrow.GetCommission(),
-			model.EventType_EventApprovalEscrowTransaction,
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
-			uint64(blockTimestamp),
+			blockTimestamp,
 		)
 		if err != nil {
 			return err
 		}
 	case model.EscrowApproval_Reject:
 		tx.TransactionObject.Escrow.Status = model.EscrowStatus_Rejected
-		err = tx.AccountBalanceHelper.AddAccountBalance(
-			tx.TransactionObject.Escrow.GetApproverAddress(),
-			tx.TransactionObject.Escrow.GetCommission(),
-			model.EventType_EventApprovalEscrowTransaction,
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
-			uint64(blockTimestamp),
+			blockTimestamp,
 		)
 		if err != nil {
 			return err
@@ -50,6 +50,7 @@
 package transaction
 
 import (
+	"crypto/sha256"
 	"strconv"
 
 	"github.com/zoobc/zoobc-core/common/chaintype"
@@ -244,6 +245,23 @@ func GetFixturesForRemoveAccountDataset() (
 	return txBody, txBodyBytes
 }
 
+func GetFixturesForRegisterAccountDatasetSchema() (
+	txBody *model.RegisterAccountDatasetSchemaTransactionBody,
+	txBodyBytes []byte,
+) {
+	txBody = &model.RegisterAccountDatasetSchemaTransactionBody{
+		Property:  "KycLevel",
+		ValueType: model.AccountDatasetValueType_AccountDatasetValueInt,
+		MaxLength: 2,
+	}
+
+	rs := RegisterAccountDatasetSchema{
+		Body: txBody,
+	}
+	txBodyBytes, _ = rs.GetBodyBytes()
+	return txBody, txBodyBytes
+}
+
 func GetFixturesForTransactionBytes(tx *model.Transaction, sign bool) (txBytes []byte, hashed [32]byte) {
 	byteValue, _ := (&Util{}).GetTransactionBytes(tx, sign)
 	transactionHash := sha3.Sum256(byteValue)
@@ -345,6 +363,46 @@ func GetFixturesForLiquidPaymentTransaction() (
 
 }
 
+func GetFixturesForTimeLockedSendZBCTransaction() (
+	txBody *model.TimeLockedSendZBCTransactionBody,
+	txBodyBytes []byte,
+) {
+	txBody = &model.TimeLockedSendZBCTransactionBody{
+		Amount:       100,
+		UnlockHeight: 200,
+	}
+
+	sa := TimeLockedSendZBC{
+		Body: txBody,
+	}
+	txBodyBytes, _ = sa.GetBodyBytes()
+	return txBody, txBodyBytes
+}
+
+func GetFixturesForMultiSendZBCTransaction() (
+	txBody *model.MultiSendZBCTransactionBody,
+	txBodyBytes []byte,
+) {
+	txBody = &model.MultiSendZBCTransactionBody{
+		Recipients: []*model.MultiSendZBCRecipient{
+			{
+				RecipientAddress: senderAddress2,
+				Amount:           100,
+			},
+			{
+				RecipientAddress: recipientAddress1,
+				Amount:           50,
+			},
+		},
+	}
+
+	sa := MultiSendZBC{
+		Body: txBody,
+	}
+	txBodyBytes, _ = sa.GetBodyBytes()
+	return txBody, txBodyBytes
+}
+
 func GetFixturesForLiquidPaymentStopTransaction() (
 	txBody *model.LiquidPaymentStopTransactionBody,
 	txBodyBytes []byte,
@@ -360,6 +418,102 @@ func GetFixturesForLiquidPaymentStopTransaction() (
 	return txBody, txBodyBytes
 }
 
+func GetFixturesForLiquidPaymentWithdrawTransaction() (
+	txBody *model.LiquidPaymentWithdrawTransactionBody,
+	txBodyBytes []byte,
+) {
+	txBody = &model.LiquidPaymentWithdrawTransactionBody{
+		TransactionID: 123,
+	}
+
+	sa := LiquidPaymentWithdrawTransaction{
+		Body: txBody,
+	}
+	txBodyBytes, _ = sa.GetBodyBytes()
+	return txBody, txBodyBytes
+}
+
+func GetFixturesForHtlcLockTransaction() (
+	txBody *model.HtlcLockTransactionBody,
+	txBodyBytes []byte,
+) {
+	hashLock := sha256.Sum256([]byte{1, 2, 3})
+	txBody = &model.HtlcLockTransactionBody{
+		Amount:         100,
+		HashLock:       hashLock[:],
+		TimeLockHeight: 200,
+	}
+
+	sa := HtlcLockTransaction{
+		Body: txBody,
+	}
+	txBodyBytes, _ = sa.GetBodyBytes()
+	return txBody, txBodyBytes
+}
+
+func GetFixturesForHtlcClaimTransaction() (
+	txBody *model.HtlcClaimTransactionBody,
+	txBodyBytes []byte,
+) {
+	txBody = &model.HtlcClaimTransactionBody{
+		HtlcID:   123,
+		Preimage: []byte{1, 2, 3},
+	}
+
+	sa := HtlcClaimTransaction{
+		Body: txBody,
+	}
+	txBodyBytes, _ = sa.GetBodyBytes()
+	return txBody, txBodyBytes
+}
+
+func GetFixturesForHtlcRefundTransaction() (
+	txBody *model.HtlcRefundTransactionBody,
+	txBodyBytes []byte,
+) {
+	txBody = &model.HtlcRefundTransactionBody{
+		HtlcID: 123,
+	}
+
+	sa := HtlcRefundTransaction{
+		Body: txBody,
+	}
+	txBodyBytes, _ = sa.GetBodyBytes()
+	return txBody, txBodyBytes
+}
+
+func GetFixturesForMultiSignatureRotationTransaction() (
+	txBody *model.MultiSignatureRotationTransactionBody,
+	txBodyBytes []byte,
+) {
+	txBody = &model.MultiSignatureRotationTransactionBody{
+		MinimumSignatures: 3,
+		Addresses:         [][]byte{senderAddress2, senderAddress3, senderAddress4},
+		Weights:           []uint32{2, 1, 1},
+	}
+
+	sa := MultiSignatureRotationTransaction{
+		Body: txBody,
+	}
+	txBodyBytes, _ = sa.GetBodyBytes()
+	return txBody, txBodyBytes
+}
+
+func GetFixturesForMultiSignatureCancelTransaction() (
+	txBody *model.MultiSignatureCancelTransactionBody,
+	txBodyBytes []byte,
+) {
+	txBody = &model.MultiSignatureCancelTransactionBody{
+		TransactionHash: make([]byte, 32),
+	}
+
+	sa := MultiSignatureCancelTransaction{
+		Body: txBody,
+	}
+	txBodyBytes, _ = sa.GetBodyBytes()
+	return txBody, txBodyBytes
+}
+
 func GetFixtureForSpecificTransaction(
 	id, timestamp int64,
 	sender, recipient []byte,
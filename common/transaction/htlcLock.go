@@ -0,0 +1,316 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/fee"
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+	"github.com/zoobc/zoobc-core/common/util"
+)
+
+// HtlcLockTransaction is Transaction Type that implemented TypeAction
+type HtlcLockTransaction struct {
+	TransactionObject    *model.Transaction
+	Body                 *model.HtlcLockTransactionBody
+	QueryExecutor        query.ExecutorInterface
+	HtlcQuery            query.HtlcQueryInterface
+	AccountBalanceHelper AccountBalanceHelperInterface
+	FeeScaleService      fee.FeeScaleServiceInterface
+	AccountDatasetQuery  query.AccountDatasetQueryInterface
+}
+
+/*
+ApplyConfirmed debit the sender and lock the amount in a htlc,
+the amount is only credited back by a htlc claim or a htlc refund
+*/
+func (tx *HtlcLockTransaction) ApplyConfirmed(blockTimestamp int64) error {
+	var err = tx.AccountBalanceHelper.AddAccountBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		-(tx.Body.GetAmount() + tx.TransactionObject.Fee),
+		model.EventType_EventHtlcLockTransaction,
+		tx.TransactionObject.Height,
+		tx.TransactionObject.ID,
+		uint64(blockTimestamp),
+	)
+	if err != nil {
+		return err
+	}
+
+	htlcQ := tx.HtlcQuery.InsertHtlc(&model.Htlc{
+		ID:               tx.TransactionObject.ID,
+		SenderAddress:    tx.TransactionObject.SenderAccountAddress,
+		RecipientAddress: tx.TransactionObject.RecipientAccountAddress,
+		Amount:           tx.Body.GetAmount(),
+		HashLock:         tx.Body.GetHashLock(),
+		TimeLockHeight:   tx.Body.GetTimeLockHeight(),
+		Status:           model.HtlcStatus_HtlcLocked,
+		BlockHeight:      tx.TransactionObject.Height,
+	})
+	err = tx.QueryExecutor.ExecuteTransactions(htlcQ)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *HtlcLockTransaction) ApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		-(tx.Body.GetAmount() + tx.TransactionObject.Fee),
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *HtlcLockTransaction) UndoApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		tx.Body.GetAmount()+tx.TransactionObject.Fee,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+/*
+Validate is func that for validating to Transaction HtlcLock type
+That specs:
+	- the hash lock is a sha256 digest
+	- the time lock height is after the height the transaction is applied at
+	- `sender.spendable_balance` must be enough for amount and fee
+	- the recipient must be whitelisted by every spending restriction of the sender
+*/
+func (tx *HtlcLockTransaction) Validate(dbTx bool) error {
+	var (
+		err    error
+		enough bool
+	)
+	if tx.Body.GetAmount() <= 0 {
+		return errors.New("transaction must have an amount more than 0")
+	}
+	if tx.TransactionObject.SenderAccountAddress == nil {
+		return errors.New("transaction must have a valid sender account id")
+	}
+	if tx.TransactionObject.RecipientAccountAddress == nil {
+		return errors.New("transaction must have a valid recipient account id")
+	}
+	if len(tx.Body.GetHashLock()) != int(constant.HtlcHashLock) {
+		return blocker.NewBlocker(blocker.ValidationErr, "InvalidHtlcHashLock")
+	}
+	if tx.Body.GetTimeLockHeight() <= tx.TransactionObject.Height {
+		return blocker.NewBlocker(blocker.ValidationErr, "TimeLockHeightMustBeAfterTransactionHeight")
+	}
+
+	enough, err = tx.AccountBalanceHelper.HasEnoughSpendableBalance(
+		dbTx,
+		tx.TransactionObject.SenderAccountAddress,
+		tx.Body.GetAmount()+tx.TransactionObject.Fee,
+	)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotFound")
+	}
+	if !enough {
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotEnough")
+	}
+	err = validateSpendingRestriction(
+		tx.QueryExecutor,
+		tx.AccountDatasetQuery,
+		tx.TransactionObject.SenderAccountAddress,
+		tx.TransactionObject.RecipientAccountAddress,
+		dbTx,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *HtlcLockTransaction) GetMinimumFee() (int64, error) {
+	var lastFeeScale model.FeeScale
+	err := tx.FeeScaleService.GetLatestFeeScale(&lastFeeScale)
+	if err != nil {
+		return 0, err
+	}
+	return fee.CalculateTxMinimumFee(tx.TransactionObject, lastFeeScale.FeeScale)
+}
+
+// GetAmount return Amount from TransactionBody
+func (tx *HtlcLockTransaction) GetAmount() int64 {
+	return tx.Body.GetAmount()
+}
+
+// GetSize amount, hash lock and time lock height
+func (*HtlcLockTransaction) GetSize() (uint32, error) {
+	return constant.Balance + constant.HtlcHashLock + constant.HtlcTimeLockHeight, nil
+}
+
+// ParseBodyBytes read and translate body bytes to body implementation fields
+func (tx *HtlcLockTransaction) ParseBodyBytes(txBodyBytes []byte) (model.TransactionBodyInterface, error) {
+	// validate the body bytes is correct
+	txSize, err := tx.GetSize()
+	if err != nil {
+		return nil, err
+	}
+	_, err = util.ReadTransactionBytes(bytes.NewBuffer(txBodyBytes), int(txSize))
+	if err != nil {
+		return nil, err
+	}
+	// read body bytes
+	bufferBytes := bytes.NewBuffer(txBodyBytes)
+	amount := util.ConvertBytesToUint64(bufferBytes.Next(int(constant.Balance)))
+	hashLock := bufferBytes.Next(int(constant.HtlcHashLock))
+	timeLockHeight := util.ConvertBytesToUint32(bufferBytes.Next(int(constant.HtlcTimeLockHeight)))
+	return &model.HtlcLockTransactionBody{
+		Amount:         int64(amount),
+		HashLock:       hashLock,
+		TimeLockHeight: timeLockHeight,
+	}, nil
+}
+
+// GetBodyBytes translate tx body to bytes representation
+func (tx *HtlcLockTransaction) GetBodyBytes() ([]byte, error) {
+	buffer := bytes.NewBuffer([]byte{})
+	buffer.Write(util.ConvertUint64ToBytes(uint64(tx.Body.GetAmount())))
+	buffer.Write(tx.Body.GetHashLock())
+	buffer.Write(util.ConvertUint32ToBytes(tx.Body.GetTimeLockHeight()))
+	return buffer.Bytes(), nil
+}
+
+// GetTransactionBody append isTransaction_TransactionBody oneOf
+func (tx *HtlcLockTransaction) GetTransactionBody(transaction *model.Transaction) {
+	transaction.TransactionBody = &model.Transaction_HtlcLockTransactionBody{
+		HtlcLockTransactionBody: tx.Body,
+	}
+}
+
+// SkipMempoolTransaction this tx type has no mempool filter
+func (tx *HtlcLockTransaction) SkipMempoolTransaction([]*model.Transaction, int64, uint32) (bool, error) {
+	return false, nil
+}
+
+// Escrowable htlc transactions can't be escrowed, their hash and time locks already condition the transfer
+func (*HtlcLockTransaction) Escrowable() (EscrowTypeAction, bool) {
+	return nil, false
+}
+
+// getLatestHtlc fetch the latest state of the htlc locked by the htlc lock transaction id
+func getLatestHtlc(executor query.ExecutorInterface, htlcQuery query.HtlcQueryInterface, id int64, dbTx bool) (*model.Htlc, error) {
+	var htlc model.Htlc
+	qry, args := htlcQuery.GetHtlcByID(id)
+	row, err := executor.ExecuteSelectRow(qry, dbTx, args...)
+	if err != nil {
+		return nil, err
+	}
+	err = htlcQuery.Scan(&htlc, row)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return nil, err
+		}
+		return nil, blocker.NewBlocker(blocker.ValidationErr, "HtlcNotFound")
+	}
+	return &htlc, nil
+}
+
+// getHtlcSettlementHeight the height a claim or a refund is checked against the htlc time lock,
+// a transaction still in the mempool can be included at the earliest in the block after the last block
+func getHtlcSettlementHeight(
+	executor query.ExecutorInterface,
+	blockQuery query.BlockQueryInterface,
+	transaction *model.Transaction,
+) (uint32, error) {
+	if transaction.GetHeight() != 0 {
+		return transaction.GetHeight(), nil
+	}
+	lastBlock, err := util.GetLastBlock(executor, blockQuery)
+	if err != nil {
+		return 0, err
+	}
+	return lastBlock.GetHeight() + 1, nil
+}
+
+// isHtlcSettledBySelectedTransactions return true when a claim or a refund of the htlc is already selected for the block
+func isHtlcSettledBySelectedTransactions(selectedTransactions []*model.Transaction, htlcID int64) bool {
+	for _, sel := range selectedTransactions {
+		var settledHtlcID int64
+		switch model.TransactionType(sel.GetTransactionType()) {
+		case model.TransactionType_HtlcClaimTransaction:
+			body, err := new(HtlcClaimTransaction).ParseBodyBytes(sel.GetTransactionBodyBytes())
+			if err != nil {
+				continue
+			}
+			settledHtlcID = body.(*model.HtlcClaimTransactionBody).GetHtlcID()
+		case model.TransactionType_HtlcRefundTransaction:
+			body, err := new(HtlcRefundTransaction).ParseBodyBytes(sel.GetTransactionBodyBytes())
+			if err != nil {
+				continue
+			}
+			settledHtlcID = body.(*model.HtlcRefundTransactionBody).GetHtlcID()
+		default:
+			continue
+		}
+		if settledHtlcID == htlcID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,283 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zoobc/zoobc-core/common/chaintype"
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+)
+
+type (
+	mockHtlcQuery struct {
+		*query.HtlcQuery
+		htlc *model.Htlc
+		err  error
+	}
+	mockHtlcBlockQuery struct {
+		*query.BlockQuery
+		lastBlockHeight uint32
+	}
+)
+
+var (
+	mockHtlcPreimage = []byte{1, 2, 3}
+	mockHtlcHashLock = func() []byte {
+		digest := sha256.Sum256(mockHtlcPreimage)
+		return digest[:]
+	}()
+)
+
+func (m *mockHtlcQuery) Scan(htlc *model.Htlc, _ *sql.Row) error {
+	if m.err != nil {
+		return m.err
+	}
+	*htlc = *m.htlc
+	return nil
+}
+
+func (m *mockHtlcBlockQuery) Scan(block *model.Block, _ *sql.Row) error {
+	block.Height = m.lastBlockHeight
+	return nil
+}
+
+func newMockHtlcBlockQuery(lastBlockHeight uint32) *mockHtlcBlockQuery {
+	return &mockHtlcBlockQuery{
+		BlockQuery:      query.NewBlockQuery(&chaintype.MainChain{}),
+		lastBlockHeight: lastBlockHeight,
+	}
+}
+
+func newMockHtlcQuery(status model.HtlcStatus, err error) *mockHtlcQuery {
+	return &mockHtlcQuery{
+		HtlcQuery: query.NewHtlcQuery(),
+		htlc: &model.Htlc{
+			ID:               123,
+			SenderAddress:    liquidPayAddress1,
+			RecipientAddress: liquidPayAddress2,
+			Amount:           100,
+			HashLock:         mockHtlcHashLock,
+			TimeLockHeight:   10,
+			Status:           status,
+			BlockHeight:      2,
+			Latest:           true,
+		},
+		err: err,
+	}
+}
+
+func TestHtlcLockTransaction_Validate(t *testing.T) {
+	tests := []struct {
+		name                 string
+		body                 *model.HtlcLockTransactionBody
+		recipient            []byte
+		accountBalanceHelper AccountBalanceHelperInterface
+		restricted           bool
+		wantErr              bool
+	}{
+		{
+			name:                 "wantError:AmountZero",
+			body:                 &model.HtlcLockTransactionBody{HashLock: mockHtlcHashLock, TimeLockHeight: 10},
+			recipient:            liquidPayAddress2,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:NoRecipient",
+			body:                 &model.HtlcLockTransactionBody{Amount: 10, HashLock: mockHtlcHashLock, TimeLockHeight: 10},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:InvalidHashLock",
+			body:                 &model.HtlcLockTransactionBody{Amount: 10, HashLock: mockHtlcPreimage, TimeLockHeight: 10},
+			recipient:            liquidPayAddress2,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:TimeLockHeightPassed",
+			body:                 &model.HtlcLockTransactionBody{Amount: 10, HashLock: mockHtlcHashLock, TimeLockHeight: 5},
+			recipient:            liquidPayAddress2,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:BalanceNotEnough",
+			body:                 &model.HtlcLockTransactionBody{Amount: 10, HashLock: mockHtlcHashLock, TimeLockHeight: 10},
+			recipient:            liquidPayAddress2,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:RecipientNotWhitelisted",
+			body:                 &model.HtlcLockTransactionBody{Amount: 10, HashLock: mockHtlcHashLock, TimeLockHeight: 10},
+			recipient:            liquidPayAddress2,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			restricted:           true,
+			wantErr:              true,
+		},
+		{
+			name:                 "wantSuccess",
+			body:                 &model.HtlcLockTransactionBody{Amount: 10, HashLock: mockHtlcHashLock, TimeLockHeight: 10},
+			recipient:            liquidPayAddress2,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &HtlcLockTransaction{
+				TransactionObject: &model.Transaction{
+					Fee:                     1,
+					Height:                  5,
+					SenderAccountAddress:    liquidPayAddress1,
+					RecipientAccountAddress: tt.recipient,
+				},
+				Body:                 tt.body,
+				QueryExecutor:        &executorSetupLiquidPaymentSuccess{},
+				AccountBalanceHelper: tt.accountBalanceHelper,
+				AccountDatasetQuery:  &mockAccountDatasetQuerySpendingRestriction{restricted: tt.restricted},
+			}
+			if err := tx.Validate(false); (err != nil) != tt.wantErr {
+				t.Errorf("HtlcLockTransaction.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHtlcLockTransaction_ApplyConfirmed(t *testing.T) {
+	tests := []struct {
+		name                 string
+		queryExecutor        query.ExecutorInterface
+		accountBalanceHelper *mockTimeLockedSendZBCAccountBalanceHelper
+		wantErr              bool
+	}{
+		{
+			name:                 "wantError:AddAccountBalanceFail",
+			queryExecutor:        &executorSetupLiquidPaymentSuccess{},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{err: errors.New("mockedError")},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:InsertHtlcFail",
+			queryExecutor:        &executorSetupLiquidPaymentFail{},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantSuccess",
+			queryExecutor:        &executorSetupLiquidPaymentSuccess{},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &HtlcLockTransaction{
+				TransactionObject: &model.Transaction{
+					ID:                      10,
+					Fee:                     1,
+					Height:                  5,
+					SenderAccountAddress:    liquidPayAddress1,
+					RecipientAccountAddress: liquidPayAddress2,
+				},
+				Body:                 &model.HtlcLockTransactionBody{Amount: 10, HashLock: mockHtlcHashLock, TimeLockHeight: 10},
+				QueryExecutor:        tt.queryExecutor,
+				HtlcQuery:            query.NewHtlcQuery(),
+				AccountBalanceHelper: tt.accountBalanceHelper,
+			}
+			if err := tx.ApplyConfirmed(1000); (err != nil) != tt.wantErr {
+				t.Errorf("HtlcLockTransaction.ApplyConfirmed() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if want := []model.EventType{model.EventType_EventHtlcLockTransaction}; !reflect.DeepEqual(tt.accountBalanceHelper.addedEvents, want) {
+				t.Errorf("HtlcLockTransaction.ApplyConfirmed() ledger events = %v, want %v", tt.accountBalanceHelper.addedEvents, want)
+			}
+		})
+	}
+}
+
+func TestHtlcLockTransaction_ParseBodyBytes(t *testing.T) {
+	body, bodyBytes := GetFixturesForHtlcLockTransaction()
+	tests := []struct {
+		name        string
+		txBodyBytes []byte
+		want        model.TransactionBodyInterface
+		wantErr     bool
+	}{
+		{
+			name:        "wantError:WrongSize",
+			txBodyBytes: bodyBytes[:10],
+			wantErr:     true,
+		},
+		{
+			name:        "wantSuccess",
+			txBodyBytes: bodyBytes,
+			want:        body,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := new(HtlcLockTransaction).ParseBodyBytes(tt.txBodyBytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HtlcLockTransaction.ParseBodyBytes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HtlcLockTransaction.ParseBodyBytes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/fee"
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+	"github.com/zoobc/zoobc-core/common/util"
+)
+
+// HtlcRefundTransaction is Transaction Type that implemented TypeAction
+type HtlcRefundTransaction struct {
+	TransactionObject    *model.Transaction
+	Body                 *model.HtlcRefundTransactionBody
+	QueryExecutor        query.ExecutorInterface
+	HtlcQuery            query.HtlcQueryInterface
+	BlockQuery           query.BlockQueryInterface
+	AccountBalanceHelper AccountBalanceHelperInterface
+	FeeScaleService      fee.FeeScaleServiceInterface
+}
+
+// ApplyConfirmed credit back the locked amount to the sender of the expired htlc
+func (tx *HtlcRefundTransaction) ApplyConfirmed(blockTimestamp int64) error {
+	htlc, err := getLatestHtlc(tx.QueryExecutor, tx.HtlcQuery, tx.Body.GetHtlcID(), true)
+	if err != nil {
+		return err
+	}
+	err = tx.AccountBalanceHelper.AddAccountBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		htlc.GetAmount()-tx.TransactionObject.Fee,
+		model.EventType_EventHtlcRefundTransaction,
+		tx.TransactionObject.Height,
+		tx.TransactionObject.ID,
+		uint64(blockTimestamp),
+	)
+	if err != nil {
+		return err
+	}
+
+	htlc.Status = model.HtlcStatus_HtlcRefunded
+	htlc.BlockHeight = tx.TransactionObject.Height
+	err = tx.QueryExecutor.ExecuteTransactions(tx.HtlcQuery.InsertHtlc(htlc))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *HtlcRefundTransaction) ApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(tx.TransactionObject.SenderAccountAddress, -tx.TransactionObject.Fee)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *HtlcRefundTransaction) UndoApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(tx.TransactionObject.SenderAccountAddress, tx.TransactionObject.Fee)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+/*
+Validate is func that for validating to Transaction HtlcRefund type
+That specs:
+	- the sender is the sender of a still locked htlc
+	- the htlc time lock height is reached,
+	a transaction still in the mempool is checked against the height of the next block
+	- `sender.spendable_balance` must be enough for the fee
+*/
+func (tx *HtlcRefundTransaction) Validate(dbTx bool) error {
+	var (
+		htlc   *model.Htlc
+		height uint32
+		err    error
+		enough bool
+	)
+	if tx.TransactionObject.SenderAccountAddress == nil {
+		return errors.New("transaction must have a valid sender account id")
+	}
+
+	htlc, err = getLatestHtlc(tx.QueryExecutor, tx.HtlcQuery, tx.Body.GetHtlcID(), dbTx)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(htlc.GetSenderAddress(), tx.TransactionObject.SenderAccountAddress) {
+		return blocker.NewBlocker(blocker.ValidationErr, "OnlyHtlcSenderCanRefund")
+	}
+	if htlc.GetStatus() != model.HtlcStatus_HtlcLocked {
+		return blocker.NewBlocker(blocker.ValidationErr, "HtlcAlreadySettled")
+	}
+	height, err = getHtlcSettlementHeight(tx.QueryExecutor, tx.BlockQuery, tx.TransactionObject)
+	if err != nil {
+		return err
+	}
+	if height < htlc.GetTimeLockHeight() {
+		return blocker.NewBlocker(blocker.ValidationErr, "HtlcTimeLockNotExpired")
+	}
+
+	enough, err = tx.AccountBalanceHelper.HasEnoughSpendableBalance(dbTx, tx.TransactionObject.SenderAccountAddress, tx.TransactionObject.Fee)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotFound")
+	}
+	if !enough {
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotEnough")
+	}
+	return nil
+}
+
+func (tx *HtlcRefundTransaction) GetMinimumFee() (int64, error) {
+	var lastFeeScale model.FeeScale
+	err := tx.FeeScaleService.GetLatestFeeScale(&lastFeeScale)
+	if err != nil {
+		return 0, err
+	}
+	return fee.CalculateTxMinimumFee(tx.TransactionObject, lastFeeScale.FeeScale)
+}
+
+// GetAmount the refunded amount was already spent by the htlc lock transaction
+func (*HtlcRefundTransaction) GetAmount() int64 {
+	return 0
+}
+
+// GetSize only the htlc id
+func (*HtlcRefundTransaction) GetSize() (uint32, error) {
+	return constant.HtlcID, nil
+}
+
+// ParseBodyBytes read and translate body bytes to body implementation fields
+func (tx *HtlcRefundTransaction) ParseBodyBytes(txBodyBytes []byte) (model.TransactionBodyInterface, error) {
+	// validate the body bytes is correct
+	txSize, err := tx.GetSize()
+	if err != nil {
+		return nil, err
+	}
+	_, err = util.ReadTransactionBytes(bytes.NewBuffer(txBodyBytes), int(txSize))
+	if err != nil {
+		return nil, err
+	}
+	// read body bytes
+	bufferBytes := bytes.NewBuffer(txBodyBytes)
+	htlcID := util.ConvertBytesToUint64(bufferBytes.Next(int(constant.HtlcID)))
+	return &model.HtlcRefundTransactionBody{
+		HtlcID: int64(htlcID),
+	}, nil
+}
+
+// GetBodyBytes translate tx body to bytes representation
+func (tx *HtlcRefundTransaction) GetBodyBytes() ([]byte, error) {
+	buffer := bytes.NewBuffer([]byte{})
+	buffer.Write(util.ConvertUint64ToBytes(uint64(tx.Body.GetHtlcID())))
+	return buffer.Bytes(), nil
+}
+
+// GetTransactionBody append isTransaction_TransactionBody oneOf
+func (tx *HtlcRefundTransaction) GetTransactionBody(transaction *model.Transaction) {
+	transaction.TransactionBody = &model.Transaction_HtlcRefundTransactionBody{
+		HtlcRefundTransactionBody: tx.Body,
+	}
+}
+
+// SkipMempoolTransaction filter out a refund of a htlc still time locked at the new block height
+// or already claimed or refunded by a transaction selected for the block
+func (tx *HtlcRefundTransaction) SkipMempoolTransaction(
+	selectedTransactions []*model.Transaction,
+	_ int64,
+	newBlockHeight uint32,
+) (bool, error) {
+	htlc, err := getLatestHtlc(tx.QueryExecutor, tx.HtlcQuery, tx.Body.GetHtlcID(), false)
+	if err != nil {
+		return true, err
+	}
+	if newBlockHeight < htlc.GetTimeLockHeight() {
+		return true, nil
+	}
+	return isHtlcSettledBySelectedTransactions(selectedTransactions, tx.Body.GetHtlcID()), nil
+}
+
+// Escrowable htlc transactions can't be escrowed, their hash and time locks already condition the transfer
+func (*HtlcRefundTransaction) Escrowable() (EscrowTypeAction, bool) {
+	return nil, false
+}
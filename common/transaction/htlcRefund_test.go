@@ -0,0 +1,227 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+)
+
+func TestHtlcRefundTransaction_Validate(t *testing.T) {
+	tests := []struct {
+		name            string
+		sender          []byte
+		height          uint32
+		lastBlockHeight uint32
+		htlcQuery       query.HtlcQueryInterface
+		wantErr         bool
+	}{
+		{
+			name:      "wantError:HtlcNotFound",
+			sender:    liquidPayAddress1,
+			height:    10,
+			htlcQuery: newMockHtlcQuery(model.HtlcStatus_HtlcLocked, sql.ErrNoRows),
+			wantErr:   true,
+		},
+		{
+			name:      "wantError:NotSender",
+			sender:    liquidPayAddress2,
+			height:    10,
+			htlcQuery: newMockHtlcQuery(model.HtlcStatus_HtlcLocked, nil),
+			wantErr:   true,
+		},
+		{
+			name:      "wantError:AlreadyClaimed",
+			sender:    liquidPayAddress1,
+			height:    10,
+			htlcQuery: newMockHtlcQuery(model.HtlcStatus_HtlcClaimed, nil),
+			wantErr:   true,
+		},
+		{
+			name:      "wantError:TimeLockNotExpired",
+			sender:    liquidPayAddress1,
+			height:    9,
+			htlcQuery: newMockHtlcQuery(model.HtlcStatus_HtlcLocked, nil),
+			wantErr:   true,
+		},
+		{
+			name:            "wantError:MempoolNextBlockBeforeTimeLock",
+			sender:          liquidPayAddress1,
+			lastBlockHeight: 8,
+			htlcQuery:       newMockHtlcQuery(model.HtlcStatus_HtlcLocked, nil),
+			wantErr:         true,
+		},
+		{
+			name:      "wantSuccess",
+			sender:    liquidPayAddress1,
+			height:    10,
+			htlcQuery: newMockHtlcQuery(model.HtlcStatus_HtlcLocked, nil),
+		},
+		{
+			name:            "wantSuccess:MempoolNextBlockReachesTimeLock",
+			sender:          liquidPayAddress1,
+			lastBlockHeight: 9,
+			htlcQuery:       newMockHtlcQuery(model.HtlcStatus_HtlcLocked, nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &HtlcRefundTransaction{
+				TransactionObject: &model.Transaction{
+					Fee:                  1,
+					Height:               tt.height,
+					SenderAccountAddress: tt.sender,
+				},
+				Body:                 &model.HtlcRefundTransactionBody{HtlcID: 123},
+				QueryExecutor:        &executorSetupLiquidPaymentSuccess{},
+				HtlcQuery:            tt.htlcQuery,
+				BlockQuery:           newMockHtlcBlockQuery(tt.lastBlockHeight),
+				AccountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			}
+			if err := tx.Validate(false); (err != nil) != tt.wantErr {
+				t.Errorf("HtlcRefundTransaction.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHtlcRefundTransaction_ApplyConfirmed(t *testing.T) {
+	tests := []struct {
+		name          string
+		queryExecutor query.ExecutorInterface
+		htlcQuery     query.HtlcQueryInterface
+		wantErr       bool
+	}{
+		{
+			name:          "wantError:HtlcNotFound",
+			queryExecutor: &executorSetupLiquidPaymentSuccess{},
+			htlcQuery:     newMockHtlcQuery(model.HtlcStatus_HtlcLocked, sql.ErrNoRows),
+			wantErr:       true,
+		},
+		{
+			name:          "wantError:InsertHtlcFail",
+			queryExecutor: &executorSetupLiquidPaymentFail{},
+			htlcQuery:     newMockHtlcQuery(model.HtlcStatus_HtlcLocked, nil),
+			wantErr:       true,
+		},
+		{
+			name:          "wantSuccess",
+			queryExecutor: &executorSetupLiquidPaymentSuccess{},
+			htlcQuery:     newMockHtlcQuery(model.HtlcStatus_HtlcLocked, nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &HtlcRefundTransaction{
+				TransactionObject: &model.Transaction{
+					ID:                   12,
+					Fee:                  1,
+					Height:               10,
+					SenderAccountAddress: liquidPayAddress1,
+				},
+				Body:                 &model.HtlcRefundTransactionBody{HtlcID: 123},
+				QueryExecutor:        tt.queryExecutor,
+				HtlcQuery:            tt.htlcQuery,
+				AccountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{},
+			}
+			if err := tx.ApplyConfirmed(1000); (err != nil) != tt.wantErr {
+				t.Errorf("HtlcRefundTransaction.ApplyConfirmed() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHtlcRefundTransaction_SkipMempoolTransaction(t *testing.T) {
+	_, claimBytes := GetFixturesForHtlcClaimTransaction()
+	tests := []struct {
+		name                 string
+		selectedTransactions []*model.Transaction
+		newBlockHeight       uint32
+		want                 bool
+	}{
+		{
+			name:           "wantSkipped:TimeLockNotExpired",
+			newBlockHeight: 9,
+			want:           true,
+		},
+		{
+			name:           "wantNotSkipped:TimeLockExpired",
+			newBlockHeight: 10,
+		},
+		{
+			name: "wantSkipped:AlreadyClaimed",
+			selectedTransactions: []*model.Transaction{
+				{TransactionType: uint32(model.TransactionType_HtlcClaimTransaction), TransactionBodyBytes: claimBytes},
+			},
+			newBlockHeight: 10,
+			want:           true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &HtlcRefundTransaction{
+				Body:          &model.HtlcRefundTransactionBody{HtlcID: 123},
+				QueryExecutor: &executorSetupLiquidPaymentSuccess{},
+				HtlcQuery:     newMockHtlcQuery(model.HtlcStatus_HtlcLocked, nil),
+			}
+			got, err := tx.SkipMempoolTransaction(tt.selectedTransactions, 0, tt.newBlockHeight)
+			if err != nil {
+				t.Errorf("HtlcRefundTransaction.SkipMempoolTransaction() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("HtlcRefundTransaction.SkipMempoolTransaction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
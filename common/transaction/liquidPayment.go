@@ -53,8 +53,6 @@ import (
 	"bytes"
 	"database/sql"
 	"errors"
-	"math"
-	"time"
 
 	"github.com/zoobc/zoobc-core/common/blocker"
 	"github.com/zoobc/zoobc-core/common/constant"
@@ -74,9 +72,10 @@ type (
 		AccountBalanceHelper          AccountBalanceHelperInterface
 		EscrowQuery                   query.EscrowTransactionQueryInterface
 		FeeScaleService               fee.FeeScaleServiceInterface
+		AccountDatasetQuery           query.AccountDatasetQueryInterface
 	}
 	LiquidPaymentTransactionInterface interface {
-		CompletePayment(blockHeight uint32, blockTimestamp, firstAppliedTimestamp int64) error
+		CompletePayment(blockHeight uint32, blockTimestamp, firstAppliedTimestamp, claimedAmount int64) error
 	}
 )
 
@@ -162,6 +161,16 @@ func (tx *LiquidPaymentTransaction) Validate(dbTx bool) error {
 	if !enough {
 		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotEnough")
 	}
+	err = validateSpendingRestriction(
+		tx.QueryExecutor,
+		tx.AccountDatasetQuery,
+		tx.TransactionObject.SenderAccountAddress,
+		tx.TransactionObject.RecipientAccountAddress,
+		dbTx,
+	)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -226,26 +235,21 @@ func (tx *LiquidPaymentTransaction) SkipMempoolTransaction(
 	return false, nil
 }
 
-func (tx *LiquidPaymentTransaction) CompletePayment(blockHeight uint32, blockTimestamp, firstAppliedTimestamp int64) error {
+// CompletePayment credit the recipient with the vested amount not yet withdrawn and return the remaining amount to the sender
+func (tx *LiquidPaymentTransaction) CompletePayment(blockHeight uint32, blockTimestamp, firstAppliedTimestamp, claimedAmount int64) error {
 	var (
 		err                                               error
 		recipientBalanceIncrement, senderBalanceIncrement int64
-		blockTimestampTime                                = time.Unix(blockTimestamp, 0)
-		firstAppliedTimestampTime                         = time.Unix(firstAppliedTimestamp, 0)
-		durationPassed                                    = blockTimestampTime.Sub(firstAppliedTimestampTime).Minutes()
+		vestedAmount                                      int64
 	)
 
-	if durationPassed < 0 {
+	if blockTimestamp < firstAppliedTimestamp {
 		return blocker.NewBlocker(blocker.ValidationErr, "blockTimestamp is less than firstAppliedTimestamp")
 	}
 
-	durationRate := durationPassed / float64(tx.Body.GetCompleteMinutes())
-	if durationRate > 1 {
-		recipientBalanceIncrement = tx.Body.GetAmount()
-	} else {
-		recipientBalanceIncrement = int64(math.Ceil(durationRate * float64(tx.Body.GetAmount())))
-		senderBalanceIncrement = tx.Body.GetAmount() - recipientBalanceIncrement
-	}
+	vestedAmount = util.GetLiquidPaymentVestedAmount(tx.Body.GetAmount(), tx.Body.GetCompleteMinutes(), firstAppliedTimestamp, blockTimestamp)
+	recipientBalanceIncrement = vestedAmount - claimedAmount
+	senderBalanceIncrement = tx.Body.GetAmount() - vestedAmount
 
 	// transfer the zbc to the recipient pro-rate wise
 	err = tx.AccountBalanceHelper.AddAccountBalance(
@@ -278,7 +282,7 @@ func (tx *LiquidPaymentTransaction) CompletePayment(blockHeight uint32, blockTim
 	// update the status of the liquid payment
 	liquidPaymentStatusUpdateQ := tx.LiquidPaymentTransactionQuery.CompleteLiquidPaymentTransaction(
 		tx.TransactionObject.ID,
-		map[string]interface{}{"block_height": blockHeight},
+		map[string]interface{}{"block_height": blockHeight, "claimed_amount": vestedAmount},
 	)
 
 	err = tx.QueryExecutor.ExecuteTransactions(liquidPaymentStatusUpdateQ)
@@ -383,13 +387,12 @@ func (tx *LiquidPaymentTransaction) EscrowApproval(blockTimestamp int64, txBody
 		if err != nil {
 			return err
 		}
-		err = tx.AccountBalanceHelper.AddAccountBalance(
-			tx.TransactionObject.Escrow.GetApproverAddress(),
-			tx.TransactionObject.Escrow.GetCommission(),
-			model.EventType_EventApprovalEscrowTransaction,
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
-			uint64(blockTimestamp),
+			blockTimestamp,
 		)
 		if err != nil {
 			return err
@@ -407,13 +410,12 @@ func (tx *LiquidPaymentTransaction) EscrowApproval(blockTimestamp int64, txBody
 		if err != nil {
 			return err
 		}
-		err = tx.AccountBalanceHelper.AddAccountBalance(
-			tx.TransactionObject.Escrow.GetApproverAddress(),
-			tx.TransactionObject.Escrow.GetCommission(),
-			model.EventType_EventApprovalEscrowTransaction,
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
-			uint64(blockTimestamp),
+			blockTimestamp,
 		)
 		if err != nil {
 			return err
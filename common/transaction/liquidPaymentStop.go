@@ -143,7 +143,12 @@ func (tx *LiquidPaymentStopTransaction) ApplyConfirmed(blockTimestamp int64) err
 	if !ok {
 		return blocker.NewBlocker(blocker.AppErr, "Wrong type of transaction")
 	}
-	err = liquidPaymentTransaction.CompletePayment(tx.TransactionObject.Height, blockTimestamp, liquidPayment.AppliedTime)
+	err = liquidPaymentTransaction.CompletePayment(
+		tx.TransactionObject.Height,
+		blockTimestamp,
+		liquidPayment.AppliedTime,
+		liquidPayment.ClaimedAmount,
+	)
 	if err != nil {
 		return err
 	}
@@ -268,13 +273,13 @@ func (tx *LiquidPaymentStopTransaction) GetTransactionBody(transaction *model.Tr
 	}
 }
 
-// SkipMempoolTransaction filter out of the mempool tx under specific condition
+// SkipMempoolTransaction filter out a stop of a liquid payment already withdrawn from or stopped by a transaction selected for the block
 func (tx *LiquidPaymentStopTransaction) SkipMempoolTransaction(
 	selectedTransactions []*model.Transaction,
 	newBlockTimestamp int64,
 	newBlockHeight uint32,
 ) (bool, error) {
-	return false, nil
+	return isLiquidPaymentSettledBySelectedTransactions(selectedTransactions, tx.Body.GetTransactionID()), nil
 }
 
 func (tx *LiquidPaymentStopTransaction) Escrowable() (EscrowTypeAction, bool) {
@@ -369,25 +374,23 @@ func (tx *LiquidPaymentStopTransaction) EscrowApproval(blockTimestamp int64, txB
 		if err != nil {
 			return err
 		}
-		err = tx.AccountBalanceHelper.AddAccountBalance(
-			tx.TransactionObject.Escrow.GetApproverAddress(),
-			tx.TransactionObject.Escrow.GetCommission(),
-			model.EventType_EventApprovalEscrowTransaction,
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
-			uint64(blockTimestamp),
+			blockTimestamp,
 		)
 		if err != nil {
 			return err
 		}
 	case model.EscrowApproval_Reject:
-		err = tx.AccountBalanceHelper.AddAccountBalance(
-			tx.TransactionObject.Escrow.GetApproverAddress(),
-			tx.TransactionObject.Escrow.GetCommission(),
-			model.EventType_EventApprovalEscrowTransaction,
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
-			uint64(blockTimestamp),
+			blockTimestamp,
 		)
 		if err != nil {
 			return err
@@ -183,7 +183,7 @@ func (m *mockTypeActionSwitcher) GetTransactionType(tx *model.Transaction) (Type
 	return m.returnTx, nil
 }
 
-func (m *mockLiquidPaymentTransaction) CompletePayment(blockHeight uint32, blockTimestamp, firstAppliedTimestamp int64) error {
+func (m *mockLiquidPaymentTransaction) CompletePayment(blockHeight uint32, blockTimestamp, firstAppliedTimestamp, claimedAmount int64) error {
 	if m.isError {
 		return errors.New("mock error")
 	}
@@ -1113,6 +1113,7 @@ func TestLiquidPaymentStop_GetTransactionBody(t *testing.T) {
 }
 
 func TestLiquidPaymentStop_SkipMempoolTransaction(t *testing.T) {
+	_, withdrawBodyBytes := GetFixturesForLiquidPaymentWithdrawTransaction()
 	type fields struct {
 		TransactionObject             *model.Transaction
 		Body                          *model.LiquidPaymentStopTransactionBody
@@ -1138,6 +1139,32 @@ func TestLiquidPaymentStop_SkipMempoolTransaction(t *testing.T) {
 			name: "wantNoSkip",
 			want: false,
 		},
+		{
+			name: "wantNoSkip:WithdrawalOfAnotherLiquidPaymentSelected",
+			fields: fields{
+				Body: &model.LiquidPaymentStopTransactionBody{TransactionID: 456},
+			},
+			args: args{
+				selectedTransactions: []*model.Transaction{{
+					TransactionType:      uint32(model.TransactionType_LiquidPaymentWithdrawTransaction),
+					TransactionBodyBytes: withdrawBodyBytes,
+				}},
+			},
+			want: false,
+		},
+		{
+			name: "wantSkip:WithdrawalAlreadySelected",
+			fields: fields{
+				Body: &model.LiquidPaymentStopTransactionBody{TransactionID: 123},
+			},
+			args: args{
+				selectedTransactions: []*model.Transaction{{
+					TransactionType:      uint32(model.TransactionType_LiquidPaymentWithdrawTransaction),
+					TransactionBodyBytes: withdrawBodyBytes,
+				}},
+			},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
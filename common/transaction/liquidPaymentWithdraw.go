@@ -0,0 +1,307 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/fee"
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+	"github.com/zoobc/zoobc-core/common/util"
+)
+
+// LiquidPaymentWithdrawTransaction is Transaction Type that implemented TypeAction
+type LiquidPaymentWithdrawTransaction struct {
+	TransactionObject             *model.Transaction
+	Body                          *model.LiquidPaymentWithdrawTransactionBody
+	QueryExecutor                 query.ExecutorInterface
+	LiquidPaymentTransactionQuery query.LiquidPaymentTransactionQueryInterface
+	AccountBalanceHelper          AccountBalanceHelperInterface
+	FeeScaleService               fee.FeeScaleServiceInterface
+}
+
+// ApplyConfirmed credit the recipient with the amount vested since the last withdrawal, the liquid payment keeps streaming
+func (tx *LiquidPaymentWithdrawTransaction) ApplyConfirmed(blockTimestamp int64) error {
+	liquidPayment, err := getPendingLiquidPayment(tx.QueryExecutor, tx.LiquidPaymentTransactionQuery, tx.Body.GetTransactionID(), true)
+	if err != nil {
+		return err
+	}
+
+	err = tx.AccountBalanceHelper.AddAccountBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		-tx.TransactionObject.Fee,
+		model.EventType_EventLiquidPaymentWithdrawTransaction,
+		tx.TransactionObject.Height,
+		tx.TransactionObject.ID,
+		uint64(blockTimestamp),
+	)
+	if err != nil {
+		return err
+	}
+
+	vestedAmount := util.GetLiquidPaymentVestedAmount(
+		liquidPayment.GetAmount(),
+		liquidPayment.GetCompleteMinutes(),
+		liquidPayment.GetAppliedTime(),
+		blockTimestamp,
+	)
+	if vestedAmount <= liquidPayment.GetClaimedAmount() {
+		return nil
+	}
+	err = tx.AccountBalanceHelper.AddAccountBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		vestedAmount-liquidPayment.GetClaimedAmount(),
+		model.EventType_EventLiquidPaymentPaidTransaction,
+		tx.TransactionObject.Height,
+		liquidPayment.GetID(),
+		uint64(blockTimestamp),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryExecutor.ExecuteTransactions(tx.LiquidPaymentTransactionQuery.WithdrawLiquidPaymentTransaction(
+		liquidPayment.GetID(),
+		vestedAmount,
+		map[string]interface{}{"block_height": tx.TransactionObject.Height},
+	))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *LiquidPaymentWithdrawTransaction) ApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(tx.TransactionObject.SenderAccountAddress, -tx.TransactionObject.Fee)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *LiquidPaymentWithdrawTransaction) UndoApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(tx.TransactionObject.SenderAccountAddress, tx.TransactionObject.Fee)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+/*
+Validate is func that for validating to Transaction LiquidPaymentWithdraw type
+That specs:
+	- the sender is the recipient of a pending liquid payment,
+	the withdrawn amount depends on the block timestamp so it is only known once applied
+	- `sender.spendable_balance` must be enough for the fee
+*/
+func (tx *LiquidPaymentWithdrawTransaction) Validate(dbTx bool) error {
+	var (
+		liquidPayment *model.LiquidPayment
+		err           error
+		enough        bool
+	)
+	if tx.TransactionObject.SenderAccountAddress == nil {
+		return errors.New("transaction must have a valid sender account id")
+	}
+	if tx.Body.GetTransactionID() == 0 {
+		return errors.New("transaction must have a valid transaction id")
+	}
+
+	liquidPayment, err = getPendingLiquidPayment(tx.QueryExecutor, tx.LiquidPaymentTransactionQuery, tx.Body.GetTransactionID(), dbTx)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(liquidPayment.GetRecipientAddress(), tx.TransactionObject.SenderAccountAddress) {
+		return blocker.NewBlocker(blocker.ValidationErr, "OnlyLiquidPaymentRecipientCanWithdraw")
+	}
+
+	enough, err = tx.AccountBalanceHelper.HasEnoughSpendableBalance(dbTx, tx.TransactionObject.SenderAccountAddress, tx.TransactionObject.Fee)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotFound")
+	}
+	if !enough {
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotEnough")
+	}
+	return nil
+}
+
+func (tx *LiquidPaymentWithdrawTransaction) GetMinimumFee() (int64, error) {
+	var lastFeeScale model.FeeScale
+	err := tx.FeeScaleService.GetLatestFeeScale(&lastFeeScale)
+	if err != nil {
+		return 0, err
+	}
+	return fee.CalculateTxMinimumFee(tx.TransactionObject, lastFeeScale.FeeScale)
+}
+
+// GetAmount the withdrawn amount was already spent by the liquid payment transaction
+func (*LiquidPaymentWithdrawTransaction) GetAmount() int64 {
+	return 0
+}
+
+// GetSize only the liquid payment transaction id
+func (*LiquidPaymentWithdrawTransaction) GetSize() (uint32, error) {
+	return constant.TransactionID, nil
+}
+
+// ParseBodyBytes read and translate body bytes to body implementation fields
+func (tx *LiquidPaymentWithdrawTransaction) ParseBodyBytes(txBodyBytes []byte) (model.TransactionBodyInterface, error) {
+	txSize, err := tx.GetSize()
+	if err != nil {
+		return nil, err
+	}
+	chunked, err := util.ReadTransactionBytes(bytes.NewBuffer(txBodyBytes), int(txSize))
+	if err != nil {
+		return nil, err
+	}
+	return &model.LiquidPaymentWithdrawTransactionBody{
+		TransactionID: int64(util.ConvertBytesToUint64(chunked)),
+	}, nil
+}
+
+// GetBodyBytes translate tx body to bytes representation
+func (tx *LiquidPaymentWithdrawTransaction) GetBodyBytes() ([]byte, error) {
+	buffer := bytes.NewBuffer([]byte{})
+	buffer.Write(util.ConvertUint64ToBytes(uint64(tx.Body.GetTransactionID())))
+	return buffer.Bytes(), nil
+}
+
+// GetTransactionBody append isTransaction_TransactionBody oneOf
+func (tx *LiquidPaymentWithdrawTransaction) GetTransactionBody(transaction *model.Transaction) {
+	transaction.TransactionBody = &model.Transaction_LiquidPaymentWithdrawTransactionBody{
+		LiquidPaymentWithdrawTransactionBody: tx.Body,
+	}
+}
+
+/*
+SkipMempoolTransaction filter out a withdrawal the block would reject:
+	- the liquid payment is completed by the block itself, its whole vested amount is paid then
+	- a withdrawal or a stop of the same liquid payment is already selected for the block
+*/
+func (tx *LiquidPaymentWithdrawTransaction) SkipMempoolTransaction(
+	selectedTransactions []*model.Transaction,
+	newBlockTimestamp int64,
+	_ uint32,
+) (bool, error) {
+	liquidPayment, err := getPendingLiquidPayment(tx.QueryExecutor, tx.LiquidPaymentTransactionQuery, tx.Body.GetTransactionID(), false)
+	if err != nil {
+		if blockerErr, ok := err.(blocker.Blocker); ok && blockerErr.Type == blocker.ValidationErr {
+			return true, nil
+		}
+		return false, err
+	}
+	if liquidPayment.GetAppliedTime()+int64(liquidPayment.GetCompleteMinutes())*int64(constant.CompleteMinutesUnit) <= newBlockTimestamp {
+		return true, nil
+	}
+	return isLiquidPaymentSettledBySelectedTransactions(selectedTransactions, tx.Body.GetTransactionID()), nil
+}
+
+// isLiquidPaymentSettledBySelectedTransactions return true when a withdrawal or a stop of the liquid payment is already selected for the block
+func isLiquidPaymentSettledBySelectedTransactions(selectedTransactions []*model.Transaction, liquidPaymentID int64) bool {
+	for _, sel := range selectedTransactions {
+		var selectedPaymentID int64
+		switch model.TransactionType(sel.GetTransactionType()) {
+		case model.TransactionType_LiquidPaymentWithdrawTransaction:
+			body, err := new(LiquidPaymentWithdrawTransaction).ParseBodyBytes(sel.GetTransactionBodyBytes())
+			if err != nil {
+				continue
+			}
+			selectedPaymentID = body.(*model.LiquidPaymentWithdrawTransactionBody).GetTransactionID()
+		case model.TransactionType_LiquidPaymentStopTransaction:
+			body, err := new(LiquidPaymentStopTransaction).ParseBodyBytes(sel.GetTransactionBodyBytes())
+			if err != nil {
+				continue
+			}
+			selectedPaymentID = body.(*model.LiquidPaymentStopTransactionBody).GetTransactionID()
+		default:
+			continue
+		}
+		if selectedPaymentID == liquidPaymentID {
+			return true
+		}
+	}
+	return false
+}
+
+// Escrowable a withdrawal can't be escrowed, the amount it pays depends on the block it is applied in
+func (*LiquidPaymentWithdrawTransaction) Escrowable() (EscrowTypeAction, bool) {
+	return nil, false
+}
+
+// getPendingLiquidPayment return the latest version of a pending liquid payment
+func getPendingLiquidPayment(
+	executor query.ExecutorInterface,
+	liquidPaymentQuery query.LiquidPaymentTransactionQueryInterface,
+	id int64,
+	dbTx bool,
+) (*model.LiquidPayment, error) {
+	var liquidPayment model.LiquidPayment
+	liquidPaymentQ, liquidPaymentArgs := liquidPaymentQuery.GetPendingLiquidPaymentTransactionByID(id, model.LiquidPaymentStatus_LiquidPaymentPending)
+	row, err := executor.ExecuteSelectRow(liquidPaymentQ, dbTx, liquidPaymentArgs...)
+	if err != nil {
+		return nil, err
+	}
+	err = liquidPaymentQuery.Scan(&liquidPayment, row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, blocker.NewBlocker(blocker.ValidationErr, "LiquidPaymentNotExists")
+		}
+		return nil, err
+	}
+	return &liquidPayment, nil
+}
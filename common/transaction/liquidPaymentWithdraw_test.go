@@ -0,0 +1,339 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+)
+
+type (
+	executorLiquidPaymentWithdrawFail struct {
+		executorSetupLiquidPaymentSuccess
+	}
+	mockLiquidPaymentWithdrawQuery struct {
+		*query.LiquidPaymentTransactionQuery
+		liquidPayment *model.LiquidPayment
+		err           error
+	}
+)
+
+func (*executorLiquidPaymentWithdrawFail) ExecuteTransactions([][]interface{}) error {
+	return errors.New("executor mock error")
+}
+
+func (m *mockLiquidPaymentWithdrawQuery) Scan(liquidPayment *model.LiquidPayment, row *sql.Row) error {
+	if m.err != nil {
+		return m.err
+	}
+	*liquidPayment = *m.liquidPayment
+	return nil
+}
+
+// newMockLiquidPaymentWithdrawQuery mock a 10 minutes liquid payment of 1000 applied at timestamp 1000
+func newMockLiquidPaymentWithdrawQuery(claimedAmount int64, err error) *mockLiquidPaymentWithdrawQuery {
+	return &mockLiquidPaymentWithdrawQuery{
+		LiquidPaymentTransactionQuery: query.NewLiquidPaymentTransactionQuery(),
+		liquidPayment: &model.LiquidPayment{
+			ID:               123,
+			SenderAddress:    liquidPayAddress1,
+			RecipientAddress: liquidPayAddress2,
+			Amount:           1000,
+			AppliedTime:      1000,
+			CompleteMinutes:  10,
+			Status:           model.LiquidPaymentStatus_LiquidPaymentPending,
+			BlockHeight:      2,
+			Latest:           true,
+			ClaimedAmount:    claimedAmount,
+		},
+		err: err,
+	}
+}
+
+func TestLiquidPaymentWithdrawTransaction_Validate(t *testing.T) {
+	tests := []struct {
+		name               string
+		sender             []byte
+		timestamp          int64
+		liquidPaymentQuery query.LiquidPaymentTransactionQueryInterface
+		wantErr            bool
+	}{
+		{
+			name:               "wantError:LiquidPaymentNotExists",
+			sender:             liquidPayAddress2,
+			timestamp:          1300,
+			liquidPaymentQuery: newMockLiquidPaymentWithdrawQuery(0, sql.ErrNoRows),
+			wantErr:            true,
+		},
+		{
+			name:               "wantError:NotRecipient",
+			sender:             liquidPayAddress1,
+			timestamp:          1300,
+			liquidPaymentQuery: newMockLiquidPaymentWithdrawQuery(0, nil),
+			wantErr:            true,
+		},
+		{
+			name:               "wantSuccess",
+			sender:             liquidPayAddress2,
+			timestamp:          1300,
+			liquidPaymentQuery: newMockLiquidPaymentWithdrawQuery(200, nil),
+		},
+		{
+			name:               "wantSuccess:VestedAmountAlreadyWithdrawnAtTransactionTimestamp",
+			sender:             liquidPayAddress2,
+			timestamp:          1300,
+			liquidPaymentQuery: newMockLiquidPaymentWithdrawQuery(500, nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &LiquidPaymentWithdrawTransaction{
+				TransactionObject: &model.Transaction{
+					Fee:                  1,
+					Timestamp:            tt.timestamp,
+					SenderAccountAddress: tt.sender,
+				},
+				Body:                          &model.LiquidPaymentWithdrawTransactionBody{TransactionID: 123},
+				QueryExecutor:                 &executorSetupLiquidPaymentSuccess{},
+				LiquidPaymentTransactionQuery: tt.liquidPaymentQuery,
+				AccountBalanceHelper:          &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			}
+			if err := tx.Validate(false); (err != nil) != tt.wantErr {
+				t.Errorf("LiquidPaymentWithdrawTransaction.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLiquidPaymentWithdrawTransaction_ApplyConfirmed(t *testing.T) {
+	tests := []struct {
+		name               string
+		queryExecutor      query.ExecutorInterface
+		liquidPaymentQuery query.LiquidPaymentTransactionQueryInterface
+		blockTimestamp     int64
+		wantEvents         []model.EventType
+		wantErr            bool
+	}{
+		{
+			name:               "wantError:LiquidPaymentNotExists",
+			queryExecutor:      &executorSetupLiquidPaymentSuccess{},
+			liquidPaymentQuery: newMockLiquidPaymentWithdrawQuery(0, sql.ErrNoRows),
+			blockTimestamp:     1300,
+			wantErr:            true,
+		},
+		{
+			name:               "wantError:WithdrawLiquidPaymentFail",
+			queryExecutor:      &executorLiquidPaymentWithdrawFail{},
+			liquidPaymentQuery: newMockLiquidPaymentWithdrawQuery(0, nil),
+			blockTimestamp:     1300,
+			wantEvents: []model.EventType{
+				model.EventType_EventLiquidPaymentWithdrawTransaction,
+				model.EventType_EventLiquidPaymentPaidTransaction,
+			},
+			wantErr: true,
+		},
+		{
+			name:               "wantSuccess:OnlyFeeWhenNothingVested",
+			queryExecutor:      &executorSetupLiquidPaymentSuccess{},
+			liquidPaymentQuery: newMockLiquidPaymentWithdrawQuery(500, nil),
+			blockTimestamp:     1300,
+			wantEvents: []model.EventType{
+				model.EventType_EventLiquidPaymentWithdrawTransaction,
+			},
+		},
+		{
+			name:               "wantSuccess",
+			queryExecutor:      &executorSetupLiquidPaymentSuccess{},
+			liquidPaymentQuery: newMockLiquidPaymentWithdrawQuery(200, nil),
+			blockTimestamp:     1300,
+			wantEvents: []model.EventType{
+				model.EventType_EventLiquidPaymentWithdrawTransaction,
+				model.EventType_EventLiquidPaymentPaidTransaction,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountBalanceHelper := &mockTimeLockedSendZBCAccountBalanceHelper{}
+			tx := &LiquidPaymentWithdrawTransaction{
+				TransactionObject: &model.Transaction{
+					ID:                   12,
+					Fee:                  1,
+					Height:               10,
+					SenderAccountAddress: liquidPayAddress2,
+				},
+				Body:                          &model.LiquidPaymentWithdrawTransactionBody{TransactionID: 123},
+				QueryExecutor:                 tt.queryExecutor,
+				LiquidPaymentTransactionQuery: tt.liquidPaymentQuery,
+				AccountBalanceHelper:          accountBalanceHelper,
+			}
+			if err := tx.ApplyConfirmed(tt.blockTimestamp); (err != nil) != tt.wantErr {
+				t.Errorf("LiquidPaymentWithdrawTransaction.ApplyConfirmed() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(accountBalanceHelper.addedEvents, tt.wantEvents) {
+				t.Errorf("LiquidPaymentWithdrawTransaction.ApplyConfirmed() events = %v, want %v", accountBalanceHelper.addedEvents, tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestLiquidPaymentWithdrawTransaction_ParseBodyBytes(t *testing.T) {
+	txBody, txBodyBytes := GetFixturesForLiquidPaymentWithdrawTransaction()
+	tests := []struct {
+		name        string
+		txBodyBytes []byte
+		want        model.TransactionBodyInterface
+		wantErr     bool
+	}{
+		{
+			name:        "wantError:BodyTooShort",
+			txBodyBytes: txBodyBytes[:4],
+			wantErr:     true,
+		},
+		{
+			name:        "wantSuccess",
+			txBodyBytes: txBodyBytes,
+			want:        txBody,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := new(LiquidPaymentWithdrawTransaction).ParseBodyBytes(tt.txBodyBytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LiquidPaymentWithdrawTransaction.ParseBodyBytes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LiquidPaymentWithdrawTransaction.ParseBodyBytes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLiquidPaymentWithdrawTransaction_SkipMempoolTransaction(t *testing.T) {
+	_, withdrawBodyBytes := GetFixturesForLiquidPaymentWithdrawTransaction()
+	_, stopBodyBytes := GetFixturesForLiquidPaymentStopTransaction()
+	otherStopBodyBytes, _ := (&LiquidPaymentStopTransaction{
+		Body: &model.LiquidPaymentStopTransactionBody{TransactionID: 456},
+	}).GetBodyBytes()
+	tests := []struct {
+		name                 string
+		liquidPaymentQuery   query.LiquidPaymentTransactionQueryInterface
+		selectedTransactions []*model.Transaction
+		newBlockTimestamp    int64
+		want                 bool
+	}{
+		{
+			name:               "wantSkip:LiquidPaymentNotExists",
+			liquidPaymentQuery: newMockLiquidPaymentWithdrawQuery(0, sql.ErrNoRows),
+			newBlockTimestamp:  1300,
+			want:               true,
+		},
+		{
+			name:               "wantSkip:CompletedByTheBlock",
+			liquidPaymentQuery: newMockLiquidPaymentWithdrawQuery(0, nil),
+			newBlockTimestamp:  1600,
+			want:               true,
+		},
+		{
+			name:               "wantSkip:WithdrawalAlreadySelected",
+			liquidPaymentQuery: newMockLiquidPaymentWithdrawQuery(0, nil),
+			selectedTransactions: []*model.Transaction{{
+				TransactionType:      uint32(model.TransactionType_LiquidPaymentWithdrawTransaction),
+				TransactionBodyBytes: withdrawBodyBytes,
+			}},
+			newBlockTimestamp: 1300,
+			want:              true,
+		},
+		{
+			name:               "wantSkip:StopAlreadySelected",
+			liquidPaymentQuery: newMockLiquidPaymentWithdrawQuery(0, nil),
+			selectedTransactions: []*model.Transaction{{
+				TransactionType:      uint32(model.TransactionType_LiquidPaymentStopTransaction),
+				TransactionBodyBytes: stopBodyBytes,
+			}},
+			newBlockTimestamp: 1300,
+			want:              true,
+		},
+		{
+			name:               "wantNotSkip",
+			liquidPaymentQuery: newMockLiquidPaymentWithdrawQuery(0, nil),
+			selectedTransactions: []*model.Transaction{{
+				TransactionType:      uint32(model.TransactionType_LiquidPaymentStopTransaction),
+				TransactionBodyBytes: otherStopBodyBytes,
+			}},
+			newBlockTimestamp: 1300,
+			want:              false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &LiquidPaymentWithdrawTransaction{
+				TransactionObject:             &model.Transaction{SenderAccountAddress: liquidPayAddress2},
+				Body:                          &model.LiquidPaymentWithdrawTransactionBody{TransactionID: 123},
+				QueryExecutor:                 &executorSetupLiquidPaymentSuccess{},
+				LiquidPaymentTransactionQuery: tt.liquidPaymentQuery,
+			}
+			got, err := tx.SkipMempoolTransaction(tt.selectedTransactions, tt.newBlockTimestamp, 0)
+			if err != nil {
+				t.Errorf("LiquidPaymentWithdrawTransaction.SkipMempoolTransaction() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("LiquidPaymentWithdrawTransaction.SkipMempoolTransaction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -368,6 +368,7 @@ func TestLiquidPayment_Validate(t *testing.T) {
 		QueryExecutor                 query.ExecutorInterface
 		LiquidPaymentTransactionQuery query.LiquidPaymentTransactionQueryInterface
 		AccountBalanceHelper          AccountBalanceHelperInterface
+		AccountDatasetQuery           query.AccountDatasetQueryInterface
 	}
 	type args struct {
 		dbTx bool
@@ -544,6 +545,29 @@ func TestLiquidPayment_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "wantError:RecipientNotWhitelisted",
+			fields: fields{
+				TransactionObject: &model.Transaction{
+					ID:                      10,
+					Fee:                     10,
+					SenderAccountAddress:    liquidPayAddress1,
+					RecipientAccountAddress: liquidPayAddress2,
+					Height:                  10,
+				},
+				Body: &model.LiquidPaymentTransactionBody{
+					Amount:          10,
+					CompleteMinutes: 100,
+				},
+				QueryExecutor:                 &executorSetupLiquidPaymentSuccess{},
+				LiquidPaymentTransactionQuery: query.NewLiquidPaymentTransactionQuery(),
+				AccountBalanceHelper: NewAccountBalanceHelper(&executorSetupLiquidPaymentSuccess{}, &mockAccountBalanceQueryForLiquidPaymentSuccess{
+					mockSpendableBalance: 20,
+				}, query.NewAccountLedgerQuery()),
+				AccountDatasetQuery: &mockAccountDatasetQuerySpendingRestriction{restricted: true},
+			},
+			wantErr: true,
+		},
 		{
 			name: "wantSuccess",
 			fields: fields{
@@ -563,6 +587,7 @@ func TestLiquidPayment_Validate(t *testing.T) {
 				AccountBalanceHelper: NewAccountBalanceHelper(&executorSetupLiquidPaymentSuccess{}, &mockAccountBalanceQueryForLiquidPaymentSuccess{
 					mockSpendableBalance: 20,
 				}, query.NewAccountLedgerQuery()),
+				AccountDatasetQuery: &mockAccountDatasetQuerySpendingRestriction{},
 			},
 			wantErr: false,
 		},
@@ -575,6 +600,7 @@ func TestLiquidPayment_Validate(t *testing.T) {
 				QueryExecutor:                 tt.fields.QueryExecutor,
 				LiquidPaymentTransactionQuery: tt.fields.LiquidPaymentTransactionQuery,
 				AccountBalanceHelper:          tt.fields.AccountBalanceHelper,
+				AccountDatasetQuery:           tt.fields.AccountDatasetQuery,
 			}
 			if err := tx.Validate(tt.args.dbTx); (err != nil) != tt.wantErr {
 				t.Errorf("LiquidPayment.Validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -890,6 +916,7 @@ func TestLiquidPayment_CompletePayment(t *testing.T) {
 		blockHeight           uint32
 		blockTimestamp        int64
 		firstAppliedTimestamp int64
+		claimedAmount         int64
 	}
 	tests := []struct {
 		name    string
@@ -961,6 +988,35 @@ func TestLiquidPayment_CompletePayment(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "wantSuccess:PartiallyWithdrawn",
+			args: args{
+				blockTimestamp:        1257897004,
+				firstAppliedTimestamp: 1257894004,
+				claimedAmount:         3,
+			},
+			fields: fields{
+				TransactionObject: &model.Transaction{
+					ID:                      10,
+					Fee:                     10,
+					SenderAccountAddress:    liquidPayAddress1,
+					RecipientAccountAddress: liquidPayAddress2,
+					Height:                  10,
+				},
+				Body: &model.LiquidPaymentTransactionBody{
+					Amount:          10,
+					CompleteMinutes: 100,
+				},
+				QueryExecutor:                 &executorSetupLiquidPaymentSuccess{},
+				LiquidPaymentTransactionQuery: query.NewLiquidPaymentTransactionQuery(),
+				AccountBalanceHelper: NewAccountBalanceHelper(
+					&executorSetupLiquidPaymentSuccess{},
+					query.NewAccountBalanceQuery(),
+					query.NewAccountLedgerQuery(),
+				),
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -971,7 +1027,8 @@ func TestLiquidPayment_CompletePayment(t *testing.T) {
 				LiquidPaymentTransactionQuery: tt.fields.LiquidPaymentTransactionQuery,
 				AccountBalanceHelper:          tt.fields.AccountBalanceHelper,
 			}
-			if err := tx.CompletePayment(tt.args.blockHeight, tt.args.blockTimestamp, tt.args.firstAppliedTimestamp); (err != nil) != tt.wantErr {
+			err := tx.CompletePayment(tt.args.blockHeight, tt.args.blockTimestamp, tt.args.firstAppliedTimestamp, tt.args.claimedAmount)
+			if (err != nil) != tt.wantErr {
 				t.Errorf("LiquidPayment.CompletePayment() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
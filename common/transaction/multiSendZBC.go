@@ -0,0 +1,465 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/hex"
+	"math"
+
+	"github.com/zoobc/zoobc-core/common/accounttype"
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/fee"
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+	"github.com/zoobc/zoobc-core/common/util"
+)
+
+type (
+	// MultiSendZBC is Transaction Type that implemented TypeAction
+	MultiSendZBC struct {
+		TransactionObject    *model.Transaction
+		Body                 *model.MultiSendZBCTransactionBody
+		QueryExecutor        query.ExecutorInterface
+		EscrowQuery          query.EscrowTransactionQueryInterface
+		FeeScaleService      fee.FeeScaleServiceInterface
+		AccountBalanceHelper AccountBalanceHelperInterface
+		AccountDatasetQuery  query.AccountDatasetQueryInterface
+	}
+)
+
+// SkipMempoolTransaction this tx type has no mempool filter
+func (tx *MultiSendZBC) SkipMempoolTransaction([]*model.Transaction, int64, uint32) (bool, error) {
+	return false, nil
+}
+
+/*
+ApplyConfirmed func that for applying Transaction MultiSendZBC type:
+	- every recipient balance = current + its amount, with an account ledger entry per recipient
+	- sender balance = current - (total amount + fee)
+*/
+func (tx *MultiSendZBC) ApplyConfirmed(blockTimestamp int64) error {
+	var err error
+	for _, recipient := range tx.Body.GetRecipients() {
+		err = tx.AccountBalanceHelper.AddAccountBalance(
+			recipient.GetRecipientAddress(),
+			recipient.GetAmount(),
+			model.EventType_EventMultiSendZBCTransaction,
+			tx.TransactionObject.Height,
+			tx.TransactionObject.ID,
+			uint64(blockTimestamp),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	err = tx.AccountBalanceHelper.AddAccountBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		-(tx.GetAmount() + tx.TransactionObject.Fee),
+		model.EventType_EventMultiSendZBCTransaction,
+		tx.TransactionObject.Height,
+		tx.TransactionObject.ID,
+		uint64(blockTimestamp),
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *MultiSendZBC) ApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		-(tx.GetAmount() + tx.TransactionObject.Fee),
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *MultiSendZBC) UndoApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		tx.GetAmount()+tx.TransactionObject.Fee,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+/*
+Validate is func that for validating to Transaction MultiSendZBC type
+That specs:
+	- between 1 and constant.MaxMultiSendZBCRecipients distinct recipients, each with an amount more than 0
+	- `sender.spendable_balance` must be enough for the total amount and fee
+	- every recipient must be whitelisted by every spending restriction of the sender
+*/
+func (tx *MultiSendZBC) Validate(dbTx bool) error {
+	var (
+		err         error
+		enough      bool
+		totalAmount int64
+		recipients  = make(map[string]bool)
+	)
+	if tx.TransactionObject.SenderAccountAddress == nil {
+		return blocker.NewBlocker(blocker.ValidationErr, "TransactionMustHaveValidSenderAccountAddress")
+	}
+	if len(tx.Body.GetRecipients()) == 0 {
+		return blocker.NewBlocker(blocker.ValidationErr, "MultiSendZBCRecipientsRequired")
+	}
+	if len(tx.Body.GetRecipients()) > constant.MaxMultiSendZBCRecipients {
+		return blocker.NewBlocker(blocker.ValidationErr, "MultiSendZBCTooManyRecipients")
+	}
+	for _, recipient := range tx.Body.GetRecipients() {
+		if len(recipient.GetRecipientAddress()) == 0 {
+			return blocker.NewBlocker(blocker.ValidationErr, "MultiSendZBCRecipientAddressRequired")
+		}
+		if recipient.GetAmount() <= 0 {
+			return blocker.NewBlocker(blocker.ValidationErr, "MultiSendZBCAmountMustBeMoreThanZero")
+		}
+		if recipient.GetAmount() > math.MaxInt64-totalAmount {
+			return blocker.NewBlocker(blocker.ValidationErr, "MultiSendZBCTotalAmountOverflow")
+		}
+		recipientKey := hex.EncodeToString(recipient.GetRecipientAddress())
+		if recipients[recipientKey] {
+			return blocker.NewBlocker(blocker.ValidationErr, "MultiSendZBCDuplicateRecipient")
+		}
+		recipients[recipientKey] = true
+		totalAmount += recipient.GetAmount()
+	}
+
+	enough, err = tx.AccountBalanceHelper.HasEnoughSpendableBalance(
+		dbTx,
+		tx.TransactionObject.SenderAccountAddress,
+		totalAmount+tx.TransactionObject.Fee,
+	)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotFound")
+	}
+	if !enough {
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotEnough")
+	}
+	for _, recipient := range tx.Body.GetRecipients() {
+		err = validateSpendingRestriction(
+			tx.QueryExecutor,
+			tx.AccountDatasetQuery,
+			tx.TransactionObject.SenderAccountAddress,
+			recipient.GetRecipientAddress(),
+			dbTx,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetMinimumFee scale the minimum fee with the number of recipients instead of charging a flat send zbc fee
+func (tx *MultiSendZBC) GetMinimumFee() (int64, error) {
+	var lastFeeScale model.FeeScale
+	err := tx.FeeScaleService.GetLatestFeeScale(&lastFeeScale)
+	if err != nil {
+		return 0, err
+	}
+	return fee.CalculateMultiOutputTxMinimumFee(tx.TransactionObject, lastFeeScale.FeeScale, len(tx.Body.GetRecipients()))
+}
+
+// GetAmount return the total amount sent to all recipients
+func (tx *MultiSendZBC) GetAmount() int64 {
+	var totalAmount int64
+	for _, recipient := range tx.Body.GetRecipients() {
+		totalAmount += recipient.GetAmount()
+	}
+	return totalAmount
+}
+
+// GetSize number of recipients, then the address and amount of every recipient
+func (tx *MultiSendZBC) GetSize() (uint32, error) {
+	var size = constant.MultiSendZBCNumberOfRecipients
+	for _, recipient := range tx.Body.GetRecipients() {
+		size += uint32(len(recipient.GetRecipientAddress())) + constant.Balance
+	}
+	return size, nil
+}
+
+// ParseBodyBytes read and translate body bytes to body implementation fields
+func (tx *MultiSendZBC) ParseBodyBytes(txBodyBytes []byte) (model.TransactionBodyInterface, error) {
+	var (
+		recipients  []*model.MultiSendZBCRecipient
+		bufferBytes = bytes.NewBuffer(txBodyBytes)
+	)
+	numberOfRecipientsBytes, err := util.ReadTransactionBytes(bufferBytes, int(constant.MultiSendZBCNumberOfRecipients))
+	if err != nil {
+		return nil, err
+	}
+	numberOfRecipients := util.ConvertBytesToUint32(numberOfRecipientsBytes)
+	if numberOfRecipients > uint32(constant.MaxMultiSendZBCRecipients) {
+		return nil, blocker.NewBlocker(blocker.ParserErr, "MultiSendZBCTooManyRecipients")
+	}
+	for i := 0; i < int(numberOfRecipients); i++ {
+		accType, err := accounttype.ParseBytesToAccountType(bufferBytes)
+		if err != nil {
+			return nil, err
+		}
+		recipientAddress, err := accType.GetAccountAddress()
+		if err != nil {
+			return nil, err
+		}
+		amountBytes, err := util.ReadTransactionBytes(bufferBytes, int(constant.Balance))
+		if err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, &model.MultiSendZBCRecipient{
+			RecipientAddress: recipientAddress,
+			Amount:           int64(util.ConvertBytesToUint64(amountBytes)),
+		})
+	}
+	return &model.MultiSendZBCTransactionBody{
+		Recipients: recipients,
+	}, nil
+}
+
+// GetBodyBytes translate tx body to bytes representation
+func (tx *MultiSendZBC) GetBodyBytes() ([]byte, error) {
+	buffer := bytes.NewBuffer([]byte{})
+	buffer.Write(util.ConvertUint32ToBytes(uint32(len(tx.Body.GetRecipients()))))
+	for _, recipient := range tx.Body.GetRecipients() {
+		buffer.Write(recipient.GetRecipientAddress())
+		buffer.Write(util.ConvertUint64ToBytes(uint64(recipient.GetAmount())))
+	}
+	return buffer.Bytes(), nil
+}
+
+// GetTransactionBody append isTransaction_TransactionBody oneOf
+func (tx *MultiSendZBC) GetTransactionBody(transaction *model.Transaction) {
+	transaction.TransactionBody = &model.Transaction_MultiSendZBCTransactionBody{
+		MultiSendZBCTransactionBody: tx.Body,
+	}
+}
+
+/*
+Escrowable will check the transaction is escrow or not.
+Rebuild escrow if not nil, and can use for whole sibling methods (escrow)
+*/
+func (tx *MultiSendZBC) Escrowable() (EscrowTypeAction, bool) {
+	if tx.TransactionObject.Escrow != nil &&
+		tx.TransactionObject.Escrow.GetApproverAddress() != nil &&
+		!bytes.Equal(tx.TransactionObject.Escrow.GetApproverAddress(), []byte{}) {
+		tx.TransactionObject.Escrow = util.PrepareEscrowObjectForAction(tx.TransactionObject)
+		return EscrowTypeAction(tx), true
+	}
+	return nil, false
+}
+
+// EscrowValidate special validation for escrow's transaction
+func (tx *MultiSendZBC) EscrowValidate(dbTx bool) error {
+	var (
+		err    error
+		enough bool
+	)
+	err = util.ValidateBasicEscrow(tx.TransactionObject)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Validate(dbTx)
+	if err != nil {
+		return err
+	}
+	enough, err = tx.AccountBalanceHelper.HasEnoughSpendableBalance(
+		dbTx,
+		tx.TransactionObject.SenderAccountAddress,
+		tx.GetAmount()+tx.TransactionObject.Fee+tx.TransactionObject.Escrow.GetCommission(),
+	)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotFound")
+	}
+	if !enough {
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotEnough")
+	}
+	return nil
+}
+
+// EscrowApplyUnconfirmed is applyUnconfirmed specific for Escrow's transaction
+func (tx *MultiSendZBC) EscrowApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		-(tx.GetAmount() + tx.TransactionObject.Fee + tx.TransactionObject.Escrow.GetCommission()),
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// EscrowUndoApplyUnconfirmed is used to undo the previous applied unconfirmed tx action
+func (tx *MultiSendZBC) EscrowUndoApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		tx.GetAmount()+tx.TransactionObject.Fee+tx.TransactionObject.Escrow.GetCommission(),
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// EscrowApplyConfirmed debit the sender and insert the escrow, the recipients are only paid once the escrow is approved
+func (tx *MultiSendZBC) EscrowApplyConfirmed(blockTimestamp int64) error {
+	var err = tx.AccountBalanceHelper.AddAccountBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		-(tx.GetAmount() + tx.TransactionObject.Fee + tx.TransactionObject.Escrow.GetCommission()),
+		model.EventType_EventEscrowedTransaction,
+		tx.TransactionObject.Height,
+		tx.TransactionObject.ID,
+		uint64(blockTimestamp),
+	)
+	if err != nil {
+		return err
+	}
+
+	escrowQ := tx.EscrowQuery.InsertEscrowTransaction(tx.TransactionObject.Escrow)
+	err = tx.QueryExecutor.ExecuteTransactions(escrowQ)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+/*
+EscrowApproval handle approval an escrow transaction, execute tasks that was skipped when escrow pending.
+like: paying the recipients, spreading commission and fee
+*/
+func (tx *MultiSendZBC) EscrowApproval(blockTimestamp int64, txBody *model.ApprovalEscrowTransactionBody) error {
+	var err error
+
+	switch txBody.GetApproval() {
+	case model.EscrowApproval_Approve:
+		tx.TransactionObject.Escrow.Status = model.EscrowStatus_Approved
+		// Bring back the amount and fee that were decreased on EscrowApplyConfirmed before do ApplyConfirmed
+		err = tx.AccountBalanceHelper.AddAccountBalance(
+			tx.TransactionObject.SenderAccountAddress,
+			tx.GetAmount()+tx.TransactionObject.Fee,
+			model.EventType_EventEscrowedTransaction,
+			tx.TransactionObject.Height,
+			tx.TransactionObject.ID,
+			uint64(blockTimestamp),
+		)
+		if err != nil {
+			return err
+		}
+		err = tx.ApplyConfirmed(blockTimestamp)
+		if err != nil {
+			return err
+		}
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
+			tx.TransactionObject.Height,
+			tx.TransactionObject.ID,
+			blockTimestamp,
+		)
+		if err != nil {
+			return err
+		}
+	case model.EscrowApproval_Reject:
+		tx.TransactionObject.Escrow.Status = model.EscrowStatus_Rejected
+		err = tx.AccountBalanceHelper.AddAccountBalance(
+			tx.TransactionObject.SenderAccountAddress,
+			tx.GetAmount(),
+			model.EventType_EventApprovalEscrowTransaction,
+			tx.TransactionObject.Height,
+			tx.TransactionObject.ID,
+			uint64(blockTimestamp),
+		)
+		if err != nil {
+			return err
+		}
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
+			tx.TransactionObject.Height,
+			tx.TransactionObject.ID,
+			blockTimestamp,
+		)
+		if err != nil {
+			return err
+		}
+	default:
+		tx.TransactionObject.Escrow.Status = model.EscrowStatus_Expired
+		err = tx.AccountBalanceHelper.AddAccountBalance(
+			tx.TransactionObject.SenderAccountAddress,
+			tx.GetAmount()+tx.TransactionObject.Escrow.GetCommission(),
+			model.EventType_EventApprovalEscrowTransaction,
+			tx.TransactionObject.Height,
+			tx.TransactionObject.ID,
+			uint64(blockTimestamp),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	escrowQ := tx.EscrowQuery.InsertEscrowTransaction(tx.TransactionObject.Escrow)
+	err = tx.QueryExecutor.ExecuteTransactions(escrowQ)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,282 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zoobc/zoobc-core/common/fee"
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+func TestMultiSendZBC_Validate(t *testing.T) {
+	tooManyRecipients := make([]*model.MultiSendZBCRecipient, 501)
+	for i := range tooManyRecipients {
+		tooManyRecipients[i] = &model.MultiSendZBCRecipient{RecipientAddress: []byte{byte(i), byte(i >> 8)}, Amount: 1}
+	}
+	tests := []struct {
+		name                 string
+		recipients           []*model.MultiSendZBCRecipient
+		accountBalanceHelper AccountBalanceHelperInterface
+		restricted           bool
+		wantErr              bool
+	}{
+		{
+			name:                 "wantError:NoRecipient",
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:TooManyRecipients",
+			recipients:           tooManyRecipients,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name: "wantError:AmountZero",
+			recipients: []*model.MultiSendZBCRecipient{
+				{RecipientAddress: liquidPayAddress2},
+			},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name: "wantError:NoRecipientAddress",
+			recipients: []*model.MultiSendZBCRecipient{
+				{Amount: 10},
+			},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name: "wantError:DuplicateRecipient",
+			recipients: []*model.MultiSendZBCRecipient{
+				{RecipientAddress: liquidPayAddress2, Amount: 10},
+				{RecipientAddress: liquidPayAddress2, Amount: 20},
+			},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name: "wantError:BalanceNotEnough",
+			recipients: []*model.MultiSendZBCRecipient{
+				{RecipientAddress: liquidPayAddress2, Amount: 10},
+			},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{},
+			wantErr:              true,
+		},
+		{
+			name: "wantError:HasEnoughSpendableBalanceFail",
+			recipients: []*model.MultiSendZBCRecipient{
+				{RecipientAddress: liquidPayAddress2, Amount: 10},
+			},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{err: errors.New("mockedError")},
+			wantErr:              true,
+		},
+		{
+			name: "wantError:RecipientNotWhitelisted",
+			recipients: []*model.MultiSendZBCRecipient{
+				{RecipientAddress: liquidPayAddress2, Amount: 10},
+			},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			restricted:           true,
+			wantErr:              true,
+		},
+		{
+			name: "wantSuccess",
+			recipients: []*model.MultiSendZBCRecipient{
+				{RecipientAddress: liquidPayAddress2, Amount: 10},
+				{RecipientAddress: liquidPayAddress1, Amount: 20},
+			},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &MultiSendZBC{
+				TransactionObject: &model.Transaction{
+					Fee:                  1,
+					SenderAccountAddress: liquidPayAddress1,
+				},
+				Body:                 &model.MultiSendZBCTransactionBody{Recipients: tt.recipients},
+				QueryExecutor:        &executorSetupLiquidPaymentSuccess{},
+				AccountBalanceHelper: tt.accountBalanceHelper,
+				AccountDatasetQuery:  &mockAccountDatasetQuerySpendingRestriction{restricted: tt.restricted},
+			}
+			if err := tx.Validate(false); (err != nil) != tt.wantErr {
+				t.Errorf("MultiSendZBC.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMultiSendZBC_ApplyConfirmed(t *testing.T) {
+	tests := []struct {
+		name                 string
+		accountBalanceHelper *mockTimeLockedSendZBCAccountBalanceHelper
+		wantEvents           []model.EventType
+		wantErr              bool
+	}{
+		{
+			name:                 "wantError:AddAccountBalanceFail",
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{err: errors.New("mockedError")},
+			wantEvents:           []model.EventType{model.EventType_EventMultiSendZBCTransaction},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantSuccess",
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{},
+			wantEvents: []model.EventType{
+				model.EventType_EventMultiSendZBCTransaction,
+				model.EventType_EventMultiSendZBCTransaction,
+				model.EventType_EventMultiSendZBCTransaction,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := GetFixturesForMultiSendZBCTransaction()
+			tx := &MultiSendZBC{
+				TransactionObject: &model.Transaction{
+					ID:                   10,
+					Fee:                  1,
+					Height:               5,
+					SenderAccountAddress: liquidPayAddress1,
+				},
+				Body:                 body,
+				AccountBalanceHelper: tt.accountBalanceHelper,
+			}
+			if err := tx.ApplyConfirmed(1000); (err != nil) != tt.wantErr {
+				t.Errorf("MultiSendZBC.ApplyConfirmed() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(tt.accountBalanceHelper.addedEvents, tt.wantEvents) {
+				t.Errorf("MultiSendZBC.ApplyConfirmed() ledger events = %v, want %v", tt.accountBalanceHelper.addedEvents, tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestMultiSendZBC_GetMinimumFee(t *testing.T) {
+	body, _ := GetFixturesForMultiSendZBCTransaction()
+	tx := &MultiSendZBC{
+		TransactionObject: &model.Transaction{},
+		Body:              body,
+		FeeScaleService:   &mockFeeScaleServiceValidateSuccess{},
+	}
+	got, err := tx.GetMinimumFee()
+	if err != nil {
+		t.Errorf("MultiSendZBC.GetMinimumFee() error = %v", err)
+		return
+	}
+	if want := int64(fee.InitialFeeScale + fee.InitialFeeScale/10); got != want {
+		t.Errorf("MultiSendZBC.GetMinimumFee() = %v, want %v", got, want)
+	}
+}
+
+func TestMultiSendZBC_GetAmount(t *testing.T) {
+	body, _ := GetFixturesForMultiSendZBCTransaction()
+	if got := (&MultiSendZBC{Body: body}).GetAmount(); got != 150 {
+		t.Errorf("MultiSendZBC.GetAmount() = %v, want %v", got, 150)
+	}
+}
+
+func TestMultiSendZBC_ParseBodyBytes(t *testing.T) {
+	body, bodyBytes := GetFixturesForMultiSendZBCTransaction()
+	tests := []struct {
+		name        string
+		txBodyBytes []byte
+		want        model.TransactionBodyInterface
+		wantErr     bool
+	}{
+		{
+			name:        "wantError:NoNumberOfRecipients",
+			txBodyBytes: bodyBytes[:2],
+			wantErr:     true,
+		},
+		{
+			name:        "wantError:TooManyRecipients",
+			txBodyBytes: []byte{255, 255, 0, 0},
+			wantErr:     true,
+		},
+		{
+			name:        "wantError:MissingAmount",
+			txBodyBytes: bodyBytes[:len(bodyBytes)-4],
+			wantErr:     true,
+		},
+		{
+			name:        "wantSuccess",
+			txBodyBytes: bodyBytes,
+			want:        body,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := new(MultiSendZBC).ParseBodyBytes(tt.txBodyBytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MultiSendZBC.ParseBodyBytes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MultiSendZBC.ParseBodyBytes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiSendZBC_GetSize(t *testing.T) {
+	body, bodyBytes := GetFixturesForMultiSendZBCTransaction()
+	got, err := (&MultiSendZBC{Body: body}).GetSize()
+	if err != nil || got != uint32(len(bodyBytes)) {
+		t.Errorf("MultiSendZBC.GetSize() = %v, %v, want %v", got, err, len(bodyBytes))
+	}
+}
@@ -107,6 +107,7 @@ type (
 		InsertMultisignatureInfo(
 			multisigInfo *model.MultiSignatureInfo,
 		) error
+		IsMultisigRotated(multisigAddress []byte, dbTx bool) (bool, error)
 	}
 
 	PendingTransactionHelperInterface interface {
@@ -140,6 +141,7 @@ type (
 	MultisignatureInfoHelper struct {
 		MultisignatureInfoQuery        query.MultisignatureInfoQueryInterface
 		MultiSignatureParticipantQuery query.MultiSignatureParticipantQueryInterface
+		MultiSignatureRotationQuery    query.MultiSignatureRotationQueryInterface
 		QueryExecutor                  query.ExecutorInterface
 	}
 
@@ -324,22 +326,28 @@ func (msi *MultisignatureInfoHelper) GetMultisigInfoByAddress(
 		err              error
 		multisigInfos    []*model.MultiSignatureInfo
 		multisigAccounts [][]byte
+		multisigWeights  []uint32
 	)
-	q, args := msi.MultisignatureInfoQuery.GetMultisignatureInfoByAddressWithParticipants(
-		multisigAddress, blockHeight, constant.MinRollbackBlocks,
-	)
-	rows, err := msi.QueryExecutor.ExecuteSelect(q, false, args...)
-	if err != nil {
-		return status.Error(codes.Internal, err.Error())
-	}
-	defer rows.Close()
-	multisigInfos, err = msi.MultisignatureInfoQuery.BuildModelWithParticipant(multisigInfos, rows)
+	multisigInfos, err = msi.getMultisigInfosByAddress(multisigAddress, blockHeight, constant.MinRollbackBlocks)
 	if err != nil {
 		return err
 	}
 
 	if len(multisigInfos) == 0 {
-		return blocker.NewBlocker(blocker.AppErr, "EmptyResultSet")
+		// the participants set by a rotation can no longer be provided in the multisig transaction, they never expire
+		rotated, err := msi.IsMultisigRotated(multisigAddress, false)
+		if err != nil {
+			return err
+		}
+		if rotated {
+			multisigInfos, err = msi.getMultisigInfosByAddress(multisigAddress, blockHeight, blockHeight)
+			if err != nil {
+				return err
+			}
+		}
+		if len(multisigInfos) == 0 {
+			return blocker.NewBlocker(blocker.AppErr, "EmptyResultSet")
+		}
 	}
 	// make sure we have all data from db when returning
 	multisigInfo.MultisigAddress = multisigInfos[0].GetMultisigAddress()
@@ -350,12 +358,48 @@ func (msi *MultisignatureInfoHelper) GetMultisigInfoByAddress(
 	for _, msInfo := range multisigInfos {
 		if len(msInfo.Addresses[0]) > 0 {
 			multisigAccounts = append(multisigAccounts, msInfo.Addresses[0])
+			multisigWeights = append(multisigWeights, msInfo.GetWeights()...)
 		}
 	}
 	multisigInfo.Addresses = multisigAccounts
+	multisigInfo.Weights = multisigWeights
 	return nil
 }
 
+func (msi *MultisignatureInfoHelper) getMultisigInfosByAddress(
+	multisigAddress []byte,
+	blockHeight, limit uint32,
+) ([]*model.MultiSignatureInfo, error) {
+	var multisigInfos []*model.MultiSignatureInfo
+	q, args := msi.MultisignatureInfoQuery.GetMultisignatureInfoByAddressWithParticipants(
+		multisigAddress, blockHeight, limit,
+	)
+	rows, err := msi.QueryExecutor.ExecuteSelect(q, false, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+	return msi.MultisignatureInfoQuery.BuildModelWithParticipant(multisigInfos, rows)
+}
+
+// IsMultisigRotated tell whether the participants of the multisig address have been replaced by a rotation
+func (msi *MultisignatureInfoHelper) IsMultisigRotated(multisigAddress []byte, dbTx bool) (bool, error) {
+	var rotation model.MultiSignatureRotation
+	q, args := msi.MultiSignatureRotationQuery.GetLatestMultiSignatureRotationByMultisigAddress(multisigAddress)
+	row, err := msi.QueryExecutor.ExecuteSelectRow(q, dbTx, args...)
+	if err != nil {
+		return false, err
+	}
+	err = msi.MultiSignatureRotationQuery.Scan(&rotation, row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 func (msi *MultisignatureInfoHelper) InsertMultisignatureInfo(multisigInfo *model.MultiSignatureInfo) error {
 	var (
 		queries              = msi.MultisignatureInfoQuery.InsertMultisignatureInfo(multisigInfo)
@@ -368,6 +412,7 @@ func (msi *MultisignatureInfoHelper) InsertMultisignatureInfo(multisigInfo *mode
 			AccountAddress:        participant,
 			BlockHeight:           multisigInfo.GetBlockHeight(),
 			Latest:                true,
+			Weight:                util.GetMultisigParticipantStoredWeight(multisigInfo, k),
 		})
 	}
 	participantQ := msi.MultiSignatureParticipantQuery.InsertMultisignatureParticipants(participantAddresses)
@@ -467,12 +512,11 @@ func (tx *MultiSignatureTransaction) ApplyConfirmed(blockTimestamp int64) error
 		return err
 	}
 	// every element in txs will have all three optional field filled, to avoid infinite recursive calls.
+	// tx.Body is kept as it is, the completed bodies are only used to execute their pending transaction
 	for _, v := range txs {
-		cpTx := tx
-		cpTx.Body = v
 		// parse the UnsignedTransactionBytes
 		utx, err := tx.PendingTransactionHelper.ApplyConfirmedPendingTransaction(
-			cpTx.Body.UnsignedTransactionBytes,
+			v.UnsignedTransactionBytes,
 			tx.TransactionObject.Height,
 			blockTimestamp,
 		)
@@ -586,6 +630,21 @@ func (tx *MultiSignatureTransaction) Validate(dbTx bool) error {
 		if err != nil {
 			return err
 		}
+		// participants removed by a rotation must not be able to restore the original multisig info
+		multisigAddress, err := tx.TransactionUtil.GenerateMultiSigAddress(body.MultiSignatureInfo)
+		if err != nil {
+			return err
+		}
+		rotated, err := tx.MultisignatureInfoHelper.IsMultisigRotated(multisigAddress, dbTx)
+		if err != nil {
+			return err
+		}
+		if rotated {
+			return blocker.NewBlocker(
+				blocker.ValidationErr,
+				"MultisigParticipantsRotated",
+			)
+		}
 		for _, address := range body.MultiSignatureInfo.Addresses {
 			multisigInfoAddresses[hex.EncodeToString(address)] = true
 		}
@@ -702,6 +761,9 @@ func (tx *MultiSignatureTransaction) GetSize() (uint32, error) {
 			multisigInfoSize += constant.MultiSigAddressLength
 			multisigInfoSize += uint32(len(v))
 		}
+		if util.IsWeightedMultisig(multisigInfo) {
+			multisigInfoSize += constant.MultiSigParticipantWeight * uint32(len(multisigInfo.GetWeights()))
+		}
 	}
 	// TransactionBytes
 	txByteSize = constant.MultiSigUnsignedTxBytesLength + uint32(len(tx.Body.GetUnsignedTransactionBytes()))
@@ -724,6 +786,7 @@ func (tx *MultiSignatureTransaction) GetSize() (uint32, error) {
 func (tx *MultiSignatureTransaction) ParseBodyBytes(txBodyBytes []byte) (model.TransactionBodyInterface, error) {
 	var (
 		addresses     [][]byte
+		weights       []uint32
 		signatures    = make(map[string][]byte)
 		multisigInfo  *model.MultiSignatureInfo
 		signatureInfo *model.SignatureInfo
@@ -731,7 +794,7 @@ func (tx *MultiSignatureTransaction) ParseBodyBytes(txBodyBytes []byte) (model.T
 	bufferBytes := bytes.NewBuffer(txBodyBytes)
 	// MultisigInfo
 	multisigInfoPresent := util.ConvertBytesToUint32(bufferBytes.Next(int(constant.MultisigFieldLength)))
-	if multisigInfoPresent == constant.MultiSigFieldPresent {
+	if multisigInfoPresent == constant.MultiSigFieldPresent || multisigInfoPresent == constant.MultiSigFieldPresentWeighted {
 		minSignatures := util.ConvertBytesToUint32(bufferBytes.Next(int(constant.MultiSigInfoMinSignature)))
 		nonce := util.ConvertBytesToUint64(bufferBytes.Next(int(constant.MultiSigInfoNonce)))
 		addressesLength := util.ConvertBytesToUint32(bufferBytes.Next(int(constant.MultiSigNumberOfAddress)))
@@ -746,12 +809,22 @@ func (tx *MultiSignatureTransaction) ParseBodyBytes(txBodyBytes []byte) (model.T
 			}
 			addresses = append(addresses, address)
 		}
+		if multisigInfoPresent == constant.MultiSigFieldPresentWeighted {
+			for i := 0; i < int(addressesLength); i++ {
+				weightBytes, err := util.ReadTransactionBytes(bufferBytes, int(constant.MultiSigParticipantWeight))
+				if err != nil {
+					return nil, err
+				}
+				weights = append(weights, util.ConvertBytesToUint32(weightBytes))
+			}
+		}
 		// MultisigAddress is not provided because that data is not present in the body bytes.
 		// It always matches with the sender of the multisig transaction, so external application can get that data from the sender data
 		multisigInfo = &model.MultiSignatureInfo{
 			MinimumSignatures: minSignatures,
 			Nonce:             int64(nonce),
 			Addresses:         addresses,
+			Weights:           weights,
 		}
 	}
 	// TransactionBytes
@@ -804,13 +877,21 @@ func (tx *MultiSignatureTransaction) GetBodyBytes() ([]byte, error) {
 	)
 	// Multisig Info
 	if tx.Body.GetMultiSignatureInfo() != nil {
-		buffer.Write(util.ConvertUint32ToBytes(constant.MultiSigFieldPresent))
+		// weighted multisig info use its own flag, so the body bytes of non weighted multisig info are unchanged
+		if util.IsWeightedMultisig(tx.Body.GetMultiSignatureInfo()) {
+			buffer.Write(util.ConvertUint32ToBytes(constant.MultiSigFieldPresentWeighted))
+		} else {
+			buffer.Write(util.ConvertUint32ToBytes(constant.MultiSigFieldPresent))
+		}
 		buffer.Write(util.ConvertUint32ToBytes(tx.Body.GetMultiSignatureInfo().GetMinimumSignatures()))
 		buffer.Write(util.ConvertUint64ToBytes(uint64(tx.Body.GetMultiSignatureInfo().GetNonce())))
 		buffer.Write(util.ConvertUint32ToBytes(uint32(len(tx.Body.GetMultiSignatureInfo().GetAddresses()))))
 		for _, v := range tx.Body.GetMultiSignatureInfo().GetAddresses() {
 			buffer.Write(v)
 		}
+		for _, weight := range tx.Body.GetMultiSignatureInfo().GetWeights() {
+			buffer.Write(util.ConvertUint32ToBytes(weight))
+		}
 	} else {
 		buffer.Write(util.ConvertUint32ToBytes(constant.MultiSigFieldMissing))
 	}
@@ -927,26 +1008,24 @@ func (tx *MultiSignatureTransaction) EscrowApproval(blockTimestamp int64, txBody
 		if err != nil {
 			return err
 		}
-		err = tx.AccountBalanceHelper.AddAccountBalance(
-			tx.TransactionObject.Escrow.GetApproverAddress(),
-			tx.TransactionObject.Escrow.GetCommission(),
-			model.EventType_EventApprovalEscrowTransaction,
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
-			uint64(blockTimestamp),
+			blockTimestamp,
 		)
 		if err != nil {
 			return err
 		}
 	case model.EscrowApproval_Reject:
 		tx.TransactionObject.Escrow.Status = model.EscrowStatus_Rejected
-		err = tx.AccountBalanceHelper.AddAccountBalance(
-			tx.TransactionObject.Escrow.GetApproverAddress(),
-			tx.TransactionObject.Escrow.GetCommission(),
-			model.EventType_EventApprovalEscrowTransaction,
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
-			uint64(blockTimestamp),
+			blockTimestamp,
 		)
 		if err != nil {
 			return err
@@ -0,0 +1,244 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/fee"
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+	"github.com/zoobc/zoobc-core/common/util"
+)
+
+// MultiSignatureCancelTransaction is Transaction Type that implemented TypeAction.
+// Its sender is the multisig account itself, so it is only applied as the inner transaction of a multisig transaction
+// signed by the threshold of participants
+type MultiSignatureCancelTransaction struct {
+	TransactionObject        *model.Transaction
+	Body                     *model.MultiSignatureCancelTransactionBody
+	QueryExecutor            query.ExecutorInterface
+	PendingTransactionHelper PendingTransactionHelperInterface
+	AccountBalanceHelper     AccountBalanceHelperInterface
+	FeeScaleService          fee.FeeScaleServiceInterface
+}
+
+// ApplyConfirmed mark the pending transaction as cancelled and release what its apply unconfirmed has held.
+// The pending transaction can have been executed or expired since the cancellation was proposed, the cancellation is then a no-op
+func (tx *MultiSignatureCancelTransaction) ApplyConfirmed(blockTimestamp int64) error {
+	var (
+		pendingTx model.PendingTransaction
+		err       error
+	)
+	err = tx.AccountBalanceHelper.AddAccountBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		-tx.TransactionObject.Fee,
+		model.EventType_EventMultiSignatureCancelTransaction,
+		tx.TransactionObject.Height,
+		tx.TransactionObject.ID,
+		uint64(blockTimestamp),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = tx.PendingTransactionHelper.GetPendingTransactionByHash(
+		&pendingTx,
+		tx.Body.GetTransactionHash(),
+		[]model.PendingTransactionStatus{model.PendingTransactionStatus_PendingTransactionPending},
+		tx.TransactionObject.Height,
+		true,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+	err = tx.PendingTransactionHelper.UndoApplyUnconfirmedPendingTransaction(pendingTx.GetTransactionBytes())
+	if err != nil {
+		return err
+	}
+	err = tx.PendingTransactionHelper.InsertPendingTransaction(&model.PendingTransaction{
+		SenderAddress:    pendingTx.GetSenderAddress(),
+		TransactionHash:  pendingTx.GetTransactionHash(),
+		TransactionBytes: pendingTx.GetTransactionBytes(),
+		Status:           model.PendingTransactionStatus_PendingTransactionCancelled,
+		BlockHeight:      tx.TransactionObject.Height,
+		Latest:           true,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *MultiSignatureCancelTransaction) ApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(tx.TransactionObject.SenderAccountAddress, -tx.TransactionObject.Fee)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *MultiSignatureCancelTransaction) UndoApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(tx.TransactionObject.SenderAccountAddress, tx.TransactionObject.Fee)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+/*
+Validate is func that for validating to Transaction MultiSignatureCancel type
+That specs:
+  - the transaction hash is a pending transaction of the sender, the multisig account
+  - `sender.spendable_balance` must be enough for the fee
+*/
+func (tx *MultiSignatureCancelTransaction) Validate(dbTx bool) error {
+	var (
+		pendingTx model.PendingTransaction
+		err       error
+		enough    bool
+	)
+	if tx.TransactionObject.SenderAccountAddress == nil {
+		return errors.New("transaction must have a valid sender account id")
+	}
+	if len(tx.Body.GetTransactionHash()) != int(constant.MultiSigTransactionHash) {
+		return blocker.NewBlocker(blocker.ValidationErr, "InvalidTransactionHash")
+	}
+
+	err = tx.PendingTransactionHelper.GetPendingTransactionByHash(
+		&pendingTx,
+		tx.Body.GetTransactionHash(),
+		[]model.PendingTransactionStatus{model.PendingTransactionStatus_PendingTransactionPending},
+		tx.TransactionObject.Height,
+		dbTx,
+	)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		return blocker.NewBlocker(blocker.ValidationErr, "NoPendingTransactionWithProvidedTransactionHash")
+	}
+	if !bytes.Equal(pendingTx.GetSenderAddress(), tx.TransactionObject.SenderAccountAddress) {
+		return blocker.NewBlocker(blocker.ValidationErr, "PendingTransactionNotSentBySender")
+	}
+
+	enough, err = tx.AccountBalanceHelper.HasEnoughSpendableBalance(dbTx, tx.TransactionObject.SenderAccountAddress, tx.TransactionObject.Fee)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotFound")
+	}
+	if !enough {
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotEnough")
+	}
+	return nil
+}
+
+func (tx *MultiSignatureCancelTransaction) GetMinimumFee() (int64, error) {
+	var lastFeeScale model.FeeScale
+	err := tx.FeeScaleService.GetLatestFeeScale(&lastFeeScale)
+	if err != nil {
+		return 0, err
+	}
+	return fee.CalculateTxMinimumFee(tx.TransactionObject, lastFeeScale.FeeScale)
+}
+
+// GetAmount a cancellation doesn't move any amount
+func (*MultiSignatureCancelTransaction) GetAmount() int64 {
+	return 0
+}
+
+// GetSize the hash of the cancelled pending transaction
+func (*MultiSignatureCancelTransaction) GetSize() (uint32, error) {
+	return constant.MultiSigTransactionHash, nil
+}
+
+// ParseBodyBytes read and translate body bytes to body implementation fields
+func (*MultiSignatureCancelTransaction) ParseBodyBytes(txBodyBytes []byte) (model.TransactionBodyInterface, error) {
+	bufferBytes := bytes.NewBuffer(txBodyBytes)
+	transactionHash, err := util.ReadTransactionBytes(bufferBytes, int(constant.MultiSigTransactionHash))
+	if err != nil {
+		return nil, err
+	}
+	return &model.MultiSignatureCancelTransactionBody{
+		TransactionHash: transactionHash,
+	}, nil
+}
+
+// GetBodyBytes translate tx body to bytes representation
+func (tx *MultiSignatureCancelTransaction) GetBodyBytes() ([]byte, error) {
+	buffer := bytes.NewBuffer([]byte{})
+	buffer.Write(tx.Body.GetTransactionHash())
+	return buffer.Bytes(), nil
+}
+
+// GetTransactionBody append isTransaction_TransactionBody oneOf
+func (tx *MultiSignatureCancelTransaction) GetTransactionBody(transaction *model.Transaction) {
+	transaction.TransactionBody = &model.Transaction_MultiSignatureCancelTransactionBody{
+		MultiSignatureCancelTransactionBody: tx.Body,
+	}
+}
+
+func (*MultiSignatureCancelTransaction) SkipMempoolTransaction([]*model.Transaction, int64, uint32) (bool, error) {
+	return false, nil
+}
+
+// Escrowable a cancellation is already approved by the threshold of the multisig account, it can't be escrowed
+func (*MultiSignatureCancelTransaction) Escrowable() (EscrowTypeAction, bool) {
+	return nil, false
+}
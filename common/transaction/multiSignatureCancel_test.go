@@ -0,0 +1,270 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+type (
+	mockMultiSignatureCancelPendingTransactionHelper struct {
+		PendingTransactionHelperInterface
+		getErr, undoErr, insertErr error
+		sender                     []byte
+		inserted                   *model.PendingTransaction
+	}
+)
+
+func (m *mockMultiSignatureCancelPendingTransactionHelper) GetPendingTransactionByHash(
+	pendingTx *model.PendingTransaction,
+	pendingTransactionHash []byte,
+	pendingTransactionStatuses []model.PendingTransactionStatus,
+	blockHeight uint32,
+	dbTx bool,
+) error {
+	if m.getErr != nil {
+		return m.getErr
+	}
+	*pendingTx = model.PendingTransaction{
+		SenderAddress:    m.sender,
+		TransactionHash:  pendingTransactionHash,
+		TransactionBytes: []byte{1, 2, 3},
+		Status:           model.PendingTransactionStatus_PendingTransactionPending,
+		BlockHeight:      5,
+		Latest:           true,
+	}
+	return nil
+}
+
+func (m *mockMultiSignatureCancelPendingTransactionHelper) UndoApplyUnconfirmedPendingTransaction([]byte) error {
+	return m.undoErr
+}
+
+func (m *mockMultiSignatureCancelPendingTransactionHelper) InsertPendingTransaction(pendingTx *model.PendingTransaction) error {
+	m.inserted = pendingTx
+	return m.insertErr
+}
+
+func TestMultiSignatureCancelTransaction_Validate(t *testing.T) {
+	body, _ := GetFixturesForMultiSignatureCancelTransaction()
+	tests := []struct {
+		name                 string
+		body                 *model.MultiSignatureCancelTransactionBody
+		pendingTxHelper      PendingTransactionHelperInterface
+		accountBalanceHelper AccountBalanceHelperInterface
+		wantErr              bool
+	}{
+		{
+			name:                 "wantError:InvalidTransactionHash",
+			body:                 &model.MultiSignatureCancelTransactionBody{TransactionHash: []byte{1, 2, 3}},
+			pendingTxHelper:      &mockMultiSignatureCancelPendingTransactionHelper{sender: senderAddress4},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:NotPending",
+			body:                 body,
+			pendingTxHelper:      &mockMultiSignatureCancelPendingTransactionHelper{getErr: sql.ErrNoRows},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:PendingTransactionOfAnotherAccount",
+			body:                 body,
+			pendingTxHelper:      &mockMultiSignatureCancelPendingTransactionHelper{sender: senderAddress1},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:BalanceNotEnough",
+			body:                 body,
+			pendingTxHelper:      &mockMultiSignatureCancelPendingTransactionHelper{sender: senderAddress4},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantSuccess",
+			body:                 body,
+			pendingTxHelper:      &mockMultiSignatureCancelPendingTransactionHelper{sender: senderAddress4},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &MultiSignatureCancelTransaction{
+				TransactionObject: &model.Transaction{
+					Fee:                  1,
+					Height:               10,
+					SenderAccountAddress: senderAddress4,
+				},
+				Body:                     tt.body,
+				PendingTransactionHelper: tt.pendingTxHelper,
+				AccountBalanceHelper:     tt.accountBalanceHelper,
+			}
+			if err := tx.Validate(false); (err != nil) != tt.wantErr {
+				t.Errorf("MultiSignatureCancelTransaction.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMultiSignatureCancelTransaction_ApplyConfirmed(t *testing.T) {
+	body, _ := GetFixturesForMultiSignatureCancelTransaction()
+	tests := []struct {
+		name            string
+		pendingTxHelper *mockMultiSignatureCancelPendingTransactionHelper
+		wantInserted    bool
+		wantErr         bool
+	}{
+		{
+			name:            "wantSuccess:NoLongerPending",
+			pendingTxHelper: &mockMultiSignatureCancelPendingTransactionHelper{getErr: sql.ErrNoRows},
+		},
+		{
+			name:            "wantError:GetPendingTransactionFail",
+			pendingTxHelper: &mockMultiSignatureCancelPendingTransactionHelper{getErr: errors.New("mockedError")},
+			wantErr:         true,
+		},
+		{
+			name: "wantError:UndoApplyUnconfirmedFail",
+			pendingTxHelper: &mockMultiSignatureCancelPendingTransactionHelper{
+				sender:  senderAddress4,
+				undoErr: errors.New("mockedError"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantError:InsertPendingTransactionFail",
+			pendingTxHelper: &mockMultiSignatureCancelPendingTransactionHelper{
+				sender:    senderAddress4,
+				insertErr: errors.New("mockedError"),
+			},
+			wantErr: true,
+		},
+		{
+			name:            "wantSuccess",
+			pendingTxHelper: &mockMultiSignatureCancelPendingTransactionHelper{sender: senderAddress4},
+			wantInserted:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &MultiSignatureCancelTransaction{
+				TransactionObject: &model.Transaction{
+					ID:                   12,
+					Fee:                  1,
+					Height:               10,
+					SenderAccountAddress: senderAddress4,
+				},
+				Body:                     body,
+				PendingTransactionHelper: tt.pendingTxHelper,
+				AccountBalanceHelper:     &mockTimeLockedSendZBCAccountBalanceHelper{},
+			}
+			err := tx.ApplyConfirmed(1000)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MultiSignatureCancelTransaction.ApplyConfirmed() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantInserted {
+				return
+			}
+			want := &model.PendingTransaction{
+				SenderAddress:    senderAddress4,
+				TransactionHash:  body.GetTransactionHash(),
+				TransactionBytes: []byte{1, 2, 3},
+				Status:           model.PendingTransactionStatus_PendingTransactionCancelled,
+				BlockHeight:      10,
+				Latest:           true,
+			}
+			if !reflect.DeepEqual(tt.pendingTxHelper.inserted, want) {
+				t.Errorf("MultiSignatureCancelTransaction.ApplyConfirmed() inserted %v, want %v", tt.pendingTxHelper.inserted, want)
+			}
+		})
+	}
+}
+
+func TestMultiSignatureCancelTransaction_ParseBodyBytes(t *testing.T) {
+	body, bodyBytes := GetFixturesForMultiSignatureCancelTransaction()
+	tests := []struct {
+		name      string
+		bodyBytes []byte
+		want      model.TransactionBodyInterface
+		wantErr   bool
+	}{
+		{
+			name:      "wantError:TruncatedTransactionHash",
+			bodyBytes: bodyBytes[:20],
+			wantErr:   true,
+		},
+		{
+			name:      "wantSuccess",
+			bodyBytes: bodyBytes,
+			want:      body,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &MultiSignatureCancelTransaction{}
+			got, err := tx.ParseBodyBytes(tt.bodyBytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MultiSignatureCancelTransaction.ParseBodyBytes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MultiSignatureCancelTransaction.ParseBodyBytes() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+
+	"github.com/zoobc/zoobc-core/common/accounttype"
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/fee"
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+	"github.com/zoobc/zoobc-core/common/util"
+)
+
+// MultiSignatureRotationTransaction is Transaction Type that implemented TypeAction.
+// Its sender is the multisig account itself, so it is only applied as the inner transaction of a multisig transaction
+// signed by the current threshold of participants
+type MultiSignatureRotationTransaction struct {
+	TransactionObject           *model.Transaction
+	Body                        *model.MultiSignatureRotationTransactionBody
+	QueryExecutor               query.ExecutorInterface
+	MultiSignatureRotationQuery query.MultiSignatureRotationQueryInterface
+	MultisignatureInfoHelper    MultisignatureInfoHelperInterface
+	MultisigUtil                MultisigTransactionUtilInterface
+	AccountBalanceHelper        AccountBalanceHelperInterface
+	FeeScaleService             fee.FeeScaleServiceInterface
+}
+
+// ApplyConfirmed replace the participants and threshold of the multisig account, keeping its address and nonce
+func (tx *MultiSignatureRotationTransaction) ApplyConfirmed(blockTimestamp int64) error {
+	var (
+		multisigInfo model.MultiSignatureInfo
+		err          error
+	)
+	err = tx.AccountBalanceHelper.AddAccountBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		-tx.TransactionObject.Fee,
+		model.EventType_EventMultiSignatureRotationTransaction,
+		tx.TransactionObject.Height,
+		tx.TransactionObject.ID,
+		uint64(blockTimestamp),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = tx.MultisignatureInfoHelper.GetMultisigInfoByAddress(&multisigInfo, tx.TransactionObject.SenderAccountAddress, tx.TransactionObject.Height)
+	if err != nil {
+		return err
+	}
+	err = tx.MultisignatureInfoHelper.InsertMultisignatureInfo(&model.MultiSignatureInfo{
+		MultisigAddress:   tx.TransactionObject.SenderAccountAddress,
+		MinimumSignatures: tx.Body.GetMinimumSignatures(),
+		Nonce:             multisigInfo.GetNonce(),
+		Addresses:         tx.Body.GetAddresses(),
+		Weights:           tx.Body.GetWeights(),
+		BlockHeight:       tx.TransactionObject.Height,
+		Latest:            true,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryExecutor.ExecuteTransactions(tx.MultiSignatureRotationQuery.InsertMultiSignatureRotation(&model.MultiSignatureRotation{
+		ID:                tx.TransactionObject.ID,
+		MultisigAddress:   tx.TransactionObject.SenderAccountAddress,
+		MinimumSignatures: tx.Body.GetMinimumSignatures(),
+		Addresses:         tx.Body.GetAddresses(),
+		Weights:           tx.Body.GetWeights(),
+		BlockHeight:       tx.TransactionObject.Height,
+	}))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *MultiSignatureRotationTransaction) ApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(tx.TransactionObject.SenderAccountAddress, -tx.TransactionObject.Fee)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *MultiSignatureRotationTransaction) UndoApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(tx.TransactionObject.SenderAccountAddress, tx.TransactionObject.Fee)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+/*
+Validate is func that for validating to Transaction MultiSignatureRotation type
+That specs:
+	- the sender is a multisig account already known by the node
+	- the new participants are a valid multisig info, distinct from each other
+	- the summed weight of the new participants can reach the new threshold
+	- `sender.spendable_balance` must be enough for the fee
+*/
+func (tx *MultiSignatureRotationTransaction) Validate(dbTx bool) error {
+	var (
+		multisigInfo model.MultiSignatureInfo
+		rotatedInfo  = &model.MultiSignatureInfo{
+			MinimumSignatures: tx.Body.GetMinimumSignatures(),
+			Addresses:         tx.Body.GetAddresses(),
+			Weights:           tx.Body.GetWeights(),
+		}
+		weights []uint32
+		err     error
+		enough  bool
+	)
+	if tx.TransactionObject.SenderAccountAddress == nil {
+		return errors.New("transaction must have a valid sender account id")
+	}
+
+	err = tx.MultisigUtil.ValidateMultisignatureInfo(rotatedInfo)
+	if err != nil {
+		return err
+	}
+	// a non weighted rotation is checked as if every participant weights 1
+	for i := range rotatedInfo.GetAddresses() {
+		weights = append(weights, util.GetMultisigParticipantWeight(rotatedInfo, i))
+	}
+	err = validateMultisignatureWeights(&model.MultiSignatureInfo{
+		MinimumSignatures: rotatedInfo.GetMinimumSignatures(),
+		Addresses:         rotatedInfo.GetAddresses(),
+		Weights:           weights,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tx.MultisignatureInfoHelper.GetMultisigInfoByAddress(&multisigInfo, tx.TransactionObject.SenderAccountAddress, tx.TransactionObject.Height)
+	if err != nil {
+		return blocker.NewBlocker(blocker.ValidationErr, "SenderNotMultisigAccount")
+	}
+
+	enough, err = tx.AccountBalanceHelper.HasEnoughSpendableBalance(dbTx, tx.TransactionObject.SenderAccountAddress, tx.TransactionObject.Fee)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotFound")
+	}
+	if !enough {
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotEnough")
+	}
+	return nil
+}
+
+func (tx *MultiSignatureRotationTransaction) GetMinimumFee() (int64, error) {
+	var lastFeeScale model.FeeScale
+	err := tx.FeeScaleService.GetLatestFeeScale(&lastFeeScale)
+	if err != nil {
+		return 0, err
+	}
+	return fee.CalculateTxMinimumFee(tx.TransactionObject, lastFeeScale.FeeScale)
+}
+
+// GetAmount a rotation doesn't move any amount
+func (*MultiSignatureRotationTransaction) GetAmount() int64 {
+	return 0
+}
+
+// GetSize the threshold, the participant addresses and their weights
+func (tx *MultiSignatureRotationTransaction) GetSize() (uint32, error) {
+	var size = constant.MultiSigInfoMinSignature + constant.MultiSigNumberOfAddress + constant.MultiSigNumberOfWeights
+	for _, address := range tx.Body.GetAddresses() {
+		size += uint32(len(address))
+	}
+	size += constant.MultiSigParticipantWeight * uint32(len(tx.Body.GetWeights()))
+	return size, nil
+}
+
+// ParseBodyBytes read and translate body bytes to body implementation fields
+func (*MultiSignatureRotationTransaction) ParseBodyBytes(txBodyBytes []byte) (model.TransactionBodyInterface, error) {
+	var (
+		addresses [][]byte
+		weights   []uint32
+	)
+	bufferBytes := bytes.NewBuffer(txBodyBytes)
+	minSignaturesBytes, err := util.ReadTransactionBytes(bufferBytes, int(constant.MultiSigInfoMinSignature))
+	if err != nil {
+		return nil, err
+	}
+	addressesLengthBytes, err := util.ReadTransactionBytes(bufferBytes, int(constant.MultiSigNumberOfAddress))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < int(util.ConvertBytesToUint32(addressesLengthBytes)); i++ {
+		accType, err := accounttype.ParseBytesToAccountType(bufferBytes)
+		if err != nil {
+			return nil, err
+		}
+		address, err := accType.GetAccountAddress()
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+	weightsLengthBytes, err := util.ReadTransactionBytes(bufferBytes, int(constant.MultiSigNumberOfWeights))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < int(util.ConvertBytesToUint32(weightsLengthBytes)); i++ {
+		weightBytes, err := util.ReadTransactionBytes(bufferBytes, int(constant.MultiSigParticipantWeight))
+		if err != nil {
+			return nil, err
+		}
+		weights = append(weights, util.ConvertBytesToUint32(weightBytes))
+	}
+	return &model.MultiSignatureRotationTransactionBody{
+		MinimumSignatures: util.ConvertBytesToUint32(minSignaturesBytes),
+		Addresses:         addresses,
+		Weights:           weights,
+	}, nil
+}
+
+// GetBodyBytes translate tx body to bytes representation
+func (tx *MultiSignatureRotationTransaction) GetBodyBytes() ([]byte, error) {
+	buffer := bytes.NewBuffer([]byte{})
+	buffer.Write(util.ConvertUint32ToBytes(tx.Body.GetMinimumSignatures()))
+	buffer.Write(util.ConvertUint32ToBytes(uint32(len(tx.Body.GetAddresses()))))
+	for _, address := range tx.Body.GetAddresses() {
+		buffer.Write(address)
+	}
+	buffer.Write(util.ConvertUint32ToBytes(uint32(len(tx.Body.GetWeights()))))
+	for _, weight := range tx.Body.GetWeights() {
+		buffer.Write(util.ConvertUint32ToBytes(weight))
+	}
+	return buffer.Bytes(), nil
+}
+
+// GetTransactionBody append isTransaction_TransactionBody oneOf
+func (tx *MultiSignatureRotationTransaction) GetTransactionBody(transaction *model.Transaction) {
+	transaction.TransactionBody = &model.Transaction_MultiSignatureRotationTransactionBody{
+		MultiSignatureRotationTransactionBody: tx.Body,
+	}
+}
+
+func (*MultiSignatureRotationTransaction) SkipMempoolTransaction([]*model.Transaction, int64, uint32) (bool, error) {
+	return false, nil
+}
+
+// Escrowable a rotation is already approved by the threshold of the multisig account, it can't be escrowed
+func (*MultiSignatureRotationTransaction) Escrowable() (EscrowTypeAction, bool) {
+	return nil, false
+}
@@ -0,0 +1,301 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+)
+
+type (
+	mockMultiSignatureRotationInfoHelper struct {
+		MultisignatureInfoHelperInterface
+		getErr, insertErr error
+		inserted          *model.MultiSignatureInfo
+	}
+)
+
+func (m *mockMultiSignatureRotationInfoHelper) GetMultisigInfoByAddress(
+	multisigInfo *model.MultiSignatureInfo,
+	multisigAddress []byte,
+	blockHeight uint32,
+) error {
+	if m.getErr != nil {
+		return m.getErr
+	}
+	*multisigInfo = model.MultiSignatureInfo{
+		MultisigAddress:   multisigAddress,
+		MinimumSignatures: 2,
+		Nonce:             7,
+		Addresses:         [][]byte{senderAddress1, senderAddress2},
+	}
+	return nil
+}
+
+func (m *mockMultiSignatureRotationInfoHelper) InsertMultisignatureInfo(multisigInfo *model.MultiSignatureInfo) error {
+	m.inserted = multisigInfo
+	return m.insertErr
+}
+
+func TestMultiSignatureRotationTransaction_Validate(t *testing.T) {
+	tests := []struct {
+		name                 string
+		body                 *model.MultiSignatureRotationTransactionBody
+		infoHelper           MultisignatureInfoHelperInterface
+		accountBalanceHelper AccountBalanceHelperInterface
+		wantErr              bool
+	}{
+		{
+			name: "wantError:OneParticipant",
+			body: &model.MultiSignatureRotationTransactionBody{
+				MinimumSignatures: 1,
+				Addresses:         [][]byte{senderAddress2},
+			},
+			infoHelper:           &mockMultiSignatureRotationInfoHelper{},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name: "wantError:DuplicateParticipant",
+			body: &model.MultiSignatureRotationTransactionBody{
+				MinimumSignatures: 2,
+				Addresses:         [][]byte{senderAddress2, senderAddress2},
+			},
+			infoHelper:           &mockMultiSignatureRotationInfoHelper{},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name: "wantError:MinimumSignaturesMoreThanParticipants",
+			body: &model.MultiSignatureRotationTransactionBody{
+				MinimumSignatures: 3,
+				Addresses:         [][]byte{senderAddress2, senderAddress3},
+			},
+			infoHelper:           &mockMultiSignatureRotationInfoHelper{},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name: "wantError:SenderNotMultisigAccount",
+			body: &model.MultiSignatureRotationTransactionBody{
+				MinimumSignatures: 2,
+				Addresses:         [][]byte{senderAddress2, senderAddress3},
+			},
+			infoHelper:           &mockMultiSignatureRotationInfoHelper{getErr: errors.New("EmptyResultSet")},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name: "wantError:BalanceNotEnough",
+			body: &model.MultiSignatureRotationTransactionBody{
+				MinimumSignatures: 2,
+				Addresses:         [][]byte{senderAddress2, senderAddress3},
+			},
+			infoHelper:           &mockMultiSignatureRotationInfoHelper{},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{},
+			wantErr:              true,
+		},
+		{
+			name: "wantSuccess:Weighted",
+			body: &model.MultiSignatureRotationTransactionBody{
+				MinimumSignatures: 3,
+				Addresses:         [][]byte{senderAddress2, senderAddress3},
+				Weights:           []uint32{2, 1},
+			},
+			infoHelper:           &mockMultiSignatureRotationInfoHelper{},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &MultiSignatureRotationTransaction{
+				TransactionObject: &model.Transaction{
+					Fee:                  1,
+					Height:               10,
+					SenderAccountAddress: senderAddress4,
+				},
+				Body:                     tt.body,
+				MultisignatureInfoHelper: tt.infoHelper,
+				MultisigUtil:             NewMultisigTransactionUtil(),
+				AccountBalanceHelper:     tt.accountBalanceHelper,
+			}
+			if err := tx.Validate(false); (err != nil) != tt.wantErr {
+				t.Errorf("MultiSignatureRotationTransaction.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMultiSignatureRotationTransaction_ApplyConfirmed(t *testing.T) {
+	body, _ := GetFixturesForMultiSignatureRotationTransaction()
+	tests := []struct {
+		name          string
+		queryExecutor query.ExecutorInterface
+		infoHelper    *mockMultiSignatureRotationInfoHelper
+		wantErr       bool
+	}{
+		{
+			name:          "wantError:MultisigInfoNotFound",
+			queryExecutor: &executorSetupLiquidPaymentSuccess{},
+			infoHelper:    &mockMultiSignatureRotationInfoHelper{getErr: errors.New("EmptyResultSet")},
+			wantErr:       true,
+		},
+		{
+			name:          "wantError:InsertMultisigInfoFail",
+			queryExecutor: &executorSetupLiquidPaymentSuccess{},
+			infoHelper:    &mockMultiSignatureRotationInfoHelper{insertErr: errors.New("mockedError")},
+			wantErr:       true,
+		},
+		{
+			name:          "wantError:InsertRotationFail",
+			queryExecutor: &executorSetupLiquidPaymentFail{},
+			infoHelper:    &mockMultiSignatureRotationInfoHelper{},
+			wantErr:       true,
+		},
+		{
+			name:          "wantSuccess",
+			queryExecutor: &executorSetupLiquidPaymentSuccess{},
+			infoHelper:    &mockMultiSignatureRotationInfoHelper{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &MultiSignatureRotationTransaction{
+				TransactionObject: &model.Transaction{
+					ID:                   12,
+					Fee:                  1,
+					Height:               10,
+					SenderAccountAddress: senderAddress4,
+				},
+				Body:                        body,
+				QueryExecutor:               tt.queryExecutor,
+				MultiSignatureRotationQuery: query.NewMultiSignatureRotationQuery(),
+				MultisignatureInfoHelper:    tt.infoHelper,
+				AccountBalanceHelper:        &mockTimeLockedSendZBCAccountBalanceHelper{},
+			}
+			err := tx.ApplyConfirmed(1000)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MultiSignatureRotationTransaction.ApplyConfirmed() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			want := &model.MultiSignatureInfo{
+				MultisigAddress:   senderAddress4,
+				MinimumSignatures: body.GetMinimumSignatures(),
+				Nonce:             7,
+				Addresses:         body.GetAddresses(),
+				Weights:           body.GetWeights(),
+				BlockHeight:       10,
+				Latest:            true,
+			}
+			if !reflect.DeepEqual(tt.infoHelper.inserted, want) {
+				t.Errorf("MultiSignatureRotationTransaction.ApplyConfirmed() inserted %v, want %v", tt.infoHelper.inserted, want)
+			}
+		})
+	}
+}
+
+func TestMultiSignatureRotationTransaction_ParseBodyBytes(t *testing.T) {
+	body, bodyBytes := GetFixturesForMultiSignatureRotationTransaction()
+	tests := []struct {
+		name      string
+		bodyBytes []byte
+		want      model.TransactionBodyInterface
+		wantErr   bool
+	}{
+		{
+			name:      "wantError:TruncatedWeights",
+			bodyBytes: bodyBytes[:len(bodyBytes)-2],
+			wantErr:   true,
+		},
+		{
+			name:      "wantError:TruncatedAddresses",
+			bodyBytes: bodyBytes[:20],
+			wantErr:   true,
+		},
+		{
+			name:      "wantSuccess",
+			bodyBytes: bodyBytes,
+			want:      body,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &MultiSignatureRotationTransaction{}
+			got, err := tx.ParseBodyBytes(tt.bodyBytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MultiSignatureRotationTransaction.ParseBodyBytes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MultiSignatureRotationTransaction.ParseBodyBytes() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiSignatureRotationTransaction_GetSize(t *testing.T) {
+	body, bodyBytes := GetFixturesForMultiSignatureRotationTransaction()
+	tx := &MultiSignatureRotationTransaction{Body: body}
+	got, err := tx.GetSize()
+	if err != nil {
+		t.Errorf("MultiSignatureRotationTransaction.GetSize() error = %v", err)
+		return
+	}
+	if got != uint32(len(bodyBytes)) {
+		t.Errorf("MultiSignatureRotationTransaction.GetSize() = %d, want %d", got, len(bodyBytes))
+	}
+}
@@ -55,6 +55,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/zoobc/zoobc-core/common/constant"
 	"github.com/zoobc/zoobc-core/common/crypto"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -71,6 +72,12 @@ type (
 		emptyResultSet bool
 		query.Executor
 	}
+	multisignatureInfoHelperQueryExecutorNoRotation struct {
+		multisignatureInfoHelperQueryExecutorSuccess
+	}
+	multisignatureInfoHelperQueryExecutorRotated struct {
+		query.Executor
+	}
 	// multisignatureInfoHelper mocks
 
 )
@@ -130,9 +137,9 @@ func (mockMsi *multisignatureInfoHelperQueryExecutorSuccess) ExecuteSelect(qStr
 
 	multisigInfoQuery := query.NewMultisignatureInfoQuery()
 	if mockMsi.emptyResultSet {
-		mock.ExpectQuery("").WillReturnRows(sqlmock.NewRows(append(multisigInfoQuery.Fields, "account_address")))
+		mock.ExpectQuery("").WillReturnRows(sqlmock.NewRows(append(multisigInfoQuery.Fields, "account_address", "weight")))
 	} else {
-		mockRows := mock.NewRows(append(multisigInfoQuery.Fields, "account_address"))
+		mockRows := mock.NewRows(append(multisigInfoQuery.Fields, "account_address", "weight"))
 		mockRows.AddRow(
 			mockMultisignatureInfoHelperMultisigInfoSuccess.MultisigAddress,
 			mockMultisignatureInfoHelperMultisigInfoSuccess.MinimumSignatures,
@@ -140,6 +147,7 @@ func (mockMsi *multisignatureInfoHelperQueryExecutorSuccess) ExecuteSelect(qStr
 			mockMultisignatureInfoHelperMultisigInfoSuccess.BlockHeight,
 			mockMultisignatureInfoHelperMultisigInfoSuccess.Latest,
 			mockMultisignatureInfoHelperMultisigInfoSuccess.Addresses[0],
+			constant.MultiSigParticipantNotWeighted,
 		)
 		mockRows.AddRow(
 			mockMultisignatureInfoHelperMultisigInfoSuccess.MultisigAddress,
@@ -148,6 +156,7 @@ func (mockMsi *multisignatureInfoHelperQueryExecutorSuccess) ExecuteSelect(qStr
 			mockMultisignatureInfoHelperMultisigInfoSuccess.BlockHeight,
 			mockMultisignatureInfoHelperMultisigInfoSuccess.Latest,
 			mockMultisignatureInfoHelperMultisigInfoSuccess.Addresses[1],
+			constant.MultiSigParticipantNotWeighted,
 		)
 		mockRows.AddRow(
 			mockMultisignatureInfoHelperMultisigInfoSuccess.MultisigAddress,
@@ -156,6 +165,7 @@ func (mockMsi *multisignatureInfoHelperQueryExecutorSuccess) ExecuteSelect(qStr
 			mockMultisignatureInfoHelperMultisigInfoSuccess.BlockHeight,
 			mockMultisignatureInfoHelperMultisigInfoSuccess.Latest,
 			mockMultisignatureInfoHelperMultisigInfoSuccess.Addresses[2],
+			constant.MultiSigParticipantNotWeighted,
 		)
 		mock.ExpectQuery("").WillReturnRows(mockRows)
 	}
@@ -163,13 +173,76 @@ func (mockMsi *multisignatureInfoHelperQueryExecutorSuccess) ExecuteSelect(qStr
 
 }
 
+func (*multisignatureInfoHelperQueryExecutorNoRotation) ExecuteSelectRow(
+	string, bool, ...interface{},
+) (*sql.Row, error) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	mock.ExpectQuery("").WillReturnRows(sqlmock.NewRows(query.NewMultiSignatureRotationQuery().Fields))
+	return db.QueryRow(""), nil
+}
+
+func (*multisignatureInfoHelperQueryExecutorRotated) ExecuteSelectRow(
+	string, bool, ...interface{},
+) (*sql.Row, error) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	rotationQuery := query.NewMultiSignatureRotationQuery()
+	mock.ExpectQuery("").WillReturnRows(sqlmock.NewRows(rotationQuery.Fields).AddRow(
+		int64(1),
+		mockMultisignatureInfoHelperMultisigInfoSuccess.MultisigAddress,
+		uint32(1),
+		mockMultisignatureInfoHelperMultisigInfoSuccess.Addresses[0],
+		[]byte{},
+		uint32(720),
+		true,
+	))
+	return db.QueryRow(""), nil
+}
+
+func TestMultisignatureInfoHelper_IsMultisigRotated(t *testing.T) {
+	tests := []struct {
+		name          string
+		queryExecutor query.ExecutorInterface
+		want          bool
+	}{
+		{
+			name:          "IsMultisigRotated - not rotated",
+			queryExecutor: &multisignatureInfoHelperQueryExecutorNoRotation{},
+			want:          false,
+		},
+		{
+			name:          "IsMultisigRotated - rotated",
+			queryExecutor: &multisignatureInfoHelperQueryExecutorRotated{},
+			want:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msi := &MultisignatureInfoHelper{
+				MultiSignatureRotationQuery: query.NewMultiSignatureRotationQuery(),
+				QueryExecutor:               tt.queryExecutor,
+			}
+			got, err := msi.IsMultisigRotated(mockMultisignatureInfoHelperMultisigInfoSuccess.MultisigAddress, false)
+			if err != nil {
+				t.Errorf("IsMultisigRotated() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsMultisigRotated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMultisignatureInfoHelper_GetMultisigInfoByAddress(t *testing.T) {
 	var (
 		multisigInfoSuccess model.MultiSignatureInfo
 	)
 	type fields struct {
-		MultisignatureInfoQuery query.MultisignatureInfoQueryInterface
-		QueryExecutor           query.ExecutorInterface
+		MultisignatureInfoQuery     query.MultisignatureInfoQueryInterface
+		MultiSignatureRotationQuery query.MultiSignatureRotationQueryInterface
+		QueryExecutor               query.ExecutorInterface
 	}
 	type args struct {
 		multisigInfo    *model.MultiSignatureInfo
@@ -198,9 +271,12 @@ func TestMultisignatureInfoHelper_GetMultisigInfoByAddress(t *testing.T) {
 		{
 			name: "GetMultisigInfo - buildModel empty",
 			fields: fields{
-				MultisignatureInfoQuery: &multisignatureInfoHelperMultisignatureInfoQueryScanFail{},
-				QueryExecutor: &multisignatureInfoHelperQueryExecutorSuccess{
-					emptyResultSet: true,
+				MultisignatureInfoQuery:     &multisignatureInfoHelperMultisignatureInfoQueryScanFail{},
+				MultiSignatureRotationQuery: query.NewMultiSignatureRotationQuery(),
+				QueryExecutor: &multisignatureInfoHelperQueryExecutorNoRotation{
+					multisignatureInfoHelperQueryExecutorSuccess{
+						emptyResultSet: true,
+					},
 				},
 			},
 			args: args{
@@ -214,8 +290,9 @@ func TestMultisignatureInfoHelper_GetMultisigInfoByAddress(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			msi := &MultisignatureInfoHelper{
-				MultisignatureInfoQuery: tt.fields.MultisignatureInfoQuery,
-				QueryExecutor:           tt.fields.QueryExecutor,
+				MultisignatureInfoQuery:     tt.fields.MultisignatureInfoQuery,
+				MultiSignatureRotationQuery: tt.fields.MultiSignatureRotationQuery,
+				QueryExecutor:               tt.fields.QueryExecutor,
 			}
 			if err := msi.GetMultisigInfoByAddress(tt.args.multisigInfo, tt.args.multisigAddress, tt.args.blockHeight); (err != nil) != tt.wantErr {
 				t.Errorf("GetMultisigInfoByAddress() error = %v, wantErr %v", err, tt.wantErr)
@@ -1212,6 +1289,16 @@ type (
 		MultisigTransactionUtilInterface
 	}
 
+	mockMultisignatureValidateTransactionUtil struct {
+		Util
+	}
+	mockMultisignatureValidateMultisigInfoNotRotated struct {
+		MultisignatureInfoHelperInterface
+	}
+	mockMultisignatureValidateMultisigInfoRotated struct {
+		MultisignatureInfoHelperInterface
+	}
+
 	mockAccountBalanceHelperMultisignatureValidateSuccess struct {
 		AccountBalanceHelper
 	}
@@ -1227,6 +1314,18 @@ func (*mockValidateMultisigUtilValidateSignatureInfoSucess) ValidateSignatureInf
 	return nil
 }
 
+func (*mockMultisignatureValidateTransactionUtil) GenerateMultiSigAddress(info *model.MultiSignatureInfo) ([]byte, error) {
+	return mockMultisignatureInfoHelperMultisigInfoSuccess.MultisigAddress, nil
+}
+
+func (*mockMultisignatureValidateMultisigInfoNotRotated) IsMultisigRotated(multisigAddress []byte, dbTx bool) (bool, error) {
+	return false, nil
+}
+
+func (*mockMultisignatureValidateMultisigInfoRotated) IsMultisigRotated(multisigAddress []byte, dbTx bool) (bool, error) {
+	return true, nil
+}
+
 func (*mockMultisignatureValidateMultisigInfoNotExist) GetMultisigInfoByAddress(
 	multisigInfo *model.MultiSignatureInfo,
 	multisigAddress []byte,
@@ -1428,8 +1527,31 @@ func TestMultiSignatureTransaction_Validate(t *testing.T) {
 					UnsignedTransactionBytes: make([]byte, 32),
 					SignatureInfo:            nil,
 				},
-				MultisigUtil:         &mockMultisignatureValidateMultisigUtilValidateMultisigInfoSuccessPendingTxFail{},
-				AccountBalanceHelper: &mockAccountBalanceHelperMultisignatureValidateSuccess{},
+				TransactionUtil:          &mockMultisignatureValidateTransactionUtil{},
+				MultisigUtil:             &mockMultisignatureValidateMultisigUtilValidateMultisigInfoSuccessPendingTxFail{},
+				MultisignatureInfoHelper: &mockMultisignatureValidateMultisigInfoNotRotated{},
+				AccountBalanceHelper:     &mockAccountBalanceHelperMultisignatureValidateSuccess{},
+			},
+			args: args{
+				dbTx: true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Validate - multisignatureInfo:exist - multisignatureInfo valid - multisig address rotated",
+			fields: fields{
+				TransactionObject: &model.Transaction{
+					Fee: mockFeeMultisignatureValidate,
+				},
+				Body: &model.MultiSignatureTransactionBody{
+					MultiSignatureInfo:       &model.MultiSignatureInfo{},
+					UnsignedTransactionBytes: nil,
+					SignatureInfo:            nil,
+				},
+				TransactionUtil:          &mockMultisignatureValidateTransactionUtil{},
+				MultisigUtil:             &mockMultisignatureValidateMultisigUtilValidateMultisigInfoSuccessSignatureInfoFail{},
+				MultisignatureInfoHelper: &mockMultisignatureValidateMultisigInfoRotated{},
+				AccountBalanceHelper:     &mockAccountBalanceHelperMultisignatureValidateSuccess{},
 			},
 			args: args{
 				dbTx: true,
@@ -1448,8 +1570,10 @@ func TestMultiSignatureTransaction_Validate(t *testing.T) {
 					UnsignedTransactionBytes: nil,
 					SignatureInfo:            &model.SignatureInfo{},
 				},
-				MultisigUtil:         &mockMultisignatureValidateMultisigUtilValidateMultisigInfoSuccessSignatureInfoFail{},
-				AccountBalanceHelper: &mockAccountBalanceHelperMultisignatureValidateSuccess{},
+				TransactionUtil:          &mockMultisignatureValidateTransactionUtil{},
+				MultisigUtil:             &mockMultisignatureValidateMultisigUtilValidateMultisigInfoSuccessSignatureInfoFail{},
+				MultisignatureInfoHelper: &mockMultisignatureValidateMultisigInfoNotRotated{},
+				AccountBalanceHelper:     &mockAccountBalanceHelperMultisignatureValidateSuccess{},
 			},
 			args: args{
 				dbTx: true,
@@ -2223,6 +2347,20 @@ func TestMultiSignatureTransaction_ParseBodyBytes(t *testing.T) {
 			Body: multisigTxBody,
 		}
 		multisigTx1BodyBytes, _ = tx1.GetBodyBytes()
+		weightedMultisigTxBody  = &model.MultiSignatureTransactionBody{
+			MultiSignatureInfo: &model.MultiSignatureInfo{
+				MinimumSignatures: 4,
+				Nonce:             1,
+				Addresses: [][]byte{
+					senderAddress1,
+					senderAddress2,
+					senderAddress3,
+				},
+				Weights: []uint32{3, 1, 1},
+			},
+			UnsignedTransactionBytes: make([]byte, 64),
+		}
+		weightedMultisigTxBodyBytes, _ = (&MultiSignatureTransaction{Body: weightedMultisigTxBody}).GetBodyBytes()
 	)
 
 	type fields struct {
@@ -2266,6 +2404,17 @@ func TestMultiSignatureTransaction_ParseBodyBytes(t *testing.T) {
 			want:    multisigTxBody,
 			wantErr: false,
 		},
+		{
+			name: "parseBodyBytes - weighted multisig info",
+			fields: fields{
+				Body: weightedMultisigTxBody,
+			},
+			args: args{
+				txBodyBytes: weightedMultisigTxBodyBytes,
+			},
+			want:    weightedMultisigTxBody,
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2337,6 +2486,25 @@ func TestMultiSignatureTransaction_GetSize(t *testing.T) {
 			},
 			want: 360,
 		},
+		{
+			name: "GetSizeWeighted",
+			fields: fields{
+				Body: &model.MultiSignatureTransactionBody{
+					MultiSignatureInfo: &model.MultiSignatureInfo{
+						MinimumSignatures: 4,
+						Nonce:             1,
+						Addresses: [][]byte{
+							senderAddress1,
+							senderAddress2,
+							senderAddress3,
+						},
+						Weights: []uint32{3, 1, 1},
+					},
+					UnsignedTransactionBytes: make([]byte, 64),
+				},
+			},
+			want: 224,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,250 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"bytes"
+	"database/sql"
+
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/fee"
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+	"github.com/zoobc/zoobc-core/common/util"
+)
+
+// RegisterAccountDatasetSchema is Transaction Type that implemented TypeAction.
+// It registers the value type of a dataset property set by the sender, the datasets set afterwards must match it
+type RegisterAccountDatasetSchema struct {
+	TransactionObject         *model.Transaction
+	Body                      *model.RegisterAccountDatasetSchemaTransactionBody
+	QueryExecutor             query.ExecutorInterface
+	AccountDatasetSchemaQuery query.AccountDatasetSchemaQueryInterface
+	AccountBalanceHelper      AccountBalanceHelperInterface
+	FeeScaleService           fee.FeeScaleServiceInterface
+}
+
+// SkipMempoolTransaction this tx type has no mempool filter
+func (*RegisterAccountDatasetSchema) SkipMempoolTransaction([]*model.Transaction, int64, uint32) (bool, error) {
+	return false, nil
+}
+
+// ApplyConfirmed register the schema of the property for the sender
+func (tx *RegisterAccountDatasetSchema) ApplyConfirmed(blockTimestamp int64) error {
+	var err = tx.AccountBalanceHelper.AddAccountBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		-tx.TransactionObject.Fee,
+		model.EventType_EventRegisterAccountDatasetSchemaTransaction,
+		tx.TransactionObject.Height,
+		tx.TransactionObject.ID,
+		uint64(blockTimestamp),
+	)
+	if err != nil {
+		return err
+	}
+
+	schemaQ := tx.AccountDatasetSchemaQuery.InsertAccountDatasetSchema(&model.AccountDatasetSchema{
+		SetterAccountAddress: tx.TransactionObject.SenderAccountAddress,
+		Property:             tx.Body.GetProperty(),
+		ValueType:            tx.Body.GetValueType(),
+		MaxLength:            tx.Body.GetMaxLength(),
+		BlockHeight:          tx.TransactionObject.Height,
+		Latest:               true,
+	})
+	err = tx.QueryExecutor.ExecuteTransactions(schemaQ)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *RegisterAccountDatasetSchema) ApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(tx.TransactionObject.SenderAccountAddress, -tx.TransactionObject.Fee)
+	if err != nil {
+		return blocker.NewBlocker(blocker.DBErr, err.Error())
+	}
+	return nil
+}
+
+func (tx *RegisterAccountDatasetSchema) UndoApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(tx.TransactionObject.SenderAccountAddress, tx.TransactionObject.Fee)
+	if err != nil {
+		return blocker.NewBlocker(blocker.DBErr, err.Error())
+	}
+	return nil
+}
+
+/*
+Validate is func that for validating to Transaction RegisterAccountDatasetSchema type
+That specs:
+  - the value type is known and a JSON schema has a maximum length within DatasetMaxJSONValueLength
+  - the property isn't reserved nor already has a schema set by the sender, schemas can't be changed once registered
+  - `sender.spendable_balance` must be enough for the fee
+*/
+func (tx *RegisterAccountDatasetSchema) Validate(dbTx bool) error {
+	var (
+		schema model.AccountDatasetSchema
+		row    *sql.Row
+		err    error
+		enough bool
+	)
+	if tx.TransactionObject.SenderAccountAddress == nil {
+		return blocker.NewBlocker(blocker.ValidationErr, "SenderAccountAddressRequired")
+	}
+	err = util.ValidateAccountDatasetSchema(tx.Body.GetProperty(), tx.Body.GetValueType(), tx.Body.GetMaxLength())
+	if err != nil {
+		return err
+	}
+
+	qry, qryArgs := tx.AccountDatasetSchemaQuery.GetLatestAccountDatasetSchema(
+		tx.TransactionObject.SenderAccountAddress,
+		tx.Body.GetProperty(),
+	)
+	row, err = tx.QueryExecutor.ExecuteSelectRow(qry, dbTx, qryArgs...)
+	if err != nil {
+		return blocker.NewBlocker(blocker.DBErr, err.Error())
+	}
+	err = tx.AccountDatasetSchemaQuery.Scan(&schema, row)
+	if err != sql.ErrNoRows {
+		if err != nil {
+			return blocker.NewBlocker(blocker.DBErr, err.Error())
+		}
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountDatasetSchemaAlreadyRegistered")
+	}
+
+	enough, err = tx.AccountBalanceHelper.HasEnoughSpendableBalance(dbTx, tx.TransactionObject.SenderAccountAddress, tx.TransactionObject.Fee)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return blocker.NewBlocker(blocker.ValidationErr, err.Error())
+		}
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotFound")
+	}
+	if !enough {
+		return blocker.NewBlocker(blocker.ValidationErr, "UserBalanceNotEnough")
+	}
+	return nil
+}
+
+// GetAmount registering a schema doesn't move any amount
+func (*RegisterAccountDatasetSchema) GetAmount() int64 {
+	return 0
+}
+
+// GetMinimumFee return minimum fee of transaction
+func (tx *RegisterAccountDatasetSchema) GetMinimumFee() (int64, error) {
+	var lastFeeScale model.FeeScale
+	err := tx.FeeScaleService.GetLatestFeeScale(&lastFeeScale)
+	if err != nil {
+		return 0, err
+	}
+	return fee.CalculateTxMinimumFee(tx.TransactionObject, lastFeeScale.FeeScale)
+}
+
+// GetSize is size of transaction body
+func (tx *RegisterAccountDatasetSchema) GetSize() (uint32, error) {
+	txBodyBytes, err := tx.GetBodyBytes()
+	if err != nil {
+		return 0, err
+	}
+	return uint32(len(txBodyBytes)), nil
+}
+
+// ParseBodyBytes read and translate body bytes to body implementation fields
+func (*RegisterAccountDatasetSchema) ParseBodyBytes(txBodyBytes []byte) (model.TransactionBodyInterface, error) {
+	var (
+		err          error
+		chunkedBytes []byte
+		txBody       model.RegisterAccountDatasetSchemaTransactionBody
+		buffer       = bytes.NewBuffer(txBodyBytes)
+	)
+	chunkedBytes, err = util.ReadTransactionBytes(buffer, int(constant.DatasetPropertyLength))
+	if err != nil {
+		return nil, err
+	}
+	chunkedBytes, err = util.ReadTransactionBytes(buffer, int(util.ConvertBytesToUint32(chunkedBytes)))
+	if err != nil {
+		return nil, err
+	}
+	txBody.Property = string(chunkedBytes)
+	chunkedBytes, err = util.ReadTransactionBytes(buffer, int(constant.DatasetSchemaValueTypeLength))
+	if err != nil {
+		return nil, err
+	}
+	txBody.ValueType = model.AccountDatasetValueType(util.ConvertBytesToUint32(chunkedBytes))
+	chunkedBytes, err = util.ReadTransactionBytes(buffer, int(constant.DatasetSchemaMaxLength))
+	if err != nil {
+		return nil, err
+	}
+	txBody.MaxLength = util.ConvertBytesToUint32(chunkedBytes)
+	return &txBody, nil
+}
+
+// GetBodyBytes translate tx body to bytes representation
+func (tx *RegisterAccountDatasetSchema) GetBodyBytes() ([]byte, error) {
+	buffer := bytes.NewBuffer([]byte{})
+	buffer.Write(util.ConvertUint32ToBytes(uint32(len([]byte(tx.Body.GetProperty())))))
+	buffer.Write([]byte(tx.Body.GetProperty()))
+	buffer.Write(util.ConvertUint32ToBytes(uint32(tx.Body.GetValueType())))
+	buffer.Write(util.ConvertUint32ToBytes(tx.Body.GetMaxLength()))
+	return buffer.Bytes(), nil
+}
+
+// GetTransactionBody return transaction body of RegisterAccountDatasetSchema transactions
+func (tx *RegisterAccountDatasetSchema) GetTransactionBody(transaction *model.Transaction) {
+	transaction.TransactionBody = &model.Transaction_RegisterAccountDatasetSchemaTransactionBody{
+		RegisterAccountDatasetSchemaTransactionBody: tx.Body,
+	}
+}
+
+// Escrowable registering a schema can't be escrowed
+func (*RegisterAccountDatasetSchema) Escrowable() (EscrowTypeAction, bool) {
+	return nil, false
+}
@@ -0,0 +1,248 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+)
+
+type (
+	mockExecutorRegisterAccountDatasetSchema struct {
+		query.Executor
+		registered bool
+		executeErr error
+	}
+)
+
+func (m *mockExecutorRegisterAccountDatasetSchema) ExecuteSelectRow(qStr string, _ bool, _ ...interface{}) (*sql.Row, error) {
+	db, mock, _ := sqlmock.New()
+	rows := sqlmock.NewRows(query.NewAccountDatasetSchemaQuery().Fields)
+	if m.registered {
+		rows.AddRow(senderAddress1, "KycLevel", int32(model.AccountDatasetValueType_AccountDatasetValueString), 0, 1, true)
+	}
+	mock.ExpectQuery("").WillReturnRows(rows)
+	return db.QueryRow(""), nil
+}
+
+func (m *mockExecutorRegisterAccountDatasetSchema) ExecuteTransactions([][]interface{}) error {
+	return m.executeErr
+}
+
+func TestRegisterAccountDatasetSchema_Validate(t *testing.T) {
+	type fields struct {
+		Body                 *model.RegisterAccountDatasetSchemaTransactionBody
+		QueryExecutor        query.ExecutorInterface
+		AccountBalanceHelper AccountBalanceHelperInterface
+	}
+	body, _ := GetFixturesForRegisterAccountDatasetSchema()
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{
+			name: "wantFail:ReservedProperty",
+			fields: fields{
+				Body: &model.RegisterAccountDatasetSchemaTransactionBody{
+					Property: model.AccountDatasetProperty_AccountDatasetEscrowApproval.String(),
+				},
+				QueryExecutor:        &mockExecutorRegisterAccountDatasetSchema{},
+				AccountBalanceHelper: &mockAccountBalanceHelperSuccess{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantFail:AlreadyRegistered",
+			fields: fields{
+				Body:                 body,
+				QueryExecutor:        &mockExecutorRegisterAccountDatasetSchema{registered: true},
+				AccountBalanceHelper: &mockAccountBalanceHelperSuccess{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantFail:BalanceNotEnough",
+			fields: fields{
+				Body:                 body,
+				QueryExecutor:        &mockExecutorRegisterAccountDatasetSchema{},
+				AccountBalanceHelper: &mockAccountBalanceHelperFail{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantSuccess",
+			fields: fields{
+				Body:                 body,
+				QueryExecutor:        &mockExecutorRegisterAccountDatasetSchema{},
+				AccountBalanceHelper: &mockAccountBalanceHelperSuccess{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &RegisterAccountDatasetSchema{
+				TransactionObject: &model.Transaction{
+					SenderAccountAddress: senderAddress1,
+					Fee:                  1,
+				},
+				Body:                      tt.fields.Body,
+				QueryExecutor:             tt.fields.QueryExecutor,
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				AccountBalanceHelper:      tt.fields.AccountBalanceHelper,
+			}
+			if err := tx.Validate(false); (err != nil) != tt.wantErr {
+				t.Errorf("RegisterAccountDatasetSchema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRegisterAccountDatasetSchema_ApplyConfirmed(t *testing.T) {
+	type fields struct {
+		QueryExecutor        query.ExecutorInterface
+		AccountBalanceHelper AccountBalanceHelperInterface
+	}
+	body, _ := GetFixturesForRegisterAccountDatasetSchema()
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{
+			name: "wantFail:AddAccountBalance",
+			fields: fields{
+				QueryExecutor:        &mockExecutorRegisterAccountDatasetSchema{},
+				AccountBalanceHelper: &mockAccountBalanceHelperFail{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantFail:InsertSchema",
+			fields: fields{
+				QueryExecutor:        &mockExecutorRegisterAccountDatasetSchema{executeErr: errors.New("mockedError")},
+				AccountBalanceHelper: &mockAccountBalanceHelperSuccess{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantSuccess",
+			fields: fields{
+				QueryExecutor:        &mockExecutorRegisterAccountDatasetSchema{},
+				AccountBalanceHelper: &mockAccountBalanceHelperSuccess{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &RegisterAccountDatasetSchema{
+				TransactionObject: &model.Transaction{
+					SenderAccountAddress: senderAddress1,
+					Fee:                  1,
+					Height:               5,
+				},
+				Body:                      body,
+				QueryExecutor:             tt.fields.QueryExecutor,
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				AccountBalanceHelper:      tt.fields.AccountBalanceHelper,
+			}
+			if err := tx.ApplyConfirmed(0); (err != nil) != tt.wantErr {
+				t.Errorf("RegisterAccountDatasetSchema.ApplyConfirmed() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRegisterAccountDatasetSchema_ParseBodyBytes(t *testing.T) {
+	body, bodyBytes := GetFixturesForRegisterAccountDatasetSchema()
+	tests := []struct {
+		name        string
+		txBodyBytes []byte
+		want        model.TransactionBodyInterface
+		wantErr     bool
+	}{
+		{
+			name:        "wantFail:Truncated",
+			txBodyBytes: bodyBytes[:len(bodyBytes)-1],
+			wantErr:     true,
+		},
+		{
+			name:        "wantSuccess",
+			txBodyBytes: bodyBytes,
+			want:        body,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&RegisterAccountDatasetSchema{}).ParseBodyBytes(tt.txBodyBytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RegisterAccountDatasetSchema.ParseBodyBytes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RegisterAccountDatasetSchema.ParseBodyBytes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterAccountDatasetSchema_GetSize(t *testing.T) {
+	body, bodyBytes := GetFixturesForRegisterAccountDatasetSchema()
+	got, err := (&RegisterAccountDatasetSchema{Body: body}).GetSize()
+	if err != nil || got != uint32(len(bodyBytes)) {
+		t.Errorf("RegisterAccountDatasetSchema.GetSize() = %v, %v, want %v", got, err, len(bodyBytes))
+	}
+}
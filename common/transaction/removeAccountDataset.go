@@ -151,6 +151,8 @@ func (tx *RemoveAccountDataset) UndoApplyUnconfirmed() error {
 Validate is func that for validating to Transaction RemoveAccountDataset type
 That specs:
 	- Check existing Account Dataset
+	- A spending restriction consent can't be removed while the restriction it consents to is active,
+	only the setter of the restriction can lift it
 	- Check Spendable Balance sender
 */
 func (tx *RemoveAccountDataset) Validate(dbTx bool) error {
@@ -192,6 +194,25 @@ func (tx *RemoveAccountDataset) Validate(dbTx bool) error {
 	if !accountDataset.GetIsActive() {
 		return blocker.NewBlocker(blocker.ValidationErr, "AccountDatasetAlreadyRemoved")
 	}
+	if tx.Body.GetProperty() == model.AccountDatasetProperty_AccountDatasetSpendingRestrictionConsent.String() {
+		var restriction model.AccountDataset
+		qry, qryArgs = tx.AccountDatasetQuery.GetLatestAccountDataset(
+			tx.TransactionObject.RecipientAccountAddress,
+			tx.TransactionObject.SenderAccountAddress,
+			model.AccountDatasetProperty_AccountDatasetSpendingRestriction.String(),
+		)
+		row, err = tx.QueryExecutor.ExecuteSelectRow(qry, false, qryArgs...)
+		if err != nil {
+			return err
+		}
+		err = tx.AccountDatasetQuery.Scan(&restriction, row)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if restriction.GetIsActive() {
+			return blocker.NewBlocker(blocker.ValidationErr, "SpendingRestrictionConsentInUse")
+		}
+	}
 
 	enough, err = tx.AccountBalanceHelper.HasEnoughSpendableBalance(dbTx, tx.TransactionObject.SenderAccountAddress, tx.TransactionObject.Fee)
 	if err != nil {
@@ -418,26 +439,24 @@ func (tx *RemoveAccountDataset) EscrowApproval(
 			return err
 		}
 
-		err = tx.AccountBalanceHelper.AddAccountBalance(
-			tx.TransactionObject.Escrow.GetApproverAddress(),
-			tx.TransactionObject.Escrow.GetCommission(),
-			model.EventType_EventApprovalEscrowTransaction,
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
-			uint64(blockTimestamp),
+			blockTimestamp,
 		)
 		if err != nil {
 			return err
 		}
 	case model.EscrowApproval_Reject:
 		tx.TransactionObject.Escrow.Status = model.EscrowStatus_Rejected
-		err = tx.AccountBalanceHelper.AddAccountBalance(
-			tx.TransactionObject.Escrow.GetApproverAddress(),
-			tx.TransactionObject.Escrow.GetCommission(),
-			model.EventType_EventApprovalEscrowTransaction,
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
-			uint64(blockTimestamp),
+			blockTimestamp,
 		)
 		if err != nil {
 			return err
@@ -380,6 +380,10 @@ type (
 	executorRemoveAccountDatasetValidateFail struct {
 		query.Executor
 	}
+	executorRemoveAccountDatasetValidateConsent struct {
+		query.Executor
+		restrictionActive bool
+	}
 )
 
 func (*executorRemoveAccountDatasetValidateSuccess) ExecuteSelectRow(qStr string, _ bool, _ ...interface{}) (*sql.Row, error) {
@@ -406,6 +410,8 @@ func (*executorRemoveAccountDatasetValidateSuccess) ExecuteSelectRow(qStr string
 				true,
 				true,
 				5,
+				0,
+				0,
 			),
 		)
 	}
@@ -426,8 +432,58 @@ func (*executorRemoveAccountDatasetValidateFail) ExecuteSelectRow(qStr string, _
 	return db.QueryRow(qStr), nil
 }
 
+func (e *executorRemoveAccountDatasetValidateConsent) ExecuteSelectRow(qStr string, _ bool, args ...interface{}) (*sql.Row, error) {
+	db, mock, _ := sqlmock.New()
+	switch {
+	case strings.Contains(qStr, "account_balance"):
+		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(
+			sqlmock.NewRows(query.NewAccountBalanceQuery().Fields).AddRow(
+				senderAddress1,
+				1,
+				1,
+				1,
+				0,
+				true,
+			),
+		)
+	case args[2] == model.AccountDatasetProperty_AccountDatasetSpendingRestriction.String():
+		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(
+			sqlmock.NewRows(query.NewAccountDatasetsQuery().Fields).AddRow(
+				recipientAddress1,
+				senderAddress1,
+				model.AccountDatasetProperty_AccountDatasetSpendingRestriction.String(),
+				"",
+				e.restrictionActive,
+				true,
+				5,
+				0,
+				0,
+			),
+		)
+	default:
+		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(
+			sqlmock.NewRows(query.NewAccountDatasetsQuery().Fields).AddRow(
+				senderAddress1,
+				recipientAddress1,
+				model.AccountDatasetProperty_AccountDatasetSpendingRestrictionConsent.String(),
+				"",
+				true,
+				true,
+				5,
+				0,
+				0,
+			),
+		)
+	}
+
+	return db.QueryRow(qStr), nil
+}
+
 func TestRemoveAccountDataset_Validate(t *testing.T) {
 	mockRemoveAccountDatasetTransactionBody, _ := GetFixturesForRemoveAccountDataset()
+	mockRemoveConsentTransactionBody := &model.RemoveAccountDatasetTransactionBody{
+		Property: model.AccountDatasetProperty_AccountDatasetSpendingRestrictionConsent.String(),
+	}
 
 	type fields struct {
 		Body                 *model.RemoveAccountDatasetTransactionBody
@@ -485,6 +541,36 @@ func TestRemoveAccountDataset_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Validate:ConsentInUse",
+			fields: fields{
+				Body: mockRemoveConsentTransactionBody,
+				TransactionObject: &model.Transaction{
+					Fee:                     1,
+					SenderAccountAddress:    senderAddress1,
+					RecipientAccountAddress: recipientAddress1,
+				},
+				AccountDatasetQuery:  query.NewAccountDatasetsQuery(),
+				QueryExecutor:        &executorRemoveAccountDatasetValidateConsent{restrictionActive: true},
+				AccountBalanceHelper: &mockAccountBalanceHelperSuccess{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Validate:ConsentRestrictionLifted",
+			fields: fields{
+				Body: mockRemoveConsentTransactionBody,
+				TransactionObject: &model.Transaction{
+					Fee:                     1,
+					SenderAccountAddress:    senderAddress1,
+					RecipientAccountAddress: recipientAddress1,
+				},
+				AccountDatasetQuery:  query.NewAccountDatasetsQuery(),
+				QueryExecutor:        &executorRemoveAccountDatasetValidateConsent{},
+				AccountBalanceHelper: &mockAccountBalanceHelperSuccess{},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
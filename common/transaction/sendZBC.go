@@ -53,6 +53,7 @@ import (
 	"bytes"
 	"database/sql"
 	"errors"
+	"fmt"
 
 	"github.com/zoobc/zoobc-core/common/blocker"
 	"github.com/zoobc/zoobc-core/common/constant"
@@ -72,6 +73,7 @@ type (
 		BlockQuery           query.BlockQueryInterface
 		FeeScaleService      fee.FeeScaleServiceInterface
 		AccountBalanceHelper AccountBalanceHelperInterface
+		AccountDatasetQuery  query.AccountDatasetQueryInterface
 	}
 )
 
@@ -149,6 +151,7 @@ Validate is func that for validating to Transaction SendZBC type
 That specs:
 	- If Genesis, sender and recipient allowed not exists,
 	- If Not Genesis,  sender and recipient must be exists, `sender.spendable_balance` must bigger than amount
+	- If Not Genesis, the recipient must be whitelisted by every spending restriction of the sender
 */
 func (tx *SendZBC) Validate(dbTx bool) error {
 	var (
@@ -183,10 +186,56 @@ func (tx *SendZBC) Validate(dbTx bool) error {
 				"UserBalanceNotEnough",
 			)
 		}
+		err = validateSpendingRestriction(
+			tx.QueryExecutor,
+			tx.AccountDatasetQuery,
+			tx.TransactionObject.SenderAccountAddress,
+			tx.TransactionObject.RecipientAccountAddress,
+			dbTx,
+		)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+/*
+validateSpendingRestriction check the recipient of a transfer is whitelisted by the trusted setters that restrict the sender:
+for each active `AccountDatasetSpendingRestriction` dataset having the sender as recipient, and consented by the sender with an
+active `AccountDatasetSpendingRestrictionConsent` dataset naming the setter, the recipient of the transfer must carry an active
+dataset, set by the same setter, which property is the value of the restriction. It is called by every transfer type so that
+a restriction cannot be bypassed through another transaction type
+*/
+func validateSpendingRestriction(
+	queryExecutor query.ExecutorInterface,
+	accountDatasetQuery query.AccountDatasetQueryInterface,
+	senderAddress, recipientAddress []byte,
+	dbTx bool,
+) error {
+	var (
+		restriction model.AccountDataset
+		row         *sql.Row
+		err         error
+	)
+	qry, qryArgs := accountDatasetQuery.GetUnmetAccountDatasetSpendingRestriction(senderAddress, recipientAddress)
+	row, err = queryExecutor.ExecuteSelectRow(qry, dbTx, qryArgs...)
+	if err != nil {
+		return blocker.NewBlocker(blocker.DBErr, err.Error())
+	}
+	err = accountDatasetQuery.Scan(&restriction, row)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return blocker.NewBlocker(blocker.DBErr, err.Error())
+		}
+		return nil
+	}
+	return blocker.NewBlocker(
+		blocker.ValidationErr,
+		fmt.Sprintf("RecipientNotWhitelisted: sender is restricted to recipients carrying dataset %s", restriction.GetValue()),
+	)
+}
+
 // GetAmount return Amount from TransactionBody
 func (tx *SendZBC) GetAmount() int64 {
 	return tx.Body.Amount
@@ -362,40 +411,67 @@ func (tx *SendZBC) EscrowApproval(
 
 	switch txBody.GetApproval() {
 	case model.EscrowApproval_Approve:
-		tx.TransactionObject.Escrow.Status = model.EscrowStatus_Approved
-		// Bring back the fee that was decreased on EscrowApplyConfirmed before do ApplyConfirmed
-		err = tx.AccountBalanceHelper.AddAccountBalance(
-			tx.TransactionObject.SenderAccountAddress,
-			tx.Body.GetAmount()+tx.TransactionObject.Fee,
-			model.EventType_EventEscrowedTransaction,
-			tx.TransactionObject.Height,
-			tx.TransactionObject.ID,
-			uint64(blockTimestamp),
+		var (
+			remainingAmount = tx.Body.GetAmount() - tx.TransactionObject.Escrow.GetReleasedAmount()
+			releaseAmount   = txBody.GetAmount()
 		)
-		if err != nil {
-			return err
+		if releaseAmount == 0 || releaseAmount > remainingAmount {
+			releaseAmount = remainingAmount
 		}
-		err = tx.ApplyConfirmed(blockTimestamp)
-		if err != nil {
-			return err
+		if releaseAmount == tx.Body.GetAmount() {
+			// Bring back the fee that was decreased on EscrowApplyConfirmed before do ApplyConfirmed
+			err = tx.AccountBalanceHelper.AddAccountBalance(
+				tx.TransactionObject.SenderAccountAddress,
+				tx.Body.GetAmount()+tx.TransactionObject.Fee,
+				model.EventType_EventEscrowedTransaction,
+				tx.TransactionObject.Height,
+				tx.TransactionObject.ID,
+				uint64(blockTimestamp),
+			)
+			if err != nil {
+				return err
+			}
+			err = tx.ApplyConfirmed(blockTimestamp)
+			if err != nil {
+				return err
+			}
+		} else {
+			// partial release, the escrowed amount has already been taken from the sender on EscrowApplyConfirmed
+			err = tx.AccountBalanceHelper.AddAccountBalance(
+				tx.TransactionObject.RecipientAccountAddress,
+				releaseAmount,
+				model.EventType_EventApprovalEscrowTransaction,
+				tx.TransactionObject.Height,
+				tx.TransactionObject.ID,
+				uint64(blockTimestamp),
+			)
+			if err != nil {
+				return err
+			}
 		}
+		tx.TransactionObject.Escrow.ReleasedAmount += releaseAmount
+		if tx.TransactionObject.Escrow.GetReleasedAmount() < tx.Body.GetAmount() {
+			// the escrow stays pending until the whole amount is released or it expires
+			break
+		}
+		tx.TransactionObject.Escrow.Status = model.EscrowStatus_Approved
 
-		err = tx.AccountBalanceHelper.AddAccountBalance(
-			tx.TransactionObject.Escrow.GetApproverAddress(),
-			tx.TransactionObject.Escrow.GetCommission(),
-			model.EventType_EventApprovalEscrowTransaction,
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
-			uint64(blockTimestamp),
+			blockTimestamp,
 		)
 		if err != nil {
 			return err
 		}
 	case model.EscrowApproval_Reject:
 		tx.TransactionObject.Escrow.Status = model.EscrowStatus_Rejected
+		// only the amount not released yet goes back to the sender
 		err = tx.AccountBalanceHelper.AddAccountBalance(
 			tx.TransactionObject.SenderAccountAddress,
-			tx.Body.Amount,
+			tx.Body.GetAmount()-tx.TransactionObject.Escrow.GetReleasedAmount(),
 			model.EventType_EventApprovalEscrowTransaction,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
@@ -405,13 +481,12 @@ func (tx *SendZBC) EscrowApproval(
 			return err
 		}
 
-		err = tx.AccountBalanceHelper.AddAccountBalance(
-			tx.TransactionObject.Escrow.GetApproverAddress(),
-			tx.TransactionObject.Escrow.GetCommission(),
-			model.EventType_EventApprovalEscrowTransaction,
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
-			uint64(blockTimestamp),
+			blockTimestamp,
 		)
 		if err != nil {
 			return err
@@ -420,7 +495,7 @@ func (tx *SendZBC) EscrowApproval(
 		tx.TransactionObject.Escrow.Status = model.EscrowStatus_Expired
 		err = tx.AccountBalanceHelper.AddAccountBalance(
 			tx.TransactionObject.SenderAccountAddress,
-			tx.TransactionObject.Escrow.GetCommission()+tx.Body.Amount,
+			tx.TransactionObject.Escrow.GetCommission()+tx.Body.GetAmount()-tx.TransactionObject.Escrow.GetReleasedAmount(),
 			model.EventType_EventApprovalEscrowTransaction,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
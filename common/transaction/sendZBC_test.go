@@ -156,8 +156,29 @@ type (
 	mockAccountBalanceValidateSendZBCSuccess struct {
 		query.AccountBalanceQuery
 	}
+	mockQueryExecutorValidateSendZBCRestricted struct {
+		query.Executor
+	}
+	// mockAccountDatasetQuerySpendingRestriction mock the unmet spending restriction lookup of the other transfer types
+	mockAccountDatasetQuerySpendingRestriction struct {
+		query.AccountDatasetQuery
+		restricted bool
+	}
 )
 
+func (m *mockAccountDatasetQuerySpendingRestriction) Scan(dataset *model.AccountDataset, _ *sql.Row) error {
+	if !m.restricted {
+		return sql.ErrNoRows
+	}
+	dataset.SetterAccountAddress = senderAddress2
+	dataset.RecipientAccountAddress = senderAddress1
+	dataset.Property = "AccountDatasetSpendingRestriction"
+	dataset.Value = "KycVerified"
+	dataset.IsActive = true
+	dataset.Latest = true
+	return nil
+}
+
 func (*mockQueryExecutorValidateSendZBCHasEscrow) ExecuteSelectRow(string, bool, ...interface{}) (*sql.Row, error) {
 	db, mock, _ := sqlmock.New()
 	mockRow := mock.NewRows(query.NewAccountDatasetsQuery().Fields)
@@ -181,6 +202,28 @@ func (*mockQueryExecutorValidateSendZBCNeedEscrow) ExecuteSelectRow(string, bool
 		true,
 		true,
 		5,
+		0,
+		0,
+	)
+
+	mock.ExpectQuery("").WillReturnRows(mockRow)
+	row := db.QueryRow("")
+	return row, nil
+}
+
+func (*mockQueryExecutorValidateSendZBCRestricted) ExecuteSelectRow(string, bool, ...interface{}) (*sql.Row, error) {
+	db, mock, _ := sqlmock.New()
+	mockRow := mock.NewRows(query.NewAccountDatasetsQuery().Fields)
+	mockRow.AddRow(
+		senderAddress2,
+		senderAddress1,
+		"AccountDatasetSpendingRestriction",
+		"KycVerified",
+		true,
+		true,
+		5,
+		0,
+		0,
 	)
 
 	mock.ExpectQuery("").WillReturnRows(mockRow)
@@ -204,6 +247,7 @@ func TestSendZBC_Validate(t *testing.T) {
 		TransactionObject    *model.Transaction
 		QueryExecutor        query.ExecutorInterface
 		AccountBalanceHelper AccountBalanceHelperInterface
+		AccountDatasetQuery  query.AccountDatasetQueryInterface
 	}
 	tests := []struct {
 		name    string
@@ -275,9 +319,27 @@ func TestSendZBC_Validate(t *testing.T) {
 				},
 				QueryExecutor:        &mockQueryExecutorValidateSendZBCHasEscrow{},
 				AccountBalanceHelper: &mockAccountBalanceHelperSuccess{},
+				AccountDatasetQuery:  query.NewAccountDatasetsQuery(),
 			},
 			wantErr: false,
 		},
+		{
+			name: "wantError:RecipientNotWhitelisted",
+			fields: fields{
+				Body: &model.SendZBCTransactionBody{
+					Amount: 10,
+				},
+				TransactionObject: &model.Transaction{
+					Height:                  1,
+					SenderAccountAddress:    senderAddress1,
+					RecipientAccountAddress: recipientAddress1,
+				},
+				QueryExecutor:        &mockQueryExecutorValidateSendZBCRestricted{},
+				AccountBalanceHelper: &mockAccountBalanceHelperSuccess{},
+				AccountDatasetQuery:  query.NewAccountDatasetsQuery(),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -286,6 +348,7 @@ func TestSendZBC_Validate(t *testing.T) {
 				TransactionObject:    tt.fields.TransactionObject,
 				QueryExecutor:        tt.fields.QueryExecutor,
 				AccountBalanceHelper: tt.fields.AccountBalanceHelper,
+				AccountDatasetQuery:  tt.fields.AccountDatasetQuery,
 			}
 			if err := tx.Validate(false); (err != nil) != tt.wantErr {
 				t.Errorf("SendZBC.Validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -992,6 +1055,7 @@ func TestSendZBC_EscrowValidate(t *testing.T) {
 				EscrowQuery:          tt.fields.EscrowQuery,
 				BlockQuery:           tt.fields.BlockQuery,
 				AccountBalanceHelper: tt.fields.AccountBalanceHelper,
+				AccountDatasetQuery:  tt.fields.AccountDatasetQuery,
 			}
 			if err := tx.EscrowValidate(tt.args.dbTx); (err != nil) != tt.wantErr {
 				t.Errorf("EscrowValidate() error = %v, wantErr %v", err, tt.wantErr)
@@ -1355,3 +1419,77 @@ func TestSendZBC_EscrowApproval(t *testing.T) {
 		})
 	}
 }
+
+func TestSendZBC_EscrowApproval_PartialRelease(t *testing.T) {
+	tests := []struct {
+		name               string
+		releasedAmount     int64
+		releaseAmount      int64
+		wantReleasedAmount int64
+		wantStatus         model.EscrowStatus
+	}{
+		{
+			name:               "wantSuccess:PartialRelease",
+			releaseAmount:      4,
+			wantReleasedAmount: 4,
+			wantStatus:         model.EscrowStatus_Pending,
+		},
+		{
+			name:               "wantSuccess:ReleaseRemainingAmount",
+			releasedAmount:     4,
+			releaseAmount:      6,
+			wantReleasedAmount: 10,
+			wantStatus:         model.EscrowStatus_Approved,
+		},
+		{
+			name:               "wantSuccess:ReleaseWholeRemainingAmountWithoutAmount",
+			releasedAmount:     4,
+			wantReleasedAmount: 10,
+			wantStatus:         model.EscrowStatus_Approved,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &SendZBC{
+				TransactionObject: &model.Transaction{
+					ID:                      1234567890,
+					Fee:                     1,
+					SenderAccountAddress:    senderAddress1,
+					RecipientAccountAddress: recipientAddress1,
+					Height:                  1,
+					Escrow: &model.Escrow{
+						ID:               1234567890,
+						SenderAddress:    senderAddress1,
+						RecipientAddress: recipientAddress1,
+						ApproverAddress:  senderAddress2,
+						Amount:           10,
+						Commission:       1,
+						BlockHeight:      1,
+						ReleasedAmount:   tt.releasedAmount,
+					},
+				},
+				Body: &model.SendZBCTransactionBody{
+					Amount: 10,
+				},
+				EscrowQuery:          query.NewEscrowTransactionQuery(),
+				QueryExecutor:        &mockQueryEscrowApprovalOK{},
+				AccountBalanceHelper: &mockAccountBalanceHelperSuccess{},
+			}
+			err := tx.EscrowApproval(100, &model.ApprovalEscrowTransactionBody{
+				Approval:      model.EscrowApproval_Approve,
+				TransactionID: 1234567890,
+				Amount:        tt.releaseAmount,
+			})
+			if err != nil {
+				t.Errorf("EscrowApproval() error = %v", err)
+				return
+			}
+			if tx.TransactionObject.Escrow.GetReleasedAmount() != tt.wantReleasedAmount {
+				t.Errorf("EscrowApproval() ReleasedAmount = %d, want %d", tx.TransactionObject.Escrow.GetReleasedAmount(), tt.wantReleasedAmount)
+			}
+			if tx.TransactionObject.Escrow.GetStatus() != tt.wantStatus {
+				t.Errorf("EscrowApproval() Status = %v, want %v", tx.TransactionObject.Escrow.GetStatus(), tt.wantStatus)
+			}
+		})
+	}
+}
@@ -63,14 +63,15 @@ import (
 
 // SetupAccountDataset fields that's needed
 type SetupAccountDataset struct {
-	TransactionObject    *model.Transaction
-	Body                 *model.SetupAccountDatasetTransactionBody
-	AccountDatasetQuery  query.AccountDatasetQueryInterface
-	QueryExecutor        query.ExecutorInterface
-	EscrowQuery          query.EscrowTransactionQueryInterface
-	AccountBalanceHelper AccountBalanceHelperInterface
-	TransactionQuery     query.TransactionQueryInterface
-	FeeScaleService      fee.FeeScaleServiceInterface
+	TransactionObject         *model.Transaction
+	Body                      *model.SetupAccountDatasetTransactionBody
+	AccountDatasetQuery       query.AccountDatasetQueryInterface
+	AccountDatasetSchemaQuery query.AccountDatasetSchemaQueryInterface
+	QueryExecutor             query.ExecutorInterface
+	EscrowQuery               query.EscrowTransactionQueryInterface
+	AccountBalanceHelper      AccountBalanceHelperInterface
+	TransactionQuery          query.TransactionQueryInterface
+	FeeScaleService           fee.FeeScaleServiceInterface
 }
 
 // SkipMempoolTransaction this tx type has no mempool filter
@@ -111,6 +112,8 @@ func (tx *SetupAccountDataset) ApplyConfirmed(blockTimestamp int64) error {
 		Height:                  tx.TransactionObject.Height,
 		IsActive:                true,
 		Latest:                  true,
+		ExpiryHeight:            tx.Body.GetExpiryHeight(),
+		ExpiryTimestamp:         tx.Body.GetExpiryTimestamp(),
 	})
 
 	err = tx.QueryExecutor.ExecuteTransactions(accDatasetQ)
@@ -148,19 +151,35 @@ func (tx *SetupAccountDataset) UndoApplyUnconfirmed() error {
 /*
 Validate is func that for validating to Transaction SetupAccountDataset type
 That specs:
-	- Checking the expiration time
+	- Checking the expiry height and timestamp, if any, are after the transaction
+	- A spending restriction consent can't expire, only the setter of the restriction can lift it
+	- Checking the value matches the schema the sender registered for the property, if any
 	- Checking Spendable Balance sender
 */
 func (tx *SetupAccountDataset) Validate(dbTx bool) error {
 	var (
 		accountDataset model.AccountDataset
+		schema         model.AccountDatasetSchema
 		row            *sql.Row
 		err            error
 		qry            string
 		qryArgs        []interface{}
 	)
 
-	// Recipient required while property set as AccountDatasetEscrowApproval
+	if tx.Body.GetExpiryHeight() != 0 && tx.Body.GetExpiryHeight() <= tx.TransactionObject.Height {
+		return blocker.NewBlocker(blocker.ValidationErr, "ExpiryHeightMustBeAfterTransactionHeight")
+	}
+	if tx.Body.GetExpiryTimestamp() != 0 && tx.Body.GetExpiryTimestamp() <= tx.TransactionObject.Timestamp {
+		return blocker.NewBlocker(blocker.ValidationErr, "ExpiryTimestampMustBeAfterTransactionTimestamp")
+	}
+	// the restricted account could escape the spending restriction it consented to by letting the consent expire
+	if tx.Body.GetProperty() == model.AccountDatasetProperty_AccountDatasetSpendingRestrictionConsent.String() &&
+		(tx.Body.GetExpiryHeight() != 0 || tx.Body.GetExpiryTimestamp() != 0) {
+		return blocker.NewBlocker(blocker.ValidationErr, "SpendingRestrictionConsentCannotExpire")
+	}
+
+	// Recipient required while property set as AccountDatasetEscrowApproval, AccountDatasetSpendingRestriction or
+	// AccountDatasetSpendingRestrictionConsent
 	_, ok := model.AccountDatasetProperty_value[tx.Body.GetProperty()]
 	if ok && tx.TransactionObject.RecipientAccountAddress == nil {
 		return blocker.NewBlocker(blocker.ValidationErr, "RecipientRequired")
@@ -190,6 +209,30 @@ func (tx *SetupAccountDataset) Validate(dbTx bool) error {
 		return blocker.NewBlocker(blocker.ValidationErr, "DatasetAlreadyExists")
 	}
 
+	// check the value against the schema of the property
+	qry, qryArgs = tx.AccountDatasetSchemaQuery.GetLatestAccountDatasetSchema(
+		tx.TransactionObject.SenderAccountAddress,
+		tx.Body.GetProperty(),
+	)
+	row, err = tx.QueryExecutor.ExecuteSelectRow(qry, dbTx, qryArgs...)
+	if err != nil {
+		return blocker.NewBlocker(blocker.DBErr, err.Error())
+	}
+	err = tx.AccountDatasetSchemaQuery.Scan(&schema, row)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return blocker.NewBlocker(blocker.DBErr, err.Error())
+		}
+	} else {
+		_, err = util.ParseAccountDatasetValue(&schema, &model.AccountDataset{
+			Property: tx.Body.GetProperty(),
+			Value:    tx.Body.GetValue(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	// check account balance sender
 	enough, e := tx.AccountBalanceHelper.HasEnoughSpendableBalance(dbTx, tx.TransactionObject.SenderAccountAddress, tx.TransactionObject.Fee)
 	if e != nil {
@@ -264,6 +307,19 @@ func (tx *SetupAccountDataset) ParseBodyBytes(txBodyBytes []byte) (model.Transac
 		return nil, err
 	}
 	txBody.Value = string(chunkedBytes)
+	// the expiry is only present in the body of an expiring dataset
+	if buffer.Len() > 0 {
+		chunkedBytes, err = util.ReadTransactionBytes(buffer, int(constant.DatasetExpiryHeight))
+		if err != nil {
+			return nil, err
+		}
+		txBody.ExpiryHeight = util.ConvertBytesToUint32(chunkedBytes)
+		chunkedBytes, err = util.ReadTransactionBytes(buffer, int(constant.DatasetExpiryTimestamp))
+		if err != nil {
+			return nil, err
+		}
+		txBody.ExpiryTimestamp = int64(util.ConvertBytesToUint64(chunkedBytes))
+	}
 
 	return &txBody, nil
 }
@@ -277,6 +333,11 @@ func (tx *SetupAccountDataset) GetBodyBytes() ([]byte, error) {
 	buffer.Write(util.ConvertUint32ToBytes(uint32(len([]byte(tx.Body.GetValue())))))
 	buffer.Write([]byte(tx.Body.GetValue()))
 
+	if tx.Body.GetExpiryHeight() != 0 || tx.Body.GetExpiryTimestamp() != 0 {
+		buffer.Write(util.ConvertUint32ToBytes(tx.Body.GetExpiryHeight()))
+		buffer.Write(util.ConvertUint64ToBytes(uint64(tx.Body.GetExpiryTimestamp())))
+	}
+
 	return buffer.Bytes(), nil
 }
 
@@ -424,13 +485,12 @@ func (tx *SetupAccountDataset) EscrowApproval(
 			return err
 		}
 
-		err = tx.AccountBalanceHelper.AddAccountBalance(
-			tx.TransactionObject.Escrow.GetApproverAddress(),
-			tx.TransactionObject.Escrow.GetCommission(),
-			model.EventType_EventApprovalEscrowTransaction,
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
-			uint64(blockTimestamp),
+			blockTimestamp,
 		)
 		if err != nil {
 			return err
@@ -438,13 +498,12 @@ func (tx *SetupAccountDataset) EscrowApproval(
 
 	case model.EscrowApproval_Reject:
 		tx.TransactionObject.Escrow.Status = model.EscrowStatus_Rejected
-		err = tx.AccountBalanceHelper.AddAccountBalance(
-			tx.TransactionObject.Escrow.GetApproverAddress(),
-			tx.TransactionObject.Escrow.GetCommission(),
-			model.EventType_EventApprovalEscrowTransaction,
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
 			tx.TransactionObject.Height,
 			tx.TransactionObject.ID,
-			uint64(blockTimestamp),
+			blockTimestamp,
 		)
 		if err != nil {
 			return err
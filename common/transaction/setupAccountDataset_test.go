@@ -360,12 +360,15 @@ type (
 	executorSetupAccountDatasetValidateAlreadyExists struct {
 		query.Executor
 	}
+	executorSetupAccountDatasetValidateIntSchema struct {
+		query.Executor
+	}
 )
 
 func (*executorSetupAccountDatasetValidateSuccess) ExecuteSelectRow(qStr string, _ bool, _ ...interface{}) (*sql.Row, error) {
 	db, mock, _ := sqlmock.New()
-	switch strings.Contains(qStr, "account_balance") {
-	case true:
+	switch {
+	case strings.Contains(qStr, "account_balance"):
 		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(
 			sqlmock.NewRows(query.NewAccountBalanceQuery().Fields).AddRow(
 				senderAddress1,
@@ -376,6 +379,8 @@ func (*executorSetupAccountDatasetValidateSuccess) ExecuteSelectRow(qStr string,
 				true,
 			),
 		)
+	case strings.Contains(qStr, "account_dataset_schema"):
+		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(sqlmock.NewRows(query.NewAccountDatasetSchemaQuery().Fields))
 	default:
 		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(
 			sqlmock.NewRows(query.NewAccountDatasetsQuery().Fields).AddRow(
@@ -386,6 +391,8 @@ func (*executorSetupAccountDatasetValidateSuccess) ExecuteSelectRow(qStr string,
 				false,
 				true,
 				5,
+				0,
+				0,
 			),
 		)
 	}
@@ -395,8 +402,8 @@ func (*executorSetupAccountDatasetValidateSuccess) ExecuteSelectRow(qStr string,
 
 func (*executorSetupAccountDatasetValidateAlreadyExists) ExecuteSelectRow(qStr string, _ bool, _ ...interface{}) (*sql.Row, error) {
 	db, mock, _ := sqlmock.New()
-	switch strings.Contains(qStr, "account_balance") {
-	case true:
+	switch {
+	case strings.Contains(qStr, "account_balance"):
 		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(
 			sqlmock.NewRows(query.NewAccountBalanceQuery().Fields).AddRow(
 				senderAddress1,
@@ -407,6 +414,8 @@ func (*executorSetupAccountDatasetValidateAlreadyExists) ExecuteSelectRow(qStr s
 				true,
 			),
 		)
+	case strings.Contains(qStr, "account_dataset_schema"):
+		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(sqlmock.NewRows(query.NewAccountDatasetSchemaQuery().Fields))
 	default:
 		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(
 			sqlmock.NewRows(query.NewAccountDatasetsQuery().Fields).AddRow(
@@ -417,6 +426,8 @@ func (*executorSetupAccountDatasetValidateAlreadyExists) ExecuteSelectRow(qStr s
 				true,
 				true,
 				5,
+				0,
+				0,
 			),
 		)
 	}
@@ -424,13 +435,35 @@ func (*executorSetupAccountDatasetValidateAlreadyExists) ExecuteSelectRow(qStr s
 	return db.QueryRow(qStr), nil
 }
 
+func (*executorSetupAccountDatasetValidateIntSchema) ExecuteSelectRow(qStr string, _ bool, _ ...interface{}) (*sql.Row, error) {
+	db, mock, _ := sqlmock.New()
+	switch {
+	case strings.Contains(qStr, "account_dataset_schema"):
+		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(
+			sqlmock.NewRows(query.NewAccountDatasetSchemaQuery().Fields).AddRow(
+				senderAddress1,
+				"KycLevel",
+				int32(model.AccountDatasetValueType_AccountDatasetValueInt),
+				2,
+				1,
+				true,
+			),
+		)
+	default:
+		mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(sqlmock.NewRows(query.NewAccountDatasetsQuery().Fields))
+	}
+
+	return db.QueryRow(qStr), nil
+}
+
 func TestSetupAccountDataset_Validate(t *testing.T) {
 	type fields struct {
-		Body                 *model.SetupAccountDatasetTransactionBody
-		TransactionObject    *model.Transaction
-		AccountDatasetQuery  query.AccountDatasetQueryInterface
-		QueryExecutor        query.ExecutorInterface
-		AccountBalanceHelper AccountBalanceHelperInterface
+		Body                      *model.SetupAccountDatasetTransactionBody
+		TransactionObject         *model.Transaction
+		AccountDatasetQuery       query.AccountDatasetQueryInterface
+		AccountDatasetSchemaQuery query.AccountDatasetSchemaQueryInterface
+		QueryExecutor             query.ExecutorInterface
+		AccountBalanceHelper      AccountBalanceHelperInterface
 	}
 	tests := []struct {
 		name    string
@@ -444,12 +477,97 @@ func TestSetupAccountDataset_Validate(t *testing.T) {
 				TransactionObject: &model.Transaction{
 					Fee: 60,
 				},
-				AccountDatasetQuery:  query.NewAccountDatasetsQuery(),
-				QueryExecutor:        &executorSetupAccountDatasetValidateSuccess{},
-				AccountBalanceHelper: &mockAccountBalanceHelperFail{},
+				AccountDatasetQuery:       query.NewAccountDatasetsQuery(),
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				QueryExecutor:             &executorSetupAccountDatasetValidateSuccess{},
+				AccountBalanceHelper:      &mockAccountBalanceHelperFail{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantErr:ExpiryHeightNotAfterTransaction",
+			fields: fields{
+				Body: &model.SetupAccountDatasetTransactionBody{
+					Property:     "Admin",
+					Value:        "Welcome",
+					ExpiryHeight: 5,
+				},
+				TransactionObject: &model.Transaction{
+					Fee:                     1,
+					SenderAccountAddress:    senderAddress1,
+					RecipientAccountAddress: recipientAddress1,
+					Height:                  5,
+				},
+				AccountDatasetQuery:       query.NewAccountDatasetsQuery(),
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				QueryExecutor:             &executorSetupAccountDatasetValidateSuccess{},
+				AccountBalanceHelper:      &mockAccountBalanceHelperSuccess{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantErr:ExpiryTimestampNotAfterTransaction",
+			fields: fields{
+				Body: &model.SetupAccountDatasetTransactionBody{
+					Property:        "Admin",
+					Value:           "Welcome",
+					ExpiryTimestamp: 1500000000,
+				},
+				TransactionObject: &model.Transaction{
+					Fee:                     1,
+					SenderAccountAddress:    senderAddress1,
+					RecipientAccountAddress: recipientAddress1,
+					Timestamp:               1600000000,
+				},
+				AccountDatasetQuery:       query.NewAccountDatasetsQuery(),
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				QueryExecutor:             &executorSetupAccountDatasetValidateSuccess{},
+				AccountBalanceHelper:      &mockAccountBalanceHelperSuccess{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantErr:SpendingRestrictionConsentWithExpiry",
+			fields: fields{
+				Body: &model.SetupAccountDatasetTransactionBody{
+					Property:     model.AccountDatasetProperty_AccountDatasetSpendingRestrictionConsent.String(),
+					ExpiryHeight: 10,
+				},
+				TransactionObject: &model.Transaction{
+					Fee:                     1,
+					SenderAccountAddress:    senderAddress1,
+					RecipientAccountAddress: recipientAddress1,
+					Height:                  5,
+				},
+				AccountDatasetQuery:       query.NewAccountDatasetsQuery(),
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				QueryExecutor:             &executorSetupAccountDatasetValidateSuccess{},
+				AccountBalanceHelper:      &mockAccountBalanceHelperSuccess{},
 			},
 			wantErr: true,
 		},
+		{
+			name: "wantSuccess:WithExpiry",
+			fields: fields{
+				Body: &model.SetupAccountDatasetTransactionBody{
+					Property:        "Admin",
+					Value:           "Welcome",
+					ExpiryHeight:    10,
+					ExpiryTimestamp: 1700000000,
+				},
+				TransactionObject: &model.Transaction{
+					Fee:                     1,
+					SenderAccountAddress:    senderAddress1,
+					RecipientAccountAddress: recipientAddress1,
+					Height:                  5,
+					Timestamp:               1600000000,
+				},
+				AccountDatasetQuery:       query.NewAccountDatasetsQuery(),
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				QueryExecutor:             &executorSetupAccountDatasetValidateSuccess{},
+				AccountBalanceHelper:      &mockAccountBalanceHelperSuccess{},
+			},
+		},
 		{
 			name: "wantErr:AlreadyExists",
 			fields: fields{
@@ -462,11 +580,49 @@ func TestSetupAccountDataset_Validate(t *testing.T) {
 					SenderAccountAddress:    senderAddress1,
 					RecipientAccountAddress: recipientAddress1,
 				},
-				AccountDatasetQuery: query.NewAccountDatasetsQuery(),
-				QueryExecutor:       &executorSetupAccountDatasetValidateAlreadyExists{},
+				AccountDatasetQuery:       query.NewAccountDatasetsQuery(),
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				QueryExecutor:             &executorSetupAccountDatasetValidateAlreadyExists{},
 			},
 			wantErr: true,
 		},
+		{
+			name: "wantErr:ValueNotMatchingSchema",
+			fields: fields{
+				Body: &model.SetupAccountDatasetTransactionBody{
+					Property: "KycLevel",
+					Value:    "high",
+				},
+				TransactionObject: &model.Transaction{
+					Fee:                     1,
+					SenderAccountAddress:    senderAddress1,
+					RecipientAccountAddress: recipientAddress1,
+				},
+				AccountDatasetQuery:       query.NewAccountDatasetsQuery(),
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				QueryExecutor:             &executorSetupAccountDatasetValidateIntSchema{},
+				AccountBalanceHelper:      &mockAccountBalanceHelperSuccess{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantSuccess:ValueMatchingSchema",
+			fields: fields{
+				Body: &model.SetupAccountDatasetTransactionBody{
+					Property: "KycLevel",
+					Value:    "3",
+				},
+				TransactionObject: &model.Transaction{
+					Fee:                     1,
+					SenderAccountAddress:    senderAddress1,
+					RecipientAccountAddress: recipientAddress1,
+				},
+				AccountDatasetQuery:       query.NewAccountDatasetsQuery(),
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				QueryExecutor:             &executorSetupAccountDatasetValidateIntSchema{},
+				AccountBalanceHelper:      &mockAccountBalanceHelperSuccess{},
+			},
+		},
 		{
 			name: "wantErr:Success",
 			fields: fields{
@@ -479,20 +635,22 @@ func TestSetupAccountDataset_Validate(t *testing.T) {
 					SenderAccountAddress:    senderAddress1,
 					RecipientAccountAddress: recipientAddress1,
 				},
-				AccountDatasetQuery:  query.NewAccountDatasetsQuery(),
-				QueryExecutor:        &executorSetupAccountDatasetValidateSuccess{},
-				AccountBalanceHelper: &mockAccountBalanceHelperSuccess{},
+				AccountDatasetQuery:       query.NewAccountDatasetsQuery(),
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				QueryExecutor:             &executorSetupAccountDatasetValidateSuccess{},
+				AccountBalanceHelper:      &mockAccountBalanceHelperSuccess{},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tx := &SetupAccountDataset{
-				Body:                 tt.fields.Body,
-				TransactionObject:    tt.fields.TransactionObject,
-				AccountDatasetQuery:  tt.fields.AccountDatasetQuery,
-				QueryExecutor:        tt.fields.QueryExecutor,
-				AccountBalanceHelper: tt.fields.AccountBalanceHelper,
+				Body:                      tt.fields.Body,
+				TransactionObject:         tt.fields.TransactionObject,
+				AccountDatasetQuery:       tt.fields.AccountDatasetQuery,
+				AccountDatasetSchemaQuery: tt.fields.AccountDatasetSchemaQuery,
+				QueryExecutor:             tt.fields.QueryExecutor,
+				AccountBalanceHelper:      tt.fields.AccountBalanceHelper,
 			}
 			if err := tx.Validate(false); (err != nil) != tt.wantErr {
 				t.Errorf("SetupAccountDataset.Validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -626,6 +784,30 @@ func TestSetupAccountDataset_GetBodyBytes(t *testing.T) {
 				32, 98, 105, 114, 116, 104, 100, 97, 121,
 			},
 		},
+		{
+			name: "GetBodyBytes:successWithExpiry",
+			fields: fields{
+				Body: &model.SetupAccountDatasetTransactionBody{
+					Property:        "AccountDatasetEscrowApproval",
+					Value:           "Happy birthday",
+					ExpiryHeight:    10,
+					ExpiryTimestamp: 1600000000,
+				},
+				TransactionObject: &model.Transaction{
+					Fee:                     1,
+					SenderAccountAddress:    senderAddress1,
+					RecipientAccountAddress: recipientAddress1,
+					Height:                  5,
+				},
+				AccountDatasetQuery: nil,
+				QueryExecutor:       nil,
+			},
+			want: []byte{
+				28, 0, 0, 0, 65, 99, 99, 111, 117, 110, 116, 68, 97, 116, 97, 115, 101, 116, 69, 115, 99,
+				114, 111, 119, 65, 112, 112, 114, 111, 118, 97, 108, 14, 0, 0, 0, 72, 97, 112, 112, 121,
+				32, 98, 105, 114, 116, 104, 100, 97, 121, 10, 0, 0, 0, 0, 16, 94, 95, 0, 0, 0, 0,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
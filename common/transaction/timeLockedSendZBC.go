@@ -0,0 +1,453 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/fee"
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+	"github.com/zoobc/zoobc-core/common/util"
+)
+
+type (
+	// TimeLockedSendZBC is Transaction Type that implemented TypeAction
+	TimeLockedSendZBC struct {
+		TransactionObject    *model.Transaction
+		Body                 *model.TimeLockedSendZBCTransactionBody
+		QueryExecutor        query.ExecutorInterface
+		LockedFundQuery      query.LockedFundQueryInterface
+		AccountBalanceHelper AccountBalanceHelperInterface
+		EscrowQuery          query.EscrowTransactionQueryInterface
+		FeeScaleService      fee.FeeScaleServiceInterface
+		AccountDatasetQuery  query.AccountDatasetQueryInterface
+	}
+	// TimeLockedSendZBCInterface methods to release the fund locked by a TimeLockedSendZBC
+	TimeLockedSendZBCInterface interface {
+		ReleaseLockedFund(blockHeight uint32, blockTimestamp int64) error
+	}
+)
+
+/*
+ApplyConfirmed debit the sender and keep the amount in a locked fund until the unlock condition is reached,
+the recipient balance is only updated by ReleaseLockedFund
+*/
+func (tx *TimeLockedSendZBC) ApplyConfirmed(blockTimestamp int64) error {
+	var err = tx.AccountBalanceHelper.AddAccountBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		-(tx.Body.GetAmount() + tx.TransactionObject.Fee),
+		model.EventType_EventTimeLockedSendZBCTransaction,
+		tx.TransactionObject.Height,
+		tx.TransactionObject.ID,
+		uint64(blockTimestamp),
+	)
+	if err != nil {
+		return err
+	}
+
+	lockedFundQ := tx.LockedFundQuery.InsertLockedFund(&model.LockedFund{
+		ID:               tx.TransactionObject.ID,
+		SenderAddress:    tx.TransactionObject.SenderAccountAddress,
+		RecipientAddress: tx.TransactionObject.RecipientAccountAddress,
+		Amount:           tx.Body.GetAmount(),
+		UnlockHeight:     tx.Body.GetUnlockHeight(),
+		UnlockTimestamp:  tx.Body.GetUnlockTimestamp(),
+		Status:           model.LockedFundStatus_LockedFundLocked,
+		BlockHeight:      tx.TransactionObject.Height,
+	})
+	err = tx.QueryExecutor.ExecuteTransactions(lockedFundQ)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *TimeLockedSendZBC) ApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		-(tx.Body.GetAmount() + tx.TransactionObject.Fee),
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *TimeLockedSendZBC) UndoApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		tx.Body.GetAmount()+tx.TransactionObject.Fee,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+/*
+Validate is func that for validating to Transaction TimeLockedSendZBC type
+That specs:
+	- exactly one of unlock height and unlock timestamp is set
+	- an unlock height must be after the transaction height, an unlock timestamp after the transaction timestamp
+	- `sender.spendable_balance` must be enough for amount and fee
+	- the recipient must be whitelisted by every spending restriction of the sender
+*/
+func (tx *TimeLockedSendZBC) Validate(dbTx bool) error {
+	var (
+		err    error
+		enough bool
+	)
+	if tx.Body.GetAmount() <= 0 {
+		return errors.New("transaction must have an amount more than 0")
+	}
+	if tx.TransactionObject.SenderAccountAddress == nil {
+		return errors.New("transaction must have a valid sender account id")
+	}
+	if tx.TransactionObject.RecipientAccountAddress == nil {
+		return errors.New("transaction must have a valid recipient account id")
+	}
+	if (tx.Body.GetUnlockHeight() == 0) == (tx.Body.GetUnlockTimestamp() == 0) {
+		return blocker.NewBlocker(blocker.ValidationErr, "EitherUnlockHeightOrUnlockTimestampRequired")
+	}
+	if tx.Body.GetUnlockHeight() != 0 && tx.Body.GetUnlockHeight() <= tx.TransactionObject.Height {
+		return blocker.NewBlocker(blocker.ValidationErr, "UnlockHeightMustBeAfterTransactionHeight")
+	}
+	if tx.Body.GetUnlockTimestamp() != 0 && tx.Body.GetUnlockTimestamp() <= tx.TransactionObject.Timestamp {
+		return blocker.NewBlocker(blocker.ValidationErr, "UnlockTimestampMustBeAfterTransactionTimestamp")
+	}
+
+	enough, err = tx.AccountBalanceHelper.HasEnoughSpendableBalance(
+		dbTx,
+		tx.TransactionObject.SenderAccountAddress,
+		tx.Body.GetAmount()+tx.TransactionObject.Fee,
+	)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotFound")
+	}
+	if !enough {
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotEnough")
+	}
+	err = validateSpendingRestriction(
+		tx.QueryExecutor,
+		tx.AccountDatasetQuery,
+		tx.TransactionObject.SenderAccountAddress,
+		tx.TransactionObject.RecipientAccountAddress,
+		dbTx,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *TimeLockedSendZBC) GetMinimumFee() (int64, error) {
+	var lastFeeScale model.FeeScale
+	err := tx.FeeScaleService.GetLatestFeeScale(&lastFeeScale)
+	if err != nil {
+		return 0, err
+	}
+	return fee.CalculateTxMinimumFee(tx.TransactionObject, lastFeeScale.FeeScale)
+}
+
+// GetAmount return Amount from TransactionBody
+func (tx *TimeLockedSendZBC) GetAmount() int64 {
+	return tx.Body.GetAmount()
+}
+
+// GetSize amount, unlock height and unlock timestamp
+func (*TimeLockedSendZBC) GetSize() (uint32, error) {
+	return constant.Balance + constant.UnlockHeight + constant.UnlockTimestamp, nil
+}
+
+// ParseBodyBytes read and translate body bytes to body implementation fields
+func (tx *TimeLockedSendZBC) ParseBodyBytes(txBodyBytes []byte) (model.TransactionBodyInterface, error) {
+	// validate the body bytes is correct
+	txSize, err := tx.GetSize()
+	if err != nil {
+		return nil, err
+	}
+	_, err = util.ReadTransactionBytes(bytes.NewBuffer(txBodyBytes), int(txSize))
+	if err != nil {
+		return nil, err
+	}
+	// read body bytes
+	bufferBytes := bytes.NewBuffer(txBodyBytes)
+	amount := util.ConvertBytesToUint64(bufferBytes.Next(int(constant.Balance)))
+	unlockHeight := util.ConvertBytesToUint32(bufferBytes.Next(int(constant.UnlockHeight)))
+	unlockTimestamp := util.ConvertBytesToUint64(bufferBytes.Next(int(constant.UnlockTimestamp)))
+	return &model.TimeLockedSendZBCTransactionBody{
+		Amount:          int64(amount),
+		UnlockHeight:    unlockHeight,
+		UnlockTimestamp: int64(unlockTimestamp),
+	}, nil
+}
+
+// GetBodyBytes translate tx body to bytes representation
+func (tx *TimeLockedSendZBC) GetBodyBytes() ([]byte, error) {
+	buffer := bytes.NewBuffer([]byte{})
+	buffer.Write(util.ConvertUint64ToBytes(uint64(tx.Body.GetAmount())))
+	buffer.Write(util.ConvertUint32ToBytes(tx.Body.GetUnlockHeight()))
+	buffer.Write(util.ConvertUint64ToBytes(uint64(tx.Body.GetUnlockTimestamp())))
+	return buffer.Bytes(), nil
+}
+
+// GetTransactionBody append isTransaction_TransactionBody oneOf
+func (tx *TimeLockedSendZBC) GetTransactionBody(transaction *model.Transaction) {
+	transaction.TransactionBody = &model.Transaction_TimeLockedSendZBCTransactionBody{
+		TimeLockedSendZBCTransactionBody: tx.Body,
+	}
+}
+
+// SkipMempoolTransaction filter out a lock whose unlock height is already reached by the new block, the block would reject it
+func (tx *TimeLockedSendZBC) SkipMempoolTransaction(_ []*model.Transaction, _ int64, newBlockHeight uint32) (bool, error) {
+	return tx.Body.GetUnlockHeight() != 0 && tx.Body.GetUnlockHeight() <= newBlockHeight, nil
+}
+
+// ReleaseLockedFund credit the recipient with the locked amount and mark the locked fund as released at blockHeight
+func (tx *TimeLockedSendZBC) ReleaseLockedFund(blockHeight uint32, blockTimestamp int64) error {
+	var err = tx.AccountBalanceHelper.AddAccountBalance(
+		tx.TransactionObject.RecipientAccountAddress,
+		tx.Body.GetAmount(),
+		model.EventType_EventTimeLockedFundReleased,
+		blockHeight,
+		tx.TransactionObject.ID,
+		uint64(blockTimestamp),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryExecutor.ExecuteTransactions(tx.LockedFundQuery.ReleaseLockedFund(tx.TransactionObject.ID, blockHeight))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+/*
+Escrowable will check the transaction is escrow or not.
+Rebuild escrow if not nil, and can use for whole sibling methods (escrow)
+*/
+func (tx *TimeLockedSendZBC) Escrowable() (EscrowTypeAction, bool) {
+	if tx.TransactionObject.Escrow != nil &&
+		tx.TransactionObject.Escrow.GetApproverAddress() != nil &&
+		!bytes.Equal(tx.TransactionObject.Escrow.GetApproverAddress(), []byte{}) {
+		tx.TransactionObject.Escrow = util.PrepareEscrowObjectForAction(tx.TransactionObject)
+		return EscrowTypeAction(tx), true
+	}
+	return nil, false
+}
+
+// EscrowValidate special validation for escrow's transaction
+func (tx *TimeLockedSendZBC) EscrowValidate(dbTx bool) error {
+	var (
+		err    error
+		enough bool
+	)
+	err = util.ValidateBasicEscrow(tx.TransactionObject)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Validate(dbTx)
+	if err != nil {
+		return err
+	}
+	enough, err = tx.AccountBalanceHelper.HasEnoughSpendableBalance(
+		dbTx,
+		tx.TransactionObject.SenderAccountAddress,
+		tx.Body.GetAmount()+tx.TransactionObject.Fee+tx.TransactionObject.Escrow.GetCommission(),
+	)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotFound")
+	}
+	if !enough {
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountBalanceNotEnough")
+	}
+	return nil
+}
+
+// EscrowApplyUnconfirmed is applyUnconfirmed specific for Escrow's transaction
+func (tx *TimeLockedSendZBC) EscrowApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		-(tx.Body.GetAmount() + tx.TransactionObject.Fee + tx.TransactionObject.Escrow.GetCommission()),
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// EscrowUndoApplyUnconfirmed is used to undo the previous applied unconfirmed tx action
+func (tx *TimeLockedSendZBC) EscrowUndoApplyUnconfirmed() error {
+	var err = tx.AccountBalanceHelper.AddAccountSpendableBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		tx.Body.GetAmount()+tx.TransactionObject.Fee+tx.TransactionObject.Escrow.GetCommission(),
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// EscrowApplyConfirmed debit the sender and insert the escrow, the fund is only locked once the escrow is approved
+func (tx *TimeLockedSendZBC) EscrowApplyConfirmed(blockTimestamp int64) error {
+	var err = tx.AccountBalanceHelper.AddAccountBalance(
+		tx.TransactionObject.SenderAccountAddress,
+		-(tx.Body.GetAmount() + tx.TransactionObject.Fee + tx.TransactionObject.Escrow.GetCommission()),
+		model.EventType_EventEscrowedTransaction,
+		tx.TransactionObject.Height,
+		tx.TransactionObject.ID,
+		uint64(blockTimestamp),
+	)
+	if err != nil {
+		return err
+	}
+
+	escrowQ := tx.EscrowQuery.InsertEscrowTransaction(tx.TransactionObject.Escrow)
+	err = tx.QueryExecutor.ExecuteTransactions(escrowQ)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+/*
+EscrowApproval handle approval an escrow transaction, execute tasks that was skipped when escrow pending.
+like: locking the fund, spreading commission and fee
+*/
+func (tx *TimeLockedSendZBC) EscrowApproval(blockTimestamp int64, txBody *model.ApprovalEscrowTransactionBody) error {
+	var err error
+
+	switch txBody.GetApproval() {
+	case model.EscrowApproval_Approve:
+		tx.TransactionObject.Escrow.Status = model.EscrowStatus_Approved
+		// Bring back the amount and fee that were decreased on EscrowApplyConfirmed before do ApplyConfirmed
+		err = tx.AccountBalanceHelper.AddAccountBalance(
+			tx.TransactionObject.SenderAccountAddress,
+			tx.Body.GetAmount()+tx.TransactionObject.Fee,
+			model.EventType_EventEscrowedTransaction,
+			tx.TransactionObject.Height,
+			tx.TransactionObject.ID,
+			uint64(blockTimestamp),
+		)
+		if err != nil {
+			return err
+		}
+		err = tx.ApplyConfirmed(blockTimestamp)
+		if err != nil {
+			return err
+		}
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
+			tx.TransactionObject.Height,
+			tx.TransactionObject.ID,
+			blockTimestamp,
+		)
+		if err != nil {
+			return err
+		}
+	case model.EscrowApproval_Reject:
+		tx.TransactionObject.Escrow.Status = model.EscrowStatus_Rejected
+		err = tx.AccountBalanceHelper.AddAccountBalance(
+			tx.TransactionObject.SenderAccountAddress,
+			tx.Body.GetAmount(),
+			model.EventType_EventApprovalEscrowTransaction,
+			tx.TransactionObject.Height,
+			tx.TransactionObject.ID,
+			uint64(blockTimestamp),
+		)
+		if err != nil {
+			return err
+		}
+		err = addEscrowCommission(
+			tx.AccountBalanceHelper,
+			tx.TransactionObject.Escrow,
+			tx.TransactionObject.Height,
+			tx.TransactionObject.ID,
+			blockTimestamp,
+		)
+		if err != nil {
+			return err
+		}
+	default:
+		tx.TransactionObject.Escrow.Status = model.EscrowStatus_Expired
+		err = tx.AccountBalanceHelper.AddAccountBalance(
+			tx.TransactionObject.SenderAccountAddress,
+			tx.Body.GetAmount()+tx.TransactionObject.Escrow.GetCommission(),
+			model.EventType_EventApprovalEscrowTransaction,
+			tx.TransactionObject.Height,
+			tx.TransactionObject.ID,
+			uint64(blockTimestamp),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	escrowQ := tx.EscrowQuery.InsertEscrowTransaction(tx.TransactionObject.Escrow)
+	err = tx.QueryExecutor.ExecuteTransactions(escrowQ)
+	if err != nil {
+		return err
+	}
+	return nil
+}
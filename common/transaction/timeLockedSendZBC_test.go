@@ -0,0 +1,358 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package transaction
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+)
+
+type (
+	mockTimeLockedSendZBCAccountBalanceHelper struct {
+		AccountBalanceHelperInterface
+		enough      bool
+		err         error
+		addedEvents []model.EventType
+	}
+)
+
+func (m *mockTimeLockedSendZBCAccountBalanceHelper) AddAccountBalance(
+	address []byte, amount int64, event model.EventType, blockHeight uint32, transactionID int64, blockTimestamp uint64,
+) error {
+	m.addedEvents = append(m.addedEvents, event)
+	return m.err
+}
+
+func (m *mockTimeLockedSendZBCAccountBalanceHelper) AddAccountSpendableBalance(address []byte, amount int64) error {
+	return m.err
+}
+
+func (m *mockTimeLockedSendZBCAccountBalanceHelper) HasEnoughSpendableBalance(dbTX bool, address []byte, compareBalance int64) (bool, error) {
+	return m.enough, m.err
+}
+
+func TestTimeLockedSendZBC_Validate(t *testing.T) {
+	tests := []struct {
+		name                 string
+		body                 *model.TimeLockedSendZBCTransactionBody
+		recipient            []byte
+		accountBalanceHelper AccountBalanceHelperInterface
+		restricted           bool
+		wantErr              bool
+	}{
+		{
+			name:                 "wantError:AmountZero",
+			body:                 &model.TimeLockedSendZBCTransactionBody{UnlockHeight: 10},
+			recipient:            liquidPayAddress2,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:NoRecipient",
+			body:                 &model.TimeLockedSendZBCTransactionBody{Amount: 10, UnlockHeight: 10},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:NoUnlockCondition",
+			body:                 &model.TimeLockedSendZBCTransactionBody{Amount: 10},
+			recipient:            liquidPayAddress2,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:BothUnlockConditions",
+			body:                 &model.TimeLockedSendZBCTransactionBody{Amount: 10, UnlockHeight: 10, UnlockTimestamp: 2000},
+			recipient:            liquidPayAddress2,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:UnlockHeightReached",
+			body:                 &model.TimeLockedSendZBCTransactionBody{Amount: 10, UnlockHeight: 5},
+			recipient:            liquidPayAddress2,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:UnlockTimestampPassed",
+			body:                 &model.TimeLockedSendZBCTransactionBody{Amount: 10, UnlockTimestamp: 1000},
+			recipient:            liquidPayAddress2,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:BalanceNotEnough",
+			body:                 &model.TimeLockedSendZBCTransactionBody{Amount: 10, UnlockHeight: 10},
+			recipient:            liquidPayAddress2,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:RecipientNotWhitelisted",
+			body:                 &model.TimeLockedSendZBCTransactionBody{Amount: 10, UnlockHeight: 10},
+			recipient:            liquidPayAddress2,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+			restricted:           true,
+			wantErr:              true,
+		},
+		{
+			name:                 "wantSuccess:UnlockHeight",
+			body:                 &model.TimeLockedSendZBCTransactionBody{Amount: 10, UnlockHeight: 10},
+			recipient:            liquidPayAddress2,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+		},
+		{
+			name:                 "wantSuccess:UnlockTimestamp",
+			body:                 &model.TimeLockedSendZBCTransactionBody{Amount: 10, UnlockTimestamp: 2000},
+			recipient:            liquidPayAddress2,
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{enough: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &TimeLockedSendZBC{
+				TransactionObject: &model.Transaction{
+					Fee:                     1,
+					Height:                  5,
+					Timestamp:               1000,
+					SenderAccountAddress:    liquidPayAddress1,
+					RecipientAccountAddress: tt.recipient,
+				},
+				Body:                 tt.body,
+				QueryExecutor:        &executorSetupLiquidPaymentSuccess{},
+				AccountBalanceHelper: tt.accountBalanceHelper,
+				AccountDatasetQuery:  &mockAccountDatasetQuerySpendingRestriction{restricted: tt.restricted},
+			}
+			if err := tx.Validate(false); (err != nil) != tt.wantErr {
+				t.Errorf("TimeLockedSendZBC.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTimeLockedSendZBC_SkipMempoolTransaction(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           *model.TimeLockedSendZBCTransactionBody
+		newBlockHeight uint32
+		want           bool
+	}{
+		{
+			name:           "wantSkip:UnlockHeightReached",
+			body:           &model.TimeLockedSendZBCTransactionBody{Amount: 10, UnlockHeight: 10},
+			newBlockHeight: 10,
+			want:           true,
+		},
+		{
+			name:           "wantNotSkip:UnlockHeightAhead",
+			body:           &model.TimeLockedSendZBCTransactionBody{Amount: 10, UnlockHeight: 10},
+			newBlockHeight: 9,
+		},
+		{
+			name:           "wantNotSkip:UnlockTimestamp",
+			body:           &model.TimeLockedSendZBCTransactionBody{Amount: 10, UnlockTimestamp: 2000},
+			newBlockHeight: 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &TimeLockedSendZBC{
+				TransactionObject: &model.Transaction{},
+				Body:              tt.body,
+			}
+			got, err := tx.SkipMempoolTransaction(nil, 0, tt.newBlockHeight)
+			if err != nil {
+				t.Errorf("TimeLockedSendZBC.SkipMempoolTransaction() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("TimeLockedSendZBC.SkipMempoolTransaction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeLockedSendZBC_ApplyConfirmed(t *testing.T) {
+	tests := []struct {
+		name                 string
+		queryExecutor        query.ExecutorInterface
+		accountBalanceHelper *mockTimeLockedSendZBCAccountBalanceHelper
+		wantErr              bool
+	}{
+		{
+			name:                 "wantError:AddAccountBalanceFail",
+			queryExecutor:        &executorSetupLiquidPaymentSuccess{},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{err: errors.New("mockedError")},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:InsertLockedFundFail",
+			queryExecutor:        &executorSetupLiquidPaymentFail{},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantSuccess",
+			queryExecutor:        &executorSetupLiquidPaymentSuccess{},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &TimeLockedSendZBC{
+				TransactionObject: &model.Transaction{
+					ID:                      10,
+					Fee:                     1,
+					Height:                  5,
+					SenderAccountAddress:    liquidPayAddress1,
+					RecipientAccountAddress: liquidPayAddress2,
+				},
+				Body:                 &model.TimeLockedSendZBCTransactionBody{Amount: 10, UnlockHeight: 10},
+				QueryExecutor:        tt.queryExecutor,
+				LockedFundQuery:      query.NewLockedFundQuery(),
+				AccountBalanceHelper: tt.accountBalanceHelper,
+			}
+			if err := tx.ApplyConfirmed(1000); (err != nil) != tt.wantErr {
+				t.Errorf("TimeLockedSendZBC.ApplyConfirmed() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if want := []model.EventType{model.EventType_EventTimeLockedSendZBCTransaction}; !reflect.DeepEqual(tt.accountBalanceHelper.addedEvents, want) {
+				t.Errorf("TimeLockedSendZBC.ApplyConfirmed() ledger events = %v, want %v", tt.accountBalanceHelper.addedEvents, want)
+			}
+		})
+	}
+}
+
+func TestTimeLockedSendZBC_ReleaseLockedFund(t *testing.T) {
+	tests := []struct {
+		name                 string
+		queryExecutor        query.ExecutorInterface
+		accountBalanceHelper *mockTimeLockedSendZBCAccountBalanceHelper
+		wantErr              bool
+	}{
+		{
+			name:                 "wantError:AddAccountBalanceFail",
+			queryExecutor:        &executorSetupLiquidPaymentSuccess{},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{err: errors.New("mockedError")},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantError:ReleaseLockedFundFail",
+			queryExecutor:        &executorSetupLiquidPaymentFail{},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{},
+			wantErr:              true,
+		},
+		{
+			name:                 "wantSuccess",
+			queryExecutor:        &executorSetupLiquidPaymentSuccess{},
+			accountBalanceHelper: &mockTimeLockedSendZBCAccountBalanceHelper{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &TimeLockedSendZBC{
+				TransactionObject: &model.Transaction{
+					ID:                      10,
+					SenderAccountAddress:    liquidPayAddress1,
+					RecipientAccountAddress: liquidPayAddress2,
+				},
+				Body:                 &model.TimeLockedSendZBCTransactionBody{Amount: 10, UnlockHeight: 10},
+				QueryExecutor:        tt.queryExecutor,
+				LockedFundQuery:      query.NewLockedFundQuery(),
+				AccountBalanceHelper: tt.accountBalanceHelper,
+			}
+			if err := tx.ReleaseLockedFund(10, 2000); (err != nil) != tt.wantErr {
+				t.Errorf("TimeLockedSendZBC.ReleaseLockedFund() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if want := []model.EventType{model.EventType_EventTimeLockedFundReleased}; !reflect.DeepEqual(tt.accountBalanceHelper.addedEvents, want) {
+				t.Errorf("TimeLockedSendZBC.ReleaseLockedFund() ledger events = %v, want %v", tt.accountBalanceHelper.addedEvents, want)
+			}
+		})
+	}
+}
+
+func TestTimeLockedSendZBC_ParseBodyBytes(t *testing.T) {
+	body, bodyBytes := GetFixturesForTimeLockedSendZBCTransaction()
+	tests := []struct {
+		name        string
+		txBodyBytes []byte
+		want        model.TransactionBodyInterface
+		wantErr     bool
+	}{
+		{
+			name:        "wantError:WrongSize",
+			txBodyBytes: bodyBytes[:10],
+			wantErr:     true,
+		},
+		{
+			name:        "wantSuccess",
+			txBodyBytes: bodyBytes,
+			want:        body,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := new(TimeLockedSendZBC).ParseBodyBytes(tt.txBodyBytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TimeLockedSendZBC.ParseBodyBytes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TimeLockedSendZBC.ParseBodyBytes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -143,6 +143,36 @@ func (ts *TypeSwitcher) GetTransactionType(tx *model.Transaction) (TypeAction, e
 				BlockQuery:           query.NewBlockQuery(&chaintype.MainChain{}),
 				FeeScaleService:      ts.FeeScaleService,
 				AccountBalanceHelper: accountBalanceHelper,
+				AccountDatasetQuery:  query.NewAccountDatasetsQuery(),
+			}, nil
+		case 1:
+			transactionBody, err = new(TimeLockedSendZBC).ParseBodyBytes(tx.GetTransactionBodyBytes())
+			if err != nil {
+				return nil, err
+			}
+			return &TimeLockedSendZBC{
+				TransactionObject:    tx,
+				Body:                 transactionBody.(*model.TimeLockedSendZBCTransactionBody),
+				QueryExecutor:        ts.Executor,
+				LockedFundQuery:      query.NewLockedFundQuery(),
+				AccountBalanceHelper: accountBalanceHelper,
+				EscrowQuery:          query.NewEscrowTransactionQuery(),
+				FeeScaleService:      ts.FeeScaleService,
+				AccountDatasetQuery:  accountDatasetQuery,
+			}, nil
+		case 2:
+			transactionBody, err = new(MultiSendZBC).ParseBodyBytes(tx.GetTransactionBodyBytes())
+			if err != nil {
+				return nil, err
+			}
+			return &MultiSendZBC{
+				TransactionObject:    tx,
+				Body:                 transactionBody.(*model.MultiSendZBCTransactionBody),
+				QueryExecutor:        ts.Executor,
+				EscrowQuery:          query.NewEscrowTransactionQuery(),
+				FeeScaleService:      ts.FeeScaleService,
+				AccountBalanceHelper: accountBalanceHelper,
+				AccountDatasetQuery:  accountDatasetQuery,
 			}, nil
 		default:
 			return nil, nil
@@ -239,13 +269,14 @@ func (ts *TypeSwitcher) GetTransactionType(tx *model.Transaction) (TypeAction, e
 				return nil, err
 			}
 			return &SetupAccountDataset{
-				TransactionObject:    tx,
-				Body:                 transactionBody.(*model.SetupAccountDatasetTransactionBody),
-				AccountDatasetQuery:  accountDatasetQuery,
-				QueryExecutor:        ts.Executor,
-				EscrowQuery:          query.NewEscrowTransactionQuery(),
-				AccountBalanceHelper: accountBalanceHelper,
-				FeeScaleService:      ts.FeeScaleService,
+				TransactionObject:         tx,
+				Body:                      transactionBody.(*model.SetupAccountDatasetTransactionBody),
+				AccountDatasetQuery:       accountDatasetQuery,
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				QueryExecutor:             ts.Executor,
+				EscrowQuery:               query.NewEscrowTransactionQuery(),
+				AccountBalanceHelper:      accountBalanceHelper,
+				FeeScaleService:           ts.FeeScaleService,
 			}, nil
 		case 1:
 			transactionBody, err = new(RemoveAccountDataset).ParseBodyBytes(tx.TransactionBodyBytes)
@@ -261,6 +292,19 @@ func (ts *TypeSwitcher) GetTransactionType(tx *model.Transaction) (TypeAction, e
 				AccountBalanceHelper: accountBalanceHelper,
 				FeeScaleService:      ts.FeeScaleService,
 			}, nil
+		case 2:
+			transactionBody, err = new(RegisterAccountDatasetSchema).ParseBodyBytes(tx.TransactionBodyBytes)
+			if err != nil {
+				return nil, err
+			}
+			return &RegisterAccountDatasetSchema{
+				TransactionObject:         tx,
+				Body:                      transactionBody.(*model.RegisterAccountDatasetSchemaTransactionBody),
+				QueryExecutor:             ts.Executor,
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				AccountBalanceHelper:      accountBalanceHelper,
+				FeeScaleService:           ts.FeeScaleService,
+			}, nil
 		default:
 			return nil, nil
 		}
@@ -302,6 +346,7 @@ func (ts *TypeSwitcher) GetTransactionType(tx *model.Transaction) (TypeAction, e
 			multisignatureInfoHelper := &MultisignatureInfoHelper{
 				MultiSignatureParticipantQuery: query.NewMultiSignatureParticipantQuery(),
 				MultisignatureInfoQuery:        query.NewMultisignatureInfoQuery(),
+				MultiSignatureRotationQuery:    query.NewMultiSignatureRotationQuery(),
 				QueryExecutor:                  ts.Executor,
 			}
 			signatureInfoHelper := &SignatureInfoHelper{
@@ -331,6 +376,45 @@ func (ts *TypeSwitcher) GetTransactionType(tx *model.Transaction) (TypeAction, e
 				EscrowQuery:              query.NewEscrowTransactionQuery(),
 				QueryExecutor:            ts.Executor,
 			}, nil
+		case 1: // MultiSignatureRotation Transaction
+			transactionBody, err = new(MultiSignatureRotationTransaction).ParseBodyBytes(tx.GetTransactionBodyBytes())
+			if err != nil {
+				return nil, err
+			}
+			return &MultiSignatureRotationTransaction{
+				TransactionObject:           tx,
+				Body:                        transactionBody.(*model.MultiSignatureRotationTransactionBody),
+				QueryExecutor:               ts.Executor,
+				MultiSignatureRotationQuery: query.NewMultiSignatureRotationQuery(),
+				MultisignatureInfoHelper: &MultisignatureInfoHelper{
+					MultiSignatureParticipantQuery: query.NewMultiSignatureParticipantQuery(),
+					MultisignatureInfoQuery:        query.NewMultisignatureInfoQuery(),
+					MultiSignatureRotationQuery:    query.NewMultiSignatureRotationQuery(),
+					QueryExecutor:                  ts.Executor,
+				},
+				MultisigUtil:         NewMultisigTransactionUtil(),
+				AccountBalanceHelper: accountBalanceHelper,
+				FeeScaleService:      ts.FeeScaleService,
+			}, nil
+		case 2: // MultiSignatureCancel Transaction
+			transactionBody, err = new(MultiSignatureCancelTransaction).ParseBodyBytes(tx.GetTransactionBodyBytes())
+			if err != nil {
+				return nil, err
+			}
+			return &MultiSignatureCancelTransaction{
+				TransactionObject: tx,
+				Body:              transactionBody.(*model.MultiSignatureCancelTransactionBody),
+				QueryExecutor:     ts.Executor,
+				PendingTransactionHelper: &PendingTransactionHelper{
+					MultisignatureInfoQuery: query.NewMultisignatureInfoQuery(),
+					PendingTransactionQuery: query.NewPendingTransactionQuery(),
+					TransactionUtil:         transactionUtil,
+					TypeSwitcher:            ts,
+					QueryExecutor:           ts.Executor,
+				},
+				AccountBalanceHelper: accountBalanceHelper,
+				FeeScaleService:      ts.FeeScaleService,
+			}, nil
 		default:
 			return nil, nil
 		}
@@ -349,6 +433,7 @@ func (ts *TypeSwitcher) GetTransactionType(tx *model.Transaction) (TypeAction, e
 				LiquidPaymentTransactionQuery: query.NewLiquidPaymentTransactionQuery(),
 				EscrowQuery:                   query.NewEscrowTransactionQuery(),
 				FeeScaleService:               ts.FeeScaleService,
+				AccountDatasetQuery:           accountDatasetQuery,
 			}, nil
 		case 1: // LiquidPaymentStop Transaction
 			transactionBody, err = new(LiquidPaymentStopTransaction).ParseBodyBytes(tx.GetTransactionBodyBytes())
@@ -366,6 +451,19 @@ func (ts *TypeSwitcher) GetTransactionType(tx *model.Transaction) (TypeAction, e
 				TypeActionSwitcher:            ts,
 				EscrowQuery:                   query.NewEscrowTransactionQuery(),
 			}, nil
+		case 2: // LiquidPaymentWithdraw Transaction
+			transactionBody, err = new(LiquidPaymentWithdrawTransaction).ParseBodyBytes(tx.GetTransactionBodyBytes())
+			if err != nil {
+				return nil, err
+			}
+			return &LiquidPaymentWithdrawTransaction{
+				TransactionObject:             tx,
+				Body:                          transactionBody.(*model.LiquidPaymentWithdrawTransactionBody),
+				QueryExecutor:                 ts.Executor,
+				LiquidPaymentTransactionQuery: query.NewLiquidPaymentTransactionQuery(),
+				AccountBalanceHelper:          accountBalanceHelper,
+				FeeScaleService:               ts.FeeScaleService,
+			}, nil
 		default:
 			return nil, blocker.NewBlocker(blocker.ValidationErr, fmt.Sprintf("transaction type is not valid: %v", buf[1]))
 		}
@@ -409,6 +507,54 @@ func (ts *TypeSwitcher) GetTransactionType(tx *model.Transaction) (TypeAction, e
 		default:
 			return nil, nil
 		}
+	// Hash Time Locked Transfer
+	case 8:
+		switch buf[1] {
+		case 0:
+			transactionBody, err = new(HtlcLockTransaction).ParseBodyBytes(tx.GetTransactionBodyBytes())
+			if err != nil {
+				return nil, err
+			}
+			return &HtlcLockTransaction{
+				TransactionObject:    tx,
+				Body:                 transactionBody.(*model.HtlcLockTransactionBody),
+				QueryExecutor:        ts.Executor,
+				HtlcQuery:            query.NewHtlcQuery(),
+				AccountBalanceHelper: accountBalanceHelper,
+				FeeScaleService:      ts.FeeScaleService,
+				AccountDatasetQuery:  accountDatasetQuery,
+			}, nil
+		case 1:
+			transactionBody, err = new(HtlcClaimTransaction).ParseBodyBytes(tx.GetTransactionBodyBytes())
+			if err != nil {
+				return nil, err
+			}
+			return &HtlcClaimTransaction{
+				TransactionObject:    tx,
+				Body:                 transactionBody.(*model.HtlcClaimTransactionBody),
+				QueryExecutor:        ts.Executor,
+				HtlcQuery:            query.NewHtlcQuery(),
+				BlockQuery:           query.NewBlockQuery(&chaintype.MainChain{}),
+				AccountBalanceHelper: accountBalanceHelper,
+				FeeScaleService:      ts.FeeScaleService,
+			}, nil
+		case 2:
+			transactionBody, err = new(HtlcRefundTransaction).ParseBodyBytes(tx.GetTransactionBodyBytes())
+			if err != nil {
+				return nil, err
+			}
+			return &HtlcRefundTransaction{
+				TransactionObject:    tx,
+				Body:                 transactionBody.(*model.HtlcRefundTransactionBody),
+				QueryExecutor:        ts.Executor,
+				HtlcQuery:            query.NewHtlcQuery(),
+				BlockQuery:           query.NewBlockQuery(&chaintype.MainChain{}),
+				AccountBalanceHelper: accountBalanceHelper,
+				FeeScaleService:      ts.FeeScaleService,
+			}, nil
+		default:
+			return nil, blocker.NewBlocker(blocker.ValidationErr, fmt.Sprintf("transaction type is not valid: %v", buf[1]))
+		}
 	default:
 		return nil, blocker.NewBlocker(blocker.ValidationErr, fmt.Sprintf("transaction type is not valid: %v", buf[0]))
 	}
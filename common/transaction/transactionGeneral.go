@@ -148,6 +148,7 @@ func (*Util) GetTransactionBytes(transaction *model.Transaction, signed bool) ([
 	2. Commission
 	3. Timeout
 	4. Instruction
+	5. Additional approvers and MinimumApprovals, from EscrowApproversTransactionVersion
 	*/
 	if transaction.GetEscrow() != nil && transaction.GetEscrow().GetApproverAddress() != nil {
 		// Address format (byte array): [account type][address public key]
@@ -158,6 +159,19 @@ func (*Util) GetTransactionBytes(transaction *model.Transaction, signed bool) ([
 
 		buffer.Write(util.ConvertUint32ToBytes(uint32(len([]byte(transaction.GetEscrow().GetInstruction())))))
 		buffer.Write([]byte(transaction.GetEscrow().GetInstruction()))
+
+		if transaction.GetVersion() >= constant.EscrowApproversTransactionVersion {
+			// the first approver is always ApproverAddress, already written above
+			var additionalApprovers [][]byte
+			if len(transaction.GetEscrow().GetApproverAddresses()) > 0 {
+				additionalApprovers = transaction.GetEscrow().GetApproverAddresses()[1:]
+			}
+			buffer.Write(util.ConvertUint32ToBytes(uint32(len(additionalApprovers))))
+			for _, approver := range additionalApprovers {
+				buffer.Write(approver)
+			}
+			buffer.Write(util.ConvertUint32ToBytes(transaction.GetEscrow().GetMinimumApprovals()))
+		}
 	} else {
 		// if no escrow, write an empty account for approver
 		emptyAccType, err := accounttype.NewAccountType(int32(model.AccountType_EmptyAccountType), []byte{})
@@ -178,6 +192,11 @@ func (*Util) GetTransactionBytes(transaction *model.Transaction, signed bool) ([
 		buffer.Write(transaction.GetMessage())
 	}
 
+	// replaced transaction, from ReplacementTransactionVersion
+	if transaction.GetVersion() >= constant.ReplacementTransactionVersion {
+		buffer.Write(util.ConvertUint64ToBytes(uint64(transaction.GetReplacedTransactionID())))
+	}
+
 	if signed {
 		if transaction.Signature == nil {
 			return nil, errors.New("TransactionSignatureNotExist")
@@ -271,6 +290,7 @@ func (u *Util) ParseTransactionBytes(transactionBytes []byte, sign bool) (*model
 	2. Commission
 	3. Timeout
 	4. Instruction
+	5. Additional approvers and MinimumApprovals, from EscrowApproversTransactionVersion
 	*/
 	approverAccType, err := accounttype.ParseBytesToAccountType(buffer)
 	if err != nil {
@@ -306,6 +326,36 @@ func (u *Util) ParseTransactionBytes(transactionBytes []byte, sign bool) (*model
 		}
 		escrow.Instruction = string(instruction)
 
+		if transaction.GetVersion() >= constant.EscrowApproversTransactionVersion {
+			chunkedBytes, err = util.ReadTransactionBytes(buffer, int(constant.EscrowNumberOfApprovers))
+			if err != nil {
+				return nil, err
+			}
+			numberOfAdditionalApprovers := int(util.ConvertBytesToUint32(chunkedBytes))
+			if numberOfAdditionalApprovers >= constant.MaxEscrowApprovers {
+				return nil, blocker.NewBlocker(blocker.ParserErr, "TooManyEscrowApprovers")
+			}
+			if numberOfAdditionalApprovers > 0 {
+				escrow.ApproverAddresses = [][]byte{escrow.ApproverAddress}
+				for i := 0; i < numberOfAdditionalApprovers; i++ {
+					additionalApproverAccType, err := accounttype.ParseBytesToAccountType(buffer)
+					if err != nil {
+						return nil, err
+					}
+					additionalApprover, err := additionalApproverAccType.GetAccountAddress()
+					if err != nil {
+						return nil, err
+					}
+					escrow.ApproverAddresses = append(escrow.ApproverAddresses, additionalApprover)
+				}
+			}
+			chunkedBytes, err = util.ReadTransactionBytes(buffer, int(constant.EscrowMinimumApprovals))
+			if err != nil {
+				return nil, err
+			}
+			escrow.MinimumApprovals = util.ConvertBytesToUint32(chunkedBytes)
+		}
+
 		transaction.Escrow = &escrow
 	}
 
@@ -322,8 +372,20 @@ func (u *Util) ParseTransactionBytes(transactionBytes []byte, sign bool) (*model
 		transaction.Message = messageBytes
 	}
 
+	if transaction.GetVersion() >= constant.ReplacementTransactionVersion {
+		chunkedBytes, err = util.ReadTransactionBytes(buffer, int(constant.ReplacedTransactionID))
+		if err != nil {
+			return nil, err
+		}
+		transaction.ReplacedTransactionID = int64(util.ConvertBytesToUint64(chunkedBytes))
+	}
+
 	if sign {
 		signatureLength := senderAccType.GetSignatureLength()
+		if signatureLength == 0 {
+			// variable length signatures, as the bitcoin or webauthn ones, end the transaction bytes
+			signatureLength = uint32(buffer.Len())
+		}
 		transaction.Signature, err = util.ReadTransactionBytes(buffer, int(signatureLength))
 		if err != nil {
 			return nil, blocker.NewBlocker(
@@ -461,7 +523,7 @@ func (u *Util) GenerateMultiSigAddress(info *model.MultiSignatureInfo) ([]byte,
 	if info == nil {
 		return nil, fmt.Errorf("params cannot be nil")
 	}
-	util.SortByteArrays(info.Addresses)
+	util.SortMultisigParticipants(info)
 	var (
 		buff    = bytes.NewBuffer([]byte{})
 		accType accounttype.AccountTypeInterface
@@ -473,6 +535,10 @@ func (u *Util) GenerateMultiSigAddress(info *model.MultiSignatureInfo) ([]byte,
 	for _, address := range info.GetAddresses() {
 		buff.Write(address)
 	}
+	// weights are only part of the address of weighted multisig, non weighted multisig addresses are unchanged
+	for _, weight := range info.GetWeights() {
+		buff.Write(util.ConvertUint32ToBytes(weight))
+	}
 	hashed := sha3.Sum256(buff.Bytes())
 	accType, err = accounttype.NewAccountType(int32(model.AccountType_ZbcAccountType), hashed[:])
 	if err != nil {
@@ -546,6 +612,46 @@ func (*MultisigTransactionUtil) ValidateMultisignatureInfo(multisigInfo *model.M
 			"AtLeastOneMinimumSignatures",
 		)
 	}
+	if util.IsWeightedMultisig(multisigInfo) {
+		return validateMultisignatureWeights(multisigInfo)
+	}
+	return nil
+}
+
+// validateMultisignatureWeights check every distinct participant has a weight and the summed weight can reach the threshold
+func validateMultisignatureWeights(multisigInfo *model.MultiSignatureInfo) error {
+	var (
+		totalWeight  uint64
+		participants = make(map[string]bool)
+	)
+	if len(multisigInfo.GetWeights()) != len(multisigInfo.GetAddresses()) {
+		return blocker.NewBlocker(
+			blocker.ValidationErr,
+			"WeightsNotMatchingParticipants",
+		)
+	}
+	for i, address := range multisigInfo.GetAddresses() {
+		if participants[hex.EncodeToString(address)] {
+			return blocker.NewBlocker(
+				blocker.ValidationErr,
+				"DuplicateMultisigParticipant",
+			)
+		}
+		participants[hex.EncodeToString(address)] = true
+		if multisigInfo.GetWeights()[i] < 1 {
+			return blocker.NewBlocker(
+				blocker.ValidationErr,
+				"AtLeastOneParticipantWeight",
+			)
+		}
+		totalWeight += uint64(multisigInfo.GetWeights()[i])
+	}
+	if totalWeight < uint64(multisigInfo.GetMinimumSignatures()) {
+		return blocker.NewBlocker(
+			blocker.ValidationErr,
+			"MinimumSignaturesMoreThanTotalWeight",
+		)
+	}
 	return nil
 }
 
@@ -617,6 +723,7 @@ func (mtu *MultisigTransactionUtil) ValidatePendingTransactionBytes(
 		[]model.PendingTransactionStatus{
 			model.PendingTransactionStatus_PendingTransactionExecuted,
 			model.PendingTransactionStatus_PendingTransactionPending,
+			model.PendingTransactionStatus_PendingTransactionCancelled,
 		},
 		blockHeight,
 		dbTx,
@@ -664,10 +771,9 @@ func (mtu *MultisigTransactionUtil) CheckMultisigComplete(
 		var readyTxs []*model.MultiSignatureTransactionBody
 		for _, v := range pendingTxs {
 			var (
-				sigInfo               *model.SignatureInfo
-				pendingSigs           []*model.PendingSignature
-				signatures            = make(map[string][]byte)
-				validSignatureCounter uint32
+				sigInfo     *model.SignatureInfo
+				pendingSigs []*model.PendingSignature
+				signatures  = make(map[string][]byte)
 			)
 			pendingSigs, err := signatureInfoHelper.GetPendingSignatureByTransactionHash(v.TransactionHash, txHeight)
 			if err != nil {
@@ -691,12 +797,8 @@ func (mtu *MultisigTransactionUtil) CheckMultisigComplete(
 				TransactionHash: v.TransactionHash,
 				Signatures:      signatures,
 			}
-			for _, addr := range body.MultiSignatureInfo.Addresses {
-				if sigInfo.Signatures[hex.EncodeToString(addr)] != nil {
-					validSignatureCounter++
-				}
-			}
-			if validSignatureCounter >= body.MultiSignatureInfo.MinimumSignatures {
+			if util.GetMultisigSignaturesWeight(body.MultiSignatureInfo, sigInfo.Signatures) >=
+				uint64(body.MultiSignatureInfo.MinimumSignatures) {
 				// todo: return ready to applyConfirm tx
 				cpTx := &model.MultiSignatureTransactionBody{
 					MultiSignatureInfo:       body.MultiSignatureInfo,
@@ -709,10 +811,9 @@ func (mtu *MultisigTransactionUtil) CheckMultisigComplete(
 		return readyTxs, nil
 	} else if len(body.UnsignedTransactionBytes) > 0 {
 		var (
-			multisigInfo          model.MultiSignatureInfo
-			pendingSigs           []*model.PendingSignature
-			validSignatureCounter uint32
-			err                   error
+			multisigInfo model.MultiSignatureInfo
+			pendingSigs  []*model.PendingSignature
+			err          error
 		)
 		txHash := sha3.Sum256(body.UnsignedTransactionBytes)
 		innerTx, err := transactionUtil.ParseTransactionBytes(body.UnsignedTransactionBytes, false)
@@ -734,7 +835,6 @@ func (mtu *MultisigTransactionUtil) CheckMultisigComplete(
 			// other database errors
 			return nil, err
 		}
-		body.MultiSignatureInfo = &multisigInfo
 		if body.SignatureInfo != nil {
 			for addrHex, sig := range body.SignatureInfo.Signatures {
 				decodedAddr, err := hex.DecodeString(addrHex)
@@ -759,34 +859,33 @@ func (mtu *MultisigTransactionUtil) CheckMultisigComplete(
 		}
 
 		pendingSigs = append(pendingSigs, dbPendingSigs...)
-		body.SignatureInfo = &model.SignatureInfo{
+		sigInfo := &model.SignatureInfo{
 			TransactionHash: txHash[:],
 			Signatures:      make(map[string][]byte),
 		}
 		for _, sig := range pendingSigs {
-			body.SignatureInfo.Signatures[hex.EncodeToString(sig.AccountAddress)] = sig.Signature
+			sigInfo.Signatures[hex.EncodeToString(sig.AccountAddress)] = sig.Signature
 		}
-		if len(body.SignatureInfo.Signatures) < 1 {
+		if len(sigInfo.Signatures) < 1 {
 			return nil, nil
 		}
 
-		for _, addr := range multisigInfo.Addresses {
-			if body.SignatureInfo.Signatures[hex.EncodeToString(addr)] != nil {
-				validSignatureCounter++
-			}
-		}
-		if validSignatureCounter >= multisigInfo.MinimumSignatures {
+		if util.GetMultisigSignaturesWeight(&multisigInfo, sigInfo.Signatures) >= uint64(multisigInfo.MinimumSignatures) {
+			// the body of the multisig transaction is left as it is, its bytes must not change once applied
 			return []*model.MultiSignatureTransactionBody{
-				body,
+				{
+					MultiSignatureInfo:       &multisigInfo,
+					UnsignedTransactionBytes: body.UnsignedTransactionBytes,
+					SignatureInfo:            sigInfo,
+				},
 			}, nil
 		}
 	} else if body.SignatureInfo != nil {
 		var (
-			pendingTx             model.PendingTransaction
-			pendingSigs           []*model.PendingSignature
-			multisigInfo          model.MultiSignatureInfo
-			validSignatureCounter uint32
-			err                   error
+			pendingTx    model.PendingTransaction
+			pendingSigs  []*model.PendingSignature
+			multisigInfo model.MultiSignatureInfo
+			err          error
 		)
 		txHash := body.SignatureInfo.TransactionHash
 
@@ -803,8 +902,7 @@ func (mtu *MultisigTransactionUtil) CheckMultisigComplete(
 			}
 			return nil, err
 		}
-		body.UnsignedTransactionBytes = pendingTx.TransactionBytes
-		innerTx, err := transactionUtil.ParseTransactionBytes(body.UnsignedTransactionBytes, false)
+		innerTx, err := transactionUtil.ParseTransactionBytes(pendingTx.TransactionBytes, false)
 		if err != nil {
 			return nil, blocker.NewBlocker(
 				blocker.ValidationErr,
@@ -815,8 +913,15 @@ func (mtu *MultisigTransactionUtil) CheckMultisigComplete(
 		if err != nil {
 			return nil, err
 		}
+		sigInfo := &model.SignatureInfo{
+			TransactionHash: txHash,
+			Signatures:      make(map[string][]byte),
+		}
+		for addr, sig := range body.SignatureInfo.Signatures {
+			sigInfo.Signatures[addr] = sig
+		}
 		for _, sig := range pendingSigs {
-			body.SignatureInfo.Signatures[hex.EncodeToString(sig.AccountAddress)] = sig.Signature
+			sigInfo.Signatures[hex.EncodeToString(sig.AccountAddress)] = sig.Signature
 		}
 		err = multisignatureInfoHelper.GetMultisigInfoByAddress(
 			&multisigInfo,
@@ -829,18 +934,14 @@ func (mtu *MultisigTransactionUtil) CheckMultisigComplete(
 			}
 			return nil, err
 		}
-		// validate signature
-		for _, addr := range multisigInfo.Addresses {
-			if body.SignatureInfo.Signatures[hex.EncodeToString(addr)] != nil {
-				validSignatureCounter++
-			}
-		}
-		if validSignatureCounter >= multisigInfo.MinimumSignatures {
-			cpTx := body
-			cpTx.UnsignedTransactionBytes = pendingTx.TransactionBytes
-			cpTx.MultiSignatureInfo = &multisigInfo
+		// sum the weight of the participants who signed
+		if util.GetMultisigSignaturesWeight(&multisigInfo, sigInfo.Signatures) >= uint64(multisigInfo.MinimumSignatures) {
 			return []*model.MultiSignatureTransactionBody{
-				cpTx,
+				{
+					MultiSignatureInfo:       &multisigInfo,
+					UnsignedTransactionBytes: pendingTx.TransactionBytes,
+					SignatureInfo:            sigInfo,
+				},
 			}, nil
 		}
 
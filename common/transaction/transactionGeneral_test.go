@@ -281,6 +281,27 @@ func TestGetTransactionBytes(t *testing.T) {
 				127, 214, 82, 224, 72, 239, 56, 139, 255, 81, 229, 184, 77, 80, 80, 39, 254, 173, 28, 169, 2, 0, 0, 0, 1, 0, 0, 0, 0, 0,
 				0, 0, 12, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0},
 		},
+		{
+			name: "GetTransactionBytes:success-{replacement}",
+			args: args{
+				transaction: &model.Transaction{
+					Version:               constant.ReplacementTransactionVersion,
+					ID:                    1,
+					BlockID:               1,
+					Height:                1,
+					SenderAccountAddress:  mockTxSenderAccountAddress,
+					TransactionType:       4,
+					Fee:                   1,
+					Timestamp:             1562806389280,
+					TransactionBodyLength: 12,
+					TransactionBodyBytes:  []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+					ReplacedTransactionID: 1,
+				},
+			},
+			want: []byte{4, 0, 0, 0, 3, 32, 10, 133, 222, 107, 1, 0, 0, 0, 0, 0, 0, 4, 38, 68, 24, 230, 247, 88, 220, 119, 124, 51, 149,
+				127, 214, 82, 224, 72, 239, 56, 139, 255, 81, 229, 184, 77, 80, 80, 39, 254, 173, 28, 169, 2, 0, 0, 0, 1, 0, 0, 0, 0, 0,
+				0, 0, 12, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -755,6 +776,21 @@ func TestUtil_GenerateMultiSigAddress(t *testing.T) {
 			want: []byte{0, 0, 0, 0, 156, 245, 22, 64, 141, 106, 136, 228, 125, 30, 62, 62, 38, 92, 203, 116, 9, 51, 188, 100, 158, 147, 219, 171, 75,
 				7, 219, 56, 28, 223, 180, 47},
 		},
+		{
+			name: "wantSuccess:Weighted",
+			args: args{info: &model.MultiSignatureInfo{
+				MinimumSignatures: 4,
+				Nonce:             12,
+				Addresses: [][]byte{
+					recipientAddress1,
+					senderAddress1,
+					approverAddress1,
+				},
+				Weights: []uint32{1, 3, 1},
+			}},
+			want: []byte{0, 0, 0, 0, 246, 77, 37, 58, 196, 100, 210, 195, 128, 2, 10, 11, 8, 146, 92, 141, 48, 154, 10, 147, 23, 132, 249, 56, 214, 238,
+				203, 178, 219, 33, 209, 140},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -813,6 +849,61 @@ func TestMultisigTransactionUtil_ValidateMultisignatureInfo(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Multsig.Weights not matching participants",
+			args: args{
+				&model.MultiSignatureInfo{
+					MinimumSignatures: 1,
+					Addresses:         [][]byte{senderAddress1, senderAddress2},
+					Weights:           []uint32{1},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Multsig.Weight < 1",
+			args: args{
+				&model.MultiSignatureInfo{
+					MinimumSignatures: 1,
+					Addresses:         [][]byte{senderAddress1, senderAddress2},
+					Weights:           []uint32{1, 0},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Multsig.Weighted duplicate participant",
+			args: args{
+				&model.MultiSignatureInfo{
+					MinimumSignatures: 1,
+					Addresses:         [][]byte{senderAddress1, senderAddress1},
+					Weights:           []uint32{1, 1},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Multsig.MinSigs > total weight",
+			args: args{
+				&model.MultiSignatureInfo{
+					MinimumSignatures: 5,
+					Addresses:         [][]byte{senderAddress1, senderAddress2, senderAddress3},
+					Weights:           []uint32{2, 1, 1},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Multsig.Weighted",
+			args: args{
+				&model.MultiSignatureInfo{
+					MinimumSignatures: 4,
+					Addresses:         [][]byte{senderAddress1, senderAddress2, senderAddress3},
+					Weights:           []uint32{3, 1, 1},
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -79,6 +79,7 @@ func TestTypeSwitcher_GetTransactionType(t *testing.T) {
 
 	mockSetupAccountDatasetBody, mockBytesSetupAccountDataset := GetFixturesForSetupAccountDataset()
 	mockRemoveAccountDatasetBody, mockBytesRemoveAccountDataset := GetFixturesForRemoveAccountDataset()
+	mockRegisterAccountDatasetSchemaBody, mockBytesRegisterAccountDatasetSchema := GetFixturesForRegisterAccountDatasetSchema()
 
 	approvalEscrowBody, approvalEscrowBytes := GetFixturesForApprovalEscrowTransaction()
 	feeVoteCommitTransactionBody, feeVoteCommitTransactionBodyBytes := GetFixtureForFeeVoteCommitTransaction(&model.FeeVoteInfo{
@@ -93,6 +94,14 @@ func TestTypeSwitcher_GetTransactionType(t *testing.T) {
 	}, "ZOOBC")
 	liquidPaymentBody, liquidPaymentBytes := GetFixturesForLiquidPaymentTransaction()
 	liquidPaymentStopBody, liquidPaymentStopBytes := GetFixturesForLiquidPaymentStopTransaction()
+	liquidPaymentWithdrawBody, liquidPaymentWithdrawBytes := GetFixturesForLiquidPaymentWithdrawTransaction()
+	timeLockedSendZBCBody, timeLockedSendZBCBytes := GetFixturesForTimeLockedSendZBCTransaction()
+	multiSendZBCBody, multiSendZBCBytes := GetFixturesForMultiSendZBCTransaction()
+	htlcLockBody, htlcLockBytes := GetFixturesForHtlcLockTransaction()
+	htlcClaimBody, htlcClaimBytes := GetFixturesForHtlcClaimTransaction()
+	htlcRefundBody, htlcRefundBytes := GetFixturesForHtlcRefundTransaction()
+	multiSignatureRotationBody, multiSignatureRotationBytes := GetFixturesForMultiSignatureRotationTransaction()
+	multiSignatureCancelBody, multiSignatureCancelBytes := GetFixturesForMultiSignatureCancelTransaction()
 	accountBalanceHelper := NewAccountBalanceHelper(&query.Executor{}, query.NewAccountBalanceQuery(), query.NewAccountLedgerQuery())
 	// // cache mock
 	fixtureTransactionalCache := func(cache interface{}) storage.TransactionalCache {
@@ -162,6 +171,203 @@ func TestTypeSwitcher_GetTransactionType(t *testing.T) {
 				EscrowQuery:          query.NewEscrowTransactionQuery(),
 				BlockQuery:           query.NewBlockQuery(&chaintype.MainChain{}),
 				AccountBalanceHelper: accountBalanceHelper,
+				AccountDatasetQuery:  query.NewAccountDatasetsQuery(),
+			},
+		},
+		{
+			name: "wantTimeLockedSendZBC",
+			fields: fields{
+				Executor: &query.Executor{},
+			},
+			args: args{
+				tx: &model.Transaction{
+					SenderAccountAddress:    senderAddress1,
+					RecipientAccountAddress: senderAddress2,
+					TransactionBodyBytes:    timeLockedSendZBCBytes,
+					TransactionType:         binary.LittleEndian.Uint32([]byte{1, 1, 0, 0}),
+				},
+			},
+			want: &TimeLockedSendZBC{
+				TransactionObject: &model.Transaction{
+					SenderAccountAddress:    senderAddress1,
+					RecipientAccountAddress: senderAddress2,
+					TransactionBodyBytes:    timeLockedSendZBCBytes,
+					TransactionType:         binary.LittleEndian.Uint32([]byte{1, 1, 0, 0}),
+				},
+				Body:                 timeLockedSendZBCBody,
+				QueryExecutor:        &query.Executor{},
+				LockedFundQuery:      query.NewLockedFundQuery(),
+				AccountBalanceHelper: accountBalanceHelper,
+				EscrowQuery:          query.NewEscrowTransactionQuery(),
+				AccountDatasetQuery:  query.NewAccountDatasetsQuery(),
+			},
+		},
+		{
+			name: "wantMultiSendZBC",
+			fields: fields{
+				Executor: &query.Executor{},
+			},
+			args: args{
+				tx: &model.Transaction{
+					SenderAccountAddress: senderAddress1,
+					TransactionBodyBytes: multiSendZBCBytes,
+					TransactionType:      binary.LittleEndian.Uint32([]byte{1, 2, 0, 0}),
+				},
+			},
+			want: &MultiSendZBC{
+				TransactionObject: &model.Transaction{
+					SenderAccountAddress: senderAddress1,
+					TransactionBodyBytes: multiSendZBCBytes,
+					TransactionType:      binary.LittleEndian.Uint32([]byte{1, 2, 0, 0}),
+				},
+				Body:                 multiSendZBCBody,
+				QueryExecutor:        &query.Executor{},
+				EscrowQuery:          query.NewEscrowTransactionQuery(),
+				AccountBalanceHelper: accountBalanceHelper,
+				AccountDatasetQuery:  query.NewAccountDatasetsQuery(),
+			},
+		},
+		{
+			name: "wantHtlcLock",
+			fields: fields{
+				Executor: &query.Executor{},
+			},
+			args: args{
+				tx: &model.Transaction{
+					SenderAccountAddress:    senderAddress1,
+					RecipientAccountAddress: senderAddress2,
+					TransactionBodyBytes:    htlcLockBytes,
+					TransactionType:         binary.LittleEndian.Uint32([]byte{8, 0, 0, 0}),
+				},
+			},
+			want: &HtlcLockTransaction{
+				TransactionObject: &model.Transaction{
+					SenderAccountAddress:    senderAddress1,
+					RecipientAccountAddress: senderAddress2,
+					TransactionBodyBytes:    htlcLockBytes,
+					TransactionType:         binary.LittleEndian.Uint32([]byte{8, 0, 0, 0}),
+				},
+				Body:                 htlcLockBody,
+				QueryExecutor:        &query.Executor{},
+				HtlcQuery:            query.NewHtlcQuery(),
+				AccountBalanceHelper: accountBalanceHelper,
+				AccountDatasetQuery:  query.NewAccountDatasetsQuery(),
+			},
+		},
+		{
+			name: "wantHtlcClaim",
+			fields: fields{
+				Executor: &query.Executor{},
+			},
+			args: args{
+				tx: &model.Transaction{
+					SenderAccountAddress: senderAddress1,
+					TransactionBodyBytes: htlcClaimBytes,
+					TransactionType:      binary.LittleEndian.Uint32([]byte{8, 1, 0, 0}),
+				},
+			},
+			want: &HtlcClaimTransaction{
+				TransactionObject: &model.Transaction{
+					SenderAccountAddress: senderAddress1,
+					TransactionBodyBytes: htlcClaimBytes,
+					TransactionType:      binary.LittleEndian.Uint32([]byte{8, 1, 0, 0}),
+				},
+				Body:                 htlcClaimBody,
+				QueryExecutor:        &query.Executor{},
+				HtlcQuery:            query.NewHtlcQuery(),
+				BlockQuery:           query.NewBlockQuery(&chaintype.MainChain{}),
+				AccountBalanceHelper: accountBalanceHelper,
+			},
+		},
+		{
+			name: "wantHtlcRefund",
+			fields: fields{
+				Executor: &query.Executor{},
+			},
+			args: args{
+				tx: &model.Transaction{
+					SenderAccountAddress: senderAddress1,
+					TransactionBodyBytes: htlcRefundBytes,
+					TransactionType:      binary.LittleEndian.Uint32([]byte{8, 2, 0, 0}),
+				},
+			},
+			want: &HtlcRefundTransaction{
+				TransactionObject: &model.Transaction{
+					SenderAccountAddress: senderAddress1,
+					TransactionBodyBytes: htlcRefundBytes,
+					TransactionType:      binary.LittleEndian.Uint32([]byte{8, 2, 0, 0}),
+				},
+				Body:                 htlcRefundBody,
+				QueryExecutor:        &query.Executor{},
+				HtlcQuery:            query.NewHtlcQuery(),
+				BlockQuery:           query.NewBlockQuery(&chaintype.MainChain{}),
+				AccountBalanceHelper: accountBalanceHelper,
+			},
+		},
+		{
+			name: "wantMultiSignatureRotation",
+			fields: fields{
+				Executor: &query.Executor{},
+			},
+			args: args{
+				tx: &model.Transaction{
+					SenderAccountAddress: senderAddress1,
+					TransactionBodyBytes: multiSignatureRotationBytes,
+					TransactionType:      binary.LittleEndian.Uint32([]byte{5, 1, 0, 0}),
+				},
+			},
+			want: &MultiSignatureRotationTransaction{
+				TransactionObject: &model.Transaction{
+					SenderAccountAddress: senderAddress1,
+					TransactionBodyBytes: multiSignatureRotationBytes,
+					TransactionType:      binary.LittleEndian.Uint32([]byte{5, 1, 0, 0}),
+				},
+				Body:                        multiSignatureRotationBody,
+				QueryExecutor:               &query.Executor{},
+				MultiSignatureRotationQuery: query.NewMultiSignatureRotationQuery(),
+				MultisignatureInfoHelper: &MultisignatureInfoHelper{
+					MultiSignatureParticipantQuery: query.NewMultiSignatureParticipantQuery(),
+					MultisignatureInfoQuery:        query.NewMultisignatureInfoQuery(),
+					MultiSignatureRotationQuery:    query.NewMultiSignatureRotationQuery(),
+					QueryExecutor:                  &query.Executor{},
+				},
+				MultisigUtil:         NewMultisigTransactionUtil(),
+				AccountBalanceHelper: accountBalanceHelper,
+			},
+		},
+		{
+			name: "wantMultiSignatureCancel",
+			fields: fields{
+				Executor: &query.Executor{},
+			},
+			args: args{
+				tx: &model.Transaction{
+					SenderAccountAddress: senderAddress1,
+					TransactionBodyBytes: multiSignatureCancelBytes,
+					TransactionType:      binary.LittleEndian.Uint32([]byte{5, 2, 0, 0}),
+				},
+			},
+			want: &MultiSignatureCancelTransaction{
+				TransactionObject: &model.Transaction{
+					SenderAccountAddress: senderAddress1,
+					TransactionBodyBytes: multiSignatureCancelBytes,
+					TransactionType:      binary.LittleEndian.Uint32([]byte{5, 2, 0, 0}),
+				},
+				Body:          multiSignatureCancelBody,
+				QueryExecutor: &query.Executor{},
+				PendingTransactionHelper: &PendingTransactionHelper{
+					MultisignatureInfoQuery: query.NewMultisignatureInfoQuery(),
+					PendingTransactionQuery: query.NewPendingTransactionQuery(),
+					TransactionUtil: &Util{
+						AccountDatasetQuery: query.NewAccountDatasetsQuery(),
+						QueryExecutor:       &query.Executor{},
+					},
+					TypeSwitcher: &TypeSwitcher{
+						Executor: &query.Executor{},
+					},
+					QueryExecutor: &query.Executor{},
+				},
+				AccountBalanceHelper: accountBalanceHelper,
 			},
 		},
 		{
@@ -394,10 +600,11 @@ func TestTypeSwitcher_GetTransactionType(t *testing.T) {
 					TransactionType:      binary.LittleEndian.Uint32([]byte{3, 0, 0, 0}),
 					TransactionBodyBytes: mockBytesSetupAccountDataset,
 				},
-				QueryExecutor:        &query.Executor{},
-				AccountDatasetQuery:  query.NewAccountDatasetsQuery(),
-				AccountBalanceHelper: accountBalanceHelper,
-				EscrowQuery:          query.NewEscrowTransactionQuery(),
+				QueryExecutor:             &query.Executor{},
+				AccountDatasetQuery:       query.NewAccountDatasetsQuery(),
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				AccountBalanceHelper:      accountBalanceHelper,
+				EscrowQuery:               query.NewEscrowTransactionQuery(),
 			},
 		},
 		{
@@ -435,6 +642,38 @@ func TestTypeSwitcher_GetTransactionType(t *testing.T) {
 				EscrowQuery:          query.NewEscrowTransactionQuery(),
 			},
 		},
+		{
+			name: "wantRegisterAccountDatasetSchema",
+			fields: fields{
+				Executor: &query.Executor{},
+			},
+			args: args{
+				tx: &model.Transaction{
+					Height:               5,
+					SenderAccountAddress: senderAddress1,
+					TransactionBody: &model.Transaction_RegisterAccountDatasetSchemaTransactionBody{
+						RegisterAccountDatasetSchemaTransactionBody: mockRegisterAccountDatasetSchemaBody,
+					},
+					TransactionType:      binary.LittleEndian.Uint32([]byte{3, 2, 0, 0}),
+					TransactionBodyBytes: mockBytesRegisterAccountDatasetSchema,
+				},
+			},
+			want: &RegisterAccountDatasetSchema{
+				Body: mockRegisterAccountDatasetSchemaBody,
+				TransactionObject: &model.Transaction{
+					Height:               5,
+					SenderAccountAddress: senderAddress1,
+					TransactionBody: &model.Transaction_RegisterAccountDatasetSchemaTransactionBody{
+						RegisterAccountDatasetSchemaTransactionBody: mockRegisterAccountDatasetSchemaBody,
+					},
+					TransactionType:      binary.LittleEndian.Uint32([]byte{3, 2, 0, 0}),
+					TransactionBodyBytes: mockBytesRegisterAccountDatasetSchema,
+				},
+				QueryExecutor:             &query.Executor{},
+				AccountDatasetSchemaQuery: query.NewAccountDatasetSchemaQuery(),
+				AccountBalanceHelper:      accountBalanceHelper,
+			},
+		},
 		{
 			name: "wantEscrowApproval",
 			fields: fields{
@@ -598,6 +837,7 @@ func TestTypeSwitcher_GetTransactionType(t *testing.T) {
 				AccountBalanceHelper:          accountBalanceHelper,
 				LiquidPaymentTransactionQuery: query.NewLiquidPaymentTransactionQuery(),
 				EscrowQuery:                   query.NewEscrowTransactionQuery(),
+				AccountDatasetQuery:           query.NewAccountDatasetsQuery(),
 			},
 		},
 		{
@@ -636,6 +876,37 @@ func TestTypeSwitcher_GetTransactionType(t *testing.T) {
 				EscrowQuery: query.NewEscrowTransactionQuery(),
 			},
 		},
+		{
+			name: "wantLiquidPaymentWithdraw",
+			fields: fields{
+				Executor: &query.Executor{},
+			},
+			args: args{
+				tx: &model.Transaction{
+					Height:                  5,
+					SenderAccountAddress:    mockTxSenderAccountAddress,
+					RecipientAccountAddress: mockTxRecipientAccountAddress,
+					TransactionBody:         liquidPaymentWithdrawBody,
+					TransactionType:         binary.LittleEndian.Uint32([]byte{6, 2, 0, 0}),
+					TransactionBodyBytes:    liquidPaymentWithdrawBytes,
+				},
+			},
+			want: &LiquidPaymentWithdrawTransaction{
+				TransactionObject: &model.Transaction{
+					ID:                      0,
+					SenderAccountAddress:    mockTxSenderAccountAddress,
+					RecipientAccountAddress: mockTxRecipientAccountAddress,
+					Height:                  5,
+					TransactionBody:         liquidPaymentWithdrawBody,
+					TransactionType:         binary.LittleEndian.Uint32([]byte{6, 2, 0, 0}),
+					TransactionBodyBytes:    liquidPaymentWithdrawBytes,
+				},
+				Body:                          liquidPaymentWithdrawBody,
+				QueryExecutor:                 &query.Executor{},
+				AccountBalanceHelper:          accountBalanceHelper,
+				LiquidPaymentTransactionQuery: query.NewLiquidPaymentTransactionQuery(),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,152 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package util
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+
+	"github.com/zoobc/zoobc-core/common/accounttype"
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+// ValidateAccountDatasetSchema check the property, value type and maximum length of a schema to be registered
+func ValidateAccountDatasetSchema(property string, valueType model.AccountDatasetValueType, maxLength uint32) error {
+	if property == "" {
+		return blocker.NewBlocker(blocker.ValidationErr, "PropertyIsRequired")
+	}
+	if _, ok := model.AccountDatasetProperty_value[property]; ok {
+		return blocker.NewBlocker(blocker.ValidationErr, "AccountDatasetPropertyReserved")
+	}
+	if _, ok := model.AccountDatasetValueType_name[int32(valueType)]; !ok {
+		return blocker.NewBlocker(blocker.ValidationErr, "InvalidAccountDatasetValueType")
+	}
+	if valueType == model.AccountDatasetValueType_AccountDatasetValueJSON &&
+		(maxLength == 0 || maxLength > constant.DatasetMaxJSONValueLength) {
+		return blocker.NewBlocker(blocker.ValidationErr, "InvalidAccountDatasetJSONMaxLength")
+	}
+	return nil
+}
+
+/*
+ParseAccountDatasetValue check the value of a dataset against the schema of its property and return the typed value:
+  - String: any value
+  - Int: a decimal signed 64 bit integer
+  - Bool: either true or false
+  - Address: a hex encoded full account address (account type followed by the public key)
+  - Hash: a hex encoded 32 bytes hash
+  - JSON: a valid JSON document
+
+a MaxLength of 0 doesn't limit the length of the value, except for JSON values
+*/
+func ParseAccountDatasetValue(
+	schema *model.AccountDatasetSchema,
+	dataset *model.AccountDataset,
+) (*model.TypedAccountDataset, error) {
+	var (
+		value = dataset.GetValue()
+		typed = &model.TypedAccountDataset{
+			AccountDataset: dataset,
+			ValueType:      schema.GetValueType(),
+		}
+	)
+	if schema.GetMaxLength() > 0 && uint32(len(value)) > schema.GetMaxLength() {
+		return nil, blocker.NewBlocker(blocker.ValidationErr, "AccountDatasetValueTooLong")
+	}
+	switch schema.GetValueType() {
+	case model.AccountDatasetValueType_AccountDatasetValueString:
+	case model.AccountDatasetValueType_AccountDatasetValueInt:
+		intValue, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, blocker.NewBlocker(blocker.ValidationErr, "AccountDatasetValueNotInt")
+		}
+		typed.IntValue = intValue
+	case model.AccountDatasetValueType_AccountDatasetValueBool:
+		switch value {
+		case "true":
+			typed.BoolValue = true
+		case "false":
+		default:
+			return nil, blocker.NewBlocker(blocker.ValidationErr, "AccountDatasetValueNotBool")
+		}
+	case model.AccountDatasetValueType_AccountDatasetValueAddress:
+		address, err := hex.DecodeString(value)
+		if err != nil {
+			return nil, blocker.NewBlocker(blocker.ValidationErr, "AccountDatasetValueNotAddress")
+		}
+		accType, err := accounttype.NewAccountTypeFromAccount(address)
+		if err != nil {
+			return nil, blocker.NewBlocker(blocker.ValidationErr, "AccountDatasetValueNotAddress")
+		}
+		fullAddress, err := accType.GetAccountAddress()
+		if err != nil || !bytes.Equal(fullAddress, address) {
+			return nil, blocker.NewBlocker(blocker.ValidationErr, "AccountDatasetValueNotAddress")
+		}
+		typed.BytesValue = address
+	case model.AccountDatasetValueType_AccountDatasetValueHash:
+		hash, err := hex.DecodeString(value)
+		if err != nil || uint32(len(hash)) != constant.DatasetHashValueLength {
+			return nil, blocker.NewBlocker(blocker.ValidationErr, "AccountDatasetValueNotHash")
+		}
+		typed.BytesValue = hash
+	case model.AccountDatasetValueType_AccountDatasetValueJSON:
+		if !json.Valid([]byte(value)) {
+			return nil, blocker.NewBlocker(blocker.ValidationErr, "AccountDatasetValueNotJSON")
+		}
+		typed.JSONValue = value
+	default:
+		return nil, blocker.NewBlocker(blocker.ValidationErr, "InvalidAccountDatasetValueType")
+	}
+	return typed, nil
+}
@@ -0,0 +1,273 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package util
+
+import (
+	"bytes"
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+func TestValidateAccountDatasetSchema(t *testing.T) {
+	type args struct {
+		property  string
+		valueType model.AccountDatasetValueType
+		maxLength uint32
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "wantFail:EmptyProperty",
+			args:    args{valueType: model.AccountDatasetValueType_AccountDatasetValueInt},
+			wantErr: true,
+		},
+		{
+			name: "wantFail:ReservedProperty",
+			args: args{
+				property:  model.AccountDatasetProperty_AccountDatasetEscrowApproval.String(),
+				valueType: model.AccountDatasetValueType_AccountDatasetValueString,
+			},
+			wantErr: true,
+		},
+		{
+			name:    "wantFail:UnknownValueType",
+			args:    args{property: "KycLevel", valueType: model.AccountDatasetValueType(99)},
+			wantErr: true,
+		},
+		{
+			name:    "wantFail:JSONWithoutMaxLength",
+			args:    args{property: "Profile", valueType: model.AccountDatasetValueType_AccountDatasetValueJSON},
+			wantErr: true,
+		},
+		{
+			name: "wantFail:JSONMaxLengthTooBig",
+			args: args{
+				property:  "Profile",
+				valueType: model.AccountDatasetValueType_AccountDatasetValueJSON,
+				maxLength: constant.DatasetMaxJSONValueLength + 1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantSuccess:JSON",
+			args: args{
+				property:  "Profile",
+				valueType: model.AccountDatasetValueType_AccountDatasetValueJSON,
+				maxLength: constant.DatasetMaxJSONValueLength,
+			},
+		},
+		{
+			name: "wantSuccess:IntWithoutMaxLength",
+			args: args{property: "KycLevel", valueType: model.AccountDatasetValueType_AccountDatasetValueInt},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateAccountDatasetSchema(tt.args.property, tt.args.valueType, tt.args.maxLength); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAccountDatasetSchema() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseAccountDatasetValue(t *testing.T) {
+	var (
+		zbcAddress = append([]byte{0, 0, 0, 0}, bytes.Repeat([]byte{1}, 32)...)
+		hash       = bytes.Repeat([]byte{2}, 32)
+	)
+	schemaOf := func(valueType model.AccountDatasetValueType, maxLength uint32) *model.AccountDatasetSchema {
+		return &model.AccountDatasetSchema{
+			Property:  "Property",
+			ValueType: valueType,
+			MaxLength: maxLength,
+		}
+	}
+	datasetOf := func(value string) *model.AccountDataset {
+		return &model.AccountDataset{
+			Property: "Property",
+			Value:    value,
+		}
+	}
+	tests := []struct {
+		name    string
+		schema  *model.AccountDatasetSchema
+		dataset *model.AccountDataset
+		want    *model.TypedAccountDataset
+		wantErr bool
+	}{
+		{
+			name:    "wantFail:TooLong",
+			schema:  schemaOf(model.AccountDatasetValueType_AccountDatasetValueString, 3),
+			dataset: datasetOf("abcd"),
+			wantErr: true,
+		},
+		{
+			name:    "wantSuccess:String",
+			schema:  schemaOf(model.AccountDatasetValueType_AccountDatasetValueString, 0),
+			dataset: datasetOf("abcd"),
+			want: &model.TypedAccountDataset{
+				AccountDataset: datasetOf("abcd"),
+				ValueType:      model.AccountDatasetValueType_AccountDatasetValueString,
+			},
+		},
+		{
+			name:    "wantFail:NotInt",
+			schema:  schemaOf(model.AccountDatasetValueType_AccountDatasetValueInt, 0),
+			dataset: datasetOf("1.5"),
+			wantErr: true,
+		},
+		{
+			name:    "wantSuccess:Int",
+			schema:  schemaOf(model.AccountDatasetValueType_AccountDatasetValueInt, 0),
+			dataset: datasetOf("-42"),
+			want: &model.TypedAccountDataset{
+				AccountDataset: datasetOf("-42"),
+				ValueType:      model.AccountDatasetValueType_AccountDatasetValueInt,
+				IntValue:       -42,
+			},
+		},
+		{
+			name:    "wantFail:NotBool",
+			schema:  schemaOf(model.AccountDatasetValueType_AccountDatasetValueBool, 0),
+			dataset: datasetOf("TRUE"),
+			wantErr: true,
+		},
+		{
+			name:    "wantSuccess:Bool",
+			schema:  schemaOf(model.AccountDatasetValueType_AccountDatasetValueBool, 0),
+			dataset: datasetOf("true"),
+			want: &model.TypedAccountDataset{
+				AccountDataset: datasetOf("true"),
+				ValueType:      model.AccountDatasetValueType_AccountDatasetValueBool,
+				BoolValue:      true,
+			},
+		},
+		{
+			name:    "wantFail:AddressTrailingBytes",
+			schema:  schemaOf(model.AccountDatasetValueType_AccountDatasetValueAddress, 0),
+			dataset: datasetOf(hex.EncodeToString(append(zbcAddress, 3))),
+			wantErr: true,
+		},
+		{
+			name:    "wantFail:AddressNotHex",
+			schema:  schemaOf(model.AccountDatasetValueType_AccountDatasetValueAddress, 0),
+			dataset: datasetOf("ZBC_AAAA"),
+			wantErr: true,
+		},
+		{
+			name:    "wantSuccess:Address",
+			schema:  schemaOf(model.AccountDatasetValueType_AccountDatasetValueAddress, 0),
+			dataset: datasetOf(hex.EncodeToString(zbcAddress)),
+			want: &model.TypedAccountDataset{
+				AccountDataset: datasetOf(hex.EncodeToString(zbcAddress)),
+				ValueType:      model.AccountDatasetValueType_AccountDatasetValueAddress,
+				BytesValue:     zbcAddress,
+			},
+		},
+		{
+			name:    "wantFail:HashWrongLength",
+			schema:  schemaOf(model.AccountDatasetValueType_AccountDatasetValueHash, 0),
+			dataset: datasetOf(hex.EncodeToString(hash[:31])),
+			wantErr: true,
+		},
+		{
+			name:    "wantSuccess:Hash",
+			schema:  schemaOf(model.AccountDatasetValueType_AccountDatasetValueHash, 0),
+			dataset: datasetOf(hex.EncodeToString(hash)),
+			want: &model.TypedAccountDataset{
+				AccountDataset: datasetOf(hex.EncodeToString(hash)),
+				ValueType:      model.AccountDatasetValueType_AccountDatasetValueHash,
+				BytesValue:     hash,
+			},
+		},
+		{
+			name:    "wantFail:InvalidJSON",
+			schema:  schemaOf(model.AccountDatasetValueType_AccountDatasetValueJSON, 100),
+			dataset: datasetOf(`{"level":`),
+			wantErr: true,
+		},
+		{
+			name:    "wantFail:JSONTooLong",
+			schema:  schemaOf(model.AccountDatasetValueType_AccountDatasetValueJSON, 10),
+			dataset: datasetOf(`{"level":"` + strings.Repeat("a", 10) + `"}`),
+			wantErr: true,
+		},
+		{
+			name:    "wantSuccess:JSON",
+			schema:  schemaOf(model.AccountDatasetValueType_AccountDatasetValueJSON, 100),
+			dataset: datasetOf(`{"level":2}`),
+			want: &model.TypedAccountDataset{
+				AccountDataset: datasetOf(`{"level":2}`),
+				ValueType:      model.AccountDatasetValueType_AccountDatasetValueJSON,
+				JSONValue:      `{"level":2}`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAccountDatasetValue(tt.schema, tt.dataset)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseAccountDatasetValue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseAccountDatasetValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
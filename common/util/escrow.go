@@ -2,8 +2,10 @@ package util
 
 import (
 	"bytes"
+	"encoding/hex"
 
 	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/constant"
 	"github.com/zoobc/zoobc-core/common/model"
 )
 
@@ -18,6 +20,38 @@ func ValidateBasicEscrow(tx *model.Transaction) error {
 	if tx.Escrow.GetTimeout() < tx.GetTimestamp() {
 		return blocker.NewBlocker(blocker.ValidationErr, "TimeoutHasPassed")
 	}
+	return validateEscrowApprovers(tx.Escrow)
+}
+
+// validateEscrowApprovers check the approvers of a multi approver escrow are distinct and can reach the minimum approvals
+func validateEscrowApprovers(escrow *model.Escrow) error {
+	var (
+		approvers = make(map[string]bool)
+	)
+	if len(escrow.GetApproverAddresses()) == 0 {
+		if escrow.GetMinimumApprovals() > 1 {
+			return blocker.NewBlocker(blocker.ValidationErr, "MinimumApprovalsMoreThanApprovers")
+		}
+		return nil
+	}
+	if len(escrow.GetApproverAddresses()) > constant.MaxEscrowApprovers {
+		return blocker.NewBlocker(blocker.ValidationErr, "TooManyEscrowApprovers")
+	}
+	if !bytes.Equal(escrow.GetApproverAddresses()[0], escrow.GetApproverAddress()) {
+		return blocker.NewBlocker(blocker.ValidationErr, "FirstApproverMustBeApproverAddress")
+	}
+	for _, approver := range escrow.GetApproverAddresses() {
+		if IsBytesEmpty(approver) {
+			return blocker.NewBlocker(blocker.ValidationErr, "ApproverAddressRequired")
+		}
+		if approvers[hex.EncodeToString(approver)] {
+			return blocker.NewBlocker(blocker.ValidationErr, "DuplicateEscrowApprover")
+		}
+		approvers[hex.EncodeToString(approver)] = true
+	}
+	if escrow.GetMinimumApprovals() == 0 || int(escrow.GetMinimumApprovals()) > len(escrow.GetApproverAddresses()) {
+		return blocker.NewBlocker(blocker.ValidationErr, "InvalidMinimumApprovals")
+	}
 	return nil
 }
 
@@ -30,3 +64,63 @@ func PrepareEscrowObjectForAction(tx *model.Transaction) *model.Escrow {
 	tx.Escrow.Latest = true
 	return tx.Escrow
 }
+
+// GetEscrowApprovers return every approver of the escrow, a single approver escrow only has its ApproverAddress
+func GetEscrowApprovers(escrow *model.Escrow) [][]byte {
+	if len(escrow.GetApproverAddresses()) > 0 {
+		return escrow.GetApproverAddresses()
+	}
+	return [][]byte{escrow.GetApproverAddress()}
+}
+
+// IsMultiApproverEscrow return true when the escrow is settled by the votes of several approvers
+func IsMultiApproverEscrow(escrow *model.Escrow) bool {
+	return len(escrow.GetApproverAddresses()) > 1
+}
+
+// HasEscrowApproverVoted return true when address already approved or rejected the escrow
+func HasEscrowApproverVoted(escrow *model.Escrow, address []byte) bool {
+	for _, voter := range escrow.GetApprovals() {
+		if bytes.Equal(voter, address) {
+			return true
+		}
+	}
+	for _, voter := range escrow.GetRejections() {
+		if bytes.Equal(voter, address) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+GetEscrowSettlement return the approval settling a multi approver escrow from its votes:
+	- approve once the minimum approvals is reached
+	- reject once the remaining approvers can't reach the minimum approvals anymore
+settled is false while the votes can still go either way
+*/
+func GetEscrowSettlement(escrow *model.Escrow) (approval model.EscrowApproval, settled bool) {
+	var (
+		numberOfApprovers = len(GetEscrowApprovers(escrow))
+		minimumApprovals  = int(escrow.GetMinimumApprovals())
+	)
+	if minimumApprovals == 0 {
+		minimumApprovals = 1
+	}
+	if len(escrow.GetApprovals()) >= minimumApprovals {
+		return model.EscrowApproval_Approve, true
+	}
+	if len(escrow.GetRejections()) > numberOfApprovers-minimumApprovals {
+		return model.EscrowApproval_Reject, true
+	}
+	return model.EscrowApproval_Approve, false
+}
+
+// GetEscrowCommissionRecipients return the approvers sharing the commission, the approvers who voted or the single approver
+func GetEscrowCommissionRecipients(escrow *model.Escrow) [][]byte {
+	var voters = append(append([][]byte{}, escrow.GetApprovals()...), escrow.GetRejections()...)
+	if len(voters) == 0 {
+		return [][]byte{escrow.GetApproverAddress()}
+	}
+	return voters
+}
@@ -59,6 +59,79 @@ func TestValidateBasicEscrow(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "wantFailed:MinimumApprovalsMoreThanApprovers",
+			args: args{
+				tx: &model.Transaction{
+					Escrow: &model.Escrow{
+						ApproverAddress:  []byte{1, 2, 3},
+						MinimumApprovals: 2,
+						Timeout:          100,
+					},
+					Timestamp: 100,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantFailed:FirstApproverMustBeApproverAddress",
+			args: args{
+				tx: &model.Transaction{
+					Escrow: &model.Escrow{
+						ApproverAddress:   []byte{1, 2, 3},
+						ApproverAddresses: [][]byte{{4, 5, 6}, {1, 2, 3}},
+						MinimumApprovals:  1,
+						Timeout:           100,
+					},
+					Timestamp: 100,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantFailed:DuplicateEscrowApprover",
+			args: args{
+				tx: &model.Transaction{
+					Escrow: &model.Escrow{
+						ApproverAddress:   []byte{1, 2, 3},
+						ApproverAddresses: [][]byte{{1, 2, 3}, {1, 2, 3}},
+						MinimumApprovals:  1,
+						Timeout:           100,
+					},
+					Timestamp: 100,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantFailed:InvalidMinimumApprovals",
+			args: args{
+				tx: &model.Transaction{
+					Escrow: &model.Escrow{
+						ApproverAddress:   []byte{1, 2, 3},
+						ApproverAddresses: [][]byte{{1, 2, 3}, {4, 5, 6}},
+						MinimumApprovals:  3,
+						Timeout:           100,
+					},
+					Timestamp: 100,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantSuccess:MultiApprover",
+			args: args{
+				tx: &model.Transaction{
+					Escrow: &model.Escrow{
+						ApproverAddress:   []byte{1, 2, 3},
+						ApproverAddresses: [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}},
+						MinimumApprovals:  2,
+						Timeout:           100,
+					},
+					Timestamp: 100,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -117,3 +190,94 @@ func TestPrepareEscrowObjectForAction(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEscrowSettlement(t *testing.T) {
+	var approvers = [][]byte{{1}, {2}, {3}}
+	tests := []struct {
+		name         string
+		escrow       *model.Escrow
+		wantApproval model.EscrowApproval
+		wantSettled  bool
+	}{
+		{
+			name: "wantNotSettled:NoVote",
+			escrow: &model.Escrow{
+				ApproverAddress:   approvers[0],
+				ApproverAddresses: approvers,
+				MinimumApprovals:  2,
+			},
+		},
+		{
+			name: "wantNotSettled:ApprovalStillReachable",
+			escrow: &model.Escrow{
+				ApproverAddress:   approvers[0],
+				ApproverAddresses: approvers,
+				MinimumApprovals:  2,
+				Approvals:         [][]byte{approvers[0]},
+				Rejections:        [][]byte{approvers[1]},
+			},
+		},
+		{
+			name: "wantSettled:Approved",
+			escrow: &model.Escrow{
+				ApproverAddress:   approvers[0],
+				ApproverAddresses: approvers,
+				MinimumApprovals:  2,
+				Approvals:         [][]byte{approvers[0], approvers[2]},
+				Rejections:        [][]byte{approvers[1]},
+			},
+			wantApproval: model.EscrowApproval_Approve,
+			wantSettled:  true,
+		},
+		{
+			name: "wantSettled:Rejected",
+			escrow: &model.Escrow{
+				ApproverAddress:   approvers[0],
+				ApproverAddresses: approvers,
+				MinimumApprovals:  2,
+				Rejections:        [][]byte{approvers[1], approvers[2]},
+			},
+			wantApproval: model.EscrowApproval_Reject,
+			wantSettled:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			approval, settled := GetEscrowSettlement(tt.escrow)
+			if settled != tt.wantSettled || (settled && approval != tt.wantApproval) {
+				t.Errorf("GetEscrowSettlement() = %v, %v, want %v, %v", approval, settled, tt.wantApproval, tt.wantSettled)
+			}
+		})
+	}
+}
+
+func TestGetEscrowCommissionRecipients(t *testing.T) {
+	tests := []struct {
+		name   string
+		escrow *model.Escrow
+		want   [][]byte
+	}{
+		{
+			name:   "wantSuccess:SingleApprover",
+			escrow: &model.Escrow{ApproverAddress: []byte{1}},
+			want:   [][]byte{{1}},
+		},
+		{
+			name: "wantSuccess:Voters",
+			escrow: &model.Escrow{
+				ApproverAddress:   []byte{1},
+				ApproverAddresses: [][]byte{{1}, {2}, {3}},
+				Approvals:         [][]byte{{3}},
+				Rejections:        [][]byte{{2}},
+			},
+			want: [][]byte{{3}, {2}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetEscrowCommissionRecipients(tt.escrow); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetEscrowCommissionRecipients() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package util
+
+import (
+	"math"
+	"time"
+)
+
+// GetLiquidPaymentVestedAmount return the part of a liquid payment amount streamed to the recipient at timestamp,
+// the whole amount once completeMinutes have passed since appliedTime
+func GetLiquidPaymentVestedAmount(amount int64, completeMinutes uint64, appliedTime, timestamp int64) int64 {
+	var (
+		durationPassed = time.Unix(timestamp, 0).Sub(time.Unix(appliedTime, 0)).Minutes()
+		durationRate   = durationPassed / float64(completeMinutes)
+	)
+	if durationPassed <= 0 {
+		return 0
+	}
+	if durationRate > 1 {
+		return amount
+	}
+	return int64(math.Ceil(durationRate * float64(amount)))
+}
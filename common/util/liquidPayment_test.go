@@ -0,0 +1,52 @@
+package util
+
+import (
+	"testing"
+)
+
+func TestGetLiquidPaymentVestedAmount(t *testing.T) {
+	type args struct {
+		amount          int64
+		completeMinutes uint64
+		appliedTime     int64
+		timestamp       int64
+	}
+	tests := []struct {
+		name string
+		args args
+		want int64
+	}{
+		{
+			name: "wantZero:TimestampBeforeAppliedTime",
+			args: args{amount: 1000, completeMinutes: 10, appliedTime: 1000, timestamp: 900},
+			want: 0,
+		},
+		{
+			name: "wantZero:NoTimePassed",
+			args: args{amount: 1000, completeMinutes: 10, appliedTime: 1000, timestamp: 1000},
+			want: 0,
+		},
+		{
+			name: "wantPartial:HalfTimePassed",
+			args: args{amount: 1000, completeMinutes: 10, appliedTime: 1000, timestamp: 1300},
+			want: 500,
+		},
+		{
+			name: "wantPartial:RoundedUp",
+			args: args{amount: 10, completeMinutes: 100, appliedTime: 1000, timestamp: 1060},
+			want: 1,
+		},
+		{
+			name: "wantWholeAmount:CompleteMinutesPassed",
+			args: args{amount: 1000, completeMinutes: 10, appliedTime: 1000, timestamp: 2000},
+			want: 1000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetLiquidPaymentVestedAmount(tt.args.amount, tt.args.completeMinutes, tt.args.appliedTime, tt.args.timestamp); got != tt.want {
+				t.Errorf("GetLiquidPaymentVestedAmount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -112,6 +112,7 @@ func loadFromFile(path, name, extension, resourcePath string) error {
 	viper.SetDefault("antiSpamFilter", false)
 	viper.SetDefault("antiSpamP2PRequestLimit", constant.P2PRequestHardLimit)
 	viper.SetDefault("antiSpamCPULimitPercentage", constant.FeedbackLimitCPUPercentage)
+	viper.SetDefault("detachedMultisigBroadcast", false)
 
 	viper.SetEnvPrefix("zoobc") // will be uppercased automatically
 	viper.AutomaticEnv()        // value will be read each time it is accessed
@@ -169,6 +170,8 @@ func readConfigurations(cfg *model.Config) {
 	cfg.AntiSpamFilter = viper.GetBool("antiSpamFilter")
 	cfg.AntiSpamP2PRequestLimit = viper.GetInt("antiSpamP2PRequestLimit")
 	cfg.AntiSpamCPULimitPercentage = viper.GetInt("antiSpamCPULimitPercentage")
+	cfg.DetachedMultisigBroadcast = viper.GetBool("detachedMultisigBroadcast")
+	cfg.DetachedMultisigFeePayerSeed = viper.GetString("detachedMultisigFeePayerSeed")
 }
 
 func SaveConfig(cfg *model.Config, filePath string) error {
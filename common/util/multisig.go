@@ -0,0 +1,120 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package util
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+// IsWeightedMultisig return true when the participants of the multisig info carry their own weight
+func IsWeightedMultisig(multisigInfo *model.MultiSignatureInfo) bool {
+	return len(multisigInfo.GetWeights()) > 0
+}
+
+// GetMultisigParticipantWeight return the weight of the participant at index, every participant weights 1 in a non weighted multisig
+func GetMultisigParticipantWeight(multisigInfo *model.MultiSignatureInfo, index int) uint32 {
+	if !IsWeightedMultisig(multisigInfo) {
+		return 1
+	}
+	if index >= len(multisigInfo.GetWeights()) {
+		return 0
+	}
+	return multisigInfo.GetWeights()[index]
+}
+
+// GetMultisigParticipantStoredWeight return the weight stored for the participant at index, participants of a non weighted
+// multisig are stored without weight so the multisig is read back as non weighted
+func GetMultisigParticipantStoredWeight(multisigInfo *model.MultiSignatureInfo, index int) uint32 {
+	if !IsWeightedMultisig(multisigInfo) {
+		return constant.MultiSigParticipantNotWeighted
+	}
+	return GetMultisigParticipantWeight(multisigInfo, index)
+}
+
+// GetMultisigSignaturesWeight return the summed weight of the participants having a signature, signatures are keyed by hex address
+func GetMultisigSignaturesWeight(multisigInfo *model.MultiSignatureInfo, signatures map[string][]byte) uint64 {
+	var weight uint64
+	for i, address := range multisigInfo.GetAddresses() {
+		if signatures[hex.EncodeToString(address)] != nil {
+			weight += uint64(GetMultisigParticipantWeight(multisigInfo, i))
+		}
+	}
+	return weight
+}
+
+// SortMultisigParticipants sort the participant addresses, keeping the weights aligned with their address
+func SortMultisigParticipants(multisigInfo *model.MultiSignatureInfo) {
+	if len(multisigInfo.GetWeights()) != len(multisigInfo.GetAddresses()) {
+		SortByteArrays(multisigInfo.Addresses)
+		return
+	}
+	var (
+		addresses = multisigInfo.GetAddresses()
+		weights   = multisigInfo.GetWeights()
+		indexes   = make([]int, len(addresses))
+	)
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return bytes.Compare(addresses[indexes[i]], addresses[indexes[j]]) < 0
+	})
+	multisigInfo.Addresses = make([][]byte, len(addresses))
+	multisigInfo.Weights = make([]uint32, len(weights))
+	for i, index := range indexes {
+		multisigInfo.Addresses[i] = addresses[index]
+		multisigInfo.Weights[i] = weights[index]
+	}
+}
@@ -0,0 +1,196 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package util
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+
+	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/model"
+)
+
+var (
+	mockMultisigParticipant1 = []byte{0, 0, 0, 0, 1}
+	mockMultisigParticipant2 = []byte{0, 0, 0, 0, 2}
+	mockMultisigParticipant3 = []byte{0, 0, 0, 0, 3}
+)
+
+func TestGetMultisigSignaturesWeight(t *testing.T) {
+	type args struct {
+		multisigInfo *model.MultiSignatureInfo
+		signatures   map[string][]byte
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint64
+	}{
+		{
+			name: "NotWeighted",
+			args: args{
+				multisigInfo: &model.MultiSignatureInfo{
+					Addresses: [][]byte{mockMultisigParticipant1, mockMultisigParticipant2, mockMultisigParticipant3},
+				},
+				signatures: map[string][]byte{
+					hex.EncodeToString(mockMultisigParticipant1): {1},
+					hex.EncodeToString(mockMultisigParticipant3): {3},
+				},
+			},
+			want: 2,
+		},
+		{
+			name: "Weighted",
+			args: args{
+				multisigInfo: &model.MultiSignatureInfo{
+					Addresses: [][]byte{mockMultisigParticipant1, mockMultisigParticipant2, mockMultisigParticipant3},
+					Weights:   []uint32{3, 1, 1},
+				},
+				signatures: map[string][]byte{
+					hex.EncodeToString(mockMultisigParticipant1): {1},
+					hex.EncodeToString(mockMultisigParticipant3): {3},
+				},
+			},
+			want: 4,
+		},
+		{
+			name: "SignatureOfNonParticipant",
+			args: args{
+				multisigInfo: &model.MultiSignatureInfo{
+					Addresses: [][]byte{mockMultisigParticipant1, mockMultisigParticipant2},
+					Weights:   []uint32{3, 1},
+				},
+				signatures: map[string][]byte{
+					hex.EncodeToString(mockMultisigParticipant2): {2},
+					hex.EncodeToString(mockMultisigParticipant3): {3},
+				},
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetMultisigSignaturesWeight(tt.args.multisigInfo, tt.args.signatures); got != tt.want {
+				t.Errorf("GetMultisigSignaturesWeight() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetMultisigParticipantStoredWeight(t *testing.T) {
+	tests := []struct {
+		name         string
+		multisigInfo *model.MultiSignatureInfo
+		want         []uint32
+	}{
+		{
+			name: "NotWeighted",
+			multisigInfo: &model.MultiSignatureInfo{
+				Addresses: [][]byte{mockMultisigParticipant1, mockMultisigParticipant2},
+			},
+			want: []uint32{constant.MultiSigParticipantNotWeighted, constant.MultiSigParticipantNotWeighted},
+		},
+		{
+			name: "Weighted",
+			multisigInfo: &model.MultiSignatureInfo{
+				Addresses: [][]byte{mockMultisigParticipant1, mockMultisigParticipant2},
+				Weights:   []uint32{3, 1},
+			},
+			want: []uint32{3, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, want := range tt.want {
+				if got := GetMultisigParticipantStoredWeight(tt.multisigInfo, i); got != want {
+					t.Errorf("GetMultisigParticipantStoredWeight() index %d = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSortMultisigParticipants(t *testing.T) {
+	tests := []struct {
+		name         string
+		multisigInfo *model.MultiSignatureInfo
+		want         *model.MultiSignatureInfo
+	}{
+		{
+			name: "NotWeighted",
+			multisigInfo: &model.MultiSignatureInfo{
+				Addresses: [][]byte{mockMultisigParticipant3, mockMultisigParticipant1, mockMultisigParticipant2},
+			},
+			want: &model.MultiSignatureInfo{
+				Addresses: [][]byte{mockMultisigParticipant1, mockMultisigParticipant2, mockMultisigParticipant3},
+			},
+		},
+		{
+			name: "Weighted",
+			multisigInfo: &model.MultiSignatureInfo{
+				Addresses: [][]byte{mockMultisigParticipant3, mockMultisigParticipant1, mockMultisigParticipant2},
+				Weights:   []uint32{1, 3, 2},
+			},
+			want: &model.MultiSignatureInfo{
+				Addresses: [][]byte{mockMultisigParticipant1, mockMultisigParticipant2, mockMultisigParticipant3},
+				Weights:   []uint32{3, 2, 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SortMultisigParticipants(tt.multisigInfo)
+			if !reflect.DeepEqual(tt.multisigInfo, tt.want) {
+				t.Errorf("SortMultisigParticipants() = %v, want %v", tt.multisigInfo, tt.want)
+			}
+		})
+	}
+}
@@ -275,9 +275,10 @@ func (fp *ForkingProcessor) ProcessFork(forkBlocks []*model.Block, commonBlock *
 
 func (fp *ForkingProcessor) ProcessLater(txs []*model.Transaction) error {
 	var (
-		err                         error
+		err, rejectErr              error
 		txBytes                     []byte
 		txType                      transaction.TypeAction
+		replaced, txReplaced        *storage.MempoolCacheObject
 		isDbTransactionHighPriority = true
 	)
 	err = fp.QueryExecutor.BeginTx(isDbTransactionHighPriority, monitoring.ProcessMempoolLaterOwnerProcess)
@@ -304,21 +305,24 @@ func (fp *ForkingProcessor) ProcessLater(txs []*model.Transaction) error {
 			continue
 		}
 
-		err = fp.TransactionCorService.ApplyUnconfirmedTransaction(txType)
-		if err != nil {
-			fp.Logger.Warnf("ProcessLater:ApplyUnconfirmedTransaction - tx.Height: %d - txID: %d - %s", tx.GetHeight(), tx.GetID(), err.Error())
-			continue
+		// a rejected transaction leaves the db transaction, and the transaction it replaces, as they were
+		txReplaced, rejectErr, err = fp.MempoolService.ApplyMempoolTransaction(tx, txType, txBytes)
+		if txReplaced != nil {
+			replaced = txReplaced
 		}
-		err = fp.MempoolService.AddMempoolTransaction(tx, txBytes)
 		if err != nil {
-			// undo spendable balance when add mempool fail
-			errUndo := fp.TransactionCorService.UndoApplyUnconfirmedTransaction(txType)
-			if errUndo != nil {
-				fp.Logger.Warnf("ProcessLater:UndoApplyUnconfirmedTransaction - tx.Height: %d - txID: %d - %s", tx.GetHeight(), tx.GetID(), errUndo.Error())
-				// rollback DB when fail undo spendable balance
-				return fp.QueryExecutor.RollbackTx(isDbTransactionHighPriority)
+			fp.Logger.Warnf("ProcessLater:ApplyMempoolTransaction - tx.Height: %d - txID: %d - %s", tx.GetHeight(), tx.GetID(), err.Error())
+			// rollback DB when fail undo spendable balance, the mempool cache is reloaded if a transaction was replaced meanwhile
+			err = fp.QueryExecutor.RollbackTx(isDbTransactionHighPriority)
+			if replaced != nil {
+				if errInit := fp.MempoolService.InitMempoolTransaction(); errInit != nil {
+					fp.Logger.Warnf("ProcessLater:InitMempoolTransaction - %s", errInit.Error())
+				}
 			}
-			fp.Logger.Warnf("ProcessLater:AddMempoolFail - tx.Height: %d - txID: %d - %s", tx.GetHeight(), tx.GetID(), err.Error())
+			return err
+		}
+		if rejectErr != nil {
+			fp.Logger.Warnf("ProcessLater:ApplyMempoolTransaction - tx.Height: %d - txID: %d - %s", tx.GetHeight(), tx.GetID(), rejectErr.Error())
 			continue
 		}
 	}
@@ -335,6 +339,7 @@ func (fp *ForkingProcessor) restoreMempoolsBackup() error {
 	var (
 		err                         error
 		mempools                    map[int64][]byte
+		replaced                    *storage.MempoolCacheObject
 		isDbTransactionHighPriority = true
 	)
 
@@ -379,26 +384,30 @@ func (fp *ForkingProcessor) restoreMempoolsBackup() error {
 				return
 			}
 
-			err = fp.TransactionCorService.ApplyUnconfirmedTransaction(txType)
-			if err != nil {
-				fp.Logger.Warnf("restoreMempoolsBackup:ApplyUnconfirmedTransaction: %v", err)
+			// a rejected transaction leaves the db transaction, and the transaction it replaces, as they were
+			txReplaced, rejectErr, errApply := fp.MempoolService.ApplyMempoolTransaction(tx, txType, mempools[mempoolID])
+			if txReplaced != nil {
+				replaced = txReplaced
+			}
+			if errApply != nil {
+				*errUndo = errApply
+				fp.Logger.Warnf("restoreMempoolsBackup:ApplyMempoolTransaction %v", errApply)
 				return
 			}
-			err = fp.MempoolService.AddMempoolTransaction(tx, mempools[mempoolID])
-			if err != nil {
-				// undo spendable balance when add mempool fail
-				*errUndo = fp.TransactionCorService.UndoApplyUnconfirmedTransaction(txType)
-				if *errUndo != nil {
-					fp.Logger.Warnf("restoreMempoolsBackup:UndoApplyUnconfirmedTransaction %v", err)
-					return
-				}
-				fp.Logger.Warnf("error when AddMempoolTransaction: %v", err)
+			if rejectErr != nil {
+				fp.Logger.Warnf("error when ApplyMempoolTransaction: %v", rejectErr)
 				return
 			}
 		}(id, &err)
-		// rollback DB when undo spendable balance fail
+		// rollback DB when undo spendable balance fail, the mempool cache is reloaded if a transaction was replaced meanwhile
 		if err != nil {
-			return fp.QueryExecutor.RollbackTx(isDbTransactionHighPriority)
+			err = fp.QueryExecutor.RollbackTx(isDbTransactionHighPriority)
+			if replaced != nil {
+				if errInit := fp.MempoolService.InitMempoolTransaction(); errInit != nil {
+					fp.Logger.Warnf("restoreMempoolsBackup:InitMempoolTransaction %v", errInit)
+				}
+			}
+			return err
 		}
 
 	}
@@ -366,12 +366,8 @@ func (bs *BlockService) ValidateBlock(block, previousLastBlock *model.Block) err
 		return err
 	}
 
-	if !bs.Signature.VerifyNodeSignature(
-		blockByte,
-		block.BlockSignature,
-		block.BlocksmithPublicKey,
-	) {
-		return blocker.NewBlocker(blocker.BlockErr, "InvalidSignature")
+	if err := bs.validateBlockSignatures(block, blockByte); err != nil {
+		return err
 	}
 	// Verify previous block hash
 	previousBlockHash, err := commonUtils.GetBlockHash(previousLastBlock, bs.Chaintype)
@@ -388,6 +384,39 @@ func (bs *BlockService) ValidateBlock(block, previousLastBlock *model.Block) err
 	return nil
 }
 
+// validateBlockSignatures verify the block signature, then batch verify the signatures of the transactions, the failing one
+// is located by verifying them one by one only when a batch fails. The recipient signatures of the published receipts are
+// batch verified with the receipts by the PublishedReceiptService
+func (bs *BlockService) validateBlockSignatures(block *model.Block, blockByte []byte) error {
+	if !bs.Signature.VerifyNodeSignature(
+		blockByte,
+		block.BlockSignature,
+		block.BlocksmithPublicKey,
+	) {
+		return blocker.NewBlocker(blocker.BlockErr, "InvalidSignature")
+	}
+	var (
+		transactions     = block.GetTransactions()
+		txPayloads       = make([][]byte, len(transactions))
+		txSignatures     = make([][]byte, len(transactions))
+		accountAddresses = make([][]byte, len(transactions))
+	)
+	for i, tx := range transactions {
+		unsignedTransactionBytes, err := bs.TransactionUtil.GetTransactionBytes(tx, false)
+		if err != nil {
+			return err
+		}
+		txBytesHash := sha3.Sum256(unsignedTransactionBytes)
+		txPayloads[i] = txBytesHash[:]
+		txSignatures[i] = tx.GetSignature()
+		accountAddresses[i] = tx.GetSenderAccountAddress()
+	}
+	if err := bs.Signature.VerifySignatures(txPayloads, txSignatures, accountAddresses); err != nil {
+		return blocker.NewBlocker(blocker.BlockErr, fmt.Sprintf("InvalidTransactionSignature - %s", err.Error()))
+	}
+	return nil
+}
+
 // validateBlockAtHeight Check if the same block height is already in the database compare cummulative difficulty.
 // and return error if current block's cumulative difficulty is lower than the one in db
 func (bs *BlockService) validateBlockHeight(block *model.Block) error {
@@ -427,7 +456,10 @@ func (bs *BlockService) ProcessPushBlock(previousBlock,
 	block *model.Block,
 	broadcast, persist bool,
 	round int64) (nodeAdmissionTimestamp *model.NodeAdmissionTimestamp, transactionIDs []int64, err error) {
-	var mempoolMap storage.MempoolMap
+	var (
+		mempoolMap         storage.MempoolMap
+		replacedMempoolTxs []*model.Transaction
+	)
 
 	err = bs.NodeRegistrationService.BeginCacheTransaction()
 	if err != nil {
@@ -463,6 +495,24 @@ func (bs *BlockService) ProcessPushBlock(previousBlock,
 		return nil, nil, err
 	}
 
+	/*
+		Releasing time locked send zbc funds that reach their unlock height or timestamp
+	*/
+	err = bs.TransactionCoreService.ReleaseUnlockedFunds(block)
+	if err != nil {
+		err = blocker.NewBlocker(blocker.BlockErr, fmt.Sprintf("ReleaseUnlockedFundsErr - %s", err.Error()))
+		return nil, nil, err
+	}
+
+	/*
+		Deactivating account datasets that reach their expiry height or timestamp
+	*/
+	err = bs.TransactionCoreService.ExpireAccountDatasets(block)
+	if err != nil {
+		err = blocker.NewBlocker(blocker.BlockErr, fmt.Sprintf("ExpireAccountDatasetsErr - %s", err.Error()))
+		return nil, nil, err
+	}
+
 	transactionIDs = make([]int64, len(block.GetTransactions()))
 	mempoolMap, err = bs.MempoolService.GetMempoolTransactions()
 	if err != nil {
@@ -488,8 +538,26 @@ func (bs *BlockService) ProcessPushBlock(previousBlock,
 				return nil, nil, err
 			}
 		}
+		// the pending transaction it replaces can't be confirmed anymore, undo its unconfirmed effects and remove it too
+		if replaced, ok := mempoolMap[tx.GetReplacedTransactionID()]; ok && tx.GetReplacedTransactionID() != 0 &&
+			bytes.Equal(replaced.Tx.GetSenderAccountAddress(), tx.GetSenderAccountAddress()) {
+			replacedTx := replaced.Tx
+			replacedTxType, err := bs.ActionTypeSwitcher.GetTransactionType(&replacedTx)
+			if err != nil {
+				return nil, nil, err
+			}
+			err = bs.TransactionCoreService.UndoApplyUnconfirmedTransaction(replacedTxType)
+			if err != nil {
+				return nil, nil, err
+			}
+			replacedMempoolTxs = append(replacedMempoolTxs, &replacedTx)
+		}
 
 		if block.Height > 0 {
+			err = bs.TransactionCoreService.ValidateTransactionReplacement(tx, true)
+			if err != nil {
+				return nil, nil, err
+			}
 			err = bs.TransactionCoreService.ValidateTransaction(txType, true)
 			if err != nil {
 				return nil, nil, err
@@ -726,7 +794,8 @@ func (bs *BlockService) ProcessPushBlock(previousBlock,
 		}
 	}
 	if !coreUtil.IsGenesis(previousBlock.GetID(), block) {
-		if errRemoveMempool := bs.MempoolService.RemoveMempoolTransactions(block.GetTransactions()); errRemoveMempool != nil {
+		removedMempoolTxs := append(replacedMempoolTxs, block.GetTransactions()...)
+		if errRemoveMempool := bs.MempoolService.RemoveMempoolTransactions(removedMempoolTxs); errRemoveMempool != nil {
 			err = fmt.Errorf(fmt.Sprintf("RemoveMempoolTransactionsRollbackErr: %v", err))
 			// reset mempool cache
 			initMempoolErr := bs.MempoolService.InitMempoolTransaction()
@@ -1683,6 +1752,7 @@ func (bs *BlockService) PopOffToBlock(commonBlock *model.Block) ([]*model.Block,
 
 		return poppedBlocks[i].GetHeight() < poppedBlocks[j].GetHeight()
 	})
+	bs.Observer.Notify(observer.BlockRollback, commonBlock, bs.Chaintype)
 
 	return poppedBlocks, nil
 }
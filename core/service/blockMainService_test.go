@@ -367,12 +367,30 @@ func (*mockSignature) VerifyNodeSignature(
 	return true
 }
 
+func (*mockSignature) VerifyNodeSignatures(
+	payloads, signatures, nodePublicKeys [][]byte,
+) error {
+	return nil
+}
+
+func (*mockSignature) VerifySignatures(
+	payloads, signatures, accountAddresses [][]byte,
+) error {
+	return nil
+}
+
 func (*mockSignatureFail) VerifyNodeSignature(
 	payload, signature, nodePublicKey []byte,
 ) bool {
 	return false
 }
 
+func (*mockSignatureFail) VerifyNodeSignatures(
+	payloads, signatures, nodePublicKeys [][]byte,
+) error {
+	return errors.New("mockedError")
+}
+
 // mockQueryExecutorScanFail
 func (*mockQueryExecutorScanFail) ExecuteSelect(qe string, tx bool, args ...interface{}) (*sql.Rows, error) {
 	db, mock, _ := sqlmock.New()
@@ -686,8 +704,18 @@ func (*mockQueryExecutorSuccess) ExecuteSelect(qe string, tx bool, args ...inter
 		"FROM pending_transaction WHERE block_height = ? AND status = ? AND latest = ?":
 		mock.ExpectQuery(regexp.QuoteMeta(qe)).WillReturnRows(mock.NewRows(query.NewPendingTransactionQuery().Fields))
 	case "SELECT id, sender_address, recipient_address, amount, applied_time, complete_minutes, status," +
-		" block_height, latest FROM liquid_payment_transaction WHERE applied_time+(complete_minutes*60) <= ? AND status = ? AND latest = ?":
+		" block_height, latest, claimed_amount FROM liquid_payment_transaction WHERE applied_time+(complete_minutes*60) <= ? AND status = ?" +
+		" AND latest = ?":
 		mock.ExpectQuery(regexp.QuoteMeta(qe)).WillReturnRows(mock.NewRows(query.NewLiquidPaymentTransactionQuery().Fields))
+	case "SELECT id, sender_address, recipient_address, amount, unlock_height, unlock_timestamp, status, block_height, latest " +
+		"FROM locked_fund WHERE status = ? AND latest = ? AND " +
+		"((unlock_height > 0 AND unlock_height <= ?) OR (unlock_timestamp > 0 AND unlock_timestamp <= ?)) ORDER BY id":
+		mock.ExpectQuery(regexp.QuoteMeta(qe)).WillReturnRows(mock.NewRows(query.NewLockedFundQuery().Fields))
+	case "SELECT setter_account_address, recipient_account_address, property, value, is_active, latest, height, expiry_height, " +
+		"expiry_timestamp FROM account_dataset WHERE is_active = ? AND latest = ? AND " +
+		"((expiry_height > 0 AND expiry_height <= ?) OR (expiry_timestamp > 0 AND expiry_timestamp <= ?)) " +
+		"ORDER BY setter_account_address, recipient_account_address, property":
+		mock.ExpectQuery(regexp.QuoteMeta(qe)).WillReturnRows(mock.NewRows(query.NewAccountDatasetsQuery().Fields))
 	// which is escrow expiration process
 	default:
 		mockRows := sqlmock.NewRows(query.NewEscrowTransactionQuery().Fields)
@@ -703,6 +731,11 @@ func (*mockQueryExecutorSuccess) ExecuteSelect(qe string, tx bool, args ...inter
 			uint32(0),
 			true,
 			"",
+			nil,
+			uint32(0),
+			nil,
+			nil,
+			int64(0),
 		)
 		mock.ExpectQuery(regexp.QuoteMeta(qe)).WillReturnRows(mockRows)
 	}
@@ -1940,6 +1973,8 @@ func TestBlockService_AddGenesis(t *testing.T) {
 					query.NewTransactionQuery(&chaintype.MainChain{}),
 					query.NewEscrowTransactionQuery(),
 					query.NewLiquidPaymentTransactionQuery(),
+					query.NewLockedFundQuery(),
+					query.NewAccountDatasetsQuery(),
 				),
 				PublishedReceiptService:   &mockAddGenesisPublishedReceiptServiceSuccess{},
 				BlockStateStorage:         storage.NewBlockStateStorage(),
@@ -3870,7 +3905,7 @@ func TestBlockService_PopOffToBlock(t *testing.T) {
 				AccountBalanceQuery:     nil,
 				ParticipationScoreQuery: nil,
 				NodeRegistrationQuery:   nil,
-				Observer:                nil,
+				Observer:                observer.NewObserver(),
 				BlockPoolService:        &mockBlockPoolServicePopOffToBlockSuccess{},
 				TransactionCoreService:  &mockPopOffToBlockTransactionCoreService{},
 				Logger:                  log.New(),
@@ -3938,7 +3973,7 @@ func TestBlockService_PopOffToBlock(t *testing.T) {
 				AccountBalanceQuery:     nil,
 				ParticipationScoreQuery: nil,
 				NodeRegistrationQuery:   nil,
-				Observer:                nil,
+				Observer:                observer.NewObserver(),
 				BlockPoolService:        &mockBlockPoolServicePopOffToBlockSuccess{},
 				TransactionCoreService:  &mockPopOffToBlockTransactionCoreService{},
 				Logger:                  log.New(),
@@ -1200,6 +1200,7 @@ func (bs *BlockSpineService) PopOffToBlock(commonBlock *model.Block) ([]*model.B
 	sort.Slice(poppedBlocks, func(i, j int) bool {
 		return poppedBlocks[i].GetHeight() < poppedBlocks[j].GetHeight()
 	})
+	bs.Observer.Notify(observer.BlockRollback, commonBlock, bs.Chaintype)
 
 	return poppedBlocks, nil
 }
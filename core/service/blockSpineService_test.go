@@ -3143,7 +3143,7 @@ func TestBlockSpineService_PopOffToBlock(t *testing.T) {
 				ActionTypeSwitcher:        nil,
 				AccountBalanceQuery:       nil,
 				ParticipationScoreQuery:   nil,
-				Observer:                  nil,
+				Observer:                  observer.NewObserver(),
 				Logger:                    log.New(),
 				SpinePublicKeyService:     &mockBlockSpinePublicKeyService{},
 				SpineBlockManifestService: &mockSpineBlockManifestServiceSuccesGetManifestFromHeight{},
@@ -50,7 +50,9 @@
 package service
 
 import (
+	"bytes"
 	"database/sql"
+	"fmt"
 	"sort"
 	"strconv"
 	"time"
@@ -83,6 +85,12 @@ type (
 		GetTotalMempoolTransactions() (int, error)
 		SelectTransactionsFromMempool(blockTimestamp int64, blockHeight uint32) ([]*model.Transaction, error)
 		ValidateMempoolTransaction(mpTx *model.Transaction) error
+		GetReplacedMempoolTransaction(tx *model.Transaction) (*storage.MempoolCacheObject, error)
+		ApplyMempoolTransaction(
+			tx *model.Transaction,
+			txType transaction.TypeAction,
+			txBytes []byte,
+		) (replaced *storage.MempoolCacheObject, rejectErr, err error)
 		ReceivedTransaction(
 			senderPublicKey, receivedTxBytes []byte,
 			lastBlockCacheFormat *storage.BlockCacheObject,
@@ -98,6 +106,7 @@ type (
 		DeleteExpiredMempoolTransactions() error
 		GetMempoolTransactionsWantToBackup(height uint32) ([]*model.Transaction, error)
 		BackupMempools(commonBlock *model.Block) error
+		GetFeePerByteToBeSelected(numberOfBlocks int) (int64, error)
 	}
 
 	// MempoolService contains all transactions in mempool plus a mux to manage locks in concurrency
@@ -329,6 +338,18 @@ func (mps *MempoolService) ValidateMempoolTransaction(mpTx *model.Transaction) e
 		return blocker.NewBlocker(blocker.ValidationErr, errVal.Error())
 	}
 
+	// a replacement must pay a higher fee than the pending transaction it replaces
+	_, err = mps.GetReplacedMempoolTransaction(mpTx)
+	if err != nil {
+		return err
+	}
+	err = mps.TransactionCoreService.ValidateTransactionReplacement(mpTx, false)
+	if err != nil {
+		return err
+	}
+
+	// a replacement is validated here against the spendable balance still held by the transaction it replaces, then again
+	// by ApplyMempoolTransaction once it is freed
 	err = mps.TransactionCoreService.ValidateTransaction(txType, false)
 	if err != nil {
 		return blocker.NewBlocker(blocker.ValidationErr, err.Error())
@@ -336,6 +357,118 @@ func (mps *MempoolService) ValidateMempoolTransaction(mpTx *model.Transaction) e
 	return nil
 }
 
+// GetReplacedMempoolTransaction return the mempool transaction tx replaces, the one its ReplacedTransactionID names, nil if
+// none is in mempool. The replaced transaction must have the same sender and a lower fee per byte than tx
+func (mps *MempoolService) GetReplacedMempoolTransaction(tx *model.Transaction) (*storage.MempoolCacheObject, error) {
+	var (
+		replaced storage.MempoolCacheObject
+		txBytes  []byte
+		err      error
+	)
+	if tx.GetReplacedTransactionID() == 0 {
+		return nil, nil
+	}
+	err = mps.MempoolCacheStorage.GetItem(tx.GetReplacedTransactionID(), &replaced)
+	if err != nil {
+		return nil, blocker.NewBlocker(blocker.ValidationErr, "FailReadingMempoolCache")
+	}
+	if replaced.Tx.GetID() != tx.GetReplacedTransactionID() {
+		return nil, nil
+	}
+	if !bytes.Equal(replaced.Tx.GetSenderAccountAddress(), tx.GetSenderAccountAddress()) {
+		return nil, blocker.NewBlocker(blocker.ValidationErr, "ReplacedTransactionSenderMismatch")
+	}
+	txBytes, err = mps.TransactionUtil.GetTransactionBytes(tx, true)
+	if err != nil {
+		return nil, err
+	}
+	if commonUtils.FeePerByteTransaction(tx.GetFee(), txBytes) <= replaced.FeePerByte {
+		return nil, blocker.NewBlocker(
+			blocker.ValidationErr,
+			fmt.Sprintf("ReplacementFeeTooLow: transaction %d must be replaced by a higher fee per byte", replaced.Tx.GetID()),
+		)
+	}
+	return &replaced, nil
+}
+
+/*
+ApplyMempoolTransaction validate and apply unconfirmed tx then add it to mempool, in place of the pending transaction it
+replaces if any. Must be called inside db transaction scope:
+	- the spendable balance held by the replaced transaction is freed before tx is validated against the db transaction state
+	- the replaced transaction is evicted last, so it is kept with its unconfirmed effects when tx is rejected
+	- rejectErr rejects tx and leaves the db transaction as it was, err must abort the db transaction
+	- the mempool cache must be reloaded when aborting with a replaced transaction, tx is already cached then
+*/
+func (mps *MempoolService) ApplyMempoolTransaction(
+	tx *model.Transaction,
+	txType transaction.TypeAction,
+	txBytes []byte,
+) (replaced *storage.MempoolCacheObject, rejectErr, err error) {
+	var replacedTxType transaction.TypeAction
+	replaced, rejectErr = mps.GetReplacedMempoolTransaction(tx)
+	if rejectErr != nil {
+		return nil, rejectErr, nil
+	}
+	if replaced != nil {
+		replacedTxType, err = mps.ActionTypeSwitcher.GetTransactionType(&replaced.Tx)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = mps.TransactionCoreService.UndoApplyUnconfirmedTransaction(replacedTxType)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	rejectErr, err = mps.applyMempoolTransaction(tx, txType, txBytes)
+	if err != nil {
+		return replaced, nil, err
+	}
+	if rejectErr != nil {
+		if replaced != nil {
+			// the replaced transaction stays in mempool, it holds its spendable balance again
+			err = mps.TransactionCoreService.ApplyUnconfirmedTransaction(replacedTxType)
+			if err != nil {
+				return replaced, nil, err
+			}
+		}
+		return nil, rejectErr, nil
+	}
+	if replaced == nil {
+		return nil, nil, nil
+	}
+	err = mps.RemoveMempoolTransactions([]*model.Transaction{&replaced.Tx})
+	if err != nil {
+		return replaced, nil, err
+	}
+	mps.Logger.Infof("mempool transaction %d replaced by transaction %d", replaced.Tx.GetID(), tx.GetID())
+	return replaced, nil, nil
+}
+
+// applyMempoolTransaction validate, apply unconfirmed and add tx to mempool, its unconfirmed effects are undone on rejection
+func (mps *MempoolService) applyMempoolTransaction(
+	tx *model.Transaction,
+	txType transaction.TypeAction,
+	txBytes []byte,
+) (rejectErr, err error) {
+	rejectErr = mps.TransactionCoreService.ValidateTransaction(txType, true)
+	if rejectErr != nil {
+		return blocker.NewBlocker(blocker.ValidationErr, rejectErr.Error()), nil
+	}
+	rejectErr = mps.TransactionCoreService.ApplyUnconfirmedTransaction(txType)
+	if rejectErr != nil {
+		return rejectErr, nil
+	}
+	rejectErr = mps.AddMempoolTransaction(tx, txBytes)
+	if rejectErr != nil {
+		err = mps.TransactionCoreService.UndoApplyUnconfirmedTransaction(txType)
+		if err != nil {
+			return nil, err
+		}
+		return rejectErr, nil
+	}
+	return nil, nil
+}
+
 // SelectTransactionsFromMempool Select transactions from mempool to be included in the block and return an ordered list.
 // 1. get all mempool transaction from db (all mpTx already processed but still not included in a block)
 // 2. merge with mempool, until it's full (payload <= MAX_PAYLOAD_LENGTH and max 255 mpTx) and do formal validation
@@ -352,6 +485,13 @@ func (mps *MempoolService) SelectTransactionsFromMempool(blockTimestamp int64, b
 	var payloadLength int
 	selectedTransactions := make([]*model.Transaction, 0)
 	selectedMempoolTxs := make([]storage.MempoolCacheObject, 0)
+	// a pending transaction replaced by another one of the same sender in mempool conflicts with it, it is not selected
+	replacedTxSenders := make(map[int64][]byte)
+	for _, memObj := range mempoolTransactions {
+		if memObj.Tx.GetReplacedTransactionID() != 0 {
+			replacedTxSenders[memObj.Tx.GetReplacedTransactionID()] = memObj.Tx.GetSenderAccountAddress()
+		}
+	}
 	for _, memObj := range mempoolTransactions {
 		if len(selectedTransactions) >= constant.MaxNumberOfTransactionsInBlock {
 			break
@@ -367,6 +507,9 @@ func (mps *MempoolService) SelectTransactionsFromMempool(blockTimestamp int64, b
 		if blockTimestamp == 0 || blockTimestamp > txExpirationTime {
 			continue
 		}
+		if sender, ok := replacedTxSenders[memObj.Tx.GetID()]; ok && bytes.Equal(sender, memObj.Tx.GetSenderAccountAddress()) {
+			continue
+		}
 
 		memObj.Tx.Height = blockHeight
 
@@ -378,6 +521,9 @@ func (mps *MempoolService) SelectTransactionsFromMempool(blockTimestamp int64, b
 		if err := mps.TransactionUtil.ValidateTransaction(&memObj.Tx, txType, true); err != nil {
 			continue
 		}
+		if err := mps.TransactionCoreService.ValidateTransactionReplacement(&memObj.Tx, false); err != nil {
+			continue
+		}
 
 		toRemove, err := txType.SkipMempoolTransaction(
 			selectedTransactions,
@@ -413,6 +559,7 @@ func (mps *MempoolService) ReceivedTransaction(
 		err                  error
 		receivedTx           *model.Transaction
 		receipt              *model.Receipt
+		replaced             *storage.MempoolCacheObject
 		isHighPriorityDbLock = false
 	)
 	receipt, receivedTx, err = mps.ProcessReceivedTransaction(
@@ -438,22 +585,25 @@ func (mps *MempoolService) ReceivedTransaction(
 		if err != nil {
 			return err
 		}
-
-		if err := mps.TransactionCoreService.ApplyUnconfirmedTransaction(txType); err != nil {
-			return err
-		}
-
-		// Store the transaction to Mempool
-		if err := mps.AddMempoolTransaction(receivedTx, receivedTxBytes); err != nil {
+		var rejectErr error
+		// Store the transaction to Mempool, in place of the one it replaces if any
+		replaced, rejectErr, err = mps.ApplyMempoolTransaction(receivedTx, txType, receivedTxBytes)
+		if err != nil {
 			return err
 		}
-		return nil
+		return rejectErr
 	}()
 	if err != nil {
 		rollbackErr := mps.QueryExecutor.RollbackTx(isHighPriorityDbLock)
 		if rollbackErr != nil {
 			mps.Logger.Warnf("rollbackErr:ReceivedTransaction - %v", rollbackErr)
 		}
+		// the replacement was cached, and the replaced transaction evicted, by the rolled back db transaction
+		if replaced != nil {
+			if initMempoolErr := mps.InitMempoolTransaction(); initMempoolErr != nil {
+				mps.Logger.Warnf("ReceivedTransaction - InitMempoolErr - %v", initMempoolErr)
+			}
+		}
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	err = mps.QueryExecutor.CommitTx(isHighPriorityDbLock)
@@ -565,6 +715,35 @@ func sortFeePerByteThenTimestampThenID(memTxs []storage.MempoolCacheObject) {
 	})
 }
 
+// GetFeePerByteToBeSelected return the lowest fee per byte a new transaction needs to be selected within the given number of
+// blocks, outbidding the transactions waiting in mempool in the order they are selected. 0 when they don't fill those blocks
+func (mps *MempoolService) GetFeePerByteToBeSelected(numberOfBlocks int) (int64, error) {
+	var (
+		cachedTxs        = make(storage.MempoolMap)
+		memTxs           = make([]storage.MempoolCacheObject, 0)
+		maxTransactions  = numberOfBlocks * constant.MaxNumberOfTransactionsInBlock
+		maxPayloadLength = numberOfBlocks * constant.MaxPayloadLengthInBlock
+		payloadLength    int
+		err              error
+	)
+	err = mps.MempoolCacheStorage.GetAllItems(cachedTxs)
+	if err != nil {
+		return 0, err
+	}
+	for _, memObj := range cachedTxs {
+		memTxs = append(memTxs, memObj)
+	}
+	sortFeePerByteThenTimestampThenID(memTxs)
+	for i, memObj := range memTxs {
+		payloadLength += int(memObj.TransactionByteSize)
+		// the blocks are full once this transaction is selected, a new one has to take its place
+		if i+1 >= maxTransactions || payloadLength >= maxPayloadLength {
+			return memObj.FeePerByte + 1, nil
+		}
+	}
+	return 0, nil
+}
+
 // DeleteExpiredMempoolTransactions handle fresh clean the mempool
 // which is the mempool transaction has been hit expiration time
 func (mps *MempoolService) DeleteExpiredMempoolTransactions() error {
@@ -420,10 +420,11 @@ func TestMempoolService_SelectTransactionsFromMempool(t *testing.T) {
 					AccountDatasetQuery: &mockAccountDatasetQueryMempoolCoreService{wantNoRow: true},
 					QueryExecutor:       &mockQueryExecutoMempoolCoreService{},
 				},
-				Chaintype:           tt.fields.Chaintype,
-				ActionTypeSwitcher:  tt.fields.ActionTypeSwitcher,
-				MempoolCacheStorage: &mockCacheStorageSelectMempoolSuccess{},
-				AccountBalanceQuery: tt.fields.AccountBalanceQuery,
+				Chaintype:              tt.fields.Chaintype,
+				ActionTypeSwitcher:     tt.fields.ActionTypeSwitcher,
+				MempoolCacheStorage:    &mockCacheStorageSelectMempoolSuccess{},
+				AccountBalanceQuery:    tt.fields.AccountBalanceQuery,
+				TransactionCoreService: &mockTransactionCoreServiceReplace{},
 			}
 			got, err := mps.SelectTransactionsFromMempool(tt.args.blockTimestamp, 0)
 			if (err != nil) != tt.wantErr {
@@ -593,6 +594,8 @@ func TestMempoolService_DeleteExpiredMempoolTransactions(t *testing.T) {
 					query.NewTransactionQuery(&chaintype.MainChain{}),
 					nil,
 					nil,
+					nil,
+					nil,
 				),
 			},
 			wantErr: false,
@@ -1146,6 +1149,7 @@ func (*mockExecutorValidateMempoolTransactionSuccess) ExecuteSelectRow(qStr stri
 			make([]byte, 64),
 			false,
 			"",
+			0,
 		),
 	)
 	return db.QueryRow(qStr), nil
@@ -1272,6 +1276,8 @@ func TestMempoolService_ValidateMempoolTransaction(t *testing.T) {
 					query.NewTransactionQuery(&chaintype.MainChain{}),
 					nil,
 					nil,
+					nil,
+					nil,
 				),
 				MempoolCacheStorage: &mockCacheStorageAlwaysSuccess{},
 			},
@@ -1552,3 +1558,271 @@ func TestMempoolService_GetMempoolTransactions(t *testing.T) {
 		})
 	}
 }
+
+func TestMempoolService_GetFeePerByteToBeSelected(t *testing.T) {
+	var (
+		halfBlockPayload = uint32(constant.MaxPayloadLengthInBlock / 2)
+		fullMempool      = storage.NewMempoolStorage()
+		smallMempool     = storage.NewMempoolStorage()
+	)
+	for i, feePerByte := range []int64{30, 10, 20} {
+		_ = fullMempool.SetItem(int64(i), storage.MempoolCacheObject{
+			Tx:                  model.Transaction{ID: int64(i)},
+			FeePerByte:          feePerByte,
+			TransactionByteSize: halfBlockPayload,
+		})
+	}
+	_ = smallMempool.SetItem(int64(1), storage.MempoolCacheObject{
+		Tx:                  model.Transaction{ID: 1},
+		FeePerByte:          30,
+		TransactionByteSize: uint32(constant.MinTransactionSizeInBlock),
+	})
+	tests := []struct {
+		name           string
+		mempoolStorage storage.CacheStorageInterface
+		numberOfBlocks int
+		want           int64
+	}{
+		{
+			name:           "wantSuccess:EmptyMempool",
+			mempoolStorage: storage.NewMempoolStorage(),
+			numberOfBlocks: 1,
+			want:           0,
+		},
+		{
+			name:           "wantSuccess:BlockNotFilled",
+			mempoolStorage: smallMempool,
+			numberOfBlocks: 1,
+			want:           0,
+		},
+		{
+			name:           "wantSuccess:OutbidLastSelected",
+			mempoolStorage: fullMempool,
+			numberOfBlocks: 1,
+			want:           21,
+		},
+		{
+			name:           "wantSuccess:SeveralBlocksNotFilled",
+			mempoolStorage: fullMempool,
+			numberOfBlocks: 2,
+			want:           0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mps := &MempoolService{
+				MempoolCacheStorage: tt.mempoolStorage,
+			}
+			got, err := mps.GetFeePerByteToBeSelected(tt.numberOfBlocks)
+			if err != nil {
+				t.Errorf("GetFeePerByteToBeSelected() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetFeePerByteToBeSelected() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type (
+	mockMempoolCacheStorageReplace struct {
+		storage.MempoolCacheStorage
+		feePerByte int64
+	}
+	mockTypeSwitcherReplace struct {
+		transaction.TypeSwitcher
+	}
+	mockTransactionCoreServiceReplace struct {
+		TransactionCoreService
+		validateErr error
+	}
+	mockQueryExecutorReplace struct {
+		query.Executor
+	}
+)
+
+func (m *mockMempoolCacheStorageReplace) GetItem(key, item interface{}) error {
+	if key.(int64) != mockTransaction.ID {
+		return nil
+	}
+	*item.(*storage.MempoolCacheObject) = storage.MempoolCacheObject{
+		Tx:         *mockTransaction,
+		FeePerByte: m.feePerByte,
+	}
+	return nil
+}
+
+func (*mockMempoolCacheStorageReplace) SetItem(interface{}, interface{}) error {
+	return nil
+}
+
+func (*mockMempoolCacheStorageReplace) RemoveItem(interface{}) error {
+	return nil
+}
+
+func (*mockMempoolCacheStorageReplace) GetTotalItems() int {
+	return 1
+}
+
+func (*mockTypeSwitcherReplace) GetTransactionType(*model.Transaction) (transaction.TypeAction, error) {
+	return &transaction.TXEmpty{}, nil
+}
+
+func (*mockTransactionCoreServiceReplace) UndoApplyUnconfirmedTransaction(transaction.TypeAction) error {
+	return nil
+}
+
+func (*mockTransactionCoreServiceReplace) ApplyUnconfirmedTransaction(transaction.TypeAction) error {
+	return nil
+}
+
+func (m *mockTransactionCoreServiceReplace) ValidateTransaction(transaction.TypeAction, bool) error {
+	return m.validateErr
+}
+
+func (*mockTransactionCoreServiceReplace) ValidateTransactionReplacement(*model.Transaction, bool) error {
+	return nil
+}
+
+func (*mockQueryExecutorReplace) ExecuteTransaction(string, ...interface{}) error {
+	return nil
+}
+
+func TestMempoolService_GetReplacedMempoolTransaction(t *testing.T) {
+	replacement := *mockTransaction
+	replacement.ID = 2
+	replacement.Fee = 1000
+	replacement.ReplacedTransactionID = mockTransaction.ID
+	notNamed := replacement
+	notNamed.ReplacedTransactionID = 0
+	notInMempool := replacement
+	notInMempool.ReplacedTransactionID = 3
+	otherSender := replacement
+	otherSender.SenderAccountAddress = []byte{0, 0, 0, 0, 1, 2, 3}
+	tests := []struct {
+		name       string
+		tx         *model.Transaction
+		feePerByte int64
+		want       *storage.MempoolCacheObject
+		wantErr    bool
+	}{
+		{
+			name:    "GetReplacedMempoolTransaction:NothingReplaced",
+			tx:      &notNamed,
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "GetReplacedMempoolTransaction:NotInMempool",
+			tx:      &notInMempool,
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:       "GetReplacedMempoolTransaction:SenderMismatch",
+			tx:         &otherSender,
+			feePerByte: 1,
+			want:       nil,
+			wantErr:    true,
+		},
+		{
+			name:       "GetReplacedMempoolTransaction:FeeTooLow",
+			tx:         &replacement,
+			feePerByte: 1000 * constant.OneFeePerByteTransaction,
+			want:       nil,
+			wantErr:    true,
+		},
+		{
+			name:       "GetReplacedMempoolTransaction:Success",
+			tx:         &replacement,
+			feePerByte: 1,
+			want: &storage.MempoolCacheObject{
+				Tx:         *mockTransaction,
+				FeePerByte: 1,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mps := &MempoolService{
+				TransactionUtil:     &transaction.Util{},
+				MempoolCacheStorage: &mockMempoolCacheStorageReplace{feePerByte: tt.feePerByte},
+			}
+			got, err := mps.GetReplacedMempoolTransaction(tt.tx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetReplacedMempoolTransaction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetReplacedMempoolTransaction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMempoolService_ApplyMempoolTransaction(t *testing.T) {
+	replacement := *mockTransaction
+	replacement.ID = 2
+	replacement.Fee = 1000
+	replacement.ReplacedTransactionID = mockTransaction.ID
+	tests := []struct {
+		name                   string
+		transactionCoreService TransactionCoreServiceInterface
+		feePerByte             int64
+		want                   *storage.MempoolCacheObject
+		wantRejectErr          bool
+		wantErr                bool
+	}{
+		{
+			name:                   "ApplyMempoolTransaction:FeeTooLow",
+			transactionCoreService: &mockTransactionCoreServiceReplace{},
+			feePerByte:             1000 * constant.OneFeePerByteTransaction,
+			want:                   nil,
+			wantRejectErr:          true,
+		},
+		{
+			name:                   "ApplyMempoolTransaction:ValidateFailKeepReplaced",
+			transactionCoreService: &mockTransactionCoreServiceReplace{validateErr: errors.New("mockedError")},
+			feePerByte:             1,
+			want:                   nil,
+			wantRejectErr:          true,
+		},
+		{
+			name:                   "ApplyMempoolTransaction:Success",
+			transactionCoreService: &mockTransactionCoreServiceReplace{},
+			feePerByte:             1,
+			want: &storage.MempoolCacheObject{
+				Tx:         *mockTransaction,
+				FeePerByte: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mps := &MempoolService{
+				TransactionUtil:        &transaction.Util{},
+				QueryExecutor:          &mockQueryExecutorReplace{},
+				MempoolQuery:           mockMempoolQuery,
+				ActionTypeSwitcher:     &mockTypeSwitcherReplace{},
+				Logger:                 log.New(),
+				TransactionCoreService: tt.transactionCoreService,
+				MempoolCacheStorage:    &mockMempoolCacheStorageReplace{feePerByte: tt.feePerByte},
+				BlocksStorage:          &mockAddMempoolTransactionBlockStateStorageSuccess{},
+			}
+			got, rejectErr, err := mps.ApplyMempoolTransaction(&replacement, &transaction.TXEmpty{}, []byte{1, 2, 3})
+			if (rejectErr != nil) != tt.wantRejectErr {
+				t.Errorf("ApplyMempoolTransaction() rejectErr = %v, wantRejectErr %v", rejectErr, tt.wantRejectErr)
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ApplyMempoolTransaction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ApplyMempoolTransaction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
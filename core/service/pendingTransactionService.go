@@ -51,8 +51,11 @@ package service
 
 import (
 	"database/sql"
+	"encoding/hex"
 
 	"github.com/sirupsen/logrus"
+	"github.com/zoobc/zoobc-core/common/blocker"
+	"github.com/zoobc/zoobc-core/common/crypto"
 	"github.com/zoobc/zoobc-core/common/model"
 	"github.com/zoobc/zoobc-core/common/monitoring"
 	"github.com/zoobc/zoobc-core/common/query"
@@ -62,15 +65,28 @@ import (
 type (
 	PendingTransactionServiceInterface interface {
 		ExpiringPendingTransactions(blockHeight uint32, useTX bool) error
+		AddDetachedSignature(
+			txHash, accountAddress, signature []byte,
+			blockHeight uint32,
+		) (*model.MultiSignatureTransactionBody, error)
+		SetDetachedPendingTransactionExecuted(body *model.MultiSignatureTransactionBody, blockHeight uint32) error
 	}
 
 	PendingTransactionService struct {
-		Log                     *logrus.Logger
-		QueryExecutor           query.ExecutorInterface
-		TypeActionSwitcher      transaction.TypeActionSwitcher
-		TransactionUtil         transaction.UtilInterface
-		TransactionQuery        query.TransactionQueryInterface
-		PendingTransactionQuery query.PendingTransactionQueryInterface
+		Log                      *logrus.Logger
+		QueryExecutor            query.ExecutorInterface
+		TypeActionSwitcher       transaction.TypeActionSwitcher
+		TransactionUtil          transaction.UtilInterface
+		TransactionQuery         query.TransactionQueryInterface
+		PendingTransactionQuery  query.PendingTransactionQueryInterface
+		PendingTransactionHelper transaction.PendingTransactionHelperInterface
+		SignatureInfoHelper      transaction.SignatureInfoHelperInterface
+		// detached signatures are collected off chain, in tables apart from the pending transactions of the chain state
+		DetachedPendingTransactionHelper transaction.PendingTransactionHelperInterface
+		DetachedSignatureInfoHelper      transaction.SignatureInfoHelperInterface
+		MultisignatureInfoHelper         transaction.MultisignatureInfoHelperInterface
+		MultisigUtil                     transaction.MultisigTransactionUtilInterface
+		Signature                        crypto.SignatureInterface
 	}
 )
 
@@ -81,14 +97,28 @@ func NewPendingTransactionService(
 	transactionUtil transaction.UtilInterface,
 	transactionQuery query.TransactionQueryInterface,
 	pendingTransactionQuery query.PendingTransactionQueryInterface,
+	pendingTransactionHelper transaction.PendingTransactionHelperInterface,
+	signatureInfoHelper transaction.SignatureInfoHelperInterface,
+	detachedPendingTransactionHelper transaction.PendingTransactionHelperInterface,
+	detachedSignatureInfoHelper transaction.SignatureInfoHelperInterface,
+	multisignatureInfoHelper transaction.MultisignatureInfoHelperInterface,
+	multisigUtil transaction.MultisigTransactionUtilInterface,
+	signature crypto.SignatureInterface,
 ) PendingTransactionServiceInterface {
 	return &PendingTransactionService{
-		Log:                     log,
-		QueryExecutor:           queryExecutor,
-		TypeActionSwitcher:      typeActionSwitcher,
-		TransactionUtil:         transactionUtil,
-		TransactionQuery:        transactionQuery,
-		PendingTransactionQuery: pendingTransactionQuery,
+		Log:                              log,
+		QueryExecutor:                    queryExecutor,
+		TypeActionSwitcher:               typeActionSwitcher,
+		TransactionUtil:                  transactionUtil,
+		TransactionQuery:                 transactionQuery,
+		PendingTransactionQuery:          pendingTransactionQuery,
+		PendingTransactionHelper:         pendingTransactionHelper,
+		SignatureInfoHelper:              signatureInfoHelper,
+		DetachedPendingTransactionHelper: detachedPendingTransactionHelper,
+		DetachedSignatureInfoHelper:      detachedSignatureInfoHelper,
+		MultisignatureInfoHelper:         multisignatureInfoHelper,
+		MultisigUtil:                     multisigUtil,
+		Signature:                        signature,
 	}
 }
 
@@ -178,3 +208,149 @@ func (tg *PendingTransactionService) ExpiringPendingTransactions(blockHeight uin
 	}
 	return nil
 }
+
+// AddDetachedSignature store the signature of a multisig participant collected off chain for a transaction pending on chain.
+// It returns the multisig transaction body assembling every signature collected so far, on chain and off chain, the caller
+// broadcast it once the weight of the signatures reach the minimum signatures of the multisig info
+func (tg *PendingTransactionService) AddDetachedSignature(
+	txHash, accountAddress, signature []byte,
+	blockHeight uint32,
+) (*model.MultiSignatureTransactionBody, error) {
+	var (
+		pendingTx                   model.PendingTransaction
+		detachedPendingTx           model.PendingTransaction
+		multisigInfo                model.MultiSignatureInfo
+		pendingSigs                 []*model.PendingSignature
+		detachedPendingSigs         []*model.PendingSignature
+		isNewDetachedPendingTx      bool
+		participants                = make(map[string]bool)
+		signatures                  = make(map[string][]byte)
+		err                         error
+		isDbTransactionHighPriority = false
+	)
+	if len(txHash) == 0 {
+		return nil, blocker.NewBlocker(blocker.ValidationErr, "TransactionHashRequired")
+	}
+	err = tg.DetachedPendingTransactionHelper.GetPendingTransactionByHash(&detachedPendingTx, txHash, nil, blockHeight, false)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return nil, err
+		}
+		isNewDetachedPendingTx = true
+	} else if detachedPendingTx.GetStatus() != model.PendingTransactionStatus_PendingTransactionPending {
+		return nil, blocker.NewBlocker(blocker.ValidationErr, "PendingTransactionAlreadyBroadcast")
+	}
+	err = tg.PendingTransactionHelper.GetPendingTransactionByHash(
+		&pendingTx,
+		txHash,
+		[]model.PendingTransactionStatus{model.PendingTransactionStatus_PendingTransactionPending},
+		blockHeight,
+		false,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, blocker.NewBlocker(blocker.ValidationErr, "NoPendingTransactionWithProvidedTransactionHash")
+		}
+		return nil, err
+	}
+
+	err = tg.MultisignatureInfoHelper.GetMultisigInfoByAddress(&multisigInfo, pendingTx.GetSenderAddress(), blockHeight)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, blocker.NewBlocker(blocker.ValidationErr, "MultisignatureInfoHasNotBeenPosted")
+		}
+		return nil, err
+	}
+	for _, address := range multisigInfo.GetAddresses() {
+		participants[hex.EncodeToString(address)] = true
+	}
+	err = tg.MultisigUtil.ValidateSignatureInfo(tg.Signature, &model.SignatureInfo{
+		TransactionHash: txHash,
+		Signatures: map[string][]byte{
+			hex.EncodeToString(accountAddress): signature,
+		},
+	}, participants)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tg.QueryExecutor.BeginTx(isDbTransactionHighPriority, monitoring.AddDetachedSignatureOwnerProcess)
+	if err != nil {
+		return nil, err
+	}
+	if isNewDetachedPendingTx {
+		pendingTx.BlockHeight = blockHeight
+		pendingTx.Latest = true
+		err = tg.DetachedPendingTransactionHelper.InsertPendingTransaction(&pendingTx)
+	}
+	if err == nil {
+		err = tg.DetachedSignatureInfoHelper.InsertPendingSignature(&model.PendingSignature{
+			TransactionHash: txHash,
+			AccountAddress:  accountAddress,
+			Signature:       signature,
+			BlockHeight:     blockHeight,
+			Latest:          true,
+		})
+	}
+	if err != nil {
+		if rollbackErr := tg.QueryExecutor.RollbackTx(isDbTransactionHighPriority); rollbackErr != nil {
+			tg.Log.Errorf("Rollback fail: %s", rollbackErr.Error())
+		}
+		return nil, err
+	}
+	err = tg.QueryExecutor.CommitTx(isDbTransactionHighPriority)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingSigs, err = tg.SignatureInfoHelper.GetPendingSignatureByTransactionHash(txHash, blockHeight)
+	if err != nil {
+		return nil, err
+	}
+	detachedPendingSigs, err = tg.DetachedSignatureInfoHelper.GetPendingSignatureByTransactionHash(txHash, blockHeight)
+	if err != nil {
+		return nil, err
+	}
+	for _, pendingSig := range append(pendingSigs, detachedPendingSigs...) {
+		signatures[hex.EncodeToString(pendingSig.GetAccountAddress())] = pendingSig.GetSignature()
+	}
+	return &model.MultiSignatureTransactionBody{
+		MultiSignatureInfo:       &multisigInfo,
+		UnsignedTransactionBytes: pendingTx.GetTransactionBytes(),
+		SignatureInfo: &model.SignatureInfo{
+			TransactionHash: txHash,
+			Signatures:      signatures,
+		},
+	}, nil
+}
+
+// SetDetachedPendingTransactionExecuted mark a detached pending transaction as executed once its multisig transaction has been
+// broadcast, the signatures submitted afterward are rejected
+func (tg *PendingTransactionService) SetDetachedPendingTransactionExecuted(
+	body *model.MultiSignatureTransactionBody,
+	blockHeight uint32,
+) error {
+	var (
+		err                         error
+		isDbTransactionHighPriority = false
+	)
+	err = tg.QueryExecutor.BeginTx(isDbTransactionHighPriority, monitoring.AddDetachedSignatureOwnerProcess)
+	if err != nil {
+		return err
+	}
+	err = tg.DetachedPendingTransactionHelper.InsertPendingTransaction(&model.PendingTransaction{
+		SenderAddress:    body.GetMultiSignatureInfo().GetMultisigAddress(),
+		TransactionHash:  body.GetSignatureInfo().GetTransactionHash(),
+		TransactionBytes: body.GetUnsignedTransactionBytes(),
+		Status:           model.PendingTransactionStatus_PendingTransactionExecuted,
+		BlockHeight:      blockHeight,
+		Latest:           true,
+	})
+	if err != nil {
+		if rollbackErr := tg.QueryExecutor.RollbackTx(isDbTransactionHighPriority); rollbackErr != nil {
+			tg.Log.Errorf("Rollback fail: %s", rollbackErr.Error())
+		}
+		return err
+	}
+	return tg.QueryExecutor.CommitTx(isDbTransactionHighPriority)
+}
@@ -0,0 +1,309 @@
+// ZooBC Copyright (C) 2020 Quasisoft Limited - Hong Kong
+// This file is part of ZooBC <https://github.com/zoobc/zoobc-core>
+//
+// ZooBC is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// ZooBC is distributed in the hope that it will be useful, but
+// WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+// See the GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with ZooBC.  If not, see <http://www.gnu.org/licenses/>.
+//
+// Additional Permission Under GNU GPL Version 3 section 7.
+// As the special exception permitted under Section 7b, c and e,
+// in respect with the Author’s copyright, please refer to this section:
+//
+// 1. You are free to convey this Program according to GNU GPL Version 3,
+//     as long as you respect and comply with the Author’s copyright by
+//     showing in its user interface an Appropriate Notice that the derivate
+//     program and its source code are “powered by ZooBC”.
+//     This is an acknowledgement for the copyright holder, ZooBC,
+//     as the implementation of appreciation of the exclusive right of the
+//     creator and to avoid any circumvention on the rights under trademark
+//     law for use of some trade names, trademarks, or service marks.
+//
+// 2. Complying to the GNU GPL Version 3, you may distribute
+//     the program without any permission from the Author.
+//     However a prior notification to the authors will be appreciated.
+//
+// ZooBC is architected by Roberto Capodieci & Barton Johnston
+//             contact us at roberto.capodieci[at]blockchainzoo.com
+//             and barton.johnston[at]blockchainzoo.com
+//
+// Core developers that contributed to the current implementation of the
+// software are:
+//             Ahmad Ali Abdilah ahmad.abdilah[at]blockchainzoo.com
+//             Allan Bintoro allan.bintoro[at]blockchainzoo.com
+//             Andy Herman
+//             Gede Sukra
+//             Ketut Ariasa
+//             Nawi Kartini nawi.kartini[at]blockchainzoo.com
+//             Stefano Galassi stefano.galassi[at]blockchainzoo.com
+//
+// IMPORTANT: The above copyright notice and this permission notice
+// shall be included in all copies or substantial portions of the Software.
+package service
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/zoobc/zoobc-core/common/crypto"
+	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/query"
+	"github.com/zoobc/zoobc-core/common/transaction"
+	"golang.org/x/crypto/sha3"
+)
+
+type (
+	mockDetachedSignatureExecutor struct {
+		query.Executor
+	}
+	mockDetachedSignaturePendingTxHelperNotFound struct {
+		transaction.PendingTransactionHelper
+	}
+	mockDetachedSignaturePendingTxHelperFound struct {
+		transaction.PendingTransactionHelper
+	}
+	mockDetachedSignaturePendingTxHelperExecuted struct {
+		transaction.PendingTransactionHelper
+	}
+	mockDetachedSignatureSignatureInfoHelper struct {
+		transaction.SignatureInfoHelper
+	}
+	mockDetachedSignatureDetachedSignatureInfoHelper struct {
+		transaction.SignatureInfoHelper
+	}
+	mockDetachedSignatureMultisigInfoHelperNotFound struct {
+		transaction.MultisignatureInfoHelper
+	}
+	mockDetachedSignatureMultisigInfoHelperSuccess struct {
+		transaction.MultisignatureInfoHelper
+	}
+	mockDetachedSignatureMultisigUtilInvalid struct {
+		transaction.MultisigTransactionUtil
+	}
+	mockDetachedSignatureMultisigUtilValid struct {
+		transaction.MultisigTransactionUtil
+	}
+)
+
+var (
+	mockDetachedSignatureTxBytes  = []byte{1, 2, 3}
+	mockDetachedSignatureTxHash   = sha3.Sum256(mockDetachedSignatureTxBytes)
+	mockDetachedSignatureMultisig = []byte{0, 0, 0, 0, 1, 2, 3}
+	mockDetachedSignatureSigner1  = []byte{0, 0, 0, 0, 4, 5, 6}
+	mockDetachedSignatureSigner2  = []byte{0, 0, 0, 0, 7, 8, 9}
+	mockDetachedSignatureInfo     = model.MultiSignatureInfo{
+		MinimumSignatures: 2,
+		MultisigAddress:   mockDetachedSignatureMultisig,
+		Addresses:         [][]byte{mockDetachedSignatureSigner1, mockDetachedSignatureSigner2},
+	}
+)
+
+func (*mockDetachedSignatureExecutor) BeginTx(bool, int) error {
+	return nil
+}
+
+func (*mockDetachedSignatureExecutor) CommitTx(bool) error {
+	return nil
+}
+
+func (*mockDetachedSignatureExecutor) RollbackTx(bool) error {
+	return nil
+}
+
+func (*mockDetachedSignaturePendingTxHelperNotFound) GetPendingTransactionByHash(
+	*model.PendingTransaction, []byte, []model.PendingTransactionStatus, uint32, bool,
+) error {
+	return sql.ErrNoRows
+}
+
+func (*mockDetachedSignaturePendingTxHelperNotFound) InsertPendingTransaction(*model.PendingTransaction) error {
+	return nil
+}
+
+func (*mockDetachedSignaturePendingTxHelperFound) GetPendingTransactionByHash(
+	pendingTx *model.PendingTransaction, txHash []byte, _ []model.PendingTransactionStatus, _ uint32, _ bool,
+) error {
+	*pendingTx = model.PendingTransaction{
+		SenderAddress:    mockDetachedSignatureMultisig,
+		TransactionHash:  txHash,
+		TransactionBytes: mockDetachedSignatureTxBytes,
+		Status:           model.PendingTransactionStatus_PendingTransactionPending,
+	}
+	return nil
+}
+
+func (*mockDetachedSignaturePendingTxHelperExecuted) GetPendingTransactionByHash(
+	pendingTx *model.PendingTransaction, txHash []byte, _ []model.PendingTransactionStatus, _ uint32, _ bool,
+) error {
+	*pendingTx = model.PendingTransaction{
+		SenderAddress:    mockDetachedSignatureMultisig,
+		TransactionHash:  txHash,
+		TransactionBytes: mockDetachedSignatureTxBytes,
+		Status:           model.PendingTransactionStatus_PendingTransactionExecuted,
+	}
+	return nil
+}
+
+func (*mockDetachedSignatureSignatureInfoHelper) GetPendingSignatureByTransactionHash([]byte, uint32) ([]*model.PendingSignature, error) {
+	return []*model.PendingSignature{
+		{
+			TransactionHash: mockDetachedSignatureTxHash[:],
+			AccountAddress:  mockDetachedSignatureSigner2,
+			Signature:       []byte{2},
+		},
+	}, nil
+}
+
+func (*mockDetachedSignatureDetachedSignatureInfoHelper) InsertPendingSignature(*model.PendingSignature) error {
+	return nil
+}
+
+func (*mockDetachedSignatureDetachedSignatureInfoHelper) GetPendingSignatureByTransactionHash(
+	[]byte, uint32,
+) ([]*model.PendingSignature, error) {
+	return []*model.PendingSignature{
+		{
+			TransactionHash: mockDetachedSignatureTxHash[:],
+			AccountAddress:  mockDetachedSignatureSigner1,
+			Signature:       []byte{1},
+		},
+	}, nil
+}
+
+func (*mockDetachedSignatureMultisigInfoHelperNotFound) GetMultisigInfoByAddress(*model.MultiSignatureInfo, []byte, uint32) error {
+	return sql.ErrNoRows
+}
+
+func (*mockDetachedSignatureMultisigInfoHelperSuccess) GetMultisigInfoByAddress(
+	multisigInfo *model.MultiSignatureInfo, _ []byte, _ uint32,
+) error {
+	*multisigInfo = mockDetachedSignatureInfo
+	return nil
+}
+
+func (*mockDetachedSignatureMultisigUtilInvalid) ValidateSignatureInfo(
+	crypto.SignatureInterface, *model.SignatureInfo, map[string]bool,
+) error {
+	return errors.New("mockedError")
+}
+
+func (*mockDetachedSignatureMultisigUtilValid) ValidateSignatureInfo(
+	crypto.SignatureInterface, *model.SignatureInfo, map[string]bool,
+) error {
+	return nil
+}
+
+func TestPendingTransactionService_AddDetachedSignature(t *testing.T) {
+	type fields struct {
+		PendingTransactionHelper         transaction.PendingTransactionHelperInterface
+		DetachedPendingTransactionHelper transaction.PendingTransactionHelperInterface
+		MultisignatureInfoHelper         transaction.MultisignatureInfoHelperInterface
+		MultisigUtil                     transaction.MultisigTransactionUtilInterface
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		txHash  []byte
+		want    *model.MultiSignatureTransactionBody
+		wantErr bool
+	}{
+		{
+			name:    "AddDetachedSignature:NoTransactionHash",
+			wantErr: true,
+		},
+		{
+			name: "AddDetachedSignature:AlreadyBroadcast",
+			fields: fields{
+				DetachedPendingTransactionHelper: &mockDetachedSignaturePendingTxHelperExecuted{},
+			},
+			txHash:  mockDetachedSignatureTxHash[:],
+			wantErr: true,
+		},
+		{
+			name: "AddDetachedSignature:NotPendingOnChain",
+			fields: fields{
+				PendingTransactionHelper:         &mockDetachedSignaturePendingTxHelperNotFound{},
+				DetachedPendingTransactionHelper: &mockDetachedSignaturePendingTxHelperNotFound{},
+			},
+			txHash:  mockDetachedSignatureTxHash[:],
+			wantErr: true,
+		},
+		{
+			name: "AddDetachedSignature:MultisigInfoNotPosted",
+			fields: fields{
+				PendingTransactionHelper:         &mockDetachedSignaturePendingTxHelperFound{},
+				DetachedPendingTransactionHelper: &mockDetachedSignaturePendingTxHelperNotFound{},
+				MultisignatureInfoHelper:         &mockDetachedSignatureMultisigInfoHelperNotFound{},
+			},
+			txHash:  mockDetachedSignatureTxHash[:],
+			wantErr: true,
+		},
+		{
+			name: "AddDetachedSignature:InvalidSignature",
+			fields: fields{
+				PendingTransactionHelper:         &mockDetachedSignaturePendingTxHelperFound{},
+				DetachedPendingTransactionHelper: &mockDetachedSignaturePendingTxHelperNotFound{},
+				MultisignatureInfoHelper:         &mockDetachedSignatureMultisigInfoHelperSuccess{},
+				MultisigUtil:                     &mockDetachedSignatureMultisigUtilInvalid{},
+			},
+			txHash:  mockDetachedSignatureTxHash[:],
+			wantErr: true,
+		},
+		{
+			name: "AddDetachedSignature:Success",
+			fields: fields{
+				PendingTransactionHelper:         &mockDetachedSignaturePendingTxHelperFound{},
+				DetachedPendingTransactionHelper: &mockDetachedSignaturePendingTxHelperNotFound{},
+				MultisignatureInfoHelper:         &mockDetachedSignatureMultisigInfoHelperSuccess{},
+				MultisigUtil:                     &mockDetachedSignatureMultisigUtilValid{},
+			},
+			txHash: mockDetachedSignatureTxHash[:],
+			want: &model.MultiSignatureTransactionBody{
+				MultiSignatureInfo:       &mockDetachedSignatureInfo,
+				UnsignedTransactionBytes: mockDetachedSignatureTxBytes,
+				SignatureInfo: &model.SignatureInfo{
+					TransactionHash: mockDetachedSignatureTxHash[:],
+					Signatures: map[string][]byte{
+						hex.EncodeToString(mockDetachedSignatureSigner1): {1},
+						hex.EncodeToString(mockDetachedSignatureSigner2): {2},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tg := &PendingTransactionService{
+				Log:                              logrus.New(),
+				QueryExecutor:                    &mockDetachedSignatureExecutor{},
+				PendingTransactionHelper:         tt.fields.PendingTransactionHelper,
+				SignatureInfoHelper:              &mockDetachedSignatureSignatureInfoHelper{},
+				DetachedPendingTransactionHelper: tt.fields.DetachedPendingTransactionHelper,
+				DetachedSignatureInfoHelper:      &mockDetachedSignatureDetachedSignatureInfoHelper{},
+				MultisignatureInfoHelper:         tt.fields.MultisignatureInfoHelper,
+				MultisigUtil:                     tt.fields.MultisigUtil,
+				Signature:                        crypto.NewSignature(),
+			}
+			got, err := tg.AddDetachedSignature(tt.txHash, mockDetachedSignatureSigner1, []byte{1}, 10)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AddDetachedSignature() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AddDetachedSignature() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -146,15 +146,19 @@ func (ps *PublishedReceiptService) ProcessPublishedReceipts(
 		}
 	}
 
-	for index, rc := range publishedReceipts {
-		// validate sender and recipient of receipt
-		if validateReceipt {
-			// formally validate receipts
-			err = ps.ReceiptService.ValidateReceipt(rc.GetReceipt(), true)
-			if err != nil {
-				return 0, 0, err
-			}
+	// validate sender and recipient of receipts
+	if validateReceipt {
+		var receipts = make([]*model.Receipt, len(publishedReceipts))
+		for index, rc := range publishedReceipts {
+			receipts[index] = rc.GetReceipt()
 		}
+		// formally validate receipts
+		err = ps.ReceiptService.ValidateReceipts(receipts, true)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	for index, rc := range publishedReceipts {
 		// store in database
 		// assign index and height, index is the order of the receipt in the block,
 		// it's different with receiptIndex which is used to validate merkle root.
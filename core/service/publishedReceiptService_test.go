@@ -118,15 +118,15 @@ type (
 	// ProcessPublishedReceipts mocks
 )
 
-func (*mockProcessPublishedReceiptsReceiptServiceFail) ValidateReceipt(
-	_ *model.Receipt,
+func (*mockProcessPublishedReceiptsReceiptServiceFail) ValidateReceipts(
+	_ []*model.Receipt,
 	_ bool,
 ) error {
 	return errors.New("mockedError")
 }
 
-func (*mockProcessPublishedReceiptsReceiptServiceSuccess) ValidateReceipt(
-	_ *model.Receipt,
+func (*mockProcessPublishedReceiptsReceiptServiceSuccess) ValidateReceipts(
+	_ []*model.Receipt,
 	_ bool,
 ) error {
 	return nil
@@ -111,6 +111,11 @@ type (
 			receipt *model.Receipt,
 			validateRefBlock bool,
 		) error
+		// ValidateReceipts to validating the published receipts of a block, verifying their signatures in one batch
+		ValidateReceipts(
+			receipts []*model.Receipt,
+			validateRefBlock bool,
+		) error
 		GetPublishedReceiptsByHeight(blockHeight uint32) ([]*model.PublishedReceipt, error)
 		// GenerateReceiptWithReminder generating batch receipt and store to reminder also
 		GenerateReceiptWithReminder(
@@ -1110,6 +1115,36 @@ func (rs *ReceiptService) ValidateReceipt(
 	)
 }
 
+func (rs *ReceiptService) ValidateReceipts(
+	receipts []*model.Receipt,
+	validateRefBlock bool,
+) error {
+	err := rs.ReceiptUtil.ValidateReceiptSignatures(receipts, rs.Signature)
+	if err != nil {
+		return err
+	}
+	for _, receipt := range receipts {
+		scrambleNode, err := rs.ScrambleNodeService.GetScrambleNodesByHeight(receipt.ReferenceBlockHeight)
+		if err != nil {
+			return err
+		}
+		// nil signature, it has been verified by ValidateReceiptSignatures
+		err = rs.ReceiptUtil.ValidateReceiptHelper(
+			receipt,
+			validateRefBlock,
+			rs.QueryExecutor,
+			rs.BlockQuery,
+			rs.MainBlocksStorage,
+			nil,
+			scrambleNode,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetPublishedReceiptsByHeight that handling database connection to get published receipts by height
 func (rs *ReceiptService) GetPublishedReceiptsByHeight(blockHeight uint32) ([]*model.PublishedReceipt, error) {
 	var (
@@ -411,7 +411,8 @@ func (*mockQueryExecutorSuccessSelectUnlinked) ExecuteSelect(
 	switch qe {
 	case "SELECT id, block_id, block_height, sender_account_address, recipient_account_address, transaction_type, fee, timestamp, " +
 		"transaction_hash, transaction_body_length, transaction_body_bytes, signature, version, transaction_index, multisig_child, " +
-		"message FROM \"transaction\" WHERE block_id = ? AND multisig_child = false ORDER BY transaction_index ASC":
+		"message, replaced_transaction_id FROM \"transaction\" WHERE block_id = ? AND multisig_child = false ORDER BY " +
+		"transaction_index ASC":
 		mock.ExpectQuery(regexp.QuoteMeta(qe)).WillReturnRows(sqlmock.NewRows(
 			query.NewTransactionQuery(&chaintype.MainChain{}).Fields,
 		).AddRow(
@@ -431,6 +432,7 @@ func (*mockQueryExecutorSuccessSelectUnlinked) ExecuteSelect(
 			mockRecSrvTransaction.TransactionIndex,
 			mockRecSrvTransaction.MultisigChild,
 			mockRecSrvTransaction.Message,
+			mockRecSrvTransaction.ReplacedTransactionID,
 		))
 	case "SELECT sender_public_key, recipient_public_key, datum_type, datum_hash, reference_block_height, reference_block_hash, rmr, " +
 		"recipient_signature, rmr_batch, rmr_batch_index FROM node_receipt AS rc WHERE rc.rmr_batch = ? AND rc.datum_hash = ? AND rc." +
@@ -601,7 +603,8 @@ func (*mockQueryExecutorSuccessSelectLinked) ExecuteSelect(
 		))
 	case "SELECT id, block_id, block_height, sender_account_address, recipient_account_address, transaction_type, fee, timestamp, " +
 		"transaction_hash, transaction_body_length, transaction_body_bytes, signature, version, transaction_index, multisig_child, " +
-		"message FROM \"transaction\" WHERE block_id = ? AND multisig_child = false ORDER BY transaction_index ASC":
+		"message, replaced_transaction_id FROM \"transaction\" WHERE block_id = ? AND multisig_child = false ORDER BY " +
+		"transaction_index ASC":
 		mock.ExpectQuery(regexp.QuoteMeta(qe)).WillReturnRows(sqlmock.NewRows(
 			query.NewTransactionQuery(&chaintype.MainChain{}).Fields,
 		).AddRow(
@@ -621,6 +624,7 @@ func (*mockQueryExecutorSuccessSelectLinked) ExecuteSelect(
 			mockRecSrvTransaction.TransactionIndex,
 			mockRecSrvTransaction.MultisigChild,
 			mockRecSrvTransaction.Message,
+			mockRecSrvTransaction.ReplacedTransactionID,
 		))
 	case "SELECT sender_public_key, recipient_public_key, datum_type, datum_hash, reference_block_height, reference_block_hash, rmr," +
 		" recipient_signature, rmr_batch, rmr_batch_index FROM node_receipt AS rc WHERE rc.rmr = ? AND rc.datum_hash = ? AND rc." +
@@ -89,6 +89,10 @@ type (
 		FeeVoteRevealVoteQuery         query.FeeVoteRevealVoteQueryInterface
 		LiquidPaymentTransactionQuery  query.LiquidPaymentTransactionQueryInterface
 		NodeAdmissionTimestampQuery    query.NodeAdmissionTimestampQueryInterface
+		LockedFundQuery                query.LockedFundQueryInterface
+		HtlcQuery                      query.HtlcQueryInterface
+		MultiSignatureRotationQuery    query.MultiSignatureRotationQueryInterface
+		AccountDatasetSchemaQuery      query.AccountDatasetSchemaQueryInterface
 		SnapshotQueries                map[string]query.SnapshotQuery
 		BlocksmithSafeQuery            map[string]bool
 		DerivedQueries                 []query.DerivedQuery
@@ -119,6 +123,10 @@ func NewSnapshotMainBlockService(
 	feeVoteRevealVoteQuery query.FeeVoteRevealVoteQueryInterface,
 	liquidPaymentTransactionQuery query.LiquidPaymentTransactionQueryInterface,
 	nodeAdmissionTimestampQuery query.NodeAdmissionTimestampQueryInterface,
+	lockedFundQuery query.LockedFundQueryInterface,
+	htlcQuery query.HtlcQueryInterface,
+	multiSignatureRotationQuery query.MultiSignatureRotationQueryInterface,
+	accountDatasetSchemaQuery query.AccountDatasetSchemaQueryInterface,
 	blockQuery query.BlockQueryInterface,
 	snapshotQueries map[string]query.SnapshotQuery,
 	blocksmithSafeQueries map[string]bool,
@@ -151,6 +159,10 @@ func NewSnapshotMainBlockService(
 		FeeVoteRevealVoteQuery:         feeVoteRevealVoteQuery,
 		LiquidPaymentTransactionQuery:  liquidPaymentTransactionQuery,
 		NodeAdmissionTimestampQuery:    nodeAdmissionTimestampQuery,
+		LockedFundQuery:                lockedFundQuery,
+		HtlcQuery:                      htlcQuery,
+		MultiSignatureRotationQuery:    multiSignatureRotationQuery,
+		AccountDatasetSchemaQuery:      accountDatasetSchemaQuery,
 		BlockQuery:                     blockQuery,
 		SnapshotQueries:                snapshotQueries,
 		BlocksmithSafeQuery:            blocksmithSafeQueries,
@@ -222,10 +234,11 @@ func (ss *SnapshotMainBlockService) NewSnapshotFile(block *model.Block) (snapsho
 				multisigInfos, err = ss.MultisignatureInfoQuery.BuildModel([]*model.MultiSignatureInfo{}, rows)
 				for idx, multisigInfo := range multisigInfos {
 					err = func(idx int, multisigInfos []*model.MultiSignatureInfo) error {
+						// only the participants of the snapshot version, a rotation keeps the previous ones at a lower height
 						qry, args := ss.MultisignatureParticipantQuery.GetMultiSignatureParticipantsByMultisigAddressAndHeightRange(
 							multisigInfo.GetMultisigAddress(),
-							fromHeight,
-							snapshotPayloadHeight,
+							multisigInfo.GetBlockHeight(),
+							multisigInfo.GetBlockHeight(),
 						)
 						rows2, err := ss.QueryExecutor.ExecuteSelect(qry, false, args...)
 						if err != nil {
@@ -238,6 +251,9 @@ func (ss *SnapshotMainBlockService) NewSnapshotFile(block *model.Block) (snapsho
 						}
 						for _, participant := range participants {
 							multisigInfos[idx].Addresses = append(multisigInfos[idx].Addresses, participant.GetAccountAddress())
+							if participant.GetWeight() != constant.MultiSigParticipantNotWeighted {
+								multisigInfos[idx].Weights = append(multisigInfos[idx].Weights, participant.GetWeight())
+							}
 						}
 						return nil
 					}(idx, multisigInfos)
@@ -258,6 +274,14 @@ func (ss *SnapshotMainBlockService) NewSnapshotFile(block *model.Block) (snapsho
 				snapshotPayload.LiquidPayment, err = ss.LiquidPaymentTransactionQuery.BuildModels(rows)
 			case "nodeAdmissionTimestamp":
 				snapshotPayload.NodeAdmissionTimestamp, err = ss.NodeAdmissionTimestampQuery.BuildModel([]*model.NodeAdmissionTimestamp{}, rows)
+			case "lockedFund":
+				snapshotPayload.LockedFunds, err = ss.LockedFundQuery.BuildModels(rows)
+			case "htlc":
+				snapshotPayload.Htlcs, err = ss.HtlcQuery.BuildModels(rows)
+			case "multisignatureRotation":
+				snapshotPayload.MultiSignatureRotations, err = ss.MultiSignatureRotationQuery.BuildModels(rows)
+			case "accountDatasetSchema":
+				snapshotPayload.AccountDatasetSchemas, err = ss.AccountDatasetSchemaQuery.BuildModels(rows)
 			default:
 				err = blocker.NewBlocker(blocker.ParserErr, fmt.Sprintf("Invalid Snapshot Query Repository: %s", qryRepoName))
 			}
@@ -509,6 +533,38 @@ func (ss *SnapshotMainBlockService) InsertSnapshotPayloadToDB(payload *model.Sna
 				}
 				queries = append(queries, q...)
 			}
+		case "lockedFund":
+			if len(payload.GetLockedFunds()) > 0 {
+				q, err := snapshotQuery.ImportSnapshot(payload.GetLockedFunds())
+				if err != nil {
+					return err
+				}
+				queries = append(queries, q...)
+			}
+		case "htlc":
+			if len(payload.GetHtlcs()) > 0 {
+				q, err := snapshotQuery.ImportSnapshot(payload.GetHtlcs())
+				if err != nil {
+					return err
+				}
+				queries = append(queries, q...)
+			}
+		case "multisignatureRotation":
+			if len(payload.GetMultiSignatureRotations()) > 0 {
+				q, err := snapshotQuery.ImportSnapshot(payload.GetMultiSignatureRotations())
+				if err != nil {
+					return err
+				}
+				queries = append(queries, q...)
+			}
+		case "accountDatasetSchema":
+			if len(payload.GetAccountDatasetSchemas()) > 0 {
+				q, err := snapshotQuery.ImportSnapshot(payload.GetAccountDatasetSchemas())
+				if err != nil {
+					return err
+				}
+				queries = append(queries, q...)
+			}
 		default:
 			return blocker.NewBlocker(blocker.ParserErr, fmt.Sprintf("Invalid Snapshot Query Repository: %s", qryRepoName))
 		}
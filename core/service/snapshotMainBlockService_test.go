@@ -288,6 +288,14 @@ type (
 		query.NodeAdmissionTimestampQueryInterface
 		success bool
 	}
+	mockSnapshotLockedFundQuery struct {
+		query.LockedFundQueryInterface
+		success bool
+	}
+	mockSnapshotHtlcQuery struct {
+		query.HtlcQueryInterface
+		success bool
+	}
 	mockBlockMainServiceSuccess struct {
 		BlockServiceInterface
 	}
@@ -406,8 +414,8 @@ var (
 		Height:    2160,
 		Timestamp: 15875392,
 	}
-	snapshotFullHash = []byte{52, 88, 4, 228, 41, 161, 251, 154, 150, 182, 205, 187, 178, 30, 4, 220, 225, 120, 14, 189, 125, 232, 241,
-		246, 84, 127, 105, 140, 147, 243, 73, 38}
+	snapshotFullHash = []byte{161, 53, 9, 222, 240, 0, 204, 125, 66, 191, 32, 167, 121, 230, 216, 146, 122, 86, 108, 32, 10, 219, 15,
+		243, 240, 132, 203, 76, 148, 29, 191, 88}
 	snapshotChunk1Hash = []byte{
 		1, 1, 1, 249, 145, 71, 241, 88, 208, 4, 80, 132, 88, 43, 189, 93, 19, 104, 255, 61, 177, 177, 223,
 		188, 144, 9, 73, 75, 6, 1, 1, 1,
@@ -527,7 +535,7 @@ func (*mockSnapshotQueryExecutor) ExecuteSelect(qry string, tx bool, args ...int
 					11,
 					true,
 				))
-	case "SELECT multisig_address,account_address,account_address_index,latest,block_height " +
+	case "SELECT multisig_address,account_address,account_address_index,latest,block_height,weight " +
 		"FROM multisignature_participant WHERE multisig_address = ? AND block_height >= ? AND block_height <= ? " +
 		"ORDER BY account_address_index":
 		mock.ExpectQuery("").
@@ -538,6 +546,7 @@ func (*mockSnapshotQueryExecutor) ExecuteSelect(qry string, tx bool, args ...int
 					0,
 					true,
 					10,
+					3,
 				).
 				AddRow(
 					address1,
@@ -545,6 +554,7 @@ func (*mockSnapshotQueryExecutor) ExecuteSelect(qry string, tx bool, args ...int
 					0,
 					true,
 					10,
+					1,
 				))
 	default:
 		mock.ExpectQuery("").
@@ -600,6 +610,20 @@ func (*mockBlockMainServiceSuccess) InitializeBlocksCache() error {
 	return nil
 }
 
+func (mslf *mockSnapshotLockedFundQuery) BuildModels(*sql.Rows) ([]*model.LockedFund, error) {
+	if mslf.success {
+		return []*model.LockedFund{}, nil
+	}
+	return nil, errors.New("mockedError")
+}
+
+func (mshq *mockSnapshotHtlcQuery) BuildModels(*sql.Rows) ([]*model.Htlc, error) {
+	if mshq.success {
+		return []*model.Htlc{}, nil
+	}
+	return nil, errors.New("mockedError")
+}
+
 func (*mockBlockMainServiceSuccess) GetLastBlock() (*model.Block, error) {
 	mockedBlock := transaction.GetFixturesForBlock(100, 123456789)
 	return mockedBlock, nil
@@ -628,6 +652,10 @@ func TestSnapshotMainBlockService_NewSnapshotFile(t *testing.T) {
 		FeeVoteRevealVoteQuery         query.FeeVoteRevealVoteQueryInterface
 		LiquidPaymentTransactionQuery  query.LiquidPaymentTransactionQueryInterface
 		NodeAdmissionTimestampQuery    query.NodeAdmissionTimestampQueryInterface
+		LockedFundQuery                query.LockedFundQueryInterface
+		HtlcQuery                      query.HtlcQueryInterface
+		MultiSignatureRotationQuery    query.MultiSignatureRotationQueryInterface
+		AccountDatasetSchemaQuery      query.AccountDatasetSchemaQueryInterface
 		BlockQuery                     query.BlockQueryInterface
 		SnapshotQueries                map[string]query.SnapshotQuery
 		BlocksmithSafeQuery            map[string]bool
@@ -673,6 +701,10 @@ func TestSnapshotMainBlockService_NewSnapshotFile(t *testing.T) {
 				FeeVoteRevealVoteQuery:         &mockSnapshotFeeVoteRevealQuery{success: true},
 				LiquidPaymentTransactionQuery:  &mockSnapshotLiquidPaymentTransactionQuery{success: true},
 				NodeAdmissionTimestampQuery:    &mockSnapshotNodeAdmissionTimestampQuery{success: true},
+				LockedFundQuery:                &mockSnapshotLockedFundQuery{success: true},
+				HtlcQuery:                      &mockSnapshotHtlcQuery{success: true},
+				MultiSignatureRotationQuery:    query.NewMultiSignatureRotationQuery(),
+				AccountDatasetSchemaQuery:      query.NewAccountDatasetSchemaQuery(),
 				SnapshotQueries:                query.GetSnapshotQuery(chaintype.GetChainType(0)),
 				BlocksmithSafeQuery:            query.GetBlocksmithSafeQuery(chaintype.GetChainType(0)),
 				DerivedQueries:                 query.GetDerivedQuery(chaintype.GetChainType(0)),
@@ -720,6 +752,10 @@ func TestSnapshotMainBlockService_NewSnapshotFile(t *testing.T) {
 				FeeVoteRevealVoteQuery:         tt.fields.FeeVoteRevealVoteQuery,
 				LiquidPaymentTransactionQuery:  tt.fields.LiquidPaymentTransactionQuery,
 				NodeAdmissionTimestampQuery:    tt.fields.NodeAdmissionTimestampQuery,
+				LockedFundQuery:                tt.fields.LockedFundQuery,
+				HtlcQuery:                      tt.fields.HtlcQuery,
+				MultiSignatureRotationQuery:    tt.fields.MultiSignatureRotationQuery,
+				AccountDatasetSchemaQuery:      tt.fields.AccountDatasetSchemaQuery,
 				DerivedQueries:                 tt.fields.DerivedQueries,
 			}
 			got, err := ss.NewSnapshotFile(tt.args.block)
@@ -767,6 +803,10 @@ func TestSnapshotMainBlockService_Integration_NewSnapshotFile(t *testing.T) {
 		LiquidPaymentTransactionQuery query.LiquidPaymentTransactionQueryInterface
 		BlockQuery                    query.BlockQueryInterface
 		NodeAdmissionTimestampQuery   query.NodeAdmissionTimestampQueryInterface
+		LockedFundQuery               query.LockedFundQueryInterface
+		HtlcQuery                     query.HtlcQueryInterface
+		MultiSignatureRotationQuery   query.MultiSignatureRotationQueryInterface
+		AccountDatasetSchemaQuery     query.AccountDatasetSchemaQueryInterface
 		SnapshotQueries               map[string]query.SnapshotQuery
 		BlocksmithSafeQuery           map[string]bool
 		DerivedQueries                []query.DerivedQuery
@@ -813,6 +853,10 @@ func TestSnapshotMainBlockService_Integration_NewSnapshotFile(t *testing.T) {
 				FeeVoteRevealVoteQuery:        &mockSnapshotFeeVoteRevealQuery{success: true},
 				LiquidPaymentTransactionQuery: &mockSnapshotLiquidPaymentTransactionQuery{success: true},
 				NodeAdmissionTimestampQuery:   &mockSnapshotNodeAdmissionTimestampQuery{success: true},
+				LockedFundQuery:               &mockSnapshotLockedFundQuery{success: true},
+				HtlcQuery:                     &mockSnapshotHtlcQuery{success: true},
+				MultiSignatureRotationQuery:   query.NewMultiSignatureRotationQuery(),
+				AccountDatasetSchemaQuery:     query.NewAccountDatasetSchemaQuery(),
 				SnapshotQueries:               query.GetSnapshotQuery(chaintype.GetChainType(0)),
 				DerivedQueries:                query.GetDerivedQuery(chaintype.GetChainType(0)),
 				BlocksmithSafeQuery:           query.GetBlocksmithSafeQuery(chaintype.GetChainType(0)),
@@ -855,6 +899,10 @@ func TestSnapshotMainBlockService_Integration_NewSnapshotFile(t *testing.T) {
 				FeeVoteRevealVoteQuery:        &mockSnapshotFeeVoteRevealQuery{success: true},
 				LiquidPaymentTransactionQuery: &mockSnapshotLiquidPaymentTransactionQuery{success: true},
 				NodeAdmissionTimestampQuery:   &mockSnapshotNodeAdmissionTimestampQuery{success: true},
+				LockedFundQuery:               &mockSnapshotLockedFundQuery{success: true},
+				HtlcQuery:                     &mockSnapshotHtlcQuery{success: true},
+				MultiSignatureRotationQuery:   query.NewMultiSignatureRotationQuery(),
+				AccountDatasetSchemaQuery:     query.NewAccountDatasetSchemaQuery(),
 				SnapshotQueries:               query.GetSnapshotQuery(chaintype.GetChainType(0)),
 				DerivedQueries:                query.GetDerivedQuery(chaintype.GetChainType(0)),
 				BlocksmithSafeQuery:           query.GetBlocksmithSafeQuery(chaintype.GetChainType(0)),
@@ -892,6 +940,10 @@ func TestSnapshotMainBlockService_Integration_NewSnapshotFile(t *testing.T) {
 				FeeVoteRevealVoteQuery:        tt.fields.FeeVoteRevealVoteQuery,
 				LiquidPaymentTransactionQuery: tt.fields.LiquidPaymentTransactionQuery,
 				NodeAdmissionTimestampQuery:   tt.fields.NodeAdmissionTimestampQuery,
+				LockedFundQuery:               tt.fields.LockedFundQuery,
+				HtlcQuery:                     tt.fields.HtlcQuery,
+				MultiSignatureRotationQuery:   tt.fields.MultiSignatureRotationQuery,
+				AccountDatasetSchemaQuery:     tt.fields.AccountDatasetSchemaQuery,
 			}
 			got, err := ss.NewSnapshotFile(tt.args.block)
 			if err != nil {
@@ -974,6 +1026,10 @@ func TestSnapshotMainBlockService_ImportSnapshotFile(t *testing.T) {
 		FeeVoteRevealVoteQuery         query.FeeVoteRevealVoteQueryInterface
 		LiquidPaymentTransactionQuery  query.LiquidPaymentTransactionQueryInterface
 		NodeAdmissionTimestampQuery    query.NodeAdmissionTimestampQueryInterface
+		LockedFundQuery                query.LockedFundQueryInterface
+		HtlcQuery                      query.HtlcQueryInterface
+		MultiSignatureRotationQuery    query.MultiSignatureRotationQueryInterface
+		AccountDatasetSchemaQuery      query.AccountDatasetSchemaQueryInterface
 		BlockQuery                     query.BlockQueryInterface
 		SnapshotQueries                map[string]query.SnapshotQuery
 		BlocksmithSafeQuery            map[string]bool
@@ -1019,6 +1075,10 @@ func TestSnapshotMainBlockService_ImportSnapshotFile(t *testing.T) {
 				LiquidPaymentTransactionQuery:  query.NewLiquidPaymentTransactionQuery(),
 				BlockQuery:                     query.NewBlockQuery(&chaintype.MainChain{}),
 				NodeAdmissionTimestampQuery:    query.NewNodeAdmissionTimestampQuery(),
+				LockedFundQuery:                query.NewLockedFundQuery(),
+				HtlcQuery:                      query.NewHtlcQuery(),
+				MultiSignatureRotationQuery:    query.NewMultiSignatureRotationQuery(),
+				AccountDatasetSchemaQuery:      query.NewAccountDatasetSchemaQuery(),
 				SnapshotQueries:                query.GetSnapshotQuery(chaintype.GetChainType(0)),
 				BlocksmithSafeQuery:            query.GetBlocksmithSafeQuery(chaintype.GetChainType(0)),
 				DerivedQueries:                 query.GetDerivedQuery(chaintype.GetChainType(0)),
@@ -1057,6 +1117,10 @@ func TestSnapshotMainBlockService_ImportSnapshotFile(t *testing.T) {
 				LiquidPaymentTransactionQuery:  tt.fields.LiquidPaymentTransactionQuery,
 				BlockQuery:                     tt.fields.BlockQuery,
 				NodeAdmissionTimestampQuery:    tt.fields.NodeAdmissionTimestampQuery,
+				LockedFundQuery:                tt.fields.LockedFundQuery,
+				HtlcQuery:                      tt.fields.HtlcQuery,
+				MultiSignatureRotationQuery:    tt.fields.MultiSignatureRotationQuery,
+				AccountDatasetSchemaQuery:      tt.fields.AccountDatasetSchemaQuery,
 				SnapshotQueries:                tt.fields.SnapshotQueries,
 				BlocksmithSafeQuery:            tt.fields.BlocksmithSafeQuery,
 				DerivedQueries:                 tt.fields.DerivedQueries,
@@ -50,6 +50,7 @@
 package service
 
 import (
+	"bytes"
 	"database/sql"
 	"fmt"
 	"strconv"
@@ -67,11 +68,14 @@ type (
 		GetTransactionsByIds(transactionIds []int64) ([]*model.Transaction, error)
 		GetTransactionsByBlockID(blockID int64) ([]*model.Transaction, error)
 		ValidateTransaction(txAction transaction.TypeAction, useTX bool) error
+		ValidateTransactionReplacement(tx *model.Transaction, useTX bool) error
 		ApplyUnconfirmedTransaction(txAction transaction.TypeAction) error
 		UndoApplyUnconfirmedTransaction(txAction transaction.TypeAction) error
 		ApplyConfirmedTransaction(txAction transaction.TypeAction, blockTimestamp int64) error
 		ExpiringEscrowTransactions(blockHeight uint32, blockTimestamp int64, useTX bool) error
 		CompletePassedLiquidPayment(block *model.Block) error
+		ReleaseUnlockedFunds(block *model.Block) error
+		ExpireAccountDatasets(block *model.Block) error
 	}
 
 	TransactionCoreService struct {
@@ -82,6 +86,8 @@ type (
 		TransactionQuery              query.TransactionQueryInterface
 		EscrowTransactionQuery        query.EscrowTransactionQueryInterface
 		LiquidPaymentTransactionQuery query.LiquidPaymentTransactionQueryInterface
+		LockedFundQuery               query.LockedFundQueryInterface
+		AccountDatasetQuery           query.AccountDatasetQueryInterface
 	}
 )
 
@@ -93,6 +99,8 @@ func NewTransactionCoreService(
 	transactionQuery query.TransactionQueryInterface,
 	escrowTransactionQuery query.EscrowTransactionQueryInterface,
 	liquidPaymentTransactionQuery query.LiquidPaymentTransactionQueryInterface,
+	lockedFundQuery query.LockedFundQueryInterface,
+	accountDatasetQuery query.AccountDatasetQueryInterface,
 ) TransactionCoreServiceInterface {
 	return &TransactionCoreService{
 		Log:                           log,
@@ -102,6 +110,8 @@ func NewTransactionCoreService(
 		TransactionQuery:              transactionQuery,
 		EscrowTransactionQuery:        escrowTransactionQuery,
 		LiquidPaymentTransactionQuery: liquidPaymentTransactionQuery,
+		LockedFundQuery:               lockedFundQuery,
+		AccountDatasetQuery:           accountDatasetQuery,
 	}
 }
 
@@ -266,6 +276,7 @@ func (tg *TransactionCoreService) ExpiringEscrowTransactions(blockHeight uint32,
 			}
 
 			refTransaction.Height = blockHeight
+			escrow.BlockHeight = blockHeight
 			refTransaction.Escrow = escrow
 			typeAction, err = tg.TypeActionSwitcher.GetTransactionType(&refTransaction)
 			if err != nil {
@@ -349,7 +360,7 @@ func (tg *TransactionCoreService) CompletePassedLiquidPayment(block *model.Block
 		if !ok {
 			return blocker.NewBlocker(blocker.AppErr, "Wrong type of transaction")
 		}
-		err = liquidPaymentTransaction.CompletePayment(block.GetHeight(), block.GetTimestamp(), payment.AppliedTime)
+		err = liquidPaymentTransaction.CompletePayment(block.GetHeight(), block.GetTimestamp(), payment.AppliedTime, payment.ClaimedAmount)
 		if err != nil {
 			return err
 		}
@@ -358,6 +369,93 @@ func (tg *TransactionCoreService) CompletePassedLiquidPayment(block *model.Block
 	return nil
 }
 
+// ReleaseUnlockedFunds credit the recipients of the time locked send zbc transactions which unlock height or timestamp is reached by block
+func (tg *TransactionCoreService) ReleaseUnlockedFunds(block *model.Block) error {
+	var (
+		rows        *sql.Rows
+		row         *sql.Row
+		err         error
+		lockedFunds []*model.LockedFund
+		txType      transaction.TypeAction
+	)
+	lockedFunds, err = func() ([]*model.LockedFund, error) {
+		lockedFundQ, lockedFundArgs := tg.LockedFundQuery.GetReleasableLockedFunds(block.GetHeight(), block.GetTimestamp())
+		rows, err = tg.QueryExecutor.ExecuteSelect(lockedFundQ, true, lockedFundArgs...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		return tg.LockedFundQuery.BuildModels(rows)
+	}()
+	if err != nil {
+		return err
+	}
+
+	for _, lockedFund := range lockedFunds {
+		var tx model.Transaction
+		transactionQ := tg.TransactionQuery.GetTransaction(lockedFund.GetID())
+		row, err = tg.QueryExecutor.ExecuteSelectRow(transactionQ, false)
+		if err != nil {
+			return err
+		}
+		err = tg.TransactionQuery.Scan(&tx, row)
+		if err != nil {
+			if err != sql.ErrNoRows {
+				return err
+			}
+			return blocker.NewBlocker(blocker.AppErr, "TransactionNotFound")
+		}
+
+		txType, err = tg.TypeActionSwitcher.GetTransactionType(&tx)
+		if err != nil {
+			return err
+		}
+		timeLockedSendZBC, ok := txType.(transaction.TimeLockedSendZBCInterface)
+		if !ok {
+			return blocker.NewBlocker(blocker.AppErr, "Wrong type of transaction")
+		}
+		err = timeLockedSendZBC.ReleaseLockedFund(block.GetHeight(), block.GetTimestamp())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExpireAccountDatasets deactivate the account datasets which expiry height or timestamp is reached by block
+func (tg *TransactionCoreService) ExpireAccountDatasets(block *model.Block) error {
+	var (
+		rows     *sql.Rows
+		err      error
+		datasets []*model.AccountDataset
+		queries  [][]interface{}
+	)
+	datasets, err = func() ([]*model.AccountDataset, error) {
+		datasetQ, datasetArgs := tg.AccountDatasetQuery.GetExpiredAccountDatasets(block.GetHeight(), block.GetTimestamp())
+		rows, err = tg.QueryExecutor.ExecuteSelect(datasetQ, true, datasetArgs...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		return tg.AccountDatasetQuery.BuildModel([]*model.AccountDataset{}, rows)
+	}()
+	if err != nil {
+		return err
+	}
+
+	for _, dataset := range datasets {
+		// keep the expiry, so that the new version can be told apart from a removed dataset
+		dataset.IsActive = false
+		dataset.Latest = true
+		dataset.Height = block.GetHeight()
+		queries = append(queries, tg.AccountDatasetQuery.InsertAccountDataset(dataset)...)
+	}
+	if len(queries) == 0 {
+		return nil
+	}
+	return tg.QueryExecutor.ExecuteTransactions(queries)
+}
+
 func (tg *TransactionCoreService) ValidateTransaction(txAction transaction.TypeAction, useTX bool) error {
 	escrowAction, ok := txAction.Escrowable()
 	switch ok {
@@ -368,6 +466,47 @@ func (tg *TransactionCoreService) ValidateTransaction(txAction transaction.TypeA
 	}
 }
 
+/*
+ValidateTransactionReplacement check tx doesn't conflict with a confirmed transaction of the same sender: a pending
+transaction and the replacement naming it in ReplacedTransactionID can't be both confirmed, whichever comes first
+*/
+func (tg *TransactionCoreService) ValidateTransactionReplacement(tx *model.Transaction, useTX bool) error {
+	var (
+		confirmedTx model.Transaction
+		row         *sql.Row
+		err         error
+	)
+	if tx.GetReplacedTransactionID() != 0 {
+		row, err = tg.QueryExecutor.ExecuteSelectRow(tg.TransactionQuery.GetTransaction(tx.GetReplacedTransactionID()), useTX)
+		if err != nil {
+			return blocker.NewBlocker(blocker.DBErr, err.Error())
+		}
+		err = tg.TransactionQuery.Scan(&confirmedTx, row)
+		if err != nil && err != sql.ErrNoRows {
+			return blocker.NewBlocker(blocker.DBErr, err.Error())
+		}
+		if err == nil && bytes.Equal(confirmedTx.GetSenderAccountAddress(), tx.GetSenderAccountAddress()) {
+			return blocker.NewBlocker(blocker.ValidationErr, "ReplacedTransactionAlreadyConfirmed")
+		}
+	}
+	qry, args := tg.TransactionQuery.GetReplacementTransaction(tx.GetID(), tx.GetSenderAccountAddress())
+	row, err = tg.QueryExecutor.ExecuteSelectRow(qry, useTX, args...)
+	if err != nil {
+		return blocker.NewBlocker(blocker.DBErr, err.Error())
+	}
+	err = tg.TransactionQuery.Scan(&confirmedTx, row)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return blocker.NewBlocker(blocker.DBErr, err.Error())
+		}
+		return nil
+	}
+	return blocker.NewBlocker(
+		blocker.ValidationErr,
+		fmt.Sprintf("TransactionAlreadyReplaced: by confirmed transaction %d", confirmedTx.GetID()),
+	)
+}
+
 func (tg *TransactionCoreService) ApplyUnconfirmedTransaction(txAction transaction.TypeAction) error {
 
 	escrowAction, ok := txAction.Escrowable()
@@ -195,6 +195,7 @@ func (*mockGetTransactionsByIdsExecutorSelectWithEscrowSuccess) ExecuteSelect(q
 			mockedTX.GetTransactionIndex(),
 			mockedTX.GetMultisigChild(),
 			mockedTX.GetMessage(),
+			0,
 		))
 	default:
 		mockedEscrow := mockedTX.GetEscrow()
@@ -210,6 +211,11 @@ func (*mockGetTransactionsByIdsExecutorSelectWithEscrowSuccess) ExecuteSelect(q
 			mockedEscrow.GetBlockHeight(),
 			mockedEscrow.GetLatest(),
 			mockedEscrow.GetInstruction(),
+			nil,
+			mockedEscrow.GetMinimumApprovals(),
+			nil,
+			nil,
+			mockedEscrow.GetReleasedAmount(),
 		))
 	}
 	rows, _ := db.Query(q)
@@ -457,6 +463,11 @@ func (*mockQueryExecutorExpiringEscrowSuccess) ExecuteSelect(qStr string, tx boo
 		uint32(0),
 		true,
 		"",
+		nil,
+		uint32(0),
+		nil,
+		nil,
+		int64(0),
 	)
 	mock.ExpectQuery(regexp.QuoteMeta(qStr)).WillReturnRows(mockRows)
 	return db.Query(qStr)
@@ -495,6 +506,7 @@ func (*mockQueryExecutorExpiringEscrowSuccess) ExecuteSelectRow(qStr string, _ b
 		tx.GetTransactionIndex(),
 		tx.GetMultisigChild(),
 		tx.GetMessage(),
+		0,
 	)
 	mock.ExpectQuery(qStr).WillReturnRows(mockedRows)
 	return db.QueryRow(qStr), nil
@@ -836,6 +848,91 @@ func TestTransactionCoreService_ValidateTransaction(t *testing.T) {
 	}
 }
 
+type (
+	mockValidateTransactionReplacementExecutor struct {
+		query.ExecutorInterface
+		isExecuteSelectRowError bool
+	}
+	mockValidateTransactionReplacementTransactionQuery struct {
+		query.TransactionQuery
+		confirmedTx *model.Transaction
+	}
+)
+
+func (m *mockValidateTransactionReplacementExecutor) ExecuteSelectRow(string, bool, ...interface{}) (*sql.Row, error) {
+	if m.isExecuteSelectRowError {
+		return nil, errors.New("mockError ExecuteSelectRow")
+	}
+	return nil, nil
+}
+
+func (m *mockValidateTransactionReplacementTransactionQuery) Scan(tx *model.Transaction, _ *sql.Row) error {
+	if m.confirmedTx == nil {
+		return sql.ErrNoRows
+	}
+	*tx = *m.confirmedTx
+	return nil
+}
+
+func TestTransactionCoreService_ValidateTransactionReplacement(t *testing.T) {
+	var (
+		confirmedTx = &model.Transaction{
+			ID:                   2,
+			SenderAccountAddress: address1,
+		}
+		replacement = &model.Transaction{
+			ID:                    3,
+			SenderAccountAddress:  address1,
+			ReplacedTransactionID: 2,
+		}
+	)
+	tests := []struct {
+		name          string
+		tx            *model.Transaction
+		queryExecutor query.ExecutorInterface
+		confirmedTx   *model.Transaction
+		wantErr       bool
+	}{
+		{
+			name:          "ValidateTransactionReplacement:NoConflict",
+			tx:            replacement,
+			queryExecutor: &mockValidateTransactionReplacementExecutor{},
+			wantErr:       false,
+		},
+		{
+			name:          "ValidateTransactionReplacement:ExecuteSelectRowError",
+			tx:            replacement,
+			queryExecutor: &mockValidateTransactionReplacementExecutor{isExecuteSelectRowError: true},
+			wantErr:       true,
+		},
+		{
+			name:          "ValidateTransactionReplacement:ReplacedTransactionAlreadyConfirmed",
+			tx:            replacement,
+			queryExecutor: &mockValidateTransactionReplacementExecutor{},
+			confirmedTx:   confirmedTx,
+			wantErr:       true,
+		},
+		{
+			name:          "ValidateTransactionReplacement:TransactionAlreadyReplaced",
+			tx:            confirmedTx,
+			queryExecutor: &mockValidateTransactionReplacementExecutor{},
+			confirmedTx:   replacement,
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tg := &TransactionCoreService{
+				TransactionQuery: &mockValidateTransactionReplacementTransactionQuery{confirmedTx: tt.confirmedTx},
+				QueryExecutor:    tt.queryExecutor,
+			}
+			if err := tg.ValidateTransactionReplacement(tt.tx, false); (err != nil) != tt.wantErr {
+				t.Errorf("TransactionCoreService.ValidateTransactionReplacement() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 type (
 	mockCompletePassedLiquidPaymentExecutor struct {
 		isExecuteSelectError    bool
@@ -907,7 +1004,7 @@ func (m *mockTypeActionSwitcher) GetTransactionType(tx *model.Transaction) (tran
 	return m.returnTx, nil
 }
 
-func (m *mockLiquidPaymentTransaction) CompletePayment(blockHeight uint32, blockTimestamp, firstAppliedTimestamp int64) error {
+func (m *mockLiquidPaymentTransaction) CompletePayment(blockHeight uint32, blockTimestamp, firstAppliedTimestamp, claimedAmount int64) error {
 	if m.isError {
 		return errors.New("mock error CompletePayment")
 	}
@@ -1067,3 +1164,237 @@ func TestTransactionCoreService_CompletePassedLiquidPayment(t *testing.T) {
 		})
 	}
 }
+
+type (
+	mockReleaseUnlockedFundsLockedFundQuery struct {
+		isBuildModelsError bool
+		returnModels       []*model.LockedFund
+		query.LockedFundQuery
+	}
+
+	mockTimeLockedSendZBCTransaction struct {
+		isError bool
+		transaction.TimeLockedSendZBC
+	}
+)
+
+func (m *mockReleaseUnlockedFundsLockedFundQuery) BuildModels(*sql.Rows) ([]*model.LockedFund, error) {
+	if m.isBuildModelsError {
+		return nil, errors.New("mockError BuildModels")
+	}
+	return m.returnModels, nil
+}
+
+func (m *mockTimeLockedSendZBCTransaction) ReleaseLockedFund(blockHeight uint32, blockTimestamp int64) error {
+	if m.isError {
+		return errors.New("mock error ReleaseLockedFund")
+	}
+	return nil
+}
+
+func TestTransactionCoreService_ReleaseUnlockedFunds(t *testing.T) {
+	type fields struct {
+		QueryExecutor      query.ExecutorInterface
+		TypeActionSwitcher transaction.TypeActionSwitcher
+		TransactionQuery   query.TransactionQueryInterface
+		LockedFundQuery    query.LockedFundQueryInterface
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{
+			name: "wantErr:ExecuteSelect_error",
+			fields: fields{
+				QueryExecutor: &mockCompletePassedLiquidPaymentExecutor{
+					isExecuteSelectError: true,
+				},
+				LockedFundQuery: query.NewLockedFundQuery(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantErr:BuildModels_error",
+			fields: fields{
+				QueryExecutor: &mockCompletePassedLiquidPaymentExecutor{},
+				LockedFundQuery: &mockReleaseUnlockedFundsLockedFundQuery{
+					isBuildModelsError: true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantErr:TransactionQuery.Scan_error",
+			fields: fields{
+				QueryExecutor: &mockCompletePassedLiquidPaymentExecutor{},
+				LockedFundQuery: &mockReleaseUnlockedFundsLockedFundQuery{
+					returnModels: []*model.LockedFund{{}},
+				},
+				TransactionQuery: &mockCompletePassedLiquidPaymentTransactionQuery{
+					isScanError: true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantErr:TimeLockedSendZBC_casting_error",
+			fields: fields{
+				QueryExecutor: &mockCompletePassedLiquidPaymentExecutor{},
+				LockedFundQuery: &mockReleaseUnlockedFundsLockedFundQuery{
+					returnModels: []*model.LockedFund{{}},
+				},
+				TransactionQuery: &mockCompletePassedLiquidPaymentTransactionQuery{},
+				TypeActionSwitcher: &mockTypeActionSwitcher{
+					returnTx: &transaction.TXEmpty{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantErr:ReleaseLockedFund_error",
+			fields: fields{
+				QueryExecutor: &mockCompletePassedLiquidPaymentExecutor{},
+				LockedFundQuery: &mockReleaseUnlockedFundsLockedFundQuery{
+					returnModels: []*model.LockedFund{{}},
+				},
+				TransactionQuery: &mockCompletePassedLiquidPaymentTransactionQuery{},
+				TypeActionSwitcher: &mockTypeActionSwitcher{
+					returnTx: &mockTimeLockedSendZBCTransaction{
+						isError: true,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantSuccess",
+			fields: fields{
+				QueryExecutor: &mockCompletePassedLiquidPaymentExecutor{},
+				LockedFundQuery: &mockReleaseUnlockedFundsLockedFundQuery{
+					returnModels: []*model.LockedFund{{}},
+				},
+				TransactionQuery: &mockCompletePassedLiquidPaymentTransactionQuery{},
+				TypeActionSwitcher: &mockTypeActionSwitcher{
+					returnTx: &mockTimeLockedSendZBCTransaction{},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tg := &TransactionCoreService{
+				QueryExecutor:      tt.fields.QueryExecutor,
+				TypeActionSwitcher: tt.fields.TypeActionSwitcher,
+				TransactionQuery:   tt.fields.TransactionQuery,
+				LockedFundQuery:    tt.fields.LockedFundQuery,
+			}
+			if err := tg.ReleaseUnlockedFunds(&model.Block{Height: 10, Timestamp: 1000}); (err != nil) != tt.wantErr {
+				t.Errorf("TransactionCoreService.ReleaseUnlockedFunds() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type (
+	mockExpireAccountDatasetsExecutor struct {
+		isExecuteSelectError       bool
+		isExecuteTransactionsError bool
+		returnNoDataset            bool
+		query.ExecutorInterface
+	}
+)
+
+func (m *mockExpireAccountDatasetsExecutor) ExecuteSelect(qe string, tx bool, args ...interface{}) (*sql.Rows, error) {
+	if m.isExecuteSelectError {
+		return nil, errors.New("mockError ExecuteSelect")
+	}
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	mockRows := mock.NewRows(query.NewAccountDatasetsQuery().Fields)
+	if !m.returnNoDataset {
+		mockRows.AddRow(
+			[]byte{1, 2, 3},
+			[]byte{4, 5, 6},
+			"Admin",
+			"Welcome",
+			true,
+			true,
+			5,
+			10,
+			0,
+		)
+	}
+	mock.ExpectQuery("").WillReturnRows(mockRows)
+
+	return db.Query("")
+}
+
+func (m *mockExpireAccountDatasetsExecutor) ExecuteTransactions(queries [][]interface{}) error {
+	if m.isExecuteTransactionsError {
+		return errors.New("mockError ExecuteTransactions")
+	}
+	return nil
+}
+
+func TestTransactionCoreService_ExpireAccountDatasets(t *testing.T) {
+	type fields struct {
+		QueryExecutor       query.ExecutorInterface
+		AccountDatasetQuery query.AccountDatasetQueryInterface
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{
+			name: "wantErr:ExecuteSelect_error",
+			fields: fields{
+				QueryExecutor: &mockExpireAccountDatasetsExecutor{
+					isExecuteSelectError: true,
+				},
+				AccountDatasetQuery: query.NewAccountDatasetsQuery(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantErr:ExecuteTransactions_error",
+			fields: fields{
+				QueryExecutor: &mockExpireAccountDatasetsExecutor{
+					isExecuteTransactionsError: true,
+				},
+				AccountDatasetQuery: query.NewAccountDatasetsQuery(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "wantSuccess:NoExpiredDataset",
+			fields: fields{
+				QueryExecutor: &mockExpireAccountDatasetsExecutor{
+					returnNoDataset:            true,
+					isExecuteTransactionsError: true,
+				},
+				AccountDatasetQuery: query.NewAccountDatasetsQuery(),
+			},
+		},
+		{
+			name: "wantSuccess",
+			fields: fields{
+				QueryExecutor:       &mockExpireAccountDatasetsExecutor{},
+				AccountDatasetQuery: query.NewAccountDatasetsQuery(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tg := &TransactionCoreService{
+				QueryExecutor:       tt.fields.QueryExecutor,
+				AccountDatasetQuery: tt.fields.AccountDatasetQuery,
+			}
+			if err := tg.ExpireAccountDatasets(&model.Block{Height: 10, Timestamp: 1000}); (err != nil) != tt.wantErr {
+				t.Errorf("TransactionCoreService.ExpireAccountDatasets() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -55,6 +55,7 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/rand"
 
 	"github.com/zoobc/zoobc-core/common/blocker"
@@ -93,6 +94,7 @@ type (
 			signature crypto.SignatureInterface,
 			scrambleNodesAtHeight *model.ScrambledNodes,
 		) error
+		ValidateReceiptSignatures(receipts []*model.Receipt, signature crypto.SignatureInterface) error
 		ValidateReceiptSenderRecipient(
 			receipt *model.Receipt,
 			scrambledNode *model.ScrambledNodes,
@@ -273,7 +275,8 @@ func (ru *ReceiptUtil) GetPriorityPeersAtHeight(
 	return peersByPubKeyMap, nil
 }
 
-// ValidateReceiptHelper helper function for better code testability
+// ValidateReceiptHelper helper function for better code testability, signature is nil when the receipt signature has already
+// been verified by ValidateReceiptSignatures
 func (ru *ReceiptUtil) ValidateReceiptHelper(
 	receipt *model.Receipt,
 	validateRefBlock bool,
@@ -287,32 +290,22 @@ func (ru *ReceiptUtil) ValidateReceiptHelper(
 		blockAtHeight *storage.BlockCacheObject
 		err           error
 	)
-	if len(receipt.GetRecipientPublicKey()) != ed25519.PublicKeySize {
-		return blocker.NewBlocker(blocker.ValidationErr,
-			"[SendBlockTransactions:MaliciousReceipt] - %d is %s",
-			len(receipt.GetRecipientPublicKey()),
-			"InvalidReceiptRecipientPublicKeySize",
-		)
-	}
-	if len(receipt.GetRecipientSignature()) != ed25519.SignatureSize {
-		return blocker.NewBlocker(blocker.ValidationErr,
-			"[SendBlockTransactions:MaliciousReceipt] - %d is %s",
-			len(receipt.GetRecipientPublicKey()),
-			"InvalidReceiptSignatureSize",
-		)
-	}
-
-	unsignedBytes := ru.GetUnsignedReceiptBytes(receipt)
-	if !signature.VerifyNodeSignature(
-		unsignedBytes,
-		receipt.RecipientSignature,
-		receipt.RecipientPublicKey,
-	) {
-		// rollback
-		return blocker.NewBlocker(
-			blocker.ValidationErr,
-			"InvalidReceiptSignature",
-		)
+	if signature != nil {
+		if err = validateReceiptSignatureSize(receipt); err != nil {
+			return err
+		}
+		unsignedBytes := ru.GetUnsignedReceiptBytes(receipt)
+		if !signature.VerifyNodeSignature(
+			unsignedBytes,
+			receipt.RecipientSignature,
+			receipt.RecipientPublicKey,
+		) {
+			// rollback
+			return blocker.NewBlocker(
+				blocker.ValidationErr,
+				"InvalidReceiptSignature",
+			)
+		}
 	}
 
 	// validate reference block hash only if necessary
@@ -341,6 +334,48 @@ func (ru *ReceiptUtil) ValidateReceiptHelper(
 	return nil
 }
 
+// ValidateReceiptSignatures verify the recipient signatures of many receipts at once, as the published ones of a block
+func (ru *ReceiptUtil) ValidateReceiptSignatures(receipts []*model.Receipt, signature crypto.SignatureInterface) error {
+	var (
+		payloads         = make([][]byte, len(receipts))
+		signatures       = make([][]byte, len(receipts))
+		recipientPubKeys = make([][]byte, len(receipts))
+	)
+	for i, receipt := range receipts {
+		if err := validateReceiptSignatureSize(receipt); err != nil {
+			return err
+		}
+		payloads[i] = ru.GetUnsignedReceiptBytes(receipt)
+		signatures[i] = receipt.RecipientSignature
+		recipientPubKeys[i] = receipt.RecipientPublicKey
+	}
+	if err := signature.VerifyNodeSignatures(payloads, signatures, recipientPubKeys); err != nil {
+		return blocker.NewBlocker(
+			blocker.ValidationErr,
+			fmt.Sprintf("InvalidReceiptSignature - %s", err.Error()),
+		)
+	}
+	return nil
+}
+
+func validateReceiptSignatureSize(receipt *model.Receipt) error {
+	if len(receipt.GetRecipientPublicKey()) != ed25519.PublicKeySize {
+		return blocker.NewBlocker(blocker.ValidationErr,
+			"[SendBlockTransactions:MaliciousReceipt] - %d is %s",
+			len(receipt.GetRecipientPublicKey()),
+			"InvalidReceiptRecipientPublicKeySize",
+		)
+	}
+	if len(receipt.GetRecipientSignature()) != ed25519.SignatureSize {
+		return blocker.NewBlocker(blocker.ValidationErr,
+			"[SendBlockTransactions:MaliciousReceipt] - %d is %s",
+			len(receipt.GetRecipientPublicKey()),
+			"InvalidReceiptSignatureSize",
+		)
+	}
+	return nil
+}
+
 func (ru *ReceiptUtil) ValidateReceiptSenderRecipient(
 	receipt *model.Receipt,
 	scrambledNode *model.ScrambledNodes,
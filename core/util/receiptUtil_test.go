@@ -55,7 +55,9 @@ import (
 
 	"github.com/zoobc/zoobc-core/common/chaintype"
 	"github.com/zoobc/zoobc-core/common/constant"
+	"github.com/zoobc/zoobc-core/common/crypto"
 	"github.com/zoobc/zoobc-core/common/model"
+	"github.com/zoobc/zoobc-core/common/signaturetype"
 	"github.com/zoobc/zoobc-core/common/storage"
 	"github.com/zoobc/zoobc-core/common/util"
 )
@@ -240,3 +242,62 @@ func TestGetReceiptKey(t *testing.T) {
 		})
 	}
 }
+
+func TestReceiptUtil_ValidateReceiptSignatures(t *testing.T) {
+	var (
+		nodeSeed      = "concur vocalist rotten busload gap quote stinging undiluted surfer goofiness deviation starved"
+		signature     = crypto.NewSignature()
+		signedReceipt = func(datumHash []byte) *model.Receipt {
+			receipt := &model.Receipt{
+				SenderPublicKey:      []byte{1, 2, 3},
+				RecipientPublicKey:   signaturetype.NewEd25519Signature().GetPublicKeyFromSeed(nodeSeed),
+				DatumType:            constant.ReceiptDatumTypeBlock,
+				DatumHash:            datumHash,
+				ReferenceBlockHash:   mockReceipt1.ReferenceBlockHash,
+				ReferenceBlockHeight: 1,
+			}
+			receipt.RecipientSignature = signature.SignByNode(receiptUtilInstance.GetUnsignedReceiptBytes(receipt), nodeSeed)
+			return receipt
+		}
+		receipt1        = signedReceipt([]byte{1, 2, 3})
+		receipt2        = signedReceipt([]byte{4, 5, 6})
+		tamperedReceipt = signedReceipt([]byte{7, 8, 9})
+	)
+	tamperedReceipt.DatumHash = []byte{9, 8, 7}
+	type args struct {
+		receipts []*model.Receipt
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "ValidateReceiptSignatures:success",
+			args:    args{receipts: []*model.Receipt{receipt1, receipt2}},
+			wantErr: false,
+		},
+		{
+			name:    "ValidateReceiptSignatures:success-{empty}",
+			args:    args{},
+			wantErr: false,
+		},
+		{
+			name:    "ValidateReceiptSignatures:fail-{invalidSignature}",
+			args:    args{receipts: []*model.Receipt{receipt1, tamperedReceipt, receipt2}},
+			wantErr: true,
+		},
+		{
+			name:    "ValidateReceiptSignatures:fail-{invalidSignatureSize}",
+			args:    args{receipts: []*model.Receipt{receipt1, mockReceipt1}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := receiptUtilInstance.ValidateReceiptSignatures(tt.args.receipts, signature); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateReceiptSignatures() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
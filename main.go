@@ -242,6 +242,14 @@ func initiateMainInstance() {
 				log.Error(err)
 				os.Exit(1)
 			}
+		case 5:
+			webAuthnAccountType := &accounttype.WebAuthnAccountType{}
+			webAuthnAccountType.SetAccountPublicKey(accType.GetAccountPublicKey())
+			encodedAccountAddress, err = webAuthnAccountType.GetEncodedAddress()
+			if err != nil {
+				log.Error(err)
+				os.Exit(1)
+			}
 		default:
 			log.Error("Invalid Owner Account Type")
 			os.Exit(1)
@@ -563,6 +571,8 @@ func initiateMainInstance() {
 		query.NewTransactionQuery(mainchain),
 		query.NewEscrowTransactionQuery(),
 		query.NewLiquidPaymentTransactionQuery(),
+		query.NewLockedFundQuery(),
+		query.NewAccountDatasetsQuery(),
 	)
 	pendingTransactionServiceIns = service.NewPendingTransactionService(
 		loggerCoreService,
@@ -571,6 +581,40 @@ func initiateMainInstance() {
 		transactionUtil,
 		query.NewTransactionQuery(mainchain),
 		query.NewPendingTransactionQuery(),
+		&transaction.PendingTransactionHelper{
+			MultisignatureInfoQuery: query.NewMultisignatureInfoQuery(),
+			PendingTransactionQuery: query.NewPendingTransactionQuery(),
+			TransactionUtil:         transactionUtil,
+			TypeSwitcher:            actionSwitcher,
+			QueryExecutor:           queryExecutor,
+		},
+		&transaction.SignatureInfoHelper{
+			PendingSignatureQuery:   query.NewPendingSignatureQuery(),
+			PendingTransactionQuery: query.NewPendingTransactionQuery(),
+			QueryExecutor:           queryExecutor,
+			Signature:               crypto.NewSignature(),
+		},
+		&transaction.PendingTransactionHelper{
+			MultisignatureInfoQuery: query.NewMultisignatureInfoQuery(),
+			PendingTransactionQuery: query.NewDetachedPendingTransactionQuery(),
+			TransactionUtil:         transactionUtil,
+			TypeSwitcher:            actionSwitcher,
+			QueryExecutor:           queryExecutor,
+		},
+		&transaction.SignatureInfoHelper{
+			PendingSignatureQuery:   query.NewDetachedPendingSignatureQuery(),
+			PendingTransactionQuery: query.NewDetachedPendingTransactionQuery(),
+			QueryExecutor:           queryExecutor,
+			Signature:               crypto.NewSignature(),
+		},
+		&transaction.MultisignatureInfoHelper{
+			MultisignatureInfoQuery:        query.NewMultisignatureInfoQuery(),
+			MultiSignatureParticipantQuery: query.NewMultiSignatureParticipantQuery(),
+			MultiSignatureRotationQuery:    query.NewMultiSignatureRotationQuery(),
+			QueryExecutor:                  queryExecutor,
+		},
+		transaction.NewMultisigTransactionUtil(),
+		crypto.NewSignature(),
 	)
 
 	mempoolService = service.NewMempoolService(
@@ -653,6 +697,10 @@ func initiateMainInstance() {
 		query.NewFeeVoteRevealVoteQuery(),
 		query.NewLiquidPaymentTransactionQuery(),
 		query.NewNodeAdmissionTimestampQuery(),
+		query.NewLockedFundQuery(),
+		query.NewHtlcQuery(),
+		query.NewMultiSignatureRotationQuery(),
+		query.NewAccountDatasetSchemaQuery(),
 		query.NewBlockQuery(mainchain),
 		query.GetSnapshotQuery(mainchain),
 		query.GetBlocksmithSafeQuery(mainchain),
@@ -841,6 +889,14 @@ func startServices() {
 		feedbackStrategy,
 		scrambleNodeStorage,
 	)
+	// the node only broadcasts the multisig transactions completed by detached signatures when configured with a fee payer account
+	var detachedMultisigFeePayerSeed string
+	if config.DetachedMultisigBroadcast {
+		if config.DetachedMultisigFeePayerSeed == "" {
+			log.Warn("detachedMultisigBroadcast is enabled without a detachedMultisigFeePayerSeed, detached multisig broadcast disabled")
+		}
+		detachedMultisigFeePayerSeed = config.DetachedMultisigFeePayerSeed
+	}
 	api.Start(
 		queryExecutor,
 		p2pServiceInstance,
@@ -863,6 +919,9 @@ func startServices() {
 		config.MaxAPIRequestPerSecond,
 		config.NodeKey.PublicKey,
 		feedbackStrategy,
+		pendingTransactionServiceIns,
+		feeScaleService,
+		detachedMultisigFeePayerSeed,
 	)
 }
 
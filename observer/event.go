@@ -52,6 +52,7 @@ package observer
 const (
 	// block listener event
 	BlockPushed                Event = "BlockEvent.BlockPushed"
+	BlockRollback              Event = "BlockEvent.BlockRollback"
 	BroadcastBlock             Event = "BlockEvent.BroadcastBlock"
 	BlockRequestTransactions   Event = "BlockEvent.BlockRequestTransaction"
 	BlockTransactionsRequested Event = "BlockEvent.BlockTransactionsRequested"
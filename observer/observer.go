@@ -62,6 +62,8 @@ type (
 
 	Observer struct {
 		Listeners map[Event][]Listener
+		// listenersLock guard Listeners, listeners are registered while Notify reads them from other goroutines
+		listenersLock sync.RWMutex
 	}
 )
 
@@ -80,6 +82,8 @@ func NewObserver() *Observer {
 
 // AddListener add new listener in observer
 func (o *Observer) AddListener(event Event, listener Listener) {
+	o.listenersLock.Lock()
+	defer o.listenersLock.Unlock()
 	if o.Listeners == nil {
 		o.Listeners = map[Event][]Listener{}
 	}
@@ -88,12 +92,16 @@ func (o *Observer) AddListener(event Event, listener Listener) {
 
 // Remove remove registered listener in observer
 func (o *Observer) Remove(event Event) {
+	o.listenersLock.Lock()
+	defer o.listenersLock.Unlock()
 	delete(o.Listeners, event)
 }
 
 // Notify send data & arg to registered listener based on event
 func (o *Observer) Notify(event Event, data interface{}, args ...interface{}) {
+	o.listenersLock.RLock()
 	listeners, ok := o.Listeners[event]
+	o.listenersLock.RUnlock()
 	if !ok {
 		return
 	}
@@ -201,3 +201,24 @@ func TestObserver_Notify(t *testing.T) {
 		})
 	}
 }
+
+func TestObserver_AddListenerWhileNotify(t *testing.T) {
+	var (
+		o    = &Observer{}
+		done = make(chan bool)
+	)
+	o.AddListener(BlockPushed, Listener{OnNotify: func(interface{}, ...interface{}) {}})
+	go func() {
+		for i := 0; i < 100; i++ {
+			o.Notify(BlockPushed, mockData{})
+		}
+		done <- true
+	}()
+	for i := 0; i < 100; i++ {
+		o.AddListener(BlockPushed, Listener{OnNotify: func(interface{}, ...interface{}) {}})
+	}
+	<-done
+	if got := len(o.Listeners[BlockPushed]); got != 101 {
+		t.Errorf("AddListener() registered %d listeners, want 101", got)
+	}
+}